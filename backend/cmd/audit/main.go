@@ -0,0 +1,95 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"time"
+
+	"terraforming-mars-backend/internal/archive"
+	"terraforming-mars-backend/internal/audit"
+)
+
+// audit replays archived games and checks their conservation invariants, to catch engine bugs
+// It reads the archive the server writes, configured by the same environment variables:
+// TM_ARCHIVE_S3_BUCKET (with the other TM_ARCHIVE_S3_* settings) or TM_ARCHIVE_DIR.
+// Exits 1 when any game breaks an invariant and 2 when the archive cannot be read.
+func main() {
+	since := flag.Duration("since", 0, "Only audit games finished within this long, e.g. 24h (default every game)")
+	gameID := flag.String("game", "", "Only audit this game")
+	flag.Parse()
+
+	store, err := openStore()
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "❌", err)
+		os.Exit(2)
+	}
+
+	ctx := context.Background()
+	gameArchive, err := archive.Open(ctx, store)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "❌ Failed to open game archive:", err)
+		os.Exit(2)
+	}
+
+	gameIDs := make([]string, 0)
+	if *gameID != "" {
+		gameIDs = append(gameIDs, *gameID)
+	} else {
+		cutoff := time.Time{}
+		if *since > 0 {
+			cutoff = time.Now().Add(-*since)
+		}
+		for _, entry := range gameArchive.Games(archive.Query{}) {
+			if entry.FinishedAt.Before(cutoff) {
+				break // Most recently finished first
+			}
+			gameIDs = append(gameIDs, entry.GameID)
+		}
+	}
+
+	failed, unreadable := 0, 0
+	for _, id := range gameIDs {
+		record, err := gameArchive.Load(ctx, id)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "❌ %s: %v\n", id, err)
+			unreadable++
+			continue
+		}
+		violations := audit.CheckRecord(record)
+		if len(violations) == 0 {
+			continue
+		}
+		failed++
+		for _, violation := range violations {
+			fmt.Printf("%s\t%s\n", id, violation)
+		}
+	}
+
+	fmt.Fprintf(os.Stderr, "🧮 Audited %d games: %d with violations, %d unreadable\n", len(gameIDs), failed, unreadable)
+	switch {
+	case unreadable > 0:
+		os.Exit(2)
+	case failed > 0:
+		os.Exit(1)
+	}
+}
+
+func openStore() (archive.Store, error) {
+	switch {
+	case os.Getenv("TM_ARCHIVE_S3_BUCKET") != "":
+		return archive.NewS3Store(archive.S3Config{
+			Endpoint:  os.Getenv("TM_ARCHIVE_S3_ENDPOINT"),
+			Region:    os.Getenv("TM_ARCHIVE_S3_REGION"),
+			Bucket:    os.Getenv("TM_ARCHIVE_S3_BUCKET"),
+			Prefix:    os.Getenv("TM_ARCHIVE_S3_PREFIX"),
+			AccessKey: os.Getenv("TM_ARCHIVE_S3_ACCESS_KEY"),
+			SecretKey: os.Getenv("TM_ARCHIVE_S3_SECRET_KEY"),
+		})
+	case os.Getenv("TM_ARCHIVE_DIR") != "":
+		return archive.NewFileStore(os.Getenv("TM_ARCHIVE_DIR"))
+	default:
+		return nil, fmt.Errorf("set TM_ARCHIVE_DIR or TM_ARCHIVE_S3_BUCKET to the archive to audit")
+	}
+}
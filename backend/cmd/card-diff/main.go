@@ -0,0 +1,58 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+
+	"terraforming-mars-backend/internal/cards"
+)
+
+// card-diff compares two versions of the card data and prints what plays differently: cards added
+// and removed, cost, tag and requirement changes, and behavior changes as engine rules text.
+// The markdown report is meant for release notes; compare against the last release with e.g.
+//
+//	git show v1.4.0:backend/assets/terraforming_mars_cards.json > /tmp/old-cards.json
+//	go run ./cmd/card-diff -old /tmp/old-cards.json
+//
+// Exits 1 with -fail-on-change when anything changed, and 2 when a card file cannot be read.
+func main() {
+	oldPath := flag.String("old", "", "Card file of the previous release (required)")
+	newPath := flag.String("new", "assets/terraforming_mars_cards.json", "Card file to release")
+	format := flag.String("format", "markdown", "Report format: markdown or json")
+	failOnChange := flag.Bool("fail-on-change", false, "Exit 1 when any card plays differently")
+	flag.Parse()
+
+	if *oldPath == "" || (*format != "markdown" && *format != "json") {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	oldCards, err := cards.LoadCardsFromJSON(*oldPath)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "❌ Failed to load old cards:", err)
+		os.Exit(2)
+	}
+	newCards, err := cards.LoadCardsFromJSON(*newPath)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "❌ Failed to load new cards:", err)
+		os.Exit(2)
+	}
+
+	diff := cards.DiffCards(oldCards, newCards)
+	if *format == "json" {
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "  ")
+		if err := encoder.Encode(diff); err != nil {
+			fmt.Fprintln(os.Stderr, "❌ Failed to write report:", err)
+			os.Exit(2)
+		}
+	} else {
+		fmt.Print(diff.Markdown())
+	}
+
+	if *failOnChange && !diff.Empty() {
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,146 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"terraforming-mars-backend/internal/archive"
+	"terraforming-mars-backend/internal/delivery/dto"
+	"terraforming-mars-backend/internal/game"
+)
+
+// inspect prints a game's logged state right after one log entry, to narrow down when a bug appeared
+// Live games are read from a running server's admin endpoint (TM_ADMIN_ENABLED=true on the server);
+// with -archive, finished games are read from the archive configured by the same environment
+// variables as the server: TM_ARCHIVE_S3_BUCKET (with the other TM_ARCHIVE_S3_* settings) or TM_ARCHIVE_DIR.
+// Exits 1 when the game or entry is not found and 2 when the source cannot be read.
+func main() {
+	server := flag.String("server", "http://localhost:3001", "Base URL of the server to ask for live games")
+	fromArchive := flag.Bool("archive", false, "Read a finished game from the archive instead of the server")
+	flag.Usage = func() {
+		fmt.Fprintln(os.Stderr, "Usage: inspect [-server URL | -archive] <gameId> <seq>")
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	if flag.NArg() != 2 {
+		flag.Usage()
+		os.Exit(2)
+	}
+	gameID := flag.Arg(0)
+	sequence, err := strconv.ParseInt(flag.Arg(1), 10, 64)
+	if err != nil || sequence < 1 {
+		fmt.Fprintln(os.Stderr, "❌ seq must be a log sequence number, starting at 1")
+		os.Exit(2)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	var response dto.AdminStateAtResponse
+	var notFound bool
+	if *fromArchive {
+		response, notFound, err = fromArchiveRecord(ctx, gameID, sequence)
+	} else {
+		response, notFound, err = fromServer(ctx, *server, gameID, sequence)
+	}
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "❌", err)
+		if notFound {
+			os.Exit(1)
+		}
+		os.Exit(2)
+	}
+
+	encoder := json.NewEncoder(os.Stdout)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(response); err != nil {
+		fmt.Fprintln(os.Stderr, "❌ Failed to print state:", err)
+		os.Exit(2)
+	}
+}
+
+func fromServer(ctx context.Context, server, gameID string, sequence int64) (dto.AdminStateAtResponse, bool, error) {
+	var response dto.AdminStateAtResponse
+
+	endpoint := fmt.Sprintf("%s/api/v1/admin/games/%s/state-at?sequence=%d",
+		strings.TrimSuffix(server, "/"), url.PathEscape(gameID), sequence)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return response, false, err
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return response, false, fmt.Errorf("failed to reach server: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return response, false, fmt.Errorf("failed to read response: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return response, resp.StatusCode == http.StatusNotFound,
+			fmt.Errorf("server answered %s: %s (is TM_ADMIN_ENABLED=true?)", resp.Status, strings.TrimSpace(string(body)))
+	}
+	if err := json.Unmarshal(body, &response); err != nil {
+		return response, false, fmt.Errorf("failed to decode response: %w", err)
+	}
+	return response, false, nil
+}
+
+func fromArchiveRecord(ctx context.Context, gameID string, sequence int64) (dto.AdminStateAtResponse, bool, error) {
+	store, err := openStore()
+	if err != nil {
+		return dto.AdminStateAtResponse{}, false, err
+	}
+	gameArchive, err := archive.Open(ctx, store)
+	if err != nil {
+		return dto.AdminStateAtResponse{}, false, fmt.Errorf("failed to open game archive: %w", err)
+	}
+	record, err := gameArchive.Load(ctx, gameID)
+	if err != nil {
+		return dto.AdminStateAtResponse{}, errors.Is(err, game.ErrGameNotFound), err
+	}
+
+	snapshot, err := game.ReplaySnapshot(record.Log, sequence)
+	if err != nil {
+		return dto.AdminStateAtResponse{}, true, fmt.Errorf("%s: %w", gameID, err)
+	}
+	var entry game.StateDiff
+	for _, diff := range record.Log {
+		if diff.SequenceNumber == sequence {
+			entry = diff
+			break
+		}
+	}
+	return dto.ToAdminStateAtResponse(gameID, entry, snapshot), false, nil
+}
+
+func openStore() (archive.Store, error) {
+	switch {
+	case os.Getenv("TM_ARCHIVE_S3_BUCKET") != "":
+		return archive.NewS3Store(archive.S3Config{
+			Endpoint:  os.Getenv("TM_ARCHIVE_S3_ENDPOINT"),
+			Region:    os.Getenv("TM_ARCHIVE_S3_REGION"),
+			Bucket:    os.Getenv("TM_ARCHIVE_S3_BUCKET"),
+			Prefix:    os.Getenv("TM_ARCHIVE_S3_PREFIX"),
+			AccessKey: os.Getenv("TM_ARCHIVE_S3_ACCESS_KEY"),
+			SecretKey: os.Getenv("TM_ARCHIVE_S3_SECRET_KEY"),
+		})
+	case os.Getenv("TM_ARCHIVE_DIR") != "":
+		return archive.NewFileStore(os.Getenv("TM_ARCHIVE_DIR"))
+	default:
+		return nil, fmt.Errorf("set TM_ARCHIVE_DIR or TM_ARCHIVE_S3_BUCKET to the archive to read")
+	}
+}
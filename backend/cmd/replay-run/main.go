@@ -0,0 +1,130 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+
+	"terraforming-mars-backend/internal/archive"
+	"terraforming-mars-backend/internal/cards"
+	"terraforming-mars-backend/internal/game"
+	"terraforming-mars-backend/internal/logger"
+	"terraforming-mars-backend/internal/replay"
+)
+
+// replay-run replays an archived game's inputs through the current engine and reports the first input
+// whose resulting state differs from the recorded one, to reproduce bugs from real games.
+// The game comes from -file (a record blob copied out of the archive) or, with -game, from the archive
+// the server writes: TM_ARCHIVE_S3_BUCKET (with the other TM_ARCHIVE_S3_* settings) or TM_ARCHIVE_DIR.
+// Exits 1 when the replay diverges and 2 when the game cannot be replayed.
+func main() {
+	gameID := flag.String("game", "", "Replay this archived game")
+	file := flag.String("file", "", "Replay the record blob in this file instead of loading it from the archive")
+	cardPath := flag.String("cards", "assets/terraforming_mars_cards.json", "Card data to replay with")
+	logLevel := flag.String("log-level", "error", "Engine log level while replaying")
+	flag.Parse()
+
+	if (*gameID == "") == (*file == "") {
+		fmt.Fprintln(os.Stderr, "❌ Pass exactly one of -game or -file")
+		os.Exit(2)
+	}
+	if err := logger.Init(logLevel); err != nil {
+		fmt.Fprintln(os.Stderr, "❌ Failed to initialize logger:", err)
+		os.Exit(2)
+	}
+
+	cardData, err := cards.LoadCardsFromJSON(*cardPath)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "❌ Failed to load cards:", err)
+		os.Exit(2)
+	}
+	cardRegistry := cards.NewInMemoryCardRegistry(cardData)
+
+	ctx := context.Background()
+	record, err := loadRecord(ctx, *gameID, *file)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "❌", err)
+		os.Exit(2)
+	}
+
+	result, err := replay.Run(ctx, cardRegistry, record, logger.Get())
+	if err != nil {
+		if errors.Is(err, replay.ErrNoInputs) {
+			fmt.Fprintf(os.Stderr, "❌ %s: %v (only games archived since inputs are recorded can be replayed)\n", record.GameID, err)
+		} else {
+			fmt.Fprintf(os.Stderr, "❌ %s: %v\n", record.GameID, err)
+		}
+		os.Exit(2)
+	}
+
+	if result.Divergence == nil {
+		fmt.Fprintf(os.Stderr, "✅ %s: all %d inputs reproduced their recorded state\n", record.GameID, result.Replayed)
+		return
+	}
+	printDivergence(record, result)
+	os.Exit(1)
+}
+
+func loadRecord(ctx context.Context, gameID, file string) (archive.Record, error) {
+	if file != "" {
+		data, err := os.ReadFile(file)
+		if err != nil {
+			return archive.Record{}, err
+		}
+		return archive.DecodeRecord(data)
+	}
+
+	store, err := openStore()
+	if err != nil {
+		return archive.Record{}, err
+	}
+	gameArchive, err := archive.Open(ctx, store)
+	if err != nil {
+		return archive.Record{}, fmt.Errorf("failed to open game archive: %w", err)
+	}
+	return gameArchive.Load(ctx, gameID)
+}
+
+func printDivergence(record archive.Record, result *replay.Result) {
+	divergence := result.Divergence
+	input := divergence.Input
+	fmt.Printf("❌ %s diverged at input %d of %d: %s from %s\n", record.GameID, input.Sequence, len(record.Inputs), input.Type, input.PlayerID)
+	fmt.Printf("payload: %s\n", input.Payload)
+	for _, message := range divergence.Errors {
+		fmt.Printf("replay error: %s\n", message)
+	}
+	if divergence.Changes != nil {
+		changes, _ := json.MarshalIndent(divergence.Changes, "", "  ")
+		fmt.Printf("state, recorded (old) → replayed (new):\n%s\n", changes)
+	}
+	printEntries("recorded log", divergence.RecordedLog)
+	printEntries("replayed log", divergence.ReplayedLog)
+}
+
+func printEntries(title string, entries []game.StateDiff) {
+	fmt.Printf("%s (%d):\n", title, len(entries))
+	for _, entry := range entries {
+		fmt.Printf("  %s\t%s\t%s\t%s\n", entry.SourceType, entry.Source, entry.PlayerID, entry.Description)
+	}
+}
+
+func openStore() (archive.Store, error) {
+	switch {
+	case os.Getenv("TM_ARCHIVE_S3_BUCKET") != "":
+		return archive.NewS3Store(archive.S3Config{
+			Endpoint:  os.Getenv("TM_ARCHIVE_S3_ENDPOINT"),
+			Region:    os.Getenv("TM_ARCHIVE_S3_REGION"),
+			Bucket:    os.Getenv("TM_ARCHIVE_S3_BUCKET"),
+			Prefix:    os.Getenv("TM_ARCHIVE_S3_PREFIX"),
+			AccessKey: os.Getenv("TM_ARCHIVE_S3_ACCESS_KEY"),
+			SecretKey: os.Getenv("TM_ARCHIVE_S3_SECRET_KEY"),
+		})
+	case os.Getenv("TM_ARCHIVE_DIR") != "":
+		return archive.NewFileStore(os.Getenv("TM_ARCHIVE_DIR"))
+	default:
+		return nil, fmt.Errorf("set TM_ARCHIVE_DIR or TM_ARCHIVE_S3_BUCKET to the archive to replay from, or pass -file")
+	}
+}
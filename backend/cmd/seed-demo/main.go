@@ -0,0 +1,120 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	"terraforming-mars-backend/internal/delivery/dto"
+	"terraforming-mars-backend/internal/delivery/jsonrpc"
+
+	"github.com/google/uuid"
+)
+
+// seed-demo asks a running server for a demo game already in a later generation, with bot seats,
+// and prints where to open it; for frontend development and screenshots.
+// Each bot seat gets a bot token for the JSON-RPC endpoint, so a script can play it; any seat can
+// also be taken over from the game's player list. The seats are linked to a fresh account, whose key
+// proves to the server that the tokens are requested by the seats' owner.
+func main() {
+	server := flag.String("server", "http://localhost:3001", "Backend to create the game on")
+	site := flag.String("site", "http://localhost:3000", "Frontend the join links point at")
+	name := flag.String("name", "You", "Name of the human player")
+	bots := flag.Int("bots", 2, "Bot seats, 1-4")
+	generation := flag.Int("generation", 5, "Generation to fast-forward to, 1-10")
+	packs := flag.String("packs", "", "Comma-separated card packs (default: the demo lobby's)")
+	tokens := flag.Bool("bot-tokens", true, "Issue a bot token for each bot seat")
+	flag.Parse()
+
+	client := &http.Client{Timeout: 30 * time.Second}
+	apiURL := strings.TrimSuffix(*server, "/") + "/api/v1"
+
+	request := dto.SeedDemoGameRequest{
+		PlayerName: *name,
+		BotCount:   *bots,
+		Generation: *generation,
+	}
+	if *packs != "" {
+		request.CardPacks = strings.Split(*packs, ",")
+	}
+
+	var accountKey string
+	if *tokens {
+		request.AccountID = uuid.New().String()
+		var registered dto.AccountKeyResponse
+		if err := post(client, apiURL+"/players/"+url.PathEscape(request.AccountID)+"/key", nil, "", &registered); err != nil {
+			fmt.Fprintln(os.Stderr, "❌ Failed to register the seats' account:", err)
+			os.Exit(1)
+		}
+		accountKey = registered.AccountKey
+	}
+
+	var seeded dto.SeedDemoGameResponse
+	if err := post(client, apiURL+"/games/demo/seeded", request, "", &seeded); err != nil {
+		fmt.Fprintln(os.Stderr, "❌ Failed to seed demo game:", err)
+		os.Exit(1)
+	}
+
+	gameID := seeded.Game.ID
+	gameURL := strings.TrimSuffix(*site, "/") + "/game/" + url.PathEscape(gameID)
+	fmt.Printf("🌱 Demo game %s at generation %d\n", gameID, seeded.Game.Generation)
+	fmt.Printf("   open:   %s\n", gameURL)
+	fmt.Printf("   %s (%s) moves first\n", *name, seeded.PlayerID)
+
+	for i, botID := range seeded.BotIDs {
+		line := fmt.Sprintf("   Bot %d (%s)", i+1, botID)
+		if *tokens {
+			var issued jsonrpc.IssueTokenResponse
+			tokenURL := apiURL + "/games/" + url.PathEscape(gameID) + "/players/" + url.PathEscape(botID) + "/bot-token"
+			if err := post(client, tokenURL, nil, accountKey, &issued); err != nil {
+				fmt.Fprintln(os.Stderr, "❌ Failed to issue bot token:", err)
+				os.Exit(1)
+			}
+			line += " token " + issued.Token + " (expires " + issued.ExpiresAt.Format(time.RFC3339) + ")"
+		}
+		fmt.Println(line)
+	}
+	if *tokens && len(seeded.BotIDs) > 0 {
+		fmt.Printf("   bots play through POST %s/rpc\n", apiURL)
+	}
+}
+
+// post sends body as JSON, with the key as a Bearer token when set, and decodes a 201 Created response into out
+func post(client *http.Client, target string, body any, key string, out any) error {
+	var payload io.Reader = http.NoBody
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		payload = bytes.NewReader(data)
+	}
+
+	req, err := http.NewRequest(http.MethodPost, target, payload)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if key != "" {
+		req.Header.Set("Authorization", "Bearer "+key)
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusCreated {
+		message, _ := io.ReadAll(io.LimitReader(resp.Body, 4096))
+		return fmt.Errorf("%s: %s", resp.Status, strings.TrimSpace(string(message)))
+	}
+	return json.NewDecoder(resp.Body).Decode(out)
+}
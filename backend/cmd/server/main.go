@@ -2,18 +2,22 @@ package main
 
 import (
 	"context"
+	"fmt"
 	"net/http"
 	"os"
 	"os/signal"
 	"path/filepath"
+	"strconv"
 	"syscall"
 	"time"
 
+	accountAction "terraforming-mars-backend/internal/action/account"
 	admin "terraforming-mars-backend/internal/action/admin"
 	awardAction "terraforming-mars-backend/internal/action/award"
 	cardAction "terraforming-mars-backend/internal/action/card"
 	confirmAction "terraforming-mars-backend/internal/action/confirmation"
 	connAction "terraforming-mars-backend/internal/action/connection"
+	demoAction "terraforming-mars-backend/internal/action/demo"
 	gameAction "terraforming-mars-backend/internal/action/game"
 	milestoneAction "terraforming-mars-backend/internal/action/milestone"
 	query "terraforming-mars-backend/internal/action/query"
@@ -21,13 +25,20 @@ import (
 	stdprojAction "terraforming-mars-backend/internal/action/standard_project"
 	tileAction "terraforming-mars-backend/internal/action/tile"
 	turnAction "terraforming-mars-backend/internal/action/turn_management"
+	tutorialAction "terraforming-mars-backend/internal/action/tutorial"
+	"terraforming-mars-backend/internal/analytics"
+	"terraforming-mars-backend/internal/archive"
+	"terraforming-mars-backend/internal/buildinfo"
 	"terraforming-mars-backend/internal/cards"
 	httpHandler "terraforming-mars-backend/internal/delivery/http"
+	"terraforming-mars-backend/internal/delivery/jsonrpc"
 	wsHandler "terraforming-mars-backend/internal/delivery/websocket"
 	"terraforming-mars-backend/internal/delivery/websocket/core"
 	"terraforming-mars-backend/internal/game"
 	"terraforming-mars-backend/internal/logger"
 	httpmiddleware "terraforming-mars-backend/internal/middleware/http"
+	"terraforming-mars-backend/internal/tutorial"
+	"terraforming-mars-backend/internal/webhook"
 
 	"github.com/gorilla/mux"
 	"go.uber.org/zap"
@@ -68,40 +79,197 @@ func main() {
 		log.Fatal("Failed to load cards", zap.Error(err))
 	}
 	cardRegistry := cards.NewInMemoryCardRegistry(cardData)
-	log.Info("🃏 Card registry initialized", zap.Int("card_count", len(cardData)))
+	cardDataVersion, err := cards.CardDataVersion(cardPath)
+	if err != nil {
+		log.Fatal("Failed to fingerprint cards", zap.Error(err))
+	}
+	log.Info("🃏 Card registry initialized", zap.Int("card_count", len(cardData)), zap.String("card_data_version", cardDataVersion))
+	if turmoilCards := cards.TurmoilDependentCards(cardData); len(turmoilCards) > 0 {
+		log.Warn("🗳️ Cards with Turmoil requirements, influence or delegates skip those parts until Turmoil is implemented",
+			zap.Int("card_count", len(turmoilCards)),
+			zap.Strings("card_ids", turmoilCards))
+	}
+
+	build := buildinfo.Read(cardDataVersion)
+	log.Info("🏷️ Build info", zap.String("git_sha", build.GitSHA), zap.Bool("modified", build.Modified))
+
+	// ========== Initialize Tutorial Scenarios & Puzzles ==========
+	tutorialPath := filepath.Join(wd, "assets", "tutorials")
+	scenarioData, err := tutorial.LoadScenariosFromDir(tutorialPath)
+	if err != nil {
+		log.Fatal("Failed to load tutorial scenarios", zap.Error(err))
+	}
+	tutorialScenarios := tutorial.NewRegistry(scenarioData)
+	log.Info("🎓 Tutorial scenarios loaded", zap.Int("scenario_count", len(scenarioData)))
+
+	puzzlePath := filepath.Join(wd, "assets", "puzzles")
+	puzzleData, err := tutorial.LoadScenariosFromDir(puzzlePath)
+	if err != nil {
+		log.Fatal("Failed to load puzzles", zap.Error(err))
+	}
+	puzzles := tutorial.NewRegistry(puzzleData)
+	log.Info("🧩 Puzzles loaded", zap.Int("puzzle_count", len(puzzleData)))
+
+	guidePath := filepath.Join(wd, "assets", "guides")
+	guideData, err := cards.LoadGuidesFromDir(guidePath)
+	if err != nil {
+		log.Fatal("Failed to load corporation guides", zap.Error(err))
+	}
+	cardGuides := cards.NewGuideRegistry(guideData)
+	if err := cardGuides.CheckAgainst(cardRegistry); err != nil {
+		log.Fatal("Corporation guides do not match the card data", zap.Error(err))
+	}
+	log.Info("📖 Corporation guides loaded", zap.Int("guide_count", len(guideData)))
+
+	puzzleCompletions := tutorial.NewCompletionStore()
+	tutorialTracker := tutorial.NewTracker(cardRegistry, puzzleCompletions)
+
+	// ========== Initialize Analytics Store (Opt-in, Anonymized) ==========
+	analyticsStore := analytics.NewStore()
 
 	// ========== Initialize Game Repository (Single Source of Truth) ==========
 	gameRepo := game.NewInMemoryGameRepository()
-	log.Info("🎮 Game repository initialized")
+	if raw := os.Getenv("TM_MAX_ACTIVE_GAMES"); raw != "" {
+		parsed, err := strconv.Atoi(raw)
+		if err != nil || parsed < 0 {
+			log.Fatal("Invalid TM_MAX_ACTIVE_GAMES", zap.String("value", raw))
+		}
+		gameRepo.SetMaxActiveGames(parsed)
+	}
+	log.Info("🎮 Game repository initialized", zap.Int("max_active_games", gameRepo.Capacity().Max))
 
 	// ========== Initialize Game State Repository (Diff Logging) ==========
 	stateRepo := game.NewInMemoryGameStateRepository()
 	log.Info("📊 Game state repository initialized")
 
+	// ========== Initialize Game Archive (Finished Games Leave the Hot Store) ==========
+	// TM_ARCHIVE_S3_BUCKET selects an S3-compatible bucket, TM_ARCHIVE_DIR a local directory;
+	// without either, archived games are kept compressed in memory
+	var archiveStore archive.Store
+	switch {
+	case os.Getenv("TM_ARCHIVE_S3_BUCKET") != "":
+		s3Store, err := archive.NewS3Store(archive.S3Config{
+			Endpoint:  os.Getenv("TM_ARCHIVE_S3_ENDPOINT"),
+			Region:    os.Getenv("TM_ARCHIVE_S3_REGION"),
+			Bucket:    os.Getenv("TM_ARCHIVE_S3_BUCKET"),
+			Prefix:    os.Getenv("TM_ARCHIVE_S3_PREFIX"),
+			AccessKey: os.Getenv("TM_ARCHIVE_S3_ACCESS_KEY"),
+			SecretKey: os.Getenv("TM_ARCHIVE_S3_SECRET_KEY"),
+		})
+		if err != nil {
+			log.Fatal("Invalid archive bucket settings", zap.Error(err))
+		}
+		archiveStore = s3Store
+	case os.Getenv("TM_ARCHIVE_DIR") != "":
+		fileStore, err := archive.NewFileStore(os.Getenv("TM_ARCHIVE_DIR"))
+		if err != nil {
+			log.Fatal("Invalid TM_ARCHIVE_DIR", zap.Error(err))
+		}
+		archiveStore = fileStore
+	default:
+		archiveStore = archive.NewMemoryStore()
+	}
+	gameArchive, err := archive.Open(context.Background(), archiveStore)
+	if err != nil {
+		log.Fatal("Failed to open game archive", zap.Error(err))
+	}
+	archiveAfter := gameAction.DefaultArchiveAfter
+	if raw := os.Getenv("TM_ARCHIVE_AFTER"); raw != "" {
+		parsed, err := time.ParseDuration(raw)
+		if err != nil || parsed <= 0 {
+			log.Fatal("Invalid TM_ARCHIVE_AFTER", zap.String("value", raw))
+		}
+		archiveAfter = parsed
+	}
+	log.Info("📦 Game archive initialized", zap.Duration("archive_after", archiveAfter))
+
 	// ========== Initialize WebSocket Hub ==========
 	hub := core.NewHub()
+	if raw := os.Getenv("TM_ACTION_TIMEOUT"); raw != "" {
+		parsed, err := time.ParseDuration(raw)
+		if err != nil || parsed < 0 {
+			log.Fatal("Invalid TM_ACTION_TIMEOUT", zap.String("value", raw))
+		}
+		hub.SetActionTimeout(parsed)
+	}
+	if raw := os.Getenv("TM_ACTION_HANG_TIMEOUT"); raw != "" {
+		parsed, err := time.ParseDuration(raw)
+		if err != nil || parsed < 0 {
+			log.Fatal("Invalid TM_ACTION_HANG_TIMEOUT", zap.String("value", raw))
+		}
+		hub.SetHangTimeout(parsed)
+	}
+	if raw := os.Getenv("TM_DISCONNECT_GRACE"); raw != "" {
+		parsed, err := time.ParseDuration(raw)
+		if err != nil || parsed < 0 {
+			log.Fatal("Invalid TM_DISCONNECT_GRACE", zap.String("value", raw))
+		}
+		hub.SetDisconnectGrace(parsed)
+	}
+	if raw := os.Getenv("TM_BROADCAST_SIZE_BUDGET"); raw != "" {
+		parsed, err := strconv.ParseInt(raw, 10, 64)
+		if err != nil || parsed < 0 {
+			log.Fatal("Invalid TM_BROADCAST_SIZE_BUDGET", zap.String("value", raw))
+		}
+		hub.WireMetrics().SetSizeBudget(parsed)
+	}
 	log.Info("🔌 WebSocket hub initialized")
 
 	// ========== Initialize Game State Broadcaster (Automatic Broadcasting) ==========
-	broadcaster := wsHandler.NewBroadcaster(gameRepo, stateRepo, hub, cardRegistry)
+	broadcaster := wsHandler.NewBroadcaster(gameRepo, stateRepo, hub, cardRegistry, tutorialTracker)
 	log.Info("📡 Game state broadcaster initialized (provides automatic broadcasting for all games)")
 
+	// A game whose action hung is marked failed so its players and the archive see it ended
+	hub.SetGameFailedHandler(func(gameID string) {
+		g, err := gameRepo.Get(context.Background(), gameID)
+		if err != nil {
+			log.Error("Failed to load hung game", zap.String("game_id", gameID), zap.Error(err))
+			return
+		}
+		if err := g.UpdateStatus(context.Background(), game.GameStatusFailed); err != nil {
+			log.Error("Failed to mark hung game failed", zap.String("game_id", gameID), zap.Error(err))
+			return
+		}
+		broadcaster.BroadcastGameState(gameID, nil)
+	})
+
+	// Games deleted or archived out of the repository take their message queue with them
+	gameRepo.OnDelete(hub.RemoveGameQueue)
+
 	// ========== Initialize Game Actions ==========
 
-	// Game lifecycle (5)
+	// Game lifecycle (19 here, 22 with ForceAdvancePhase, ArchiveGames and NotifyWebhooks, built below after their dependencies)
 	createGameAction := gameAction.NewCreateGameAction(gameRepo, cardRegistry, log)
 	createDemoLobbyAction := gameAction.NewCreateDemoLobbyAction(gameRepo, cardRegistry, log)
 	joinGameAction := gameAction.NewJoinGameAction(gameRepo, cardRegistry, log)
 	confirmDemoSetupAction := gameAction.NewConfirmDemoSetupAction(gameRepo, cardRegistry, log)
-	finalScoringAction := gameAction.NewFinalScoringAction(gameRepo, cardRegistry, log)
+	finalScoringAction := gameAction.NewFinalScoringAction(gameRepo, cardRegistry, analyticsStore, log)
+	setSeatOrderAction := gameAction.NewSetSeatOrderAction(gameRepo, log)
+	setHandicapAction := gameAction.NewSetHandicapAction(gameRepo, log)
+	setPlayerColorAction := gameAction.NewSetPlayerColorAction(gameRepo, log)
+	pauseGameAction := gameAction.NewPauseGameAction(gameRepo, log)
+	resumeGameAction := gameAction.NewResumeGameAction(gameRepo, log)
+	voteAbandonAction := gameAction.NewVoteAbandonAction(gameRepo, log)
+	setPreferencesAction := gameAction.NewSetPreferencesAction(gameRepo, log)
+	createInviteAction := gameAction.NewCreateInviteAction(gameRepo, log)
+	listInvitesAction := gameAction.NewListInvitesAction(gameRepo, log)
+	revokeInviteAction := gameAction.NewRevokeInviteAction(gameRepo, log)
+	sendReactionAction := gameAction.NewSendReactionAction(gameRepo, stateRepo, log)
+	muteReactionsAction := gameAction.NewMuteReactionsAction(gameRepo, log)
+	setNoteAction := gameAction.NewSetNoteAction(gameRepo, log)
+	runBatchAction := gameAction.NewRunBatchAction(gameRepo, stateRepo, log)
 
 	// Milestones & Awards (2)
 	claimMilestoneAction := milestoneAction.NewClaimMilestoneAction(gameRepo, cardRegistry, stateRepo, log)
 	fundAwardAction := awardAction.NewFundAwardAction(gameRepo, cardRegistry, stateRepo, log)
 
-	// Card actions (2)
+	// Card actions (6)
 	playCardAction := cardAction.NewPlayCardAction(gameRepo, cardRegistry, stateRepo, log)
+	preparePlayCardAction := cardAction.NewPreparePlayCardAction(gameRepo, cardRegistry, log)
+	commitPlayCardAction := cardAction.NewCommitPlayCardAction(gameRepo, playCardAction, log)
+	cancelPlayCardAction := cardAction.NewCancelPlayCardAction(gameRepo, log)
 	useCardActionAction := cardAction.NewUseCardActionAction(gameRepo, cardRegistry, stateRepo, log)
+	previewActionAction := cardAction.NewPreviewActionAction(gameRepo, cardRegistry, log)
 
 	// Standard projects (6)
 	launchAsteroidAction := stdprojAction.NewLaunchAsteroidAction(gameRepo, stateRepo, log)
@@ -111,22 +279,42 @@ func main() {
 	plantGreeneryAction := stdprojAction.NewPlantGreeneryAction(gameRepo, stateRepo, log)
 	sellPatentsAction := stdprojAction.NewSellPatentsAction(gameRepo, stateRepo, log)
 
-	// Resource conversions (2)
+	// Resource conversions (3)
 	convertHeatAction := resconvAction.NewConvertHeatToTemperatureAction(gameRepo, cardRegistry, stateRepo, log)
 	convertPlantsAction := resconvAction.NewConvertPlantsToGreeneryAction(gameRepo, cardRegistry, stateRepo, log)
+	convertAllAction := resconvAction.NewConvertAllAction(gameRepo, cardRegistry, stateRepo, log)
 
 	// Tile selection (1)
 	selectTileAction := tileAction.NewSelectTileAction(gameRepo, cardRegistry, stateRepo, log)
 
-	// Turn management (3)
-	startGameAction := turnAction.NewStartGameAction(gameRepo, log)
-	skipActionAction := turnAction.NewSkipActionAction(gameRepo, finalScoringAction, log)
+	// Turn management (8)
+	startGameAction := turnAction.NewStartGameAction(gameRepo, cardRegistry, log)
+	skipActionAction := turnAction.NewSkipActionAction(gameRepo, cardRegistry, finalScoringAction, log)
+	concedeAction := turnAction.NewConcedeAction(gameRepo, skipActionAction)
+	autoPassAction := turnAction.NewAutoPassAction(gameRepo, skipActionAction, cardRegistry, log)
 	selectStartingCardsAction := turnAction.NewSelectStartingCardsAction(gameRepo, cardRegistry, log)
+	mulliganStartingHandAction := turnAction.NewMulliganStartingHandAction(gameRepo, stateRepo, log)
+	startingSelectionTimeoutAction := turnAction.NewStartingSelectionTimeoutAction(gameRepo, stateRepo, selectStartingCardsAction, log)
+	turnTimeoutAction := turnAction.NewTurnTimeoutAction(gameRepo, stateRepo, skipActionAction, log)
 
-	// Confirmations (3)
+	// Confirmations (4)
 	confirmSellPatentsAction := confirmAction.NewConfirmSellPatentsAction(gameRepo, log)
-	confirmProductionCardsAction := confirmAction.NewConfirmProductionCardsAction(gameRepo, cardRegistry, log)
+	confirmProductionCardsAction := confirmAction.NewConfirmProductionCardsAction(gameRepo, cardRegistry, analyticsStore, log)
 	confirmCardDrawAction := confirmAction.NewConfirmCardDrawAction(gameRepo, cardRegistry, log)
+	respondToEffectAction := confirmAction.NewRespondToEffectAction(gameRepo, stateRepo, log)
+
+	// Host force-advance and research timeout both confirm empty purchases for waiting players
+	forceAdvancePhaseAction := gameAction.NewForceAdvancePhaseAction(gameRepo, stateRepo, confirmProductionCardsAction, log)
+	archiveGamesAction := gameAction.NewArchiveGamesAction(gameRepo, stateRepo, gameArchive, archiveAfter, log)
+
+	// Lifecycle webhooks for community sites: TM_WEBHOOK_URLS lists endpoints ("discord:" prefix for Discord channels),
+	// TM_WEBHOOK_SECRET signs deliveries and TM_PUBLIC_URL builds lobby join links (invite links use it too)
+	webhookEndpoints, err := webhook.ParseEndpoints(os.Getenv("TM_WEBHOOK_URLS"))
+	if err != nil {
+		log.Fatal("Invalid TM_WEBHOOK_URLS", zap.Error(err))
+	}
+	webhookSender := webhook.NewSender(webhookEndpoints, os.Getenv("TM_WEBHOOK_SECRET"), log)
+	notifyWebhooksAction := gameAction.NewNotifyWebhooksAction(gameRepo, webhookSender, os.Getenv("TM_PUBLIC_URL"), log)
 
 	// Connection management (4)
 	playerReconnectedAction := connAction.NewPlayerReconnectedAction(gameRepo, log)
@@ -134,36 +322,101 @@ func main() {
 	playerTakeoverAction := connAction.NewPlayerTakeoverAction(gameRepo, cardRegistry, log)
 	kickPlayerAction := connAction.NewKickPlayerAction(gameRepo, log)
 
-	// Admin actions (9)
+	// Admin actions (11)
 	adminSetPhaseAction := admin.NewSetPhaseAction(gameRepo, log)
 	adminSetCurrentTurnAction := admin.NewSetCurrentTurnAction(gameRepo, log)
 	adminSetResourcesAction := admin.NewSetResourcesAction(gameRepo, log)
 	adminSetProductionAction := admin.NewSetProductionAction(gameRepo, log)
 	adminSetGlobalParametersAction := admin.NewSetGlobalParametersAction(gameRepo, log)
-	adminGiveCardAction := admin.NewGiveCardAction(gameRepo, cardRegistry, log)
+	adminGiveCardAction := admin.NewGiveCardAction(gameRepo, cardRegistry, stateRepo, log)
 	adminSetCorporationAction := admin.NewSetCorporationAction(gameRepo, cardRegistry, log)
 	adminStartTileSelectionAction := admin.NewStartTileSelectionAction(gameRepo, log)
 	adminSetTRAction := admin.NewSetTRAction(gameRepo, log)
+	adminSetupTestStateAction := admin.NewSetupTestStateAction(gameRepo, cardRegistry, log)
+	adminSetAwardFreezeAction := admin.NewSetAwardFreezeAction(gameRepo, log)
 
-	// Query actions for HTTP (5)
+	// Tutorials & puzzles (2)
+	startTutorialAction := tutorialAction.NewStartTutorialAction(gameRepo, cardRegistry, tutorialScenarios, tutorialTracker, createDemoLobbyAction, startGameAction, confirmDemoSetupAction, log)
+	startPuzzleAction := tutorialAction.NewStartTutorialAction(gameRepo, cardRegistry, puzzles, tutorialTracker, createDemoLobbyAction, startGameAction, confirmDemoSetupAction, log)
+
+	// Seeded demo games for frontend development (1)
+	seedDemoGameAction := demoAction.NewSeedDemoGameAction(gameRepo, cardRegistry, createDemoLobbyAction, joinGameAction, startGameAction, confirmDemoSetupAction, adminSetupTestStateAction, log)
+
+	// Query actions for HTTP (11)
 	getGameAction := query.NewGetGameAction(gameRepo, log)
+	gameQueries := query.NewGameQueryService(gameRepo, cardRegistry, query.DefaultProjectionMaxAge, log)
+	gameRepo.OnDelete(gameQueries.Invalidate)
 	getGameLogsAction := query.NewGetGameLogsAction(stateRepo, log)
+	exportGameLogAction := query.NewExportGameLogAction(gameRepo, stateRepo, log)
+	getGameOverlayAction := query.NewGetGameOverlayAction(gameQueries, stateRepo, log)
 	listGamesAction := query.NewListGamesAction(gameRepo, log)
 	listCardsAction := query.NewListCardsAction(cardRegistry, log)
+	resolveCardsAction := query.NewResolveCardsAction(cardRegistry, log)
 	getPlayerAction := query.NewGetPlayerAction(gameRepo, log)
+	listArchivedGamesAction := query.NewListArchivedGamesAction(gameArchive, log)
+	getArchivedGameAction := query.NewGetArchivedGameAction(gameArchive, log)
+
+	// Account actions for HTTP (2)
+	registerAccountAction := accountAction.NewRegisterAccountAction(gameArchive, log)
+	deleteAccountDataAction := accountAction.NewDeleteAccountDataAction(gameArchive, puzzleCompletions, log)
+
+	// Memory monitoring (alerts always on; listing and pprof only with TM_ADMIN_ENABLED=true, pprof on loopback)
+	adminEnabled := os.Getenv("TM_ADMIN_ENABLED") == "true"
+	memoryThreshold := admin.DefaultGameMemoryThreshold
+	if raw := os.Getenv("TM_GAME_MEMORY_ALERT_BYTES"); raw != "" {
+		parsed, err := strconv.ParseInt(raw, 10, 64)
+		if err != nil || parsed <= 0 {
+			log.Fatal("Invalid TM_GAME_MEMORY_ALERT_BYTES", zap.String("value", raw))
+		}
+		memoryThreshold = parsed
+	}
+	listGameFootprintsAction := admin.NewListGameFootprintsAction(gameRepo, stateRepo, memoryThreshold, log)
+
+	// Per-game log buffers only feed the admin logs endpoint, so they record nothing without it
+	if adminEnabled {
+		gameLogBytes := logger.DefaultMaxGameLogBytes
+		if raw := os.Getenv("TM_GAME_LOG_MAX_BYTES"); raw != "" {
+			parsed, err := strconv.ParseInt(raw, 10, 64)
+			if err != nil || parsed <= 0 {
+				log.Fatal("Invalid TM_GAME_LOG_MAX_BYTES", zap.String("value", raw))
+			}
+			gameLogBytes = parsed
+		}
+		logger.GameLogs().Configure(true, gameLogBytes)
+		log.Info("📜 Per-game log buffers recording", zap.Int64("max_bytes", gameLogBytes))
+	}
+
+	// Collusion heuristics on public games (flags are logged always; listed only with TM_ADMIN_ENABLED=true)
+	collusionMinAttacks := 0
+	if raw := os.Getenv("TM_COLLUSION_MIN_ATTACKS"); raw != "" {
+		parsed, err := strconv.Atoi(raw)
+		if err != nil || parsed <= 0 {
+			log.Fatal("Invalid TM_COLLUSION_MIN_ATTACKS", zap.String("value", raw))
+		}
+		collusionMinAttacks = parsed
+	}
+	collusionHeuristics, err := admin.ParseCollusionHeuristics(os.Getenv("TM_COLLUSION_HEURISTICS"), collusionMinAttacks)
+	if err != nil {
+		log.Fatal("Invalid TM_COLLUSION_HEURISTICS", zap.Error(err))
+	}
+	playerAddresses := admin.NewPlayerAddresses()
+	hub.GetManager().SetAddressRecorder(playerAddresses.Record)
+	listCollusionFlagsAction := admin.NewListCollusionFlagsAction(gameRepo, stateRepo, playerAddresses, collusionHeuristics, log)
 
 	log.Info("✅ All migration actions initialized")
-	log.Info("   📌 Game Lifecycle (5): CreateGame, CreateDemoLobby, JoinGame, ConfirmDemoSetup, FinalScoring")
-	log.Info("   📌 Card Actions (2): PlayCard, UseCardAction")
+	log.Info("   📌 Game Lifecycle (22): CreateGame, CreateDemoLobby, JoinGame, CreateInvite, ListInvites, RevokeInvite, ConfirmDemoSetup, FinalScoring, SetSeatOrder, SetHandicap, SetPlayerColor, PauseGame, ResumeGame, VoteAbandon, SetPreferences, SendReaction, MuteReactions, SetNote, RunBatch, ForceAdvancePhase, ArchiveGames, NotifyWebhooks")
+	log.Info("   📌 Card Actions (6): PlayCard, PreparePlayCard, CommitPlayCard, CancelPlayCard, UseCardAction, PreviewAction")
 	log.Info("   📌 Standard Projects (6): LaunchAsteroid, BuildPowerPlant, BuildAquifer, BuildCity, PlantGreenery, SellPatents")
-	log.Info("   📌 Resource Conversions (2): ConvertHeat, ConvertPlants")
+	log.Info("   📌 Resource Conversions (3): ConvertHeat, ConvertPlants, ConvertAll")
 	log.Info("   📌 Tile Selection (1): SelectTile")
-	log.Info("   📌 Turn Management (3): StartGame, SkipAction, SelectStartingCards")
-	log.Info("   📌 Confirmations (3): ConfirmSellPatents, ConfirmProductionCards, ConfirmCardDraw")
+	log.Info("   📌 Turn Management (8): StartGame, SkipAction, Concede, AutoPass, SelectStartingCards, MulliganStartingHand, StartingSelectionTimeout, TurnTimeout")
+	log.Info("   📌 Confirmations (4): ConfirmSellPatents, ConfirmProductionCards, ConfirmCardDraw, RespondToEffect")
 	log.Info("   📌 Connection Management (4): PlayerReconnected, PlayerDisconnected, PlayerTakeover, KickPlayer")
 	log.Info("   📌 Milestones & Awards (2): ClaimMilestone, FundAward")
-	log.Info("   📌 Admin Actions (9): SetPhase, SetCurrentTurn, SetResources, SetProduction, SetGlobalParameters, GiveCard, SetCorporation, StartTileSelection, SetTR")
-	log.Info("   📌 Query Actions (5): GetGame, GetGameLogs, ListGames, ListCards, GetPlayer")
+	log.Info("   📌 Admin Actions (11): SetPhase, SetCurrentTurn, SetResources, SetProduction, SetGlobalParameters, GiveCard, SetCorporation, StartTileSelection, SetTR, SetupTestState, SetAwardFreeze")
+	log.Info("   📌 Tutorials & Puzzles (2): StartTutorial, StartPuzzle")
+	log.Info("   📌 Account Actions (2): RegisterAccount, DeleteAccountData")
+	log.Info("   📌 Query Actions (9): GetGame, GetGameLogs, ExportGameLog, GetGameOverlay, ListGames, ListCards, GetPlayer, ListArchivedGames, GetArchivedGame")
 
 	// ========== Register Migration Handlers with WebSocket Hub ==========
 	wsHandler.RegisterHandlers(
@@ -173,8 +426,23 @@ func main() {
 		createGameAction,
 		joinGameAction,
 		confirmDemoSetupAction,
+		setSeatOrderAction,
+		setHandicapAction,
+		setPlayerColorAction,
+		pauseGameAction,
+		resumeGameAction,
+		voteAbandonAction,
+		setPreferencesAction,
+		forceAdvancePhaseAction,
+		sendReactionAction,
+		muteReactionsAction,
+		setNoteAction,
+		runBatchAction,
 		// Card actions
 		playCardAction,
+		preparePlayCardAction,
+		commitPlayCardAction,
+		cancelPlayCardAction,
 		useCardActionAction,
 		// Standard projects
 		launchAsteroidAction,
@@ -186,16 +454,21 @@ func main() {
 		// Resource conversions
 		convertHeatAction,
 		convertPlantsAction,
+		convertAllAction,
 		// Tile selection
 		selectTileAction,
 		// Turn management
 		startGameAction,
 		skipActionAction,
+		concedeAction,
+		autoPassAction,
 		selectStartingCardsAction,
+		mulliganStartingHandAction,
 		// Confirmations
 		confirmSellPatentsAction,
 		confirmProductionCardsAction,
 		confirmCardDrawAction,
+		respondToEffectAction,
 		// Connection
 		playerReconnectedAction,
 		playerDisconnectedAction,
@@ -214,9 +487,11 @@ func main() {
 		adminSetCorporationAction,
 		adminStartTileSelectionAction,
 		adminSetTRAction,
+		adminSetupTestStateAction,
+		adminSetAwardFreezeAction,
 	)
 
-	log.Info("🎯 Migration handlers registered with WebSocket hub (26 handlers)")
+	log.Info("🎯 Migration handlers registered with WebSocket hub (44 handlers)")
 
 	// ========== Start WebSocket Hub ==========
 	ctx, cancel := context.WithCancel(context.Background())
@@ -225,39 +500,194 @@ func main() {
 	go hub.Run(ctx)
 	log.Info("🔌 WebSocket hub running")
 
+	go listGameFootprintsAction.Monitor(ctx, time.Minute)
+	log.Info("🧮 Game memory monitor running", zap.Int64("threshold_bytes", memoryThreshold))
+
+	go listCollusionFlagsAction.Monitor(ctx, time.Minute)
+	log.Info("🚩 Collusion monitor running",
+		zap.Bool("same_ip", collusionHeuristics.SameAddress),
+		zap.Bool("targeted_attacks", collusionHeuristics.TargetedAttacks),
+		zap.Int("min_attacks", collusionHeuristics.MinAttacks))
+
+	go forceAdvancePhaseAction.Monitor(ctx, 5*time.Second, hub.RunInGameQueue, func(gameID string) {
+		broadcaster.BroadcastGameState(gameID, nil)
+	})
+	log.Info("⏰ Research timeout monitor running")
+
+	go startingSelectionTimeoutAction.Monitor(ctx, 5*time.Second, hub.RunInGameQueue, func(gameID string) {
+		broadcaster.BroadcastGameState(gameID, nil)
+	})
+	log.Info("⏰ Starting selection timeout monitor running")
+
+	go turnTimeoutAction.Monitor(ctx, time.Second, hub.RunInGameQueue, func(gameID string) {
+		broadcaster.BroadcastGameState(gameID, nil)
+	})
+	log.Info("⏰ Turn timeout monitor running")
+
+	go respondToEffectAction.Monitor(ctx, time.Second, hub.RunInGameQueue, func(gameID string) {
+		broadcaster.BroadcastGameState(gameID, nil)
+	})
+	log.Info("⏰ Response timeout monitor running", zap.Duration("timeout", game.ResponseTimeout))
+
+	go archiveGamesAction.Monitor(ctx, time.Minute)
+	log.Info("📦 Game archive monitor running")
+
+	if webhookSender.Enabled() {
+		go webhookSender.Run(ctx)
+		go notifyWebhooksAction.Monitor(ctx, 5*time.Second)
+		log.Info("🪝 Game lifecycle webhooks enabled", zap.Int("endpoints", len(webhookEndpoints)))
+	}
+
 	// ========== Setup HTTP Router ==========
 	mainRouter := mux.NewRouter()
 	mainRouter.Use(httpmiddleware.CORS) // Apply CORS to all routes
 
+	// pprof never shares the public listener: it only answers on a loopback address
+	var profilingServer *http.Server
+	if adminEnabled {
+		profilingAddr := httpHandler.DefaultProfilingAddr
+		if raw := os.Getenv("TM_PPROF_ADDR"); raw != "" {
+			profilingAddr = raw
+		}
+		var err error
+		profilingServer, err = httpHandler.NewProfilingServer(profilingAddr)
+		if err != nil {
+			log.Fatal("Invalid TM_PPROF_ADDR", zap.Error(err))
+		}
+		log.Warn("🩺 Admin endpoints enabled: /debug/pprof (loopback only), /api/v1/admin/games, /api/v1/admin/games/{gameId}/logs, /api/v1/admin/games/{gameId}/audit, /api/v1/admin/games/{gameId}/state-at, /api/v1/admin/games/{gameId}/awards, /api/v1/admin/collusion-flags and /api/v1/admin/websocket",
+			zap.String("pprof_addr", profilingAddr))
+	}
+
+	var adminFootprints *admin.ListGameFootprintsAction
+	var adminCollusionFlags *admin.ListCollusionFlagsAction
+	var adminAuditGame *admin.AuditGameAction
+	var adminGetStateAt *admin.GetStateAtAction
+	var adminInspectAwards *admin.InspectAwardsAction
+	if adminEnabled {
+		adminFootprints = listGameFootprintsAction
+		adminCollusionFlags = listCollusionFlagsAction
+		adminAuditGame = admin.NewAuditGameAction(gameRepo, stateRepo, log)
+		adminGetStateAt = admin.NewGetStateAtAction(stateRepo, log)
+		adminInspectAwards = admin.NewInspectAwardsAction(gameRepo, cardRegistry, log)
+	}
+
+	// Liveness, readiness and build info; readiness waits for cards, repositories and the hub
+	healthHandler := httpHandler.NewHealthHandler(build,
+		httpHandler.ReadinessCheck{Name: "cards", Check: func() error {
+			if len(cardRegistry.GetAll()) == 0 {
+				return fmt.Errorf("no cards loaded")
+			}
+			return nil
+		}},
+		httpHandler.ReadinessCheck{Name: "repositories", Check: func() error {
+			if gameRepo == nil || stateRepo == nil || gameArchive == nil {
+				return fmt.Errorf("repositories not initialized")
+			}
+			return nil
+		}},
+		httpHandler.ReadinessCheck{Name: "websocket-hub", Check: func() error {
+			if !hub.Running() {
+				return fmt.Errorf("websocket hub not running")
+			}
+			return nil
+		}},
+	)
+	healthHandler.SetCapacity(gameRepo.Capacity)
+	mainRouter.HandleFunc("/healthz", healthHandler.Liveness).Methods(http.MethodGet)
+	mainRouter.HandleFunc("/readyz", healthHandler.Readiness).Methods(http.MethodGet)
+
 	// Setup API router with migration actions
+	rpcServer := jsonrpc.NewServer(hub, getGameAction, cardRegistry, gameArchive)
+	inviteHandler := httpHandler.NewInviteHandler(createInviteAction, listInvitesAction, revokeInviteAction, hub, os.Getenv("TM_PUBLIC_URL"))
+
 	apiRouter := httpHandler.SetupRouter(
 		createGameAction,
 		createDemoLobbyAction,
+		seedDemoGameAction,
 		getGameAction,
+		gameQueries,
 		getGameLogsAction,
+		exportGameLogAction,
+		getGameOverlayAction,
 		listGamesAction,
 		listCardsAction,
+		resolveCardsAction,
 		getPlayerAction,
+		listArchivedGamesAction,
+		getArchivedGameAction,
+		registerAccountAction,
+		deleteAccountDataAction,
 		cardRegistry,
+		cardGuides,
+		rpcServer,
+		tutorialScenarios,
+		startTutorialAction,
+		puzzles,
+		puzzleCompletions,
+		startPuzzleAction,
+		analyticsStore,
+		hub,
+		healthHandler,
+		previewActionAction,
+		inviteHandler,
+		adminFootprints,
+		adminCollusionFlags,
+		adminAuditGame,
+		adminGetStateAt,
+		adminInspectAwards,
 	)
 
 	// Mount API router
 	mainRouter.PathPrefix("/api/v1").Handler(apiRouter)
 
+	// Mount API docs (Swagger UI reads /api/v1/openapi.json)
+	mainRouter.HandleFunc("/api/docs", httpHandler.NewDocsHandler().SwaggerUI).Methods(http.MethodGet)
+
 	// Create WebSocket handler
 	wsHttpHandler := core.NewHandler(hub)
+	if header := os.Getenv("TM_CLIENT_IP_HEADER"); header != "" {
+		wsHttpHandler.TrustClientIPHeader(header)
+		log.Info("🌍 Client addresses read from proxy header", zap.String("header", header))
+	}
 
 	// Add WebSocket endpoint
 	mainRouter.HandleFunc("/ws", wsHttpHandler.ServeWS)
 
 	log.Info("🌐 HTTP routes configured")
+	log.Info("   📌 GET  /healthz - Liveness probe")
+	log.Info("   📌 GET  /readyz - Readiness probe (cards, repositories, hub)")
+	log.Info("   📌 GET  /api/v1/build-info - Git SHA and card data version")
+	log.Info("   📌 GET  /api/v1/capacity - Running games against the instance's cap")
 	log.Info("   📌 POST /api/v1/games - Create game")
 	log.Info("   📌 POST /api/v1/games/demo/lobby - Create demo lobby")
-	log.Info("   📌 GET  /api/v1/games - List games")
+	log.Info("   📌 GET  /api/v1/games - List games (?status=, ?speed=blitz)")
 	log.Info("   📌 GET  /api/v1/games/{gameId} - Get game")
+	log.Info("   📌 GET  /api/v1/games/{gameId}/summary - Get cached game read models")
 	log.Info("   📌 GET  /api/v1/games/{gameId}/logs - Get game logs")
+	log.Info("   📌 POST /api/v1/games/{gameId}/invites - Create a seat invite link (host; GET lists, DELETE /{token} revokes)")
 	log.Info("   📌 GET  /api/v1/cards - List cards")
+	log.Info("   📌 GET  /api/v1/cards/{cardId}/guide - Corporation guide for starting selection")
+	log.Info("   📌 GET  /api/v1/cards/{cardId}/behaviors/explain - Card behaviors as rules text")
 	log.Info("   📌 GET  /api/v1/games/{gameId}/players/{playerId} - Get player")
+	log.Info("   📌 GET  /api/v1/action-catalog - Action catalog")
+	log.Info("   📌 GET  /api/v1/openapi.json - OpenAPI document")
+	log.Info("   📌 GET  /api/v1/ws-schema - WebSocket message catalog")
+	log.Info("   📌 GET  /api/docs - Swagger UI")
+	log.Info("   📌 POST /api/v1/games/{gameId}/players/{playerId}/bot-token - Issue bot token")
+	log.Info("   📌 POST /api/v1/games/{gameId}/players/{playerId}/preview-action - Dry-run a card play or card action")
+	log.Info("   📌 POST /api/v1/rpc - Bot JSON-RPC (observeState, submitAction, streamEvents)")
+	log.Info("   📌 GET  /api/v1/tutorials - List tutorial scenarios")
+	log.Info("   📌 POST /api/v1/tutorials/{scenarioId}/start - Start tutorial")
+	log.Info("   📌 GET  /api/v1/puzzles - List puzzles")
+	log.Info("   📌 POST /api/v1/puzzles/{puzzleId}/start - Start puzzle")
+	log.Info("   📌 POST /api/v1/players/{accountId}/key - Register an account key (DELETE /api/v1/players/{accountId} with it deletes the account's data)")
+	if adminEnabled {
+		log.Info("   📌 GET  /api/v1/admin/games - List games with memory estimates")
+		log.Info("   📌 GET  /api/v1/admin/games/{gameId}/logs - Server log lines of one game (?level=warn)")
+		log.Info("   📌 GET  /api/v1/admin/collusion-flags - Collusion flags in public games")
+		log.Info("   📌 GET  /api/v1/admin/websocket - WebSocket payload sizes and send queues")
+		log.Info("   📌 GET  /debug/pprof/ - Go runtime profiles (loopback listener, TM_PPROF_ADDR)")
+	}
 	log.Info("   📌 WS   /ws - WebSocket endpoint")
 	log.Info("   ℹ️  Game creation available via both HTTP POST and WebSocket 'create-game'")
 
@@ -278,6 +708,15 @@ func main() {
 		}
 	}()
 
+	if profilingServer != nil {
+		go func() {
+			log.Info("🩺 Profiling server listening", zap.String("addr", profilingServer.Addr))
+			if err := profilingServer.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+				log.Error("Profiling server stopped", zap.Error(err))
+			}
+		}()
+	}
+
 	log.Info("✅ Server started successfully")
 	log.Info("🎮 Using migration architecture - all old code removed")
 
@@ -297,6 +736,12 @@ func main() {
 		log.Info("✅ HTTP server stopped")
 	}
 
+	if profilingServer != nil {
+		if err := profilingServer.Shutdown(shutdownCtx); err != nil {
+			log.Error("Failed to gracefully shutdown profiling server", zap.Error(err))
+		}
+	}
+
 	// Cancel WebSocket hub context
 	cancel()
 	log.Info("✅ WebSocket hub stopped")
@@ -0,0 +1,73 @@
+package account
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+
+	"terraforming-mars-backend/internal/archive"
+	"terraforming-mars-backend/internal/tutorial"
+)
+
+// DeleteAccountDataAction erases an account's personal data on request
+// Archived games keep their scores and log but name the account's seats AnonymousName, and its puzzle
+// completions are removed. Games still in the hot store are anonymized when they are archived.
+// The caller proves ownership with the account's key (see RegisterAccountAction); any other key is refused
+// with archive.ErrInvalidAccountKey. Each deletion is audited under a hash of the account ID. Card analytics
+// hold no personal data and are untouched
+type DeleteAccountDataAction struct {
+	archive     *archive.Archive
+	completions *tutorial.CompletionStore
+	logger      *zap.Logger
+}
+
+// NewDeleteAccountDataAction creates a new delete account data action
+func NewDeleteAccountDataAction(
+	gameArchive *archive.Archive,
+	completions *tutorial.CompletionStore,
+	logger *zap.Logger,
+) *DeleteAccountDataAction {
+	return &DeleteAccountDataAction{
+		archive:     gameArchive,
+		completions: completions,
+		logger:      logger,
+	}
+}
+
+// Execute anonymizes the account everywhere and returns the audit record
+func (a *DeleteAccountDataAction) Execute(ctx context.Context, accountID, accountKey string) (archive.Deletion, error) {
+	deletion := archive.Deletion{AccountHash: archive.HashAccount(accountID), DeletedAt: time.Now()}
+	log := a.logger.With(
+		zap.String("account_hash", deletion.AccountHash),
+		zap.String("action", "delete_account_data"),
+	)
+	log.Info("🗑️ Deleting account data")
+
+	if accountID == "" {
+		return archive.Deletion{}, fmt.Errorf("account ID is required")
+	}
+	if err := a.archive.VerifyAccountKey(accountID, accountKey); err != nil {
+		log.Warn("🚫 Account key did not match")
+		return archive.Deletion{}, err
+	}
+
+	games, err := a.archive.AnonymizeAccount(ctx, accountID)
+	if err != nil {
+		log.Error("Failed to anonymize archived games", zap.Int("games_anonymized", games), zap.Error(err))
+		return archive.Deletion{}, fmt.Errorf("failed to anonymize archived games: %w", err)
+	}
+	deletion.GamesAnonymized = games
+	deletion.PuzzleCompletionsRemoved = a.completions.Forget(accountID)
+
+	if err := a.archive.RecordDeletion(ctx, deletion); err != nil {
+		log.Error("Failed to record deletion", zap.Error(err))
+		return archive.Deletion{}, err
+	}
+
+	log.Info("✅ Account data deleted",
+		zap.Int("games_anonymized", deletion.GamesAnonymized),
+		zap.Int("puzzle_completions_removed", deletion.PuzzleCompletionsRemoved))
+	return deletion, nil
+}
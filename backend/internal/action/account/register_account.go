@@ -0,0 +1,49 @@
+package account
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"go.uber.org/zap"
+
+	"terraforming-mars-backend/internal/archive"
+)
+
+// RegisterAccountAction hands out the key that later proves ownership of an account
+// Account IDs are client-chosen, so the first caller to register one gets its only key;
+// the client keeps it and presents it to delete the account's data
+type RegisterAccountAction struct {
+	archive *archive.Archive
+	logger  *zap.Logger
+}
+
+// NewRegisterAccountAction creates a new register account action
+func NewRegisterAccountAction(gameArchive *archive.Archive, logger *zap.Logger) *RegisterAccountAction {
+	return &RegisterAccountAction{
+		archive: gameArchive,
+		logger:  logger,
+	}
+}
+
+// Execute mints the account's key; an account that already has one returns archive.ErrAccountRegistered
+func (a *RegisterAccountAction) Execute(ctx context.Context, accountID string) (string, error) {
+	log := a.logger.With(
+		zap.String("account_hash", archive.HashAccount(accountID)),
+		zap.String("action", "register_account"),
+	)
+	log.Info("🔑 Registering account key")
+
+	key, err := a.archive.RegisterAccount(ctx, accountID)
+	if errors.Is(err, archive.ErrAccountRegistered) {
+		log.Warn("Account already has a key")
+		return "", err
+	}
+	if err != nil {
+		log.Error("Failed to register account key", zap.Error(err))
+		return "", fmt.Errorf("failed to register account: %w", err)
+	}
+
+	log.Info("✅ Account key registered")
+	return key, nil
+}
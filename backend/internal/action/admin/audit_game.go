@@ -0,0 +1,62 @@
+package admin
+
+import (
+	"context"
+	"errors"
+
+	"go.uber.org/zap"
+	"terraforming-mars-backend/internal/audit"
+	"terraforming-mars-backend/internal/game"
+)
+
+// AuditGameAction checks a live game's conservation invariants: card counts, the ocean limit
+// and resources against the game log. Violations point at engine bugs and are logged as warnings.
+// Admin commands in development mode can break these invariants on purpose.
+type AuditGameAction struct {
+	gameRepo  game.GameRepository
+	stateRepo game.GameStateRepository
+	logger    *zap.Logger
+}
+
+// NewAuditGameAction creates a new audit game admin action
+func NewAuditGameAction(
+	gameRepo game.GameRepository,
+	stateRepo game.GameStateRepository,
+	logger *zap.Logger,
+) *AuditGameAction {
+	return &AuditGameAction{
+		gameRepo:  gameRepo,
+		stateRepo: stateRepo,
+		logger:    logger,
+	}
+}
+
+// Execute audits one game; unknown games return an error wrapping game.ErrGameNotFound
+func (a *AuditGameAction) Execute(ctx context.Context, gameID string) ([]audit.Violation, error) {
+	log := a.logger.With(
+		zap.String("game_id", gameID),
+		zap.String("action", "admin_audit_game"),
+	)
+
+	g, err := a.gameRepo.Get(ctx, gameID)
+	if err != nil {
+		log.Warn("Failed to get game", zap.Error(err))
+		return nil, err
+	}
+
+	// A game nothing has been logged for yet is audited on its state alone
+	diffs, err := a.stateRepo.GetDiff(ctx, gameID)
+	if err != nil && !errors.Is(err, game.ErrGameNotFound) {
+		log.Error("Failed to read game log", zap.Error(err))
+		return nil, err
+	}
+
+	violations := audit.CheckGame(g, diffs)
+	for _, violation := range violations {
+		log.Warn("🧮 Game audit violation",
+			zap.String("check", string(violation.Check)),
+			zap.String("detail", violation.Detail))
+	}
+	log.Info("🧮 Game audited", zap.Int("violations", len(violations)))
+	return violations, nil
+}
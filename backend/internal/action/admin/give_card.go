@@ -8,13 +8,16 @@ import (
 	"terraforming-mars-backend/internal/action"
 	"terraforming-mars-backend/internal/cards"
 	"terraforming-mars-backend/internal/game"
+	gamecards "terraforming-mars-backend/internal/game/cards"
 )
 
 // GiveCardAction handles the admin action to give a card to a player
-// NOTE: Card validation is skipped (admin action with trusted input)
+// NOTE: Requirements and costs are skipped (admin action with trusted input), but the card must be
+// a project card from one of the game's packs that no player holds, so scoring and audits still add up.
 type GiveCardAction struct {
 	gameRepo     game.GameRepository
 	cardRegistry cards.CardRegistry
+	stateRepo    game.GameStateRepository
 	logger       *zap.Logger
 }
 
@@ -22,17 +25,20 @@ type GiveCardAction struct {
 func NewGiveCardAction(
 	gameRepo game.GameRepository,
 	cardRegistry cards.CardRegistry,
+	stateRepo game.GameStateRepository,
 	logger *zap.Logger,
 ) *GiveCardAction {
 	return &GiveCardAction{
 		gameRepo:     gameRepo,
 		cardRegistry: cardRegistry,
+		stateRepo:    stateRepo,
 		logger:       logger,
 	}
 }
 
 // Execute performs the give card admin action
-func (a *GiveCardAction) Execute(ctx context.Context, gameID string, playerID string, cardID string) error {
+// allowAnyPack lets the card come from a pack the game was not created with
+func (a *GiveCardAction) Execute(ctx context.Context, gameID string, playerID string, cardID string, allowAnyPack bool) error {
 	log := a.logger.With(
 		zap.String("game_id", gameID),
 		zap.String("player_id", playerID),
@@ -41,19 +47,56 @@ func (a *GiveCardAction) Execute(ctx context.Context, gameID string, playerID st
 	)
 	log.Info("🎴 Admin: Giving card to player")
 
-	game, err := a.gameRepo.Get(ctx, gameID)
+	g, err := a.gameRepo.Get(ctx, gameID)
 	if err != nil {
 		log.Error("Failed to get game", zap.Error(err))
 		return fmt.Errorf("game not found: %s", gameID)
 	}
 
-	player, err := game.GetPlayer(playerID)
+	player, err := g.GetPlayer(playerID)
 	if err != nil {
 		log.Error("Player not found in game", zap.Error(err))
 		return fmt.Errorf("player not found: %s", playerID)
 	}
 
-	action.AddCardsToPlayerHand([]string{cardID}, player, game, a.cardRegistry, log)
+	card, err := a.cardRegistry.GetByID(cardID)
+	if err != nil {
+		log.Warn("Card not found in registry", zap.Error(err))
+		return fmt.Errorf("card not found: %s", cardID)
+	}
+
+	if card.Type == gamecards.CardTypeCorporation || card.Type == gamecards.CardTypePrelude {
+		log.Warn("Card is not a project card", zap.String("card_type", string(card.Type)))
+		return fmt.Errorf("card %s is a %s card, not a project card", cardID, card.Type)
+	}
+
+	if !allowAnyPack && !g.Settings().PackEnabled(card.Pack) {
+		log.Warn("Card pack not enabled", zap.String("pack", card.Pack))
+		return fmt.Errorf("card %s is from the %s pack, which this game was not created with", cardID, card.Pack)
+	}
+
+	for _, holder := range g.GetAllPlayers() {
+		if holder.Hand().HasCard(cardID) || holder.PlayedCards().Contains(cardID) {
+			log.Warn("Card already held", zap.String("holder_id", holder.ID()))
+			return fmt.Errorf("card %s is already held by %s", cardID, holder.Name())
+		}
+	}
+
+	// The card leaves the deck so it is never in two places at once
+	if g.Deck() != nil {
+		if _, err := g.Deck().Take(ctx, cardID); err != nil {
+			log.Error("Failed to take card from deck", zap.Error(err))
+			return fmt.Errorf("failed to take card from deck: %w", err)
+		}
+	}
+
+	action.AddCardsToPlayerHand([]string{cardID}, player, g, a.cardRegistry, log)
+
+	description := fmt.Sprintf("Admin gave %s", card.Name)
+	if _, err := a.stateRepo.WriteFull(ctx, gameID, g, "Admin", game.SourceTypeAdmin, playerID, description, nil, nil, nil); err != nil {
+		log.Error("Failed to log admin give card", zap.Error(err))
+		return fmt.Errorf("failed to log admin give card: %w", err)
+	}
 
 	log.Info("✅ Admin give card completed")
 	return nil
@@ -0,0 +1,86 @@
+package admin
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+	"terraforming-mars-backend/internal/cards"
+	"terraforming-mars-backend/internal/game"
+	gamecards "terraforming-mars-backend/internal/game/cards"
+	"terraforming-mars-backend/internal/game/shared"
+)
+
+// AwardsInspection is every award's standings in a live game
+type AwardsInspection struct {
+	FreezeAtFunding bool // Awards funded from now on keep the standings they are funded at
+	Awards          []AwardInspection
+}
+
+// AwardInspection is one award's standings now and, when they were frozen, at funding
+type AwardInspection struct {
+	Type               shared.AwardType
+	FundedBy           string               // Empty when the award is not funded
+	StandingsAtFunding []game.AwardStanding // Nil unless frozen at funding; these are what the award scores
+	CurrentStandings   []game.AwardStanding
+}
+
+// InspectAwardsAction reports award standings for debugging scoring
+type InspectAwardsAction struct {
+	gameRepo     game.GameRepository
+	cardRegistry cards.CardRegistry
+	logger       *zap.Logger
+}
+
+// NewInspectAwardsAction creates a new inspect awards admin action
+func NewInspectAwardsAction(
+	gameRepo game.GameRepository,
+	cardRegistry cards.CardRegistry,
+	logger *zap.Logger,
+) *InspectAwardsAction {
+	return &InspectAwardsAction{
+		gameRepo:     gameRepo,
+		cardRegistry: cardRegistry,
+		logger:       logger,
+	}
+}
+
+// Execute inspects one game's awards; unknown games return an error wrapping game.ErrGameNotFound
+func (a *InspectAwardsAction) Execute(ctx context.Context, gameID string) (*AwardsInspection, error) {
+	log := a.logger.With(
+		zap.String("game_id", gameID),
+		zap.String("action", "admin_inspect_awards"),
+	)
+
+	g, err := a.gameRepo.Get(ctx, gameID)
+	if err != nil {
+		log.Warn("Failed to get game", zap.Error(err))
+		return nil, err
+	}
+
+	funded := make(map[shared.AwardType]game.FundedAward)
+	for _, award := range g.Awards().FundedAwards() {
+		funded[award.Type] = award
+	}
+
+	// Counts are read once per player and ranked for every award
+	players := g.GetAllPlayers()
+	counts := make([]gamecards.AwardCounts, len(players))
+	for i, p := range players {
+		counts[i] = gamecards.CollectAwardCounts(p, g.Board(), a.cardRegistry)
+	}
+
+	inspection := &AwardsInspection{
+		FreezeAtFunding: g.Awards().FreezesAtFunding(),
+		Awards:          make([]AwardInspection, 0, len(game.AllAwards)),
+	}
+	for _, info := range game.AllAwards {
+		award := funded[info.Type]
+		inspection.Awards = append(inspection.Awards, AwardInspection{
+			Type:               info.Type,
+			FundedBy:           award.FundedByPlayer,
+			StandingsAtFunding: award.StandingsAtFunding,
+			CurrentStandings:   gamecards.RankAward(info.Type, counts),
+		})
+	}
+	return inspection, nil
+}
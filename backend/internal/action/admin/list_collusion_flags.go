@@ -0,0 +1,350 @@
+package admin
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+	"terraforming-mars-backend/internal/game"
+)
+
+// CollusionFlagKind names the heuristic that raised a flag
+type CollusionFlagKind string
+
+const (
+	// CollusionFlagSameAddress marks players in one game connecting from the same IP address
+	CollusionFlagSameAddress CollusionFlagKind = "same-ip"
+	// CollusionFlagTargetedAttacks marks a player who aims every attack at the same opponent
+	CollusionFlagTargetedAttacks CollusionFlagKind = "targeted-attacks"
+)
+
+// DefaultMinTargetedAttacks is how many attacks a player makes before aiming them all at one opponent is flagged
+const DefaultMinTargetedAttacks = 3
+
+// CollusionHeuristics selects which checks run on public games
+type CollusionHeuristics struct {
+	SameAddress     bool
+	TargetedAttacks bool
+	MinAttacks      int // Attacks before targeting counts as a pattern
+}
+
+// DefaultCollusionHeuristics enables every check
+func DefaultCollusionHeuristics() CollusionHeuristics {
+	return CollusionHeuristics{SameAddress: true, TargetedAttacks: true, MinAttacks: DefaultMinTargetedAttacks}
+}
+
+// ParseCollusionHeuristics reads a comma-separated list of flag kinds, e.g. "same-ip,targeted-attacks"
+// "none" disables every check; an empty string keeps the defaults
+func ParseCollusionHeuristics(raw string, minAttacks int) (CollusionHeuristics, error) {
+	heuristics := DefaultCollusionHeuristics()
+	if minAttacks > 0 {
+		heuristics.MinAttacks = minAttacks
+	}
+	raw = strings.TrimSpace(raw)
+	if raw == "" {
+		return heuristics, nil
+	}
+
+	heuristics.SameAddress = false
+	heuristics.TargetedAttacks = false
+	if raw == "none" {
+		return heuristics, nil
+	}
+	for _, name := range strings.Split(raw, ",") {
+		switch CollusionFlagKind(strings.TrimSpace(name)) {
+		case CollusionFlagSameAddress:
+			heuristics.SameAddress = true
+		case CollusionFlagTargetedAttacks:
+			heuristics.TargetedAttacks = true
+		default:
+			return CollusionHeuristics{}, fmt.Errorf("unknown collusion heuristic %q", name)
+		}
+	}
+	return heuristics, nil
+}
+
+// CollusionFlag is one suspicious pattern in a game, for an operator to review
+// Flags are never acted on automatically
+type CollusionFlag struct {
+	GameID    string
+	Kind      CollusionFlagKind
+	PlayerIDs []string
+	Detail    string
+}
+
+// PlayerAddresses remembers which IP addresses each seat connected from, per game
+// Addresses stay in memory only and are dropped once their game leaves the server
+type PlayerAddresses struct {
+	mu        sync.RWMutex
+	addresses map[string]map[string][]string // game ID -> player ID -> addresses, first seen first
+}
+
+// NewPlayerAddresses creates an empty address record
+func NewPlayerAddresses() *PlayerAddresses {
+	return &PlayerAddresses{addresses: make(map[string]map[string][]string)}
+}
+
+// Record notes that a seat connected from an address
+func (p *PlayerAddresses) Record(gameID, playerID, address string) {
+	if gameID == "" || playerID == "" || address == "" {
+		return
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	players := p.addresses[gameID]
+	if players == nil {
+		players = make(map[string][]string)
+		p.addresses[gameID] = players
+	}
+	if !slices.Contains(players[playerID], address) {
+		players[playerID] = append(players[playerID], address)
+	}
+}
+
+// sharedAddresses groups a game's seats by address, keeping only addresses used by more than one seat
+func (p *PlayerAddresses) sharedAddresses(gameID string) [][]string {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	byAddress := make(map[string][]string)
+	for playerID, addresses := range p.addresses[gameID] {
+		for _, address := range addresses {
+			byAddress[address] = append(byAddress[address], playerID)
+		}
+	}
+
+	groups := make([][]string, 0)
+	for _, playerIDs := range byAddress {
+		if len(playerIDs) > 1 {
+			sort.Strings(playerIDs)
+			groups = append(groups, playerIDs)
+		}
+	}
+	sort.Slice(groups, func(i, j int) bool {
+		return strings.Join(groups[i], ",") < strings.Join(groups[j], ",")
+	})
+	return slices.CompactFunc(groups, slices.Equal)
+}
+
+// retain drops every game not in the set
+func (p *PlayerAddresses) retain(gameIDs map[string]bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	for gameID := range p.addresses {
+		if !gameIDs[gameID] {
+			delete(p.addresses, gameID)
+		}
+	}
+}
+
+// ListCollusionFlagsAction runs the collusion heuristics over every public game
+// A game is public unless it is pass-and-play, a demo or tutorial, or in development mode;
+// those seat several players on one device on purpose. Flags are logged once, when first raised.
+type ListCollusionFlagsAction struct {
+	gameRepo   game.GameRepository
+	stateRepo  game.GameStateRepository
+	addresses  *PlayerAddresses
+	heuristics CollusionHeuristics
+	logger     *zap.Logger
+
+	mu     sync.Mutex
+	logged map[string]map[string]bool // game ID -> flags already logged
+}
+
+// NewListCollusionFlagsAction creates a new list collusion flags admin action
+func NewListCollusionFlagsAction(
+	gameRepo game.GameRepository,
+	stateRepo game.GameStateRepository,
+	addresses *PlayerAddresses,
+	heuristics CollusionHeuristics,
+	logger *zap.Logger,
+) *ListCollusionFlagsAction {
+	if heuristics.MinAttacks <= 0 {
+		heuristics.MinAttacks = DefaultMinTargetedAttacks
+	}
+	return &ListCollusionFlagsAction{
+		gameRepo:   gameRepo,
+		stateRepo:  stateRepo,
+		addresses:  addresses,
+		heuristics: heuristics,
+		logger:     logger,
+		logged:     make(map[string]map[string]bool),
+	}
+}
+
+// Heuristics returns the checks this action runs
+func (a *ListCollusionFlagsAction) Heuristics() CollusionHeuristics {
+	return a.heuristics
+}
+
+// Execute returns every flag raised in the games on the server, grouped by game
+func (a *ListCollusionFlagsAction) Execute(ctx context.Context) ([]CollusionFlag, error) {
+	log := a.logger.With(zap.String("action", "admin_list_collusion_flags"))
+
+	games, err := a.gameRepo.List(ctx, nil)
+	if err != nil {
+		log.Error("Failed to list games", zap.Error(err))
+		return nil, err
+	}
+
+	present := make(map[string]bool, len(games))
+	flags := make([]CollusionFlag, 0)
+	for _, g := range games {
+		present[g.ID()] = true
+		if !g.Settings().IsPublic() {
+			continue
+		}
+		if a.heuristics.SameAddress && a.addresses != nil {
+			flags = append(flags, a.sameAddressFlags(g)...)
+		}
+		if a.heuristics.TargetedAttacks && len(g.GetAllPlayers()) > 2 {
+			flags = append(flags, a.targetedAttackFlags(ctx, g)...)
+		}
+	}
+	if a.addresses != nil {
+		a.addresses.retain(present)
+	}
+
+	sort.SliceStable(flags, func(i, j int) bool { return flags[i].GameID < flags[j].GameID })
+	a.logNew(flags, present, log)
+	return flags, nil
+}
+
+// Monitor re-runs the heuristics on each tick so flags reach the server log without anyone polling the listing
+func (a *ListCollusionFlagsAction) Monitor(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if _, err := a.Execute(ctx); err != nil && ctx.Err() == nil {
+				a.logger.Warn("Collusion check failed", zap.Error(err))
+			}
+		}
+	}
+}
+
+func (a *ListCollusionFlagsAction) sameAddressFlags(g *game.Game) []CollusionFlag {
+	flags := make([]CollusionFlag, 0)
+	for _, playerIDs := range a.addresses.sharedAddresses(g.ID()) {
+		flags = append(flags, CollusionFlag{
+			GameID:    g.ID(),
+			Kind:      CollusionFlagSameAddress,
+			PlayerIDs: playerIDs,
+			Detail:    fmt.Sprintf("%d players connected from the same IP address", len(playerIDs)),
+		})
+	}
+	return flags
+}
+
+// targetedAttackFlags reads attacks from the game log: another player losing resources or production
+// in the log entry of a card someone played or used
+func (a *ListCollusionFlagsAction) targetedAttackFlags(ctx context.Context, g *game.Game) []CollusionFlag {
+	if a.stateRepo == nil {
+		return nil
+	}
+	diffs, err := a.stateRepo.GetDiff(ctx, g.ID())
+	if err != nil {
+		return nil
+	}
+
+	attacks := make(map[string]map[string]int) // attacker -> target -> attacks
+	for _, diff := range diffs {
+		if diff.SourceType != game.SourceTypeCardPlay && diff.SourceType != game.SourceTypeCardAction {
+			continue
+		}
+		if diff.PlayerID == "" || diff.Changes == nil {
+			continue
+		}
+		for targetID, changes := range diff.Changes.PlayerChanges {
+			if targetID == diff.PlayerID || !lostResources(changes) {
+				continue
+			}
+			if attacks[diff.PlayerID] == nil {
+				attacks[diff.PlayerID] = make(map[string]int)
+			}
+			attacks[diff.PlayerID][targetID]++
+		}
+	}
+
+	flags := make([]CollusionFlag, 0)
+	for _, attackerID := range sortedKeys(attacks) {
+		targets := attacks[attackerID]
+		if len(targets) != 1 {
+			continue
+		}
+		for targetID, count := range targets {
+			if count < a.heuristics.MinAttacks {
+				continue
+			}
+			flags = append(flags, CollusionFlag{
+				GameID:    g.ID(),
+				Kind:      CollusionFlagTargetedAttacks,
+				PlayerIDs: []string{attackerID, targetID},
+				Detail:    fmt.Sprintf("all %d attacks aimed at the same opponent", count),
+			})
+		}
+	}
+	return flags
+}
+
+// lostResources reports whether a player's resources or production went down
+func lostResources(changes *game.PlayerChanges) bool {
+	if changes == nil {
+		return false
+	}
+	for _, value := range []*game.DiffValueInt{
+		changes.Credits, changes.Steel, changes.Titanium, changes.Plants, changes.Energy, changes.Heat,
+		changes.CreditsProduction, changes.SteelProduction, changes.TitaniumProduction,
+		changes.PlantsProduction, changes.EnergyProduction, changes.HeatProduction,
+	} {
+		if value != nil && value.New < value.Old {
+			return true
+		}
+	}
+	return false
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func (a *ListCollusionFlagsAction) logNew(flags []CollusionFlag, present map[string]bool, log *zap.Logger) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	for gameID := range a.logged {
+		if !present[gameID] {
+			delete(a.logged, gameID)
+		}
+	}
+	for _, flag := range flags {
+		key := string(flag.Kind) + "|" + strings.Join(flag.PlayerIDs, ",")
+		if a.logged[flag.GameID] == nil {
+			a.logged[flag.GameID] = make(map[string]bool)
+		}
+		if a.logged[flag.GameID][key] {
+			continue
+		}
+		a.logged[flag.GameID][key] = true
+		log.Warn("🚩 Possible collusion flagged",
+			zap.String("game_id", flag.GameID),
+			zap.String("kind", string(flag.Kind)),
+			zap.Strings("player_ids", flag.PlayerIDs),
+			zap.String("detail", flag.Detail))
+	}
+}
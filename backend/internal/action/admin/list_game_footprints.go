@@ -0,0 +1,151 @@
+package admin
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+	"terraforming-mars-backend/internal/game"
+)
+
+// DefaultGameMemoryThreshold is the estimated size above which a single game raises an alert
+const DefaultGameMemoryThreshold int64 = 8 * 1024 * 1024
+
+// Estimated bytes held per state diff in a game's history
+const bytesPerStateDiff = 2048
+
+// GameFootprint is one game's memory estimate in the admin listing
+type GameFootprint struct {
+	GameID         string
+	Status         game.GameStatus
+	Footprint      game.Footprint
+	StateDiffs     int   // Entries in the game's state history
+	EstimatedBytes int64 // Game footprint plus state history
+	OverThreshold  bool
+}
+
+// ListGameFootprintsAction lists every game with its estimated memory footprint
+// Games over the threshold are logged as alerts once when they cross it, not on every listing
+type ListGameFootprintsAction struct {
+	gameRepo  game.GameRepository
+	stateRepo game.GameStateRepository
+	threshold int64
+	logger    *zap.Logger
+
+	mu      sync.Mutex
+	alerted map[string]bool
+}
+
+// NewListGameFootprintsAction creates a new list game footprints admin action
+// threshold <= 0 uses DefaultGameMemoryThreshold
+func NewListGameFootprintsAction(
+	gameRepo game.GameRepository,
+	stateRepo game.GameStateRepository,
+	threshold int64,
+	logger *zap.Logger,
+) *ListGameFootprintsAction {
+	if threshold <= 0 {
+		threshold = DefaultGameMemoryThreshold
+	}
+	return &ListGameFootprintsAction{
+		gameRepo:  gameRepo,
+		stateRepo: stateRepo,
+		threshold: threshold,
+		logger:    logger,
+		alerted:   make(map[string]bool),
+	}
+}
+
+// Threshold returns the per-game alert threshold in bytes
+func (a *ListGameFootprintsAction) Threshold() int64 {
+	return a.threshold
+}
+
+// Execute estimates every game's footprint, largest first, and alerts on games over the threshold
+func (a *ListGameFootprintsAction) Execute(ctx context.Context) ([]GameFootprint, error) {
+	log := a.logger.With(zap.String("action", "admin_list_game_footprints"))
+
+	games, err := a.gameRepo.List(ctx, nil)
+	if err != nil {
+		log.Error("Failed to list games", zap.Error(err))
+		return nil, err
+	}
+
+	result := make([]GameFootprint, 0, len(games))
+	for _, g := range games {
+		fp := g.Footprint()
+		entry := GameFootprint{
+			GameID:    g.ID(),
+			Status:    g.Status(),
+			Footprint: fp,
+		}
+		if a.stateRepo != nil {
+			if diffs, err := a.stateRepo.GetDiff(ctx, g.ID()); err == nil {
+				entry.StateDiffs = len(diffs)
+			}
+		}
+		entry.EstimatedBytes = fp.EstimatedBytes + int64(entry.StateDiffs)*bytesPerStateDiff
+		entry.OverThreshold = entry.EstimatedBytes > a.threshold
+		result = append(result, entry)
+	}
+
+	a.alert(result, log)
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].EstimatedBytes > result[j].EstimatedBytes
+	})
+	return result, nil
+}
+
+// Monitor re-checks every game on each tick so oversized games alert without anyone polling the listing
+func (a *ListGameFootprintsAction) Monitor(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if _, err := a.Execute(ctx); err != nil && ctx.Err() == nil {
+				a.logger.Warn("Game memory check failed", zap.Error(err))
+			}
+		}
+	}
+}
+
+func (a *ListGameFootprintsAction) alert(footprints []GameFootprint, log *zap.Logger) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	seen := make(map[string]bool, len(footprints))
+	for _, entry := range footprints {
+		seen[entry.GameID] = true
+		switch {
+		case entry.OverThreshold && !a.alerted[entry.GameID]:
+			a.alerted[entry.GameID] = true
+			log.Warn("🚨 Game exceeds memory threshold",
+				zap.String("game_id", entry.GameID),
+				zap.Int64("estimated_bytes", entry.EstimatedBytes),
+				zap.Int64("threshold_bytes", a.threshold),
+				zap.Int("players", entry.Footprint.Players),
+				zap.Int("effects", entry.Footprint.Effects),
+				zap.Int("actions", entry.Footprint.Actions),
+				zap.Int("state_diffs", entry.StateDiffs))
+		case !entry.OverThreshold && a.alerted[entry.GameID]:
+			delete(a.alerted, entry.GameID)
+			log.Info("✅ Game back under memory threshold",
+				zap.String("game_id", entry.GameID),
+				zap.Int64("estimated_bytes", entry.EstimatedBytes))
+		}
+	}
+
+	// Forget deleted games so a recreated ID alerts again
+	for gameID := range a.alerted {
+		if !seen[gameID] {
+			delete(a.alerted, gameID)
+		}
+	}
+}
@@ -0,0 +1,49 @@
+package admin
+
+import (
+	"context"
+	"fmt"
+
+	"go.uber.org/zap"
+	"terraforming-mars-backend/internal/game"
+)
+
+// SetAwardFreezeAction handles the admin action to freeze award standings at funding
+// Official rules score awards at game end; with the toggle on, awards funded from then on are scored
+// on the standings they were funded at, so those standings can be inspected and compared.
+type SetAwardFreezeAction struct {
+	gameRepo game.GameRepository
+	logger   *zap.Logger
+}
+
+// NewSetAwardFreezeAction creates a new set award freeze admin action
+func NewSetAwardFreezeAction(
+	gameRepo game.GameRepository,
+	logger *zap.Logger,
+) *SetAwardFreezeAction {
+	return &SetAwardFreezeAction{
+		gameRepo: gameRepo,
+		logger:   logger,
+	}
+}
+
+// Execute performs the set award freeze admin action; awards already funded keep how they are scored
+func (a *SetAwardFreezeAction) Execute(ctx context.Context, gameID string, enabled bool) error {
+	log := a.logger.With(
+		zap.String("game_id", gameID),
+		zap.String("action", "admin_set_award_freeze"),
+		zap.Bool("enabled", enabled),
+	)
+	log.Info("🧊 Admin: Setting award standings freeze")
+
+	g, err := a.gameRepo.Get(ctx, gameID)
+	if err != nil {
+		log.Error("Failed to get game", zap.Error(err))
+		return fmt.Errorf("game not found: %s", gameID)
+	}
+
+	g.Awards().SetFreezeAtFunding(enabled)
+
+	log.Info("✅ Admin set award standings freeze completed")
+	return nil
+}
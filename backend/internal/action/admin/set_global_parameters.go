@@ -6,15 +6,37 @@ import (
 
 	"go.uber.org/zap"
 	"terraforming-mars-backend/internal/game"
+	"terraforming-mars-backend/internal/game/parameters"
 )
 
-// SetGlobalParametersRequest contains the parameters to set
+// SetGlobalParametersRequest contains the parameters to set; zero leaves a parameter unchanged
 type SetGlobalParametersRequest struct {
 	Temperature int
 	Oxygen      int
 	Oceans      int
 }
 
+// Validate checks each parameter being set against its track in the parameters package
+func (r SetGlobalParametersRequest) Validate() error {
+	checks := []struct {
+		value      int
+		definition parameters.Definition
+	}{
+		{r.Temperature, parameters.Temperature},
+		{r.Oxygen, parameters.Oxygen},
+		{r.Oceans, parameters.Oceans},
+	}
+	for _, check := range checks {
+		if check.value == 0 {
+			continue
+		}
+		if err := check.definition.Validate(check.value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // SetGlobalParametersAction handles the admin action to set global parameters
 type SetGlobalParametersAction struct {
 	gameRepo game.GameRepository
@@ -33,6 +55,7 @@ func NewSetGlobalParametersAction(
 }
 
 // Execute performs the set global parameters admin action
+// Values off their parameter's track (out of bounds or between steps) are rejected before anything changes
 func (a *SetGlobalParametersAction) Execute(ctx context.Context, gameID string, params SetGlobalParametersRequest) error {
 	log := a.logger.With(
 		zap.String("game_id", gameID),
@@ -49,6 +72,11 @@ func (a *SetGlobalParametersAction) Execute(ctx context.Context, gameID string,
 		return fmt.Errorf("game not found: %s", gameID)
 	}
 
+	if err := params.Validate(); err != nil {
+		log.Warn("Rejected global parameters", zap.Error(err))
+		return err
+	}
+
 	if params.Temperature != 0 {
 		err := game.GlobalParameters().SetTemperature(ctx, params.Temperature)
 		if err != nil {
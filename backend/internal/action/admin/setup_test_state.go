@@ -0,0 +1,269 @@
+package admin
+
+import (
+	"context"
+	"fmt"
+
+	"go.uber.org/zap"
+	baseaction "terraforming-mars-backend/internal/action"
+	"terraforming-mars-backend/internal/cards"
+	"terraforming-mars-backend/internal/game"
+	"terraforming-mars-backend/internal/game/board"
+	gamecards "terraforming-mars-backend/internal/game/cards"
+	"terraforming-mars-backend/internal/game/player"
+	"terraforming-mars-backend/internal/game/shared"
+)
+
+// TestStateFixture describes a game state to reproduce in one step
+// Unset fields leave that part of the game as it is.
+type TestStateFixture struct {
+	Generation          int
+	Phase               game.GamePhase
+	CurrentTurnPlayerID string
+	GlobalParameters    *SetGlobalParametersRequest
+	Tiles               []FixtureTile
+	Players             []FixturePlayer
+}
+
+// FixtureTile is a tile to place on the board, without placement bonuses or global parameter changes
+type FixtureTile struct {
+	Position shared.HexPosition
+	TileType string // city, greenery or ocean
+	OwnerID  string // Empty for a neutral tile
+}
+
+// FixturePlayer is the state to give one player
+type FixturePlayer struct {
+	PlayerID        string
+	CorporationID   string
+	Resources       *shared.Resources
+	Production      *shared.Production
+	TerraformRating *int
+	Hand            []string // Replaces the hand when set
+	PlayedCards     []string // Added to the played cards without paying or applying immediate effects
+}
+
+var fixtureTileTypes = map[string]shared.ResourceType{
+	"city":     shared.ResourceCityTile,
+	"greenery": shared.ResourceGreeneryTile,
+	"ocean":    shared.ResourceOceanTile,
+}
+
+// SetupTestStateAction handles the admin action to apply a whole test fixture at once
+// The fixture is applied in a single transaction, so a bad entry leaves the game untouched.
+type SetupTestStateAction struct {
+	gameRepo            game.GameRepository
+	cardRegistry        cards.CardRegistry
+	setPhase            *SetPhaseAction
+	setCurrentTurn      *SetCurrentTurnAction
+	setResources        *SetResourcesAction
+	setProduction       *SetProductionAction
+	setGlobalParameters *SetGlobalParametersAction
+	setCorporation      *SetCorporationAction
+	setTR               *SetTRAction
+	logger              *zap.Logger
+}
+
+// NewSetupTestStateAction creates a new setup test state admin action
+func NewSetupTestStateAction(
+	gameRepo game.GameRepository,
+	cardRegistry cards.CardRegistry,
+	logger *zap.Logger,
+) *SetupTestStateAction {
+	return &SetupTestStateAction{
+		gameRepo:            gameRepo,
+		cardRegistry:        cardRegistry,
+		setPhase:            NewSetPhaseAction(gameRepo, logger),
+		setCurrentTurn:      NewSetCurrentTurnAction(gameRepo, logger),
+		setResources:        NewSetResourcesAction(gameRepo, logger),
+		setProduction:       NewSetProductionAction(gameRepo, logger),
+		setGlobalParameters: NewSetGlobalParametersAction(gameRepo, logger),
+		setCorporation:      NewSetCorporationAction(gameRepo, cardRegistry, logger),
+		setTR:               NewSetTRAction(gameRepo, logger),
+		logger:              logger,
+	}
+}
+
+// Execute performs the setup test state admin action
+// Tiles go down before any cards are played, so fixture cards do not trigger on fixture tiles.
+// Resources are set after corporations, so a corporation's starting credits do not overwrite them.
+func (a *SetupTestStateAction) Execute(ctx context.Context, gameID string, fixture TestStateFixture) error {
+	log := a.logger.With(
+		zap.String("game_id", gameID),
+		zap.String("action", "admin_setup_test_state"),
+		zap.Int("players", len(fixture.Players)),
+		zap.Int("tiles", len(fixture.Tiles)),
+	)
+	log.Info("🧪 Admin: Setting up test state")
+
+	g, err := a.gameRepo.Get(ctx, gameID)
+	if err != nil {
+		log.Error("Failed to get game", zap.Error(err))
+		return fmt.Errorf("game not found: %s", gameID)
+	}
+
+	if err := a.validate(g, fixture); err != nil {
+		log.Warn("Invalid test fixture", zap.Error(err))
+		return err
+	}
+
+	err = baseaction.RunInTransaction(ctx, g, log, func() error {
+		if fixture.Generation > 0 {
+			if err := g.SetGeneration(ctx, fixture.Generation); err != nil {
+				return fmt.Errorf("failed to set generation: %w", err)
+			}
+		}
+		if fixture.Phase != "" {
+			if err := a.setPhase.Execute(ctx, gameID, fixture.Phase); err != nil {
+				return err
+			}
+		}
+		if fixture.GlobalParameters != nil {
+			if err := a.setGlobalParameters.Execute(ctx, gameID, *fixture.GlobalParameters); err != nil {
+				return err
+			}
+		}
+		for _, tile := range fixture.Tiles {
+			occupant := board.TileOccupant{Type: fixtureTileTypes[tile.TileType], Tags: []string{}}
+			if err := g.Board().UpdateTileOccupancy(ctx, tile.Position, occupant, tile.OwnerID); err != nil {
+				return fmt.Errorf("failed to place %s at %s: %w", tile.TileType, tile.Position.String(), err)
+			}
+		}
+		for _, fp := range fixture.Players {
+			if err := a.applyPlayer(ctx, g, fp, log); err != nil {
+				return err
+			}
+		}
+		if fixture.CurrentTurnPlayerID != "" {
+			if err := a.setCurrentTurn.Execute(ctx, gameID, fixture.CurrentTurnPlayerID); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to set up test state: %w", err)
+	}
+
+	log.Info("✅ Admin setup test state completed")
+	return nil
+}
+
+// validate rejects fixtures that name unknown players, cards, tile types or spaces before anything changes
+func (a *SetupTestStateAction) validate(g *game.Game, fixture TestStateFixture) error {
+	if fixture.CurrentTurnPlayerID != "" {
+		if _, err := g.GetPlayer(fixture.CurrentTurnPlayerID); err != nil {
+			return fmt.Errorf("player not found: %s", fixture.CurrentTurnPlayerID)
+		}
+	}
+
+	occupied := make(map[shared.HexPosition]bool)
+	for _, tile := range fixture.Tiles {
+		if _, ok := fixtureTileTypes[tile.TileType]; !ok {
+			return fmt.Errorf("invalid tile type: %s (valid types: city, greenery, ocean)", tile.TileType)
+		}
+		if tile.OwnerID != "" {
+			if _, err := g.GetPlayer(tile.OwnerID); err != nil {
+				return fmt.Errorf("player not found: %s", tile.OwnerID)
+			}
+		}
+		existing, err := g.Board().GetTile(tile.Position)
+		if err != nil {
+			return fmt.Errorf("tile not found at coordinates %s", tile.Position.String())
+		}
+		if existing.OccupiedBy != nil || occupied[tile.Position] {
+			return fmt.Errorf("tile at %s is already occupied", tile.Position.String())
+		}
+		occupied[tile.Position] = true
+	}
+
+	for _, fp := range fixture.Players {
+		if _, err := g.GetPlayer(fp.PlayerID); err != nil {
+			return fmt.Errorf("player not found: %s", fp.PlayerID)
+		}
+		cardIDs := append(append([]string{}, fp.Hand...), fp.PlayedCards...)
+		for _, cardID := range cardIDs {
+			if _, err := a.cardRegistry.GetByID(cardID); err != nil {
+				return fmt.Errorf("card not found: %s", cardID)
+			}
+		}
+	}
+	return nil
+}
+
+func (a *SetupTestStateAction) applyPlayer(ctx context.Context, g *game.Game, fp FixturePlayer, log *zap.Logger) error {
+	p, err := g.GetPlayer(fp.PlayerID)
+	if err != nil {
+		return fmt.Errorf("player not found: %s", fp.PlayerID)
+	}
+
+	if fp.CorporationID != "" {
+		if err := a.setCorporation.Execute(ctx, g.ID(), fp.PlayerID, fp.CorporationID); err != nil {
+			return err
+		}
+	}
+
+	for _, cardID := range fp.PlayedCards {
+		if p.PlayedCards().Contains(cardID) {
+			continue
+		}
+		card, err := a.cardRegistry.GetByID(cardID)
+		if err != nil {
+			return fmt.Errorf("card not found: %s", cardID)
+		}
+		if err := a.placePlayedCard(ctx, g, p, card, log); err != nil {
+			return err
+		}
+	}
+
+	if fp.Hand != nil {
+		for _, cardID := range fp.Hand {
+			if err := takeFromDeck(ctx, g, cardID); err != nil {
+				return err
+			}
+		}
+		p.Hand().SetCards(nil)
+		baseaction.AddCardsToPlayerHand(fp.Hand, p, g, a.cardRegistry, log)
+	}
+
+	if fp.Production != nil {
+		if err := a.setProduction.Execute(ctx, g.ID(), fp.PlayerID, *fp.Production); err != nil {
+			return err
+		}
+	}
+	if fp.Resources != nil {
+		if err := a.setResources.Execute(ctx, g.ID(), fp.PlayerID, *fp.Resources); err != nil {
+			return err
+		}
+	}
+	if fp.TerraformRating != nil {
+		if err := a.setTR.Execute(ctx, g.ID(), fp.PlayerID, *fp.TerraformRating); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// placePlayedCard puts a card among the player's played cards with its lasting behaviors registered,
+// the way playing it would. Immediate outputs are not applied; the fixture sets resources and production itself.
+func (a *SetupTestStateAction) placePlayedCard(ctx context.Context, g *game.Game, p *player.Player, card *gamecards.Card, log *zap.Logger) error {
+	if err := takeFromDeck(ctx, g, card.ID); err != nil {
+		return err
+	}
+	baseaction.AddToPlayedCards(p, card, log)
+	for behaviorIndex, behavior := range card.Behaviors {
+		baseaction.RegisterLastingBehavior(ctx, g, p, card, behaviorIndex, behavior, log, a.cardRegistry)
+	}
+	return nil
+}
+
+// takeFromDeck removes a fixture card from the draw and discard piles so it cannot be dealt again
+func takeFromDeck(ctx context.Context, g *game.Game, cardID string) error {
+	if g.Deck() == nil {
+		return nil
+	}
+	if _, err := g.Deck().Take(ctx, cardID); err != nil {
+		return fmt.Errorf("failed to take %s from the deck: %w", cardID, err)
+	}
+	return nil
+}
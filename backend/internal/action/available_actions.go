@@ -0,0 +1,72 @@
+package action
+
+import (
+	"terraforming-mars-backend/internal/cards"
+	"terraforming-mars-backend/internal/game"
+	"terraforming-mars-backend/internal/game/parameters"
+	"terraforming-mars-backend/internal/game/player"
+	"terraforming-mars-backend/internal/game/shared"
+)
+
+// legalActionProjects are the standard projects and conversions that count as a legal action
+// Selling patents is left out: it is possible whenever the hand is not empty and never moves the game on by itself
+var legalActionProjects = []shared.StandardProject{
+	shared.StandardProjectPowerPlant,
+	shared.StandardProjectAsteroid,
+	shared.StandardProjectAquifer,
+	shared.StandardProjectGreenery,
+	shared.StandardProjectCity,
+	shared.StandardProjectConvertPlantsToGreenery,
+	shared.StandardProjectConvertHeatToTemperature,
+}
+
+// HasLegalAction reports whether the player could take any action other than passing right now:
+// play a card from hand, use a card action, afford a standard project or conversion, or claim a
+// milestone or fund an award. It uses the same state calculations the client sees
+func HasLegalAction(g *game.Game, p *player.Player, cardRegistry cards.CardRegistry) bool {
+	for _, cardID := range p.Hand().Cards() {
+		card, err := cardRegistry.GetByID(cardID)
+		if err != nil {
+			continue
+		}
+		if CalculatePlayerCardState(card, p, g, cardRegistry).Available() {
+			return true
+		}
+	}
+
+	for _, act := range p.Actions().List() {
+		if CalculatePlayerCardActionState(act.CardID, act.Behavior, act.TimesUsedThisGeneration, act.TimesUsedThisGame, p, g).Available() {
+			return true
+		}
+	}
+
+	for _, projectType := range legalActionProjects {
+		switch projectType {
+		case shared.StandardProjectConvertPlantsToGreenery:
+			if g.CountAvailableHexesForTile("greenery", p.ID(), nil) == 0 {
+				continue
+			}
+		case shared.StandardProjectConvertHeatToTemperature:
+			if parameters.Temperature.IsMaxed(g.GlobalParameters().Temperature()) {
+				continue
+			}
+		}
+		if CalculatePlayerStandardProjectState(projectType, p, g, cardRegistry).Available() {
+			return true
+		}
+	}
+
+	for _, info := range game.AllMilestones {
+		if CalculateMilestoneState(info.Type, p, g, cardRegistry).Available() {
+			return true
+		}
+	}
+
+	for _, info := range game.AllAwards {
+		if CalculateAwardState(info.Type, p, g).Available() {
+			return true
+		}
+	}
+
+	return false
+}
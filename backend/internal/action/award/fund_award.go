@@ -9,6 +9,7 @@ import (
 	baseaction "terraforming-mars-backend/internal/action"
 	"terraforming-mars-backend/internal/cards"
 	"terraforming-mars-backend/internal/game"
+	gamecards "terraforming-mars-backend/internal/game/cards"
 	"terraforming-mars-backend/internal/game/shared"
 )
 
@@ -90,6 +91,16 @@ func (a *FundAwardAction) Execute(ctx context.Context, gameID string, playerID s
 		return fmt.Errorf("failed to fund award: %w", err)
 	}
 
+	// Games with award standings frozen at funding (an admin debug toggle) score this award as it stands now
+	if awards.FreezesAtFunding() {
+		standings := gamecards.ScoreAward(at, g.GetAllPlayers(), g.Board(), a.CardRegistry())
+		if err := awards.FreezeStandings(at, standings); err != nil {
+			log.Error("Failed to freeze award standings", zap.Error(err))
+			return fmt.Errorf("failed to freeze award standings: %w", err)
+		}
+		log.Info("🧊 Froze award standings at funding", zap.Int("players", len(standings)))
+	}
+
 	a.ConsumePlayerAction(g, log)
 
 	a.WriteStateLog(ctx, g, awardType, game.SourceTypeAward, playerID, fmt.Sprintf("Funded %s award", awardType))
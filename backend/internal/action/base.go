@@ -142,6 +142,11 @@ func (b *BaseAction) ConsumePlayerAction(g *game.Game, log *zap.Logger) bool {
 	return consumed
 }
 
+// GameRunner runs fn in a game's queue, behind the player actions already queued for it, and waits
+// for it to finish; the websocket hub's RunInGameQueue is one. Monitors expire deadlines through it,
+// so a timeout never interleaves with a player's action on the same game
+type GameRunner func(ctx context.Context, gameID string, fn func(ctx context.Context)) error
+
 // AutoAdvanceTurnIfNeeded advances the turn to the next non-passed player
 // if the current player has 0 actions remaining and no pending tile selection.
 // This is called after consuming an action or after completing a tile placement.
@@ -189,7 +194,7 @@ func AutoAdvanceTurnIfNeeded(g *game.Game, playerID string, log *zap.Logger) {
 	if nonPassedCount == 1 {
 		currentPlayer, err := g.GetPlayer(playerID)
 		if err == nil && !currentPlayer.HasPassed() {
-			if err := g.SetCurrentTurn(context.Background(), playerID, -1); err != nil {
+			if err := g.SetCurrentTurn(g.ActionContext(), playerID, -1); err != nil {
 				log.Error("Failed to grant unlimited actions to last player", zap.Error(err))
 			} else {
 				log.Info("🏃 Last non-passed player granted unlimited actions",
@@ -214,7 +219,7 @@ func AutoAdvanceTurnIfNeeded(g *game.Game, playerID string, log *zap.Logger) {
 				log.Info("🏃 Last non-passed player granted unlimited actions",
 					zap.String("player_id", nextID))
 			}
-			if err := g.SetCurrentTurn(context.Background(), nextID, nextActions); err != nil {
+			if err := g.SetCurrentTurn(g.ActionContext(), nextID, nextActions); err != nil {
 				log.Error("Failed to auto-advance turn", zap.Error(err))
 			} else {
 				log.Info("🔄 Auto-advanced turn to next player",
@@ -0,0 +1,57 @@
+package card
+
+import (
+	"context"
+	"fmt"
+
+	"go.uber.org/zap"
+
+	"terraforming-mars-backend/internal/game"
+)
+
+// CancelPlayCardAction releases the card reserved by prepare-play-card
+// Nothing was paid or applied at prepare time, so releasing leaves the game exactly as before
+type CancelPlayCardAction struct {
+	gameRepo game.GameRepository
+	logger   *zap.Logger
+}
+
+// NewCancelPlayCardAction creates a new cancel play card action
+func NewCancelPlayCardAction(
+	gameRepo game.GameRepository,
+	logger *zap.Logger,
+) *CancelPlayCardAction {
+	return &CancelPlayCardAction{
+		gameRepo: gameRepo,
+		logger:   logger,
+	}
+}
+
+// Execute releases the player's reservation and returns the released card ID
+func (a *CancelPlayCardAction) Execute(ctx context.Context, gameID string, playerID string) (string, error) {
+	log := a.logger.With(
+		zap.String("game_id", gameID),
+		zap.String("player_id", playerID),
+		zap.String("action", "cancel_play_card"),
+	)
+	log.Info("🃏 Cancelling prepared card")
+
+	g, err := a.gameRepo.Get(ctx, gameID)
+	if err != nil {
+		log.Error("Failed to get game", zap.Error(err))
+		return "", fmt.Errorf("game not found: %s", gameID)
+	}
+
+	pending := g.GetPendingCardPlay(playerID)
+	if pending == nil {
+		log.Warn("No prepared card to cancel")
+		return "", fmt.Errorf("no prepared card to cancel")
+	}
+
+	if err := g.SetPendingCardPlay(ctx, playerID, nil); err != nil {
+		return "", err
+	}
+
+	log.Info("✅ Card reservation released", zap.String("card_id", pending.CardID))
+	return pending.CardID, nil
+}
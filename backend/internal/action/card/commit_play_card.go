@@ -0,0 +1,96 @@
+package card
+
+import (
+	"context"
+	"fmt"
+
+	"go.uber.org/zap"
+
+	"terraforming-mars-backend/internal/game"
+)
+
+// CommitPlayCardAction plays the card reserved by prepare-play-card
+// The play itself goes through PlayCardAction, so every validation runs again at commit time
+type CommitPlayCardAction struct {
+	gameRepo       game.GameRepository
+	playCardAction *PlayCardAction
+	logger         *zap.Logger
+}
+
+// NewCommitPlayCardAction creates a new commit play card action
+func NewCommitPlayCardAction(
+	gameRepo game.GameRepository,
+	playCardAction *PlayCardAction,
+	logger *zap.Logger,
+) *CommitPlayCardAction {
+	return &CommitPlayCardAction{
+		gameRepo:       gameRepo,
+		playCardAction: playCardAction,
+		logger:         logger,
+	}
+}
+
+// Execute commits the prepared card with the chosen payment and options
+// If the play fails the card stays reserved, so the player can retry or cancel
+func (a *CommitPlayCardAction) Execute(
+	ctx context.Context,
+	gameID string,
+	playerID string,
+	payment PaymentRequest,
+	choiceIndex *int,
+	cardStorageTarget *string,
+	targetPlayerID *string,
+) (string, error) {
+	return a.ExecuteWithOptions(ctx, gameID, playerID, payment, choiceIndex, cardStorageTarget, targetPlayerID, PlayOptions{})
+}
+
+// ExecuteWithOptions commits the prepared card like Execute, with the player's optional choices
+func (a *CommitPlayCardAction) ExecuteWithOptions(
+	ctx context.Context,
+	gameID string,
+	playerID string,
+	payment PaymentRequest,
+	choiceIndex *int,
+	cardStorageTarget *string,
+	targetPlayerID *string,
+	opts PlayOptions,
+) (string, error) {
+	log := a.logger.With(
+		zap.String("game_id", gameID),
+		zap.String("player_id", playerID),
+		zap.String("action", "commit_play_card"),
+	)
+	log.Info("🃏 Committing prepared card")
+
+	g, err := a.gameRepo.Get(ctx, gameID)
+	if err != nil {
+		log.Error("Failed to get game", zap.Error(err))
+		return "", fmt.Errorf("game not found: %s", gameID)
+	}
+
+	// 1. The player must have a reservation
+	pending := g.GetPendingCardPlay(playerID)
+	if pending == nil {
+		log.Warn("No prepared card to commit")
+		return "", fmt.Errorf("no prepared card to commit")
+	}
+	log = log.With(zap.String("card_id", pending.CardID))
+
+	if pending.RequiresChoice && choiceIndex == nil {
+		log.Warn("Commit is missing a required choice")
+		return "", fmt.Errorf("card %s requires a choiceIndex", pending.CardID)
+	}
+
+	// 2. BUSINESS LOGIC: Release the reservation and play the card; a rejected play leaves the card in hand
+	// and the reservation released, so the player prepares it again or plays something else
+	if err := g.SetPendingCardPlay(ctx, playerID, nil); err != nil {
+		return "", err
+	}
+	if err := a.playCardAction.ExecuteWithOptions(ctx, gameID, playerID, pending.CardID, payment, choiceIndex, cardStorageTarget, targetPlayerID, opts); err != nil {
+		log.Warn("Prepared card play rejected, reservation released", zap.Error(err))
+		return "", err
+	}
+
+	log.Info("✅ Prepared card committed")
+	return pending.CardID, nil
+}
@@ -3,14 +3,13 @@ package card
 import (
 	"context"
 	"fmt"
-	"time"
+	"strings"
 
 	baseaction "terraforming-mars-backend/internal/action"
 
 	"go.uber.org/zap"
 
 	"terraforming-mars-backend/internal/cards"
-	"terraforming-mars-backend/internal/events"
 	"terraforming-mars-backend/internal/game"
 	gamecards "terraforming-mars-backend/internal/game/cards"
 	"terraforming-mars-backend/internal/game/player"
@@ -44,6 +43,12 @@ type PaymentRequest struct {
 	Substitutes map[shared.ResourceType]int `json:"substitutes"`
 }
 
+// PlayOptions holds the optional choices a player makes when playing a card or using a card action
+type PlayOptions struct {
+	AttackAmount   *int     // How many resources "up to" removals take from the target (nil takes the full amount, 0 spares the target)
+	DiscardCardIDs []string // Cards from hand paying card-discard inputs
+}
+
 // Execute performs the play card action
 func (a *PlayCardAction) Execute(
 	ctx context.Context,
@@ -54,6 +59,21 @@ func (a *PlayCardAction) Execute(
 	choiceIndex *int,
 	cardStorageTarget *string,
 	targetPlayerID *string,
+) error {
+	return a.ExecuteWithOptions(ctx, gameID, playerID, cardID, payment, choiceIndex, cardStorageTarget, targetPlayerID, PlayOptions{})
+}
+
+// ExecuteWithOptions plays the card like Execute, with the player's optional choices
+func (a *PlayCardAction) ExecuteWithOptions(
+	ctx context.Context,
+	gameID string,
+	playerID string,
+	cardID string,
+	payment PaymentRequest,
+	choiceIndex *int,
+	cardStorageTarget *string,
+	targetPlayerID *string,
+	opts PlayOptions,
 ) error {
 	log := a.InitLogger(gameID, playerID).With(
 		zap.String("card_id", cardID),
@@ -68,6 +88,12 @@ func (a *PlayCardAction) Execute(
 	if targetPlayerID != nil {
 		log = log.With(zap.String("target_player_id", *targetPlayerID))
 	}
+	if opts.AttackAmount != nil {
+		log = log.With(zap.Int("attack_amount", *opts.AttackAmount))
+	}
+	if len(opts.DiscardCardIDs) > 0 {
+		log = log.With(zap.Strings("discard_card_ids", opts.DiscardCardIDs))
+	}
 	log.Info("🃏 Player attempting to play card")
 
 	g, err := baseaction.ValidateActiveGame(ctx, a.GameRepository(), gameID, log)
@@ -92,6 +118,11 @@ func (a *PlayCardAction) Execute(
 		return err
 	}
 
+	if pending := g.GetPendingCardPlay(playerID); pending != nil {
+		log.Warn("Card play already prepared", zap.String("reserved_card_id", pending.CardID))
+		return fmt.Errorf("card %s is prepared; commit or cancel it first", pending.CardID)
+	}
+
 	if !player.Hand().HasCard(cardID) {
 		log.Error("Card not in player's hand")
 		return fmt.Errorf("card %s not in hand", cardID)
@@ -103,11 +134,15 @@ func (a *PlayCardAction) Execute(
 		return fmt.Errorf("card not found: %w", err)
 	}
 
+	if err := baseaction.ValidateCardExpansion(g, card, log); err != nil {
+		return err
+	}
+
 	log.Debug("Card data retrieved",
 		zap.String("card_name", card.Name),
 		zap.Int("base_cost", card.Cost))
 
-	if err := validateCardRequirements(card, g, player); err != nil {
+	if err := validateCardRequirements(card, g, player, a.CardRegistry()); err != nil {
 		log.Error("Card requirements not met", zap.Error(err))
 		return fmt.Errorf("cannot play card: %w", err)
 	}
@@ -162,57 +197,59 @@ func (a *PlayCardAction) Execute(
 		return err
 	}
 
-	if !player.Hand().RemoveCard(cardID) {
-		log.Error("Failed to remove card from hand - card not found")
-		return fmt.Errorf("failed to remove card from hand: card not found")
-	}
-
-	log.Info("✅ Card removed from hand")
-
-	cardTags := make([]string, len(card.Tags))
-	for i, tag := range card.Tags {
-		cardTags[i] = string(tag)
-	}
+	// From here on the card moves, payment is taken and behaviors apply;
+	// a failure at any step rolls all of it back so the player keeps their card and resources
+	var calculatedOutputs []game.CalculatedOutput
+	var notes []string
+	if err := baseaction.RunInTransaction(ctx, g, log, func() error {
+		if !player.Hand().RemoveCard(cardID) {
+			log.Error("Failed to remove card from hand - card not found")
+			return fmt.Errorf("failed to remove card from hand: card not found")
+		}
 
-	player.PlayedCards().AddCard(cardID, card.Name, string(card.Type), cardTags)
+		log.Info("✅ Card removed from hand")
 
-	log.Info("✅ Card added to played cards")
+		baseaction.AddToPlayedCards(player, card, log)
 
-	if card.ResourceStorage != nil {
-		player.Resources().AddToStorage(cardID, card.ResourceStorage.Starting)
-		log.Info("📦 Initialized resource storage",
-			zap.String("card_id", cardID),
-			zap.String("resource_type", string(card.ResourceStorage.Type)),
-			zap.Int("starting_amount", card.ResourceStorage.Starting))
-	}
+		deductions := map[shared.ResourceType]int{
+			shared.ResourceCredit:   -adjustedPayment.Credits,
+			shared.ResourceSteel:    -adjustedPayment.Steel,
+			shared.ResourceTitanium: -adjustedPayment.Titanium,
+		}
 
-	deductions := map[shared.ResourceType]int{
-		shared.ResourceCredit:   -adjustedPayment.Credits,
-		shared.ResourceSteel:    -adjustedPayment.Steel,
-		shared.ResourceTitanium: -adjustedPayment.Titanium,
-	}
+		for resourceType, amount := range adjustedPayment.Substitutes {
+			deductions[resourceType] = -amount
+		}
 
-	for resourceType, amount := range adjustedPayment.Substitutes {
-		deductions[resourceType] = -amount
-	}
+		player.Resources().Add(deductions)
 
-	player.Resources().Add(deductions)
+		log.Info("✅ Payment deducted",
+			zap.Int("credits", adjustedPayment.Credits),
+			zap.Int("steel", adjustedPayment.Steel),
+			zap.Int("titanium", adjustedPayment.Titanium),
+			zap.Any("substitutes", adjustedPayment.Substitutes))
 
-	log.Info("✅ Payment deducted",
-		zap.Int("credits", adjustedPayment.Credits),
-		zap.Int("steel", adjustedPayment.Steel),
-		zap.Int("titanium", adjustedPayment.Titanium),
-		zap.Any("substitutes", adjustedPayment.Substitutes))
+		var err error
+		calculatedOutputs, notes, err = a.applyCardBehaviors(ctx, g, card, player, choiceIndex, cardStorageTarget, targetPlayerID, opts, log)
+		if err != nil {
+			log.Error("Failed to apply card behaviors", zap.Error(err))
+			return fmt.Errorf("failed to apply card behaviors: %w", err)
+		}
 
-	calculatedOutputs, err := a.applyCardBehaviors(ctx, g, card, player, choiceIndex, cardStorageTarget, targetPlayerID, log)
-	if err != nil {
-		log.Error("Failed to apply card behaviors", zap.Error(err))
-		return fmt.Errorf("failed to apply card behaviors: %w", err)
+		a.ConsumePlayerAction(g, log)
+		return nil
+	}); err != nil {
+		return err
 	}
 
-	a.ConsumePlayerAction(g, log)
-
 	description := fmt.Sprintf("Played %s for %d credits", card.Name, totalValue)
+	if requirement, ok := cards.TurmoilRequirement(card.ID); ok {
+		log.Warn("🗳️ Turmoil requirement not checked until Turmoil is implemented", zap.String("requirement", requirement))
+		notes = append([]string{fmt.Sprintf("its requirement (%s) is not checked until Turmoil is supported", requirement)}, notes...)
+	}
+	if len(notes) > 0 {
+		description += "; " + strings.Join(notes, "; ")
+	}
 	displayData := baseaction.BuildCardDisplayData(card, game.SourceTypeCardPlay)
 	a.WriteStateLogFull(ctx, g, card.Name, game.SourceTypeCardPlay, playerID, description, choiceIndex, calculatedOutputs, displayData)
 
@@ -235,7 +272,7 @@ func hasTag(card *gamecards.Card, tag shared.CardTag) bool {
 }
 
 // validateCardRequirements validates that the player and game state meet all card requirements
-func validateCardRequirements(card *gamecards.Card, g *game.Game, player *player.Player) error {
+func validateCardRequirements(card *gamecards.Card, g *game.Game, player *player.Player, cardRegistry cards.CardRegistry) error {
 	if card.Requirements == nil || len(card.Requirements.Items) == 0 {
 		return nil // No requirements to validate
 	}
@@ -283,14 +320,7 @@ func validateCardRequirements(card *gamecards.Card, g *game.Game, player *player
 				return fmt.Errorf("tag requirement missing tag specification")
 			}
 
-			// Count tags across all played cards (including corporation)
-			tagCount := 0
-			for _, playedCardID := range player.PlayedCards().Cards() {
-				// TODO: Get card from registry and check if it has the tag
-				// This requires injecting CardRegistry into this function
-				// For now, skip tag validation
-				_ = playedCardID
-			}
+			tagCount := gamecards.CountPlayerTagsByType(player, cardRegistry, *req.Tag)
 
 			if req.Min != nil && tagCount < *req.Min {
 				return fmt.Errorf("tag requirement not met: need %d %s tags, have %d", *req.Min, *req.Tag, tagCount)
@@ -350,7 +380,7 @@ func validateCardRequirements(card *gamecards.Card, g *game.Game, player *player
 }
 
 // applyCardBehaviors processes all card behaviors and applies immediate effects or registers actions/effects
-// Returns calculated outputs for logging purposes
+// Returns calculated outputs and game log notes (e.g. skipped attacks) for logging purposes
 func (a *PlayCardAction) applyCardBehaviors(
 	ctx context.Context,
 	g *game.Game,
@@ -359,11 +389,12 @@ func (a *PlayCardAction) applyCardBehaviors(
 	choiceIndex *int,
 	cardStorageTarget *string,
 	targetPlayerID *string,
+	opts PlayOptions,
 	log *zap.Logger,
-) ([]game.CalculatedOutput, error) {
+) ([]game.CalculatedOutput, []string, error) {
 	if len(card.Behaviors) == 0 {
 		log.Debug("No card behaviors to apply")
-		return nil, nil
+		return nil, nil, nil
 	}
 
 	log.Info("🎴 Processing card behaviors",
@@ -371,6 +402,7 @@ func (a *PlayCardAction) applyCardBehaviors(
 		zap.Int("behavior_count", len(card.Behaviors)))
 
 	var allCalculatedOutputs []game.CalculatedOutput
+	var notes []string
 
 	for behaviorIndex, behavior := range card.Behaviors {
 		log.Debug("Processing behavior",
@@ -380,7 +412,7 @@ func (a *PlayCardAction) applyCardBehaviors(
 		// Apply auto-trigger behaviors immediately
 		if gamecards.HasAutoTrigger(behavior) {
 			// Extract inputs and outputs, incorporating choice if present
-			_, outputs := behavior.ExtractInputsOutputs(choiceIndex)
+			inputs, outputs := behavior.ExtractInputsOutputs(choiceIndex)
 
 			log.Info("✨ Found auto-trigger behavior, applying outputs immediately",
 				zap.Int("output_count", len(outputs)))
@@ -395,76 +427,30 @@ func (a *PlayCardAction) applyCardBehaviors(
 			if targetPlayerID != nil {
 				applier = applier.WithTargetPlayerID(*targetPlayerID)
 			}
+			if opts.AttackAmount != nil {
+				applier = applier.WithAttackAmount(*opts.AttackAmount)
+			}
+			applier = applier.WithDiscardCardIDs(opts.DiscardCardIDs)
+
+			if err := applier.ApplyInputs(ctx, inputs); err != nil {
+				return nil, nil, fmt.Errorf("failed to apply auto behavior %d inputs: %w", behaviorIndex, err)
+			}
 
 			calculatedOutputs, err := applier.ApplyOutputsAndGetCalculated(ctx, outputs)
 			if err != nil {
-				return nil, fmt.Errorf("failed to apply auto behavior %d outputs: %w", behaviorIndex, err)
+				return nil, nil, fmt.Errorf("failed to apply auto behavior %d outputs: %w", behaviorIndex, err)
 			}
 
 			allCalculatedOutputs = append(allCalculatedOutputs, calculatedOutputs...)
-
-			// Also register as effect if it has persistent outputs (discount, payment-substitute)
-			// These need to show in the effects list for display and for modifier calculations
-			if gamecards.HasPersistentEffects(behavior) {
-				log.Info("🏷️ Registering auto-trigger behavior with persistent effects",
-					zap.String("card_name", card.Name))
-
-				effect := player.CardEffect{
-					CardID:        card.ID,
-					CardName:      card.Name,
-					BehaviorIndex: behaviorIndex,
-					Behavior:      behavior,
-				}
-				p.Effects().AddEffect(effect)
-
-				events.Publish(g.EventBus(), events.PlayerEffectsChangedEvent{
-					GameID:    g.ID(),
-					PlayerID:  p.ID(),
-					Timestamp: time.Now(),
-				})
-			}
+			notes = append(notes, applier.Notes()...)
+			baseaction.AddCardsToPlayerHand(applier.TakenCards(), p, g, a.CardRegistry(), log)
 		}
 
-		// Register manual-trigger behaviors as player actions
-		if gamecards.HasManualTrigger(behavior) {
-			log.Info("🎯 Found manual-trigger behavior, registering as player action")
-
-			p.Actions().AddAction(player.CardAction{
-				CardID:                  card.ID,
-				CardName:                card.Name,
-				BehaviorIndex:           behaviorIndex,
-				Behavior:                behavior,
-				TimesUsedThisTurn:       0,
-				TimesUsedThisGeneration: 0,
-			})
-		}
-
-		// Register conditional-trigger behaviors as passive effects
-		if gamecards.HasConditionalTrigger(behavior) {
-			log.Info("⚡ Found conditional-trigger behavior, registering as passive effect",
-				zap.Int("trigger_count", len(behavior.Triggers)))
-
-			effect := player.CardEffect{
-				CardID:        card.ID,
-				CardName:      card.Name,
-				BehaviorIndex: behaviorIndex,
-				Behavior:      behavior,
-			}
-			p.Effects().AddEffect(effect)
-
-			events.Publish(g.EventBus(), events.PlayerEffectsChangedEvent{
-				GameID:    g.ID(),
-				PlayerID:  p.ID(),
-				Timestamp: time.Now(),
-			})
-
-			// Subscribe passive effects to relevant events
-			baseaction.SubscribePassiveEffectToEvents(ctx, g, p, effect, log, a.CardRegistry())
-		}
+		baseaction.RegisterLastingBehavior(ctx, g, p, card, behaviorIndex, behavior, log, a.CardRegistry())
 	}
 
 	log.Info("✅ All card behaviors processed successfully")
-	return allCalculatedOutputs, nil
+	return allCalculatedOutputs, notes, nil
 }
 
 func adjustPaymentToEffectiveCost(
@@ -0,0 +1,217 @@
+package card
+
+import (
+	"context"
+	"fmt"
+
+	baseaction "terraforming-mars-backend/internal/action"
+
+	"go.uber.org/zap"
+
+	"terraforming-mars-backend/internal/cards"
+	"terraforming-mars-backend/internal/game"
+	gamecards "terraforming-mars-backend/internal/game/cards"
+	"terraforming-mars-backend/internal/game/player"
+	"terraforming-mars-backend/internal/game/shared"
+)
+
+// PreparePlayCardAction reserves a card in hand and reports everything needed to commit it
+// Nothing is paid or applied; the reservation is released by commit-play-card or cancel-play-card
+type PreparePlayCardAction struct {
+	baseaction.BaseAction
+}
+
+// CardPlayPreview is the cost breakdown, required choices and pending placements of a prepared card
+type CardPlayPreview struct {
+	CardID            string
+	CardName          string
+	BaseCost          int
+	Discount          int
+	EffectiveCost     int
+	AllowSteel        bool
+	AllowTitanium     bool
+	SteelRate         int
+	TitaniumRate      int
+	Substitutes       []shared.PaymentSubstitute
+	ChoiceCount       int      // Options to pick from with choiceIndex (0 when the card has no choice)
+	PendingPlacements []string // Tile types queued on commit, in order
+}
+
+// NewPreparePlayCardAction creates a new prepare play card action
+func NewPreparePlayCardAction(
+	gameRepo game.GameRepository,
+	cardRegistry cards.CardRegistry,
+	logger *zap.Logger,
+) *PreparePlayCardAction {
+	return &PreparePlayCardAction{
+		BaseAction: baseaction.NewBaseAction(gameRepo, cardRegistry),
+	}
+}
+
+// Execute validates the card can be played now and reserves it
+// Preparing again replaces any earlier reservation
+func (a *PreparePlayCardAction) Execute(
+	ctx context.Context,
+	gameID string,
+	playerID string,
+	cardID string,
+) (*CardPlayPreview, error) {
+	log := a.InitLogger(gameID, playerID).With(
+		zap.String("card_id", cardID),
+		zap.String("action", "prepare_play_card"),
+	)
+	log.Info("🃏 Player preparing to play card")
+
+	g, err := baseaction.ValidateActiveGame(ctx, a.GameRepository(), gameID, log)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := baseaction.ValidateGamePhase(g, game.GamePhaseAction, log); err != nil {
+		return nil, err
+	}
+
+	if err := baseaction.ValidateCurrentTurn(g, playerID, log); err != nil {
+		return nil, err
+	}
+
+	if err := baseaction.ValidateActionsRemaining(g, playerID, log); err != nil {
+		return nil, err
+	}
+
+	p, err := a.GetPlayerFromGame(g, playerID, log)
+	if err != nil {
+		return nil, err
+	}
+
+	if g.GetPendingTileSelection(playerID) != nil {
+		log.Warn("Tile placement still pending")
+		return nil, fmt.Errorf("finish the pending tile placement before playing another card")
+	}
+
+	if !p.Hand().HasCard(cardID) {
+		log.Error("Card not in player's hand")
+		return nil, fmt.Errorf("card %s not in hand", cardID)
+	}
+
+	card, err := a.CardRegistry().GetByID(cardID)
+	if err != nil {
+		log.Error("Card not found in registry", zap.Error(err))
+		return nil, fmt.Errorf("card not found: %w", err)
+	}
+
+	if err := baseaction.ValidateCardExpansion(g, card, log); err != nil {
+		return nil, err
+	}
+
+	// 1. BUSINESS LOGIC: Same requirement check as playing the card directly
+	if err := validateCardRequirements(card, g, p, a.CardRegistry()); err != nil {
+		log.Error("Card requirements not met", zap.Error(err))
+		return nil, fmt.Errorf("cannot play card: %w", err)
+	}
+
+	// 2. BUSINESS LOGIC: Cost breakdown
+	preview := a.buildPreview(card, p)
+
+	// 3. BUSINESS LOGIC: Every placement must have somewhere to go, so commit never leaves a dead queue
+	for _, output := range placementOutputs(card) {
+		tileType := placementTileType(output)
+		if g.CountAvailableHexesForTile(tileType, playerID, output.TileRestrictions) == 0 {
+			log.Warn("No valid hex for card placement", zap.String("tile_type", tileType))
+			return nil, fmt.Errorf("cannot play card: no valid hex to place %s", tileType)
+		}
+		for i := 0; i < output.Amount; i++ {
+			preview.PendingPlacements = append(preview.PendingPlacements, tileType)
+		}
+	}
+
+	// 4. Reserve the card
+	if err := g.SetPendingCardPlay(ctx, playerID, &player.PendingCardPlay{
+		CardID:            cardID,
+		EffectiveCost:     preview.EffectiveCost,
+		RequiresChoice:    preview.ChoiceCount > 0,
+		PendingPlacements: preview.PendingPlacements,
+	}); err != nil {
+		return nil, fmt.Errorf("failed to reserve card: %w", err)
+	}
+
+	log.Info("✅ Card play prepared",
+		zap.Int("effective_cost", preview.EffectiveCost),
+		zap.Int("choice_count", preview.ChoiceCount),
+		zap.Strings("pending_placements", preview.PendingPlacements))
+	return preview, nil
+}
+
+func (a *PreparePlayCardAction) buildPreview(card *gamecards.Card, p *player.Player) *CardPlayPreview {
+	calculator := gamecards.NewRequirementModifierCalculator(a.CardRegistry())
+	discount := calculator.CalculateCardDiscounts(p, card)
+	effectiveCost := card.Cost - discount
+	if effectiveCost < 0 {
+		effectiveCost = 0
+	}
+
+	substitutes := p.Resources().PaymentSubstitutes()
+	steelRate, titaniumRate := 2, 3
+	for _, sub := range substitutes {
+		switch sub.ResourceType {
+		case shared.ResourceSteel:
+			steelRate = sub.ConversionRate
+		case shared.ResourceTitanium:
+			titaniumRate = sub.ConversionRate
+		}
+	}
+
+	choiceCount := 0
+	for _, behavior := range card.Behaviors {
+		if gamecards.HasAutoTrigger(behavior) && len(behavior.Choices) > 0 && !behavior.TargetChooses() {
+			choiceCount = len(behavior.Choices)
+			break
+		}
+	}
+
+	return &CardPlayPreview{
+		CardID:        card.ID,
+		CardName:      card.Name,
+		BaseCost:      card.Cost,
+		Discount:      discount,
+		EffectiveCost: effectiveCost,
+		AllowSteel:    hasTag(card, shared.TagBuilding),
+		AllowTitanium: hasTag(card, shared.TagSpace),
+		SteelRate:     steelRate,
+		TitaniumRate:  titaniumRate,
+		Substitutes:   substitutes,
+		ChoiceCount:   choiceCount,
+	}
+}
+
+// placementOutputs returns the tile placement outputs applied when the card is played
+// Placements inside choices depend on the chosen option and are not listed
+func placementOutputs(card *gamecards.Card) []shared.ResourceCondition {
+	var outputs []shared.ResourceCondition
+	for _, behavior := range card.Behaviors {
+		if !gamecards.HasAutoTrigger(behavior) {
+			continue
+		}
+		for _, output := range behavior.Outputs {
+			if placementTileType(output) != "" {
+				outputs = append(outputs, output)
+			}
+		}
+	}
+	return outputs
+}
+
+// placementTileType maps a placement output to the tile type queued by the behavior applier
+func placementTileType(output shared.ResourceCondition) string {
+	switch output.ResourceType {
+	case shared.ResourceCityPlacement:
+		return "city"
+	case shared.ResourceGreeneryPlacement:
+		return "greenery"
+	case shared.ResourceOceanPlacement:
+		return "ocean"
+	case shared.ResourceSpecialPlacement:
+		return output.SpecialTileType
+	}
+	return ""
+}
@@ -0,0 +1,103 @@
+package card
+
+import (
+	"context"
+	"fmt"
+
+	baseaction "terraforming-mars-backend/internal/action"
+
+	"go.uber.org/zap"
+
+	"terraforming-mars-backend/internal/cards"
+	"terraforming-mars-backend/internal/game"
+)
+
+// PreviewKind selects which action a preview dry-runs
+type PreviewKind string
+
+const (
+	PreviewKindPlayCard   PreviewKind = "play-card"
+	PreviewKindCardAction PreviewKind = "card-action"
+)
+
+// PreviewRequest is a card play or card action to dry-run, with the same choices the real action takes
+type PreviewRequest struct {
+	Kind              PreviewKind
+	CardID            string
+	BehaviorIndex     int            // card-action only
+	Payment           PaymentRequest // play-card only
+	ChoiceIndex       *int
+	CardStorageTarget *string
+	TargetPlayerID    *string
+	Options           PlayOptions
+}
+
+// PreviewActionAction dry-runs a card play or card action for client previews
+// The real action runs with its normal validation and effect resolution against a clone of the game
+// held in a scratch repository, so events it publishes reach only the clone's listeners.
+// Log entries go to a scratch state repository, so the game log never sees them.
+// The caller must keep other actions on the same game from running while the clone is taken.
+type PreviewActionAction struct {
+	baseaction.BaseAction
+}
+
+// NewPreviewActionAction creates a new preview action
+func NewPreviewActionAction(
+	gameRepo game.GameRepository,
+	cardRegistry cards.CardRegistry,
+	logger *zap.Logger,
+) *PreviewActionAction {
+	return &PreviewActionAction{
+		BaseAction: baseaction.NewBaseAction(gameRepo, cardRegistry),
+	}
+}
+
+// Execute runs the requested action against a clone of the game and returns the state diffs it would have logged
+// The game itself is never changed, whether the action succeeds or fails
+func (a *PreviewActionAction) Execute(ctx context.Context, gameID string, playerID string, request PreviewRequest) ([]game.StateDiff, error) {
+	log := a.InitLogger(gameID, playerID).With(
+		zap.String("card_id", request.CardID),
+		zap.String("preview_kind", string(request.Kind)),
+		zap.String("action", "preview_action"),
+	)
+	log.Info("🔮 Previewing action")
+
+	g, err := baseaction.ValidateActiveGame(ctx, a.GameRepository(), gameID, log)
+	if err != nil {
+		return nil, err
+	}
+
+	clone := baseaction.CloneGame(ctx, g, a.CardRegistry(), log)
+	scratchGames := game.NewInMemoryGameRepository()
+	if err := scratchGames.Create(ctx, clone); err != nil {
+		return nil, fmt.Errorf("failed to hold preview game: %w", err)
+	}
+
+	// The scratch log starts from the current state, so the action's own entries hold only its changes
+	scratch := game.NewInMemoryGameStateRepository()
+	if _, err := scratch.WriteFull(ctx, gameID, clone, "Preview", game.SourceTypeInitial, playerID, "Preview baseline", nil, nil, nil); err != nil {
+		return nil, fmt.Errorf("failed to capture preview baseline: %w", err)
+	}
+
+	switch request.Kind {
+	case PreviewKindPlayCard:
+		playCard := NewPlayCardAction(scratchGames, a.CardRegistry(), scratch, log)
+		err = playCard.ExecuteWithOptions(ctx, gameID, playerID, request.CardID, request.Payment, request.ChoiceIndex, request.CardStorageTarget, request.TargetPlayerID, request.Options)
+	case PreviewKindCardAction:
+		useCardAction := NewUseCardActionAction(scratchGames, a.CardRegistry(), scratch, log)
+		err = useCardAction.ExecuteWithOptions(ctx, gameID, playerID, request.CardID, request.BehaviorIndex, request.ChoiceIndex, request.CardStorageTarget, request.TargetPlayerID, nil, request.Options)
+	default:
+		return nil, fmt.Errorf("unknown preview kind: %s", request.Kind)
+	}
+	if err != nil {
+		log.Info("🔮 Previewed action would fail", zap.Error(err))
+		return nil, err
+	}
+
+	diffs, err := scratch.GetDiff(ctx, gameID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read preview diffs: %w", err)
+	}
+	log.Info("🔮 Action previewed", zap.Int("diffs", len(diffs)-1))
+	return diffs[1:], nil
+}
@@ -3,6 +3,7 @@ package card
 import (
 	"context"
 	"fmt"
+	"strings"
 	baseaction "terraforming-mars-backend/internal/action"
 
 	"go.uber.org/zap"
@@ -11,7 +12,6 @@ import (
 	"terraforming-mars-backend/internal/game"
 	gamecards "terraforming-mars-backend/internal/game/cards"
 	"terraforming-mars-backend/internal/game/player"
-	"terraforming-mars-backend/internal/game/shared"
 )
 
 // UseCardActionAction handles the business logic for using a card's manual action
@@ -43,6 +43,22 @@ func (a *UseCardActionAction) Execute(
 	cardStorageTarget *string,
 	targetPlayerID *string,
 	stealSourceCardID *string,
+) error {
+	return a.ExecuteWithOptions(ctx, gameID, playerID, cardID, behaviorIndex, choiceIndex, cardStorageTarget, targetPlayerID, stealSourceCardID, PlayOptions{})
+}
+
+// ExecuteWithOptions uses the card action like Execute, with the player's optional choices
+func (a *UseCardActionAction) ExecuteWithOptions(
+	ctx context.Context,
+	gameID string,
+	playerID string,
+	cardID string,
+	behaviorIndex int,
+	choiceIndex *int,
+	cardStorageTarget *string,
+	targetPlayerID *string,
+	stealSourceCardID *string,
+	opts PlayOptions,
 ) error {
 	log := a.InitLogger(gameID, playerID).With(
 		zap.String("card_id", cardID),
@@ -90,10 +106,17 @@ func (a *UseCardActionAction) Execute(
 		return err
 	}
 
-	if a.hasManualTrigger(cardAction.Behavior) && cardAction.TimesUsedThisGeneration >= 1 {
-		log.Warn("Action already played this generation",
-			zap.Int("times_used", cardAction.TimesUsedThisGeneration))
-		return fmt.Errorf("action already played this generation")
+	if card, err := a.CardRegistry().GetByID(cardID); err == nil {
+		if err := baseaction.ValidateCardExpansion(g, card, log); err != nil {
+			return err
+		}
+	}
+
+	if cardAction.UsageLimitReached() {
+		log.Warn("Action usage limit reached",
+			zap.Int("times_used_this_generation", cardAction.TimesUsedThisGeneration),
+			zap.Int("times_used_this_game", cardAction.TimesUsedThisGame))
+		return fmt.Errorf("action already played this %s", cardAction.Behavior.EffectiveUsageLimit().Per)
 	}
 
 	log.Info("✅ Found card action",
@@ -113,6 +136,10 @@ func (a *UseCardActionAction) Execute(
 	if stealSourceCardID != nil {
 		applier = applier.WithStealSourceCardID(*stealSourceCardID)
 	}
+	if opts.AttackAmount != nil {
+		applier = applier.WithAttackAmount(*opts.AttackAmount)
+	}
+	applier = applier.WithDiscardCardIDs(opts.DiscardCardIDs)
 
 	inputs, outputs := cardAction.Behavior.ExtractInputsOutputs(choiceIndex)
 
@@ -123,15 +150,39 @@ func (a *UseCardActionAction) Execute(
 			zap.Int("output_count", len(outputs)))
 	}
 
-	if err := applier.ApplyInputs(ctx, inputs); err != nil {
-		log.Error("Failed to apply inputs", zap.Error(err))
-		return err
-	}
+	// Inputs are spent before outputs apply; roll both back if any output fails
+	var calculatedOutputs []game.CalculatedOutput
+	hasPending := false
+	err = baseaction.RunInTransaction(ctx, g, log, func() error {
+		if err := applier.ApplyInputs(ctx, inputs); err != nil {
+			log.Error("Failed to apply inputs", zap.Error(err))
+			return err
+		}
+
+		// Check for card draw outputs (card-peek/take/buy) - these create pending selection
+		pending, err := applier.ApplyCardDrawOutputs(ctx, outputs)
+		if err != nil {
+			log.Error("Failed to apply card draw outputs", zap.Error(err))
+			return err
+		}
+		if pending {
+			hasPending = true
+			return nil
+		}
 
-	// Check for card draw outputs (card-peek/take/buy) - these create pending selection
-	hasPending, err := applier.ApplyCardDrawOutputs(ctx, outputs)
+		calculatedOutputs, err = applier.ApplyOutputsAndGetCalculated(ctx, outputs)
+		if err != nil {
+			log.Error("Failed to apply outputs", zap.Error(err))
+			return err
+		}
+		baseaction.AddCardsToPlayerHand(applier.TakenCards(), p, g, a.CardRegistry(), log)
+
+		a.incrementUsageCounts(p, cardID, behaviorIndex, log)
+
+		a.ConsumePlayerAction(g, log)
+		return nil
+	})
 	if err != nil {
-		log.Error("Failed to apply card draw outputs", zap.Error(err))
 		return err
 	}
 	if hasPending {
@@ -141,17 +192,10 @@ func (a *UseCardActionAction) Execute(
 		return nil
 	}
 
-	calculatedOutputs, err := applier.ApplyOutputsAndGetCalculated(ctx, outputs)
-	if err != nil {
-		log.Error("Failed to apply outputs", zap.Error(err))
-		return err
-	}
-
-	a.incrementUsageCounts(p, cardID, behaviorIndex, log)
-
-	a.ConsumePlayerAction(g, log)
-
 	description := fmt.Sprintf("Used %s action", cardAction.CardName)
+	if notes := applier.Notes(); len(notes) > 0 {
+		description += "; " + strings.Join(notes, "; ")
+	}
 	var displayData *game.LogDisplayData
 	if cardFromRegistry, err := a.CardRegistry().GetByID(cardID); err == nil {
 		displayData = baseaction.BuildCardDisplayData(cardFromRegistry, game.SourceTypeCardAction)
@@ -190,29 +234,9 @@ func (a *UseCardActionAction) incrementUsageCounts(
 	behaviorIndex int,
 	log *zap.Logger,
 ) {
-	actions := p.Actions().List()
-
-	// Find and increment both turn and generation counts
-	for i := range actions {
-		if actions[i].CardID == cardID && actions[i].BehaviorIndex == behaviorIndex {
-			actions[i].TimesUsedThisTurn++
-			actions[i].TimesUsedThisGeneration++
-			log.Debug("📊 Incremented action usage counts",
-				zap.Int("times_used_this_turn", actions[i].TimesUsedThisTurn),
-				zap.Int("times_used_this_generation", actions[i].TimesUsedThisGeneration))
-			break
-		}
-	}
-
-	// Update player actions
-	p.Actions().SetActions(actions)
-}
-
-func (a *UseCardActionAction) hasManualTrigger(behavior shared.CardBehavior) bool {
-	for _, trigger := range behavior.Triggers {
-		if trigger.Type == shared.TriggerTypeManual {
-			return true
-		}
+	if p.Actions().RecordUse(cardID, behaviorIndex) {
+		log.Debug("📊 Incremented action usage counts",
+			zap.String("card_id", cardID),
+			zap.Int("behavior_index", behaviorIndex))
 	}
-	return false
 }
@@ -118,6 +118,7 @@ func (a *ConfirmCardDrawAction) Execute(ctx context.Context, gameID string, play
 	}
 
 	baseaction.AddCardsToPlayerHand(allSelectedCards, player, g, a.CardRegistry(), log)
+	g.RecordCardsBought(playerID, len(cardsToBuy))
 
 	log.Info("🃏 Added selected cards to hand",
 		zap.Int("cards_taken", len(cardsToTake)),
@@ -132,6 +133,10 @@ func (a *ConfirmCardDrawAction) Execute(ctx context.Context, gameID string, play
 	}
 
 	if len(unselectedCards) > 0 {
+		if err := baseaction.DiscardCards(ctx, g, unselectedCards); err != nil {
+			log.Error("Failed to discard unselected cards", zap.Error(err))
+			return err
+		}
 		log.Debug("🗑️ Discarded unselected cards",
 			zap.Int("count", len(unselectedCards)),
 			zap.Strings("card_ids", unselectedCards))
@@ -162,20 +167,11 @@ func (a *ConfirmCardDrawAction) completeSourceCardAction(
 	log *zap.Logger,
 ) {
 	// Increment usage counts for the source card action
-	actions := p.Actions().List()
-	for i := range actions {
-		if actions[i].CardID == selection.SourceCardID && actions[i].BehaviorIndex == selection.SourceBehaviorIndex {
-			actions[i].TimesUsedThisTurn++
-			actions[i].TimesUsedThisGeneration++
-			log.Debug("📊 Incremented action usage counts from card draw confirmation",
-				zap.String("card_id", selection.SourceCardID),
-				zap.Int("behavior_index", selection.SourceBehaviorIndex),
-				zap.Int("times_used_this_turn", actions[i].TimesUsedThisTurn),
-				zap.Int("times_used_this_generation", actions[i].TimesUsedThisGeneration))
-			break
-		}
+	if p.Actions().RecordUse(selection.SourceCardID, selection.SourceBehaviorIndex) {
+		log.Debug("📊 Incremented action usage counts from card draw confirmation",
+			zap.String("card_id", selection.SourceCardID),
+			zap.Int("behavior_index", selection.SourceBehaviorIndex))
 	}
-	p.Actions().SetActions(actions)
 
 	// Consume the player action
 	a.ConsumePlayerAction(g, log)
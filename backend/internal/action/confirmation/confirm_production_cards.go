@@ -3,9 +3,11 @@ package confirmation
 import (
 	"context"
 	"fmt"
+	"slices"
 	baseaction "terraforming-mars-backend/internal/action"
 
 	"go.uber.org/zap"
+	"terraforming-mars-backend/internal/analytics"
 	"terraforming-mars-backend/internal/cards"
 	"terraforming-mars-backend/internal/game"
 	"terraforming-mars-backend/internal/game/shared"
@@ -14,21 +16,35 @@ import (
 // ConfirmProductionCardsAction handles the business logic for confirming production card selection
 type ConfirmProductionCardsAction struct {
 	baseaction.BaseAction
+	stats *analytics.Store
 }
 
 // NewConfirmProductionCardsAction creates a new confirm production cards action
 func NewConfirmProductionCardsAction(
 	gameRepo game.GameRepository,
 	cardRegistry cards.CardRegistry,
+	stats *analytics.Store,
 	logger *zap.Logger,
 ) *ConfirmProductionCardsAction {
 	return &ConfirmProductionCardsAction{
 		BaseAction: baseaction.NewBaseAction(gameRepo, cardRegistry),
+		stats:      stats,
 	}
 }
 
 // Execute performs the confirm production cards action
+// Games that opted into analytics record which offered cards were bought
 func (a *ConfirmProductionCardsAction) Execute(ctx context.Context, gameID string, playerID string, selectedCardIDs []string) error {
+	return a.confirm(ctx, gameID, playerID, selectedCardIDs, true)
+}
+
+// SkipPurchase confirms an empty purchase on a player's behalf
+// It is not a choice the player made, so it is left out of analytics
+func (a *ConfirmProductionCardsAction) SkipPurchase(ctx context.Context, gameID string, playerID string) error {
+	return a.confirm(ctx, gameID, playerID, nil, false)
+}
+
+func (a *ConfirmProductionCardsAction) confirm(ctx context.Context, gameID string, playerID string, selectedCardIDs []string, recordStats bool) error {
 	log := a.InitLogger(gameID, playerID).With(
 		zap.String("action", "confirm_production_cards"),
 		zap.Strings("selected_card_ids", selectedCardIDs),
@@ -101,11 +117,24 @@ func (a *ConfirmProductionCardsAction) Execute(ctx context.Context, gameID strin
 		zap.Int("count", len(selectedCardIDs)))
 
 	baseaction.AddCardsToPlayerHand(selectedCardIDs, player, g, a.CardRegistry(), log)
+	g.RecordCardsBought(playerID, len(selectedCardIDs))
 
 	log.Info("✅ Cards added to hand",
 		zap.Strings("card_ids_added", selectedCardIDs),
 		zap.Int("card_count", len(selectedCardIDs)))
 
+	// Research cards left unbought go to the discard pile
+	unboughtCards := make([]string, 0, len(productionPhase.AvailableCards))
+	for _, cardID := range productionPhase.AvailableCards {
+		if !slices.Contains(selectedCardIDs, cardID) {
+			unboughtCards = append(unboughtCards, cardID)
+		}
+	}
+	if err := baseaction.DiscardCards(ctx, g, unboughtCards); err != nil {
+		log.Error("Failed to discard unbought cards", zap.Error(err))
+		return err
+	}
+
 	productionPhase.SelectionComplete = true
 	if err := g.SetProductionPhase(ctx, playerID, productionPhase); err != nil {
 		log.Error("Failed to update production phase", zap.Error(err))
@@ -114,6 +143,10 @@ func (a *ConfirmProductionCardsAction) Execute(ctx context.Context, gameID strin
 
 	log.Info("✅ Production selection marked complete")
 
+	if recordStats && a.stats != nil && g.Settings().Analytics {
+		a.stats.RecordResearch(productionPhase.AvailableCards, selectedCardIDs)
+	}
+
 	allPlayers := g.GetAllPlayers()
 	allComplete := true
 	for _, p := range allPlayers {
@@ -114,7 +114,12 @@ func (a *ConfirmSellPatentsAction) Execute(ctx context.Context, gameID string, p
 		}
 	}
 
-	log.Info("🗑️ Removed sold cards from hand", zap.Int("cards_removed", len(selectedCardIDs)))
+	if err := baseaction.DiscardCards(ctx, g, selectedCardIDs); err != nil {
+		log.Error("Failed to discard sold cards", zap.Error(err))
+		return err
+	}
+
+	log.Info("🗑️ Moved sold cards from hand to the discard pile", zap.Int("cards_removed", len(selectedCardIDs)))
 
 	player.Selection().SetPendingCardSelection(nil)
 
@@ -0,0 +1,181 @@
+package confirmation
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	baseaction "terraforming-mars-backend/internal/action"
+	"terraforming-mars-backend/internal/game"
+	"terraforming-mars-backend/internal/game/player"
+	"terraforming-mars-backend/internal/game/shared"
+
+	"go.uber.org/zap"
+)
+
+// RespondToEffectAction resolves a pending response: the player another player's effect targeted
+// picks which option they suffer. Responses left unanswered for game.ResponseTimeout get their default option
+type RespondToEffectAction struct {
+	baseaction.BaseAction
+}
+
+// NewRespondToEffectAction creates a new respond to effect action
+func NewRespondToEffectAction(
+	gameRepo game.GameRepository,
+	stateRepo game.GameStateRepository,
+	logger *zap.Logger,
+) *RespondToEffectAction {
+	return &RespondToEffectAction{
+		BaseAction: baseaction.NewBaseActionWithStateRepo(gameRepo, nil, stateRepo),
+	}
+}
+
+// Execute applies the option the player chose for their pending response
+func (a *RespondToEffectAction) Execute(ctx context.Context, gameID string, playerID string, optionIndex int) error {
+	log := a.InitLogger(gameID, playerID).With(
+		zap.String("action", "respond_to_effect"),
+		zap.Int("option_index", optionIndex),
+	)
+	log.Info("🛡️ Responding to effect")
+
+	g, err := baseaction.ValidateRespondableGame(ctx, a.GameRepository(), gameID, log)
+	if err != nil {
+		return err
+	}
+
+	response := g.GetPendingResponse(playerID)
+	if response == nil {
+		log.Warn("No pending response found")
+		return fmt.Errorf("no pending response found")
+	}
+
+	if optionIndex < 0 || optionIndex >= len(response.Options) {
+		log.Warn("Option index out of range", zap.Int("option_count", len(response.Options)))
+		return fmt.Errorf("invalid option index %d: must be between 0 and %d", optionIndex, len(response.Options)-1)
+	}
+
+	return a.resolve(ctx, g, playerID, response, optionIndex, "", log)
+}
+
+// ExpireResponses applies the default option to every response whose timeout has passed by now
+// The defaults are applied in the game's queue through run, where each response is looked up again, so an
+// answer that arrived while the timeout waited is kept. Paused games are skipped.
+// Returns the IDs of the games where a response was resolved
+func (a *RespondToEffectAction) ExpireResponses(ctx context.Context, now time.Time, run baseaction.GameRunner) []string {
+	status := game.GameStatusActive
+	games, err := a.GameRepository().List(ctx, &status)
+	if err != nil {
+		a.GetLogger().Warn("Failed to list games for response timeout", zap.Error(err))
+		return nil
+	}
+
+	var resolved []string
+	for _, g := range games {
+		if len(expiredResponders(g, now)) == 0 {
+			continue
+		}
+
+		expired := false
+		if err := run(ctx, g.ID(), func(ctx context.Context) {
+			for _, playerID := range expiredResponders(g, now) {
+				response := g.GetPendingResponse(playerID)
+				if response == nil {
+					continue
+				}
+
+				log := a.InitLogger(g.ID(), playerID).With(zap.String("action", "response_timeout"))
+				log.Info("⏰ Response timeout reached")
+				if err := a.resolve(ctx, g, playerID, response, response.DefaultOption, "Response time ran out; ", log); err != nil {
+					log.Error("Failed to apply default response", zap.Error(err))
+					continue
+				}
+				expired = true
+			}
+		}); err != nil {
+			a.GetLogger().Warn("Response timeout did not run", zap.String("game_id", g.ID()), zap.Error(err))
+			continue
+		}
+		if expired {
+			resolved = append(resolved, g.ID())
+		}
+	}
+	return resolved
+}
+
+// expiredResponders returns the players whose pending response has outlasted its deadline, in turn order
+func expiredResponders(g *game.Game, now time.Time) []string {
+	if g.IsPaused() || !g.AwaitingResponses() {
+		return nil
+	}
+	var expired []string
+	for _, playerID := range g.TurnOrder() {
+		if deadline, ok := g.ResponseDeadline(playerID); ok && !now.Before(deadline) {
+			expired = append(expired, playerID)
+		}
+	}
+	return expired
+}
+
+// Monitor checks response timeouts on every tick, resolving through run, and hands each game with a resolved response to onResolved
+func (a *RespondToEffectAction) Monitor(ctx context.Context, interval time.Duration, run baseaction.GameRunner, onResolved func(gameID string)) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			for _, gameID := range a.ExpireResponses(ctx, now, run) {
+				onResolved(gameID)
+			}
+		}
+	}
+}
+
+// resolve removes the chosen resources (clamped to what the player has), clears the response and logs it
+func (a *RespondToEffectAction) resolve(ctx context.Context, g *game.Game, playerID string, response *player.PendingResponse, optionIndex int, reason string, log *zap.Logger) error {
+	p, err := a.GetPlayerFromGame(g, playerID, log)
+	if err != nil {
+		return err
+	}
+
+	option := response.Options[optionIndex]
+	removed := min(option.Amount, resourceAmount(p.Resources().Get(), option.ResourceType))
+	if removed > 0 {
+		p.Resources().Add(map[shared.ResourceType]int{option.ResourceType: -removed})
+	}
+
+	if err := g.ClearPendingResponse(ctx, playerID); err != nil {
+		log.Error("Failed to clear pending response", zap.Error(err))
+		return fmt.Errorf("failed to clear pending response: %w", err)
+	}
+
+	description := fmt.Sprintf("%sLost %d %s to %s", reason, removed, option.ResourceType, response.Source)
+	a.WriteStateLog(ctx, g, response.Source, game.SourceTypeGameEvent, playerID, description)
+
+	log.Info("✅ Response resolved",
+		zap.String("resource_type", string(option.ResourceType)),
+		zap.Int("requested", option.Amount),
+		zap.Int("removed", removed))
+	return nil
+}
+
+// resourceAmount returns how much of a basic resource the player holds
+func resourceAmount(resources shared.Resources, resourceType shared.ResourceType) int {
+	switch resourceType {
+	case shared.ResourceCredit:
+		return resources.Credits
+	case shared.ResourceSteel:
+		return resources.Steel
+	case shared.ResourceTitanium:
+		return resources.Titanium
+	case shared.ResourcePlant:
+		return resources.Plants
+	case shared.ResourceEnergy:
+		return resources.Energy
+	case shared.ResourceHeat:
+		return resources.Heat
+	}
+	return 0
+}
@@ -0,0 +1,66 @@
+package action
+
+import (
+	"terraforming-mars-backend/internal/cards"
+	"terraforming-mars-backend/internal/game"
+	gamecards "terraforming-mars-backend/internal/game/cards"
+	"terraforming-mars-backend/internal/game/parameters"
+	"terraforming-mars-backend/internal/game/player"
+	"terraforming-mars-backend/internal/game/shared"
+)
+
+// ConvertiblesReminderThreshold is how many plants or heat a player holds before a pass asks for confirmation
+const ConvertiblesReminderThreshold = 8
+
+// Convertibles describes the plant and heat conversions a player could still make with effect
+// Heat counts only while the temperature can rise, and plants only while a greenery can be placed
+type Convertibles struct {
+	Plants           int // Plants held
+	Heat             int // Heat held
+	PlantCost        int // Plants per greenery after discounts (e.g. 7 for Ecoline)
+	HeatCost         int // Heat per temperature step after discounts
+	Greeneries       int // Greeneries the player's plants pay for
+	TemperatureSteps int // Temperature steps the player's heat pays for, capped at the maximum
+}
+
+// Any reports whether at least one conversion is possible
+func (c Convertibles) Any() bool {
+	return c.Greeneries > 0 || c.TemperatureSteps > 0
+}
+
+// Remind reports whether passing should ask for confirmation first: the player holds at least
+// ConvertiblesReminderThreshold plants or heat, and a conversion could still spend them
+func (c Convertibles) Remind() bool {
+	return (c.Plants >= ConvertiblesReminderThreshold && c.Greeneries > 0) ||
+		(c.Heat >= ConvertiblesReminderThreshold && c.TemperatureSteps > 0)
+}
+
+// CalculateConvertibles counts the conversions the player's plants and heat pay for right now
+func CalculateConvertibles(g *game.Game, p *player.Player, cardRegistry cards.CardRegistry) Convertibles {
+	calculator := gamecards.NewRequirementModifierCalculator(cardRegistry)
+	conversionCost := func(projectType shared.StandardProject, resourceType shared.ResourceType) int {
+		cost := getStandardProjectBaseCosts(projectType)[string(resourceType)]
+		cost -= calculator.CalculateStandardProjectDiscounts(p, projectType)[resourceType]
+		if cost < 1 {
+			cost = 1
+		}
+		return cost
+	}
+
+	resources := p.Resources().Get()
+	result := Convertibles{
+		Plants:    resources.Plants,
+		Heat:      resources.Heat,
+		PlantCost: conversionCost(shared.StandardProjectConvertPlantsToGreenery, shared.ResourcePlant),
+		HeatCost:  conversionCost(shared.StandardProjectConvertHeatToTemperature, shared.ResourceHeat),
+	}
+
+	if g.CountAvailableHexesForTile("greenery", p.ID(), nil) > 0 {
+		result.Greeneries = resources.Plants / result.PlantCost
+	}
+
+	remainingSteps := parameters.Temperature.RemainingSteps(g.GlobalParameters().Temperature())
+	result.TemperatureSteps = min(resources.Heat/result.HeatCost, remainingSteps)
+
+	return result
+}
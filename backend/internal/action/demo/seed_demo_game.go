@@ -0,0 +1,289 @@
+package demo
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"terraforming-mars-backend/internal/action/admin"
+	gameaction "terraforming-mars-backend/internal/action/game"
+	"terraforming-mars-backend/internal/action/turn_management"
+	"terraforming-mars-backend/internal/cards"
+	"terraforming-mars-backend/internal/delivery/dto"
+	"terraforming-mars-backend/internal/game"
+	"terraforming-mars-backend/internal/game/parameters"
+	"terraforming-mars-backend/internal/game/shared"
+)
+
+const (
+	// DefaultBotCount is how many bot seats join the human player when the request names none
+	DefaultBotCount = 2
+	// DefaultGeneration is the generation seeded games start in when the request names none
+	DefaultGeneration = 5
+	// MaxGeneration keeps seeded boards within what the global parameter tracks can show
+	MaxGeneration = 10
+
+	seededHandSize = 6
+)
+
+// SeedDemoGameAction creates a demo game with bot seats and fast-forwards it to a mid-game position
+// Setup goes through the regular demo flow (lobby -> demo setup -> action phase), then the admin test
+// fixture lays out each seat's city, greeneries and played cards, so the board looks several generations in.
+// Bot seats are ordinary players: they are driven through bot tokens or taken over from the player list.
+type SeedDemoGameAction struct {
+	gameRepo               game.GameRepository
+	cardRegistry           cards.CardRegistry
+	createDemoLobbyAction  *gameaction.CreateDemoLobbyAction
+	joinGameAction         *gameaction.JoinGameAction
+	startGameAction        *turn_management.StartGameAction
+	confirmDemoSetupAction *gameaction.ConfirmDemoSetupAction
+	setupTestStateAction   *admin.SetupTestStateAction
+	logger                 *zap.Logger
+}
+
+// SeedSettings contains settings for seeding a demo game
+type SeedSettings struct {
+	PlayerName string   // Name for the human player; default "You"
+	BotCount   int      // 1-4; default DefaultBotCount
+	Generation int      // 1-MaxGeneration; default DefaultGeneration
+	CardPacks  []string // Default: the demo lobby's packs
+	AccountID  string   // Links every seat to this account, so its owner can issue bot tokens; optional
+}
+
+// SeedResult contains the seeded game and its seats
+type SeedResult struct {
+	PlayerID string   // The human player, who takes the first turn
+	BotIDs   []string // In seat order
+	GameDto  dto.GameDto
+}
+
+// NewSeedDemoGameAction creates a new seed demo game action
+func NewSeedDemoGameAction(
+	gameRepo game.GameRepository,
+	cardRegistry cards.CardRegistry,
+	createDemoLobbyAction *gameaction.CreateDemoLobbyAction,
+	joinGameAction *gameaction.JoinGameAction,
+	startGameAction *turn_management.StartGameAction,
+	confirmDemoSetupAction *gameaction.ConfirmDemoSetupAction,
+	setupTestStateAction *admin.SetupTestStateAction,
+	logger *zap.Logger,
+) *SeedDemoGameAction {
+	return &SeedDemoGameAction{
+		gameRepo:               gameRepo,
+		cardRegistry:           cardRegistry,
+		createDemoLobbyAction:  createDemoLobbyAction,
+		joinGameAction:         joinGameAction,
+		startGameAction:        startGameAction,
+		confirmDemoSetupAction: confirmDemoSetupAction,
+		setupTestStateAction:   setupTestStateAction,
+		logger:                 logger,
+	}
+}
+
+// Execute creates and fast-forwards a demo game
+func (a *SeedDemoGameAction) Execute(ctx context.Context, settings SeedSettings) (*SeedResult, error) {
+	log := a.logger.With(zap.String("action", "seed_demo_game"))
+
+	if settings.BotCount == 0 {
+		settings.BotCount = DefaultBotCount
+	}
+	if settings.Generation == 0 {
+		settings.Generation = DefaultGeneration
+	}
+	if settings.BotCount < 1 || settings.BotCount > 4 {
+		return nil, fmt.Errorf("bot count must be between 1 and 4, got %d", settings.BotCount)
+	}
+	if settings.Generation < 1 || settings.Generation > MaxGeneration {
+		return nil, fmt.Errorf("generation must be between 1 and %d, got %d", MaxGeneration, settings.Generation)
+	}
+	log.Info("🌱 Seeding demo game", zap.Int("bots", settings.BotCount), zap.Int("generation", settings.Generation))
+
+	// 1. Create the lobby with the human player as host, then seat the bots
+	lobby, err := a.createDemoLobbyAction.Execute(ctx, gameaction.DemoLobbySettings{
+		PlayerCount: settings.BotCount + 1,
+		CardPacks:   settings.CardPacks,
+		PlayerName:  settings.PlayerName,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create demo lobby: %w", err)
+	}
+	gameID := lobby.GameDto.ID
+	log = log.With(zap.String("game_id", gameID))
+
+	seats := []string{lobby.PlayerID}
+	for i := 1; i <= settings.BotCount; i++ {
+		botID := uuid.New().String()
+		if _, err := a.joinGameAction.ExecuteForAccount(ctx, gameID, fmt.Sprintf("Bot %d", i), botID, settings.AccountID); err != nil {
+			return nil, fmt.Errorf("failed to seat bot %d: %w", i, err)
+		}
+		seats = append(seats, botID)
+	}
+
+	// 2. Move the lobby into demo setup
+	if err := a.startGameAction.Execute(ctx, gameID, lobby.PlayerID); err != nil {
+		return nil, fmt.Errorf("failed to start demo game: %w", err)
+	}
+
+	g, err := a.gameRepo.Get(ctx, gameID)
+	if err != nil {
+		return nil, fmt.Errorf("game not found: %s", gameID)
+	}
+	if settings.AccountID != "" {
+		host, err := g.GetPlayer(lobby.PlayerID)
+		if err != nil {
+			return nil, fmt.Errorf("host seat not found: %w", err)
+		}
+		host.SetAccountID(settings.AccountID)
+	}
+
+	// 3. BUSINESS LOGIC: Give every seat a corporation, hand and economy (moves the game to the action phase)
+	playedCards := make(map[string][]string, len(seats))
+	for seat, playerID := range seats {
+		setup, played, err := a.seatSetup(ctx, g, seat, settings.Generation)
+		if err != nil {
+			return nil, err
+		}
+		if err := a.confirmDemoSetupAction.Execute(ctx, gameID, playerID, setup); err != nil {
+			return nil, fmt.Errorf("failed to apply demo setup: %w", err)
+		}
+		playedCards[playerID] = played
+	}
+
+	// 4. BUSINESS LOGIC: Lay out each seat's tiles and tableau; cities are placed one seat at a time so
+	// every city keeps its distance from the ones before it
+	greeneries := 0
+	for seat, playerID := range seats {
+		tiles := a.seatTiles(g, seat, playerID, settings.Generation)
+		for _, tile := range tiles {
+			if tile.TileType == "greenery" {
+				greeneries++
+			}
+		}
+		fixture := admin.TestStateFixture{
+			Tiles:   tiles,
+			Players: []admin.FixturePlayer{{PlayerID: playerID, PlayedCards: playedCards[playerID]}},
+		}
+		if err := a.setupTestStateAction.Execute(ctx, gameID, fixture); err != nil {
+			return nil, fmt.Errorf("failed to lay out seat %d: %w", seat, err)
+		}
+	}
+
+	// 5. BUSINESS LOGIC: Oceans and global parameters that match the board, with the human to move
+	oceans := oceanTiles(g, min(settings.Generation-1, parameters.Oceans.Max))
+	temperature := min(parameters.Temperature.Min+parameters.Temperature.Step*(3*(settings.Generation-1)/2), parameters.Temperature.Max)
+	fixture := admin.TestStateFixture{
+		CurrentTurnPlayerID: lobby.PlayerID,
+		Tiles:               oceans,
+		GlobalParameters: &admin.SetGlobalParametersRequest{
+			Temperature: temperature,
+			Oxygen:      min(greeneries, parameters.Oxygen.Max),
+			Oceans:      len(oceans),
+		},
+	}
+	if err := a.setupTestStateAction.Execute(ctx, gameID, fixture); err != nil {
+		return nil, fmt.Errorf("failed to set global parameters: %w", err)
+	}
+
+	log.Info("✅ Demo game seeded",
+		zap.Int("greeneries", greeneries),
+		zap.Int("oceans", len(oceans)),
+		zap.Int("temperature", temperature))
+	return &SeedResult{
+		PlayerID: lobby.PlayerID,
+		BotIDs:   seats[1:],
+		GameDto:  dto.ToGameDto(g, a.cardRegistry, lobby.PlayerID),
+	}, nil
+}
+
+// seatSetup draws a seat's corporation, hand and played cards from the deck, and scales its economy
+// with the generation; later seats get slightly stronger economies so the scoreboard is not a tie
+func (a *SeedDemoGameAction) seatSetup(ctx context.Context, g *game.Game, seat, generation int) (*dto.ConfirmDemoSetupRequest, []string, error) {
+	corporations, err := g.Deck().DrawCorporations(ctx, 1)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to draw a corporation for seat %d: %w", seat, err)
+	}
+	if len(corporations) == 0 {
+		return nil, nil, fmt.Errorf("no corporation left for seat %d", seat)
+	}
+	hand, err := g.Deck().DrawProjectCards(ctx, seededHandSize)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to draw a hand for seat %d: %w", seat, err)
+	}
+	played, err := g.Deck().DrawProjectCards(ctx, 2*(generation-1))
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to draw played cards for seat %d: %w", seat, err)
+	}
+
+	elapsed := generation - 1
+	setup := &dto.ConfirmDemoSetupRequest{
+		CorporationID: &corporations[0],
+		CardIDs:       hand,
+		Resources: dto.ResourcesDto{
+			Credits:  20 + 5*seat,
+			Steel:    2,
+			Titanium: seat % 2,
+			Plants:   4 + seat,
+			Energy:   2,
+			Heat:     3 * elapsed / 2,
+		},
+		Production: dto.ProductionDto{
+			Credits:  elapsed + seat,
+			Steel:    1 + seat%2,
+			Titanium: (seat + 1) % 2,
+			Plants:   1 + seat%3,
+			Energy:   1 + (seat+1)%2,
+			Heat:     elapsed / 2,
+		},
+		TerraformRating: 20 + 2*elapsed + seat,
+	}
+	if seat == 0 {
+		setup.Generation = &generation
+	}
+	return setup, played, nil
+}
+
+// seatTiles picks a seat's city and the greeneries around it on the board as it stands
+// Seats alternate between counting from the top left and the bottom right, so cities spread out.
+func (a *SeedDemoGameAction) seatTiles(g *game.Game, seat int, playerID string, generation int) []admin.FixtureTile {
+	city, ok := g.Board().NeutralCitySpace(3+7*seat, seat%2 == 1)
+	if !ok {
+		return nil
+	}
+	tiles := []admin.FixtureTile{{Position: city, TileType: "city", OwnerID: playerID}}
+
+	placed := map[shared.HexPosition]bool{city: true}
+	for n := 1; n <= (generation-1)/2; n++ {
+		space, ok := g.Board().NeutralGreenerySpace(city, 2*n)
+		if !ok || placed[space] {
+			break
+		}
+		placed[space] = true
+		tiles = append(tiles, admin.FixtureTile{Position: space, TileType: "greenery", OwnerID: playerID})
+	}
+	return tiles
+}
+
+// oceanTiles picks every other free ocean space in reading order, up to count
+func oceanTiles(g *game.Game, count int) []admin.FixtureTile {
+	var spaces []shared.HexPosition
+	for _, tile := range g.Board().Tiles() {
+		if tile.Type == shared.ResourceOceanSpace && tile.OccupiedBy == nil {
+			spaces = append(spaces, tile.Coordinates)
+		}
+	}
+	sort.Slice(spaces, func(i, j int) bool {
+		if spaces[i].R != spaces[j].R {
+			return spaces[i].R < spaces[j].R
+		}
+		return spaces[i].Q < spaces[j].Q
+	})
+
+	var tiles []admin.FixtureTile
+	for i := 0; i < len(spaces) && len(tiles) < count; i += 2 {
+		tiles = append(tiles, admin.FixtureTile{Position: spaces[i], TileType: "ocean"})
+	}
+	return tiles
+}
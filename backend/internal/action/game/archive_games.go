@@ -0,0 +1,168 @@
+package game
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"go.uber.org/zap"
+
+	"terraforming-mars-backend/internal/archive"
+	"terraforming-mars-backend/internal/game"
+	"terraforming-mars-backend/internal/game/deck"
+)
+
+// DefaultArchiveAfter is how long a finished game stays in the hot store, so players can still look at the result
+const DefaultArchiveAfter = time.Hour
+
+// ArchiveGamesAction moves finished games from the hot store into the archive
+// A completed or abandoned game is archived once it has been untouched for the retention delay; its record keeps
+// the settings, results and full state history, and the game and its diff log are then dropped from memory
+type ArchiveGamesAction struct {
+	gameRepo  game.GameRepository
+	stateRepo game.GameStateRepository
+	archive   *archive.Archive
+	after     time.Duration
+	logger    *zap.Logger
+}
+
+// NewArchiveGamesAction creates a new archive games action
+// after <= 0 uses DefaultArchiveAfter
+func NewArchiveGamesAction(
+	gameRepo game.GameRepository,
+	stateRepo game.GameStateRepository,
+	gameArchive *archive.Archive,
+	after time.Duration,
+	logger *zap.Logger,
+) *ArchiveGamesAction {
+	if after <= 0 {
+		after = DefaultArchiveAfter
+	}
+	return &ArchiveGamesAction{
+		gameRepo:  gameRepo,
+		stateRepo: stateRepo,
+		archive:   gameArchive,
+		after:     after,
+		logger:    logger,
+	}
+}
+
+// ArchiveFinished archives every finished game last updated at least the retention delay before now
+// and returns the IDs of the games it archived. A game that fails to archive stays in the hot store for the next run
+func (a *ArchiveGamesAction) ArchiveFinished(ctx context.Context, now time.Time) []string {
+	log := a.logger.With(zap.String("action", "archive_games"))
+
+	games, err := a.gameRepo.List(ctx, nil)
+	if err != nil {
+		log.Error("Failed to list games", zap.Error(err))
+		return nil
+	}
+
+	var archived []string
+	for _, g := range games {
+		if status := g.Status(); status != game.GameStatusCompleted && status != game.GameStatusAbandoned {
+			continue
+		}
+		if now.Sub(g.UpdatedAt()) < a.after {
+			continue
+		}
+
+		gameLog := log.With(zap.String("game_id", g.ID()))
+		if err := a.archiveGame(ctx, g); err != nil {
+			gameLog.Error("Failed to archive game", zap.Error(err))
+			continue
+		}
+		gameLog.Info("📦 Game archived", zap.String("status", string(g.Status())))
+		archived = append(archived, g.ID())
+	}
+	return archived
+}
+
+// Monitor archives finished games on each tick
+func (a *ArchiveGamesAction) Monitor(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			a.ArchiveFinished(ctx, now)
+		}
+	}
+}
+
+// archiveGame saves the game's record, then drops the game and its diff log from the hot store
+func (a *ArchiveGamesAction) archiveGame(ctx context.Context, g *game.Game) error {
+	// A game that ended before anything was logged has no diff log yet
+	diffs, err := a.stateRepo.GetDiff(ctx, g.ID())
+	if err != nil && !errors.Is(err, game.ErrGameNotFound) {
+		return err
+	}
+
+	inputs, err := a.stateRepo.GetInputs(ctx, g.ID())
+	if err != nil {
+		return err
+	}
+
+	if err := a.archive.Save(ctx, buildRecord(g, diffs, inputs)); err != nil {
+		return err
+	}
+
+	if err := a.stateRepo.Delete(ctx, g.ID()); err != nil && !errors.Is(err, game.ErrGameNotFound) {
+		return err
+	}
+	return a.gameRepo.Delete(ctx, g.ID())
+}
+
+// buildRecord captures a finished game for the archive
+func buildRecord(g *game.Game, diffs []game.StateDiff, inputs []game.ActionInput) archive.Record {
+	players := make([]archive.PlayerRecord, 0, len(g.TurnOrder()))
+	for _, playerID := range g.TurnOrder() {
+		p, err := g.GetPlayer(playerID)
+		if err != nil {
+			continue
+		}
+		players = append(players, archive.PlayerRecord{
+			PlayerID:      p.ID(),
+			PlayerName:    p.Name(),
+			AccountID:     p.AccountID(),
+			CorporationID: p.CorporationID(),
+		})
+	}
+
+	return archive.Record{
+		GameID:          g.ID(),
+		Status:          g.Status(),
+		Generation:      g.Generation(),
+		Settings:        g.Settings(),
+		Players:         players,
+		ConcededPlayers: g.ConcededPlayers(),
+		FinalScores:     g.GetFinalScores(),
+		WinnerID:        g.GetWinnerID(),
+		IsTie:           g.IsTie(),
+		CreatedAt:       g.CreatedAt(),
+		FinishedAt:      g.UpdatedAt(),
+		Log:             diffs,
+		ShuffleProof:    buildShuffleProof(g),
+		Inputs:          inputs,
+	}
+}
+
+// buildShuffleProof reveals the deck seed, so archived games stay verifiable against their commitment
+func buildShuffleProof(g *game.Game) *archive.ShuffleProof {
+	if g.Deck() == nil {
+		return nil
+	}
+	seed, order, ok := g.Deck().Reveal()
+	if !ok {
+		return nil
+	}
+	return &archive.ShuffleProof{
+		Algorithm:  deck.ShuffleAlgorithm,
+		Commitment: g.Deck().Commitment(),
+		Seed:       seed.String(),
+		Order:      order,
+	}
+}
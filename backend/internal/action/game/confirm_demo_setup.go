@@ -14,6 +14,7 @@ import (
 	"terraforming-mars-backend/internal/events"
 	internalgame "terraforming-mars-backend/internal/game"
 	gamecards "terraforming-mars-backend/internal/game/cards"
+	"terraforming-mars-backend/internal/game/parameters"
 	"terraforming-mars-backend/internal/game/shared"
 )
 
@@ -73,6 +74,20 @@ func (a *ConfirmDemoSetupAction) Execute(
 		return fmt.Errorf("player not found: %s", playerID)
 	}
 
+	// 3a. Global parameters must be on their tracks; only the host's are applied
+	if params := request.GlobalParameters; params != nil && g.HostPlayerID() == playerID {
+		for _, err := range []error{
+			parameters.Temperature.Validate(params.Temperature),
+			parameters.Oxygen.Validate(params.Oxygen),
+			parameters.Oceans.Validate(params.Oceans),
+		} {
+			if err != nil {
+				log.Warn("Rejected global parameters", zap.Error(err))
+				return err
+			}
+		}
+	}
+
 	// 4. Set corporation - either specified or random
 	var corporationID string
 	if request.CorporationID != nil && *request.CorporationID != "" {
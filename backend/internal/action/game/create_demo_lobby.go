@@ -79,7 +79,10 @@ func (a *CreateDemoLobbyAction) Execute(
 	newGame := game.NewGame(gameID, "", baseSettings)
 
 	projectCardIDs, corpIDs, preludeIDs := cards.GetCardIDsByPacks(a.cardRegistry, settings.CardPacks)
-	gameDeck := deck.NewDeck(gameID, projectCardIDs, corpIDs, preludeIDs)
+	gameDeck, err := deck.NewShuffledDeck(gameID, projectCardIDs, corpIDs, preludeIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to shuffle deck: %w", err)
+	}
 	newGame.SetDeck(gameDeck)
 	newGame.SetVPCardLookup(cards.NewVPCardLookupAdapter(a.cardRegistry))
 	log.Info("Deck initialized",
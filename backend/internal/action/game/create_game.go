@@ -2,6 +2,7 @@ package game
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/google/uuid"
 	"go.uber.org/zap"
@@ -52,6 +53,18 @@ func (a *CreateGameAction) Execute(
 	if len(settings.CardPacks) == 0 {
 		settings.CardPacks = game.DefaultCardPacks()
 	}
+	if len(settings.ColorPalette) == 0 {
+		settings.ColorPalette = game.DefaultColorPalette()
+	}
+	if err := game.ValidateColorPalette(settings.ColorPalette, settings.MaxPlayers); err != nil {
+		log.Warn("Invalid color palette", zap.Error(err))
+		return nil, fmt.Errorf("invalid color palette: %w", err)
+	}
+	settings.RulesOptions = settings.RulesOptions.WithDefaults()
+	if err := settings.RulesOptions.Validate(); err != nil {
+		log.Warn("Invalid rules options", zap.Error(err))
+		return nil, fmt.Errorf("invalid rules options: %w", err)
+	}
 
 	// 3. Create game entity
 	// Note: hostPlayerID is empty initially, will be set when first player joins
@@ -61,17 +74,22 @@ func (a *CreateGameAction) Execute(
 
 	// 4. Initialize deck with cards from selected packs
 	projectCardIDs, corpIDs, preludeIDs := cards.GetCardIDsByPacks(a.cardRegistry, settings.CardPacks)
-	gameDeck := deck.NewDeck(gameID, projectCardIDs, corpIDs, preludeIDs)
+	// The deck is shuffled from a secret seed and committed to before any card is dealt
+	gameDeck, err := deck.NewShuffledDeck(gameID, projectCardIDs, corpIDs, preludeIDs)
+	if err != nil {
+		log.Error("Failed to shuffle deck", zap.Error(err))
+		return nil, err
+	}
 	newGame.SetDeck(gameDeck)
 	newGame.SetVPCardLookup(cards.NewVPCardLookupAdapter(a.cardRegistry))
 	log.Info("✅ Deck initialized",
 		zap.Int("project_cards", len(projectCardIDs)),
 		zap.Int("corporations", len(corpIDs)),
 		zap.Int("preludes", len(preludeIDs)),
-		zap.Strings("first_5_corps", getFirst5(corpIDs)))
+		zap.String("deck_commitment", gameDeck.Commitment()))
 
 	// 5. Store game in repository
-	err := a.gameRepo.Create(ctx, newGame)
+	err = a.gameRepo.Create(ctx, newGame)
 	if err != nil {
 		log.Error("Failed to create game", zap.Error(err))
 		return nil, err
@@ -80,11 +98,3 @@ func (a *CreateGameAction) Execute(
 	log.Info("✅ Game created successfully with board and deck", zap.String("game_id", gameID))
 	return newGame, nil
 }
-
-// getFirst5 returns up to the first 5 elements of a slice (for logging)
-func getFirst5(ids []string) []string {
-	if len(ids) <= 5 {
-		return ids
-	}
-	return ids[:5]
-}
@@ -0,0 +1,92 @@
+package game
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"terraforming-mars-backend/internal/game"
+)
+
+// ErrNotHost is returned when a player other than the host manages the lobby's invites
+var ErrNotHost = errors.New("only the host can manage invites")
+
+// CreateInviteAction lets the host reserve a named lobby seat behind a one-time invite token
+type CreateInviteAction struct {
+	gameRepo game.GameRepository
+	logger   *zap.Logger
+}
+
+// NewCreateInviteAction creates a new create invite action
+func NewCreateInviteAction(
+	gameRepo game.GameRepository,
+	logger *zap.Logger,
+) *CreateInviteAction {
+	return &CreateInviteAction{
+		gameRepo: gameRepo,
+		logger:   logger,
+	}
+}
+
+// Execute opens an invite for the seat name and returns it
+// Unknown games return an error wrapping game.ErrGameNotFound, and non-hosts one wrapping ErrNotHost
+func (a *CreateInviteAction) Execute(
+	ctx context.Context,
+	gameID string,
+	playerID string,
+	seatName string,
+) (game.Invite, error) {
+	log := a.logger.With(
+		zap.String("game_id", gameID),
+		zap.String("player_id", playerID),
+		zap.String("action", "create_invite"),
+	)
+
+	seatName = strings.TrimSpace(seatName)
+	log.Info("✉️ Creating invite", zap.String("seat_name", seatName))
+
+	if len(seatName) < 2 {
+		return game.Invite{}, fmt.Errorf("seat name must be at least 2 characters long")
+	}
+
+	g, err := requireLobbyHost(ctx, a.gameRepo, gameID, playerID)
+	if err != nil {
+		log.Warn("Cannot create invite", zap.Error(err))
+		return game.Invite{}, err
+	}
+
+	invite := game.Invite{
+		Token:     uuid.New().String(),
+		SeatName:  seatName,
+		CreatedBy: playerID,
+		CreatedAt: time.Now(),
+	}
+	if err := g.AddInvite(ctx, invite); err != nil {
+		log.Warn("Failed to reserve seat", zap.Error(err))
+		return game.Invite{}, err
+	}
+
+	log.Info("✅ Invite created")
+	return invite, nil
+}
+
+// requireLobbyHost fetches a game whose invites the player may manage: the game is in its lobby
+// and the player hosts it
+func requireLobbyHost(ctx context.Context, gameRepo game.GameRepository, gameID string, playerID string) (*game.Game, error) {
+	g, err := gameRepo.Get(ctx, gameID)
+	if err != nil {
+		return nil, err
+	}
+	if g.Status() != game.GameStatusLobby {
+		return nil, fmt.Errorf("game is not in lobby: %s", g.Status())
+	}
+	if playerID == "" || g.HostPlayerID() != playerID {
+		return nil, ErrNotHost
+	}
+	return g, nil
+}
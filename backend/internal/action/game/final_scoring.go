@@ -7,16 +7,19 @@ import (
 
 	"go.uber.org/zap"
 
+	"terraforming-mars-backend/internal/analytics"
 	"terraforming-mars-backend/internal/cards"
 	"terraforming-mars-backend/internal/events"
 	"terraforming-mars-backend/internal/game"
 	gamecards "terraforming-mars-backend/internal/game/cards"
+	"terraforming-mars-backend/internal/game/player"
 )
 
 // FinalScoringAction handles the business logic for calculating final scores and ending the game
 type FinalScoringAction struct {
 	gameRepo     game.GameRepository
 	cardRegistry cards.CardRegistry
+	stats        *analytics.Store
 	logger       *zap.Logger
 }
 
@@ -24,11 +27,13 @@ type FinalScoringAction struct {
 func NewFinalScoringAction(
 	gameRepo game.GameRepository,
 	cardRegistry cards.CardRegistry,
+	stats *analytics.Store,
 	logger *zap.Logger,
 ) *FinalScoringAction {
 	return &FinalScoringAction{
 		gameRepo:     gameRepo,
 		cardRegistry: cardRegistry,
+		stats:        stats,
 		logger:       logger,
 	}
 }
@@ -68,7 +73,7 @@ func (a *FinalScoringAction) Execute(ctx context.Context, gameID string) error {
 
 	// 4. Prepare milestone and award data for VP calculation
 	claimedMilestones := convertToClaimedMilestoneInfo(g.Milestones().ClaimedMilestones())
-	fundedAwards := convertToFundedAwardInfo(g.Awards().FundedAwards())
+	fundedAwards := gamecards.ToFundedAwardInfo(g.Awards().FundedAwards())
 
 	// 5. Calculate VP for each player
 	scores := make([]PlayerScore, len(allPlayers))
@@ -80,6 +85,7 @@ func (a *FinalScoringAction) Execute(ctx context.Context, gameID string) error {
 			fundedAwards,
 			allPlayers,
 			a.cardRegistry,
+			g.Settings().RulesOptions.ScoringVP(),
 		)
 		scores[i] = PlayerScore{
 			PlayerID:   p.ID(),
@@ -108,9 +114,18 @@ func (a *FinalScoringAction) Execute(ctx context.Context, gameID string) error {
 		return scores[i].Credits > scores[j].Credits
 	})
 
-	// 7. Determine winner and check for ties
+	// 7. Determine winner and check for ties; a solo game with a goal is only won by meeting it
 	winnerID := scores[0].PlayerID
 	isTie := false
+	if len(allPlayers) == 1 {
+		if goal := g.Settings().RulesOptions.SoloGoal; goal != "" {
+			achieved := soloGoalAchieved(g, allPlayers[0], goal)
+			if !achieved {
+				winnerID = ""
+			}
+			log.Info("🎯 Solo goal evaluated", zap.String("goal", goal), zap.Bool("achieved", achieved))
+		}
+	}
 	if len(scores) > 1 {
 		// Check if top players have same VP and credits (true tie)
 		if scores[0].Breakdown.TotalVP == scores[1].Breakdown.TotalVP &&
@@ -169,7 +184,12 @@ func (a *FinalScoringAction) Execute(ctx context.Context, gameID string) error {
 		return err
 	}
 
-	// 11. Publish GameEndedEvent
+	// 11. Record anonymized stats for games that opted in
+	if a.stats != nil && g.Settings().Analytics {
+		a.stats.RecordGame(gameResults(allPlayers, winnerID, isTie))
+	}
+
+	// 12. Publish GameEndedEvent
 	events.Publish(g.EventBus(), events.GameEndedEvent{
 		GameID:    gameID,
 		WinnerID:  winnerID,
@@ -181,6 +201,31 @@ func (a *FinalScoringAction) Execute(ctx context.Context, gameID string) error {
 	return nil
 }
 
+// soloGoalAchieved reports whether a solo player met the goal: a fully terraformed Mars, or a terraform
+// rating of at least 63 under the TR-63 variant
+func soloGoalAchieved(g *game.Game, p *player.Player, goal string) bool {
+	switch goal {
+	case game.SoloGoalTR63:
+		return p.Resources().TerraformRating() >= game.SoloTRGoal
+	default:
+		return g.GlobalParameters().IsMaxed()
+	}
+}
+
+// gameResults strips a finished game down to what analytics keeps: each seat's corporation, played
+// cards and whether it won outright
+func gameResults(players []*player.Player, winnerID string, isTie bool) []analytics.PlayerResult {
+	results := make([]analytics.PlayerResult, 0, len(players))
+	for _, p := range players {
+		results = append(results, analytics.PlayerResult{
+			CorporationID: p.CorporationID(),
+			PlayedCards:   p.PlayedCards().Cards(),
+			Won:           !isTie && p.ID() == winnerID,
+		})
+	}
+	return results
+}
+
 // convertToClaimedMilestoneInfo converts game milestones to the format expected by VP calculator
 func convertToClaimedMilestoneInfo(claimed []game.ClaimedMilestone) []gamecards.ClaimedMilestoneInfo {
 	result := make([]gamecards.ClaimedMilestoneInfo, len(claimed))
@@ -193,17 +238,6 @@ func convertToClaimedMilestoneInfo(claimed []game.ClaimedMilestone) []gamecards.
 	return result
 }
 
-// convertToFundedAwardInfo converts game awards to the format expected by VP calculator
-func convertToFundedAwardInfo(funded []game.FundedAward) []gamecards.FundedAwardInfo {
-	result := make([]gamecards.FundedAwardInfo, len(funded))
-	for i, a := range funded {
-		result[i] = gamecards.FundedAwardInfo{
-			Type: string(a.Type),
-		}
-	}
-	return result
-}
-
 // convertCardVPDetails converts gamecards.CardVPDetail to game.CardVPDetail
 func convertCardVPDetails(details []gamecards.CardVPDetail) []game.CardVPDetail {
 	result := make([]game.CardVPDetail, len(details))
@@ -0,0 +1,196 @@
+package game
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+
+	baseaction "terraforming-mars-backend/internal/action"
+	"terraforming-mars-backend/internal/action/confirmation"
+	"terraforming-mars-backend/internal/game"
+	playerPkg "terraforming-mars-backend/internal/game/player"
+)
+
+// ForceAdvancePhaseAction ends a production phase that is stuck waiting for card purchases
+// Players who have not confirmed buy no cards. The host forces it after confirming, and games with a
+// research timeout are forced automatically once the timeout runs out. Either way each skipped player gets a log entry
+type ForceAdvancePhaseAction struct {
+	gameRepo      game.GameRepository
+	stateRepo     game.GameStateRepository
+	confirmAction *confirmation.ConfirmProductionCardsAction
+	logger        *zap.Logger
+}
+
+// NewForceAdvancePhaseAction creates a new force advance phase action
+func NewForceAdvancePhaseAction(
+	gameRepo game.GameRepository,
+	stateRepo game.GameStateRepository,
+	confirmAction *confirmation.ConfirmProductionCardsAction,
+	logger *zap.Logger,
+) *ForceAdvancePhaseAction {
+	return &ForceAdvancePhaseAction{
+		gameRepo:      gameRepo,
+		stateRepo:     stateRepo,
+		confirmAction: confirmAction,
+		logger:        logger,
+	}
+}
+
+// Execute lets the host force the production phase on; confirmed must be set, since waiting players lose their purchase
+func (a *ForceAdvancePhaseAction) Execute(ctx context.Context, gameID string, playerID string, confirmed bool) error {
+	log := a.logger.With(
+		zap.String("game_id", gameID),
+		zap.String("player_id", playerID),
+		zap.String("action", "force_advance_phase"),
+	)
+	log.Info("⏩ Host forcing phase forward")
+
+	g, err := a.gameRepo.Get(ctx, gameID)
+	if err != nil {
+		log.Error("Failed to get game", zap.Error(err))
+		return fmt.Errorf("game not found: %s", gameID)
+	}
+
+	if g.Status() != game.GameStatusActive {
+		log.Warn("Game is not active", zap.String("status", string(g.Status())))
+		return fmt.Errorf("game is not active: %s", g.Status())
+	}
+
+	if g.HostPlayerID() != playerID {
+		log.Warn("Only the host can force the phase forward", zap.String("host_id", g.HostPlayerID()))
+		return fmt.Errorf("only the host can force the phase forward")
+	}
+
+	if g.CurrentPhase() != game.GamePhaseProductionAndCardDraw {
+		log.Warn("Game is not in production phase", zap.String("current_phase", string(g.CurrentPhase())))
+		return fmt.Errorf("only the production phase can be forced forward")
+	}
+
+	waiting := waitingForResearch(g)
+	if len(waiting) == 0 {
+		log.Warn("No players are waiting to buy cards")
+		return fmt.Errorf("no players are waiting to buy cards")
+	}
+
+	if !confirmed {
+		return fmt.Errorf("confirm to advance: %s will buy no cards", playerNames(waiting))
+	}
+
+	return a.advance(ctx, g, waiting, "Host advanced the production phase", log)
+}
+
+// ExpireResearch forces every production phase whose research timeout has passed by now
+// The force runs in the game's queue through run, where the deadline and the waiting players are checked
+// again, so a purchase confirmed while the timeout waited is kept. Paused games are skipped.
+// Returns the IDs of the games that were advanced
+func (a *ForceAdvancePhaseAction) ExpireResearch(ctx context.Context, now time.Time, run baseaction.GameRunner) []string {
+	status := game.GameStatusActive
+	games, err := a.gameRepo.List(ctx, &status)
+	if err != nil {
+		a.logger.Warn("Failed to list games for research timeout", zap.Error(err))
+		return nil
+	}
+
+	var advanced []string
+	for _, g := range games {
+		if !researchExpired(g, now) || len(waitingForResearch(g)) == 0 {
+			continue
+		}
+
+		log := a.logger.With(zap.String("game_id", g.ID()), zap.String("action", "research_timeout"))
+		forced := false
+		if err := run(ctx, g.ID(), func(ctx context.Context) {
+			if !researchExpired(g, now) {
+				log.Debug("Production phase ended before its research timeout ran")
+				return
+			}
+			waiting := waitingForResearch(g)
+			if len(waiting) == 0 {
+				log.Debug("Every player confirmed before the research timeout ran")
+				return
+			}
+			log.Info("⏰ Research timeout reached")
+			if err := a.advance(ctx, g, waiting, "Research time ran out", log); err != nil {
+				log.Error("Failed to advance production phase", zap.Error(err))
+				return
+			}
+			forced = true
+		}); err != nil {
+			log.Warn("Research timeout did not run", zap.Error(err))
+			continue
+		}
+		if forced {
+			advanced = append(advanced, g.ID())
+		}
+	}
+	return advanced
+}
+
+// researchExpired reports whether the game's production phase has outlasted its research deadline
+func researchExpired(g *game.Game, now time.Time) bool {
+	deadline, ok := g.ResearchDeadline()
+	return ok && !g.IsPaused() && !now.Before(deadline)
+}
+
+// Monitor checks research timeouts on every tick, forcing through run, and hands each advanced game to onAdvanced
+func (a *ForceAdvancePhaseAction) Monitor(ctx context.Context, interval time.Duration, run baseaction.GameRunner, onAdvanced func(gameID string)) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			for _, gameID := range a.ExpireResearch(ctx, now, run) {
+				onAdvanced(gameID)
+			}
+		}
+	}
+}
+
+// advance confirms an empty card purchase for each waiting player, which moves the game into the
+// action phase once the last one is done, and logs each skipped purchase under its player
+func (a *ForceAdvancePhaseAction) advance(ctx context.Context, g *game.Game, waiting []*playerPkg.Player, reason string, log *zap.Logger) error {
+	skipped := make([]string, 0, len(waiting))
+	for _, p := range waiting {
+		if err := a.confirmAction.SkipPurchase(ctx, g.ID(), p.ID()); err != nil {
+			return fmt.Errorf("failed to skip card purchase for %s: %w", p.ID(), err)
+		}
+		skipped = append(skipped, p.ID())
+	}
+
+	description := reason + "; bought no cards"
+	if a.stateRepo != nil {
+		for _, playerID := range skipped {
+			if _, err := a.stateRepo.WriteFull(ctx, g.ID(), g, "Production Phase", game.SourceTypeGameEvent, playerID, description, nil, nil, nil); err != nil {
+				log.Warn("Failed to write log entry", zap.String("player_id", playerID), zap.Error(err))
+			}
+		}
+	}
+
+	log.Info("✅ Production phase advanced", zap.String("reason", reason), zap.Strings("skipped_player_ids", skipped))
+	return nil
+}
+
+// waitingForResearch returns the players who have not confirmed their production phase card purchase
+func waitingForResearch(g *game.Game) []*playerPkg.Player {
+	var waiting []*playerPkg.Player
+	for _, p := range g.GetAllPlayers() {
+		if phase := g.GetProductionPhase(p.ID()); phase != nil && !phase.SelectionComplete {
+			waiting = append(waiting, p)
+		}
+	}
+	return waiting
+}
+
+func playerNames(players []*playerPkg.Player) string {
+	names := make([]string, len(players))
+	for i, p := range players {
+		names[i] = p.Name()
+	}
+	return strings.Join(names, ", ")
+}
@@ -22,8 +22,9 @@ type JoinGameAction struct {
 
 // JoinGameResult contains the result of joining a game
 type JoinGameResult struct {
-	PlayerID string
-	GameDto  dto.GameDto
+	PlayerID   string
+	PlayerName string // The seat's name, which an invite may have chosen over the requested one
+	GameDto    dto.GameDto
 }
 
 // NewJoinGameAction creates a new join game action
@@ -47,6 +48,32 @@ func (a *JoinGameAction) Execute(
 	playerName string,
 	playerID string,
 ) (*JoinGameResult, error) {
+	return a.ExecuteForAccount(ctx, gameID, playerName, playerID, "")
+}
+
+// ExecuteForAccount joins the game and links the seat to an account, so the seat can be found again
+// when the account's data is deleted. accountID is optional; an empty ID leaves any existing link alone
+func (a *JoinGameAction) ExecuteForAccount(
+	ctx context.Context,
+	gameID string,
+	playerName string,
+	playerID string,
+	accountID string,
+) (*JoinGameResult, error) {
+	return a.ExecuteWithInvite(ctx, gameID, playerName, playerID, accountID, "")
+}
+
+// ExecuteWithInvite joins the game, claiming the seat an invite reserved when inviteToken is set
+// The invited player takes the invite's seat name, and the token cannot be used again.
+// Without a token, seats and names held by open invites are unavailable.
+func (a *JoinGameAction) ExecuteWithInvite(
+	ctx context.Context,
+	gameID string,
+	playerName string,
+	playerID string,
+	accountID string,
+	inviteToken string,
+) (*JoinGameResult, error) {
 
 	log := a.logger.With(
 		zap.String("game_id", gameID),
@@ -67,11 +94,15 @@ func (a *JoinGameAction) Execute(
 		// Reconnection case - skip lobby check, just update connection status
 		log.Info("🔄 Player reconnecting", zap.String("player_id", playerID))
 		existingPlayer.SetConnected(true)
+		if accountID != "" {
+			existingPlayer.SetAccountID(accountID)
+		}
 
 		gameDto := dto.ToGameDto(g, a.cardRegistry, playerID)
 		return &JoinGameResult{
-			PlayerID: playerID,
-			GameDto:  gameDto,
+			PlayerID:   playerID,
+			PlayerName: existingPlayer.Name(),
+			GameDto:    gameDto,
 		}, nil
 	}
 
@@ -81,7 +112,21 @@ func (a *JoinGameAction) Execute(
 		return nil, fmt.Errorf("game is not in lobby: %s", g.Status())
 	}
 
-	// 4. Check if player with same name already exists (idempotent join)
+	// 4. An invite's seat name replaces the requested one; other joiners cannot take a reserved name
+	if inviteToken != "" {
+		invite, err := g.Invite(inviteToken)
+		if err != nil {
+			log.Warn("Invite is not open", zap.Error(err))
+			return nil, fmt.Errorf("invite is no longer valid: %w", err)
+		}
+		playerName = invite.SeatName
+		log = log.With(zap.String("seat_name", playerName))
+	} else if g.IsSeatNameReserved(playerName) {
+		log.Warn("Name is reserved by an invite")
+		return nil, fmt.Errorf("the name %s is reserved for an invited player", playerName)
+	}
+
+	// 5. Check if player with same name already exists (idempotent join)
 	existingPlayers := g.GetAllPlayers()
 	for _, p := range existingPlayers {
 		if p.Name() == playerName {
@@ -91,13 +136,14 @@ func (a *JoinGameAction) Execute(
 			// Return the existing game state with personalized view
 			gameDto := dto.ToGameDto(g, a.cardRegistry, p.ID())
 			return &JoinGameResult{
-				PlayerID: p.ID(),
-				GameDto:  gameDto,
+				PlayerID:   p.ID(),
+				PlayerName: p.Name(),
+				GameDto:    gameDto,
 			}, nil
 		}
 	}
 
-	// 5. Check max players only for new players
+	// 6. Check max players only for new players; an invited player's seat is already held for them
 	maxPlayers := g.Settings().MaxPlayers
 	if maxPlayers == 0 {
 		maxPlayers = game.DefaultMaxPlayers
@@ -106,34 +152,64 @@ func (a *JoinGameAction) Execute(
 		log.Error("Game is full", zap.Int("max_players", maxPlayers))
 		return nil, fmt.Errorf("game is full")
 	}
+	if inviteToken == "" && len(existingPlayers)+g.ReservedSeats() >= maxPlayers {
+		log.Warn("Free seats are reserved by invites", zap.Int("reserved_seats", g.ReservedSeats()))
+		return nil, fmt.Errorf("game is full: the remaining seats are reserved for invited players")
+	}
+
+	// 7. Claim the invite so its token cannot seat anyone else; it is reopened if seating fails
+	var claimed *game.Invite
+	if inviteToken != "" {
+		invite, err := g.ClaimInvite(ctx, inviteToken)
+		if err != nil {
+			log.Warn("Failed to claim invite", zap.Error(err))
+			return nil, fmt.Errorf("invite is no longer valid: %w", err)
+		}
+		claimed = &invite
+		log.Info("✉️ Invite claimed")
+	}
+	releaseInvite := func() {
+		if claimed == nil {
+			return
+		}
+		// The join's context may be the reason seating failed, so reopening must not depend on it
+		if err := g.ReleaseInvite(context.Background(), *claimed); err != nil {
+			log.Error("Failed to reopen invite", zap.Error(err))
+			return
+		}
+		log.Info("✉️ Invite reopened after failed join")
+	}
 
-	// 6. Create new player (using Game's EventBus for automatic broadcasting)
+	// 8. Create new player (using Game's EventBus for automatic broadcasting)
 	newPlayer := playerPkg.NewPlayer(g.EventBus(), gameID, playerID, playerName)
+	newPlayer.SetAccountID(accountID)
 	log.Info("✅ New player created", zap.String("player_id", newPlayer.ID()))
 
-	// 7. Check if this will be the first player (before adding)
+	// 9. Check if this will be the first player (before adding)
 	isFirstPlayer := len(existingPlayers) == 0
 
-	// 8. If first player, set as host BEFORE adding (so auto-broadcast includes hostPlayerID)
+	// 10. If first player, set as host BEFORE adding (so auto-broadcast includes hostPlayerID)
 	if isFirstPlayer {
 		err = g.SetHostPlayerID(ctx, newPlayer.ID())
 		if err != nil {
 			log.Error("Failed to set host player", zap.Error(err))
+			releaseInvite()
 			return nil, fmt.Errorf("failed to set host player: %w", err)
 		}
 		log.Info("👑 Player set as host")
 	}
 
-	// 9. Add player to game (publishes PlayerJoinedEvent which auto-broadcasts)
+	// 11. Add player to game (publishes PlayerJoinedEvent which auto-broadcasts)
 	err = g.AddPlayer(ctx, newPlayer)
 	if err != nil {
 		log.Error("Failed to add player to game", zap.Error(err))
+		releaseInvite()
 		return nil, fmt.Errorf("failed to add player to game: %w", err)
 	}
 
 	log.Info("✅ Player added to game")
 
-	// 10. Convert to DTO with personalized view for the joining player
+	// 12. Convert to DTO with personalized view for the joining player
 	gameDto := dto.ToGameDto(g, a.cardRegistry, newPlayer.ID())
 
 	// Note: Broadcasting handled automatically via PlayerJoinedEvent
@@ -141,7 +217,8 @@ func (a *JoinGameAction) Execute(
 
 	log.Info("🎉 Player joined game successfully")
 	return &JoinGameResult{
-		PlayerID: newPlayer.ID(),
-		GameDto:  gameDto,
+		PlayerID:   newPlayer.ID(),
+		PlayerName: newPlayer.Name(),
+		GameDto:    gameDto,
 	}, nil
 }
@@ -0,0 +1,52 @@
+package game
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"terraforming-mars-backend/internal/game"
+)
+
+// ListInvitesAction shows the host the lobby's open invites
+type ListInvitesAction struct {
+	gameRepo game.GameRepository
+	logger   *zap.Logger
+}
+
+// NewListInvitesAction creates a new list invites action
+func NewListInvitesAction(
+	gameRepo game.GameRepository,
+	logger *zap.Logger,
+) *ListInvitesAction {
+	return &ListInvitesAction{
+		gameRepo: gameRepo,
+		logger:   logger,
+	}
+}
+
+// Execute returns the open invites, oldest first
+func (a *ListInvitesAction) Execute(ctx context.Context, gameID string, playerID string) ([]game.Invite, error) {
+	log := a.logger.With(
+		zap.String("game_id", gameID),
+		zap.String("player_id", playerID),
+		zap.String("action", "list_invites"),
+	)
+
+	g, err := requireLobbyHost(ctx, a.gameRepo, gameID, playerID)
+	if err != nil {
+		log.Warn("Cannot list invites", zap.Error(err))
+		return nil, err
+	}
+	return g.Invites(), nil
+}
+
+// GetInvite looks up an open invite by its token, for the join page to show the reserved seat
+// Holding the token is what entitles the caller to the seat, so no host check applies.
+func (a *ListInvitesAction) GetInvite(ctx context.Context, gameID string, token string) (game.Invite, error) {
+	g, err := a.gameRepo.Get(ctx, gameID)
+	if err != nil {
+		return game.Invite{}, err
+	}
+	return g.Invite(token)
+}
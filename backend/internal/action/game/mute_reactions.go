@@ -0,0 +1,68 @@
+package game
+
+import (
+	"context"
+	"fmt"
+
+	"go.uber.org/zap"
+
+	"terraforming-mars-backend/internal/game"
+)
+
+// MuteReactionsAction lets a player hide reactions from one other player, or from everyone
+type MuteReactionsAction struct {
+	gameRepo game.GameRepository
+	logger   *zap.Logger
+}
+
+// NewMuteReactionsAction creates a new mute reactions action
+func NewMuteReactionsAction(
+	gameRepo game.GameRepository,
+	logger *zap.Logger,
+) *MuteReactionsAction {
+	return &MuteReactionsAction{
+		gameRepo: gameRepo,
+		logger:   logger,
+	}
+}
+
+// Execute mutes or unmutes targetPlayerID's reactions for playerID; an empty target applies to everyone
+func (a *MuteReactionsAction) Execute(ctx context.Context, gameID string, playerID string, targetPlayerID string, muted bool) error {
+	log := a.logger.With(
+		zap.String("game_id", gameID),
+		zap.String("player_id", playerID),
+		zap.String("target_player_id", targetPlayerID),
+		zap.String("action", "mute_reactions"),
+	)
+	log.Info("🔇 Setting reaction mute", zap.Bool("muted", muted))
+
+	g, err := a.gameRepo.Get(ctx, gameID)
+	if err != nil {
+		log.Error("Failed to get game", zap.Error(err))
+		return fmt.Errorf("game not found: %s", gameID)
+	}
+
+	p, err := g.GetPlayer(playerID)
+	if err != nil {
+		log.Warn("Player not found in game")
+		return fmt.Errorf("player not found: %s", playerID)
+	}
+
+	if targetPlayerID == "" {
+		p.SetReactionsMuted(muted)
+		log.Info("✅ Reaction mute set for every player")
+		return nil
+	}
+
+	if targetPlayerID == playerID {
+		return fmt.Errorf("cannot mute your own reactions")
+	}
+	if _, err := g.GetPlayer(targetPlayerID); err != nil {
+		log.Warn("Target player not found in game")
+		return fmt.Errorf("player not found: %s", targetPlayerID)
+	}
+
+	p.SetPlayerMuted(targetPlayerID, muted)
+	log.Info("✅ Reaction mute set")
+	return nil
+}
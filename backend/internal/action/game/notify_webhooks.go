@@ -0,0 +1,174 @@
+package game
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"terraforming-mars-backend/internal/game"
+	"terraforming-mars-backend/internal/webhook"
+)
+
+// NotifyWebhooksAction tells registered webhooks when public games are created, start and finish
+// Games are compared against the statuses seen on the previous run, so each event is sent once per game.
+// Pass-and-play, demo, tutorial and development games are never announced.
+type NotifyWebhooksAction struct {
+	gameRepo  game.GameRepository
+	sender    *webhook.Sender
+	publicURL string // Base URL players open the site at; lobby join links are left out without it
+	logger    *zap.Logger
+
+	mu   sync.Mutex
+	seen map[string]game.GameStatus
+}
+
+// NewNotifyWebhooksAction creates a new notify webhooks action
+func NewNotifyWebhooksAction(
+	gameRepo game.GameRepository,
+	sender *webhook.Sender,
+	publicURL string,
+	logger *zap.Logger,
+) *NotifyWebhooksAction {
+	return &NotifyWebhooksAction{
+		gameRepo:  gameRepo,
+		sender:    sender,
+		publicURL: strings.TrimSuffix(publicURL, "/"),
+		logger:    logger,
+		seen:      make(map[string]game.GameStatus),
+	}
+}
+
+// Notify queues a payload for every lifecycle change since the last run and returns them
+// A game first seen already running is announced as started, and one first seen completed as finished
+func (a *NotifyWebhooksAction) Notify(ctx context.Context, now time.Time) []webhook.Payload {
+	log := a.logger.With(zap.String("action", "notify_webhooks"))
+
+	games, err := a.gameRepo.List(ctx, nil)
+	if err != nil {
+		log.Error("Failed to list games", zap.Error(err))
+		return nil
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	present := make(map[string]bool, len(games))
+	payloads := make([]webhook.Payload, 0)
+	for _, g := range games {
+		present[g.ID()] = true
+		if !g.Settings().IsPublic() {
+			continue
+		}
+
+		status := g.Status()
+		previous, known := a.seen[g.ID()]
+		a.seen[g.ID()] = status
+
+		var event webhook.Event
+		switch {
+		case status == game.GameStatusLobby && !known:
+			event = webhook.EventGameCreated
+		case status == game.GameStatusActive && (!known || previous == game.GameStatusLobby):
+			event = webhook.EventGameStarted
+		case status == game.GameStatusCompleted && previous != game.GameStatusCompleted:
+			event = webhook.EventGameFinished
+		default:
+			continue
+		}
+
+		payload := a.buildPayload(g, event, now)
+		a.sender.Enqueue(payload)
+		payloads = append(payloads, payload)
+		log.Info("🪝 Game lifecycle webhook queued",
+			zap.String("game_id", g.ID()),
+			zap.String("event", string(event)))
+	}
+
+	for gameID := range a.seen {
+		if !present[gameID] {
+			delete(a.seen, gameID)
+		}
+	}
+	return payloads
+}
+
+// Monitor sends lifecycle webhooks on each tick
+func (a *NotifyWebhooksAction) Monitor(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			a.Notify(ctx, now)
+		}
+	}
+}
+
+func (a *NotifyWebhooksAction) buildPayload(g *game.Game, event webhook.Event, now time.Time) webhook.Payload {
+	settings := g.Settings()
+	summary := webhook.GameSummary{
+		ID:         g.ID(),
+		Status:     string(g.Status()),
+		Generation: g.Generation(),
+		MaxPlayers: settings.MaxPlayers,
+		CardPacks:  settings.CardPacks,
+		Players:    make([]string, 0),
+		IsTie:      g.IsTie(),
+	}
+	for _, p := range g.GetAllPlayers() {
+		summary.Players = append(summary.Players, p.Name())
+	}
+	if event == webhook.EventGameCreated && a.publicURL != "" {
+		summary.JoinURL = a.publicURL + "/join?code=" + url.QueryEscape(g.ID())
+	}
+
+	payload := webhook.Payload{Event: event, Timestamp: now, Game: summary}
+	if event == webhook.EventGameFinished {
+		for _, score := range g.GetFinalScores() {
+			payload.Results = append(payload.Results, webhook.PlayerScore{
+				PlayerName: score.PlayerName,
+				TotalVP:    score.Breakdown.TotalVP,
+				Placement:  score.Placement,
+				IsWinner:   score.IsWinner,
+			})
+			if score.PlayerID == g.GetWinnerID() {
+				payload.Game.WinnerName = score.PlayerName
+			}
+		}
+	}
+	payload.Summary = summarize(payload)
+	return payload
+}
+
+// summarize writes the one-line message posted to chat channels
+func summarize(payload webhook.Payload) string {
+	summary := payload.Game
+	switch payload.Event {
+	case webhook.EventGameCreated:
+		line := fmt.Sprintf("🪐 New lobby open: %d/%d players, packs %s", len(summary.Players), summary.MaxPlayers, strings.Join(summary.CardPacks, ", "))
+		if summary.JoinURL != "" {
+			line += " — join at " + summary.JoinURL
+		}
+		return line
+	case webhook.EventGameStarted:
+		return fmt.Sprintf("🚀 Game started with %s", strings.Join(summary.Players, ", "))
+	default:
+		scores := make([]string, 0, len(payload.Results))
+		for _, result := range payload.Results {
+			scores = append(scores, fmt.Sprintf("%d. %s %d VP", result.Placement, result.PlayerName, result.TotalVP))
+		}
+		outcome := "tie"
+		if !summary.IsTie && summary.WinnerName != "" {
+			outcome = summary.WinnerName + " wins"
+		}
+		return fmt.Sprintf("🏁 Game finished after generation %d, %s: %s", summary.Generation, outcome, strings.Join(scores, ", "))
+	}
+}
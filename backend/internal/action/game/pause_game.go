@@ -0,0 +1,94 @@
+package game
+
+import (
+	"context"
+	"fmt"
+
+	"go.uber.org/zap"
+
+	"terraforming-mars-backend/internal/game"
+)
+
+// PauseGameAction pauses an active game
+// The host pauses immediately; any other player casts a vote, and the game pauses once every
+// connected player has voted
+type PauseGameAction struct {
+	gameRepo game.GameRepository
+	logger   *zap.Logger
+}
+
+// NewPauseGameAction creates a new pause game action
+func NewPauseGameAction(
+	gameRepo game.GameRepository,
+	logger *zap.Logger,
+) *PauseGameAction {
+	return &PauseGameAction{
+		gameRepo: gameRepo,
+		logger:   logger,
+	}
+}
+
+// Execute performs the pause game action
+func (a *PauseGameAction) Execute(ctx context.Context, gameID string, playerID string) error {
+	log := a.logger.With(
+		zap.String("game_id", gameID),
+		zap.String("player_id", playerID),
+		zap.String("action", "pause_game"),
+	)
+	log.Info("⏸️ Requesting pause")
+
+	g, err := a.gameRepo.Get(ctx, gameID)
+	if err != nil {
+		log.Error("Failed to get game", zap.Error(err))
+		return fmt.Errorf("game not found: %s", gameID)
+	}
+
+	if g.Status() != game.GameStatusActive {
+		log.Warn("Game is not active", zap.String("status", string(g.Status())))
+		return fmt.Errorf("game is not active: %s", g.Status())
+	}
+
+	if g.IsPaused() {
+		log.Warn("Game is already paused")
+		return fmt.Errorf("game is already paused")
+	}
+
+	if g.HostPlayerID() != playerID {
+		if err := g.AddPauseVote(ctx, playerID); err != nil {
+			log.Warn("Failed to record pause vote", zap.Error(err))
+			return fmt.Errorf("failed to vote: %w", err)
+		}
+		if !votesAreUnanimous(g) {
+			log.Info("🗳️ Pause vote recorded", zap.Strings("votes", g.PauseVotes()))
+			return nil
+		}
+	}
+
+	if err := g.Pause(ctx, playerID); err != nil {
+		log.Error("Failed to pause game", zap.Error(err))
+		return fmt.Errorf("failed to pause game: %w", err)
+	}
+
+	log.Info("✅ Game paused")
+	return nil
+}
+
+// votesAreUnanimous reports whether every connected player has voted to toggle the pause
+func votesAreUnanimous(g *game.Game) bool {
+	votes := make(map[string]bool)
+	for _, playerID := range g.PauseVotes() {
+		votes[playerID] = true
+	}
+
+	connected := 0
+	for _, p := range g.GetAllPlayers() {
+		if !p.IsConnected() {
+			continue
+		}
+		connected++
+		if !votes[p.ID()] {
+			return false
+		}
+	}
+	return connected > 0
+}
@@ -0,0 +1,69 @@
+package game
+
+import (
+	"context"
+	"fmt"
+
+	"go.uber.org/zap"
+
+	"terraforming-mars-backend/internal/game"
+)
+
+// ResumeGameAction resumes a paused game
+// The host resumes immediately; any other player casts a vote, and the game resumes once every
+// connected player has voted
+type ResumeGameAction struct {
+	gameRepo game.GameRepository
+	logger   *zap.Logger
+}
+
+// NewResumeGameAction creates a new resume game action
+func NewResumeGameAction(
+	gameRepo game.GameRepository,
+	logger *zap.Logger,
+) *ResumeGameAction {
+	return &ResumeGameAction{
+		gameRepo: gameRepo,
+		logger:   logger,
+	}
+}
+
+// Execute performs the resume game action
+func (a *ResumeGameAction) Execute(ctx context.Context, gameID string, playerID string) error {
+	log := a.logger.With(
+		zap.String("game_id", gameID),
+		zap.String("player_id", playerID),
+		zap.String("action", "resume_game"),
+	)
+	log.Info("▶️ Requesting resume")
+
+	g, err := a.gameRepo.Get(ctx, gameID)
+	if err != nil {
+		log.Error("Failed to get game", zap.Error(err))
+		return fmt.Errorf("game not found: %s", gameID)
+	}
+
+	if !g.IsPaused() {
+		log.Warn("Game is not paused")
+		return fmt.Errorf("game is not paused")
+	}
+
+	if g.HostPlayerID() != playerID {
+		if err := g.AddPauseVote(ctx, playerID); err != nil {
+			log.Warn("Failed to record resume vote", zap.Error(err))
+			return fmt.Errorf("failed to vote: %w", err)
+		}
+		if !votesAreUnanimous(g) {
+			log.Info("🗳️ Resume vote recorded", zap.Strings("votes", g.PauseVotes()))
+			return nil
+		}
+	}
+
+	if err := g.Resume(ctx); err != nil {
+		log.Error("Failed to resume game", zap.Error(err))
+		return fmt.Errorf("failed to resume game: %w", err)
+	}
+
+	log.Info("✅ Game resumed")
+	return nil
+}
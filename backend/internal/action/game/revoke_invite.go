@@ -0,0 +1,49 @@
+package game
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"terraforming-mars-backend/internal/game"
+)
+
+// RevokeInviteAction lets the host withdraw an open invite, freeing its seat
+type RevokeInviteAction struct {
+	gameRepo game.GameRepository
+	logger   *zap.Logger
+}
+
+// NewRevokeInviteAction creates a new revoke invite action
+func NewRevokeInviteAction(
+	gameRepo game.GameRepository,
+	logger *zap.Logger,
+) *RevokeInviteAction {
+	return &RevokeInviteAction{
+		gameRepo: gameRepo,
+		logger:   logger,
+	}
+}
+
+// Execute revokes the invite; tokens that are not open return game.ErrInviteNotFound
+func (a *RevokeInviteAction) Execute(ctx context.Context, gameID string, playerID string, token string) error {
+	log := a.logger.With(
+		zap.String("game_id", gameID),
+		zap.String("player_id", playerID),
+		zap.String("action", "revoke_invite"),
+	)
+	log.Info("✉️ Revoking invite")
+
+	g, err := requireLobbyHost(ctx, a.gameRepo, gameID, playerID)
+	if err != nil {
+		log.Warn("Cannot revoke invite", zap.Error(err))
+		return err
+	}
+	if err := g.RevokeInvite(ctx, token); err != nil {
+		log.Warn("Failed to revoke invite", zap.Error(err))
+		return err
+	}
+
+	log.Info("✅ Invite revoked")
+	return nil
+}
@@ -0,0 +1,120 @@
+package game
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"go.uber.org/zap"
+
+	baseaction "terraforming-mars-backend/internal/action"
+	"terraforming-mars-backend/internal/game"
+)
+
+// MaxBatchSteps is the most actions one batch may carry
+const MaxBatchSteps = 10
+
+// ErrBatchStepFailed is returned when a batch stopped at a failing step and was rolled back
+var ErrBatchStepFailed = errors.New("batch step failed")
+
+// BatchStepStatus tells what became of one step of a batch
+type BatchStepStatus string
+
+const (
+	BatchStepSucceeded BatchStepStatus = "succeeded" // Ran; kept only if the whole batch succeeded
+	BatchStepFailed    BatchStepStatus = "failed"    // Ran and failed; the batch stopped here
+	BatchStepSkipped   BatchStepStatus = "skipped"   // Never ran because an earlier step failed
+)
+
+// BatchStep is one action of a batch; Run performs it for the batch's player
+type BatchStep struct {
+	Type string
+	Run  func(ctx context.Context) error
+}
+
+// BatchStepResult is the outcome of one step
+type BatchStepResult struct {
+	Type   string
+	Status BatchStepStatus
+	Err    error
+}
+
+// RunBatchAction runs an ordered batch of a player's actions as one unit, for bots and scripted clients
+// Steps run in order and the batch stops at the first failure; then every change made by the earlier
+// steps is rolled back and their game log entries are dropped, so the game is left as it was
+type RunBatchAction struct {
+	gameRepo  game.GameRepository
+	stateRepo game.GameStateRepository
+	logger    *zap.Logger
+}
+
+// NewRunBatchAction creates a new run batch action
+func NewRunBatchAction(
+	gameRepo game.GameRepository,
+	stateRepo game.GameStateRepository,
+	logger *zap.Logger,
+) *RunBatchAction {
+	return &RunBatchAction{
+		gameRepo:  gameRepo,
+		stateRepo: stateRepo,
+		logger:    logger,
+	}
+}
+
+// Execute runs steps in order and returns one result per step
+// The error is ErrBatchStepFailed (wrapping the step's error) when a step failed and the batch was rolled back,
+// or a validation error when no step ran
+func (a *RunBatchAction) Execute(ctx context.Context, gameID string, playerID string, steps []BatchStep) ([]BatchStepResult, error) {
+	log := a.logger.With(
+		zap.String("game_id", gameID),
+		zap.String("player_id", playerID),
+		zap.String("action", "run_batch"),
+		zap.Int("steps", len(steps)),
+	)
+	log.Info("📦 Running action batch")
+
+	if len(steps) == 0 || len(steps) > MaxBatchSteps {
+		return nil, fmt.Errorf("batch must have between 1 and %d steps", MaxBatchSteps)
+	}
+
+	g, err := baseaction.ValidateActiveGame(ctx, a.gameRepo, gameID, log)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := g.GetPlayer(playerID); err != nil {
+		log.Warn("Player not found in game")
+		return nil, fmt.Errorf("player not found: %s", playerID)
+	}
+
+	results := make([]BatchStepResult, len(steps))
+	for i, step := range steps {
+		results[i] = BatchStepResult{Type: step.Type, Status: BatchStepSkipped}
+	}
+
+	var logCheckpoint game.LogCheckpoint
+	if a.stateRepo != nil {
+		logCheckpoint = a.stateRepo.Checkpoint(gameID)
+	}
+
+	err = baseaction.RunInTransaction(ctx, g, log, func() error {
+		for i, step := range steps {
+			if err := step.Run(ctx); err != nil {
+				results[i].Status = BatchStepFailed
+				results[i].Err = err
+				log.Warn("Batch step failed", zap.Int("step", i), zap.String("type", step.Type), zap.Error(err))
+				return fmt.Errorf("%w: step %d (%s): %w", ErrBatchStepFailed, i+1, step.Type, err)
+			}
+			results[i].Status = BatchStepSucceeded
+		}
+		return nil
+	})
+	if err != nil {
+		if a.stateRepo != nil {
+			a.stateRepo.Rewind(logCheckpoint)
+		}
+		return results, err
+	}
+
+	log.Info("✅ Action batch completed")
+	return results, nil
+}
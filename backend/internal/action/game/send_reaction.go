@@ -0,0 +1,135 @@
+package game
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"terraforming-mars-backend/internal/game"
+)
+
+// Reactions are rate limited per player: at most ReactionBurst within ReactionWindow
+const (
+	ReactionBurst  = 3
+	ReactionWindow = 10 * time.Second
+)
+
+// ErrReactionRateLimited is returned when a player sends reactions faster than the limit
+var ErrReactionRateLimited = errors.New("sending reactions too fast")
+
+// SentReaction is a reaction accepted for broadcast to the table
+type SentReaction struct {
+	PlayerID   string
+	PlayerName string
+	Reaction   game.Reaction
+	SentAt     time.Time
+}
+
+// SendReactionAction lets a seated player send a canned reaction to the table
+// Each reaction is recorded in the game log; who sees it live is up to each player's mutes.
+type SendReactionAction struct {
+	gameRepo  game.GameRepository
+	stateRepo game.GameStateRepository
+	logger    *zap.Logger
+
+	mu     sync.Mutex
+	recent map[string][]time.Time // gameID/playerID -> send times within the window
+}
+
+// NewSendReactionAction creates a new send reaction action
+func NewSendReactionAction(
+	gameRepo game.GameRepository,
+	stateRepo game.GameStateRepository,
+	logger *zap.Logger,
+) *SendReactionAction {
+	return &SendReactionAction{
+		gameRepo:  gameRepo,
+		stateRepo: stateRepo,
+		logger:    logger,
+		recent:    make(map[string][]time.Time),
+	}
+}
+
+// Execute validates, rate limits and logs the reaction
+func (a *SendReactionAction) Execute(ctx context.Context, gameID string, playerID string, reaction game.Reaction) (*SentReaction, error) {
+	log := a.logger.With(
+		zap.String("game_id", gameID),
+		zap.String("player_id", playerID),
+		zap.String("action", "send_reaction"),
+		zap.String("reaction", string(reaction)),
+	)
+	log.Debug("💬 Sending reaction")
+
+	label, err := reaction.Label()
+	if err != nil {
+		log.Warn("Unknown reaction")
+		return nil, fmt.Errorf("%w: %s", err, reaction)
+	}
+
+	g, err := a.gameRepo.Get(ctx, gameID)
+	if err != nil {
+		log.Error("Failed to get game", zap.Error(err))
+		return nil, fmt.Errorf("game not found: %s", gameID)
+	}
+
+	if g.Status() != game.GameStatusLobby && g.Status() != game.GameStatusActive {
+		log.Warn("Game has ended", zap.String("status", string(g.Status())))
+		return nil, fmt.Errorf("game has ended: %s", g.Status())
+	}
+
+	p, err := g.GetPlayer(playerID)
+	if err != nil {
+		log.Warn("Player not found in game")
+		return nil, fmt.Errorf("player not found: %s", playerID)
+	}
+
+	now := time.Now()
+	if !a.allow(gameID+"/"+playerID, now) {
+		log.Warn("Reaction rate limited")
+		return nil, ErrReactionRateLimited
+	}
+
+	if _, err := a.stateRepo.WriteFull(ctx, gameID, g, "Reaction", game.SourceTypeReaction, playerID, "Reacted "+label, nil, nil, nil); err != nil {
+		log.Error("Failed to log reaction", zap.Error(err))
+		return nil, fmt.Errorf("failed to log reaction: %w", err)
+	}
+
+	return &SentReaction{
+		PlayerID:   playerID,
+		PlayerName: p.Name(),
+		Reaction:   reaction,
+		SentAt:     now,
+	}, nil
+}
+
+// allow records a send for the key unless it already sent ReactionBurst within the window
+// Keys whose sends have all left the window are dropped, so finished games leave nothing behind
+func (a *SendReactionAction) allow(key string, now time.Time) bool {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	cutoff := now.Add(-ReactionWindow)
+	for k, times := range a.recent {
+		kept := times[:0]
+		for _, t := range times {
+			if t.After(cutoff) {
+				kept = append(kept, t)
+			}
+		}
+		if len(kept) == 0 {
+			delete(a.recent, k)
+		} else {
+			a.recent[k] = kept
+		}
+	}
+
+	if len(a.recent[key]) >= ReactionBurst {
+		return false
+	}
+	a.recent[key] = append(a.recent[key], now)
+	return true
+}
@@ -0,0 +1,72 @@
+package game
+
+import (
+	"context"
+	"fmt"
+
+	"go.uber.org/zap"
+
+	"terraforming-mars-backend/internal/game"
+)
+
+// SetHandicapAction lets the host configure a per-seat starting bonus in the lobby
+type SetHandicapAction struct {
+	gameRepo game.GameRepository
+	logger   *zap.Logger
+}
+
+// NewSetHandicapAction creates a new set handicap action
+func NewSetHandicapAction(
+	gameRepo game.GameRepository,
+	logger *zap.Logger,
+) *SetHandicapAction {
+	return &SetHandicapAction{
+		gameRepo: gameRepo,
+		logger:   logger,
+	}
+}
+
+// Execute performs the set handicap action
+// A zero handicap clears the target player's bonus
+func (a *SetHandicapAction) Execute(
+	ctx context.Context,
+	gameID string,
+	playerID string,
+	targetPlayerID string,
+	handicap game.Handicap,
+) error {
+	log := a.logger.With(
+		zap.String("game_id", gameID),
+		zap.String("player_id", playerID),
+		zap.String("target_player_id", targetPlayerID),
+		zap.String("action", "set_handicap"),
+	)
+	log.Info("⚖️ Setting handicap",
+		zap.Int("extra_credits", handicap.ExtraCredits),
+		zap.Int("extra_cards", handicap.ExtraCards),
+		zap.Int("extra_tr", handicap.ExtraTR))
+
+	g, err := a.gameRepo.Get(ctx, gameID)
+	if err != nil {
+		log.Error("Failed to load game for handicap", zap.Error(err))
+		return fmt.Errorf("game not found: %s", gameID)
+	}
+
+	if g.Status() != game.GameStatusLobby {
+		log.Warn("Handicaps can only change in the lobby", zap.String("status", string(g.Status())))
+		return fmt.Errorf("game is not in lobby: %s", g.Status())
+	}
+
+	if g.HostPlayerID() != playerID {
+		log.Warn("Only host can set handicaps", zap.String("host_id", g.HostPlayerID()))
+		return fmt.Errorf("only host can set handicaps")
+	}
+
+	if err := g.SetHandicap(ctx, targetPlayerID, handicap); err != nil {
+		log.Warn("Handicap rejected", zap.Error(err))
+		return fmt.Errorf("invalid handicap: %w", err)
+	}
+
+	log.Info("✅ Handicap set", zap.Bool("cleared", handicap.IsZero()))
+	return nil
+}
@@ -0,0 +1,65 @@
+package game
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"go.uber.org/zap"
+
+	"terraforming-mars-backend/internal/game"
+)
+
+// MaxNoteLength is the longest private note a player may keep, in characters
+const MaxNoteLength = 2000
+
+// SetNoteAction stores a player's private note for a game, e.g. plans for an async game spanning days
+// The note changes nothing in the game and is never sent to other players or written to the game log
+type SetNoteAction struct {
+	gameRepo game.GameRepository
+	logger   *zap.Logger
+}
+
+// NewSetNoteAction creates a new set note action
+func NewSetNoteAction(
+	gameRepo game.GameRepository,
+	logger *zap.Logger,
+) *SetNoteAction {
+	return &SetNoteAction{
+		gameRepo: gameRepo,
+		logger:   logger,
+	}
+}
+
+// Execute replaces playerID's note; an empty or blank note clears it
+func (a *SetNoteAction) Execute(ctx context.Context, gameID string, playerID string, note string) error {
+	log := a.logger.With(
+		zap.String("game_id", gameID),
+		zap.String("player_id", playerID),
+		zap.String("action", "set_note"),
+	)
+
+	note = strings.TrimSpace(note)
+	if length := utf8.RuneCountInString(note); length > MaxNoteLength {
+		log.Warn("Note too long", zap.Int("length", length))
+		return fmt.Errorf("note is too long (max %d characters)", MaxNoteLength)
+	}
+
+	g, err := a.gameRepo.Get(ctx, gameID)
+	if err != nil {
+		log.Error("Failed to get game", zap.Error(err))
+		return fmt.Errorf("game not found: %s", gameID)
+	}
+
+	p, err := g.GetPlayer(playerID)
+	if err != nil {
+		log.Warn("Player not found in game")
+		return fmt.Errorf("player not found: %s", playerID)
+	}
+
+	p.SetNote(note)
+	// The note's text is private, so only its size is logged
+	log.Info("📝 Note saved", zap.Int("length", utf8.RuneCountInString(note)))
+	return nil
+}
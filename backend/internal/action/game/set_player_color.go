@@ -0,0 +1,64 @@
+package game
+
+import (
+	"context"
+	"fmt"
+
+	"go.uber.org/zap"
+
+	"terraforming-mars-backend/internal/game"
+)
+
+// SetPlayerColorAction lets the host override the color assigned to a player at join time
+type SetPlayerColorAction struct {
+	gameRepo game.GameRepository
+	logger   *zap.Logger
+}
+
+// NewSetPlayerColorAction creates a new set player color action
+func NewSetPlayerColorAction(
+	gameRepo game.GameRepository,
+	logger *zap.Logger,
+) *SetPlayerColorAction {
+	return &SetPlayerColorAction{
+		gameRepo: gameRepo,
+		logger:   logger,
+	}
+}
+
+// Execute performs the set player color action
+// The color must come from the game's palette and not be held by another player
+func (a *SetPlayerColorAction) Execute(
+	ctx context.Context,
+	gameID string,
+	playerID string,
+	targetPlayerID string,
+	color string,
+) error {
+	log := a.logger.With(
+		zap.String("game_id", gameID),
+		zap.String("player_id", playerID),
+		zap.String("target_player_id", targetPlayerID),
+		zap.String("action", "set_player_color"),
+	)
+	log.Info("🎨 Setting player color", zap.String("color", color))
+
+	g, err := a.gameRepo.Get(ctx, gameID)
+	if err != nil {
+		log.Error("Failed to get game", zap.Error(err))
+		return fmt.Errorf("game not found: %s", gameID)
+	}
+
+	if g.HostPlayerID() != playerID {
+		log.Warn("Only host can set player colors", zap.String("host_id", g.HostPlayerID()))
+		return fmt.Errorf("only host can set player colors")
+	}
+
+	if err := g.SetPlayerColor(ctx, targetPlayerID, color); err != nil {
+		log.Warn("Invalid player color", zap.Error(err))
+		return fmt.Errorf("invalid player color: %w", err)
+	}
+
+	log.Info("✅ Player color set")
+	return nil
+}
@@ -0,0 +1,89 @@
+package game
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+
+	"terraforming-mars-backend/internal/events"
+	"terraforming-mars-backend/internal/game"
+	"terraforming-mars-backend/internal/i18n"
+)
+
+// PlayerPreferences are the per-game settings a player controls for their own seat
+type PlayerPreferences struct {
+	AutoPass bool   // Pass automatically when a turn starts with no legal action
+	Locale   string // Language of server messages; empty keeps the current one
+}
+
+// SetPreferencesAction stores a player's preferences for one game
+type SetPreferencesAction struct {
+	gameRepo game.GameRepository
+	logger   *zap.Logger
+}
+
+// NewSetPreferencesAction creates a new set preferences action
+func NewSetPreferencesAction(
+	gameRepo game.GameRepository,
+	logger *zap.Logger,
+) *SetPreferencesAction {
+	return &SetPreferencesAction{
+		gameRepo: gameRepo,
+		logger:   logger,
+	}
+}
+
+// Execute performs the set preferences action
+func (a *SetPreferencesAction) Execute(ctx context.Context, gameID string, playerID string, preferences PlayerPreferences) error {
+	log := a.logger.With(
+		zap.String("game_id", gameID),
+		zap.String("player_id", playerID),
+		zap.String("action", "set_preferences"),
+	)
+	log.Info("⚙️ Setting player preferences",
+		zap.Bool("auto_pass", preferences.AutoPass),
+		zap.String("locale", preferences.Locale))
+
+	locale := ""
+	if preferences.Locale != "" {
+		locale = i18n.Normalize(preferences.Locale)
+		if locale == "" {
+			log.Warn("Unsupported locale", zap.String("locale", preferences.Locale))
+			return fmt.Errorf("unsupported locale: %s", preferences.Locale)
+		}
+	}
+
+	g, err := a.gameRepo.Get(ctx, gameID)
+	if err != nil {
+		log.Error("Failed to get game", zap.Error(err))
+		return fmt.Errorf("game not found: %s", gameID)
+	}
+
+	if g.Status() != game.GameStatusLobby && g.Status() != game.GameStatusActive {
+		log.Warn("Game has ended", zap.String("status", string(g.Status())))
+		return fmt.Errorf("game has ended: %s", g.Status())
+	}
+
+	p, err := g.GetPlayer(playerID)
+	if err != nil {
+		log.Warn("Player not found in game")
+		return fmt.Errorf("player not found: %s", playerID)
+	}
+
+	p.SetAutoPass(preferences.AutoPass)
+	if locale != "" {
+		p.SetLocale(locale)
+	}
+
+	if eventBus := g.EventBus(); eventBus != nil {
+		events.Publish(eventBus, events.GameStateChangedEvent{
+			GameID:    g.ID(),
+			Timestamp: time.Now(),
+		})
+	}
+
+	log.Info("✅ Player preferences set")
+	return nil
+}
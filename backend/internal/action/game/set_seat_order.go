@@ -0,0 +1,78 @@
+package game
+
+import (
+	"context"
+	"fmt"
+
+	"go.uber.org/zap"
+
+	"terraforming-mars-backend/internal/game"
+)
+
+// SetSeatOrderAction lets the host arrange lobby seating or request random seating at game start
+type SetSeatOrderAction struct {
+	gameRepo game.GameRepository
+	logger   *zap.Logger
+}
+
+// NewSetSeatOrderAction creates a new set seat order action
+func NewSetSeatOrderAction(
+	gameRepo game.GameRepository,
+	logger *zap.Logger,
+) *SetSeatOrderAction {
+	return &SetSeatOrderAction{
+		gameRepo: gameRepo,
+		logger:   logger,
+	}
+}
+
+// Execute performs the set seat order action
+// When randomize is true the current seating is kept and shuffled at game start,
+// otherwise seatOrder becomes the turn order used when the game starts
+func (a *SetSeatOrderAction) Execute(
+	ctx context.Context,
+	gameID string,
+	playerID string,
+	seatOrder []string,
+	randomize bool,
+) error {
+	log := a.logger.With(
+		zap.String("game_id", gameID),
+		zap.String("player_id", playerID),
+		zap.String("action", "set_seat_order"),
+	)
+	log.Info("💺 Setting seat order", zap.Strings("seat_order", seatOrder), zap.Bool("randomize", randomize))
+
+	g, err := a.gameRepo.Get(ctx, gameID)
+	if err != nil {
+		log.Error("Failed to get game", zap.Error(err))
+		return fmt.Errorf("game not found: %s", gameID)
+	}
+
+	if g.Status() != game.GameStatusLobby {
+		log.Warn("Game is not in lobby", zap.String("status", string(g.Status())))
+		return fmt.Errorf("game is not in lobby: %s", g.Status())
+	}
+
+	if g.HostPlayerID() != playerID {
+		log.Warn("Only host can arrange seats", zap.String("host_id", g.HostPlayerID()))
+		return fmt.Errorf("only host can arrange seats")
+	}
+
+	if randomize {
+		if err := g.SetRandomizeSeatOrder(ctx, true); err != nil {
+			log.Error("Failed to enable seat randomization", zap.Error(err))
+			return fmt.Errorf("failed to enable seat randomization: %w", err)
+		}
+		log.Info("🎲 Seats will be randomized at game start")
+		return nil
+	}
+
+	if err := g.SetSeatOrder(ctx, seatOrder); err != nil {
+		log.Warn("Invalid seat order", zap.Error(err))
+		return fmt.Errorf("invalid seat order: %w", err)
+	}
+
+	log.Info("✅ Seat order arranged by host")
+	return nil
+}
@@ -0,0 +1,69 @@
+package game
+
+import (
+	"context"
+	"fmt"
+
+	"go.uber.org/zap"
+
+	"terraforming-mars-backend/internal/game"
+)
+
+// VoteAbandonAction records a player's vote to abandon a running game
+// Once more than half of the seated players have voted, the game is marked abandoned with no winner
+type VoteAbandonAction struct {
+	gameRepo game.GameRepository
+	logger   *zap.Logger
+}
+
+// NewVoteAbandonAction creates a new vote abandon action
+func NewVoteAbandonAction(
+	gameRepo game.GameRepository,
+	logger *zap.Logger,
+) *VoteAbandonAction {
+	return &VoteAbandonAction{
+		gameRepo: gameRepo,
+		logger:   logger,
+	}
+}
+
+// Execute performs the vote abandon action
+func (a *VoteAbandonAction) Execute(ctx context.Context, gameID string, playerID string) error {
+	log := a.logger.With(
+		zap.String("game_id", gameID),
+		zap.String("player_id", playerID),
+		zap.String("action", "vote_abandon"),
+	)
+	log.Info("🗳️ Voting to abandon game")
+
+	g, err := a.gameRepo.Get(ctx, gameID)
+	if err != nil {
+		log.Error("Failed to get game", zap.Error(err))
+		return fmt.Errorf("game not found: %s", gameID)
+	}
+
+	if g.Status() != game.GameStatusActive {
+		log.Warn("Game is not active", zap.String("status", string(g.Status())))
+		return fmt.Errorf("game is not active: %s", g.Status())
+	}
+
+	if err := g.AddAbandonVote(ctx, playerID); err != nil {
+		log.Warn("Failed to record abandon vote", zap.Error(err))
+		return fmt.Errorf("failed to vote: %w", err)
+	}
+
+	votes := len(g.AbandonVotes())
+	seated := len(g.GetAllPlayers())
+	if votes*2 <= seated {
+		log.Info("🗳️ Abandon vote recorded", zap.Int("votes", votes), zap.Int("players", seated))
+		return nil
+	}
+
+	if err := g.UpdateStatus(ctx, game.GameStatusAbandoned); err != nil {
+		log.Error("Failed to abandon game", zap.Error(err))
+		return fmt.Errorf("failed to abandon game: %w", err)
+	}
+
+	log.Info("✅ Game abandoned by majority vote", zap.Int("votes", votes), zap.Int("players", seated))
+	return nil
+}
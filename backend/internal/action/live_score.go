@@ -0,0 +1,48 @@
+package action
+
+import (
+	"terraforming-mars-backend/internal/cards"
+	"terraforming-mars-backend/internal/game"
+	gamecards "terraforming-mars-backend/internal/game/cards"
+	"terraforming-mars-backend/internal/game/player"
+)
+
+// EstimatePlayerScore scores a player as if the game ended now, for the live scoreboard
+// Awards are scored on the current standings, or on those frozen at funding. With LiveScoresExcludeEvents, face-down events add nothing.
+func EstimatePlayerScore(p *player.Player, g *game.Game, cardRegistry cards.CardRegistry) int {
+	claimed := g.Milestones().ClaimedMilestones()
+	claimedMilestones := make([]gamecards.ClaimedMilestoneInfo, len(claimed))
+	for i, m := range claimed {
+		claimedMilestones[i] = gamecards.ClaimedMilestoneInfo{Type: string(m.Type), PlayerID: m.PlayerID}
+	}
+	fundedAwards := gamecards.ToFundedAwardInfo(g.Awards().FundedAwards())
+
+	breakdown := gamecards.CalculatePlayerVP(p, g.Board(), claimedMilestones, fundedAwards, g.GetAllPlayers(), cardRegistry, g.Settings().RulesOptions.ScoringVP())
+	total := breakdown.TotalVP
+	if g.Settings().RulesOptions.LiveScoresExcludeEvents {
+		for _, detail := range breakdown.CardVPDetails {
+			if p.PlayedCards().IsFaceDown(detail.CardID) {
+				total -= detail.TotalVP
+			}
+		}
+	}
+	return total
+}
+
+// LiveCardVP returns the per-card VP other players may see: nothing unless live scores are on,
+// and no face-down events when the game leaves them out of the estimate
+func LiveCardVP(p *player.Player, g *game.Game, cardRegistry cards.CardRegistry) map[string]int {
+	options := g.Settings().RulesOptions
+	if !options.LiveScores {
+		return nil
+	}
+	cardVP := gamecards.PreviewCardVP(p, g.Board(), cardRegistry)
+	if options.LiveScoresExcludeEvents {
+		for cardID := range cardVP {
+			if p.PlayedCards().IsFaceDown(cardID) {
+				delete(cardVP, cardID)
+			}
+		}
+	}
+	return cardVP
+}
@@ -45,6 +45,20 @@ func (a *ClaimMilestoneAction) Execute(ctx context.Context, gameID string, playe
 		return err
 	}
 
+	// Claims that lost a race are refused the same way whoever sent them, before any turn checks;
+	// the game queue runs claims one at a time, so the first one through takes the last slot
+	milestones := g.Milestones()
+	mt := shared.MilestoneType(milestoneType)
+	if milestones.IsClaimed(mt) {
+		log.Warn("Milestone already claimed", zap.String("milestone", milestoneType))
+		return fmt.Errorf("milestone %s is already claimed", milestoneType)
+	}
+
+	if !milestones.CanClaimMore() {
+		log.Warn("Maximum milestones already claimed", zap.Int("max", game.MaxClaimedMilestones))
+		return game.ErrMilestonesFull
+	}
+
 	if err := baseaction.ValidateCurrentTurn(g, playerID, log); err != nil {
 		return err
 	}
@@ -58,18 +72,6 @@ func (a *ClaimMilestoneAction) Execute(ctx context.Context, gameID string, playe
 		return err
 	}
 
-	milestones := g.Milestones()
-	mt := shared.MilestoneType(milestoneType)
-	if milestones.IsClaimed(mt) {
-		log.Warn("Milestone already claimed", zap.String("milestone", milestoneType))
-		return fmt.Errorf("milestone %s is already claimed", milestoneType)
-	}
-
-	if !milestones.CanClaimMore() {
-		log.Warn("Maximum milestones already claimed", zap.Int("max", game.MaxClaimedMilestones))
-		return fmt.Errorf("maximum milestones (%d) already claimed", game.MaxClaimedMilestones)
-	}
-
 	resources := player.Resources().Get()
 	if resources.Credits < game.MilestoneClaimCost {
 		log.Warn("Insufficient credits for milestone",
@@ -88,16 +90,23 @@ func (a *ClaimMilestoneAction) Execute(ctx context.Context, gameID string, playe
 		return fmt.Errorf("requirements not met: %s (have %d, need %d)", requirement.Description, progress, requirement.Required)
 	}
 
-	player.Resources().Add(map[shared.ResourceType]int{
-		shared.ResourceCredit: -game.MilestoneClaimCost,
-	})
-	log.Info("💰 Deducted milestone cost",
-		zap.Int("cost", game.MilestoneClaimCost),
-		zap.Int("remaining_credits", player.Resources().Get().Credits))
+	// Payment and claim stand or fall together, so a refused claim never costs anything
+	err = baseaction.RunInTransaction(ctx, g, log, func() error {
+		player.Resources().Add(map[shared.ResourceType]int{
+			shared.ResourceCredit: -game.MilestoneClaimCost,
+		})
+		log.Info("💰 Deducted milestone cost",
+			zap.Int("cost", game.MilestoneClaimCost),
+			zap.Int("remaining_credits", player.Resources().Get().Credits))
 
-	if err := milestones.ClaimMilestone(ctx, mt, playerID, g.Generation()); err != nil {
-		log.Error("Failed to claim milestone", zap.Error(err))
-		return fmt.Errorf("failed to claim milestone: %w", err)
+		if err := milestones.ClaimMilestone(ctx, mt, playerID, g.Generation()); err != nil {
+			log.Error("Failed to claim milestone", zap.Error(err))
+			return fmt.Errorf("failed to claim milestone: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
 	}
 
 	a.ConsumePlayerAction(g, log)
@@ -66,6 +66,49 @@ func SubscribePassiveEffectToEvents(
 	}
 }
 
+// passiveEffectOrder sorts an effect among others triggered by the same event, see Game.ResolutionOrder
+func passiveEffectOrder(g *game.Game, p *player.Player, effect player.CardEffect) events.ResolutionOrder {
+	return func() []int {
+		return g.ResolutionOrder(p.ID(), effect.CardID)
+	}
+}
+
+// resolvePassiveEffect applies a triggered effect's outputs and records it for the game log
+// It runs under the triggering action's context, see Game.BindActionContext
+func resolvePassiveEffect(
+	g *game.Game,
+	p *player.Player,
+	effect player.CardEffect,
+	trigger shared.Trigger,
+	log *zap.Logger,
+	cr gamecards.CardRegistryInterface,
+) {
+	// Effects fire inside the triggering action, so they stop with it when its deadline passes
+	ctx := g.ActionContext()
+	if err := game.ContextError(ctx); err != nil {
+		log.Warn("⏱️ Skipped passive effect of an ended action",
+			zap.String("card_name", effect.CardName),
+			zap.Error(err))
+		return
+	}
+
+	applier := gamecards.NewBehaviorApplier(p, g, effect.CardName, log).
+		WithSourceCardID(effect.CardID).
+		WithCardRegistry(cr)
+	if err := applier.ApplyOutputs(ctx, effect.Behavior.Outputs); err != nil {
+		log.Error("Failed to apply passive effect outputs",
+			zap.String("card_name", effect.CardName),
+			zap.Error(err))
+		return
+	}
+	g.RecordEffectResolution(game.EffectResolution{
+		PlayerID: p.ID(),
+		CardID:   effect.CardID,
+		CardName: effect.CardName,
+		Trigger:  trigger.Condition.Type,
+	})
+}
+
 // subscribePlacementBonusEffect subscribes to PlacementBonusGainedEvent
 func subscribePlacementBonusEffect(
 	_ context.Context,
@@ -76,7 +119,7 @@ func subscribePlacementBonusEffect(
 	log *zap.Logger,
 	cr gamecards.CardRegistryInterface,
 ) events.SubscriptionID {
-	subID := events.Subscribe(g.EventBus(), func(event events.PlacementBonusGainedEvent) {
+	subID := events.SubscribeOrdered(g.EventBus(), passiveEffectOrder(g, p, effect), func(event events.PlacementBonusGainedEvent) {
 		// Only process if event is for this game and player
 		if event.GameID != g.ID() {
 			return
@@ -117,14 +160,7 @@ func subscribePlacementBonusEffect(
 			zap.String("trigger_type", trigger.Condition.Type),
 			zap.Any("resources_gained", event.Resources))
 
-		applier := gamecards.NewBehaviorApplier(p, g, effect.CardName, log).
-			WithSourceCardID(effect.CardID).
-			WithCardRegistry(cr)
-		if err := applier.ApplyOutputs(context.Background(), effect.Behavior.Outputs); err != nil {
-			log.Error("Failed to apply passive effect outputs",
-				zap.String("card_name", effect.CardName),
-				zap.Error(err))
-		}
+		resolvePassiveEffect(g, p, effect, trigger, log, cr)
 	})
 
 	log.Debug("📬 Subscribed passive effect to PlacementBonusGainedEvent",
@@ -143,7 +179,7 @@ func subscribeCityPlacedEffect(
 	log *zap.Logger,
 	cr gamecards.CardRegistryInterface,
 ) events.SubscriptionID {
-	subID := events.Subscribe(g.EventBus(), func(event events.TilePlacedEvent) {
+	subID := events.SubscribeOrdered(g.EventBus(), passiveEffectOrder(g, p, effect), func(event events.TilePlacedEvent) {
 		// Only process if event is for this game
 		if event.GameID != g.ID() {
 			return
@@ -184,14 +220,7 @@ func subscribeCityPlacedEffect(
 			zap.String("placed_by", event.PlayerID),
 			zap.String("tile_type", event.TileType))
 
-		applier := gamecards.NewBehaviorApplier(p, g, effect.CardName, log).
-			WithSourceCardID(effect.CardID).
-			WithCardRegistry(cr)
-		if err := applier.ApplyOutputs(context.Background(), effect.Behavior.Outputs); err != nil {
-			log.Error("Failed to apply passive effect outputs",
-				zap.String("card_name", effect.CardName),
-				zap.Error(err))
-		}
+		resolvePassiveEffect(g, p, effect, trigger, log, cr)
 	})
 
 	log.Debug("📬 Subscribed passive effect to TilePlacedEvent (city)",
@@ -209,7 +238,7 @@ func subscribeTagPlayedEffect(
 	log *zap.Logger,
 	cr gamecards.CardRegistryInterface,
 ) events.SubscriptionID {
-	subID := events.Subscribe(g.EventBus(), func(event events.TagPlayedEvent) {
+	subID := events.SubscribeOrdered(g.EventBus(), passiveEffectOrder(g, p, effect), func(event events.TagPlayedEvent) {
 		if event.GameID != g.ID() {
 			return
 		}
@@ -248,14 +277,7 @@ func subscribeTagPlayedEffect(
 			zap.String("tag_played_by", event.PlayerID),
 			zap.String("tag", event.Tag))
 
-		applier := gamecards.NewBehaviorApplier(p, g, effect.CardName, log).
-			WithSourceCardID(effect.CardID).
-			WithCardRegistry(cr)
-		if err := applier.ApplyOutputs(context.Background(), effect.Behavior.Outputs); err != nil {
-			log.Error("Failed to apply passive effect outputs",
-				zap.String("card_name", effect.CardName),
-				zap.Error(err))
-		}
+		resolvePassiveEffect(g, p, effect, trigger, log, cr)
 	})
 
 	log.Debug("📬 Subscribed passive effect to TagPlayedEvent",
@@ -273,7 +295,7 @@ func subscribeCardPlayedEffect(
 	log *zap.Logger,
 	cr gamecards.CardRegistryInterface,
 ) events.SubscriptionID {
-	subID := events.Subscribe(g.EventBus(), func(event events.CardPlayedEvent) {
+	subID := events.SubscribeOrdered(g.EventBus(), passiveEffectOrder(g, p, effect), func(event events.CardPlayedEvent) {
 		if event.GameID != g.ID() {
 			return
 		}
@@ -309,14 +331,7 @@ func subscribeCardPlayedEffect(
 			zap.String("card_played_by", event.PlayerID),
 			zap.String("card_played", event.CardName))
 
-		applier := gamecards.NewBehaviorApplier(p, g, effect.CardName, log).
-			WithSourceCardID(effect.CardID).
-			WithCardRegistry(cr)
-		if err := applier.ApplyOutputs(context.Background(), effect.Behavior.Outputs); err != nil {
-			log.Error("Failed to apply passive effect outputs",
-				zap.String("card_name", effect.CardName),
-				zap.Error(err))
-		}
+		resolvePassiveEffect(g, p, effect, trigger, log, cr)
 	})
 
 	log.Debug("📬 Subscribed passive effect to CardPlayedEvent",
@@ -334,7 +349,7 @@ func subscribeStandardProjectPlayedEffect(
 	log *zap.Logger,
 	cr gamecards.CardRegistryInterface,
 ) events.SubscriptionID {
-	subID := events.Subscribe(g.EventBus(), func(event events.StandardProjectPlayedEvent) {
+	subID := events.SubscribeOrdered(g.EventBus(), passiveEffectOrder(g, p, effect), func(event events.StandardProjectPlayedEvent) {
 		if event.GameID != g.ID() {
 			return
 		}
@@ -381,14 +396,7 @@ func subscribeStandardProjectPlayedEffect(
 			zap.String("project_type", event.ProjectType),
 			zap.Int("project_cost", event.ProjectCost))
 
-		applier := gamecards.NewBehaviorApplier(p, g, effect.CardName, log).
-			WithSourceCardID(effect.CardID).
-			WithCardRegistry(cr)
-		if err := applier.ApplyOutputs(context.Background(), effect.Behavior.Outputs); err != nil {
-			log.Error("Failed to apply passive effect outputs",
-				zap.String("card_name", effect.CardName),
-				zap.Error(err))
-		}
+		resolvePassiveEffect(g, p, effect, trigger, log, cr)
 	})
 
 	log.Debug("📬 Subscribed passive effect to StandardProjectPlayedEvent",
@@ -0,0 +1,98 @@
+package action
+
+import (
+	"context"
+	"time"
+
+	"terraforming-mars-backend/internal/cards"
+	"terraforming-mars-backend/internal/events"
+	"terraforming-mars-backend/internal/game"
+	gamecards "terraforming-mars-backend/internal/game/cards"
+	"terraforming-mars-backend/internal/game/player"
+	"terraforming-mars-backend/internal/game/shared"
+
+	"go.uber.org/zap"
+)
+
+// AddToPlayedCards puts a card among the player's played cards and initializes its resource storage
+// The played cards publish CardPlayedEvent and a TagPlayedEvent per tag.
+func AddToPlayedCards(p *player.Player, card *gamecards.Card, log *zap.Logger) {
+	cardTags := make([]string, len(card.Tags))
+	for i, tag := range card.Tags {
+		cardTags[i] = string(tag)
+	}
+
+	p.PlayedCards().AddCard(card.ID, card.Name, string(card.Type), cardTags)
+
+	log.Info("✅ Card added to played cards")
+
+	if card.ResourceStorage != nil {
+		p.Resources().AddToStorage(card.ID, card.ResourceStorage.Starting)
+		log.Info("📦 Initialized resource storage",
+			zap.String("card_id", card.ID),
+			zap.String("resource_type", string(card.ResourceStorage.Type)),
+			zap.Int("starting_amount", card.ResourceStorage.Starting))
+	}
+}
+
+// RegisterLastingBehavior registers the part of a played card's behavior that outlasts the play:
+// manual triggers become player actions, auto triggers with persistent outputs (discounts, payment
+// substitutes) and conditional triggers become effects, and conditional triggers are subscribed to their events.
+// Immediate auto-trigger outputs are not applied here.
+func RegisterLastingBehavior(
+	ctx context.Context,
+	g *game.Game,
+	p *player.Player,
+	card *gamecards.Card,
+	behaviorIndex int,
+	behavior shared.CardBehavior,
+	log *zap.Logger,
+	cardRegistry cards.CardRegistry,
+) {
+	effect := player.CardEffect{
+		CardID:        card.ID,
+		CardName:      card.Name,
+		BehaviorIndex: behaviorIndex,
+		Behavior:      behavior,
+	}
+
+	// Persistent outputs need to show in the effects list for display and for modifier calculations
+	if gamecards.HasAutoTrigger(behavior) && gamecards.HasPersistentEffects(behavior) {
+		log.Info("🏷️ Registering auto-trigger behavior with persistent effects",
+			zap.String("card_name", card.Name))
+
+		p.Effects().AddEffect(effect)
+		publishEffectsChanged(g, p)
+	}
+
+	if gamecards.HasManualTrigger(behavior) {
+		log.Info("🎯 Found manual-trigger behavior, registering as player action")
+
+		p.Actions().AddAction(player.CardAction{
+			CardID:                  card.ID,
+			CardName:                card.Name,
+			BehaviorIndex:           behaviorIndex,
+			Behavior:                behavior,
+			TimesUsedThisTurn:       0,
+			TimesUsedThisGeneration: 0,
+		})
+	}
+
+	if gamecards.HasConditionalTrigger(behavior) {
+		log.Info("⚡ Found conditional-trigger behavior, registering as passive effect",
+			zap.Int("trigger_count", len(behavior.Triggers)))
+
+		p.Effects().AddEffect(effect)
+		publishEffectsChanged(g, p)
+
+		SubscribePassiveEffectToEvents(ctx, g, p, effect, log, cardRegistry)
+	}
+}
+
+func publishEffectsChanged(g *game.Game, p *player.Player) {
+	events.Publish(g.EventBus(), events.PlayerEffectsChangedEvent{
+		GameID:    g.ID(),
+		PlayerID:  p.ID(),
+		Timestamp: time.Now(),
+	})
+}
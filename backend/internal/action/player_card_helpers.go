@@ -1,6 +1,9 @@
 package action
 
 import (
+	"context"
+	"fmt"
+
 	"terraforming-mars-backend/internal/cards"
 	"terraforming-mars-backend/internal/events"
 	"terraforming-mars-backend/internal/game"
@@ -145,3 +148,15 @@ func AddCardsToPlayerHand(
 		CreateAndCachePlayerCard(card, p, g, cardRegistry)
 	}
 }
+
+// DiscardCards puts project cards that leave play on the discard pile, so every card stays accounted for
+// Used for unkept starting cards, unbought research and draws, and sold patents
+func DiscardCards(ctx context.Context, g *game.Game, cardIDs []string) error {
+	if len(cardIDs) == 0 || g.Deck() == nil {
+		return nil
+	}
+	if err := g.Deck().Discard(ctx, cardIDs); err != nil {
+		return fmt.Errorf("failed to discard cards: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,64 @@
+package query
+
+import (
+	"context"
+	"errors"
+
+	"terraforming-mars-backend/internal/game"
+
+	"go.uber.org/zap"
+)
+
+// ErrGameNotFinished is returned when a log export is requested before the game has ended
+var ErrGameNotFinished = errors.New("game is not finished")
+
+// ExportGameLogAction handles exporting a finished game's complete log
+// Only completed or abandoned games export, so the log never reveals anything to players still at the table
+type ExportGameLogAction struct {
+	gameRepo  game.GameRepository
+	stateRepo game.GameStateRepository
+	logger    *zap.Logger
+}
+
+// NewExportGameLogAction creates a new export game log query action
+func NewExportGameLogAction(
+	gameRepo game.GameRepository,
+	stateRepo game.GameStateRepository,
+	logger *zap.Logger,
+) *ExportGameLogAction {
+	return &ExportGameLogAction{
+		gameRepo:  gameRepo,
+		stateRepo: stateRepo,
+		logger:    logger,
+	}
+}
+
+// Execute returns the finished game and every log entry, oldest first
+func (a *ExportGameLogAction) Execute(ctx context.Context, gameID string) (*game.Game, []game.StateDiff, error) {
+	log := a.logger.With(zap.String("game_id", gameID))
+	log.Info("🔍 Exporting game log")
+
+	g, err := a.gameRepo.Get(ctx, gameID)
+	if err != nil {
+		log.Warn("Game not found", zap.Error(err))
+		return nil, nil, err
+	}
+
+	if status := g.Status(); status != game.GameStatusCompleted && status != game.GameStatusAbandoned {
+		log.Warn("Game not finished", zap.String("status", string(status)))
+		return nil, nil, ErrGameNotFinished
+	}
+
+	// A game that ended before anything was logged has no diff log yet
+	diffs, err := a.stateRepo.GetDiff(ctx, gameID)
+	if errors.Is(err, game.ErrGameNotFound) {
+		diffs, err = nil, nil
+	}
+	if err != nil {
+		log.Error("Failed to get game logs", zap.Error(err))
+		return nil, nil, err
+	}
+
+	log.Info("✅ Game log exported", zap.Int("count", len(diffs)))
+	return g, diffs, nil
+}
@@ -0,0 +1,320 @@
+package query
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"terraforming-mars-backend/internal/cards"
+	"terraforming-mars-backend/internal/delivery/dto"
+	"terraforming-mars-backend/internal/events"
+	"terraforming-mars-backend/internal/game"
+
+	"go.uber.org/zap"
+)
+
+// DefaultProjectionMaxAge bounds how stale a cached view can get when a change publishes no event
+const DefaultProjectionMaxAge = 2 * time.Second
+
+// ErrPlayerNotInGame is returned when a view is requested for a player who has no seat in the game
+var ErrPlayerNotInGame = errors.New("player not in game")
+
+// GameQueryService serves read-only views of games from cached projections
+// Each game's projections are rebuilt lazily on the first read after a change: every event
+// published on the game's bus bumps a version counter, so reads between changes never walk
+// players, board and card state under their locks. Returned values are shared between
+// readers and must not be modified. Ended games are not cached: they no longer change and are
+// rarely read, so their entries are dropped when the game ends or leaves the repository.
+type GameQueryService struct {
+	gameRepo     game.GameRepository
+	cardRegistry cards.CardRegistry
+	maxAge       time.Duration
+	logger       *zap.Logger
+
+	mu    sync.Mutex
+	games map[string]*gameProjections
+}
+
+// gameProjections holds the cached views of one game, keyed by viewing player ID
+type gameProjections struct {
+	game         *game.Game
+	version      atomic.Uint64
+	subscription events.SubscriptionID
+
+	mu    sync.Mutex
+	views map[string]*cachedView
+
+	// Shared by all viewers of the same version so each player is mapped once per change
+	snapshot        *dto.GameViewSnapshot
+	snapshotVersion uint64
+	snapshotBuiltAt time.Time
+
+	overlay        *dto.GameOverlayDto // Spectator view, rebuilt with the snapshot
+	overlayVersion uint64
+	overlayBuiltAt time.Time
+
+	// Woken on every change; closed when the projections are dropped
+	watchMu  sync.Mutex
+	watchers map[chan struct{}]bool
+	dropped  bool
+}
+
+type cachedView struct {
+	version uint64
+	builtAt time.Time
+	game    dto.GameDto
+	summary *dto.GameSummaryDto // Derived from game on first summary read
+}
+
+// NewGameQueryService creates a new game query service
+// maxAge <= 0 uses DefaultProjectionMaxAge
+func NewGameQueryService(
+	gameRepo game.GameRepository,
+	cardRegistry cards.CardRegistry,
+	maxAge time.Duration,
+	logger *zap.Logger,
+) *GameQueryService {
+	if maxAge <= 0 {
+		maxAge = DefaultProjectionMaxAge
+	}
+	return &GameQueryService{
+		gameRepo:     gameRepo,
+		cardRegistry: cardRegistry,
+		maxAge:       maxAge,
+		logger:       logger,
+		games:        make(map[string]*gameProjections),
+	}
+}
+
+// GameView returns the game as seen by playerID (empty for a spectator view)
+// Errors wrap game.ErrGameNotFound when the game does not exist and ErrPlayerNotInGame when playerID has no seat
+func (s *GameQueryService) GameView(ctx context.Context, gameID, playerID string) (dto.GameDto, error) {
+	var result dto.GameDto
+	err := s.view(ctx, gameID, playerID, func(view *cachedView) {
+		result = view.game
+	})
+	return result, err
+}
+
+// Summary returns the scoreboard, board summary, tag counts and available actions for playerID
+func (s *GameQueryService) Summary(ctx context.Context, gameID, playerID string) (dto.GameSummaryDto, error) {
+	var result dto.GameSummaryDto
+	err := s.view(ctx, gameID, playerID, func(view *cachedView) {
+		if view.summary == nil {
+			summary := dto.ToGameSummaryDto(view.game)
+			view.summary = &summary
+		}
+		result = *view.summary
+	})
+	return result, err
+}
+
+// Invalidate drops every cached view of a game
+func (s *GameQueryService) Invalidate(gameID string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.dropLocked(gameID)
+}
+
+// CachedGameCount returns how many games have cached projections
+func (s *GameQueryService) CachedGameCount() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return len(s.games)
+}
+
+// view finds or rebuilds the cached view and hands it to read while the game's projections are locked
+func (s *GameQueryService) view(ctx context.Context, gameID, playerID string, read func(*cachedView)) error {
+	g, err := s.gameRepo.Get(ctx, gameID)
+	if err != nil {
+		s.Invalidate(gameID)
+		return err
+	}
+
+	if playerID != "" {
+		if _, err := g.GetPlayer(playerID); err != nil {
+			return fmt.Errorf("player %s in game %s: %w", playerID, gameID, ErrPlayerNotInGame)
+		}
+	}
+
+	projections := s.track(g)
+
+	projections.mu.Lock()
+	defer projections.mu.Unlock()
+
+	version := projections.version.Load()
+	if view, ok := projections.views[playerID]; ok && view.version == version && time.Since(view.builtAt) < s.maxAge {
+		read(view)
+		return nil
+	}
+
+	s.refreshSnapshot(g, projections, version)
+	view := &cachedView{
+		version: version,
+		builtAt: projections.snapshotBuiltAt,
+		game:    projections.snapshot.ForViewer(playerID),
+	}
+	projections.views[playerID] = view
+
+	s.logger.Debug("🔄 Rebuilt game projection",
+		zap.String("game_id", gameID),
+		zap.String("player_id", playerID),
+		zap.Uint64("version", version))
+	read(view)
+	return nil
+}
+
+// Overlay returns the spectator-safe stream overlay of a game, built from public player views only
+// Errors wrap game.ErrGameNotFound when the game does not exist
+func (s *GameQueryService) Overlay(ctx context.Context, gameID string) (dto.GameOverlayDto, error) {
+	g, err := s.gameRepo.Get(ctx, gameID)
+	if err != nil {
+		s.Invalidate(gameID)
+		return dto.GameOverlayDto{}, err
+	}
+
+	projections := s.track(g)
+
+	projections.mu.Lock()
+	defer projections.mu.Unlock()
+
+	version := projections.version.Load()
+	if projections.overlay != nil && projections.overlayVersion == version && time.Since(projections.overlayBuiltAt) < s.maxAge {
+		return *projections.overlay, nil
+	}
+
+	s.refreshSnapshot(g, projections, version)
+	overlay := dto.ToGameOverlayDto(projections.snapshot)
+	projections.overlay = &overlay
+	projections.overlayVersion = version
+	projections.overlayBuiltAt = projections.snapshotBuiltAt
+	return overlay, nil
+}
+
+// refreshSnapshot rebuilds the shared snapshot when it is older than version or maxAge
+// Callers hold projections.mu and read the version before mapping, so an event during the
+// build leaves the views stale for the next read
+func (s *GameQueryService) refreshSnapshot(g *game.Game, projections *gameProjections, version uint64) {
+	if projections.snapshot == nil || projections.snapshotVersion != version || time.Since(projections.snapshotBuiltAt) >= s.maxAge {
+		projections.snapshot = dto.NewGameViewSnapshot(g, s.cardRegistry)
+		projections.snapshotVersion = version
+		projections.snapshotBuiltAt = time.Now()
+	}
+}
+
+// track returns the projections for g, subscribing to its event bus on first use
+// A game recreated under the same ID replaces the old entry. An ended game gets projections
+// that are built for this read only and never stored.
+func (s *GameQueryService) track(g *game.Game) *gameProjections {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if projections, ok := s.games[g.ID()]; ok {
+		if projections.game == g && !hasEnded(g) {
+			return projections
+		}
+		s.dropLocked(g.ID())
+	}
+
+	projections := &gameProjections{
+		game:  g,
+		views: make(map[string]*cachedView),
+	}
+	if hasEnded(g) {
+		return projections
+	}
+	if bus := g.EventBus(); bus != nil {
+		gameID := g.ID()
+		projections.subscription = events.SubscribeAll(bus, func(event any) {
+			projections.version.Add(1)
+			projections.wakeWatchers()
+			if changed, ok := event.(events.GameStatusChangedEvent); ok && hasEnded(g) {
+				s.logger.Debug("🧹 Evicting projections of an ended game",
+					zap.String("game_id", gameID),
+					zap.String("status", changed.NewStatus))
+				// Handlers run while the bus is held, so the unsubscribe waits for this publish to finish
+				go s.Invalidate(gameID)
+			}
+		})
+	}
+	s.games[g.ID()] = projections
+	return projections
+}
+
+// hasEnded reports whether a game will not change again
+func hasEnded(g *game.Game) bool {
+	return g.Status().Ended()
+}
+
+func (s *GameQueryService) dropLocked(gameID string) {
+	projections, ok := s.games[gameID]
+	if !ok {
+		return
+	}
+	if bus := projections.game.EventBus(); bus != nil && projections.subscription != "" {
+		bus.Unsubscribe(projections.subscription)
+	}
+	projections.closeWatchers()
+	delete(s.games, gameID)
+}
+
+// Watch returns a channel that receives after changes to a game, and a func to stop watching
+// Changes in quick succession are coalesced into one receive. The channel is closed once the
+// game ends, is deleted or is replaced, as nothing more will arrive on it; it starts closed
+// for a game that has already ended.
+// Errors wrap game.ErrGameNotFound when the game does not exist
+func (s *GameQueryService) Watch(ctx context.Context, gameID string) (<-chan struct{}, func(), error) {
+	g, err := s.gameRepo.Get(ctx, gameID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	changes := make(chan struct{}, 1)
+	projections := s.track(g)
+	if projections.subscription == "" {
+		close(changes)
+		return changes, func() {}, nil
+	}
+
+	projections.watchMu.Lock()
+	defer projections.watchMu.Unlock()
+	if projections.dropped {
+		close(changes)
+		return changes, func() {}, nil
+	}
+	if projections.watchers == nil {
+		projections.watchers = make(map[chan struct{}]bool)
+	}
+	projections.watchers[changes] = true
+
+	stop := func() {
+		projections.watchMu.Lock()
+		defer projections.watchMu.Unlock()
+		delete(projections.watchers, changes)
+	}
+	return changes, stop, nil
+}
+
+func (p *gameProjections) wakeWatchers() {
+	p.watchMu.Lock()
+	defer p.watchMu.Unlock()
+	for changes := range p.watchers {
+		select {
+		case changes <- struct{}{}:
+		default:
+		}
+	}
+}
+
+func (p *gameProjections) closeWatchers() {
+	p.watchMu.Lock()
+	defer p.watchMu.Unlock()
+	for changes := range p.watchers {
+		close(changes)
+	}
+	p.watchers = nil
+	p.dropped = true
+}
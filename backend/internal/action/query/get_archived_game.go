@@ -0,0 +1,41 @@
+package query
+
+import (
+	"context"
+
+	"terraforming-mars-backend/internal/archive"
+
+	"go.uber.org/zap"
+)
+
+// GetArchivedGameAction handles loading an archived game's full record
+type GetArchivedGameAction struct {
+	archive *archive.Archive
+	logger  *zap.Logger
+}
+
+// NewGetArchivedGameAction creates a new get archived game query action
+func NewGetArchivedGameAction(
+	gameArchive *archive.Archive,
+	logger *zap.Logger,
+) *GetArchivedGameAction {
+	return &GetArchivedGameAction{
+		archive: gameArchive,
+		logger:  logger,
+	}
+}
+
+// Execute loads the archived game; unknown games return an error wrapping game.ErrGameNotFound
+func (a *GetArchivedGameAction) Execute(ctx context.Context, gameID string) (archive.Record, error) {
+	log := a.logger.With(zap.String("game_id", gameID))
+	log.Info("🔍 Loading archived game")
+
+	record, err := a.archive.Load(ctx, gameID)
+	if err != nil {
+		log.Warn("Failed to load archived game", zap.Error(err))
+		return archive.Record{}, err
+	}
+
+	log.Info("✅ Archived game loaded", zap.Int("log_entries", len(record.Log)))
+	return record, nil
+}
@@ -0,0 +1,83 @@
+package query
+
+import (
+	"context"
+
+	"terraforming-mars-backend/internal/delivery/dto"
+	"terraforming-mars-backend/internal/game"
+
+	"go.uber.org/zap"
+)
+
+// GetGameOverlayAction handles querying the spectator-safe stream overlay of a game
+// Overlays are polled often, so it logs at debug level and reads through the cached projections
+type GetGameOverlayAction struct {
+	gameQueries *GameQueryService
+	stateRepo   game.GameStateRepository
+	logger      *zap.Logger
+}
+
+// NewGetGameOverlayAction creates a new get game overlay query action
+func NewGetGameOverlayAction(
+	gameQueries *GameQueryService,
+	stateRepo game.GameStateRepository,
+	logger *zap.Logger,
+) *GetGameOverlayAction {
+	return &GetGameOverlayAction{
+		gameQueries: gameQueries,
+		stateRepo:   stateRepo,
+		logger:      logger,
+	}
+}
+
+// Execute returns the game's overlay with its newest log entry
+// Errors wrap game.ErrGameNotFound when the game does not exist
+func (a *GetGameOverlayAction) Execute(ctx context.Context, gameID string) (dto.GameOverlayDto, error) {
+	log := a.logger.With(zap.String("game_id", gameID))
+
+	overlay, err := a.gameQueries.Overlay(ctx, gameID)
+	if err != nil {
+		log.Debug("Game not found for overlay", zap.Error(err))
+		return dto.GameOverlayDto{}, err
+	}
+
+	// A game with nothing logged yet has no diff log
+	if diffs, err := a.stateRepo.GetDiff(ctx, gameID); err == nil && len(diffs) > 0 {
+		recent := dto.ToRecentActionDtos(diffs[len(diffs)-1:])
+		overlay.LastAction = &recent[0]
+	}
+
+	log.Debug("🔍 Game overlay query completed", zap.Int("player_count", len(overlay.Players)))
+	return overlay, nil
+}
+
+// Watch returns a channel woken after changes to the game and a func to stop watching
+// See GameQueryService.Watch
+func (a *GetGameOverlayAction) Watch(ctx context.Context, gameID string) (<-chan struct{}, func(), error) {
+	return a.gameQueries.Watch(ctx, gameID)
+}
+
+// ExecuteSince returns the game's overlay and the log entries written after afterSequence
+// Only the new entries are read, so a stream can follow the log from its last entry. The
+// overlay's LastAction is the newest of them and nil when there are none.
+// Errors wrap game.ErrGameNotFound when the game does not exist
+func (a *GetGameOverlayAction) ExecuteSince(ctx context.Context, gameID string, afterSequence int64) (dto.GameOverlayDto, []dto.RecentActionDto, error) {
+	log := a.logger.With(zap.String("game_id", gameID))
+
+	overlay, err := a.gameQueries.Overlay(ctx, gameID)
+	if err != nil {
+		log.Debug("Game not found for overlay", zap.Error(err))
+		return dto.GameOverlayDto{}, nil, err
+	}
+
+	var actions []dto.RecentActionDto
+	if diffs, err := a.stateRepo.GetDiffSince(ctx, gameID, afterSequence); err == nil && len(diffs) > 0 {
+		actions = dto.ToRecentActionDtos(diffs)
+		overlay.LastAction = &actions[len(actions)-1]
+	}
+
+	log.Debug("🔍 Game overlay query completed",
+		zap.Int64("after_sequence", afterSequence),
+		zap.Int("new_actions", len(actions)))
+	return overlay, actions, nil
+}
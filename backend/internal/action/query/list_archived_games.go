@@ -0,0 +1,55 @@
+package query
+
+import (
+	"context"
+
+	"terraforming-mars-backend/internal/archive"
+
+	"go.uber.org/zap"
+)
+
+// ListArchivedGamesAction handles history and stats queries over archived games
+// Both are answered from the archive index without loading any game record
+type ListArchivedGamesAction struct {
+	archive *archive.Archive
+	logger  *zap.Logger
+}
+
+// NewListArchivedGamesAction creates a new list archived games query action
+func NewListArchivedGamesAction(
+	gameArchive *archive.Archive,
+	logger *zap.Logger,
+) *ListArchivedGamesAction {
+	return &ListArchivedGamesAction{
+		archive: gameArchive,
+		logger:  logger,
+	}
+}
+
+// Execute lists archived games, most recently finished first
+func (a *ListArchivedGamesAction) Execute(ctx context.Context, q archive.Query) ([]archive.Entry, error) {
+	log := a.logger.With(zap.String("player_name", q.PlayerName), zap.Int("limit", q.Limit))
+	log.Info("🔍 Listing archived games")
+
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	entries := a.archive.Games(q)
+	log.Info("✅ Archived games listed", zap.Int("count", len(entries)))
+	return entries, nil
+}
+
+// PlayerStats summarizes a player's archived games
+func (a *ListArchivedGamesAction) PlayerStats(ctx context.Context, playerName string) (archive.PlayerStats, error) {
+	log := a.logger.With(zap.String("player_name", playerName))
+	log.Info("🔍 Querying archived player stats")
+
+	if err := ctx.Err(); err != nil {
+		return archive.PlayerStats{}, err
+	}
+
+	stats := a.archive.PlayerStats(playerName)
+	log.Info("✅ Archived player stats queried", zap.Int("games", stats.Games))
+	return stats, nil
+}
@@ -0,0 +1,59 @@
+package query
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"terraforming-mars-backend/internal/cards"
+
+	"go.uber.org/zap"
+)
+
+const (
+	// DefaultCardMatches is how many matches a card lookup returns when the caller does not say
+	DefaultCardMatches = 5
+	// MaxCardMatches caps the matches of one card lookup
+	MaxCardMatches = 20
+)
+
+// ErrEmptyCardQuery is returned when a card lookup has nothing to look for
+var ErrEmptyCardQuery = errors.New("card query is empty")
+
+// ResolveCardsAction finds cards by what a player typed, for clients that take card names as text
+type ResolveCardsAction struct {
+	resolver *cards.Resolver
+	logger   *zap.Logger
+}
+
+// NewResolveCardsAction creates a new resolve cards query action
+func NewResolveCardsAction(
+	cardRegistry cards.CardRegistry,
+	logger *zap.Logger,
+) *ResolveCardsAction {
+	return &ResolveCardsAction{
+		resolver: cards.NewResolver(cardRegistry),
+		logger:   logger,
+	}
+}
+
+// Execute returns the best matches for query, best first
+// limit falls back to DefaultCardMatches when not positive and is capped at MaxCardMatches
+func (a *ResolveCardsAction) Execute(ctx context.Context, query string, limit int) ([]cards.CardMatch, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	log := a.logger.With(zap.String("query", query))
+	if strings.TrimSpace(query) == "" {
+		return nil, ErrEmptyCardQuery
+	}
+	if limit <= 0 {
+		limit = DefaultCardMatches
+	}
+	limit = min(limit, MaxCardMatches)
+
+	matches := a.resolver.Resolve(query, limit)
+	log.Debug("🔎 Cards resolved", zap.Int("matches", len(matches)))
+	return matches, nil
+}
@@ -0,0 +1,129 @@
+package resource_conversion
+
+import (
+	"context"
+	"fmt"
+	baseaction "terraforming-mars-backend/internal/action"
+
+	"terraforming-mars-backend/internal/cards"
+	"terraforming-mars-backend/internal/game"
+	playerPkg "terraforming-mars-backend/internal/game/player"
+	"terraforming-mars-backend/internal/game/shared"
+
+	"go.uber.org/zap"
+)
+
+// ConvertAllAction converts every useful amount of heat and plants in one action
+// Offered before passing: heat raises the temperature until it is spent or maxed, and plants queue
+// one greenery placement each. Everything is paid in a single transaction and counts as one action
+type ConvertAllAction struct {
+	baseaction.BaseAction
+	cardRegistry cards.CardRegistry
+}
+
+// NewConvertAllAction creates a new convert all action
+func NewConvertAllAction(
+	gameRepo game.GameRepository,
+	cardRegistry cards.CardRegistry,
+	stateRepo game.GameStateRepository,
+	logger *zap.Logger,
+) *ConvertAllAction {
+	return &ConvertAllAction{
+		BaseAction:   baseaction.NewBaseActionWithStateRepo(gameRepo, nil, stateRepo),
+		cardRegistry: cardRegistry,
+	}
+}
+
+// Execute performs the convert all action and returns the conversions it made
+func (a *ConvertAllAction) Execute(ctx context.Context, gameID string, playerID string) (baseaction.Convertibles, error) {
+	log := a.InitLogger(gameID, playerID).With(zap.String("action", "convert_all"))
+	log.Info("♻️ Converting all heat and plants")
+
+	g, err := baseaction.ValidateActiveGame(ctx, a.GameRepository(), gameID, log)
+	if err != nil {
+		return baseaction.Convertibles{}, err
+	}
+
+	if err := baseaction.ValidateCurrentTurn(g, playerID, log); err != nil {
+		return baseaction.Convertibles{}, err
+	}
+
+	if err := baseaction.ValidateActionsRemaining(g, playerID, log); err != nil {
+		return baseaction.Convertibles{}, err
+	}
+
+	if g.GetPendingTileSelection(playerID) != nil {
+		log.Warn("Tile placement still pending")
+		return baseaction.Convertibles{}, fmt.Errorf("finish placing your tile first")
+	}
+
+	player, err := a.GetPlayerFromGame(g, playerID, log)
+	if err != nil {
+		return baseaction.Convertibles{}, err
+	}
+
+	convertibles := baseaction.CalculateConvertibles(g, player, a.cardRegistry)
+	if !convertibles.Any() {
+		log.Warn("Nothing to convert")
+		return convertibles, fmt.Errorf("no heat or plants to convert")
+	}
+
+	var trGained int
+	err = baseaction.RunInTransaction(ctx, g, log, func() error {
+		for i := 0; i < convertibles.TemperatureSteps; i++ {
+			player.Resources().Add(map[shared.ResourceType]int{shared.ResourceHeat: -convertibles.HeatCost})
+			steps, err := g.RaiseTemperature(ctx, player.ID(), 1)
+			if err != nil {
+				return fmt.Errorf("failed to raise temperature: %w", err)
+			}
+			if steps > 0 {
+				player.Resources().UpdateTerraformRating(1)
+				trGained++
+			}
+		}
+
+		if convertibles.Greeneries > 0 {
+			player.Resources().Add(map[shared.ResourceType]int{
+				shared.ResourcePlant: -convertibles.Greeneries * convertibles.PlantCost,
+			})
+			items := make([]string, convertibles.Greeneries)
+			for i := range items {
+				items[i] = "greenery"
+			}
+			queue := &playerPkg.PendingTileSelectionQueue{
+				Items:  items,
+				Source: "convert-plants-to-greenery",
+				OnComplete: &playerPkg.TileCompletionCallback{
+					Type: "convert-plants-to-greenery",
+				},
+			}
+			if err := g.SetPendingTileSelectionQueue(ctx, playerID, queue); err != nil {
+				return fmt.Errorf("failed to queue tile placement: %w", err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return baseaction.Convertibles{}, err
+	}
+
+	a.ConsumePlayerAction(g, log)
+
+	if convertibles.TemperatureSteps > 0 {
+		calculatedOutputs := []game.CalculatedOutput{
+			{ResourceType: string(shared.ResourceTemperature), Amount: convertibles.TemperatureSteps, IsScaled: false},
+		}
+		if trGained > 0 {
+			calculatedOutputs = append(calculatedOutputs, game.CalculatedOutput{
+				ResourceType: string(shared.ResourceTR), Amount: trGained, IsScaled: false,
+			})
+		}
+		displayData := baseaction.GetStandardProjectDisplayData("Convert Heat")
+		a.WriteStateLogFull(ctx, g, "Convert Heat", game.SourceTypeResourceConvert, playerID, "Converted all heat to raise temperature", nil, calculatedOutputs, displayData)
+	}
+
+	log.Info("✅ Converted all heat and plants",
+		zap.Int("temperature_steps", convertibles.TemperatureSteps),
+		zap.Int("greeneries_queued", convertibles.Greeneries))
+	return convertibles, nil
+}
@@ -8,7 +8,7 @@ import (
 	"terraforming-mars-backend/internal/cards"
 	"terraforming-mars-backend/internal/game"
 	gamecards "terraforming-mars-backend/internal/game/cards"
-	"terraforming-mars-backend/internal/game/global_parameters"
+	"terraforming-mars-backend/internal/game/parameters"
 	"terraforming-mars-backend/internal/game/shared"
 
 	"go.uber.org/zap"
@@ -95,9 +95,9 @@ func (a *ConvertHeatToTemperatureAction) Execute(
 
 	var stepsRaised int
 	currentTemp := g.GlobalParameters().Temperature()
-	if currentTemp < global_parameters.MaxTemperature {
+	if !parameters.Temperature.IsMaxed(currentTemp) {
 		var err error
-		stepsRaised, err = g.GlobalParameters().IncreaseTemperature(ctx, 1)
+		stepsRaised, err = g.RaiseTemperature(ctx, playerID, 1)
 		if err != nil {
 			log.Error("Failed to raise temperature", zap.Error(err))
 			return fmt.Errorf("failed to raise temperature: %w", err)
@@ -28,6 +28,7 @@ func CalculatePlayerCardState(
 	errors = append(errors, validatePhase(g)...)
 	errors = append(errors, validateActionsRemaining(p, g)...)
 	errors = append(errors, validateNoActiveTileSelection(p, g)...)
+	errors = append(errors, validateExpansion(card, g)...)
 
 	costMap, discounts := calculateEffectiveCost(card, p, cardRegistry)
 	if len(discounts) > 0 {
@@ -36,12 +37,26 @@ func CalculatePlayerCardState(
 
 	errors = append(errors, validateAffordabilityWithSubstitutes(p, costMap)...)
 	errors = append(errors, validateRequirements(card, p, g, cardRegistry)...)
-	errors = append(errors, validateProductionOutputs(card, p)...)
+	errors = append(errors, validateProductionOutputs(card, p, g)...)
+	if targets, ok := legalAttackTargets(card, p, g); ok {
+		metadata["legalTargets"] = targets
+	}
 
 	tileErrors, tileWarnings := validateTileOutputs(card, p, g)
 	errors = append(errors, tileErrors...)
 	warnings = append(warnings, tileWarnings...)
 
+	// The played card leaves the hand before its discards are paid
+	var discardInputs []shared.ResourceCondition
+	for _, behavior := range card.Behaviors {
+		if gamecards.HasAutoTrigger(behavior) {
+			discardInputs = append(discardInputs, behavior.Inputs...)
+		}
+	}
+	discardErrors, discardWarnings := validateDiscardInputs(discardInputs, len(p.Hand().Cards())-1)
+	errors = append(errors, discardErrors...)
+	warnings = append(warnings, discardWarnings...)
+
 	return player.EntityState{
 		Errors:         errors,
 		Warnings:       warnings,
@@ -56,6 +71,7 @@ func CalculatePlayerCardActionState(
 	cardID string,
 	behavior shared.CardBehavior,
 	timesUsedThisGeneration int,
+	timesUsedThisGame int,
 	p *player.Player,
 	g *game.Game,
 ) player.EntityState {
@@ -75,6 +91,9 @@ func CalculatePlayerCardActionState(
 
 	resources := p.Resources().Get()
 	for _, input := range behavior.Inputs {
+		if input.ResourceType == shared.ResourceCardDiscard {
+			continue
+		}
 		available := getResourceAmount(resources, input.ResourceType)
 		if available < input.Amount {
 			errors = append(errors, player.StateError{
@@ -103,12 +122,15 @@ func CalculatePlayerCardActionState(
 		}
 	}
 
-	errors = append(errors, validateActionUsageLimit(behavior, timesUsedThisGeneration)...)
+	errors = append(errors, validateActionUsageLimit(behavior, timesUsedThisGeneration, timesUsedThisGame)...)
 	errors = append(errors, validateBehaviorTileOutputs(behavior, p, g)...)
 	errors = append(errors, validateGenerationalEventRequirements(behavior, p)...)
+	discardErrors, discardWarnings := validateDiscardInputs(behavior.Inputs, len(p.Hand().Cards()))
+	errors = append(errors, discardErrors...)
 
 	return player.EntityState{
 		Errors:         errors,
+		Warnings:       discardWarnings,
 		Cost:           make(map[string]int), // Actions typically don't have credit costs (empty map)
 		Metadata:       make(map[string]interface{}),
 		LastCalculated: time.Now(),
@@ -256,6 +278,18 @@ func validatePhase(g *game.Game) []player.StateError {
 	return nil
 }
 
+// validateExpansion checks that the card's expansion is enabled in the game.
+func validateExpansion(card *gamecards.Card, g *game.Game) []player.StateError {
+	if !game.IsExpansionPack(card.Pack) || g.Settings().PackEnabled(card.Pack) {
+		return nil
+	}
+	return []player.StateError{{
+		Code:     player.ErrorCodeExpansionDisabled,
+		Category: player.ErrorCategoryConfiguration,
+		Message:  fmt.Sprintf("The %s expansion is not enabled", card.Pack),
+	}}
+}
+
 // validateNoActiveTileSelection checks if player has an active tile selection pending.
 func validateNoActiveTileSelection(p *player.Player, g *game.Game) []player.StateError {
 	if g.GetPendingTileSelection(p.ID()) != nil {
@@ -268,33 +302,26 @@ func validateNoActiveTileSelection(p *player.Player, g *game.Game) []player.Stat
 	return nil
 }
 
-// validateActionUsageLimit checks if the action has already been used this generation.
-// Manual trigger actions can only be used once per generation by default.
+// validateActionUsageLimit checks the behavior's usage limit.
+// Manual trigger actions can only be used once per generation unless the behavior sets its own limit.
 func validateActionUsageLimit(
 	behavior shared.CardBehavior,
 	timesUsedThisGeneration int,
+	timesUsedThisGame int,
 ) []player.StateError {
-	hasManualTrigger := false
-	for _, trigger := range behavior.Triggers {
-		if trigger.Type == shared.TriggerTypeManual {
-			hasManualTrigger = true
-			break
-		}
-	}
-
-	if !hasManualTrigger {
+	if !behavior.UsageLimitReached(timesUsedThisGeneration, timesUsedThisGame) {
 		return nil
 	}
 
-	if timesUsedThisGeneration >= 1 {
-		return []player.StateError{{
-			Code:     player.ErrorCodeActionAlreadyPlayed,
-			Category: player.ErrorCategoryAvailability,
-			Message:  "Already played",
-		}}
+	message := "Already played"
+	if behavior.EffectiveUsageLimit().Per == shared.UsagePeriodGame {
+		message = "Already played this game"
 	}
-
-	return nil
+	return []player.StateError{{
+		Code:     player.ErrorCodeActionAlreadyPlayed,
+		Category: player.ErrorCategoryAvailability,
+		Message:  message,
+	}}
 }
 
 // validateRequirements checks all card requirements.
@@ -321,19 +348,22 @@ func validateRequirements(
 	return errors
 }
 
-// validateProductionOutputs checks that player has enough production for negative production outputs.
-// Cards like "Urbanized Area" have negative production outputs (e.g., -1 energy production).
-// The player must have at least that much production to play the card.
+// validateProductionOutputs checks that negative production outputs can be applied in full.
+// Cards like "Urbanized Area" have negative production outputs (e.g., -1 energy production);
+// MC production may drop to -5, every other production to 0, and a decrease that would cross
+// the floor makes the card unplayable rather than being clamped.
+// Decreases aimed at any player need at least one player (possibly the card's owner) who can take them;
+// in solo games they hit the neutral opponent and are always legal.
 func validateProductionOutputs(
 	card *gamecards.Card,
 	p *player.Player,
+	g *game.Game,
 ) []player.StateError {
 	if len(card.Behaviors) == 0 {
 		return nil
 	}
 
 	var errors []player.StateError
-	production := p.Resources().Production()
 
 	// Check all behaviors for auto-triggers with negative production outputs
 	for _, behavior := range card.Behaviors {
@@ -342,50 +372,28 @@ func validateProductionOutputs(
 			continue
 		}
 
-		// Check outputs for negative production
 		for _, output := range behavior.Outputs {
-			// Only check production resource types with negative amounts
-			if output.Amount >= 0 {
+			if output.Amount >= 0 || !shared.IsProduction(output.ResourceType) {
 				continue
 			}
 
-			// Map production resource types to base resource types for checking
-			var baseResourceType shared.ResourceType
-			switch output.ResourceType {
-			case shared.ResourceCreditProduction:
-				baseResourceType = shared.ResourceCredit
-			case shared.ResourceSteelProduction:
-				baseResourceType = shared.ResourceSteel
-			case shared.ResourceTitaniumProduction:
-				baseResourceType = shared.ResourceTitanium
-			case shared.ResourcePlantProduction:
-				baseResourceType = shared.ResourcePlant
-			case shared.ResourceEnergyProduction:
-				baseResourceType = shared.ResourceEnergy
-			case shared.ResourceHeatProduction:
-				baseResourceType = shared.ResourceHeat
-			default:
-				// Not a production type, skip
+			if output.Target == "any-player" {
+				if len(g.GetAllPlayers()) <= 1 || len(gamecards.LegalAttackTargets(g.GetAllPlayers(), p.ID(), []shared.ResourceCondition{output})) > 0 {
+					continue
+				}
+				errors = append(errors, player.StateError{
+					Code:     player.ErrorCodeInsufficientProduction,
+					Category: player.ErrorCategoryRequirement,
+					Message:  fmt.Sprintf("No player has enough %s production", getResourceDisplayName(productionBaseResource(output.ResourceType))),
+				})
 				continue
 			}
 
-			// Check if player has enough production
-			currentProduction := getProductionAmount(production, baseResourceType)
-			resultingProduction := currentProduction + output.Amount // output.Amount is negative
-
-			// MC production can go to -5, others cannot go below 0
-			var minProduction int
-			if baseResourceType == shared.ResourceCredit {
-				minProduction = shared.MinCreditProduction
-			} else {
-				minProduction = shared.MinOtherProduction
-			}
-
-			if resultingProduction < minProduction {
+			if !p.Resources().CanAddProduction(map[shared.ResourceType]int{output.ResourceType: output.Amount}) {
 				errors = append(errors, player.StateError{
 					Code:     player.ErrorCodeInsufficientProduction,
 					Category: player.ErrorCategoryRequirement,
-					Message:  formatInsufficientProductionMessage(baseResourceType),
+					Message:  formatInsufficientProductionMessage(productionBaseResource(output.ResourceType)),
 				})
 			}
 		}
@@ -394,6 +402,52 @@ func validateProductionOutputs(
 	return errors
 }
 
+// legalAttackTargets lists who the card's immediate attacks may hit, in turn order.
+// Reports false for cards without attacks and in solo games, where attacks hit the neutral opponent.
+func legalAttackTargets(card *gamecards.Card, p *player.Player, g *game.Game) ([]string, bool) {
+	players := g.GetAllPlayers()
+	if len(players) <= 1 {
+		return nil, false
+	}
+
+	var attacks []shared.ResourceCondition
+	for _, behavior := range card.Behaviors {
+		if !gamecards.HasAutoTrigger(behavior) {
+			continue
+		}
+		_, outputs := behavior.ExtractInputsOutputs(nil)
+		for _, output := range outputs {
+			if gamecards.IsAttackOutput(output) {
+				attacks = append(attacks, output)
+			}
+		}
+	}
+	if len(attacks) == 0 {
+		return nil, false
+	}
+	return gamecards.LegalAttackTargets(players, p.ID(), attacks), true
+}
+
+// productionBaseResource maps a production type to its base resource (e.g., "steel-production" to "steel")
+func productionBaseResource(productionType shared.ResourceType) shared.ResourceType {
+	switch productionType {
+	case shared.ResourceCreditProduction:
+		return shared.ResourceCredit
+	case shared.ResourceSteelProduction:
+		return shared.ResourceSteel
+	case shared.ResourceTitaniumProduction:
+		return shared.ResourceTitanium
+	case shared.ResourcePlantProduction:
+		return shared.ResourcePlant
+	case shared.ResourceEnergyProduction:
+		return shared.ResourceEnergy
+	case shared.ResourceHeatProduction:
+		return shared.ResourceHeat
+	default:
+		return productionType
+	}
+}
+
 // validateTileOutputs checks that the board has available placements for any tile outputs.
 // If a card outputs city/greenery/ocean tiles, the player must have valid placement locations.
 // Returns both errors (blocking) and warnings (non-blocking).
@@ -464,6 +518,16 @@ func validateTileOutputs(
 						Message:  "No ocean tiles remaining",
 					})
 				}
+
+			case shared.ResourceSpecialPlacement:
+				specialPlacements := g.CountAvailableHexesForTile(output.SpecialTileType, p.ID(), output.TileRestrictions)
+				if specialPlacements == 0 {
+					errors = append(errors, player.StateError{
+						Code:     player.ErrorCodeNoSpecialPlacements,
+						Category: player.ErrorCategoryAvailability,
+						Message:  "No valid placements for this tile",
+					})
+				}
 			}
 		}
 	}
@@ -564,6 +628,15 @@ func validateBehaviorTileOutputs(
 					Message:  "No ocean tiles remaining",
 				})
 			}
+		case shared.ResourceSpecialPlacement:
+			specialPlacements := g.CountAvailableHexesForTile(output.SpecialTileType, p.ID(), output.TileRestrictions)
+			if specialPlacements == 0 {
+				errors = append(errors, player.StateError{
+					Code:     player.ErrorCodeNoSpecialPlacements,
+					Category: player.ErrorCategoryAvailability,
+					Message:  "No valid placements for this tile",
+				})
+			}
 		}
 	}
 
@@ -657,29 +730,8 @@ func checkRequirement(
 		}
 
 		tagCount := 0
-		for _, playedCardID := range p.PlayedCards().Cards() {
-			if cardRegistry == nil {
-				continue
-			}
-			card, err := cardRegistry.GetByID(playedCardID)
-			if err != nil {
-				continue
-			}
-			for _, tag := range card.Tags {
-				if tag == *req.Tag {
-					tagCount++
-				}
-			}
-		}
-
-		if corpID := p.CorporationID(); corpID != "" && cardRegistry != nil {
-			if corp, err := cardRegistry.GetByID(corpID); err == nil {
-				for _, tag := range corp.Tags {
-					if tag == *req.Tag {
-						tagCount++
-					}
-				}
-			}
+		if cardRegistry != nil {
+			tagCount = gamecards.CountPlayerTagsByType(p, cardRegistry, *req.Tag)
 		}
 
 		if req.Min != nil && tagCount < *req.Min {
@@ -939,6 +991,31 @@ func GetStandardProjectBaseCosts(projectType shared.StandardProject) map[string]
 	return getStandardProjectBaseCosts(projectType)
 }
 
+// validateDiscardInputs checks a hand of handSize cards can pay the card-discard inputs,
+// and prompts for the discard selection when it can
+func validateDiscardInputs(inputs []shared.ResourceCondition, handSize int) ([]player.StateError, []player.StateWarning) {
+	count := 0
+	for _, input := range inputs {
+		if input.ResourceType == shared.ResourceCardDiscard {
+			count += input.Amount
+		}
+	}
+	if count == 0 {
+		return nil, nil
+	}
+	if handSize < count {
+		return []player.StateError{{
+			Code:     player.ErrorCodeNoCardsInHand,
+			Category: player.ErrorCategoryInput,
+			Message:  fmt.Sprintf("Need %d other cards in hand to discard", count),
+		}}, nil
+	}
+	return nil, []player.StateWarning{{
+		Code:    player.WarningCodeDiscardSelectionRequired,
+		Message: fmt.Sprintf("Choose %d cards from hand to discard", count),
+	}}
+}
+
 // getResourceAmount extracts the amount of a specific resource from Resources.
 func getResourceAmount(resources shared.Resources, resourceType shared.ResourceType) int {
 	switch resourceType {
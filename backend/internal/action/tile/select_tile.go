@@ -3,8 +3,6 @@ package tile
 import (
 	"context"
 	"fmt"
-	"strconv"
-	"strings"
 	baseaction "terraforming-mars-backend/internal/action"
 	"time"
 
@@ -72,6 +70,14 @@ func (a *SelectTileAction) Execute(ctx context.Context, gameID string, playerID
 		return nil, fmt.Errorf("no pending tile selection found for player %s", playerID)
 	}
 
+	// Players may name the hex by label or area name; availability is checked on the coordinates
+	coords, err := resolveSelectedHex(g, selectedHex)
+	if err != nil {
+		log.Warn("Failed to resolve hex", zap.String("hex", selectedHex), zap.Error(err))
+		return nil, fmt.Errorf("invalid hex format: %w", err)
+	}
+	selectedHex = coords.String()
+
 	hexIsValid := false
 	for _, availableHex := range pendingTileSelection.AvailableHexes {
 		if availableHex == selectedHex {
@@ -86,12 +92,6 @@ func (a *SelectTileAction) Execute(ctx context.Context, gameID string, playerID
 		return nil, fmt.Errorf("selected hex %s is not valid for placement", selectedHex)
 	}
 
-	coords, err := parseHexPosition(selectedHex)
-	if err != nil {
-		log.Warn("Failed to parse hex coordinates", zap.String("hex", selectedHex), zap.Error(err))
-		return nil, fmt.Errorf("invalid hex format: %w", err)
-	}
-
 	tileType := pendingTileSelection.TileType
 
 	// Handle land claims differently - they reserve a tile instead of placing an occupant
@@ -134,6 +134,9 @@ func (a *SelectTileAction) Execute(ctx context.Context, gameID string, playerID
 		Type: mapTileTypeToResourceType(tileType),
 		Tags: []string{},
 	}
+	if board.IsSpecialTileType(tileType) {
+		occupant.SpecialTileType = tileType
+	}
 
 	if err := g.Board().UpdateTileOccupancy(ctx, *coords, occupant, playerID); err != nil {
 		log.Warn("Failed to place tile", zap.Error(err))
@@ -201,6 +204,16 @@ func (a *SelectTileAction) Execute(ctx context.Context, gameID string, playerID
 				zap.Any("resources", resourceBonuses))
 		}
 
+		// Mining Area and Mining Rights also raise the production of the bonus they cover
+		if restrictions := pendingTileSelection.TileRestrictions; restrictions != nil && len(restrictions.CaptureBonus) > 0 {
+			if bonusType, ok := placedTile.BonusOf(restrictions.CaptureBonus); ok {
+				productionType := shared.ResourceType(string(bonusType) + "-production")
+				p.Resources().AddProduction(map[shared.ResourceType]int{productionType: 1})
+				log.Info("⛏️ Captured placement bonus as production",
+					zap.String("production", string(productionType)))
+			}
+		}
+
 		// Clear bonuses from tile after claiming
 		if err := g.Board().ClearTileBonuses(ctx, *coords); err != nil {
 			log.Warn("Failed to clear tile bonuses", zap.Error(err))
@@ -219,7 +232,7 @@ func (a *SelectTileAction) Execute(ctx context.Context, gameID string, playerID
 		log.Info("🏙️ City placed (no TR bonus)")
 
 	case "greenery":
-		actualSteps, err := g.GlobalParameters().IncreaseOxygen(ctx, 1)
+		actualSteps, err := g.RaiseOxygen(ctx, p.ID(), 1)
 		if err != nil {
 			return nil, fmt.Errorf("failed to increase oxygen: %w", err)
 		}
@@ -273,32 +286,12 @@ func (a *SelectTileAction) Execute(ctx context.Context, gameID string, playerID
 	return result, nil
 }
 
-func parseHexPosition(hexStr string) (*shared.HexPosition, error) {
-	parts := strings.Split(hexStr, ",")
-	if len(parts) != 3 {
-		return nil, fmt.Errorf("expected 3 coordinates, got %d", len(parts))
-	}
-
-	q, err := strconv.Atoi(parts[0])
-	if err != nil {
-		return nil, fmt.Errorf("invalid q coordinate: %w", err)
-	}
-
-	r, err := strconv.Atoi(parts[1])
-	if err != nil {
-		return nil, fmt.Errorf("invalid r coordinate: %w", err)
-	}
-
-	s, err := strconv.Atoi(parts[2])
+func resolveSelectedHex(g *game.Game, hexID string) (*shared.HexPosition, error) {
+	position, err := g.Board().ResolveHex(hexID)
 	if err != nil {
-		return nil, fmt.Errorf("invalid s coordinate: %w", err)
-	}
-
-	if q+r+s != 0 {
-		return nil, fmt.Errorf("invalid cube coordinates: q+r+s must equal 0")
+		return nil, err
 	}
-
-	return &shared.HexPosition{Q: q, R: r, S: s}, nil
+	return &position, nil
 }
 
 func mapTileTypeToResourceType(tileType string) shared.ResourceType {
@@ -310,6 +303,9 @@ func mapTileTypeToResourceType(tileType string) shared.ResourceType {
 	case "ocean":
 		return shared.ResourceOceanTile
 	default:
+		if board.IsSpecialTileType(tileType) {
+			return shared.ResourceSpecialTile
+		}
 		return shared.ResourceType(tileType)
 	}
 }
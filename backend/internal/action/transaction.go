@@ -0,0 +1,70 @@
+package action
+
+import (
+	"context"
+
+	"terraforming-mars-backend/internal/cards"
+	"terraforming-mars-backend/internal/game"
+
+	"go.uber.org/zap"
+)
+
+// RunInTransaction runs the mutating part of an action as a single unit of work
+// If fn returns an error or panics, every change it made to the game is rolled back,
+// so a failure half-way through (e.g. after paying but before outputs apply) never leaves partial state
+// ctx is bound as the game's action context while fn runs; if it ends before fn returns, the action
+// is rolled back too and game.ErrActionTimedOut (or the cancellation) is returned instead
+func RunInTransaction(ctx context.Context, g *game.Game, log *zap.Logger, fn func() error) (err error) {
+	if err := game.ContextError(ctx); err != nil {
+		log.Warn("⏱️ Action abandoned before it started", zap.Error(err))
+		return err
+	}
+
+	tx := g.BeginTransaction()
+	unbind := g.BindActionContext(ctx)
+	defer unbind()
+
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+			log.Error("↩️ Rolled back action after panic", zap.Any("panic", r))
+			panic(r)
+		}
+	}()
+
+	err = fn()
+	if ctxErr := game.ContextError(ctx); ctxErr != nil {
+		err = ctxErr
+	}
+	if err != nil {
+		tx.Rollback()
+		log.Warn("↩️ Rolled back action after failure", zap.Error(err))
+		return err
+	}
+
+	tx.Commit()
+	return nil
+}
+
+// CloneGame copies a game for a dry run and re-creates the subscriptions actions registered on it:
+// every passive card effect and every cached hand card is subscribed again on the clone's event bus
+// Running actions against the clone never touches the original game or its listeners
+func CloneGame(ctx context.Context, g *game.Game, cardRegistry cards.CardRegistry, log *zap.Logger) *game.Game {
+	clone := g.Clone()
+	for _, p := range clone.GetAllPlayers() {
+		for _, effect := range p.Effects().List() {
+			SubscribePassiveEffectToEvents(ctx, clone, p, effect, log, cardRegistry)
+		}
+		for _, cardID := range p.Hand().Cards() {
+			card, err := cardRegistry.GetByID(cardID)
+			if err != nil {
+				log.Warn("Failed to get card from registry, skipping PlayerCard creation",
+					zap.String("card_id", cardID),
+					zap.Error(err))
+				continue
+			}
+			CreateAndCachePlayerCard(card, p, clone, cardRegistry)
+		}
+	}
+	return clone
+}
@@ -0,0 +1,98 @@
+package turn_management
+
+import (
+	"context"
+	"fmt"
+
+	baseaction "terraforming-mars-backend/internal/action"
+
+	"go.uber.org/zap"
+	"terraforming-mars-backend/internal/cards"
+	"terraforming-mars-backend/internal/game"
+	playerPkg "terraforming-mars-backend/internal/game/player"
+)
+
+// AutoPassAction passes for players who enabled auto-pass and start their turn with no legal action
+// It runs after every gameplay message, so a chain of players who are out of moves all pass at once
+type AutoPassAction struct {
+	baseaction.BaseAction
+	skipAction   *SkipActionAction
+	cardRegistry cards.CardRegistry
+}
+
+// NewAutoPassAction creates a new auto-pass action
+func NewAutoPassAction(
+	gameRepo game.GameRepository,
+	skipAction *SkipActionAction,
+	cardRegistry cards.CardRegistry,
+	logger *zap.Logger,
+) *AutoPassAction {
+	return &AutoPassAction{
+		BaseAction:   baseaction.NewBaseAction(gameRepo, nil),
+		skipAction:   skipAction,
+		cardRegistry: cardRegistry,
+	}
+}
+
+// Execute passes for the current player while they qualify and returns the IDs of players who were passed
+func (a *AutoPassAction) Execute(ctx context.Context, gameID string) ([]string, error) {
+	g, err := a.GameRepository().Get(ctx, gameID)
+	if err != nil {
+		return nil, fmt.Errorf("game not found: %s", gameID)
+	}
+
+	var passed []string
+	// Each pass moves the turn on, so no more than one pass per seat can apply
+	for range g.TurnOrder() {
+		p := a.playerToAutoPass(g)
+		if p == nil {
+			break
+		}
+
+		log := a.InitLogger(gameID, p.ID()).With(zap.String("action", "auto_pass"))
+		log.Info("⏩ No legal action, passing automatically")
+
+		if err := a.skipAction.ExecuteConfirmed(ctx, gameID, p.ID()); err != nil {
+			log.Error("Failed to auto-pass", zap.Error(err))
+			return passed, fmt.Errorf("failed to auto-pass for %s: %w", p.ID(), err)
+		}
+		passed = append(passed, p.ID())
+	}
+
+	return passed, nil
+}
+
+// playerToAutoPass returns the current player when their turn has just started, they enabled
+// auto-pass, nothing is pending for them and they have no legal action; otherwise nil
+func (a *AutoPassAction) playerToAutoPass(g *game.Game) *playerPkg.Player {
+	if g.Status() != game.GameStatusActive || g.IsPaused() || g.CurrentPhase() != game.GamePhaseAction {
+		return nil
+	}
+
+	currentTurn := g.CurrentTurn()
+	if currentTurn == nil {
+		return nil
+	}
+	// A two-action turn that has already used one action is no longer at its start
+	if actions := currentTurn.ActionsRemaining(); actions != 2 && actions != -1 {
+		return nil
+	}
+
+	p, err := g.GetPlayer(currentTurn.PlayerID())
+	if err != nil || !p.AutoPass() || p.HasPassed() {
+		return nil
+	}
+
+	if g.GetPendingTileSelection(p.ID()) != nil ||
+		g.GetForcedFirstAction(p.ID()) != nil ||
+		g.GetPendingCardPlay(p.ID()) != nil ||
+		p.Selection().GetPendingCardSelection() != nil ||
+		p.Selection().GetPendingCardDrawSelection() != nil {
+		return nil
+	}
+
+	if baseaction.HasLegalAction(g, p, a.cardRegistry) {
+		return nil
+	}
+	return p
+}
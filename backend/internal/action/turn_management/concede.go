@@ -0,0 +1,181 @@
+package turn_management
+
+import (
+	"context"
+	"fmt"
+
+	"go.uber.org/zap"
+
+	baseaction "terraforming-mars-backend/internal/action"
+	"terraforming-mars-backend/internal/game"
+	playerPkg "terraforming-mars-backend/internal/game/player"
+	"terraforming-mars-backend/internal/game/shared"
+)
+
+// ConcedeAction lets a player leave a running game
+// A solo concession abandons the game and a two-player concession ends it with the opponent scored
+// as the only player. With more players the game continues without them: their tiles stay on the
+// board and their production is dealt out around the table, starting with the next player
+type ConcedeAction struct {
+	baseaction.BaseAction
+	skipAction *SkipActionAction
+}
+
+// NewConcedeAction creates a new concede action
+func NewConcedeAction(
+	gameRepo game.GameRepository,
+	skipAction *SkipActionAction,
+) *ConcedeAction {
+	return &ConcedeAction{
+		BaseAction: baseaction.NewBaseAction(gameRepo, nil),
+		skipAction: skipAction,
+	}
+}
+
+// Execute performs the concede action
+func (a *ConcedeAction) Execute(ctx context.Context, gameID string, playerID string) error {
+	log := a.InitLogger(gameID, playerID).With(zap.String("action", "concede"))
+	log.Info("🏳️ Player conceding")
+
+	g, err := baseaction.ValidateRespondableGame(ctx, a.GameRepository(), gameID, log)
+	if err != nil {
+		return err
+	}
+
+	if g.CurrentPhase() != game.GamePhaseAction {
+		log.Warn("Cannot concede outside the action phase", zap.String("phase", string(g.CurrentPhase())))
+		return fmt.Errorf("can only concede during the action phase")
+	}
+
+	if _, err := g.GetPlayer(playerID); err != nil {
+		log.Error("Player not found in game")
+		return fmt.Errorf("player not found in game")
+	}
+
+	switch len(g.GetAllPlayers()) {
+	case 1:
+		if err := g.Concede(ctx, playerID); err != nil {
+			return fmt.Errorf("failed to concede: %w", err)
+		}
+		if err := g.UpdateStatus(ctx, game.GameStatusAbandoned); err != nil {
+			log.Error("Failed to abandon game", zap.Error(err))
+			return fmt.Errorf("failed to abandon game: %w", err)
+		}
+		log.Info("✅ Solo game abandoned")
+		return nil
+
+	case 2:
+		if err := g.Concede(ctx, playerID); err != nil {
+			return fmt.Errorf("failed to concede: %w", err)
+		}
+		if err := a.skipAction.finalScoringAction.Execute(ctx, gameID); err != nil {
+			log.Error("Failed to execute final scoring", zap.Error(err))
+			return fmt.Errorf("failed to execute final scoring: %w", err)
+		}
+		log.Info("✅ Game ended by concession", zap.String("winner_id", g.GetWinnerID()))
+		return nil
+	}
+
+	turnOrder := g.TurnOrder()
+	seat := -1
+	for i, id := range turnOrder {
+		if id == playerID {
+			seat = i
+			break
+		}
+	}
+	if seat == -1 {
+		log.Error("Player not found in turn order")
+		return fmt.Errorf("player not found in turn order")
+	}
+
+	currentTurn := g.CurrentTurn()
+	heldTurn := currentTurn != nil && currentTurn.PlayerID() == playerID
+
+	conceding, _ := g.GetPlayer(playerID)
+	production := conceding.Resources().Production()
+
+	if err := g.Concede(ctx, playerID); err != nil {
+		return fmt.Errorf("failed to concede: %w", err)
+	}
+
+	// Remaining players in table order, starting with the one after the conceding seat
+	turnOrder = g.TurnOrder()
+	recipients := make([]*playerPkg.Player, 0, len(turnOrder))
+	for i := range turnOrder {
+		p, _ := g.GetPlayer(turnOrder[(seat+i)%len(turnOrder)])
+		if p != nil {
+			recipients = append(recipients, p)
+		}
+	}
+	redistributeProduction(production, recipients)
+
+	if g.HostPlayerID() == playerID {
+		if err := g.SetHostPlayerID(ctx, recipients[0].ID()); err != nil {
+			log.Error("Failed to transfer host", zap.Error(err))
+			return fmt.Errorf("failed to transfer host: %w", err)
+		}
+	}
+
+	var active []*playerPkg.Player
+	for _, p := range recipients {
+		if !p.HasPassed() {
+			active = append(active, p)
+		}
+	}
+
+	switch {
+	case len(active) == 0:
+		// The conceding player was the last one still acting this generation
+		if err := a.skipAction.endGeneration(ctx, g, log); err != nil {
+			return err
+		}
+	case heldTurn:
+		actions := 2
+		if len(active) == 1 {
+			actions = -1
+		}
+		if err := g.SetCurrentTurn(ctx, active[0].ID(), actions); err != nil {
+			log.Error("Failed to update current turn", zap.Error(err))
+			return fmt.Errorf("failed to update game: %w", err)
+		}
+	case len(active) == 1:
+		if err := g.SetCurrentTurn(ctx, active[0].ID(), -1); err != nil {
+			log.Error("Failed to grant unlimited actions to last player", zap.Error(err))
+			return fmt.Errorf("failed to grant unlimited actions: %w", err)
+		}
+	}
+
+	log.Info("✅ Player conceded, game continues",
+		zap.Int("remaining_players", len(recipients)),
+		zap.Bool("held_turn", heldTurn))
+	return nil
+}
+
+// redistributeProduction deals each positive production of a conceding player out one step at a time,
+// round the table in recipient order; negative M€ production is dropped with the player
+func redistributeProduction(production shared.Production, recipients []*playerPkg.Player) {
+	if len(recipients) == 0 {
+		return
+	}
+
+	amounts := []struct {
+		resourceType shared.ResourceType
+		amount       int
+	}{
+		{shared.ResourceCreditProduction, production.Credits},
+		{shared.ResourceSteelProduction, production.Steel},
+		{shared.ResourceTitaniumProduction, production.Titanium},
+		{shared.ResourcePlantProduction, production.Plants},
+		{shared.ResourceEnergyProduction, production.Energy},
+		{shared.ResourceHeatProduction, production.Heat},
+	}
+
+	next := 0
+	for _, entry := range amounts {
+		for i := 0; i < entry.amount; i++ {
+			recipients[next].Resources().AddProduction(map[shared.ResourceType]int{entry.resourceType: 1})
+			next = (next + 1) % len(recipients)
+		}
+	}
+}
@@ -0,0 +1,110 @@
+package turn_management
+
+import (
+	"context"
+	"fmt"
+
+	"go.uber.org/zap"
+
+	"terraforming-mars-backend/internal/game"
+)
+
+// MulliganStartingHandAction redraws a player's dealt project cards under the mulligan house rule
+// Each player may redraw once, before choosing a corporation; the old cards go to the discard pile
+type MulliganStartingHandAction struct {
+	gameRepo  game.GameRepository
+	stateRepo game.GameStateRepository
+	logger    *zap.Logger
+}
+
+// NewMulliganStartingHandAction creates a new mulligan starting hand action
+func NewMulliganStartingHandAction(
+	gameRepo game.GameRepository,
+	stateRepo game.GameStateRepository,
+	logger *zap.Logger,
+) *MulliganStartingHandAction {
+	return &MulliganStartingHandAction{
+		gameRepo:  gameRepo,
+		stateRepo: stateRepo,
+		logger:    logger,
+	}
+}
+
+// Execute replaces the player's dealt project cards with the same number of new ones
+// Corporations and preludes are kept
+func (a *MulliganStartingHandAction) Execute(ctx context.Context, gameID string, playerID string) error {
+	log := a.logger.With(
+		zap.String("game_id", gameID),
+		zap.String("player_id", playerID),
+		zap.String("action", "mulligan_starting_hand"),
+	)
+	log.Info("🔁 Player redrawing starting hand")
+
+	g, err := a.gameRepo.Get(ctx, gameID)
+	if err != nil {
+		log.Error("Failed to get game", zap.Error(err))
+		return fmt.Errorf("game not found: %s", gameID)
+	}
+
+	if !g.Settings().RulesOptions.MulliganStartingHand {
+		log.Warn("Mulligan rule not enabled")
+		return fmt.Errorf("the mulligan rule is not enabled in this game")
+	}
+
+	if g.CurrentPhase() != game.GamePhaseStartingCardSelection {
+		log.Warn("Game is not in starting card selection", zap.String("current_phase", string(g.CurrentPhase())))
+		return fmt.Errorf("starting hands can only be redrawn during starting card selection")
+	}
+
+	p, err := g.GetPlayer(playerID)
+	if err != nil {
+		log.Error("Player not found in game", zap.Error(err))
+		return fmt.Errorf("player not found: %s", playerID)
+	}
+
+	selectionPhase := g.GetSelectStartingCardsPhase(playerID)
+	if selectionPhase == nil || p.HasCorporation() {
+		log.Warn("Starting selection already complete")
+		return fmt.Errorf("starting selection already complete")
+	}
+
+	if selectionPhase.Mulliganed {
+		log.Warn("Starting hand already redrawn")
+		return fmt.Errorf("starting hand already redrawn")
+	}
+
+	deck := g.Deck()
+	if deck == nil {
+		log.Error("Game deck not initialized")
+		return fmt.Errorf("game deck not initialized")
+	}
+
+	oldCards := selectionPhase.AvailableCards
+	newCards, err := deck.DrawProjectCards(ctx, len(oldCards))
+	if err != nil {
+		log.Error("Failed to draw new starting hand", zap.Error(err))
+		return fmt.Errorf("failed to draw new starting hand: %w", err)
+	}
+	if err := deck.Discard(ctx, oldCards); err != nil {
+		log.Error("Failed to discard old starting hand", zap.Error(err))
+		return fmt.Errorf("failed to discard old starting hand: %w", err)
+	}
+
+	redrawn := *selectionPhase
+	redrawn.AvailableCards = newCards
+	redrawn.Mulliganed = true
+	if err := g.SetSelectStartingCardsPhase(ctx, playerID, &redrawn); err != nil {
+		log.Error("Failed to update starting selection", zap.Error(err))
+		return fmt.Errorf("failed to update starting selection: %w", err)
+	}
+
+	if a.stateRepo != nil {
+		if _, err := a.stateRepo.WriteFull(ctx, gameID, g, "Mulligan", game.SourceTypeGameEvent, playerID,
+			fmt.Sprintf("Redrew %d starting cards", len(newCards)), nil, nil, nil); err != nil {
+			log.Warn("Failed to write log entry", zap.Error(err))
+		}
+	}
+
+	log.Info("✅ Starting hand redrawn", zap.Int("card_count", len(newCards)))
+	return nil
+}
@@ -39,13 +39,15 @@ func NewSelectStartingCardsAction(
 }
 
 // Execute performs the select starting cards action
-func (a *SelectStartingCardsAction) Execute(ctx context.Context, gameID string, playerID string, cardIDs []string, corporationID string) error {
+// preludeIDs must name exactly KeptPreludes of the dealt preludes when any were dealt, and be empty otherwise
+func (a *SelectStartingCardsAction) Execute(ctx context.Context, gameID string, playerID string, cardIDs []string, corporationID string, preludeIDs []string) error {
 	log := a.logger.With(
 		zap.String("game_id", gameID),
 		zap.String("player_id", playerID),
 		zap.String("action", "select_starting_cards"),
 		zap.Strings("card_ids", cardIDs),
 		zap.String("corporation_id", corporationID),
+		zap.Strings("prelude_ids", preludeIDs),
 	)
 	log.Info("🃏 Player selecting starting cards and corporation")
 
@@ -76,17 +78,23 @@ func (a *SelectStartingCardsAction) Execute(ctx context.Context, gameID string,
 		return fmt.Errorf("starting selection already complete")
 	}
 
-	// 5. BUSINESS LOGIC: Validate selected cards are in available cards
+	// 5. BUSINESS LOGIC: Validate selected cards were dealt to this player, each kept at most once
 	availableSet := make(map[string]bool)
 	for _, id := range selectionPhase.AvailableCards {
 		availableSet[id] = true
 	}
 
+	keptSet := make(map[string]bool, len(cardIDs))
 	for _, cardID := range cardIDs {
 		if !availableSet[cardID] {
 			log.Error("Selected card not available", zap.String("card_id", cardID))
 			return fmt.Errorf("card %s not available for selection", cardID)
 		}
+		if keptSet[cardID] {
+			log.Error("Selected card kept twice", zap.String("card_id", cardID))
+			return fmt.Errorf("card %s selected more than once", cardID)
+		}
+		keptSet[cardID] = true
 	}
 
 	// 6. BUSINESS LOGIC: Validate corporation is in available corporations
@@ -103,6 +111,12 @@ func (a *SelectStartingCardsAction) Execute(ctx context.Context, gameID string,
 		return fmt.Errorf("corporation %s not available", corporationID)
 	}
 
+	// 6a. BUSINESS LOGIC: Validate kept preludes were dealt to this player
+	if err := validateKeptPreludes(selectionPhase.AvailablePreludes, preludeIDs); err != nil {
+		log.Error("Invalid prelude selection", zap.Error(err))
+		return err
+	}
+
 	// 7. BUSINESS LOGIC: Calculate cost (3 MC per card)
 	cost := len(cardIDs) * 3
 
@@ -120,6 +134,16 @@ func (a *SelectStartingCardsAction) Execute(ctx context.Context, gameID string,
 		return fmt.Errorf("card %s is not a corporation card", corporationID)
 	}
 
+	// 8a. BUSINESS LOGIC: Reject selections the corporation's starting M€ cannot pay for, before
+	// anything is applied, so the player can choose again
+	startingCredits := player.Resources().Get().Credits + a.corpProc.StartingCredits(corpCard)
+	if startingCredits < cost {
+		log.Warn("Starting selection not affordable",
+			zap.Int("cost", cost),
+			zap.Int("starting_credits", startingCredits))
+		return fmt.Errorf("insufficient credits: %d cards cost %d, %s starts with %d", len(cardIDs), cost, corpCard.Name, startingCredits)
+	}
+
 	// 9. BUSINESS LOGIC: Set corporation ID on player
 	player.SetCorporationID(corporationID)
 	log.Info("✅ Corporation selected", zap.String("corporation_id", corporationID))
@@ -222,14 +246,33 @@ func (a *SelectStartingCardsAction) Execute(ctx context.Context, gameID string,
 		zap.Int("count", len(cardIDs)))
 
 	baseaction.AddCardsToPlayerHand(cardIDs, player, g, a.cardRegistry, log)
+	g.RecordCardsBought(playerID, len(cardIDs))
 
 	log.Info("✅ Cards added to hand",
 		zap.Strings("card_ids_added", cardIDs),
 		zap.Int("card_count", len(cardIDs)))
 
+	// 12a. BUSINESS LOGIC: Discard the dealt project cards the player did not keep
+	unkeptCards := make([]string, 0, len(selectionPhase.AvailableCards))
+	for _, cardID := range selectionPhase.AvailableCards {
+		if !keptSet[cardID] {
+			unkeptCards = append(unkeptCards, cardID)
+		}
+	}
+	if err := baseaction.DiscardCards(ctx, g, unkeptCards); err != nil {
+		log.Error("Failed to discard unkept cards", zap.Error(err))
+		return err
+	}
+
+	if len(preludeIDs) > 0 {
+		player.SetPreludes(preludeIDs)
+		log.Info("✅ Preludes kept", zap.Strings("prelude_ids", preludeIDs))
+	}
+
 	// Note: RequirementModifier recalculation removed - discounts are now calculated on-demand during EntityState calculation
 
 	// 13. BUSINESS LOGIC: Setup forced first action if corporation requires it
+	// Runs after the starting hand is in place, so first actions that draw cards (Inventrix) add to it
 	if err := a.corpProc.SetupForcedFirstAction(ctx, corpCard, g, playerID); err != nil {
 		log.Error("Failed to setup forced first action", zap.Error(err))
 		return fmt.Errorf("failed to setup forced first action: %w", err)
@@ -289,3 +332,33 @@ func (a *SelectStartingCardsAction) Execute(ctx context.Context, gameID string,
 	log.Info("🎉 Starting card selection completed successfully")
 	return nil
 }
+
+// validateKeptPreludes checks a prelude selection against the preludes dealt to the player
+func validateKeptPreludes(dealt, kept []string) error {
+	if len(dealt) == 0 {
+		if len(kept) > 0 {
+			return fmt.Errorf("preludes are not enabled in this game")
+		}
+		return nil
+	}
+
+	if len(kept) != KeptPreludes {
+		return fmt.Errorf("must keep exactly %d preludes, got %d", KeptPreludes, len(kept))
+	}
+
+	dealtSet := make(map[string]bool, len(dealt))
+	for _, id := range dealt {
+		dealtSet[id] = true
+	}
+	keptSet := make(map[string]bool, len(kept))
+	for _, id := range kept {
+		if !dealtSet[id] {
+			return fmt.Errorf("prelude %s not available for selection", id)
+		}
+		if keptSet[id] {
+			return fmt.Errorf("prelude %s selected more than once", id)
+		}
+		keptSet[id] = true
+	}
+	return nil
+}
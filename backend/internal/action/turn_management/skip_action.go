@@ -7,6 +7,7 @@ import (
 	gameaction "terraforming-mars-backend/internal/action/game"
 
 	"go.uber.org/zap"
+	"terraforming-mars-backend/internal/cards"
 	"terraforming-mars-backend/internal/game"
 	playerPkg "terraforming-mars-backend/internal/game/player"
 	"terraforming-mars-backend/internal/game/shared"
@@ -15,23 +16,48 @@ import (
 // SkipActionAction handles the business logic for skipping/passing player turns
 type SkipActionAction struct {
 	baseaction.BaseAction
+	cardRegistry       cards.CardRegistry
 	finalScoringAction *gameaction.FinalScoringAction
 }
 
+// ConvertiblesPendingError is returned when a player tries to pass for the generation while their
+// plants or heat could still be converted; the client asks them to confirm or convert first
+type ConvertiblesPendingError struct {
+	Convertibles baseaction.Convertibles
+}
+
+func (e *ConvertiblesPendingError) Error() string {
+	return fmt.Sprintf("plants and heat can still be converted: %d greenery, %d temperature steps",
+		e.Convertibles.Greeneries, e.Convertibles.TemperatureSteps)
+}
+
 // NewSkipActionAction creates a new skip action action
 func NewSkipActionAction(
 	gameRepo game.GameRepository,
+	cardRegistry cards.CardRegistry,
 	finalScoringAction *gameaction.FinalScoringAction,
 	logger *zap.Logger,
 ) *SkipActionAction {
 	return &SkipActionAction{
 		BaseAction:         baseaction.NewBaseAction(gameRepo, nil),
+		cardRegistry:       cardRegistry,
 		finalScoringAction: finalScoringAction,
 	}
 }
 
 // Execute performs the skip action
+// Passing for the generation while holding 8 or more plants or heat that could still be converted
+// returns a *ConvertiblesPendingError
 func (a *SkipActionAction) Execute(ctx context.Context, gameID string, playerID string) error {
+	return a.execute(ctx, gameID, playerID, false)
+}
+
+// ExecuteConfirmed performs the skip action after the player confirmed passing with convertibles left
+func (a *SkipActionAction) ExecuteConfirmed(ctx context.Context, gameID string, playerID string) error {
+	return a.execute(ctx, gameID, playerID, true)
+}
+
+func (a *SkipActionAction) execute(ctx context.Context, gameID string, playerID string, confirmed bool) error {
 	log := a.InitLogger(gameID, playerID).With(zap.String("action", "skip_action"))
 	log.Info("⏭️ Skipping player turn")
 
@@ -80,6 +106,15 @@ func (a *SkipActionAction) Execute(ctx context.Context, gameID string, playerID
 	}
 	availableActions := currentTurn.ActionsRemaining()
 	isPassing := availableActions == 2 || availableActions == -1 || len(turnOrder) == 1
+	if isPassing && !confirmed {
+		if convertibles := baseaction.CalculateConvertibles(g, currentPlayer, a.cardRegistry); convertibles.Remind() {
+			log.Info("🌿 Pass held back, plants or heat can still be converted",
+				zap.Int("greeneries", convertibles.Greeneries),
+				zap.Int("temperature_steps", convertibles.TemperatureSteps))
+			return &ConvertiblesPendingError{Convertibles: convertibles}
+		}
+	}
+
 	if isPassing {
 		currentPlayer.SetPassed(true)
 
@@ -124,34 +159,7 @@ func (a *SkipActionAction) Execute(ctx context.Context, gameID string, playerID
 		zap.Bool("all_players_finished", allPlayersFinished))
 
 	if allPlayersFinished {
-		if g.GlobalParameters().IsMaxed() {
-			log.Info("🏆 All global parameters maxed - triggering final scoring",
-				zap.String("game_id", gameID),
-				zap.Int("generation", g.Generation()))
-
-			err = a.finalScoringAction.Execute(ctx, gameID)
-			if err != nil {
-				log.Error("Failed to execute final scoring", zap.Error(err))
-				return fmt.Errorf("failed to execute final scoring: %w", err)
-			}
-
-			log.Info("✅ Game ended, final scores calculated")
-			return nil
-		}
-
-		log.Info("🏭 All players finished their turns - executing production phase",
-			zap.String("game_id", gameID),
-			zap.Int("generation", g.Generation()),
-			zap.Int("passed_players", passedCount))
-
-		err = a.executeProductionPhase(ctx, g, g.GetAllPlayers())
-		if err != nil {
-			log.Error("Failed to execute production phase", zap.Error(err))
-			return fmt.Errorf("failed to execute production phase: %w", err)
-		}
-
-		log.Info("✅ Production phase completed, new generation started")
-		return nil
+		return a.endGeneration(ctx, g, log)
 	}
 
 	nextPlayerIndex := (currentPlayerIndex + 1) % len(turnOrder)
@@ -192,6 +200,41 @@ func (a *SkipActionAction) Execute(ctx context.Context, gameID string, playerID
 	return nil
 }
 
+// endGeneration runs once every player has passed: final scoring when the global parameters
+// are maxed or a solo game with a goal has played its last generation, otherwise the production
+// phase that starts the next generation
+func (a *SkipActionAction) endGeneration(ctx context.Context, g *game.Game, log *zap.Logger) error {
+	soloLimitReached := len(g.GetAllPlayers()) == 1 &&
+		g.Settings().RulesOptions.SoloGoal != "" &&
+		g.Generation() >= game.SoloGenerations
+	if g.GlobalParameters().IsMaxed() || soloLimitReached {
+		log.Info("🏆 Game end reached - triggering final scoring",
+			zap.String("game_id", g.ID()),
+			zap.Int("generation", g.Generation()),
+			zap.Bool("solo_limit_reached", soloLimitReached))
+
+		if err := a.finalScoringAction.Execute(ctx, g.ID()); err != nil {
+			log.Error("Failed to execute final scoring", zap.Error(err))
+			return fmt.Errorf("failed to execute final scoring: %w", err)
+		}
+
+		log.Info("✅ Game ended, final scores calculated")
+		return nil
+	}
+
+	log.Info("🏭 All players finished their turns - executing production phase",
+		zap.String("game_id", g.ID()),
+		zap.Int("generation", g.Generation()))
+
+	if err := a.executeProductionPhase(ctx, g, g.GetAllPlayers()); err != nil {
+		log.Error("Failed to execute production phase", zap.Error(err))
+		return fmt.Errorf("failed to execute production phase: %w", err)
+	}
+
+	log.Info("✅ Production phase completed, new generation started")
+	return nil
+}
+
 // executeProductionPhase handles the production phase when all players have passed
 func (a *SkipActionAction) executeProductionPhase(ctx context.Context, gameInstance *game.Game, players []*playerPkg.Player) error {
 	log := a.GetLogger().With(zap.String("game_id", gameInstance.ID()))
@@ -204,6 +247,8 @@ func (a *SkipActionAction) executeProductionPhase(ctx context.Context, gameInsta
 		return fmt.Errorf("game deck is nil")
 	}
 
+	summary := game.GenerationSummary{Generation: gameInstance.Generation()}
+
 	for _, p := range players {
 		currentResources := p.Resources().Get()
 		energyConverted := currentResources.Energy
@@ -222,6 +267,22 @@ func (a *SkipActionAction) executeProductionPhase(ctx context.Context, gameInsta
 		p.Resources().Set(newResources)
 		p.SetPassed(false)
 
+		tally := gameInstance.GenerationTally(p.ID())
+		summary.Players = append(summary.Players, game.PlayerGenerationSummary{
+			PlayerID: p.ID(),
+			Income: shared.Resources{
+				Credits:  production.Credits + tr,
+				Steel:    production.Steel,
+				Titanium: production.Titanium,
+				Plants:   production.Plants,
+				Energy:   production.Energy,
+				Heat:     production.Heat,
+			},
+			EnergyConverted: energyConverted,
+			CardsBought:     tally.CardsBought,
+			TRDelta:         tally.TRDelta,
+		})
+
 		drawnCards := []string{}
 		for i := range 4 {
 			cardIDs, err := deck.DrawProjectCards(ctx, 1)
@@ -261,6 +322,10 @@ func (a *SkipActionAction) executeProductionPhase(ctx context.Context, gameInsta
 			zap.Int("energy_converted", energyConverted))
 	}
 
+	if err := gameInstance.CloseGeneration(ctx, summary); err != nil {
+		return fmt.Errorf("failed to store generation summary: %w", err)
+	}
+
 	oldGeneration := gameInstance.Generation()
 	if err := gameInstance.AdvanceGeneration(ctx); err != nil {
 		return fmt.Errorf("failed to increment generation: %w", err)
@@ -0,0 +1,75 @@
+package turn_management
+
+import (
+	"context"
+	"fmt"
+
+	"go.uber.org/zap"
+
+	"terraforming-mars-backend/internal/game"
+	"terraforming-mars-backend/internal/game/board"
+	"terraforming-mars-backend/internal/game/shared"
+)
+
+// placeNeutralTiles runs the solo setup procedure: for each neutral city a card is drawn and its cost counted
+// across the board (from the top left for the first city, from the bottom right for the second), then another
+// card's cost is counted clockwise around the city for its greenery. The tiles belong to no player, give no
+// placement bonuses and do not raise oxygen; the drawn cards are discarded.
+func (a *StartGameAction) placeNeutralTiles(ctx context.Context, g *game.Game, log *zap.Logger) error {
+	b := g.Board()
+
+	for i := range game.SoloNeutralCities {
+		cityCount, err := a.drawCountCard(ctx, g)
+		if err != nil {
+			return err
+		}
+		city, ok := b.NeutralCitySpace(cityCount, i%2 == 1)
+		if !ok {
+			return fmt.Errorf("no space left for neutral city %d", i+1)
+		}
+		if err := b.UpdateTileOccupancy(ctx, city, board.TileOccupant{Type: shared.ResourceCityTile, Tags: []string{}}, ""); err != nil {
+			return err
+		}
+
+		greeneryCount, err := a.drawCountCard(ctx, g)
+		if err != nil {
+			return err
+		}
+		greenery, ok := b.NeutralGreenerySpace(city, greeneryCount)
+		if !ok {
+			log.Warn("No space next to neutral city for its greenery", zap.String("city", city.String()))
+			continue
+		}
+		if err := b.UpdateTileOccupancy(ctx, greenery, board.TileOccupant{Type: shared.ResourceGreeneryTile, Tags: []string{}}, ""); err != nil {
+			return err
+		}
+
+		log.Info("🏙️ Placed neutral city and greenery",
+			zap.String("city", city.String()),
+			zap.String("greenery", greenery.String()),
+			zap.Int("city_count", cityCount),
+			zap.Int("greenery_count", greeneryCount))
+	}
+
+	return nil
+}
+
+// drawCountCard draws a project card, discards it and returns its cost for counting spaces
+func (a *StartGameAction) drawCountCard(ctx context.Context, g *game.Game) (int, error) {
+	deck := g.Deck()
+	cardIDs, err := deck.DrawProjectCards(ctx, 1)
+	if err != nil {
+		return 0, fmt.Errorf("failed to draw a card for neutral tile placement: %w", err)
+	}
+	if len(cardIDs) == 0 {
+		return 0, fmt.Errorf("no card left to draw for neutral tile placement")
+	}
+	if err := deck.Discard(ctx, cardIDs); err != nil {
+		return 0, fmt.Errorf("failed to discard neutral placement card: %w", err)
+	}
+	card, err := a.cardRegistry.GetByID(cardIDs[0])
+	if err != nil {
+		return 0, fmt.Errorf("neutral placement card not found: %w", err)
+	}
+	return card.Cost, nil
+}
@@ -3,30 +3,41 @@ package turn_management
 import (
 	"context"
 	"fmt"
-	"math/rand"
-	"time"
 
 	"go.uber.org/zap"
 
+	"terraforming-mars-backend/internal/cards"
 	"terraforming-mars-backend/internal/game"
 	playerPkg "terraforming-mars-backend/internal/game/player"
+	"terraforming-mars-backend/internal/game/shared"
+)
+
+// Starting selection sizes
+const (
+	StartingProjectCards = 10 // Project cards dealt to each player, plus handicap extras
+	StartingCorporations = 2  // Corporations dealt to each player; one is played
+	StartingPreludes     = 4  // Preludes dealt to each player when the prelude pack is enabled
+	KeptPreludes         = 2  // Preludes each player keeps from those dealt
 )
 
 // StartGameAction handles the business logic for starting games
 // NOTE: Deck initialization is handled separately before calling this action
 type StartGameAction struct {
-	gameRepo game.GameRepository
-	logger   *zap.Logger
+	gameRepo     game.GameRepository
+	cardRegistry cards.CardRegistry
+	logger       *zap.Logger
 }
 
 // NewStartGameAction creates a new start game action
 func NewStartGameAction(
 	gameRepo game.GameRepository,
+	cardRegistry cards.CardRegistry,
 	logger *zap.Logger,
 ) *StartGameAction {
 	return &StartGameAction{
-		gameRepo: gameRepo,
-		logger:   logger,
+		gameRepo:     gameRepo,
+		cardRegistry: cardRegistry,
+		logger:       logger,
 	}
 }
 
@@ -64,28 +75,33 @@ func (a *StartGameAction) Execute(ctx context.Context, gameID string, playerID s
 	players := g.GetAllPlayers()
 	log.Info("🎮 Starting game with players", zap.Int("player_count", len(players)))
 
-	// 5. BUSINESS LOGIC: Randomize and set turn order
-	playerIDs := make([]string, len(players))
-	for i, p := range players {
-		playerIDs[i] = p.ID()
-	}
-	rng := rand.New(rand.NewSource(time.Now().UnixNano()))
-	rng.Shuffle(len(playerIDs), func(i, j int) {
-		playerIDs[i], playerIDs[j] = playerIDs[j], playerIDs[i]
-	})
-	if err := g.SetTurnOrder(ctx, playerIDs); err != nil {
-		log.Error("Failed to set turn order", zap.Error(err))
-		return fmt.Errorf("failed to set turn order: %w", err)
-	}
-	log.Info("🎲 Randomized turn order", zap.Strings("turn_order", playerIDs))
-
-	// 6. BUSINESS LOGIC: Ensure deck is initialized
+	// 5. BUSINESS LOGIC: Ensure deck is initialized
 	deck := g.Deck()
 	if deck == nil {
 		log.Error("Game deck not initialized")
 		return fmt.Errorf("game deck not initialized - must initialize deck before starting game")
 	}
 
+	// 6. BUSINESS LOGIC: Use lobby seating as turn order, shuffled unless the host arranged seats
+	playerIDs := g.TurnOrder()
+	if len(playerIDs) != len(players) {
+		playerIDs = make([]string, len(players))
+		for i, p := range players {
+			playerIDs[i] = p.ID()
+		}
+	}
+	// The shuffle is drawn from the deck's seed, so a replay of the game seats players the same way
+	if g.RandomizeSeatOrder() {
+		playerIDs = deck.ShuffleSeats(playerIDs)
+		log.Info("🎲 Randomized turn order", zap.Strings("turn_order", playerIDs))
+	} else {
+		log.Info("💺 Using host-arranged seat order", zap.Strings("turn_order", playerIDs))
+	}
+	if err := g.SetTurnOrder(ctx, playerIDs); err != nil {
+		log.Error("Failed to set turn order", zap.Error(err))
+		return fmt.Errorf("failed to set turn order: %w", err)
+	}
+
 	// 7. BUSINESS LOGIC: Update game status to Active
 	if err := g.UpdateStatus(ctx, game.GameStatusActive); err != nil {
 		log.Error("Failed to update game status", zap.Error(err))
@@ -102,6 +118,48 @@ func (a *StartGameAction) Execute(ctx context.Context, gameID string, playerID s
 		log.Info("✅ Set initial turn", zap.String("first_player_id", firstPlayerID))
 	}
 
+	// 8a. BUSINESS LOGIC: Fast mode grants extra starting MC production
+	if g.Settings().RulesOptions.FastMode {
+		for _, p := range players {
+			p.Resources().AddProduction(map[shared.ResourceType]int{
+				shared.ResourceCreditProduction: game.FastModeCreditProduction,
+			})
+		}
+		log.Info("⚡ Fast mode: granted extra MC production",
+			zap.Int("amount", game.FastModeCreditProduction))
+	}
+
+	// 8b. BUSINESS LOGIC: Apply lobby handicaps (extra cards are dealt with the starting selection)
+	for _, p := range players {
+		handicap := g.Handicap(p.ID())
+		if handicap.ExtraCredits > 0 {
+			p.Resources().Add(map[shared.ResourceType]int{
+				shared.ResourceCredit: handicap.ExtraCredits,
+			})
+		}
+		if handicap.ExtraTR > 0 {
+			p.Resources().UpdateTerraformRating(handicap.ExtraTR)
+		}
+		if !handicap.IsZero() {
+			log.Info("⚖️ Applied handicap",
+				zap.String("player_id", p.ID()),
+				zap.Int("extra_credits", handicap.ExtraCredits),
+				zap.Int("extra_cards", handicap.ExtraCards),
+				zap.Int("extra_tr", handicap.ExtraTR))
+		}
+	}
+
+	// Generation tallies start once handicaps are applied, so the first recap only counts play
+	g.ResetGenerationTallies()
+
+	// 8c. BUSINESS LOGIC: Solo neutral tiles are placed before anyone sees the board
+	if len(players) == 1 && g.Settings().RulesOptions.SoloNeutralTiles && !g.Settings().DemoGame {
+		if err := a.placeNeutralTiles(ctx, g, log); err != nil {
+			log.Error("Failed to place neutral tiles", zap.Error(err))
+			return fmt.Errorf("failed to place neutral tiles: %w", err)
+		}
+	}
+
 	// 9. BUSINESS LOGIC: Demo games go to DemoSetup phase, normal games to StartingCardSelection
 	if g.Settings().DemoGame {
 		// Demo game: go to demo setup phase where players configure their setup
@@ -129,7 +187,9 @@ func (a *StartGameAction) Execute(ctx context.Context, gameID string, playerID s
 	return nil
 }
 
-// distributeStartingCards gives each player 10 project cards and 2 corporations
+// distributeStartingCards gives each player 10 project cards (plus any handicap cards), 2 corporations and,
+// when the prelude pack is selected, 4 preludes. The dealt cards are recorded on the selection phase, which
+// SelectStartingCardsAction validates against and only the owning player is shown
 func (a *StartGameAction) distributeStartingCards(ctx context.Context, gameInstance *game.Game, players []*playerPkg.Player) error {
 	log := a.logger.With(zap.String("game_id", gameInstance.ID()))
 	log.Debug("Distributing starting cards to players", zap.Int("player_count", len(players)))
@@ -138,24 +198,35 @@ func (a *StartGameAction) distributeStartingCards(ctx context.Context, gameInsta
 	if deck == nil {
 		return fmt.Errorf("game deck is nil")
 	}
+	preludesEnabled := gameInstance.Settings().PreludesEnabled()
 
 	for _, p := range players {
-		// Draw 10 project cards from game deck
-		projectCardIDs, err := deck.DrawProjectCards(ctx, 10)
+		// Draw 10 project cards from game deck, plus handicap extras
+		projectCardIDs, err := deck.DrawProjectCards(ctx, StartingProjectCards+gameInstance.Handicap(p.ID()).ExtraCards)
 		if err != nil {
 			return fmt.Errorf("failed to draw project cards for player %s: %w", p.ID(), err)
 		}
 
 		// Draw 2 corporation cards from game deck
-		corporationIDs, err := deck.DrawCorporations(ctx, 2)
+		corporationIDs, err := deck.DrawCorporations(ctx, StartingCorporations)
 		if err != nil {
 			return fmt.Errorf("failed to draw corporations for player %s: %w", p.ID(), err)
 		}
 
+		// Draw 4 preludes when the prelude pack is enabled
+		var preludeIDs []string
+		if preludesEnabled {
+			preludeIDs, err = deck.DrawPreludes(ctx, StartingPreludes)
+			if err != nil {
+				return fmt.Errorf("failed to draw preludes for player %s: %w", p.ID(), err)
+			}
+		}
+
 		// Set starting cards selection phase for player (phase state managed by Game)
 		selectionPhase := &playerPkg.SelectStartingCardsPhase{
 			AvailableCards:        projectCardIDs,
 			AvailableCorporations: corporationIDs,
+			AvailablePreludes:     preludeIDs,
 		}
 		if err := gameInstance.SetSelectStartingCardsPhase(ctx, p.ID(), selectionPhase); err != nil {
 			return fmt.Errorf("failed to set selection phase for player %s: %w", p.ID(), err)
@@ -165,6 +236,7 @@ func (a *StartGameAction) distributeStartingCards(ctx context.Context, gameInsta
 			zap.String("player_id", p.ID()),
 			zap.Int("project_cards", len(projectCardIDs)),
 			zap.Int("corporations", len(corporationIDs)),
+			zap.Int("preludes", len(preludeIDs)),
 			zap.Strings("corporation_ids", corporationIDs))
 	}
 
@@ -0,0 +1,160 @@
+package turn_management
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+
+	baseaction "terraforming-mars-backend/internal/action"
+	"terraforming-mars-backend/internal/game"
+	playerPkg "terraforming-mars-backend/internal/game/player"
+)
+
+// StartingSelectionTimeoutAction chooses for players who never finish their starting selection
+// Once a game's starting selection timeout has passed, each undecided player gets the first offered
+// corporation, no project cards and the first dealt preludes, so the rest of the table can play.
+// Each choice is logged under the player it was made for
+type StartingSelectionTimeoutAction struct {
+	gameRepo     game.GameRepository
+	stateRepo    game.GameStateRepository
+	selectAction *SelectStartingCardsAction
+	logger       *zap.Logger
+}
+
+// NewStartingSelectionTimeoutAction creates a new starting selection timeout action
+func NewStartingSelectionTimeoutAction(
+	gameRepo game.GameRepository,
+	stateRepo game.GameStateRepository,
+	selectAction *SelectStartingCardsAction,
+	logger *zap.Logger,
+) *StartingSelectionTimeoutAction {
+	return &StartingSelectionTimeoutAction{
+		gameRepo:     gameRepo,
+		stateRepo:    stateRepo,
+		selectAction: selectAction,
+		logger:       logger,
+	}
+}
+
+// ExpireStartingSelection chooses for the undecided players of every game whose starting selection
+// timeout has passed by now. The choices run in the game's queue through run, where the deadline and each
+// seat are checked again, so a player who chose while the timeout waited keeps their choice.
+// Paused games are skipped. Returns the IDs of the games that were changed
+func (a *StartingSelectionTimeoutAction) ExpireStartingSelection(ctx context.Context, now time.Time, run baseaction.GameRunner) []string {
+	status := game.GameStatusActive
+	games, err := a.gameRepo.List(ctx, &status)
+	if err != nil {
+		a.logger.Warn("Failed to list games for starting selection timeout", zap.Error(err))
+		return nil
+	}
+
+	var expired []string
+	for _, g := range games {
+		if !startingSelectionExpired(g, now) || len(waitingForStartingSelection(g)) == 0 {
+			continue
+		}
+
+		log := a.logger.With(zap.String("game_id", g.ID()), zap.String("action", "starting_selection_timeout"))
+		var chosen []string
+		if err := run(ctx, g.ID(), func(ctx context.Context) {
+			if !startingSelectionExpired(g, now) {
+				log.Debug("Starting selection ended before its timeout ran")
+				return
+			}
+			waiting := waitingForStartingSelection(g)
+			if len(waiting) == 0 {
+				log.Debug("Every player chose before the timeout ran")
+				return
+			}
+			log.Info("⏰ Starting selection timeout reached")
+			chosen = a.autoSelect(ctx, g, waiting, log)
+		}); err != nil {
+			log.Warn("Starting selection timeout did not run", zap.Error(err))
+			continue
+		}
+		if len(chosen) > 0 {
+			expired = append(expired, g.ID())
+		}
+	}
+	return expired
+}
+
+// startingSelectionExpired reports whether the game's starting selection has outlasted its deadline
+func startingSelectionExpired(g *game.Game, now time.Time) bool {
+	deadline, ok := g.StartingSelectionDeadline()
+	return ok && !g.IsPaused() && !now.Before(deadline)
+}
+
+// Monitor checks starting selection timeouts on every tick, choosing through run, and hands each changed game to onExpired
+func (a *StartingSelectionTimeoutAction) Monitor(ctx context.Context, interval time.Duration, run baseaction.GameRunner, onExpired func(gameID string)) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			for _, gameID := range a.ExpireStartingSelection(ctx, now, run) {
+				onExpired(gameID)
+			}
+		}
+	}
+}
+
+// autoSelect makes the default selection for each waiting player and returns the players it chose for
+// A player whose default selection fails is left waiting and logged; the others still go ahead
+func (a *StartingSelectionTimeoutAction) autoSelect(ctx context.Context, g *game.Game, waiting []*playerPkg.Player, log *zap.Logger) []string {
+	var chosen []string
+	for _, p := range waiting {
+		if p.HasCorporation() {
+			continue
+		}
+		phase := g.GetSelectStartingCardsPhase(p.ID())
+		if phase == nil || len(phase.AvailableCorporations) == 0 {
+			log.Error("No corporation to choose", zap.String("player_id", p.ID()))
+			continue
+		}
+		corporationID := phase.AvailableCorporations[0]
+		var preludeIDs []string
+		if len(phase.AvailablePreludes) >= KeptPreludes {
+			preludeIDs = append(preludeIDs, phase.AvailablePreludes[:KeptPreludes]...)
+		}
+
+		if err := a.selectAction.Execute(ctx, g.ID(), p.ID(), nil, corporationID, preludeIDs); err != nil {
+			log.Error("Failed to choose starting selection", zap.String("player_id", p.ID()), zap.Error(err))
+			continue
+		}
+
+		corporationName := corporationID
+		if card, err := a.selectAction.cardRegistry.GetByID(corporationID); err == nil {
+			corporationName = card.Name
+		}
+		description := fmt.Sprintf("Ran out of starting selection time; got %s and no cards", corporationName)
+		if a.stateRepo != nil {
+			if _, err := a.stateRepo.WriteFull(ctx, g.ID(), g, "Starting Selection", game.SourceTypeGameEvent, p.ID(), description, nil, nil, nil); err != nil {
+				log.Warn("Failed to write log entry", zap.String("player_id", p.ID()), zap.Error(err))
+			}
+		}
+		chosen = append(chosen, p.ID())
+	}
+	if len(chosen) == 0 {
+		return nil
+	}
+
+	log.Info("✅ Starting selection chosen for waiting players", zap.Strings("player_ids", chosen))
+	return chosen
+}
+
+// waitingForStartingSelection returns the players who have not finished their starting selection
+func waitingForStartingSelection(g *game.Game) []*playerPkg.Player {
+	var waiting []*playerPkg.Player
+	for _, p := range g.GetAllPlayers() {
+		if g.GetSelectStartingCardsPhase(p.ID()) != nil && !p.HasCorporation() {
+			waiting = append(waiting, p)
+		}
+	}
+	return waiting
+}
@@ -0,0 +1,118 @@
+package turn_management
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	baseaction "terraforming-mars-backend/internal/action"
+	"terraforming-mars-backend/internal/game"
+)
+
+// TurnTimeoutAction ends action phase turns that outlast the turn timer and the player's timebank
+// The turn is skipped for the player as if they had pressed skip with convertibles confirmed:
+// with no action taken yet they pass for the generation, otherwise the turn moves on.
+// A log entry names the player who ran out of time
+type TurnTimeoutAction struct {
+	gameRepo   game.GameRepository
+	stateRepo  game.GameStateRepository
+	skipAction *SkipActionAction
+	logger     *zap.Logger
+}
+
+// NewTurnTimeoutAction creates a new turn timeout action
+func NewTurnTimeoutAction(
+	gameRepo game.GameRepository,
+	stateRepo game.GameStateRepository,
+	skipAction *SkipActionAction,
+	logger *zap.Logger,
+) *TurnTimeoutAction {
+	return &TurnTimeoutAction{
+		gameRepo:   gameRepo,
+		stateRepo:  stateRepo,
+		skipAction: skipAction,
+		logger:     logger,
+	}
+}
+
+// ExpireTurns skips the current turn of every game whose turn deadline has passed by now
+// Each skip runs in the game's queue through run, and the deadline is checked again there, so a player
+// who acted while the skip waited keeps their turn. Paused games are skipped. Returns the IDs of the games that were changed
+func (a *TurnTimeoutAction) ExpireTurns(ctx context.Context, now time.Time, run baseaction.GameRunner) []string {
+	status := game.GameStatusActive
+	games, err := a.gameRepo.List(ctx, &status)
+	if err != nil {
+		a.logger.Warn("Failed to list games for turn timeout", zap.Error(err))
+		return nil
+	}
+
+	var expired []string
+	for _, g := range games {
+		if !turnExpired(g, now) {
+			continue
+		}
+
+		log := a.logger.With(zap.String("game_id", g.ID()), zap.String("action", "turn_timeout"))
+		skipped := false
+		if err := run(ctx, g.ID(), func(ctx context.Context) {
+			skipped = a.skipExpiredTurn(ctx, g, now, log)
+		}); err != nil {
+			log.Warn("Turn timeout did not run", zap.Error(err))
+			continue
+		}
+		if skipped {
+			expired = append(expired, g.ID())
+		}
+	}
+	return expired
+}
+
+// turnExpired reports whether the game's current turn has outlasted its deadline
+func turnExpired(g *game.Game, now time.Time) bool {
+	deadline, ok := g.TurnDeadline()
+	return ok && !g.IsPaused() && !now.Before(deadline)
+}
+
+// skipExpiredTurn skips the current turn if it is still past its deadline and logs it under the player
+func (a *TurnTimeoutAction) skipExpiredTurn(ctx context.Context, g *game.Game, now time.Time, log *zap.Logger) bool {
+	if !turnExpired(g, now) {
+		log.Debug("Turn ended before its timeout ran")
+		return false
+	}
+	playerID := g.CurrentTurn().PlayerID()
+	if _, err := g.GetPlayer(playerID); err != nil {
+		return false
+	}
+
+	log = log.With(zap.String("player_id", playerID))
+	log.Info("⏰ Turn timer and timebank ran out")
+	if err := a.skipAction.ExecuteConfirmed(ctx, g.ID(), playerID); err != nil {
+		log.Error("Failed to skip timed out turn", zap.Error(err))
+		return false
+	}
+
+	if a.stateRepo != nil {
+		if _, err := a.stateRepo.WriteFull(ctx, g.ID(), g, "Turn Timeout", game.SourceTypeGameEvent, playerID, "Ran out of time; turn skipped", nil, nil, nil); err != nil {
+			log.Warn("Failed to write log entry", zap.Error(err))
+		}
+	}
+	return true
+}
+
+// Monitor checks turn deadlines on every tick, skipping turns through run, and hands each changed game to onExpired
+func (a *TurnTimeoutAction) Monitor(ctx context.Context, interval time.Duration, run baseaction.GameRunner, onExpired func(gameID string)) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			for _, gameID := range a.ExpireTurns(ctx, now, run) {
+				onExpired(gameID)
+			}
+		}
+	}
+}
@@ -0,0 +1,112 @@
+package tutorial
+
+import (
+	"context"
+	"fmt"
+
+	"go.uber.org/zap"
+
+	gameaction "terraforming-mars-backend/internal/action/game"
+	"terraforming-mars-backend/internal/action/turn_management"
+	"terraforming-mars-backend/internal/cards"
+	"terraforming-mars-backend/internal/delivery/dto"
+	"terraforming-mars-backend/internal/game"
+	"terraforming-mars-backend/internal/tutorial"
+)
+
+// StartTutorialAction creates a solo demo game and applies a scripted scenario (tutorial or puzzle)
+// The scenario setup goes through the regular demo flow: lobby -> demo setup -> action phase
+// One instance serves one scenario registry, so tutorials and puzzles each get their own
+type StartTutorialAction struct {
+	gameRepo               game.GameRepository
+	cardRegistry           cards.CardRegistry
+	scenarios              *tutorial.Registry
+	tracker                *tutorial.Tracker
+	createDemoLobbyAction  *gameaction.CreateDemoLobbyAction
+	startGameAction        *turn_management.StartGameAction
+	confirmDemoSetupAction *gameaction.ConfirmDemoSetupAction
+	logger                 *zap.Logger
+}
+
+// TutorialResult contains the started tutorial game and the human player's ID
+type TutorialResult struct {
+	PlayerID string
+	GameDto  dto.GameDto
+}
+
+// NewStartTutorialAction creates a new start tutorial action
+func NewStartTutorialAction(
+	gameRepo game.GameRepository,
+	cardRegistry cards.CardRegistry,
+	scenarios *tutorial.Registry,
+	tracker *tutorial.Tracker,
+	createDemoLobbyAction *gameaction.CreateDemoLobbyAction,
+	startGameAction *turn_management.StartGameAction,
+	confirmDemoSetupAction *gameaction.ConfirmDemoSetupAction,
+	logger *zap.Logger,
+) *StartTutorialAction {
+	return &StartTutorialAction{
+		gameRepo:               gameRepo,
+		cardRegistry:           cardRegistry,
+		scenarios:              scenarios,
+		tracker:                tracker,
+		createDemoLobbyAction:  createDemoLobbyAction,
+		startGameAction:        startGameAction,
+		confirmDemoSetupAction: confirmDemoSetupAction,
+		logger:                 logger,
+	}
+}
+
+// Execute starts the scenario for a new player and begins tracking their progress
+// accountID is optional and identifies who gets credit for solving a puzzle
+func (a *StartTutorialAction) Execute(ctx context.Context, scenarioID, playerName, accountID string) (*TutorialResult, error) {
+	log := a.logger.With(
+		zap.String("scenario_id", scenarioID),
+		zap.String("action", "start_tutorial"),
+	)
+	log.Info("🎓 Starting tutorial")
+
+	// 1. Look up the scenario
+	scenario, err := a.scenarios.GetByID(scenarioID)
+	if err != nil {
+		log.Warn("Tutorial scenario not found")
+		return nil, err
+	}
+
+	// 2. Create a single-player demo lobby
+	lobby, err := a.createDemoLobbyAction.Execute(ctx, gameaction.DemoLobbySettings{
+		PlayerCount: 1,
+		CardPacks:   scenario.CardPacks,
+		PlayerName:  playerName,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create tutorial lobby: %w", err)
+	}
+	gameID := lobby.GameDto.ID
+	log = log.With(zap.String("game_id", gameID), zap.String("player_id", lobby.PlayerID))
+
+	// 3. Move the lobby into demo setup
+	if err := a.startGameAction.Execute(ctx, gameID, lobby.PlayerID); err != nil {
+		return nil, fmt.Errorf("failed to start tutorial game: %w", err)
+	}
+
+	// 4. BUSINESS LOGIC: Apply the scripted hand and board state (moves the game to the action phase)
+	setup := scenario.Setup
+	if err := a.confirmDemoSetupAction.Execute(ctx, gameID, lobby.PlayerID, &setup); err != nil {
+		return nil, fmt.Errorf("failed to apply tutorial setup: %w", err)
+	}
+
+	// 5. Track objectives and victory condition for the progress stream
+	a.tracker.Start(gameID, lobby.PlayerID, accountID, *scenario)
+
+	g, err := a.gameRepo.Get(ctx, gameID)
+	if err != nil {
+		return nil, fmt.Errorf("game not found: %s", gameID)
+	}
+
+	log.Info("✅ Tutorial started", zap.Int("steps", len(scenario.Steps)))
+	return &TutorialResult{
+		PlayerID: lobby.PlayerID,
+		GameDto:  dto.ToGameDto(g, a.cardRegistry, lobby.PlayerID),
+	}, nil
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"terraforming-mars-backend/internal/game"
+	gamecards "terraforming-mars-backend/internal/game/cards"
 
 	"go.uber.org/zap"
 )
@@ -25,15 +26,62 @@ func ValidateGameExists(
 	return game, nil
 }
 
-// ValidateActiveGame validates that a game exists and is in active status
-// Returns the game if valid, or an error if not found or wrong status
+// ValidateActiveGame validates that a game exists, is in active status, is not paused
+// and is not waiting on a player's response to an effect
+// Returns the game if valid, or an error if not found, wrong status, game.ErrGamePaused or game.ErrAwaitingResponse
 func ValidateActiveGame(
 	ctx context.Context,
 	gameRepo game.GameRepository,
 	gameID string,
 	log *zap.Logger,
 ) (*game.Game, error) {
-	return ValidateGameStatus(ctx, gameRepo, gameID, game.GameStatusActive, log)
+	gameResult, err := ValidateRespondableGame(ctx, gameRepo, gameID, log)
+	if err != nil {
+		return nil, err
+	}
+
+	if gameResult.AwaitingResponses() {
+		log.Warn("Gameplay action rejected while waiting on a response")
+		return nil, game.ErrAwaitingResponse
+	}
+
+	return gameResult, nil
+}
+
+// ValidateRespondableGame validates that a game exists, is in active status and is not paused
+// Used by the actions still allowed while the game waits on responses: answering one, and conceding
+func ValidateRespondableGame(
+	ctx context.Context,
+	gameRepo game.GameRepository,
+	gameID string,
+	log *zap.Logger,
+) (*game.Game, error) {
+	gameResult, err := ValidateGameStatus(ctx, gameRepo, gameID, game.GameStatusActive, log)
+	if err != nil {
+		return nil, err
+	}
+
+	if gameResult.IsPaused() {
+		log.Warn("Gameplay action rejected while game is paused")
+		return nil, game.ErrGamePaused
+	}
+
+	return gameResult, nil
+}
+
+// ValidateExpansionEnabled rejects an action tied to an expansion pack the game was not created with
+// Packs without rules of their own (base game, corporate era, promos) always pass
+func ValidateExpansionEnabled(g *game.Game, pack string, log *zap.Logger) error {
+	if !game.IsExpansionPack(pack) || g.Settings().PackEnabled(pack) {
+		return nil
+	}
+	log.Warn("Action needs a disabled expansion", zap.String("pack", pack))
+	return &game.ExpansionDisabledError{Pack: pack}
+}
+
+// ValidateCardExpansion rejects playing or using a card whose expansion is not enabled
+func ValidateCardExpansion(g *game.Game, card *gamecards.Card, log *zap.Logger) error {
+	return ValidateExpansionEnabled(g, card.Pack, log)
 }
 
 // ValidateLobbyGame validates that a game exists and is in lobby status
@@ -0,0 +1,132 @@
+package analytics
+
+import (
+	"sort"
+	"sync"
+)
+
+// Store aggregates anonymized card and corporation statistics from games that opted in
+// Only card and corporation IDs are kept: no game IDs, player IDs or names
+type Store struct {
+	mu           sync.RWMutex
+	games        int
+	cards        map[string]*CardStats
+	corporations map[string]*CorporationStats
+}
+
+// CardStats counts how often a card was offered and bought in research, and how it fared in finished games
+type CardStats struct {
+	CardID         string
+	Offered        int // Times offered during research
+	Bought         int // Times bought during research
+	Played         int // Times it was in a played area at game end
+	PlayedByWinner int // Times it was in the winner's played area at game end
+}
+
+// CorporationStats counts finished games and outright wins per corporation
+type CorporationStats struct {
+	CorporationID string
+	Games         int
+	Wins          int
+}
+
+// PlayerResult is one seat's anonymized outcome of a finished game
+type PlayerResult struct {
+	CorporationID string
+	PlayedCards   []string
+	Won           bool
+}
+
+// NewStore creates an empty analytics store
+func NewStore() *Store {
+	return &Store{
+		cards:        make(map[string]*CardStats),
+		corporations: make(map[string]*CorporationStats),
+	}
+}
+
+// RecordResearch counts one research decision: every offered card, and the ones bought
+func (s *Store) RecordResearch(offered, bought []string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, cardID := range offered {
+		s.card(cardID).Offered++
+	}
+	for _, cardID := range bought {
+		s.card(cardID).Bought++
+	}
+}
+
+// RecordGame counts a finished game's corporations and played cards
+// Ties record no winner
+func (s *Store) RecordGame(results []PlayerResult) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.games++
+	for _, result := range results {
+		if result.CorporationID != "" {
+			corp := s.corporations[result.CorporationID]
+			if corp == nil {
+				corp = &CorporationStats{CorporationID: result.CorporationID}
+				s.corporations[result.CorporationID] = corp
+			}
+			corp.Games++
+			if result.Won {
+				corp.Wins++
+			}
+		}
+
+		for _, cardID := range result.PlayedCards {
+			stats := s.card(cardID)
+			stats.Played++
+			if result.Won {
+				stats.PlayedByWinner++
+			}
+		}
+	}
+}
+
+// Games returns the number of finished games recorded
+func (s *Store) Games() int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.games
+}
+
+// Cards returns a copy of every card's stats, ordered by card ID
+func (s *Store) Cards() []CardStats {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	result := make([]CardStats, 0, len(s.cards))
+	for _, stats := range s.cards {
+		result = append(result, *stats)
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].CardID < result[j].CardID })
+	return result
+}
+
+// Corporations returns a copy of every corporation's stats, ordered by corporation ID
+func (s *Store) Corporations() []CorporationStats {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	result := make([]CorporationStats, 0, len(s.corporations))
+	for _, stats := range s.corporations {
+		result = append(result, *stats)
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].CorporationID < result[j].CorporationID })
+	return result
+}
+
+// card returns the stats entry for a card, creating it if needed; callers hold the write lock
+func (s *Store) card(cardID string) *CardStats {
+	stats := s.cards[cardID]
+	if stats == nil {
+		stats = &CardStats{CardID: cardID}
+		s.cards[cardID] = stats
+	}
+	return stats
+}
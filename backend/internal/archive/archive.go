@@ -0,0 +1,310 @@
+package archive
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"terraforming-mars-backend/internal/game"
+)
+
+// Blob keys: one compressed record per game, a single compressed index of every archived game,
+// the audit of account deletions and the hashed account keys
+const (
+	indexKey        = "index.json.gz"
+	deletionsKey    = "audit/deletions.json.gz"
+	accountKeysKey  = "accounts/keys.json.gz"
+	recordKeyPrefix = "games/"
+)
+
+// Entry is one archived game in the index: enough for history listings and player stats
+// without loading the game's record
+type Entry struct {
+	GameID     string
+	Status     game.GameStatus
+	Generation int
+	FinishedAt time.Time
+	Players    []EntryPlayer
+}
+
+// EntryPlayer is one seat's outcome in an index entry
+type EntryPlayer struct {
+	PlayerID      string
+	PlayerName    string
+	AccountID     string
+	CorporationID string
+	TotalVP       int // 0 for abandoned games
+	Placement     int // 0 for abandoned games
+	IsWinner      bool
+	Conceded      bool
+}
+
+// Query filters the history listing
+type Query struct {
+	PlayerName string // Only games with a seat of this name (case-insensitive); empty for every game
+	Limit      int    // At most this many games; <= 0 for no limit
+}
+
+// PlayerStats summarizes a player's archived games
+// Players are matched by name, since player IDs are only unique within a game
+type PlayerStats struct {
+	PlayerName string
+	Games      int // Completed games; abandoned and conceded games are not counted
+	Wins       int
+	BestVP     int
+	AverageVP  float64
+}
+
+// Archive is the cold storage tier for finished games
+// Records are written once and never change; the index is kept in memory and rewritten to the store on every save,
+// so history and stats queries never read a record.
+//
+// Changes hold writeMu for their whole run, so one change's blobs are stored before the next
+// starts and an older index never overwrites a newer one. mu only guards the in-memory state
+// and is never held while the store is read or written, so queries never wait on the network.
+// A change may read the state without mu, since only changes modify it.
+type Archive struct {
+	store Store
+
+	writeMu     sync.Mutex
+	mu          sync.RWMutex
+	entries     map[string]Entry
+	deletions   []Deletion
+	deleted     map[string]bool   // Account hashes whose seats are anonymized
+	accountKeys map[string]string // Account hash -> hash of the key that proves ownership
+}
+
+// Open loads the archive index, deletion audit and account keys from the store; a store without them opens as an empty archive
+func Open(ctx context.Context, store Store) (*Archive, error) {
+	a := &Archive{store: store, entries: make(map[string]Entry), deleted: make(map[string]bool), accountKeys: make(map[string]string)}
+
+	var entries []Entry
+	if err := a.load(ctx, indexKey, &entries); err != nil {
+		return nil, fmt.Errorf("failed to load archive index: %w", err)
+	}
+	for _, entry := range entries {
+		a.entries[entry.GameID] = entry
+	}
+
+	if err := a.load(ctx, deletionsKey, &a.deletions); err != nil {
+		return nil, fmt.Errorf("failed to load deletion audit: %w", err)
+	}
+	for _, deletion := range a.deletions {
+		a.deleted[deletion.AccountHash] = true
+	}
+
+	if err := a.load(ctx, accountKeysKey, &a.accountKeys); err != nil {
+		return nil, fmt.Errorf("failed to load account keys: %w", err)
+	}
+	return a, nil
+}
+
+// Save stores a game's record and adds it to the index
+// Seats of deleted accounts are anonymized first. The record is written before the index,
+// so an index entry always has a record behind it
+func (a *Archive) Save(ctx context.Context, record Record) error {
+	a.writeMu.Lock()
+	defer a.writeMu.Unlock()
+
+	anonymizeRecord(&record, a.deleted)
+	if err := a.putRecord(ctx, record); err != nil {
+		return err
+	}
+
+	previous, existed := a.entries[record.GameID]
+	a.setEntry(record.GameID, indexEntry(record))
+	if err := a.putIndex(ctx); err != nil {
+		if existed {
+			a.setEntry(record.GameID, previous)
+		} else {
+			a.mu.Lock()
+			delete(a.entries, record.GameID)
+			a.mu.Unlock()
+		}
+		return err
+	}
+	return nil
+}
+
+// setEntry replaces a game's index entry; callers hold writeMu
+func (a *Archive) setEntry(gameID string, entry Entry) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.entries[gameID] = entry
+}
+
+// Load returns a game's full record; unknown games return an error wrapping game.ErrGameNotFound
+func (a *Archive) Load(ctx context.Context, gameID string) (Record, error) {
+	if !a.Has(gameID) {
+		return Record{}, fmt.Errorf("game %s: %w", gameID, game.ErrGameNotFound)
+	}
+
+	data, err := a.store.Get(ctx, recordKey(gameID))
+	if errors.Is(err, ErrNotFound) {
+		return Record{}, fmt.Errorf("game %s: %w", gameID, game.ErrGameNotFound)
+	}
+	if err != nil {
+		return Record{}, err
+	}
+
+	var record Record
+	if err := decode(data, &record); err != nil {
+		return Record{}, err
+	}
+	return record, nil
+}
+
+// Has reports whether a game is archived
+func (a *Archive) Has(gameID string) bool {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+	_, ok := a.entries[gameID]
+	return ok
+}
+
+// Games lists archived games, most recently finished first
+func (a *Archive) Games(q Query) []Entry {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+
+	result := make([]Entry, 0)
+	for _, entry := range a.sortedEntries() {
+		if q.PlayerName != "" && seatNamed(entry, q.PlayerName) == nil {
+			continue
+		}
+		result = append(result, entry)
+		if q.Limit > 0 && len(result) == q.Limit {
+			break
+		}
+	}
+	return result
+}
+
+// PlayerStats summarizes every completed archived game with a seat of the given name
+func (a *Archive) PlayerStats(playerName string) PlayerStats {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+
+	stats := PlayerStats{PlayerName: playerName}
+	totalVP := 0
+	for _, entry := range a.entries {
+		if entry.Status != game.GameStatusCompleted {
+			continue
+		}
+		seat := seatNamed(entry, playerName)
+		if seat == nil || seat.Conceded {
+			continue
+		}
+		stats.Games++
+		totalVP += seat.TotalVP
+		if seat.IsWinner {
+			stats.Wins++
+		}
+		if seat.TotalVP > stats.BestVP {
+			stats.BestVP = seat.TotalVP
+		}
+	}
+	if stats.Games > 0 {
+		stats.AverageVP = float64(totalVP) / float64(stats.Games)
+	}
+	return stats
+}
+
+// sortedEntries returns the index most recently finished first; callers hold mu or writeMu
+func (a *Archive) sortedEntries() []Entry {
+	entries := make([]Entry, 0, len(a.entries))
+	for _, entry := range a.entries {
+		entries = append(entries, entry)
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		if !entries[i].FinishedAt.Equal(entries[j].FinishedAt) {
+			return entries[i].FinishedAt.After(entries[j].FinishedAt)
+		}
+		return entries[i].GameID < entries[j].GameID
+	})
+	return entries
+}
+
+// load decodes the blob under key into v, leaving v untouched when there is no blob
+func (a *Archive) load(ctx context.Context, key string, v any) error {
+	data, err := a.store.Get(ctx, key)
+	if errors.Is(err, ErrNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	return decode(data, v)
+}
+
+// putRecord writes a game's record blob
+func (a *Archive) putRecord(ctx context.Context, record Record) error {
+	data, err := encode(record)
+	if err != nil {
+		return err
+	}
+	if err := a.store.Put(ctx, recordKey(record.GameID), data); err != nil {
+		return fmt.Errorf("failed to store game %s: %w", record.GameID, err)
+	}
+	return nil
+}
+
+// putIndex rewrites the index blob; callers hold writeMu
+func (a *Archive) putIndex(ctx context.Context) error {
+	data, err := encode(a.sortedEntries())
+	if err == nil {
+		err = a.store.Put(ctx, indexKey, data)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to store archive index: %w", err)
+	}
+	return nil
+}
+
+func recordKey(gameID string) string {
+	return recordKeyPrefix + gameID + ".json.gz"
+}
+
+// indexEntry condenses a record to its index entry
+func indexEntry(record Record) Entry {
+	scores := make(map[string]game.FinalScore, len(record.FinalScores))
+	for _, score := range record.FinalScores {
+		scores[score.PlayerID] = score
+	}
+
+	players := make([]EntryPlayer, 0, len(record.Players)+len(record.ConcededPlayers))
+	for _, p := range record.Players {
+		seat := EntryPlayer{PlayerID: p.PlayerID, PlayerName: p.PlayerName, AccountID: p.AccountID, CorporationID: p.CorporationID}
+		if score, ok := scores[p.PlayerID]; ok {
+			seat.TotalVP = score.Breakdown.TotalVP
+			seat.Placement = score.Placement
+			seat.IsWinner = score.IsWinner
+		}
+		players = append(players, seat)
+	}
+	for _, p := range record.ConcededPlayers {
+		players = append(players, EntryPlayer{PlayerID: p.PlayerID, PlayerName: p.PlayerName, AccountID: p.AccountID, Conceded: true})
+	}
+
+	return Entry{
+		GameID:     record.GameID,
+		Status:     record.Status,
+		Generation: record.Generation,
+		FinishedAt: record.FinishedAt,
+		Players:    players,
+	}
+}
+
+// seatNamed finds the seat with the given name, ignoring case
+func seatNamed(entry Entry, playerName string) *EntryPlayer {
+	for i := range entry.Players {
+		if strings.EqualFold(entry.Players[i].PlayerName, playerName) {
+			return &entry.Players[i]
+		}
+	}
+	return nil
+}
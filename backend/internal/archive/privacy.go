@@ -0,0 +1,259 @@
+package archive
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// AnonymousName replaces the name of a deleted account's seats
+const AnonymousName = "Deleted player"
+
+var (
+	// ErrAccountRegistered is returned when a key is requested for an account that already has one
+	ErrAccountRegistered = errors.New("account already has a key")
+	// ErrInvalidAccountKey is returned when a key does not prove ownership of an account
+	ErrInvalidAccountKey = errors.New("invalid account key")
+)
+
+// Deletion is the audit record of one account's data deletion
+// The account is kept only as a hash, so the audit itself holds no personal data
+type Deletion struct {
+	AccountHash              string
+	DeletedAt                time.Time
+	GamesAnonymized          int
+	PuzzleCompletionsRemoved int
+}
+
+// HashAccount returns the hash an account is recorded under in the deletion audit
+func HashAccount(accountID string) string {
+	sum := sha256.Sum256([]byte(accountID))
+	return hex.EncodeToString(sum[:])
+}
+
+// RegisterAccount mints the key that proves ownership of an account; an account gets a key only once
+// Only hashes of the account and the key are stored, so the key cannot be recovered from the store
+func (a *Archive) RegisterAccount(ctx context.Context, accountID string) (string, error) {
+	if accountID == "" {
+		return "", fmt.Errorf("account ID is required")
+	}
+
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		return "", fmt.Errorf("failed to generate account key: %w", err)
+	}
+	key := hex.EncodeToString(secret)
+
+	a.writeMu.Lock()
+	defer a.writeMu.Unlock()
+
+	accountHash := HashAccount(accountID)
+	if _, exists := a.accountKeys[accountHash]; exists {
+		return "", ErrAccountRegistered
+	}
+
+	keys := make(map[string]string, len(a.accountKeys)+1)
+	for hash, keyHash := range a.accountKeys {
+		keys[hash] = keyHash
+	}
+	keys[accountHash] = hashKey(key)
+	data, err := encode(keys)
+	if err == nil {
+		err = a.store.Put(ctx, accountKeysKey, data)
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to store account key: %w", err)
+	}
+
+	a.mu.Lock()
+	a.accountKeys = keys
+	a.mu.Unlock()
+	return key, nil
+}
+
+// VerifyAccountKey returns ErrInvalidAccountKey unless key is the one RegisterAccount minted for the account
+func (a *Archive) VerifyAccountKey(accountID, key string) error {
+	a.mu.RLock()
+	keyHash, exists := a.accountKeys[HashAccount(accountID)]
+	a.mu.RUnlock()
+
+	if !exists || key == "" || subtle.ConstantTimeCompare([]byte(keyHash), []byte(hashKey(key))) != 1 {
+		return ErrInvalidAccountKey
+	}
+	return nil
+}
+
+func hashKey(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(sum[:])
+}
+
+// AnonymizeAccount rewrites every archived game with a seat of the account: the seat's name becomes AnonymousName
+// in the seats, scores and log, and the account link is dropped. Games archived later are anonymized as they are saved.
+// Returns the number of games rewritten
+func (a *Archive) AnonymizeAccount(ctx context.Context, accountID string) (int, error) {
+	if accountID == "" {
+		return 0, fmt.Errorf("account ID is required")
+	}
+
+	a.writeMu.Lock()
+	defer a.writeMu.Unlock()
+
+	a.mu.Lock()
+	a.deleted[HashAccount(accountID)] = true
+	a.mu.Unlock()
+
+	rewritten := 0
+	for gameID, entry := range a.entries {
+		if !hasAccount(entry, accountID) {
+			continue
+		}
+
+		var record Record
+		if err := a.load(ctx, recordKey(gameID), &record); err != nil {
+			return rewritten, fmt.Errorf("failed to load game %s: %w", gameID, err)
+		}
+		anonymizeRecord(&record, a.deleted)
+		if err := a.putRecord(ctx, record); err != nil {
+			return rewritten, err
+		}
+		a.setEntry(gameID, indexEntry(record))
+		rewritten++
+	}
+
+	if rewritten > 0 {
+		if err := a.putIndex(ctx); err != nil {
+			return rewritten, err
+		}
+	}
+	return rewritten, nil
+}
+
+// RecordDeletion appends a deletion to the audit
+func (a *Archive) RecordDeletion(ctx context.Context, deletion Deletion) error {
+	a.writeMu.Lock()
+	defer a.writeMu.Unlock()
+
+	deletions := append(append([]Deletion(nil), a.deletions...), deletion)
+	data, err := encode(deletions)
+	if err == nil {
+		err = a.store.Put(ctx, deletionsKey, data)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to store deletion audit: %w", err)
+	}
+
+	a.mu.Lock()
+	a.deletions = deletions
+	a.deleted[deletion.AccountHash] = true
+	a.mu.Unlock()
+	return nil
+}
+
+// Deletions returns the deletion audit, oldest first
+func (a *Archive) Deletions() []Deletion {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+	return append([]Deletion(nil), a.deletions...)
+}
+
+func hasAccount(entry Entry, accountID string) bool {
+	for _, seat := range entry.Players {
+		if seat.AccountID == accountID {
+			return true
+		}
+	}
+	return false
+}
+
+// connectPayload is a join or connect message as the input journal keeps it
+// It mirrors dto.PlayerConnectPayload field for field, so rewriting one keeps every other field intact
+type connectPayload struct {
+	PlayerName  string `json:"playerName"`
+	GameID      string `json:"gameId"`
+	PlayerID    string `json:"playerId,omitempty"`
+	AccountID   string `json:"accountId,omitempty"`
+	InviteToken string `json:"inviteToken,omitempty"`
+}
+
+// Input types whose payload is a connectPayload
+var connectInputTypes = map[string]bool{"player-connect": true, "join-game": true}
+
+// anonymizeRecord renames the seats of deleted accounts everywhere the record names them
+// Names are only rewritten in fields known to hold them; log descriptions never embed player names
+func anonymizeRecord(record *Record, deleted map[string]bool) {
+	names := make(map[string]string) // Player ID -> original name
+	isDeleted := func(accountID string) bool {
+		return accountID != "" && deleted[HashAccount(accountID)]
+	}
+	for i := range record.Players {
+		seat := &record.Players[i]
+		if !isDeleted(seat.AccountID) {
+			continue
+		}
+		names[seat.PlayerID] = seat.PlayerName
+		seat.PlayerName = AnonymousName
+		seat.AccountID = ""
+	}
+	for i := range record.ConcededPlayers {
+		seat := &record.ConcededPlayers[i]
+		if !isDeleted(seat.AccountID) {
+			continue
+		}
+		names[seat.PlayerID] = seat.PlayerName
+		seat.PlayerName = AnonymousName
+		seat.AccountID = ""
+	}
+
+	// A join that was refused or replaced left no seat, but its payload still carries the account
+	for i := range record.Inputs {
+		input := &record.Inputs[i]
+		if !connectInputTypes[input.Type] {
+			continue
+		}
+		var payload connectPayload
+		if err := json.Unmarshal(input.Payload, &payload); err != nil {
+			continue
+		}
+		_, seated := names[input.PlayerID]
+		if !seated && !isDeleted(payload.AccountID) {
+			continue
+		}
+		payload.PlayerName = AnonymousName
+		payload.AccountID = ""
+		if data, err := json.Marshal(payload); err == nil {
+			input.Payload = data
+		}
+	}
+	if len(names) == 0 {
+		return
+	}
+
+	for i := range record.FinalScores {
+		if _, ok := names[record.FinalScores[i].PlayerID]; ok {
+			record.FinalScores[i].PlayerName = AnonymousName
+		}
+	}
+
+	// A log summary names its entry's player as params["player"] and at the start of its text
+	for i := range record.Log {
+		entry := &record.Log[i]
+		name, ok := names[entry.PlayerID]
+		if !ok {
+			continue
+		}
+		if entry.Summary.Params != nil {
+			entry.Summary.Params["player"] = AnonymousName
+		}
+		if rest, found := strings.CutPrefix(entry.Summary.Text, name); found {
+			entry.Summary.Text = AnonymousName + rest
+		}
+	}
+}
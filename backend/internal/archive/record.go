@@ -0,0 +1,88 @@
+package archive
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+	"io"
+	"time"
+
+	"terraforming-mars-backend/internal/game"
+	"terraforming-mars-backend/internal/game/deck"
+)
+
+// Record is everything kept of a finished game once it leaves the hot store:
+// the settings and results, the full state history for replays, and the inputs that led to it
+type Record struct {
+	GameID          string
+	Status          game.GameStatus // Completed or abandoned
+	Generation      int
+	Settings        game.GameSettings
+	Players         []PlayerRecord // Seated at the end, in turn order
+	ConcededPlayers []game.ConcededPlayer
+	FinalScores     []game.FinalScore // Empty for abandoned games
+	WinnerID        string
+	IsTie           bool
+	CreatedAt       time.Time
+	FinishedAt      time.Time
+	Log             []game.StateDiff   // Every state diff, oldest first
+	ShuffleProof    *ShuffleProof      // Revealed deck seed; nil for unseeded decks
+	Inputs          []game.ActionInput // Every message players sent, oldest first; empty for games archived before inputs were kept
+}
+
+// ShuffleProof is the revealed seed and starting order behind a game's deck commitment
+type ShuffleProof struct {
+	Algorithm  string
+	Commitment string
+	Seed       string // Hex-encoded
+	Order      deck.Order
+}
+
+// PlayerRecord names a seat and the corporation it played
+type PlayerRecord struct {
+	PlayerID      string
+	PlayerName    string
+	AccountID     string // Account the seat belonged to, if any
+	CorporationID string
+}
+
+// DecodeRecord reads a record blob as the archive stores it, such as a file copied out of TM_ARCHIVE_DIR
+func DecodeRecord(data []byte) (Record, error) {
+	var record Record
+	if err := decode(data, &record); err != nil {
+		return Record{}, err
+	}
+	return record, nil
+}
+
+// encode writes a value as gzip-compressed JSON
+func encode(v any) ([]byte, error) {
+	var buf bytes.Buffer
+	zw := gzip.NewWriter(&buf)
+	if err := json.NewEncoder(zw).Encode(v); err != nil {
+		return nil, fmt.Errorf("failed to encode archive blob: %w", err)
+	}
+	if err := zw.Close(); err != nil {
+		return nil, fmt.Errorf("failed to compress archive blob: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// decode reads gzip-compressed JSON into v
+func decode(data []byte, v any) error {
+	zr, err := gzip.NewReader(bytes.NewReader(data))
+	if err != nil {
+		return fmt.Errorf("failed to decompress archive blob: %w", err)
+	}
+	defer zr.Close()
+
+	raw, err := io.ReadAll(zr)
+	if err != nil {
+		return fmt.Errorf("failed to decompress archive blob: %w", err)
+	}
+	if err := json.Unmarshal(raw, v); err != nil {
+		return fmt.Errorf("failed to decode archive blob: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,416 @@
+package audit
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	"terraforming-mars-backend/internal/archive"
+	"terraforming-mars-backend/internal/game"
+	"terraforming-mars-backend/internal/game/deck"
+	"terraforming-mars-backend/internal/game/parameters"
+	"terraforming-mars-backend/internal/game/shared"
+)
+
+// Check names the invariant a violation breaks
+type Check string
+
+const (
+	// CheckCards covers project card conservation: every card in exactly one place, none gained or lost
+	CheckCards Check = "cards"
+	// CheckOceans covers the ocean limit and the ocean tiles on the board matching the ocean count
+	CheckOceans Check = "oceans"
+	// CheckResources covers resources, production and TR following from the game log
+	CheckResources Check = "resources"
+	// CheckShuffle covers the revealed deck seed matching the commitment published at game start
+	CheckShuffle Check = "shuffle"
+)
+
+// Violation is one broken invariant, described for an operator
+type Violation struct {
+	Check  Check
+	Detail string
+}
+
+func (v Violation) String() string {
+	return fmt.Sprintf("%s: %s", v.Check, v.Detail)
+}
+
+// CheckGame audits a live game against its own state and its game log
+// Project cards must each be in exactly one place, and with a seeded deck the places must hold the whole
+// pool dealt at creation. Oceans must stay within the limit and match the board. Replaying the log must
+// give each player's current resources, production, TR and hand. Run it on an idle game: a check racing
+// an action can see state the log has not caught up with yet.
+func CheckGame(g *game.Game, diffs []game.StateDiff) []Violation {
+	violations := checkCardLocations(g)
+	violations = append(violations, checkBoardOceans(g)...)
+
+	if len(diffs) == 0 {
+		return violations
+	}
+	replayed, replayViolations := replayLog(diffs, nil)
+	violations = append(violations, replayViolations...)
+	return append(violations, compareReplay(g, replayed)...)
+}
+
+// CheckRecord audits an archived game from its record alone
+// The log is replayed for continuity, the ocean limit, negative resources and cards held twice;
+// with a shuffle proof the seed is verified and every card seen must come from the revealed pool.
+// The deck and discard pile are not in the log, so card totals are only checked on live games.
+func CheckRecord(record archive.Record) []Violation {
+	violations := make([]Violation, 0)
+
+	var pool map[string]bool
+	if proof := record.ShuffleProof; proof != nil {
+		violations = append(violations, checkShuffleProof(*proof)...)
+		pool = make(map[string]bool, len(proof.Order.ProjectCards))
+		for _, cardID := range proof.Order.ProjectCards {
+			pool[cardID] = true
+		}
+	}
+
+	_, replayViolations := replayLog(record.Log, pool)
+	return append(violations, replayViolations...)
+}
+
+func checkShuffleProof(proof archive.ShuffleProof) []Violation {
+	if proof.Algorithm != deck.ShuffleAlgorithm {
+		return []Violation{{Check: CheckShuffle, Detail: fmt.Sprintf("unknown shuffle algorithm %q", proof.Algorithm)}}
+	}
+	seed, err := deck.ParseSeed(proof.Seed)
+	if err != nil {
+		return []Violation{{Check: CheckShuffle, Detail: err.Error()}}
+	}
+	if err := deck.VerifyShuffle(seed, proof.Order, proof.Commitment); err != nil {
+		return []Violation{{Check: CheckShuffle, Detail: err.Error()}}
+	}
+	return nil
+}
+
+// checkCardLocations finds every project card in the deck, the discard and removed piles, hands, tableaus
+// and cards a player is still choosing from
+func checkCardLocations(g *game.Game) []Violation {
+	d := g.Deck()
+	if d == nil {
+		return nil
+	}
+
+	locations := make(map[string][]string) // card ID -> places it was found
+	add := func(place string, cardIDs []string) {
+		for _, cardID := range cardIDs {
+			locations[cardID] = append(locations[cardID], place)
+		}
+	}
+	add("deck", d.ProjectCards())
+	add("discard pile", d.DiscardPile())
+	add("removed cards", d.RemovedCards())
+	for _, p := range g.GetAllPlayers() {
+		add(p.ID()+"'s hand", p.Hand().Cards())
+		add(p.ID()+"'s tableau", p.PlayedCards().Cards())
+		if phase := g.GetSelectStartingCardsPhase(p.ID()); phase != nil {
+			add(p.ID()+"'s starting selection", phase.AvailableCards)
+		}
+		if phase := g.GetProductionPhase(p.ID()); phase != nil && !phase.SelectionComplete {
+			add(p.ID()+"'s research", phase.AvailableCards)
+		}
+		if selection := p.Selection().GetPendingCardDrawSelection(); selection != nil {
+			add(p.ID()+"'s card draw", selection.AvailableCards)
+		}
+	}
+
+	violations := make([]Violation, 0)
+	for _, cardID := range sortedKeys(locations) {
+		if places := locations[cardID]; len(places) > 1 {
+			violations = append(violations, Violation{
+				Check:  CheckCards,
+				Detail: fmt.Sprintf("card %s is in %d places: %s", cardID, len(places), strings.Join(places, ", ")),
+			})
+		}
+	}
+
+	_, order, seeded := d.Reveal()
+	if !seeded {
+		return violations
+	}
+	pool := make(map[string]bool, len(order.ProjectCards))
+	for _, cardID := range order.ProjectCards {
+		pool[cardID] = true
+	}
+	missing := make([]string, 0)
+	for _, cardID := range order.ProjectCards {
+		if _, found := locations[cardID]; !found {
+			missing = append(missing, cardID)
+		}
+	}
+	sort.Strings(missing)
+	if len(missing) > 0 {
+		violations = append(violations, Violation{
+			Check:  CheckCards,
+			Detail: fmt.Sprintf("%d of %d cards are unaccounted for: %s", len(missing), len(order.ProjectCards), strings.Join(missing, ", ")),
+		})
+	}
+	for _, cardID := range sortedKeys(locations) {
+		if !pool[cardID] {
+			violations = append(violations, Violation{
+				Check:  CheckCards,
+				Detail: fmt.Sprintf("card %s is not in the card pool the game was created with", cardID),
+			})
+		}
+	}
+	return violations
+}
+
+func checkBoardOceans(g *game.Game) []Violation {
+	violations := make([]Violation, 0)
+	oceans := g.GlobalParameters().Oceans()
+	if oceans > parameters.MaxOceans {
+		violations = append(violations, Violation{
+			Check:  CheckOceans,
+			Detail: fmt.Sprintf("%d oceans placed, the limit is %d", oceans, parameters.MaxOceans),
+		})
+	}
+
+	if g.Board() == nil {
+		return violations
+	}
+	tiles := 0
+	for _, tile := range g.Board().Tiles() {
+		if tile.OccupiedBy != nil && tile.OccupiedBy.Type == shared.ResourceOceanTile {
+			tiles++
+		}
+	}
+	if tiles != oceans {
+		violations = append(violations, Violation{
+			Check:  CheckOceans,
+			Detail: fmt.Sprintf("%d ocean tiles on the board, but the ocean count is %d", tiles, oceans),
+		})
+	}
+	return violations
+}
+
+// replayLog replays the log with game.GameSnapshot.Apply and checks each entry follows from the one before
+// A non-nil pool restricts which cards may appear in hands and tableaus
+func replayLog(diffs []game.StateDiff, pool map[string]bool) (*game.GameSnapshot, []Violation) {
+	violations := make([]Violation, 0)
+	replayed := game.NewReplaySnapshot()
+	holders := make(map[string]string) // card ID -> player holding it
+	playedBy := make(map[string]string)
+
+	follow := func(check Check, seq int64, subject, field string, logged int, value *game.DiffValueInt) {
+		if value != nil && value.Old != logged {
+			violations = append(violations, Violation{
+				Check:  check,
+				Detail: fmt.Sprintf("entry %d: %s%s changed from %d, but the log left it at %d", seq, subject, field, value.Old, logged),
+			})
+		}
+	}
+	foreign := func(seq int64, playerID, cardID string) {
+		if pool != nil && !pool[cardID] {
+			violations = append(violations, Violation{
+				Check:  CheckCards,
+				Detail: fmt.Sprintf("entry %d: %s got card %s, which is not in the revealed card pool", seq, playerID, cardID),
+			})
+		}
+	}
+
+	for _, diff := range diffs {
+		changes := diff.Changes
+		if changes == nil {
+			continue
+		}
+		seq := diff.SequenceNumber
+
+		// Each change must start where the entries before it left the value
+		follow(CheckOceans, seq, "", "oceans", replayed.Oceans, changes.Oceans)
+		follow(CheckResources, seq, "", "temperature", replayed.Temperature, changes.Temperature)
+		follow(CheckResources, seq, "", "oxygen", replayed.Oxygen, changes.Oxygen)
+		for _, playerID := range sortedKeys(changes.PlayerChanges) {
+			pc := changes.PlayerChanges[playerID]
+			if pc == nil {
+				continue
+			}
+			logged := loggedValues(replayed.Player(playerID))
+			for _, field := range playerFields(pc) {
+				follow(CheckResources, seq, playerID+"'s ", field.name, logged[field.name], field.value)
+			}
+		}
+
+		replayed.Apply(diff)
+
+		if replayed.Oceans > parameters.MaxOceans {
+			violations = append(violations, Violation{
+				Check:  CheckOceans,
+				Detail: fmt.Sprintf("entry %d: ocean count went to %d, the limit is %d", seq, replayed.Oceans, parameters.MaxOceans),
+			})
+		}
+		oceanTiles := 0
+		for _, tile := range replayed.Tiles {
+			if tile.TileType == string(shared.ResourceOceanTile) {
+				oceanTiles++
+			}
+		}
+		if oceanTiles != replayed.Oceans {
+			violations = append(violations, Violation{
+				Check:  CheckOceans,
+				Detail: fmt.Sprintf("entry %d: %d ocean tiles placed, but the ocean count is %d", seq, oceanTiles, replayed.Oceans),
+			})
+		}
+
+		// Removals first, so a card passing between players within one entry is not held twice
+		for _, playerID := range sortedKeys(changes.PlayerChanges) {
+			pc := changes.PlayerChanges[playerID]
+			if pc == nil {
+				continue
+			}
+			for _, cardID := range pc.CardsRemoved {
+				if holders[cardID] == playerID {
+					delete(holders, cardID)
+				}
+			}
+		}
+
+		for _, playerID := range sortedKeys(changes.PlayerChanges) {
+			pc := changes.PlayerChanges[playerID]
+			if pc == nil {
+				continue
+			}
+			for _, field := range playerFields(pc) {
+				if field.stock && field.value != nil && field.value.New < 0 {
+					violations = append(violations, Violation{
+						Check:  CheckResources,
+						Detail: fmt.Sprintf("entry %d: %s's %s went negative (%d)", seq, playerID, field.name, field.value.New),
+					})
+				}
+			}
+
+			for _, cardID := range pc.CardsAdded {
+				if holder, held := holders[cardID]; held && holder != playerID {
+					violations = append(violations, Violation{
+						Check:  CheckCards,
+						Detail: fmt.Sprintf("entry %d: card %s added to %s's hand while %s still holds it", seq, cardID, playerID, holder),
+					})
+				}
+				holders[cardID] = playerID
+				foreign(seq, playerID, cardID)
+			}
+			for _, cardID := range pc.CardsPlayed {
+				if earlier, played := playedBy[cardID]; played {
+					violations = append(violations, Violation{
+						Check:  CheckCards,
+						Detail: fmt.Sprintf("entry %d: card %s played by %s was already played by %s", seq, cardID, playerID, earlier),
+					})
+				}
+				playedBy[cardID] = playerID
+				foreign(seq, playerID, cardID)
+			}
+			hand := len(replayed.Player(playerID).HandCardIDs)
+			if pc.HandSize != nil && pc.HandSize.New != hand {
+				violations = append(violations, Violation{
+					Check:  CheckCards,
+					Detail: fmt.Sprintf("entry %d: %s's hand size is %d, but the cards added and removed leave %d", seq, playerID, pc.HandSize.New, hand),
+				})
+			}
+		}
+	}
+	return replayed, violations
+}
+
+// compareReplay checks the live players against the state their log entries add up to
+func compareReplay(g *game.Game, replayed *game.GameSnapshot) []Violation {
+	violations := make([]Violation, 0)
+	for _, p := range g.GetAllPlayers() {
+		ps := replayed.Player(p.ID())
+		logged := loggedValues(ps)
+		resources := p.Resources().Get()
+		production := p.Resources().Production()
+		current := map[string]int{
+			"credits":             resources.Credits,
+			"steel":               resources.Steel,
+			"titanium":            resources.Titanium,
+			"plants":              resources.Plants,
+			"energy":              resources.Energy,
+			"heat":                resources.Heat,
+			"TR":                  p.Resources().TerraformRating(),
+			"credits production":  production.Credits,
+			"steel production":    production.Steel,
+			"titanium production": production.Titanium,
+			"plants production":   production.Plants,
+			"energy production":   production.Energy,
+			"heat production":     production.Heat,
+		}
+		for _, field := range sortedKeys(current) {
+			if current[field] != logged[field] {
+				violations = append(violations, Violation{
+					Check:  CheckResources,
+					Detail: fmt.Sprintf("%s has %d %s, but the log adds up to %d", p.ID(), current[field], field, logged[field]),
+				})
+			}
+		}
+
+		hand := p.Hand().Cards()
+		loggedHand := slices.Clone(ps.HandCardIDs)
+		sort.Strings(hand)
+		sort.Strings(loggedHand)
+		if !slices.Equal(hand, loggedHand) {
+			violations = append(violations, Violation{
+				Check:  CheckCards,
+				Detail: fmt.Sprintf("%s holds %d cards, but the log adds up to %d", p.ID(), len(hand), len(loggedHand)),
+			})
+		}
+	}
+	return violations
+}
+
+// loggedValues names the integers a replayed player holds, matching playerFields
+func loggedValues(ps *game.PlayerSnapshot) map[string]int {
+	return map[string]int{
+		"credits":             ps.Credits,
+		"steel":               ps.Steel,
+		"titanium":            ps.Titanium,
+		"plants":              ps.Plants,
+		"energy":              ps.Energy,
+		"heat":                ps.Heat,
+		"TR":                  ps.TerraformRating,
+		"credits production":  ps.CreditsProduction,
+		"steel production":    ps.SteelProduction,
+		"titanium production": ps.TitaniumProduction,
+		"plants production":   ps.PlantsProduction,
+		"energy production":   ps.EnergyProduction,
+		"heat production":     ps.HeatProduction,
+	}
+}
+
+// playerField is one integer a log entry can change for a player
+type playerField struct {
+	name  string
+	value *game.DiffValueInt
+	stock bool // A resource pile, which can never go negative
+}
+
+func playerFields(pc *game.PlayerChanges) []playerField {
+	return []playerField{
+		{name: "credits", value: pc.Credits, stock: true},
+		{name: "steel", value: pc.Steel, stock: true},
+		{name: "titanium", value: pc.Titanium, stock: true},
+		{name: "plants", value: pc.Plants, stock: true},
+		{name: "energy", value: pc.Energy, stock: true},
+		{name: "heat", value: pc.Heat, stock: true},
+		{name: "TR", value: pc.TerraformRating},
+		{name: "credits production", value: pc.CreditsProduction},
+		{name: "steel production", value: pc.SteelProduction},
+		{name: "titanium production", value: pc.TitaniumProduction},
+		{name: "plants production", value: pc.PlantsProduction},
+		{name: "energy production", value: pc.EnergyProduction},
+		{name: "heat production", value: pc.HeatProduction},
+	}
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
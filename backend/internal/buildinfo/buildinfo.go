@@ -0,0 +1,52 @@
+package buildinfo
+
+import (
+	"runtime"
+	"runtime/debug"
+)
+
+// GitSHA is the commit the server was built from, set at build time with
+// -ldflags "-X terraforming-mars-backend/internal/buildinfo.GitSHA=<sha>"
+// When unset, the VCS revision Go embeds in the binary is used instead
+var GitSHA = ""
+
+// Unknown is reported for values the build did not record
+const Unknown = "unknown"
+
+// Info identifies the running build
+type Info struct {
+	GitSHA          string
+	Modified        bool // Built from a tree with uncommitted changes
+	GoVersion       string
+	CardDataVersion string // Fingerprint of the loaded card data
+}
+
+// Read collects the build information of the running binary
+func Read(cardDataVersion string) Info {
+	info := Info{
+		GitSHA:          GitSHA,
+		GoVersion:       runtime.Version(),
+		CardDataVersion: cardDataVersion,
+	}
+
+	if build, ok := debug.ReadBuildInfo(); ok {
+		for _, setting := range build.Settings {
+			switch setting.Key {
+			case "vcs.revision":
+				if info.GitSHA == "" {
+					info.GitSHA = setting.Value
+				}
+			case "vcs.modified":
+				info.Modified = setting.Value == "true"
+			}
+		}
+	}
+
+	if info.GitSHA == "" {
+		info.GitSHA = Unknown
+	}
+	if info.CardDataVersion == "" {
+		info.CardDataVersion = Unknown
+	}
+	return info
+}
@@ -0,0 +1,235 @@
+package cards
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+
+	gamecards "terraforming-mars-backend/internal/game/cards"
+	"terraforming-mars-backend/internal/game/shared"
+)
+
+// FieldChange is one semantic field of a card that differs between two card files
+type FieldChange struct {
+	Field string `json:"field"` // "cost", "tags", "behavior 2", "behavior 2 data", ...
+	Old   string `json:"old"`   // Empty when the field was added
+	New   string `json:"new"`   // Empty when the field was removed
+}
+
+// CardChange is a card present in both files whose semantics differ
+type CardChange struct {
+	CardID  string        `json:"cardId"`
+	Name    string        `json:"name"`
+	Changes []FieldChange `json:"changes"`
+}
+
+// CardRef names a card that was added or removed
+type CardRef struct {
+	CardID string `json:"cardId"`
+	Name   string `json:"name"`
+}
+
+// CardDiff is the semantic difference between two versions of the card data
+// Descriptions are left out: they are display text, and a description change alone cannot change
+// how a card plays. Behaviors are compared as the rules text the engine data produces
+// (gamecards.ExplainBehavior), with a raw data entry when the data changed but the text did not.
+type CardDiff struct {
+	Added   []CardRef    `json:"added"`
+	Removed []CardRef    `json:"removed"`
+	Changed []CardChange `json:"changed"`
+}
+
+// Empty reports whether the two versions play the same
+func (d CardDiff) Empty() bool {
+	return len(d.Added) == 0 && len(d.Removed) == 0 && len(d.Changed) == 0
+}
+
+// DiffCards compares two versions of the card data, in card ID order
+func DiffCards(oldCards, newCards []gamecards.Card) CardDiff {
+	oldByID := make(map[string]gamecards.Card, len(oldCards))
+	for _, card := range oldCards {
+		oldByID[card.ID] = card
+	}
+	newByID := make(map[string]gamecards.Card, len(newCards))
+	for _, card := range newCards {
+		newByID[card.ID] = card
+	}
+
+	diff := CardDiff{Added: []CardRef{}, Removed: []CardRef{}, Changed: []CardChange{}}
+	for _, id := range sortedCardIDs(oldByID) {
+		oldCard := oldByID[id]
+		newCard, exists := newByID[id]
+		if !exists {
+			diff.Removed = append(diff.Removed, CardRef{CardID: id, Name: oldCard.Name})
+			continue
+		}
+		if changes := diffCard(oldCard, newCard); len(changes) > 0 {
+			diff.Changed = append(diff.Changed, CardChange{CardID: id, Name: newCard.Name, Changes: changes})
+		}
+	}
+	for _, id := range sortedCardIDs(newByID) {
+		if _, exists := oldByID[id]; !exists {
+			diff.Added = append(diff.Added, CardRef{CardID: id, Name: newByID[id].Name})
+		}
+	}
+	return diff
+}
+
+func sortedCardIDs(byID map[string]gamecards.Card) []string {
+	ids := make([]string, 0, len(byID))
+	for id := range byID {
+		ids = append(ids, id)
+	}
+	slices.Sort(ids)
+	return ids
+}
+
+func diffCard(oldCard, newCard gamecards.Card) []FieldChange {
+	var changes []FieldChange
+	compare := func(field, oldValue, newValue string) {
+		if oldValue != newValue {
+			changes = append(changes, FieldChange{Field: field, Old: oldValue, New: newValue})
+		}
+	}
+
+	compare("name", oldCard.Name, newCard.Name)
+	compare("type", string(oldCard.Type), string(newCard.Type))
+	compare("cost", fmt.Sprint(oldCard.Cost), fmt.Sprint(newCard.Cost))
+	compare("pack", oldCard.Pack, newCard.Pack)
+	compare("tags", tagsText(oldCard.Tags), tagsText(newCard.Tags))
+	compare("requirements", requirementsText(oldCard.Requirements), requirementsText(newCard.Requirements))
+	compare("resource storage", storageText(oldCard.ResourceStorage), storageText(newCard.ResourceStorage))
+	compare("victory points", vpText(oldCard.VPConditions), vpText(newCard.VPConditions))
+
+	for i := range max(len(oldCard.Behaviors), len(newCard.Behaviors)) {
+		var oldText, newText, oldData, newData string
+		if i < len(oldCard.Behaviors) {
+			oldText, oldData = behaviorText(oldCard.Behaviors[i])
+		}
+		if i < len(newCard.Behaviors) {
+			newText, newData = behaviorText(newCard.Behaviors[i])
+		}
+		field := fmt.Sprintf("behavior %d", i)
+		if oldText != newText {
+			compare(field, oldText, newText)
+		} else {
+			compare(field+" data", oldData, newData)
+		}
+	}
+	return changes
+}
+
+func tagsText(tags []shared.CardTag) string {
+	names := make([]string, len(tags))
+	for i, tag := range tags {
+		names[i] = string(tag)
+	}
+	return strings.Join(names, ", ")
+}
+
+func requirementsText(requirements *gamecards.CardRequirements) string {
+	if requirements == nil {
+		return ""
+	}
+	parts := make([]string, 0, len(requirements.Items))
+	for _, item := range requirements.Items {
+		part := string(item.Type)
+		if item.Tag != nil {
+			part += " " + string(*item.Tag)
+		}
+		if item.Resource != nil {
+			part += " " + string(*item.Resource)
+		}
+		if item.Min != nil {
+			part += fmt.Sprintf(" min %d", *item.Min)
+		}
+		if item.Max != nil {
+			part += fmt.Sprintf(" max %d", *item.Max)
+		}
+		if item.Location != nil {
+			part += " on " + string(*item.Location)
+		}
+		parts = append(parts, part)
+	}
+	return strings.Join(parts, ", ")
+}
+
+func storageText(storage *gamecards.ResourceStorage) string {
+	if storage == nil {
+		return ""
+	}
+	text := fmt.Sprintf("%s, starting %d", storage.Type, storage.Starting)
+	if storage.Capacity != nil {
+		text += fmt.Sprintf(", capacity %d", *storage.Capacity)
+	}
+	return text
+}
+
+func vpText(conditions []gamecards.VictoryPointCondition) string {
+	stripped := make([]gamecards.VictoryPointCondition, len(conditions))
+	for i, condition := range conditions {
+		condition.Description = ""
+		stripped[i] = condition
+	}
+	return compactJSON(stripped)
+}
+
+// behaviorText returns a behavior's rules text and its data without the description
+func behaviorText(behavior shared.CardBehavior) (text, data string) {
+	behavior.Description = ""
+	return gamecards.ExplainBehavior(behavior).Text, compactJSON(behavior)
+}
+
+func compactJSON(value any) string {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprintf("%v", value)
+	}
+	if string(data) == "[]" || string(data) == "null" {
+		return ""
+	}
+	return string(data)
+}
+
+// Markdown renders the diff as a release notes section
+func (d CardDiff) Markdown() string {
+	var b strings.Builder
+	b.WriteString("## Card data changes\n\n")
+	if d.Empty() {
+		b.WriteString("No card plays differently.\n")
+		return b.String()
+	}
+
+	if len(d.Added) > 0 {
+		fmt.Fprintf(&b, "### Added (%d)\n\n", len(d.Added))
+		for _, card := range d.Added {
+			fmt.Fprintf(&b, "- %s %s\n", card.CardID, card.Name)
+		}
+		b.WriteString("\n")
+	}
+	if len(d.Removed) > 0 {
+		fmt.Fprintf(&b, "### Removed (%d)\n\n", len(d.Removed))
+		for _, card := range d.Removed {
+			fmt.Fprintf(&b, "- %s %s\n", card.CardID, card.Name)
+		}
+		b.WriteString("\n")
+	}
+	if len(d.Changed) > 0 {
+		fmt.Fprintf(&b, "### Changed (%d)\n\n", len(d.Changed))
+		for _, card := range d.Changed {
+			fmt.Fprintf(&b, "- **%s %s**\n", card.CardID, card.Name)
+			for _, change := range card.Changes {
+				fmt.Fprintf(&b, "  - %s: %s → %s\n", change.Field, markdownValue(change.Old), markdownValue(change.New))
+			}
+		}
+	}
+	return b.String()
+}
+
+func markdownValue(value string) string {
+	if value == "" {
+		return "_none_"
+	}
+	return "`" + strings.ReplaceAll(value, "`", "'") + "`"
+}
@@ -0,0 +1,128 @@
+package cards
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+
+	gamecards "terraforming-mars-backend/internal/game/cards"
+)
+
+// StrategyTags is the vocabulary guides describe a corporation's plan with; clients can render
+// each as a chip or icon
+var StrategyTags = []string{
+	"economy", "cards", "plants", "heat", "building", "space", "science", "earth",
+	"jovian", "events", "cities", "oceans", "terraforming", "awards", "milestones",
+}
+
+// CorporationGuide is the "how to play" sheet for a corporation, shown to new players while they
+// choose their starting corporation
+type CorporationGuide struct {
+	CardID   string   `json:"cardId"`
+	Summary  string   `json:"summary"`  // The corporation's ability in plain words
+	Strategy []string `json:"strategy"` // Suggested directions, from StrategyTags
+	Tips     []string `json:"tips,omitempty"`
+}
+
+// LoadGuidesFromDir loads every *.json guide file in dir
+// Each file holds an array of guides, usually one file per card pack
+func LoadGuidesFromDir(dir string) ([]CorporationGuide, error) {
+	paths, err := filepath.Glob(filepath.Join(dir, "*.json"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list guide files: %w", err)
+	}
+
+	guides := make([]CorporationGuide, 0)
+	seen := make(map[string]string)
+	for _, path := range paths {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read guide file: %w", err)
+		}
+
+		var fileGuides []CorporationGuide
+		if err := json.Unmarshal(data, &fileGuides); err != nil {
+			return nil, fmt.Errorf("failed to parse guides %s: %w", filepath.Base(path), err)
+		}
+		for _, guide := range fileGuides {
+			if err := guide.validate(); err != nil {
+				return nil, fmt.Errorf("invalid guide in %s: %w", filepath.Base(path), err)
+			}
+			if other, exists := seen[guide.CardID]; exists {
+				return nil, fmt.Errorf("guide for %s is in both %s and %s", guide.CardID, other, filepath.Base(path))
+			}
+			seen[guide.CardID] = filepath.Base(path)
+			guides = append(guides, guide)
+		}
+	}
+
+	return guides, nil
+}
+
+func (g CorporationGuide) validate() error {
+	if g.CardID == "" {
+		return fmt.Errorf("guide has no card ID")
+	}
+	if g.Summary == "" {
+		return fmt.Errorf("guide for %s has no summary", g.CardID)
+	}
+	for _, tag := range g.Strategy {
+		if !slices.Contains(StrategyTags, tag) {
+			return fmt.Errorf("guide for %s has unknown strategy tag %q", g.CardID, tag)
+		}
+	}
+	return nil
+}
+
+// GuideRegistry provides lookup of corporation guides by card ID
+type GuideRegistry struct {
+	guides map[string]CorporationGuide
+}
+
+// NewGuideRegistry creates a registry from a slice of guides
+func NewGuideRegistry(guides []CorporationGuide) *GuideRegistry {
+	guideMap := make(map[string]CorporationGuide, len(guides))
+	for _, guide := range guides {
+		guideMap[guide.CardID] = guide
+	}
+	return &GuideRegistry{guides: guideMap}
+}
+
+// GetByCardID retrieves the guide for a corporation card
+func (r *GuideRegistry) GetByCardID(cardID string) (CorporationGuide, bool) {
+	guide, exists := r.guides[cardID]
+	if !exists {
+		return CorporationGuide{}, false
+	}
+	guide.Strategy = slices.Clone(guide.Strategy)
+	guide.Tips = slices.Clone(guide.Tips)
+	return guide, true
+}
+
+// Count returns the number of guides
+func (r *GuideRegistry) Count() int {
+	return len(r.guides)
+}
+
+// CheckAgainst reports guides that name a card missing from the registry or not a corporation,
+// so a typo in a data file fails at startup instead of hiding a guide
+func (r *GuideRegistry) CheckAgainst(registry CardRegistry) error {
+	ids := make([]string, 0, len(r.guides))
+	for id := range r.guides {
+		ids = append(ids, id)
+	}
+	slices.Sort(ids)
+
+	for _, id := range ids {
+		card, err := registry.GetByID(id)
+		if err != nil {
+			return fmt.Errorf("guide for unknown card: %s", id)
+		}
+		if card.Type != gamecards.CardTypeCorporation {
+			return fmt.Errorf("guide for %s, which is not a corporation", id)
+		}
+	}
+	return nil
+}
@@ -1,6 +1,8 @@
 package cards
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"os"
@@ -26,3 +28,14 @@ func LoadCardsFromJSON(filepath string) ([]cards.Card, error) {
 
 	return cards, nil
 }
+
+// CardDataVersion fingerprints a card file: the first 12 hex digits of its SHA-256,
+// so a bug report can name the card data the server had loaded
+func CardDataVersion(filepath string) (string, error) {
+	data, err := os.ReadFile(filepath)
+	if err != nil {
+		return "", fmt.Errorf("failed to read card file: %w", err)
+	}
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])[:12], nil
+}
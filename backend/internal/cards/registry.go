@@ -2,6 +2,8 @@ package cards
 
 import (
 	"fmt"
+	"sort"
+	"sync"
 
 	"terraforming-mars-backend/internal/game"
 	gamecards "terraforming-mars-backend/internal/game/cards"
@@ -16,11 +18,33 @@ type CardRegistry interface {
 
 	// GetAll returns all cards in the registry
 	GetAll() []gamecards.Card
+
+	// ProjectDeckForPacks returns the IDs of the project cards in the given packs, sorted
+	ProjectDeckForPacks(packs []string) []string
+
+	// CorporationsForPacks returns the IDs of the corporations in the given packs, sorted
+	CorporationsForPacks(packs []string) []string
+
+	// PreludesForPacks returns the IDs of the preludes in the given packs, sorted
+	PreludesForPacks(packs []string) []string
 }
 
 // InMemoryCardRegistry implements CardRegistry with an in-memory map
+// Nothing changes after construction, so it is safe for concurrent use without locking;
+// the per-pack indexes are built on first use.
 type InMemoryCardRegistry struct {
 	cards map[string]gamecards.Card
+	ids   []string // Sorted, so GetAll is stable
+
+	indexOnce sync.Once
+	packs     map[string]*packIndex
+}
+
+// packIndex holds one pack's card IDs by kind, each sorted
+type packIndex struct {
+	projectCards []string
+	corporations []string
+	preludes     []string
 }
 
 // NewInMemoryCardRegistry creates a new card registry from a slice of cards
@@ -30,8 +54,15 @@ func NewInMemoryCardRegistry(cardList []gamecards.Card) *InMemoryCardRegistry {
 		cardMap[card.ID] = card
 	}
 
+	ids := make([]string, 0, len(cardMap))
+	for id := range cardMap {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
 	return &InMemoryCardRegistry{
 		cards: cardMap,
+		ids:   ids,
 	}
 }
 
@@ -47,41 +78,75 @@ func (r *InMemoryCardRegistry) GetByID(cardID string) (*gamecards.Card, error) {
 	return &cardCopy, nil
 }
 
-// GetAll returns all cards in the registry
+// GetAll returns all cards in the registry, ordered by ID
 func (r *InMemoryCardRegistry) GetAll() []gamecards.Card {
-	cardList := make([]gamecards.Card, 0, len(r.cards))
-	for _, card := range r.cards {
-		cardList = append(cardList, card.DeepCopy())
+	cardList := make([]gamecards.Card, 0, len(r.ids))
+	for _, id := range r.ids {
+		cardList = append(cardList, r.cards[id].DeepCopy())
 	}
 	return cardList
 }
 
-// GetCardIDsByPacks filters cards by pack and separates them by type.
-// Returns project card IDs, corporation IDs, and prelude IDs.
-func GetCardIDsByPacks(registry CardRegistry, packs []string) (projectCards, corps, preludes []string) {
-	allCards := registry.GetAll()
+// ProjectDeckForPacks returns the IDs of the project cards in the given packs, sorted
+func (r *InMemoryCardRegistry) ProjectDeckForPacks(packs []string) []string {
+	return r.collect(packs, func(index *packIndex) []string { return index.projectCards })
+}
+
+// CorporationsForPacks returns the IDs of the corporations in the given packs, sorted
+func (r *InMemoryCardRegistry) CorporationsForPacks(packs []string) []string {
+	return r.collect(packs, func(index *packIndex) []string { return index.corporations })
+}
+
+// PreludesForPacks returns the IDs of the preludes in the given packs, sorted
+func (r *InMemoryCardRegistry) PreludesForPacks(packs []string) []string {
+	return r.collect(packs, func(index *packIndex) []string { return index.preludes })
+}
 
-	packMap := make(map[string]bool, len(packs))
+// collect merges one kind of card from each named pack into a new slice the caller may keep
+// Unknown and repeated pack names are ignored
+func (r *InMemoryCardRegistry) collect(packs []string, kind func(*packIndex) []string) []string {
+	r.indexOnce.Do(r.buildIndexes)
+
+	var ids []string
+	seen := make(map[string]bool, len(packs))
 	for _, pack := range packs {
-		packMap[pack] = true
+		index, ok := r.packs[pack]
+		if !ok || seen[pack] {
+			continue
+		}
+		seen[pack] = true
+		ids = append(ids, kind(index)...)
 	}
+	if len(seen) > 1 {
+		sort.Strings(ids)
+	}
+	return ids
+}
 
-	for _, card := range allCards {
-		if !packMap[card.Pack] {
-			continue
+func (r *InMemoryCardRegistry) buildIndexes() {
+	r.packs = make(map[string]*packIndex)
+	for _, id := range r.ids {
+		card := r.cards[id]
+		index, ok := r.packs[card.Pack]
+		if !ok {
+			index = &packIndex{}
+			r.packs[card.Pack] = index
 		}
 
 		switch card.Type {
 		case gamecards.CardTypeCorporation:
-			corps = append(corps, card.ID)
+			index.corporations = append(index.corporations, id)
 		case gamecards.CardTypePrelude:
-			preludes = append(preludes, card.ID)
+			index.preludes = append(index.preludes, id)
 		default:
-			projectCards = append(projectCards, card.ID)
+			index.projectCards = append(index.projectCards, id)
 		}
 	}
+}
 
-	return projectCards, corps, preludes
+// GetCardIDsByPacks returns the project card, corporation and prelude IDs of the given packs.
+func GetCardIDsByPacks(registry CardRegistry, packs []string) (projectCards, corps, preludes []string) {
+	return registry.ProjectDeckForPacks(packs), registry.CorporationsForPacks(packs), registry.PreludesForPacks(packs)
 }
 
 type VPCardLookupAdapter struct {
@@ -0,0 +1,218 @@
+package cards
+
+import (
+	"sort"
+	"strings"
+	"unicode"
+
+	gamecards "terraforming-mars-backend/internal/game/cards"
+)
+
+// MatchKind tells how a query matched a card, from the most to the least certain
+type MatchKind string
+
+const (
+	MatchID        MatchKind = "id"        // The query is the card ID, with or without leading zeros
+	MatchName      MatchKind = "name"      // The query is the full name, ignoring case and punctuation
+	MatchAlias     MatchKind = "alias"     // The query is the name's initials, e.g. "ap" for Artificial Photosynthesis
+	MatchPrefix    MatchKind = "prefix"    // The name starts with the query
+	MatchWords     MatchKind = "words"     // Each query word starts a name word, in order: "art photo"
+	MatchSubstring MatchKind = "substring" // The name contains the query
+	MatchFuzzy     MatchKind = "fuzzy"     // The query is a few typos away from the name or its start
+)
+
+var matchScores = map[MatchKind]int{
+	MatchID:        100,
+	MatchName:      100,
+	MatchAlias:     90,
+	MatchPrefix:    80,
+	MatchWords:     70,
+	MatchSubstring: 60,
+	MatchFuzzy:     50,
+}
+
+// CardMatch is one card a query resolved to; higher scores are better matches
+type CardMatch struct {
+	Card      gamecards.Card
+	Score     int
+	MatchedBy MatchKind
+}
+
+// Resolver turns what players type ("art photo", "ap", "5") into cards, so nobody has to know card IDs
+// Names and aliases are indexed once; the registry does not change after loading
+type Resolver struct {
+	entries []resolverEntry
+}
+
+type resolverEntry struct {
+	card     gamecards.Card
+	id       string
+	name     string   // Normalized name
+	words    []string // Normalized name words
+	initials string
+}
+
+// NewResolver indexes every card in the registry
+func NewResolver(registry CardRegistry) *Resolver {
+	all := registry.GetAll()
+	entries := make([]resolverEntry, 0, len(all))
+	for _, card := range all {
+		name := normalizeCardQuery(card.Name)
+		words := strings.Fields(name)
+		var initials strings.Builder
+		for _, word := range words {
+			initials.WriteRune([]rune(word)[0])
+		}
+		entries = append(entries, resolverEntry{
+			card:     card,
+			id:       strings.ToLower(card.ID),
+			name:     name,
+			words:    words,
+			initials: initials.String(),
+		})
+	}
+	return &Resolver{entries: entries}
+}
+
+// Resolve returns up to limit cards matching query, best first
+// Ties go to the shorter name, which is the closer fit for the same query
+func (r *Resolver) Resolve(query string, limit int) []CardMatch {
+	normalized := normalizeCardQuery(query)
+	if normalized == "" || limit <= 0 {
+		return nil
+	}
+	rawQuery := strings.ToLower(strings.TrimSpace(query))
+
+	var matches []CardMatch
+	for _, entry := range r.entries {
+		kind, score, ok := entry.match(rawQuery, normalized)
+		if !ok {
+			continue
+		}
+		matches = append(matches, CardMatch{Card: entry.card, Score: score, MatchedBy: kind})
+	}
+
+	sort.Slice(matches, func(i, j int) bool {
+		a, b := matches[i], matches[j]
+		if a.Score != b.Score {
+			return a.Score > b.Score
+		}
+		if len(a.Card.Name) != len(b.Card.Name) {
+			return len(a.Card.Name) < len(b.Card.Name)
+		}
+		if a.Card.Name != b.Card.Name {
+			return a.Card.Name < b.Card.Name
+		}
+		return a.Card.ID < b.Card.ID
+	})
+
+	if len(matches) > limit {
+		matches = matches[:limit]
+	}
+	return matches
+}
+
+func (e resolverEntry) match(rawQuery, query string) (MatchKind, int, bool) {
+	switch {
+	case rawQuery == e.id || (isDigits(rawQuery) && strings.TrimLeft(rawQuery, "0") == strings.TrimLeft(e.id, "0")):
+		return MatchID, matchScores[MatchID], true
+	case query == e.name:
+		return MatchName, matchScores[MatchName], true
+	case len(e.words) > 1 && strings.ReplaceAll(query, " ", "") == e.initials:
+		return MatchAlias, matchScores[MatchAlias], true
+	case strings.HasPrefix(e.name, query):
+		return MatchPrefix, matchScores[MatchPrefix], true
+	case e.matchesWords(strings.Fields(query)):
+		return MatchWords, matchScores[MatchWords], true
+	case strings.Contains(e.name, query):
+		return MatchSubstring, matchScores[MatchSubstring], true
+	}
+
+	// Typos: compare against the whole name and against its start, for partly typed names
+	queryRunes := []rune(query)
+	allowed := len(queryRunes) / 4
+	if allowed == 0 {
+		return "", 0, false
+	}
+	nameRunes := []rune(e.name)
+	distance := editDistance(queryRunes, nameRunes)
+	if len(nameRunes) > len(queryRunes) {
+		distance = min(distance, editDistance(queryRunes, nameRunes[:len(queryRunes)]))
+	}
+	if distance > allowed {
+		return "", 0, false
+	}
+	return MatchFuzzy, matchScores[MatchFuzzy] - 5*distance, true
+}
+
+// matchesWords reports whether each query word starts a different name word, in order
+func (e resolverEntry) matchesWords(queryWords []string) bool {
+	if len(queryWords) == 0 {
+		return false
+	}
+	next := 0
+	for _, queryWord := range queryWords {
+		for next < len(e.words) && !strings.HasPrefix(e.words[next], queryWord) {
+			next++
+		}
+		if next == len(e.words) {
+			return false
+		}
+		next++
+	}
+	return true
+}
+
+// normalizeCardQuery lowercases text and turns punctuation into single spaces: "Inventors' Guild" -> "inventors guild"
+func normalizeCardQuery(text string) string {
+	var b strings.Builder
+	space := false
+	for _, r := range strings.ToLower(text) {
+		switch {
+		case r == '\'' || r == '’':
+			// Apostrophes join words rather than split them
+		case unicode.IsLetter(r) || unicode.IsDigit(r):
+			if space && b.Len() > 0 {
+				b.WriteByte(' ')
+			}
+			space = false
+			b.WriteRune(r)
+		default:
+			space = true
+		}
+	}
+	return b.String()
+}
+
+func isDigits(text string) bool {
+	if text == "" {
+		return false
+	}
+	for _, r := range text {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// editDistance is the Levenshtein distance between a and b
+func editDistance(a, b []rune) int {
+	previous := make([]int, len(b)+1)
+	current := make([]int, len(b)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		current[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			current[j] = min(previous[j]+1, current[j-1]+1, previous[j-1]+cost)
+		}
+		previous, current = current, previous
+	}
+	return previous[len(b)]
+}
@@ -0,0 +1,62 @@
+package cards
+
+import (
+	"terraforming-mars-backend/internal/game/cards"
+	"terraforming-mars-backend/internal/game/shared"
+)
+
+// turmoilRequirements holds the Turmoil cards whose requirement is a ruling party, delegates, the Chairman
+// or Party Leaders. The card data has no requirement type for these, so they exist only in the description
+// and are keyed here by card ID.
+var turmoilRequirements = map[string]string{
+	"T01": "Kelvinists ruling or 2 delegates there",
+	"T02": "being Chairman",
+	"T03": "Unity ruling or 2 delegates there",
+	"T04": "Reds ruling or 2 delegates there",
+	"T05": "Scientists ruling or 2 delegates there",
+	"T06": "Greens ruling or 2 delegates there",
+	"T07": "Mars First ruling or 2 delegates there",
+	"T08": "Mars First ruling or 2 delegates there",
+	"T09": "Unity ruling or 2 delegates there",
+	"T10": "being Chairman",
+	"T12": "Reds ruling or 2 delegates there",
+	"T13": "Kelvinists ruling or 2 delegates there",
+	"T14": "Scientists ruling or 2 delegates there",
+	"T15": "Greens ruling or 2 delegates there",
+	"T16": "a Party Leader and a neutral Chairman",
+	"X09": "2 Party Leaders",
+}
+
+// TurmoilRequirement returns the requirement a card has that cannot be checked until Turmoil is implemented
+func TurmoilRequirement(cardID string) (string, bool) {
+	requirement, ok := turmoilRequirements[cardID]
+	return requirement, ok
+}
+
+// TurmoilDependentCards lists cards with a Turmoil requirement or whose behaviors spend or gain influence or delegates
+// They load and play like any other card; those parts are skipped until Turmoil is implemented
+func TurmoilDependentCards(cardList []cards.Card) []string {
+	ids := make([]string, 0)
+	for _, card := range cardList {
+		if _, ok := turmoilRequirements[card.ID]; ok || usesTurmoilResources(card) {
+			ids = append(ids, card.ID)
+		}
+	}
+	return ids
+}
+
+func usesTurmoilResources(card cards.Card) bool {
+	for _, behavior := range card.Behaviors {
+		conditions := append(append([]shared.ResourceCondition{}, behavior.Inputs...), behavior.Outputs...)
+		for _, choice := range behavior.Choices {
+			conditions = append(conditions, choice.Inputs...)
+			conditions = append(conditions, choice.Outputs...)
+		}
+		for _, cond := range conditions {
+			if shared.IsTurmoilResource(cond.ResourceType) {
+				return true
+			}
+		}
+	}
+	return false
+}
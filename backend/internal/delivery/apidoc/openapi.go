@@ -0,0 +1,466 @@
+package apidoc
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	"terraforming-mars-backend/internal/delivery/dto"
+	"terraforming-mars-backend/internal/delivery/jsonrpc"
+)
+
+// APIVersion is the version reported in generated API documents
+const APIVersion = "1.0.0"
+
+// operation describes a single HTTP endpoint in the OpenAPI document
+type operation struct {
+	method      string
+	path        string
+	tag         string
+	summary     string
+	parameters  []parameter
+	requestBody interface{}
+	status      int
+	response    interface{}
+	mediaType   string // Non-JSON response body such as text/plain, documented as a string; response is ignored
+}
+
+// parameter describes a path or query parameter
+type parameter struct {
+	name        string
+	in          string
+	kind        string
+	required    bool
+	description string
+}
+
+var (
+	gameIDParam      = parameter{name: "gameId", in: "path", kind: "string", required: true, description: "Game ID"}
+	playerIDParam    = parameter{name: "playerId", in: "path", kind: "string", required: true, description: "Player ID"}
+	inviteTokenParam = parameter{name: "token", in: "path", kind: "string", required: true, description: "Invite token from the invite link"}
+)
+
+// operations lists every endpoint registered by the HTTP router
+func operations() []operation {
+	return []operation{
+		{
+			method: http.MethodGet, path: "/health", tag: "health",
+			summary: "Service health check",
+			status:  http.StatusOK, response: map[string]string{},
+		},
+		{
+			method: http.MethodGet, path: "/build-info", tag: "health",
+			summary: "Git SHA and card data version of the running build",
+			status:  http.StatusOK, response: dto.BuildInfoResponse{},
+		},
+		{
+			method: http.MethodGet, path: "/capacity", tag: "health",
+			summary: "Running games against the instance's cap; game creation answers 503 with Retry-After at the cap",
+			status:  http.StatusOK, response: dto.CapacityResponse{},
+		},
+		{
+			method: http.MethodPost, path: "/games", tag: "games",
+			summary:     "Create a game",
+			requestBody: dto.CreateGameRequest{},
+			status:      http.StatusCreated, response: dto.CreateGameResponse{},
+		},
+		{
+			method: http.MethodGet, path: "/games", tag: "games",
+			summary: "List games",
+			parameters: []parameter{
+				{name: "status", in: "query", kind: "string", description: "Filter by game status (lobby, active, completed)"},
+				{name: "speed", in: "query", kind: "string", description: "Filter by speed preset (blitz, standard, relaxed)"},
+			},
+			status: http.StatusOK, response: dto.ListGamesResponse{},
+		},
+		{
+			method: http.MethodPost, path: "/games/demo/lobby", tag: "games",
+			summary:     "Create a demo lobby",
+			requestBody: dto.CreateDemoLobbyRequest{},
+			status:      http.StatusCreated, response: dto.CreateDemoLobbyResponse{},
+		},
+		{
+			method: http.MethodPost, path: "/games/demo/seeded", tag: "games",
+			summary:     "Create a demo game with bot seats, fast-forwarded to a later generation",
+			requestBody: dto.SeedDemoGameRequest{},
+			status:      http.StatusCreated, response: dto.SeedDemoGameResponse{},
+		},
+		{
+			method: http.MethodGet, path: "/games/{gameId}", tag: "games",
+			summary: "Get a game",
+			parameters: []parameter{
+				gameIDParam,
+				{name: "playerId", in: "query", kind: "string", description: "Viewing player; returns that player's private view"},
+			},
+			status: http.StatusOK, response: dto.GetGameResponse{},
+		},
+		{
+			method: http.MethodGet, path: "/games/{gameId}/summary", tag: "games",
+			summary: "Get cached read models of a game (scoreboard, board summary, tag counts, available actions)",
+			parameters: []parameter{
+				gameIDParam,
+				{name: "playerId", in: "query", kind: "string", description: "Viewing player; available actions are listed for this player"},
+			},
+			status: http.StatusOK, response: dto.GetGameSummaryResponse{},
+		},
+		{
+			method: http.MethodGet, path: "/games/{gameId}/logs", tag: "games",
+			summary: "Get the game state diff log",
+			parameters: []parameter{
+				gameIDParam,
+				{name: "since", in: "query", kind: "integer", description: "Only return diffs after this sequence number"},
+				{name: "playerId", in: "query", kind: "string", description: "Viewing player; hand changes of other players are omitted"},
+			},
+			status: http.StatusOK, response: []dto.StateDiffDto{},
+		},
+		{
+			method: http.MethodGet, path: "/games/{gameId}/log.json", tag: "games",
+			summary:    "Export a finished game's full human-readable log with its settings and final scores (409 until the game ends)",
+			parameters: []parameter{gameIDParam},
+			status:     http.StatusOK, response: dto.GameLogExportResponse{},
+		},
+		{
+			method: http.MethodGet, path: "/games/{gameId}/log.txt", tag: "games",
+			summary:    "Export a finished game's log as plain text, for sharing in chat or archiving (409 until the game ends)",
+			parameters: []parameter{gameIDParam},
+			status:     http.StatusOK, mediaType: "text/plain",
+		},
+		{
+			method: http.MethodGet, path: "/games/{gameId}/overlay", tag: "games",
+			summary:    "Get a compact, spectator-safe view of a game for stream overlays: players' TR, resources and production, generation, global parameters and the last action",
+			parameters: []parameter{gameIDParam},
+			status:     http.StatusOK, response: dto.GetGameOverlayResponse{},
+		},
+		{
+			method: http.MethodGet, path: "/games/{gameId}/overlay/stream", tag: "games",
+			summary:    "Server-sent events of the overlay: an \"overlay\" event carrying a GameOverlayDto right away and after every change, an \"action\" event carrying a RecentActionDto per new log entry, \"end\" once the game is over or goes away",
+			parameters: []parameter{gameIDParam},
+			status:     http.StatusOK, mediaType: "text/event-stream",
+		},
+		{
+			method: http.MethodPost, path: "/games/{gameId}/invites", tag: "games",
+			summary:     "Create a one-time invite link that reserves a named lobby seat (host only; 403 for other players, 409 once the lobby is full or started)",
+			parameters:  []parameter{gameIDParam},
+			requestBody: dto.CreateInviteRequest{},
+			status:      http.StatusCreated, response: dto.InviteResponse{},
+		},
+		{
+			method: http.MethodGet, path: "/games/{gameId}/invites", tag: "games",
+			summary: "List the lobby's open invites (host only)",
+			parameters: []parameter{
+				gameIDParam,
+				{name: "playerId", in: "query", kind: "string", required: true, description: "The host's player ID"},
+			},
+			status: http.StatusOK, response: dto.ListInvitesResponse{},
+		},
+		{
+			method: http.MethodGet, path: "/games/{gameId}/invites/{token}", tag: "games",
+			summary:    "Look up an open invite by its token, to show the reserved seat before joining (404 once claimed or revoked)",
+			parameters: []parameter{gameIDParam, inviteTokenParam},
+			status:     http.StatusOK, response: dto.InviteResponse{},
+		},
+		{
+			method: http.MethodDelete, path: "/games/{gameId}/invites/{token}", tag: "games",
+			summary: "Revoke an open invite, freeing its seat, and list the invites still open (host only)",
+			parameters: []parameter{
+				gameIDParam,
+				inviteTokenParam,
+				{name: "playerId", in: "query", kind: "string", required: true, description: "The host's player ID"},
+			},
+			status: http.StatusOK, response: dto.ListInvitesResponse{},
+		},
+		{
+			method: http.MethodGet, path: "/archive/games", tag: "archive",
+			summary: "List finished games moved to the archive, most recently finished first",
+			parameters: []parameter{
+				{name: "player", in: "query", kind: "string", description: "Only games with a player of this name (case-insensitive)"},
+				{name: "limit", in: "query", kind: "integer", description: "At most this many games (default all)"},
+			},
+			status: http.StatusOK, response: dto.ListArchivedGamesResponse{},
+		},
+		{
+			method: http.MethodGet, path: "/archive/games/{gameId}", tag: "archive",
+			summary:    "Get an archived game's settings, final scores and full log, in the same shape as log.json",
+			parameters: []parameter{gameIDParam},
+			status:     http.StatusOK, response: dto.GameLogExportResponse{},
+		},
+		{
+			method: http.MethodGet, path: "/archive/players/{playerName}/stats", tag: "archive",
+			summary: "Summarize a player's completed archived games, matched by name",
+			parameters: []parameter{
+				{name: "playerName", in: "path", kind: "string", required: true, description: "Player name (case-insensitive)"},
+			},
+			status: http.StatusOK, response: dto.ArchivedPlayerStatsResponse{},
+		},
+		{
+			method: http.MethodPost, path: "/players/{accountId}/key", tag: "players",
+			summary: "Register the key that proves ownership of an account; it is returned once (409 when the account already has one)",
+			parameters: []parameter{
+				{name: "accountId", in: "path", kind: "string", required: true, description: "Client-chosen account ID"},
+			},
+			status: http.StatusCreated, response: dto.AccountKeyResponse{},
+		},
+		{
+			method: http.MethodDelete, path: "/players/{accountId}", tag: "players",
+			summary: "Delete an account's personal data: its seats in archived games are renamed \"Deleted player\" and its puzzle completions removed; the deletion is audited under a hash of the account ID (401 without a key, 403 when it does not match)",
+			parameters: []parameter{
+				{name: "accountId", in: "path", kind: "string", required: true, description: "Client-chosen account ID"},
+				{name: "Authorization", in: "header", kind: "string", required: true, description: "Bearer <account key>"},
+			},
+			status: http.StatusOK, response: dto.DeleteAccountResponse{},
+		},
+		{
+			method: http.MethodGet, path: "/games/{gameId}/players/{playerId}", tag: "players",
+			summary:    "Get a player",
+			parameters: []parameter{gameIDParam, playerIDParam},
+			status:     http.StatusOK, response: dto.PlayerDto{},
+		},
+		{
+			method: http.MethodPost, path: "/games/{gameId}/players/{playerId}/bot-token", tag: "bots",
+			summary: "Issue a bot API token for a seated player; the token expires after 24h (401 without a key, 403 when the seat has no account or the key does not match)",
+			parameters: []parameter{
+				gameIDParam, playerIDParam,
+				{name: "Authorization", in: "header", kind: "string", required: true, description: "Bearer <account key> of the seat's account"},
+			},
+			status: http.StatusCreated, response: jsonrpc.IssueTokenResponse{},
+		},
+		{
+			method: http.MethodPost, path: "/games/{gameId}/players/{playerId}/preview-action", tag: "players",
+			summary:     "Dry-run a card play or card action and return the log entries it would write; nothing is committed",
+			parameters:  []parameter{gameIDParam, playerIDParam},
+			requestBody: dto.PreviewActionRequest{},
+			status:      http.StatusOK, response: dto.PreviewActionResponse{},
+		},
+		{
+			method: http.MethodPost, path: "/rpc", tag: "bots",
+			summary:     "Bot JSON-RPC 2.0 endpoint (observeState, submitAction, streamEvents, revokeToken); requires a Bearer bot token",
+			requestBody: jsonrpc.Request{},
+			status:      http.StatusOK, response: jsonrpc.Response{},
+		},
+		{
+			method: http.MethodGet, path: "/tutorials", tag: "tutorials",
+			summary: "List tutorial scenarios",
+			status:  http.StatusOK, response: dto.ListTutorialsResponse{},
+		},
+		{
+			method: http.MethodPost, path: "/tutorials/{scenarioId}/start", tag: "tutorials",
+			summary: "Start a tutorial game; progress is streamed as tutorial-progress messages",
+			parameters: []parameter{
+				{name: "scenarioId", in: "path", kind: "string", required: true, description: "Tutorial scenario ID"},
+			},
+			requestBody: dto.StartTutorialRequest{},
+			status:      http.StatusCreated, response: dto.StartTutorialResponse{},
+		},
+		{
+			method: http.MethodGet, path: "/puzzles", tag: "tutorials",
+			summary: "List puzzles",
+			parameters: []parameter{
+				{name: "accountId", in: "query", kind: "string", description: "Mark puzzles this account has solved"},
+			},
+			status: http.StatusOK, response: dto.ListPuzzlesResponse{},
+		},
+		{
+			method: http.MethodPost, path: "/puzzles/{puzzleId}/start", tag: "tutorials",
+			summary: "Start a puzzle game; the victory condition is reported in tutorial-progress messages",
+			parameters: []parameter{
+				{name: "puzzleId", in: "path", kind: "string", required: true, description: "Puzzle ID"},
+			},
+			requestBody: dto.StartTutorialRequest{},
+			status:      http.StatusCreated, response: dto.StartTutorialResponse{},
+		},
+		{
+			method: http.MethodGet, path: "/cards", tag: "cards",
+			summary: "List cards",
+			parameters: []parameter{
+				{name: "offset", in: "query", kind: "integer", description: "Pagination offset (default 0)"},
+				{name: "limit", in: "query", kind: "integer", description: "Page size (default 100)"},
+			},
+			status: http.StatusOK, response: dto.ListCardsResponse{},
+		},
+		{
+			method: http.MethodGet, path: "/cards/resolve", tag: "cards",
+			summary: "Find cards by a typed name, ID or initials, tolerating partial words and typos; best matches first",
+			parameters: []parameter{
+				{name: "q", in: "query", kind: "string", description: "What the player typed, e.g. \"art photo\""},
+				{name: "limit", in: "query", kind: "integer", description: "Most matches to return (default 5, at most 20)"},
+			},
+			status: http.StatusOK, response: dto.ResolveCardsResponse{},
+		},
+		{
+			method: http.MethodGet, path: "/cards/{cardId}/guide", tag: "cards",
+			summary: "How to play a corporation: its ability in plain words, strategy tags and tips",
+			parameters: []parameter{
+				{name: "cardId", in: "path", kind: "string", required: true, description: "Corporation card ID"},
+			},
+			status: http.StatusOK, response: dto.CardGuideResponse{},
+		},
+		{
+			method: http.MethodGet, path: "/cards/{cardId}/behaviors/explain", tag: "cards",
+			summary: "A card's behaviors as rules text clauses generated from the engine data, for tooltips",
+			parameters: []parameter{
+				{name: "cardId", in: "path", kind: "string", required: true, description: "Card ID"},
+			},
+			status: http.StatusOK, response: dto.CardBehaviorsExplainResponse{},
+		},
+		{
+			method: http.MethodGet, path: "/analytics/cards", tag: "cards",
+			summary: "Aggregate card pick rates and corporation win rates from games that opted into analytics",
+			status:  http.StatusOK, response: dto.CardAnalyticsResponse{},
+		},
+		{
+			method: http.MethodGet, path: "/action-catalog", tag: "protocol",
+			summary: "Describe WebSocket action requests, optionally filtered by phase",
+			parameters: []parameter{
+				{name: "phase", in: "query", kind: "string", description: "Only return actions valid in this game phase"},
+			},
+			status: http.StatusOK, response: dto.ActionCatalogResponse{},
+		},
+		{
+			method: http.MethodGet, path: "/ws-schema", tag: "protocol",
+			summary: "Describe all WebSocket message types and payload schemas",
+			status:  http.StatusOK, response: map[string]interface{}{},
+		},
+		{
+			method: http.MethodGet, path: "/admin/games", tag: "admin",
+			summary: "List games with estimated memory footprints (only when TM_ADMIN_ENABLED=true)",
+			status:  http.StatusOK, response: dto.AdminListGamesResponse{},
+		},
+		{
+			method: http.MethodGet, path: "/admin/games/{gameId}/logs", tag: "admin",
+			summary: "Server log lines recorded for one game, oldest first (only when TM_ADMIN_ENABLED=true)",
+			parameters: []parameter{
+				gameIDParam,
+				{name: "level", in: "query", kind: "string", description: "Lowest level to include: debug, info, warn or error (default debug)"},
+			},
+			status: http.StatusOK, response: dto.AdminGameLogsResponse{},
+		},
+		{
+			method: http.MethodGet, path: "/admin/games/{gameId}/audit", tag: "admin",
+			summary:    "Check a live game's card counts, ocean limit and resources against its game log (only when TM_ADMIN_ENABLED=true)",
+			parameters: []parameter{gameIDParam},
+			status:     http.StatusOK, response: dto.AdminGameAuditResponse{},
+		},
+		{
+			method: http.MethodGet, path: "/admin/games/{gameId}/state-at", tag: "admin",
+			summary: "A live game's logged state right after one log entry, rebuilt from the game log (only when TM_ADMIN_ENABLED=true)",
+			parameters: []parameter{
+				gameIDParam,
+				{name: "sequence", in: "query", kind: "integer", description: "Sequence number of the log entry to stop after"},
+			},
+			status: http.StatusOK, response: dto.AdminStateAtResponse{},
+		},
+		{
+			method: http.MethodGet, path: "/admin/games/{gameId}/awards", tag: "admin",
+			summary:    "Every award's current standings, next to any standings frozen at funding (only when TM_ADMIN_ENABLED=true)",
+			parameters: []parameter{gameIDParam},
+			status:     http.StatusOK, response: dto.AdminAwardsResponse{},
+		},
+		{
+			method: http.MethodGet, path: "/admin/collusion-flags", tag: "admin",
+			summary: "Flag same-IP seats and one-sided attack patterns in public games, for review only (only when TM_ADMIN_ENABLED=true)",
+			status:  http.StatusOK, response: dto.AdminCollusionFlagsResponse{},
+		},
+		{
+			method: http.MethodGet, path: "/admin/websocket", tag: "admin",
+			summary: "Compare outgoing WebSocket payload sizes by wire format and report send queue backpressure (only when TM_ADMIN_ENABLED=true)",
+			status:  http.StatusOK, response: dto.AdminWebSocketStatsResponse{},
+		},
+	}
+}
+
+// BuildOpenAPISpec generates the OpenAPI 3 document for the HTTP API
+// Admin commands are sent over the WebSocket (admin-command) and are not part of this document
+func BuildOpenAPISpec(basePath string) map[string]interface{} {
+	registry := NewSchemaRegistry("#/components/schemas/")
+
+	paths := make(map[string]map[string]interface{})
+	for _, op := range operations() {
+		content := jsonContent(registry.Ref(op.response))
+		if op.mediaType != "" {
+			content = stringContent(op.mediaType)
+		}
+		entry := map[string]interface{}{
+			"summary":     op.summary,
+			"tags":        []string{op.tag},
+			"operationId": operationID(op),
+			"responses": map[string]interface{}{
+				strconv.Itoa(op.status): map[string]interface{}{
+					"description": http.StatusText(op.status),
+					"content":     content,
+				},
+				"default": map[string]interface{}{
+					"description": "Error message",
+					"content":     textContent(),
+				},
+			},
+		}
+
+		if len(op.parameters) > 0 {
+			params := make([]map[string]interface{}, len(op.parameters))
+			for i, p := range op.parameters {
+				params[i] = map[string]interface{}{
+					"name":        p.name,
+					"in":          p.in,
+					"required":    p.required,
+					"description": p.description,
+					"schema":      Schema{"type": p.kind},
+				}
+			}
+			entry["parameters"] = params
+		}
+
+		if op.requestBody != nil {
+			entry["requestBody"] = map[string]interface{}{
+				"required": true,
+				"content":  jsonContent(registry.Ref(op.requestBody)),
+			}
+		}
+
+		if paths[op.path] == nil {
+			paths[op.path] = make(map[string]interface{})
+		}
+		paths[op.path][strings.ToLower(op.method)] = entry
+	}
+
+	return map[string]interface{}{
+		"openapi": "3.0.3",
+		"info": map[string]interface{}{
+			"title":       "Terraforming Mars API",
+			"version":     APIVersion,
+			"description": "HTTP API for games, players and cards. Game actions are sent over the WebSocket at /ws.",
+		},
+		"servers": []map[string]string{{"url": basePath}},
+		"paths":   paths,
+		"components": map[string]interface{}{
+			"schemas": registry.Components(),
+		},
+	}
+}
+
+func jsonContent(schema Schema) map[string]interface{} {
+	return map[string]interface{}{
+		"application/json": map[string]interface{}{"schema": schema},
+	}
+}
+
+func textContent() map[string]interface{} {
+	return stringContent("text/plain")
+}
+
+func stringContent(mediaType string) map[string]interface{} {
+	return map[string]interface{}{
+		mediaType: map[string]interface{}{"schema": Schema{"type": "string"}},
+	}
+}
+
+func operationID(op operation) string {
+	var b strings.Builder
+	b.WriteString(strings.ToLower(op.method))
+	for _, segment := range strings.Split(op.path, "/") {
+		segment = strings.Trim(segment, "{}")
+		for _, part := range strings.FieldsFunc(segment, func(r rune) bool { return r == '-' || r == '.' }) {
+			b.WriteString(strings.ToUpper(part[:1]) + part[1:])
+		}
+	}
+	return b.String()
+}
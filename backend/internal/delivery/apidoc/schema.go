@@ -0,0 +1,144 @@
+package apidoc
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"time"
+)
+
+// Schema is a JSON Schema object as used by OpenAPI and AsyncAPI documents
+type Schema map[string]interface{}
+
+// SchemaRegistry derives JSON schemas from DTO structs via reflection
+// Named struct types are registered once and referenced with $ref
+type SchemaRegistry struct {
+	refPrefix  string
+	components map[string]Schema
+}
+
+// NewSchemaRegistry creates a registry whose references point at refPrefix (e.g. "#/components/schemas/")
+func NewSchemaRegistry(refPrefix string) *SchemaRegistry {
+	return &SchemaRegistry{
+		refPrefix:  refPrefix,
+		components: make(map[string]Schema),
+	}
+}
+
+// Components returns all named schemas registered so far
+func (r *SchemaRegistry) Components() map[string]Schema {
+	return r.components
+}
+
+// Ref registers the type of v and returns a schema referencing it
+func (r *SchemaRegistry) Ref(v interface{}) Schema {
+	return r.schemaFor(reflect.TypeOf(v))
+}
+
+// Inline returns the schema of struct v without registering it as a named component
+func (r *SchemaRegistry) Inline(v interface{}) Schema {
+	return r.structSchema(reflect.TypeOf(v))
+}
+
+var (
+	timeType       = reflect.TypeOf(time.Time{})
+	rawMessageType = reflect.TypeOf(json.RawMessage{})
+)
+
+func (r *SchemaRegistry) schemaFor(t reflect.Type) Schema {
+	if t == nil {
+		return Schema{}
+	}
+
+	if t.Kind() == reflect.Ptr {
+		return r.schemaFor(t.Elem())
+	}
+
+	if t == timeType {
+		return Schema{"type": "string", "format": "date-time"}
+	}
+	if t == rawMessageType {
+		return Schema{}
+	}
+
+	switch t.Kind() {
+	case reflect.String:
+		return Schema{"type": "string"}
+	case reflect.Bool:
+		return Schema{"type": "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return Schema{"type": "integer"}
+	case reflect.Float32, reflect.Float64:
+		return Schema{"type": "number"}
+	case reflect.Slice, reflect.Array:
+		return Schema{"type": "array", "items": r.schemaFor(t.Elem())}
+	case reflect.Map:
+		return Schema{"type": "object", "additionalProperties": r.schemaFor(t.Elem())}
+	case reflect.Interface:
+		return Schema{}
+	case reflect.Struct:
+		if t.Name() == "" {
+			return r.structSchema(t)
+		}
+		if _, exists := r.components[t.Name()]; !exists {
+			// Reserve the name first so self-referencing types terminate
+			r.components[t.Name()] = Schema{}
+			r.components[t.Name()] = r.structSchema(t)
+		}
+		return Schema{"$ref": r.refPrefix + t.Name()}
+	default:
+		return Schema{}
+	}
+}
+
+func (r *SchemaRegistry) structSchema(t reflect.Type) Schema {
+	properties := make(map[string]interface{})
+	required := make([]string, 0)
+	r.collectFields(t, properties, &required)
+
+	schema := Schema{
+		"type":       "object",
+		"properties": properties,
+	}
+	if len(required) > 0 {
+		schema["required"] = required
+	}
+	return schema
+}
+
+func (r *SchemaRegistry) collectFields(t reflect.Type, properties map[string]interface{}, required *[]string) {
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+
+		tag := field.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+
+		name, opts, _ := strings.Cut(tag, ",")
+		if field.Anonymous && name == "" {
+			embedded := field.Type
+			if embedded.Kind() == reflect.Ptr {
+				embedded = embedded.Elem()
+			}
+			if embedded.Kind() == reflect.Struct {
+				r.collectFields(embedded, properties, required)
+				continue
+			}
+		}
+		if name == "" {
+			name = field.Name
+		}
+
+		properties[name] = r.schemaFor(field.Type)
+
+		optional := strings.Contains(opts, "omitempty") || field.Type.Kind() == reflect.Ptr
+		if !optional {
+			*required = append(*required, name)
+		}
+	}
+}
@@ -0,0 +1,258 @@
+package apidoc
+
+import (
+	"terraforming-mars-backend/internal/delivery/dto"
+)
+
+// Message directions
+const (
+	DirectionClientToServer = "client-to-server"
+	DirectionServerToClient = "server-to-client"
+)
+
+// WSMessageDoc describes a single WebSocket message type
+type WSMessageDoc struct {
+	Type        dto.MessageType `json:"type"`
+	Direction   string          `json:"direction"`
+	Description string          `json:"description"`
+	Payload     Schema          `json:"payload"`
+}
+
+// WSSchemaDocument is the machine-readable catalog of the WebSocket protocol
+type WSSchemaDocument struct {
+	ProtocolVersion string            `json:"protocolVersion"`
+	Endpoint        string            `json:"endpoint"`
+	Envelope        Schema            `json:"envelope"`
+	Messages        []WSMessageDoc    `json:"messages"`
+	Schemas         map[string]Schema `json:"schemas"`
+}
+
+// BuildWSSchema generates the WebSocket message catalog from the DTO definitions
+func BuildWSSchema() WSSchemaDocument {
+	registry := NewSchemaRegistry("#/schemas/")
+
+	messages := []WSMessageDoc{
+		// Connection and lobby
+		{
+			Type: dto.MessageTypeHello, Direction: DirectionClientToServer,
+			Description: "Agree on the encoding and compression of server frames; send before anything else",
+			Payload:     registry.Ref(dto.HelloPayload{}),
+		},
+		{
+			Type: dto.MessageTypeCreateGame, Direction: DirectionClientToServer,
+			Description: "Create a game",
+			Payload:     registry.Ref(dto.CreateGameRequest{}),
+		},
+		{
+			Type: dto.MessageTypePlayerConnect, Direction: DirectionClientToServer,
+			Description: "Join or rejoin a game",
+			Payload:     registry.Ref(dto.PlayerConnectPayload{}),
+		},
+		{
+			Type: dto.MessageTypeJoinGame, Direction: DirectionClientToServer,
+			Description: "Alias of player-connect",
+			Payload:     registry.Ref(dto.PlayerConnectPayload{}),
+		},
+		{
+			Type: dto.MessageTypePlayerTakeover, Direction: DirectionClientToServer,
+			Description: "Take over a disconnected player's seat",
+			Payload:     registry.Ref(dto.PlayerTakeoverPayload{}),
+		},
+		{
+			Type: dto.MessageTypeKickPlayer, Direction: DirectionClientToServer,
+			Description: "Remove a player from the lobby (host only)",
+			Payload:     objectSchema(map[string]string{"targetPlayerId": "string"}, "targetPlayerId"),
+		},
+		{
+			Type: dto.MessageTypeControlPlayer, Direction: DirectionClientToServer,
+			Description: "Select which joined seat subsequent actions are for (hotseat)",
+			Payload:     objectSchema(map[string]string{"playerId": "string"}, "playerId"),
+		},
+		{
+			Type: dto.MessageTypeSyncRequest, Direction: DirectionClientToServer,
+			Description: "Catch up after a reconnect: changes since the last game state received, plus newer log entries",
+			Payload:     registry.Ref(dto.SyncRequestPayload{}),
+		},
+		{
+			Type: dto.MessageTypeSubscribe, Direction: DirectionClientToServer,
+			Description: "Receive only the listed state slices as state-slices instead of game-updated; an empty list restores the full game. A connection without a seat sends gameId and gets public slices only",
+			Payload:     registry.Ref(dto.SubscribePayload{}),
+		},
+		{
+			Type: dto.MessageTypeAdminCommand, Direction: DirectionClientToServer,
+			Description: "Run an admin command (development mode only)",
+			Payload:     registry.Ref(dto.AdminCommandRequest{}),
+		},
+
+		// Server notifications
+		{
+			Type: dto.MessageTypeGameUpdated, Direction: DirectionServerToClient,
+			Description: "Personalized game state after any change",
+			Payload:     registry.Ref(dto.GameUpdatedPayload{}),
+		},
+		{
+			Type: dto.MessageTypePlayerConnected, Direction: DirectionServerToClient,
+			Description: "Sent to a player after joining or taking over a seat",
+			Payload:     registry.Ref(dto.PlayerConnectedPayload{}),
+		},
+		{
+			Type: dto.MessageTypePlayerReconnected, Direction: DirectionServerToClient,
+			Description: "Sent to a player after reconnecting",
+			Payload:     registry.Ref(dto.PlayerReconnectedPayload{}),
+		},
+		{
+			Type: dto.MessageTypeHelloAck, Direction: DirectionServerToClient,
+			Description: "Wire format used for every frame after this one; msgpack frames are binary",
+			Payload:     registry.Ref(dto.HelloAckPayload{}),
+		},
+		{
+			Type: dto.MessageTypeSyncResponse, Direction: DirectionServerToClient,
+			Description: "Patch from the requested sequence to the current game state, or the full state when that sequence is no longer known",
+			Payload:     registry.Ref(dto.SyncResponsePayload{}),
+		},
+		{
+			Type: dto.MessageTypeStateSlices, Direction: DirectionServerToClient,
+			Description: "The subscribed state slices after any change, for connections that sent subscribe",
+			Payload:     registry.Ref(dto.StateSlicesPayload{}),
+		},
+		{
+			Type: dto.MessageTypePlayerDisconnected, Direction: DirectionServerToClient,
+			Description: "A player's connection closed",
+			Payload:     registry.Ref(dto.PlayerDisconnectedPayload{}),
+		},
+		{
+			Type: dto.MessageTypePlayerKicked, Direction: DirectionServerToClient,
+			Description: "Sent to a player removed from the lobby",
+			Payload:     registry.Ref(dto.PlayerKickedPayload{}),
+		},
+		{
+			Type: dto.MessageTypeFullState, Direction: DirectionServerToClient,
+			Description: "Complete game state for a player",
+			Payload:     registry.Ref(dto.FullStatePayload{}),
+		},
+		{
+			Type: dto.MessageTypeProductionPhaseStarted, Direction: DirectionServerToClient,
+			Description: "Production phase began",
+			Payload:     registry.Ref(dto.ProductionPhaseStartedPayload{}),
+		},
+		{
+			Type: dto.MessageTypeGenerationSummary, Direction: DirectionServerToClient,
+			Description: "Per-player recap of the generation that just produced: income, energy converted, cards bought and TR change",
+			Payload:     registry.Ref(dto.GenerationSummaryPayload{}),
+		},
+		{
+			Type: dto.MessageTypeLogUpdate, Direction: DirectionServerToClient,
+			Description: "New game log entries",
+			Payload:     registry.Ref(dto.LogUpdatePayload{}),
+		},
+		{
+			Type: dto.MessageTypeTutorialProgress, Direction: DirectionServerToClient,
+			Description: "Current objective of a tutorial game, sent to the tutorial player after each update",
+			Payload:     registry.Ref(dto.TutorialProgressPayload{}),
+		},
+		{
+			Type: dto.MessageTypeCardPlayPrepared, Direction: DirectionServerToClient,
+			Description: "Cost breakdown, choices and pending placements of a card reserved with prepare-play-card",
+			Payload:     registry.Ref(dto.CardPlayPreparedPayload{}),
+		},
+		{
+			Type: dto.MessageTypeConfirmPassWithConvertibles, Direction: DirectionServerToClient,
+			Description: "Reply to skip-action when a pass would leave plants or heat unconverted; resend with confirmed or convert-all first",
+			Payload:     registry.Ref(dto.ConfirmPassWithConvertiblesPayload{}),
+		},
+		{
+			Type: dto.MessageTypeGameCreated, Direction: DirectionServerToClient,
+			Description: "Reply to create-game; join the new game with player-connect",
+			Payload:     registry.Ref(dto.GameCreatedPayload{}),
+		},
+		{
+			Type: dto.MessageTypeReaction, Direction: DirectionServerToClient,
+			Description: "A player sent a reaction; not sent to players who muted the sender",
+			Payload:     registry.Ref(dto.ReactionPayload{}),
+		},
+		{
+			Type: dto.MessageTypeActionSuccess, Direction: DirectionServerToClient,
+			Description: "Sent to the acting player once an action is applied; the new state arrives as game-updated",
+			Payload:     registry.Ref(dto.ActionSuccessPayload{}),
+		},
+		{
+			Type: dto.MessageTypeBatchResult, Direction: DirectionServerToClient,
+			Description: "Answers run-batch with each step's outcome; when a step failed, no step of the batch was kept",
+			Payload:     registry.Ref(dto.BatchResultPayload{}),
+		},
+		{
+			Type: dto.MessageTypeError, Direction: DirectionServerToClient,
+			Description: "A request failed",
+			Payload:     registry.Ref(dto.ErrorPayload{}),
+		},
+	}
+
+	// Game actions share their payload descriptions with the action catalog
+	for _, entry := range dto.ActionCatalog() {
+		messages = append(messages, WSMessageDoc{
+			Type:        entry.Type,
+			Direction:   DirectionClientToServer,
+			Description: entry.Description,
+			Payload:     catalogPayloadSchema(registry, entry),
+		})
+	}
+
+	return WSSchemaDocument{
+		ProtocolVersion: dto.ProtocolVersion,
+		Endpoint:        "/ws",
+		Envelope:        registry.Inline(dto.WebSocketMessage{}),
+		Messages:        messages,
+		Schemas:         registry.Components(),
+	}
+}
+
+// catalogPayloadSchema builds an object schema from an action catalog entry
+// Fields typed with a DTO name reference the DTO's schema
+func catalogPayloadSchema(registry *SchemaRegistry, entry dto.ActionCatalogEntryDto) Schema {
+	properties := make(map[string]interface{})
+	required := make([]string, 0)
+	for _, field := range entry.Fields {
+		fieldSchema := fieldTypeSchema(registry, field.Type)
+		fieldSchema["description"] = field.Description
+		properties[field.Name] = fieldSchema
+		if field.Required {
+			required = append(required, field.Name)
+		}
+	}
+
+	schema := Schema{"type": "object", "properties": properties}
+	if len(required) > 0 {
+		schema["required"] = required
+	}
+	return schema
+}
+
+// catalogFieldDtos maps DTO type names used in the action catalog to their structs
+var catalogFieldDtos = map[string]interface{}{
+	"ResourcesDto":        dto.ResourcesDto{},
+	"ProductionDto":       dto.ProductionDto{},
+	"GlobalParametersDto": dto.GlobalParametersDto{},
+	"CardPaymentDto":      dto.CardPaymentDto{},
+}
+
+func fieldTypeSchema(registry *SchemaRegistry, fieldType string) Schema {
+	switch fieldType {
+	case "string", "boolean", "number":
+		return Schema{"type": fieldType}
+	case "string[]":
+		return Schema{"type": "array", "items": Schema{"type": "string"}}
+	}
+	if value, ok := catalogFieldDtos[fieldType]; ok {
+		// Wrap the $ref so the description can sit alongside it
+		return Schema{"allOf": []Schema{registry.Ref(value)}}
+	}
+	return Schema{}
+}
+
+func objectSchema(fields map[string]string, required ...string) Schema {
+	properties := make(map[string]interface{}, len(fields))
+	for name, kind := range fields {
+		properties[name] = Schema{"type": kind}
+	}
+	return Schema{"type": "object", "properties": properties, "required": required}
+}
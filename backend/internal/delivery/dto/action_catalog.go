@@ -0,0 +1,402 @@
+package dto
+
+// ActionCatalogFieldDto describes a single field of an action request payload
+type ActionCatalogFieldDto struct {
+	Name        string `json:"name" ts:"string"`
+	Type        string `json:"type" ts:"string"`
+	Required    bool   `json:"required" ts:"boolean"`
+	Constraints string `json:"constraints,omitempty" ts:"string | undefined"`
+	Description string `json:"description" ts:"string"`
+}
+
+// ActionCatalogEntryDto describes a WebSocket action request and the phases it is valid in
+type ActionCatalogEntryDto struct {
+	Type           MessageType             `json:"type" ts:"MessageType"`
+	Description    string                  `json:"description" ts:"string"`
+	Phases         []GamePhase             `json:"phases" ts:"GamePhase[]"`
+	Fields         []ActionCatalogFieldDto `json:"fields" ts:"ActionCatalogFieldDto[]"`
+	ExamplePayload map[string]interface{}  `json:"examplePayload" ts:"Record<string, any>"`
+}
+
+// ActionCatalogResponse represents the response for the action catalog endpoint
+type ActionCatalogResponse struct {
+	Phase   *GamePhase              `json:"phase,omitempty" ts:"GamePhase | undefined"`
+	Actions []ActionCatalogEntryDto `json:"actions" ts:"ActionCatalogEntryDto[]"`
+}
+
+// IsValidGamePhase reports whether phase is a known game phase
+func IsValidGamePhase(phase GamePhase) bool {
+	switch phase {
+	case GamePhaseWaitingForGameStart, GamePhaseStartingCardSelection, GamePhaseStartGameSelection,
+		GamePhaseDemoSetup, GamePhaseAction, GamePhaseProductionAndCardDraw, GamePhaseComplete:
+		return true
+	}
+	return false
+}
+
+// ActionCatalog returns descriptions of every client action request type
+func ActionCatalog() []ActionCatalogEntryDto {
+	return actionCatalogWithPhases()
+}
+
+// ActionCatalogForPhase returns the action request types that are valid in the given phase
+func ActionCatalogForPhase(phase GamePhase) []ActionCatalogEntryDto {
+	entries := make([]ActionCatalogEntryDto, 0)
+	for _, entry := range actionCatalogWithPhases() {
+		for _, p := range entry.Phases {
+			if p == phase {
+				entries = append(entries, entry)
+				break
+			}
+		}
+	}
+	return entries
+}
+
+var hexPositionField = ActionCatalogFieldDto{
+	Name:        "hex",
+	Type:        "string",
+	Required:    true,
+	Constraints: "\"q,r,s\" cube coordinates, a tile label such as \"E5\", or an area name; must be one of the pending tile selection's available hexes",
+	Description: "Hex the pending tile is placed on",
+}
+
+// actionCatalogWithPhases fills in each entry's phases from the action phase matrix
+func actionCatalogWithPhases() []ActionCatalogEntryDto {
+	entries := actionCatalog()
+	for i := range entries {
+		entries[i].Phases, _ = AllowedPhases(entries[i].Type)
+	}
+	return entries
+}
+
+// actionCatalog builds the catalog fresh on each call so callers may mutate the result
+func actionCatalog() []ActionCatalogEntryDto {
+	return []ActionCatalogEntryDto{
+		// Game management
+		{
+			Type:           MessageTypeActionStartGame,
+			Description:    "Start the game (host only)",
+			Fields:         []ActionCatalogFieldDto{},
+			ExamplePayload: map[string]interface{}{},
+		},
+		{
+			Type:        MessageTypeActionSetSeatOrder,
+			Description: "Arrange lobby seating or request random seats (host only)",
+			Fields: []ActionCatalogFieldDto{
+				{Name: "seatOrder", Type: "string[]", Required: false, Constraints: "permutation of all player IDs in the lobby", Description: "Player IDs in seat order"},
+				{Name: "randomize", Type: "boolean", Required: true, Description: "Shuffle seats at game start instead of using seatOrder"},
+			},
+			ExamplePayload: map[string]interface{}{"seatOrder": []string{"player-2", "player-1"}, "randomize": false},
+		},
+		{
+			Type:        MessageTypeActionSetHandicap,
+			Description: "Set a per-seat starting bonus; all zeros clears it (host only)",
+			Fields: []ActionCatalogFieldDto{
+				{Name: "playerId", Type: "string", Required: true, Description: "Player receiving the handicap"},
+				{Name: "extraCredits", Type: "number", Required: true, Constraints: "0-20", Description: "Extra starting MC"},
+				{Name: "extraCards", Type: "number", Required: true, Constraints: "0-5", Description: "Extra cards in the starting selection"},
+				{Name: "extraTR", Type: "number", Required: true, Constraints: "0-5", Description: "Extra starting terraform rating"},
+			},
+			ExamplePayload: map[string]interface{}{"playerId": "player-2", "extraCredits": 10, "extraCards": 2, "extraTR": 1},
+		},
+		{
+			Type:        MessageTypeActionSetPlayerColor,
+			Description: "Override a player's color with a free color from the game's palette (host only)",
+			Fields: []ActionCatalogFieldDto{
+				{Name: "playerId", Type: "string", Required: true, Description: "Player whose color changes"},
+				{Name: "color", Type: "string", Required: true, Constraints: "\"#RRGGBB\" from settings.colorPalette, not held by another player", Description: "New player color"},
+			},
+			ExamplePayload: map[string]interface{}{"playerId": "player-2", "color": "#56B4E9"},
+		},
+		{
+			Type:        MessageTypeActionSetPreferences,
+			Description: "Set the sending player's own preferences for this game",
+			Fields: []ActionCatalogFieldDto{
+				{Name: "autoPass", Type: "boolean", Required: true, Description: "Pass automatically when a turn starts with no legal action (selling patents does not count)"},
+				{Name: "locale", Type: "string", Required: false, Description: "Language of server error messages and notifications: en, de or sv (regional variants like de-AT are accepted); omit to keep the current one"},
+			},
+			ExamplePayload: map[string]interface{}{"autoPass": true},
+		},
+		{
+			Type:        MessageTypeActionSetNote,
+			Description: "Save the sending player's private note for this game; only that player ever sees it",
+			Fields: []ActionCatalogFieldDto{
+				{Name: "note", Type: "string", Required: true, Constraints: "at most 2000 characters", Description: "Note text; empty clears the note"},
+			},
+			ExamplePayload: map[string]interface{}{"note": "plan: rush Tharsis milestone next gen"},
+		},
+		{
+			Type:        MessageTypeActionRunBatch,
+			Description: "Run several of the sending player's actions in order as one unit; if any step fails, none of them is kept. Answered with batch-result",
+			Fields: []ActionCatalogFieldDto{
+				{Name: "steps", Type: "object[]", Required: true, Constraints: "1 to 10 of {type, payload}; type is play-card, card-action, a standard project other than sell-patents, a resource conversion, tile-selected, claim-milestone, fund-award or skip-action", Description: "Actions to run, each with the payload it would be sent with on its own"},
+			},
+			ExamplePayload: map[string]interface{}{"steps": []interface{}{
+				map[string]interface{}{"type": "action.standard-project.build-city"},
+				map[string]interface{}{"type": "action.tile-selection.tile-selected", "payload": map[string]interface{}{"hex": "0,0,0"}},
+			}},
+		},
+		{
+			Type:        MessageTypeActionSendReaction,
+			Description: "Send a canned reaction to the table; it is recorded in the game log. At most 3 reactions per 10 seconds",
+			Fields: []ActionCatalogFieldDto{
+				{Name: "reaction", Type: "string", Required: true, Constraints: "thumbs-up, nice-move, well-played, wow, thinking, oops or frowny-asteroid", Description: "Reaction to send"},
+			},
+			ExamplePayload: map[string]interface{}{"reaction": "nice-move"},
+		},
+		{
+			Type:        MessageTypeActionMuteReactions,
+			Description: "Hide or show another player's reactions for the sending player, or everyone's",
+			Fields: []ActionCatalogFieldDto{
+				{Name: "targetPlayerId", Type: "string", Required: false, Description: "Player to mute or unmute; omit for every player"},
+				{Name: "muted", Type: "boolean", Required: true, Description: "Whether the reactions are hidden"},
+			},
+			ExamplePayload: map[string]interface{}{"targetPlayerId": "player-2", "muted": true},
+		},
+		{
+			Type:        MessageTypeActionForceAdvancePhase,
+			Description: "Host only: end the production phase now; players who have not confirmed their card purchase buy no cards",
+			Fields: []ActionCatalogFieldDto{
+				{Name: "confirmed", Type: "boolean", Required: true, Constraints: "must be true", Description: "Confirms that waiting players lose their purchase"},
+			},
+			ExamplePayload: map[string]interface{}{"confirmed": true},
+		},
+		{
+			Type:           MessageTypeActionPauseGame,
+			Description:    "Pause the game; the host pauses at once, other players vote and the game pauses when every connected player agrees",
+			Fields:         []ActionCatalogFieldDto{},
+			ExamplePayload: map[string]interface{}{},
+		},
+		{
+			Type:           MessageTypeActionResumeGame,
+			Description:    "Resume a paused game; the host resumes at once, other players vote and the game resumes when every connected player agrees",
+			Fields:         []ActionCatalogFieldDto{},
+			ExamplePayload: map[string]interface{}{},
+		},
+		{
+			Type:        MessageTypeActionConfirmDemoSetup,
+			Description: "Confirm corporation, cards, resources and production for a demo game",
+			Fields: []ActionCatalogFieldDto{
+				{Name: "corporationId", Type: "string", Required: false, Description: "Corporation to start with"},
+				{Name: "cardIds", Type: "string[]", Required: true, Description: "Project cards to start with in hand"},
+				{Name: "resources", Type: "ResourcesDto", Required: true, Description: "Starting resources"},
+				{Name: "production", Type: "ProductionDto", Required: true, Description: "Starting production"},
+				{Name: "terraformRating", Type: "number", Required: true, Description: "Starting terraform rating"},
+				{Name: "globalParameters", Type: "GlobalParametersDto", Required: false, Constraints: "host only", Description: "Starting global parameters"},
+				{Name: "generation", Type: "number", Required: false, Constraints: "host only", Description: "Starting generation"},
+			},
+			ExamplePayload: map[string]interface{}{"cardIds": []string{}, "resources": map[string]int{"credits": 42}, "production": map[string]int{"credits": 1}, "terraformRating": 20},
+		},
+		{
+			Type:           MessageTypeActionConcede,
+			Description:    "Leave the game; solo games are abandoned, two-player games end with the opponent winning, larger games continue with the conceding player's production dealt out around the table",
+			Fields:         []ActionCatalogFieldDto{},
+			ExamplePayload: map[string]interface{}{},
+		},
+		{
+			Type:           MessageTypeActionVoteAbandon,
+			Description:    "Vote to abandon the game; it ends with no winner once more than half of the players have voted",
+			Fields:         []ActionCatalogFieldDto{},
+			ExamplePayload: map[string]interface{}{},
+		},
+		{
+			Type:        MessageTypeActionSkipAction,
+			Description: "Pass or end the current turn; a pass with useful plants or heat left is answered with confirm-pass-with-convertibles",
+			Fields: []ActionCatalogFieldDto{
+				{Name: "confirmed", Type: "boolean", Required: false, Description: "Pass even though plants or heat could still be converted"},
+			},
+			ExamplePayload: map[string]interface{}{},
+		},
+
+		// Starting selection and production
+		{
+			Type:        MessageTypeActionSelectStartingCard,
+			Description: "Select a corporation and the starting project cards to buy",
+			Fields: []ActionCatalogFieldDto{
+				{Name: "cardIds", Type: "string[]", Required: true, Constraints: "subset of the dealt starting cards; 3 MC each", Description: "Project cards to keep"},
+				{Name: "corporationId", Type: "string", Required: true, Constraints: "one of the dealt corporations", Description: "Corporation to play"},
+				{Name: "preludeIds", Type: "string[]", Constraints: "exactly 2 of the dealt preludes when the prelude pack is enabled", Description: "Preludes to keep"},
+			},
+			ExamplePayload: map[string]interface{}{"cardIds": []string{"card-id"}, "corporationId": "corporation-id"},
+		},
+		{
+			Type:           MessageTypeActionMulliganStartingHand,
+			Description:    "Redraw the dealt starting project cards once (mulligan house rule)",
+			ExamplePayload: map[string]interface{}{},
+		},
+		{
+			Type:        MessageTypeActionConfirmProductionCards,
+			Description: "Buy cards drawn during the production phase",
+			Fields: []ActionCatalogFieldDto{
+				{Name: "cardIds", Type: "string[]", Required: true, Constraints: "subset of the drawn cards; 3 MC each", Description: "Cards to buy"},
+			},
+			ExamplePayload: map[string]interface{}{"cardIds": []string{"card-id"}},
+		},
+
+		// Standard projects
+		{
+			Type:           MessageTypeActionSellPatents,
+			Description:    "Start selling patents (choose cards with confirm-sell-patents)",
+			Fields:         []ActionCatalogFieldDto{},
+			ExamplePayload: map[string]interface{}{},
+		},
+		{
+			Type:        MessageTypeActionConfirmSellPatents,
+			Description: "Sell the selected cards for 1 MC each",
+			Fields: []ActionCatalogFieldDto{
+				{Name: "selectedCardIds", Type: "string[]", Required: true, Constraints: "cards in hand", Description: "Cards to sell"},
+			},
+			ExamplePayload: map[string]interface{}{"selectedCardIds": []string{"card-id"}},
+		},
+		{
+			Type:           MessageTypeActionLaunchAsteroid,
+			Description:    "Standard project: raise temperature for 14 MC",
+			Fields:         []ActionCatalogFieldDto{},
+			ExamplePayload: map[string]interface{}{},
+		},
+		{
+			Type:           MessageTypeActionBuildPowerPlant,
+			Description:    "Standard project: increase energy production for 11 MC",
+			Fields:         []ActionCatalogFieldDto{},
+			ExamplePayload: map[string]interface{}{},
+		},
+		{
+			Type:           MessageTypeActionBuildAquifer,
+			Description:    "Standard project: place an ocean for 18 MC (hex chosen with tile-selected)",
+			Fields:         []ActionCatalogFieldDto{},
+			ExamplePayload: map[string]interface{}{},
+		},
+		{
+			Type:           MessageTypeActionPlantGreenery,
+			Description:    "Standard project: place a greenery for 23 MC (hex chosen with tile-selected)",
+			Fields:         []ActionCatalogFieldDto{},
+			ExamplePayload: map[string]interface{}{},
+		},
+		{
+			Type:           MessageTypeActionBuildCity,
+			Description:    "Standard project: place a city for 25 MC (hex chosen with tile-selected)",
+			Fields:         []ActionCatalogFieldDto{},
+			ExamplePayload: map[string]interface{}{},
+		},
+
+		// Resource conversion
+		{
+			Type:           MessageTypeActionConvertPlantsToGreenery,
+			Description:    "Convert plants into a greenery tile (hex chosen with tile-selected)",
+			Fields:         []ActionCatalogFieldDto{},
+			ExamplePayload: map[string]interface{}{},
+		},
+		{
+			Type:           MessageTypeActionConvertHeatToTemperature,
+			Description:    "Convert heat into a temperature step",
+			Fields:         []ActionCatalogFieldDto{},
+			ExamplePayload: map[string]interface{}{},
+		},
+		{
+			Type:           MessageTypeActionConvertAll,
+			Description:    "Spend all useful heat on temperature steps and queue a greenery for each affordable batch of plants, as one action",
+			Fields:         []ActionCatalogFieldDto{},
+			ExamplePayload: map[string]interface{}{},
+		},
+
+		// Milestones and awards
+		{
+			Type:        MessageTypeActionClaimMilestone,
+			Description: "Claim a milestone",
+			Fields: []ActionCatalogFieldDto{
+				{Name: "milestoneType", Type: "string", Required: true, Description: "Milestone to claim"},
+			},
+			ExamplePayload: map[string]interface{}{"milestoneType": "terraformer"},
+		},
+		{
+			Type:        MessageTypeActionFundAward,
+			Description: "Fund an award",
+			Fields: []ActionCatalogFieldDto{
+				{Name: "awardType", Type: "string", Required: true, Description: "Award to fund"},
+			},
+			ExamplePayload: map[string]interface{}{"awardType": "landlord"},
+		},
+
+		// Tiles
+		{
+			Type:           MessageTypeActionTileSelected,
+			Description:    "Place the pending tile on a hex",
+			Fields:         []ActionCatalogFieldDto{hexPositionField},
+			ExamplePayload: map[string]interface{}{"hex": "0,0,0"},
+		},
+
+		// Cards
+		{
+			Type:        MessageTypeActionPlayCard,
+			Description: "Play a project card from hand",
+			Fields: []ActionCatalogFieldDto{
+				{Name: "cardId", Type: "string", Required: true, Constraints: "card in hand", Description: "Card to play"},
+				{Name: "payment", Type: "CardPaymentDto", Required: false, Constraints: "must cover the card cost", Description: "Payment breakdown (credits, steel, titanium, substitutes)"},
+				{Name: "choiceIndex", Type: "number", Required: false, Description: "Index of the chosen behavior for cards with choices"},
+				{Name: "cardStorageTarget", Type: "string", Required: false, Description: "Card receiving resources for outputs targeting any card"},
+				{Name: "targetPlayerId", Type: "string", Required: false, Description: "Player targeted by attacks"},
+				{Name: "attackAmount", Type: "number", Required: false, Constraints: "0 to the card's amount", Description: "How many resources \"up to\" removals take; omit for the full amount"},
+				{Name: "discardCardIds", Type: "string[]", Required: false, Constraints: "exactly the card's discard count, from hand", Description: "Cards discarded to pay card-discard inputs"},
+			},
+			ExamplePayload: map[string]interface{}{"cardId": "card-id", "payment": map[string]int{"credits": 10, "steel": 0, "titanium": 0}},
+		},
+		{
+			Type:           MessageTypeActionPreparePlayCard,
+			Description:    "Reserve a card in hand and receive its cost breakdown, choices and pending placements (card-play-prepared)",
+			Fields:         []ActionCatalogFieldDto{{Name: "cardId", Type: "string", Required: true, Constraints: "card in hand; replaces any earlier reservation", Description: "Card to prepare"}},
+			ExamplePayload: map[string]interface{}{"cardId": "card-id"},
+		},
+		{
+			Type:        MessageTypeActionCommitPlayCard,
+			Description: "Play the prepared card; it stays reserved if the play is rejected",
+			Fields: []ActionCatalogFieldDto{
+				{Name: "payment", Type: "CardPaymentDto", Required: true, Constraints: "must cover the prepared effective cost", Description: "Payment breakdown (credits, steel, titanium, substitutes)"},
+				{Name: "choiceIndex", Type: "number", Required: false, Constraints: "required when the preview reports choices", Description: "Index of the chosen behavior"},
+				{Name: "cardStorageTarget", Type: "string", Required: false, Description: "Card receiving resources for outputs targeting any card"},
+				{Name: "targetPlayerId", Type: "string", Required: false, Description: "Player targeted by attacks"},
+				{Name: "attackAmount", Type: "number", Required: false, Constraints: "0 to the card's amount", Description: "How many resources \"up to\" removals take; omit for the full amount"},
+				{Name: "discardCardIds", Type: "string[]", Required: false, Constraints: "exactly the card's discard count, from hand", Description: "Cards discarded to pay card-discard inputs"},
+			},
+			ExamplePayload: map[string]interface{}{"payment": map[string]int{"credits": 10, "steel": 0, "titanium": 0}},
+		},
+		{
+			Type:           MessageTypeActionCancelPlayCard,
+			Description:    "Release the prepared card without paying or applying anything",
+			Fields:         []ActionCatalogFieldDto{},
+			ExamplePayload: map[string]interface{}{},
+		},
+		{
+			Type:        MessageTypeActionCardAction,
+			Description: "Use an action from a played card",
+			Fields: []ActionCatalogFieldDto{
+				{Name: "cardId", Type: "string", Required: true, Constraints: "played card with an unused action", Description: "Card providing the action"},
+				{Name: "behaviorIndex", Type: "number", Required: true, Description: "Index of the action behavior on the card"},
+				{Name: "choiceIndex", Type: "number", Required: false, Description: "Index of the chosen option for actions with choices"},
+				{Name: "cardStorageTarget", Type: "string", Required: false, Description: "Card receiving resources for outputs targeting any card"},
+				{Name: "targetPlayerId", Type: "string", Required: false, Description: "Player targeted by attacks"},
+				{Name: "sourceCardForInput", Type: "string", Required: false, Description: "Card paying card-resource inputs"},
+				{Name: "attackAmount", Type: "number", Required: false, Constraints: "0 to the card's amount", Description: "How many resources \"up to\" removals take; omit for the full amount"},
+				{Name: "discardCardIds", Type: "string[]", Required: false, Constraints: "exactly the card's discard count, from hand", Description: "Cards discarded to pay card-discard inputs"},
+			},
+			ExamplePayload: map[string]interface{}{"cardId": "card-id", "behaviorIndex": 0},
+		},
+		{
+			Type:        MessageTypeActionCardDrawConfirmed,
+			Description: "Resolve a pending card draw or peek selection",
+			Fields: []ActionCatalogFieldDto{
+				{Name: "cardsToTake", Type: "string[]", Required: false, Description: "Cards taken for free"},
+				{Name: "cardsToBuy", Type: "string[]", Required: false, Description: "Cards bought for their buy cost"},
+			},
+			ExamplePayload: map[string]interface{}{"cardsToTake": []string{"card-id"}, "cardsToBuy": []string{}},
+		},
+		{
+			Type:        MessageTypeActionRespondToEffect,
+			Description: "Answer another player's effect that targets you; other gameplay waits until every response is in",
+			Fields: []ActionCatalogFieldDto{
+				{Name: "optionIndex", Type: "number", Required: true, Constraints: "index into pendingResponse.options; option 0 is applied on timeout", Description: "Option to suffer"},
+			},
+			ExamplePayload: map[string]interface{}{"optionIndex": 0},
+		},
+	}
+}
@@ -0,0 +1,91 @@
+package dto
+
+var (
+	lobbyPhases  = []GamePhase{GamePhaseWaitingForGameStart}
+	actionPhases = []GamePhase{GamePhaseAction}
+	allPhases    = []GamePhase{GamePhaseWaitingForGameStart, GamePhaseStartingCardSelection, GamePhaseStartGameSelection,
+		GamePhaseDemoSetup, GamePhaseAction, GamePhaseProductionAndCardDraw, GamePhaseComplete}
+	openPhases = []GamePhase{GamePhaseWaitingForGameStart, GamePhaseStartingCardSelection, GamePhaseStartGameSelection,
+		GamePhaseDemoSetup, GamePhaseAction, GamePhaseProductionAndCardDraw}
+	startedPhases = []GamePhase{GamePhaseStartingCardSelection, GamePhaseStartGameSelection, GamePhaseDemoSetup,
+		GamePhaseAction, GamePhaseProductionAndCardDraw}
+)
+
+// actionPhaseMatrix lists the phases each client action is legal in; the lobby and complete phases stand for
+// the lobby and finished statuses. The WebSocket phase guard enforces it before any handler runs and the
+// action catalog publishes it. Message types missing here (joining, admin commands, sync) are not phase-checked
+var actionPhaseMatrix = map[MessageType][]GamePhase{
+	// Lobby setup
+	MessageTypeActionStartGame:    lobbyPhases,
+	MessageTypeActionSetSeatOrder: lobbyPhases,
+	MessageTypeActionSetHandicap:  lobbyPhases,
+
+	// Table management, open whatever the game is doing
+	MessageTypeActionSetPlayerColor:    allPhases,
+	MessageTypeActionSetPreferences:    allPhases,
+	MessageTypeActionSetNote:           allPhases,
+	MessageTypeActionMuteReactions:     allPhases,
+	MessageTypeActionSendReaction:      openPhases,
+	MessageTypeActionPauseGame:         startedPhases,
+	MessageTypeActionResumeGame:        startedPhases,
+	MessageTypeActionVoteAbandon:       startedPhases,
+	MessageTypeActionForceAdvancePhase: {GamePhaseProductionAndCardDraw},
+
+	// Setup phases
+	MessageTypeActionConfirmDemoSetup:     {GamePhaseDemoSetup},
+	MessageTypeActionSelectStartingCard:   {GamePhaseStartingCardSelection},
+	MessageTypeActionMulliganStartingHand: {GamePhaseStartingCardSelection},
+
+	// Production
+	MessageTypeActionConfirmProductionCards: {GamePhaseProductionAndCardDraw},
+
+	// Turns in the action phase
+	MessageTypeActionConcede:                  actionPhases,
+	MessageTypeActionSkipAction:               actionPhases,
+	MessageTypeActionSellPatents:              actionPhases,
+	MessageTypeActionConfirmSellPatents:       actionPhases,
+	MessageTypeActionLaunchAsteroid:           actionPhases,
+	MessageTypeActionBuildPowerPlant:          actionPhases,
+	MessageTypeActionBuildAquifer:             actionPhases,
+	MessageTypeActionPlantGreenery:            actionPhases,
+	MessageTypeActionBuildCity:                actionPhases,
+	MessageTypeActionConvertPlantsToGreenery:  actionPhases,
+	MessageTypeActionConvertHeatToTemperature: actionPhases,
+	MessageTypeActionConvertAll:               actionPhases,
+	MessageTypeActionClaimMilestone:           actionPhases,
+	MessageTypeActionFundAward:                actionPhases,
+	MessageTypeActionPlayCard:                 actionPhases,
+	MessageTypeActionPreparePlayCard:          actionPhases,
+	MessageTypeActionCommitPlayCard:           actionPhases,
+	MessageTypeActionCancelPlayCard:           actionPhases,
+	MessageTypeActionCardAction:               actionPhases,
+	MessageTypeActionRunBatch:                 actionPhases,
+
+	// Resolving pending selections; corporation starting effects can leave these before the action phase
+	MessageTypeActionTileSelected:      startedPhases,
+	MessageTypeActionCardDrawConfirmed: startedPhases,
+	MessageTypeActionRespondToEffect:   startedPhases,
+}
+
+// AllowedPhases returns the phases a client action is legal in; ok is false for message types the matrix does not cover
+func AllowedPhases(messageType MessageType) (phases []GamePhase, ok bool) {
+	phases, ok = actionPhaseMatrix[messageType]
+	if !ok {
+		return nil, false
+	}
+	return append([]GamePhase(nil), phases...), true
+}
+
+// PhaseAllows reports whether a client action may be sent in phase; message types the matrix does not cover always may
+func PhaseAllows(messageType MessageType, phase GamePhase) bool {
+	phases, ok := actionPhaseMatrix[messageType]
+	if !ok {
+		return true
+	}
+	for _, p := range phases {
+		if p == phase {
+			return true
+		}
+	}
+	return false
+}
@@ -28,6 +28,7 @@ type SelectStartingCardAction struct {
 	Type          ActionType `json:"type" ts:"ActionType"`
 	CardIDs       []string   `json:"cardIds" ts:"string[]"`
 	CorporationID string     `json:"corporationId" ts:"string"`
+	PreludeIDs    []string   `json:"preludeIds,omitempty" ts:"string[] | undefined"`
 }
 
 // StartGameAction represents starting the game (host only)
@@ -102,7 +103,8 @@ type BuildCityAction struct {
 type ActionSelectStartingCardRequest struct {
 	Type          ActionType `json:"type" ts:"ActionType"`
 	CardIDs       []string   `json:"cardIds" ts:"string[]"`
-	CorporationID string     `json:"corporationId" ts:"string"` // Corporation selected alongside starting cards
+	CorporationID string     `json:"corporationId" ts:"string"`                      // Corporation selected alongside starting cards
+	PreludeIDs    []string   `json:"preludeIds,omitempty" ts:"string[] | undefined"` // Preludes to keep when the prelude pack is enabled
 }
 
 // ActionSelectProductionCardsRequest contains the action data for select production card actions
@@ -113,7 +115,7 @@ type ActionSelectProductionCardsRequest struct {
 
 // GetAction returns the select starting card action
 func (ap *ActionSelectStartingCardRequest) GetAction() *SelectStartingCardAction {
-	return &SelectStartingCardAction{Type: ap.Type, CardIDs: ap.CardIDs, CorporationID: ap.CorporationID}
+	return &SelectStartingCardAction{Type: ap.Type, CardIDs: ap.CardIDs, CorporationID: ap.CorporationID, PreludeIDs: ap.PreludeIDs}
 }
 
 // ActionStartGameRequest contains the action data for start game actions
@@ -147,6 +149,84 @@ type ConfirmDemoSetupRequest struct {
 	Generation       *int                 `json:"generation,omitempty" ts:"number | undefined"`                    // Host only
 }
 
+// SetSeatOrderRequest contains the host's lobby seating arrangement
+type SetSeatOrderRequest struct {
+	SeatOrder []string `json:"seatOrder,omitempty" ts:"string[] | undefined"` // Player IDs in seat order (ignored when randomize is true)
+	Randomize bool     `json:"randomize" ts:"boolean"`                        // Shuffle seats at game start instead
+}
+
+// SetHandicapRequest contains the host's handicap for one seat; all-zero bonuses clear it
+type SetHandicapRequest struct {
+	PlayerID     string `json:"playerId" ts:"string"`
+	ExtraCredits int    `json:"extraCredits" ts:"number"` // 0-20
+	ExtraCards   int    `json:"extraCards" ts:"number"`   // 0-5, added to the starting card selection
+	ExtraTR      int    `json:"extraTR" ts:"number"`      // 0-5
+}
+
+// SetPlayerColorRequest contains the host's color override for one player
+type SetPlayerColorRequest struct {
+	PlayerID string `json:"playerId" ts:"string"`
+	Color    string `json:"color" ts:"string"` // "#RRGGBB"; must be a free color from the game's palette
+}
+
+// SetPreferencesRequest contains the sending player's own preferences for this game
+type SetPreferencesRequest struct {
+	AutoPass bool   `json:"autoPass" ts:"boolean"`                    // Pass automatically when a turn starts with no legal action
+	Locale   string `json:"locale,omitempty" ts:"string | undefined"` // Language of server messages, e.g. "de"; unset keeps the current one
+}
+
+// SetNoteRequest contains the sending player's private note for this game
+type SetNoteRequest struct {
+	Note string `json:"note" ts:"string"` // At most 2000 characters; empty clears the note
+}
+
+// RunBatchRequest contains an ordered batch of the sending player's actions, run as one unit
+type RunBatchRequest struct {
+	Steps []BatchStepRequest `json:"steps" ts:"BatchStepRequest[]"` // 1 to 10 steps, run in order
+}
+
+// BatchStepRequest is one action of a batch: a message type and the payload it would be sent with
+type BatchStepRequest struct {
+	Type    MessageType            `json:"type" ts:"MessageType"`
+	Payload map[string]interface{} `json:"payload,omitempty" ts:"Record<string, any> | undefined"`
+}
+
+// SendReactionRequest contains a canned reaction for the table
+type SendReactionRequest struct {
+	Reaction string `json:"reaction" ts:"string"` // thumbs-up, nice-move, well-played, wow, thinking, oops or frowny-asteroid
+}
+
+// MuteReactionsRequest hides or shows reactions for the sending player
+type MuteReactionsRequest struct {
+	TargetPlayerID string `json:"targetPlayerId,omitempty" ts:"string | undefined"` // Player to mute; unset mutes everyone
+	Muted          bool   `json:"muted" ts:"boolean"`
+}
+
+// ForceAdvancePhaseRequest contains the host's confirmation for forcing the production phase forward
+type ForceAdvancePhaseRequest struct {
+	Confirmed bool `json:"confirmed" ts:"boolean"` // Must be true; players still choosing cards buy none
+}
+
+// SkipActionRequest contains the optional confirmation for passing with convertible resources
+type SkipActionRequest struct {
+	Confirmed bool `json:"confirmed,omitempty" ts:"boolean | undefined"` // Pass even though plants or heat could still be converted
+}
+
+// PreparePlayCardRequest reserves a card in hand and asks for its play preview
+type PreparePlayCardRequest struct {
+	CardID string `json:"cardId" ts:"string"`
+}
+
+// CommitPlayCardRequest plays the prepared card with the chosen payment and options
+type CommitPlayCardRequest struct {
+	Payment           CardPaymentDto `json:"payment" ts:"CardPaymentDto"`
+	ChoiceIndex       *int           `json:"choiceIndex,omitempty" ts:"number | undefined"`       // Required when the preview reports choices
+	CardStorageTarget *string        `json:"cardStorageTarget,omitempty" ts:"string | undefined"` // Target card for outputs with target "any-card"
+	TargetPlayerID    *string        `json:"targetPlayerId,omitempty" ts:"string | undefined"`    // Player targeted by attacks
+	AttackAmount      *int           `json:"attackAmount,omitempty" ts:"number | undefined"`      // How many resources "up to" removals take; omit for the full amount
+	DiscardCardIDs    []string       `json:"discardCardIds,omitempty" ts:"string[] | undefined"`  // Cards discarded to pay card-discard inputs
+}
+
 // ActionPlayCardRequest contains the action data for play card actions
 type ActionPlayCardRequest struct {
 	Type              ActionType     `json:"type" ts:"ActionType"`
@@ -285,6 +365,8 @@ const (
 	AdminCommandTypeSetCurrentTurn     AdminCommandType = "set-current-turn"
 	AdminCommandTypeSetCorporation     AdminCommandType = "set-corporation"
 	AdminCommandTypeSetTR              AdminCommandType = "set-tr"
+	AdminCommandTypeSetupTestState     AdminCommandType = "setup-test-state"
+	AdminCommandTypeSetAwardFreeze     AdminCommandType = "set-award-freeze"
 )
 
 // AdminCommandRequest contains the admin command data
@@ -295,8 +377,9 @@ type AdminCommandRequest struct {
 
 // GiveCardAdminCommand represents giving a card to a player
 type GiveCardAdminCommand struct {
-	PlayerID string `json:"playerId" ts:"string"`
-	CardID   string `json:"cardId" ts:"string"`
+	PlayerID     string `json:"playerId" ts:"string"`
+	CardID       string `json:"cardId" ts:"string"`
+	AllowAnyPack bool   `json:"allowAnyPack,omitempty" ts:"boolean | undefined"` // Allow a card from a pack the game was not created with
 }
 
 // SetPhaseAdminCommand represents setting the game phase
@@ -339,6 +422,39 @@ type SetTRAdminCommand struct {
 	TerraformRating int    `json:"terraformRating" ts:"number"`
 }
 
+// SetupTestStateAdminCommand applies a whole test fixture in one step; unset fields are left as they are
+type SetupTestStateAdminCommand struct {
+	Generation          int                  `json:"generation,omitempty" ts:"number | undefined"`
+	Phase               string               `json:"phase,omitempty" ts:"string | undefined"`
+	CurrentTurnPlayerID string               `json:"currentTurnPlayerId,omitempty" ts:"string | undefined"`
+	GlobalParameters    *GlobalParametersDto `json:"globalParameters,omitempty" ts:"GlobalParametersDto | undefined"`
+	Tiles               []FixtureTileDto     `json:"tiles,omitempty" ts:"FixtureTileDto[] | undefined"`
+	Players             []FixturePlayerDto   `json:"players,omitempty" ts:"FixturePlayerDto[] | undefined"`
+}
+
+// FixtureTileDto is a tile placed by a test fixture, without placement bonuses
+type FixtureTileDto struct {
+	HexPosition HexPositionDto `json:"hexPosition" ts:"HexPositionDto"`
+	TileType    string         `json:"tileType" ts:"string"`                      // city, greenery or ocean
+	OwnerID     string         `json:"ownerId,omitempty" ts:"string | undefined"` // Unset for a neutral tile
+}
+
+// FixturePlayerDto is the state a test fixture gives one player
+type FixturePlayerDto struct {
+	PlayerID        string         `json:"playerId" ts:"string"`
+	CorporationID   string         `json:"corporationId,omitempty" ts:"string | undefined"`
+	Resources       *ResourcesDto  `json:"resources,omitempty" ts:"ResourcesDto | undefined"`
+	Production      *ProductionDto `json:"production,omitempty" ts:"ProductionDto | undefined"`
+	TerraformRating *int           `json:"terraformRating,omitempty" ts:"number | undefined"`
+	Hand            []string       `json:"hand,omitempty" ts:"string[] | undefined"`        // Replaces the hand
+	PlayedCards     []string       `json:"playedCards,omitempty" ts:"string[] | undefined"` // Added without paying or immediate effects
+}
+
+// SetAwardFreezeAdminCommand turns freezing award standings at funding on or off for awards funded from now on
+type SetAwardFreezeAdminCommand struct {
+	Enabled bool `json:"enabled" ts:"boolean"`
+}
+
 // CardPaymentDto represents how a player is paying for a card
 type CardPaymentDto struct {
 	Credits     int            `json:"credits" ts:"number"`                                           // MC spent
@@ -20,6 +20,8 @@ const (
 	GameStatusLobby     GameStatus = "lobby"
 	GameStatusActive    GameStatus = "active"
 	GameStatusCompleted GameStatus = "completed"
+	GameStatusAbandoned GameStatus = "abandoned"
+	GameStatusFailed    GameStatus = "failed"
 )
 
 // CardType represents different types of cards
@@ -90,14 +92,19 @@ const (
 	ResourceTypeCardTake ResourceType = "card-take"
 	ResourceTypeCardPeek ResourceType = "card-peek"
 
+	ResourceTypeCardSearch  ResourceType = "card-search"
+	ResourceTypeCardDiscard ResourceType = "card-discard"
+
 	ResourceTypeCityPlacement     ResourceType = "city-placement"
 	ResourceTypeOceanPlacement    ResourceType = "ocean-placement"
 	ResourceTypeGreeneryPlacement ResourceType = "greenery-placement"
+	ResourceTypeSpecialPlacement  ResourceType = "special-placement"
 
 	ResourceTypeCityTile     ResourceType = "city-tile"
 	ResourceTypeOceanTile    ResourceType = "ocean-tile"
 	ResourceTypeGreeneryTile ResourceType = "greenery-tile"
 	ResourceTypeColonyTile   ResourceType = "colony-tile"
+	ResourceTypeSpecialTile  ResourceType = "special-tile"
 
 	ResourceTypeTemperature ResourceType = "temperature"
 	ResourceTypeOxygen      ResourceType = "oxygen"
@@ -125,12 +132,13 @@ const (
 type TargetType string
 
 const (
-	TargetSelfPlayer TargetType = "self-player"
-	TargetSelfCard   TargetType = "self-card"
-	TargetAnyCard    TargetType = "any-card"
-	TargetAnyPlayer  TargetType = "any-player"
-	TargetOpponent   TargetType = "opponent"
-	TargetNone       TargetType = "none"
+	TargetSelfPlayer      TargetType = "self-player"
+	TargetSelfCard        TargetType = "self-card"
+	TargetAnyCard         TargetType = "any-card"
+	TargetAnyPlayer       TargetType = "any-player"
+	TargetAnyPlayerChoice TargetType = "any-player-choice" // Outputs the target player chooses between
+	TargetOpponent        TargetType = "opponent"
+	TargetNone            TargetType = "none"
 )
 
 // CardApplyLocation represents different locations where card conditions can be evaluated for client consumption
@@ -201,9 +209,10 @@ type ResourceSet struct {
 
 // TileRestrictionsDto represents tile placement restrictions for client consumption
 type TileRestrictionsDto struct {
-	BoardTags  []string `json:"boardTags,omitempty" ts:"string[] | undefined"`
-	Adjacency  string   `json:"adjacency,omitempty" ts:"string | undefined"`  // "none" = no adjacent occupied tiles
-	OnTileType string   `json:"onTileType,omitempty" ts:"string | undefined"` // "ocean" = only on ocean spaces
+	BoardTags    []string `json:"boardTags,omitempty" ts:"string[] | undefined"`
+	Adjacency    string   `json:"adjacency,omitempty" ts:"string | undefined"`      // "none" = no adjacent occupied tiles, "own" = next to one of your tiles
+	OnTileType   string   `json:"onTileType,omitempty" ts:"string | undefined"`     // "ocean" = only on ocean spaces, "land" = only on land spaces
+	CaptureBonus []string `json:"captureBonus,omitempty" ts:"string[] | undefined"` // Only areas with one of these placement bonuses; that production rises 1 step
 }
 
 // SelectorDto represents matching criteria for cards, resources, or projects.
@@ -226,6 +235,7 @@ type ResourceConditionDto struct {
 	MaxTrigger       *int                 `json:"maxTrigger,omitempty" ts:"number | undefined"`
 	Per              *PerConditionDto     `json:"per,omitempty" ts:"PerConditionDto | undefined"`
 	TileRestrictions *TileRestrictionsDto `json:"tileRestrictions,omitempty" ts:"TileRestrictionsDto | undefined"`
+	SpecialTileType  string               `json:"specialTileType,omitempty" ts:"string | undefined"`
 }
 
 // PerConditionDto represents a per condition for client consumption
@@ -273,6 +283,7 @@ type CardBehaviorDto struct {
 	Outputs                       []ResourceConditionDto            `json:"outputs,omitempty" ts:"ResourceConditionDto[] | undefined"`
 	Choices                       []ChoiceDto                       `json:"choices,omitempty" ts:"ChoiceDto[] | undefined"`
 	GenerationalEventRequirements []GenerationalEventRequirementDto `json:"generationalEventRequirements,omitempty" ts:"GenerationalEventRequirementDto[] | undefined"`
+	UsageLimit                    *UsageLimitDto                    `json:"usageLimit,omitempty" ts:"UsageLimitDto | undefined"`
 }
 
 // PaymentConstantsDto represents payment conversion rates
@@ -330,11 +341,15 @@ type CardDto struct {
 
 	StartingResources  *ResourceSet `json:"startingResources,omitempty" ts:"ResourceSet | undefined"`
 	StartingProduction *ResourceSet `json:"startingProduction,omitempty" ts:"ResourceSet | undefined"`
+
+	IsFaceDown bool `json:"isFaceDown,omitempty" ts:"boolean | undefined"` // Played event: counts only as an event tag
 }
 
 type SelectStartingCardsPhaseDto struct {
-	AvailableCards        []CardDto `json:"availableCards" ts:"CardDto[]"`        // Cards available for selection
-	AvailableCorporations []CardDto `json:"availableCorporations" ts:"CardDto[]"` // Corporation cards available for selection (2 corporations)
+	AvailableCards        []CardDto `json:"availableCards" ts:"CardDto[]"`                          // Cards available for selection
+	AvailableCorporations []CardDto `json:"availableCorporations" ts:"CardDto[]"`                   // Corporation cards available for selection (2 corporations)
+	AvailablePreludes     []CardDto `json:"availablePreludes,omitempty" ts:"CardDto[] | undefined"` // Preludes dealt when the prelude pack is enabled; keep 2
+	CanMulligan           bool      `json:"canMulligan" ts:"boolean"`                               // The mulligan rule is on and this player has not redrawn yet
 }
 
 type SelectStartingCardsOtherPlayerDto struct {
@@ -363,17 +378,58 @@ type ProductionPhaseOtherPlayerDto struct {
 
 // GameSettingsDto contains configurable game parameters
 type GameSettingsDto struct {
-	MaxPlayers      int      `json:"maxPlayers" ts:"number"`
-	DevelopmentMode bool     `json:"developmentMode" ts:"boolean"`
-	DemoGame        bool     `json:"demoGame" ts:"boolean"`
-	CardPacks       []string `json:"cardPacks,omitempty" ts:"string[] | undefined"`
+	MaxPlayers      int             `json:"maxPlayers" ts:"number"`
+	DevelopmentMode bool            `json:"developmentMode" ts:"boolean"`
+	DemoGame        bool            `json:"demoGame" ts:"boolean"`
+	PassAndPlay     bool            `json:"passAndPlay" ts:"boolean"`
+	Analytics       bool            `json:"analytics" ts:"boolean"`
+	CardPacks       []string        `json:"cardPacks,omitempty" ts:"string[] | undefined"`
+	ColorPalette    []string        `json:"colorPalette" ts:"string[]"`
+	RulesOptions    RulesOptionsDto `json:"rulesOptions" ts:"RulesOptionsDto"`
+}
+
+// RulesOptionsDto contains the official rule variants enabled for a game
+type RulesOptionsDto struct {
+	DraftVariant      bool   `json:"draftVariant" ts:"boolean"`
+	FastMode          bool   `json:"fastMode" ts:"boolean"`
+	ShowTimers        bool   `json:"showTimers" ts:"boolean"`
+	MilestoneAwardSet string `json:"milestoneAwardSet" ts:"string"`
+	SoloTRDecay       bool   `json:"soloTRDecay" ts:"boolean"`
+
+	SpeedPreset                     string `json:"speedPreset,omitempty" ts:"string | undefined"`                     // "blitz", "standard" or "relaxed": fills in the timers left unset
+	TurnTimerSeconds                int    `json:"turnTimerSeconds,omitempty" ts:"number | undefined"`                // 0 or unset: turns have no time limit
+	TimebankSeconds                 int    `json:"timebankSeconds,omitempty" ts:"number | undefined"`                 // Extra time per player for turns that run over the turn timer
+	ResearchTimeoutSeconds          int    `json:"researchTimeoutSeconds,omitempty" ts:"number | undefined"`          // 0 or unset: wait for every card purchase
+	StartingSelectionTimeoutSeconds int    `json:"startingSelectionTimeoutSeconds,omitempty" ts:"number | undefined"` // 0 or unset: wait for every starting selection
+	MulliganStartingHand            bool   `json:"mulliganStartingHand,omitempty" ts:"boolean | undefined"`           // House rule: one redraw of the starting project cards
+
+	SoloGoal         string `json:"soloGoal,omitempty" ts:"string | undefined"`          // "terraform" or "tr63": win by the end of generation 14; unset: no limit
+	SoloNeutralTiles bool   `json:"soloNeutralTiles,omitempty" ts:"boolean | undefined"` // Place neutral cities and greeneries at solo setup
+
+	LiveScores              bool `json:"liveScores,omitempty" ts:"boolean | undefined"`              // Broadcast an estimated score per player; unset: only TR is shown
+	LiveScoresExcludeEvents bool `json:"liveScoresExcludeEvents,omitempty" ts:"boolean | undefined"` // Leave face-down event cards out of the live estimate
+
+	MilestoneVP        *int `json:"milestoneVP,omitempty" ts:"number | undefined"`        // House rule: VP per claimed milestone, 0 allowed; unset: 5
+	AwardFirstPlaceVP  *int `json:"awardFirstPlaceVP,omitempty" ts:"number | undefined"`  // House rule: VP for first place in an award, 0 allowed; unset: 5
+	AwardSecondPlaceVP *int `json:"awardSecondPlaceVP,omitempty" ts:"number | undefined"` // House rule: VP for second place, at most first place's; unset: 2
 }
 
 // GlobalParametersDto represents the terraforming progress
 type GlobalParametersDto struct {
-	Temperature int `json:"temperature" ts:"number"` // Range: -30 to +8°C
-	Oxygen      int `json:"oxygen" ts:"number"`      // Range: 0-14%
-	Oceans      int `json:"oceans" ts:"number"`      // Range: 0-9
+	Temperature int                       `json:"temperature" ts:"number"`                                     // Range: -30 to +8°C
+	Oxygen      int                       `json:"oxygen" ts:"number"`                                          // Range: 0-14%
+	Oceans      int                       `json:"oceans" ts:"number"`                                          // Range: 0-9
+	Tracks      []GlobalParameterTrackDto `json:"tracks,omitempty" ts:"GlobalParameterTrackDto[] | undefined"` // Game state only: bounds and steps of each parameter
+}
+
+// GlobalParameterTrackDto describes the values one global parameter can take
+type GlobalParameterTrackDto struct {
+	Parameter string `json:"parameter" ts:"string"` // "temperature", "oxygen" or "oceans"
+	Unit      string `json:"unit" ts:"string"`      // "°C", "%", or empty for a count
+	Min       int    `json:"min" ts:"number"`
+	Max       int    `json:"max" ts:"number"`
+	Step      int    `json:"step" ts:"number"`      // Change in value per step
+	TRPerStep int    `json:"trPerStep" ts:"number"` // Terraform rating gained per step raised
 }
 
 // ResourcesDto represents a player's resources
@@ -431,9 +487,11 @@ const (
 	ErrorCodeNoOceanTiles         StateErrorCode = "no-ocean-tiles"
 	ErrorCodeNoCityPlacements     StateErrorCode = "no-city-placements"
 	ErrorCodeNoGreeneryPlacements StateErrorCode = "no-greenery-placements"
+	ErrorCodeNoSpecialPlacements  StateErrorCode = "no-special-placements"
 	ErrorCodeNoCardsInHand        StateErrorCode = "no-cards-in-hand"
 	ErrorCodeInvalidProjectType   StateErrorCode = "invalid-project-type"
 	ErrorCodeInvalidRequirement   StateErrorCode = "invalid-requirement"
+	ErrorCodeExpansionDisabled    StateErrorCode = "expansion-disabled"
 
 	ErrorCodeInvalidCardType StateErrorCode = "invalid-card-type"
 )
@@ -466,7 +524,8 @@ type StateErrorDto struct {
 type StateWarningCode string
 
 const (
-	WarningCodeNoValidTilePlacements StateWarningCode = "no-valid-tile-placements"
+	WarningCodeNoValidTilePlacements    StateWarningCode = "no-valid-tile-placements"
+	WarningCodeDiscardSelectionRequired StateWarningCode = "discard-selection-required"
 )
 
 // StateWarningDto represents a non-blocking warning about an action
@@ -496,6 +555,7 @@ type PlayerCardDto struct {
 	Warnings      []StateWarningDto `json:"warnings,omitempty" ts:"StateWarningDto[] | undefined"`       // Non-blocking warnings
 	EffectiveCost int               `json:"effectiveCost" ts:"number"`                                   // Effective cost after discounts (credits)
 	Discounts     map[string]int    `json:"discounts,omitempty" ts:"Record<string, number> | undefined"` // Discount amounts per resource type (if any)
+	LegalTargets  []string          `json:"legalTargets,omitempty" ts:"string[] | undefined"`            // Players the card's attacks may target (attack cards only, multiplayer)
 }
 
 // PlayerEffectDto represents ongoing effects that a player has active for client consumption
@@ -518,9 +578,11 @@ type PlayerActionDto struct {
 
 	TimesUsedThisTurn       int `json:"timesUsedThisTurn" ts:"number"`       // Times used this turn
 	TimesUsedThisGeneration int `json:"timesUsedThisGeneration" ts:"number"` // Times used this generation
+	TimesUsedThisGame       int `json:"timesUsedThisGame" ts:"number"`       // Times used this game
 
-	Available bool            `json:"available" ts:"boolean"`      // Computed: action is usable
-	Errors    []StateErrorDto `json:"errors" ts:"StateErrorDto[]"` // Reasons why action is not usable
+	Available bool              `json:"available" ts:"boolean"`                                // Computed: action is usable
+	Errors    []StateErrorDto   `json:"errors" ts:"StateErrorDto[]"`                           // Reasons why action is not usable
+	Warnings  []StateWarningDto `json:"warnings,omitempty" ts:"StateWarningDto[] | undefined"` // Non-blocking warnings
 }
 
 // PlayerStandardProjectDto represents a standard project with availability state
@@ -551,6 +613,29 @@ type PendingTileSelectionDto struct {
 	Source         string   `json:"source" ts:"string"`           // What triggered this selection (card ID, standard project, etc.)
 }
 
+// PendingCardPlayDto represents a card reserved with prepare-play-card, awaiting commit or cancel
+type PendingCardPlayDto struct {
+	CardID            string   `json:"cardId" ts:"string"`
+	EffectiveCost     int      `json:"effectiveCost" ts:"number"`
+	RequiresChoice    bool     `json:"requiresChoice" ts:"boolean"`     // Commit must include a choiceIndex
+	PendingPlacements []string `json:"pendingPlacements" ts:"string[]"` // Tile types queued on commit
+}
+
+// PendingResponseDto is a choice another player's effect asks of the viewing player
+type PendingResponseDto struct {
+	SourcePlayerID string              `json:"sourcePlayerId" ts:"string"` // Player whose effect opened the response
+	Source         string              `json:"source" ts:"string"`         // Card name
+	Options        []ResponseOptionDto `json:"options" ts:"ResponseOptionDto[]"`
+	DefaultOption  int                 `json:"defaultOption" ts:"number"` // Applied when the deadline passes
+	Deadline       string              `json:"deadline" ts:"string"`      // ISO 8601; pushed back while the game is paused
+}
+
+// ResponseOptionDto is one answer to a pending response: lose Amount of ResourceType (clamped to what the player has)
+type ResponseOptionDto struct {
+	ResourceType ResourceType `json:"resourceType" ts:"ResourceType"`
+	Amount       int          `json:"amount" ts:"number"`
+}
+
 // PendingCardSelectionDto represents a pending card selection action (e.g., sell patents, card effects)
 type PendingCardSelectionDto struct {
 	AvailableCards []CardDto      `json:"availableCards" ts:"CardDto[]"`           // Card IDs player can select from
@@ -584,6 +669,7 @@ const (
 type PlayerDto struct {
 	ID               string                     `json:"id" ts:"string"`
 	Name             string                     `json:"name" ts:"string"`
+	Color            string                     `json:"color" ts:"string"` // "#RRGGBB" from the game's palette
 	Status           PlayerStatus               `json:"status" ts:"PlayerStatus"`
 	Corporation      *CardDto                   `json:"corporation" ts:"CardDto | null"`
 	Cards            []PlayerCardDto            `json:"cards" ts:"PlayerCardDto[]"` // Hand cards with playability state (Player-Scoped Architecture)
@@ -594,6 +680,12 @@ type PlayerDto struct {
 	Passed           bool                       `json:"passed" ts:"boolean"`
 	AvailableActions int                        `json:"availableActions" ts:"number"`
 	IsConnected      bool                       `json:"isConnected" ts:"boolean"`
+	AutoPass         bool                       `json:"autoPass" ts:"boolean"`                            // Preference: pass automatically when a turn starts with no legal action
+	Locale           string                     `json:"locale,omitempty" ts:"string | undefined"`         // Preference: language of server messages; unset for English
+	MutedPlayerIDs   []string                   `json:"mutedPlayerIds" ts:"string[]"`                     // Preference: players whose reactions are hidden
+	ReactionsMuted   bool                       `json:"reactionsMuted" ts:"boolean"`                      // Preference: hide every player's reactions
+	Note             string                     `json:"note,omitempty" ts:"string | undefined"`           // Private note for this game; never sent to other players
+	Preludes         []CardDto                  `json:"preludes,omitempty" ts:"CardDto[] | undefined"`    // Preludes kept from the starting selection; private until played
 	Effects          []PlayerEffectDto          `json:"effects" ts:"PlayerEffectDto[]"`                   // Active ongoing effects (discounts, special abilities, etc.)
 	Actions          []PlayerActionDto          `json:"actions" ts:"PlayerActionDto[]"`                   // Available actions from played cards with manual triggers
 	StandardProjects []PlayerStandardProjectDto `json:"standardProjects" ts:"PlayerStandardProjectDto[]"` // Standard projects with availability state (Player-Scoped Architecture)
@@ -604,22 +696,27 @@ type PlayerDto struct {
 	ProductionPhase          *ProductionPhaseDto               `json:"productionPhase" ts:"ProductionPhaseDto | null"`
 	StartingCards            []CardDto                         `json:"startingCards" ts:"CardDto[]"`
 	PendingTileSelection     *PendingTileSelectionDto          `json:"pendingTileSelection" ts:"PendingTileSelectionDto | null"`
+	PendingCardPlay          *PendingCardPlayDto               `json:"pendingCardPlay" ts:"PendingCardPlayDto | null"`
 	PendingCardSelection     *PendingCardSelectionDto          `json:"pendingCardSelection" ts:"PendingCardSelectionDto | null"`
 	PendingCardDrawSelection *PendingCardDrawSelectionDto      `json:"pendingCardDrawSelection" ts:"PendingCardDrawSelectionDto | null"`
+	PendingResponse          *PendingResponseDto               `json:"pendingResponse" ts:"PendingResponseDto | null"` // Another player's effect waiting on this player
 	ForcedFirstAction        *ForcedFirstActionDto             `json:"forcedFirstAction" ts:"ForcedFirstActionDto | null"`
 	ResourceStorage          map[string]int                    `json:"resourceStorage" ts:"Record<string, number>"`
 	PaymentSubstitutes       []PaymentSubstituteDto            `json:"paymentSubstitutes" ts:"PaymentSubstituteDto[]"`
 	GenerationalEvents       []PlayerGenerationalEventEntryDto `json:"generationalEvents" ts:"PlayerGenerationalEventEntryDto[]"`
 	VPGranters               []VPGranterDto                    `json:"vpGranters" ts:"VPGranterDto[]"`
+	CardVP                   map[string]int                    `json:"cardVP" ts:"Record<string, number>"`               // Current VP of each played card, scored as at game end
+	EstimatedScore           *int                              `json:"estimatedScore,omitempty" ts:"number | undefined"` // Score if the game ended now; only with the liveScores option
 }
 
 // OtherPlayerDto represents another player from the viewing player's perspective (limited data)
 type OtherPlayerDto struct {
 	ID               string            `json:"id" ts:"string"`
 	Name             string            `json:"name" ts:"string"`
+	Color            string            `json:"color" ts:"string"` // "#RRGGBB" from the game's palette
 	Status           PlayerStatus      `json:"status" ts:"PlayerStatus"`
 	Corporation      *CardDto          `json:"corporation" ts:"CardDto | null"`
-	HandCardCount    int               `json:"handCardCount" ts:"number"` // Number of cards in hand (private)
+	HandCardCount    int               `json:"handCardCount" ts:"number"` // Number of cards in hand; public, unlike the cards
 	Resources        ResourcesDto      `json:"resources" ts:"ResourcesDto"`
 	Production       ProductionDto     `json:"production" ts:"ProductionDto"`
 	TerraformRating  int               `json:"terraformRating" ts:"number"`
@@ -634,29 +731,85 @@ type OtherPlayerDto struct {
 	ProductionPhase          *ProductionPhaseOtherPlayerDto     `json:"productionPhase" ts:"ProductionPhaseOtherPlayerDto | null"`
 	ResourceStorage          map[string]int                     `json:"resourceStorage" ts:"Record<string, number>"`
 	PaymentSubstitutes       []PaymentSubstituteDto             `json:"paymentSubstitutes" ts:"PaymentSubstituteDto[]"`
+	CardVP                   map[string]int                     `json:"cardVP,omitempty" ts:"Record<string, number> | undefined"` // Current VP of each played card; only with the liveScores option
+	EstimatedScore           *int                               `json:"estimatedScore,omitempty" ts:"number | undefined"`         // Score if the game ended now; only with the liveScores option
+}
+
+// HandicapDto represents a per-seat starting bonus
+type HandicapDto struct {
+	ExtraCredits int `json:"extraCredits" ts:"number"`
+	ExtraCards   int `json:"extraCards" ts:"number"`
+	ExtraTR      int `json:"extraTR" ts:"number"`
+}
+
+// PauseDto describes whether a game is paused and who is asking to change that
+type PauseDto struct {
+	Paused   bool     `json:"paused" ts:"boolean"`
+	PausedBy string   `json:"pausedBy,omitempty" ts:"string | undefined"` // Host, or the player whose vote completed the pause
+	PausedAt string   `json:"pausedAt,omitempty" ts:"string | undefined"` // ISO 8601; clients stop their timers from here
+	Votes    []string `json:"votes" ts:"string[]"`                        // Players voting to pause (running) or resume (paused)
+}
+
+// ConcededPlayerDto names a player who conceded; their tiles stay on the board under their ID
+type ConcededPlayerDto struct {
+	PlayerID   string `json:"playerId" ts:"string"`
+	PlayerName string `json:"playerName" ts:"string"`
+	ConcededAt string `json:"concededAt" ts:"string"`
 }
 
 // GameDto represents a game for client consumption (clean architecture)
 type GameDto struct {
-	ID               string               `json:"id" ts:"string"`
-	Status           GameStatus           `json:"status" ts:"GameStatus"`
-	Settings         GameSettingsDto      `json:"settings" ts:"GameSettingsDto"`
-	HostPlayerID     string               `json:"hostPlayerId" ts:"string"`
-	CurrentPhase     GamePhase            `json:"currentPhase" ts:"GamePhase"`
-	GlobalParameters GlobalParametersDto  `json:"globalParameters" ts:"GlobalParametersDto"`
-	CurrentPlayer    PlayerDto            `json:"currentPlayer" ts:"PlayerDto"`       // Viewing player's full data
-	OtherPlayers     []OtherPlayerDto     `json:"otherPlayers" ts:"OtherPlayerDto[]"` // Other players' limited data
-	ViewingPlayerID  string               `json:"viewingPlayerId" ts:"string"`        // The player viewing this game state
-	CurrentTurn      *string              `json:"currentTurn" ts:"string|null"`       // Whose turn it is (nullable)
-	Generation       int                  `json:"generation" ts:"number"`
-	TurnOrder        []string             `json:"turnOrder" ts:"string[]"`                                          // Turn order of all players in game
-	Board            BoardDto             `json:"board" ts:"BoardDto"`                                              // Game board with tiles and occupancy state
-	PaymentConstants PaymentConstantsDto  `json:"paymentConstants" ts:"PaymentConstantsDto"`                        // Conversion rates for alternative payments
-	Milestones       []MilestoneDto       `json:"milestones" ts:"MilestoneDto[]"`                                   // All milestones with claim status
-	Awards           []AwardDto           `json:"awards" ts:"AwardDto[]"`                                           // All awards with funding status
-	AwardResults     []AwardResultDto     `json:"awardResults" ts:"AwardResultDto[]"`                               // Current award placements (1st/2nd place per award)
-	FinalScores      []FinalScoreDto      `json:"finalScores,omitempty" ts:"FinalScoreDto[] | undefined"`           // Final scores (only when game completed)
-	TriggeredEffects []TriggeredEffectDto `json:"triggeredEffects,omitempty" ts:"TriggeredEffectDto[] | undefined"` // Recently triggered passive effects
+	ID               string                 `json:"id" ts:"string"`
+	Status           GameStatus             `json:"status" ts:"GameStatus"`
+	Settings         GameSettingsDto        `json:"settings" ts:"GameSettingsDto"`
+	HostPlayerID     string                 `json:"hostPlayerId" ts:"string"`
+	CurrentPhase     GamePhase              `json:"currentPhase" ts:"GamePhase"`
+	GlobalParameters GlobalParametersDto    `json:"globalParameters" ts:"GlobalParametersDto"`
+	CurrentPlayer    PlayerDto              `json:"currentPlayer" ts:"PlayerDto"`       // Viewing player's full data
+	OtherPlayers     []OtherPlayerDto       `json:"otherPlayers" ts:"OtherPlayerDto[]"` // Other players' limited data
+	ViewingPlayerID  string                 `json:"viewingPlayerId" ts:"string"`        // The player viewing this game state
+	CurrentTurn      *string                `json:"currentTurn" ts:"string|null"`       // Whose turn it is (nullable)
+	Generation       int                    `json:"generation" ts:"number"`
+	TurnOrder        []string               `json:"turnOrder" ts:"string[]"`                                          // Turn order of all players in game (lobby seating before start)
+	RandomizeSeats   bool                   `json:"randomizeSeats" ts:"boolean"`                                      // Whether seating is shuffled at game start
+	Handicaps        map[string]HandicapDto `json:"handicaps" ts:"Record<string, HandicapDto>"`                       // Per-player starting bonuses (kept after start as a record)
+	Pause            PauseDto               `json:"pause" ts:"PauseDto"`                                              // Paused state and pending pause/resume votes
+	AbandonVotes     []string               `json:"abandonVotes" ts:"string[]"`                                       // Players who voted to abandon the game
+	ConcededPlayers  []ConcededPlayerDto    `json:"concededPlayers" ts:"ConcededPlayerDto[]"`                         // Players who left by conceding, in order
+	ResearchDeadline string                 `json:"researchDeadline,omitempty" ts:"string | undefined"`               // ISO 8601; production phase only, when unconfirmed players buy no cards
+	StartingDeadline string                 `json:"startingDeadline,omitempty" ts:"string | undefined"`               // ISO 8601; starting selection only, when undecided players are picked for
+	PhaseStartedAt   string                 `json:"phaseStartedAt" ts:"string"`                                       // ISO 8601; when the current phase began, not counting time paused
+	TurnStartedAt    string                 `json:"turnStartedAt,omitempty" ts:"string | undefined"`                  // ISO 8601; when the current turn began, not counting time paused
+	TurnDeadline     string                 `json:"turnDeadline,omitempty" ts:"string | undefined"`                   // ISO 8601; action phase only, when the turn is skipped (turn timer plus timebank)
+	Timebanks        map[string]int         `json:"timebanks,omitempty" ts:"Record<string, number> | undefined"`      // Seconds of timebank left per player, not counting the turn in progress
+	WaitingOn        []string               `json:"waitingOn" ts:"string[]"`                                          // Players the current phase is waiting for, in turn order
+	RecentActions    []RecentActionDto      `json:"recentActions,omitempty" ts:"RecentActionDto[] | undefined"`       // Last 20 log entries as summaries (WebSocket state only)
+	Board            BoardDto               `json:"board" ts:"BoardDto"`                                              // Game board with tiles and occupancy state
+	PaymentConstants PaymentConstantsDto    `json:"paymentConstants" ts:"PaymentConstantsDto"`                        // Conversion rates for alternative payments
+	Milestones       []MilestoneDto         `json:"milestones" ts:"MilestoneDto[]"`                                   // All milestones with claim status
+	Awards           []AwardDto             `json:"awards" ts:"AwardDto[]"`                                           // All awards with funding status
+	AwardResults     []AwardResultDto       `json:"awardResults" ts:"AwardResultDto[]"`                               // Current award placements (1st/2nd place per award)
+	FinalScores      []FinalScoreDto        `json:"finalScores,omitempty" ts:"FinalScoreDto[] | undefined"`           // Final scores (only when game completed)
+	TriggeredEffects []TriggeredEffectDto   `json:"triggeredEffects,omitempty" ts:"TriggeredEffectDto[] | undefined"` // Recently triggered passive effects
+	CardPiles        CardPilesDto           `json:"cardPiles" ts:"CardPilesDto"`                                      // Sizes of the shared piles and each player's played piles
+	DeckCommitment   string                 `json:"deckCommitment,omitempty" ts:"string | undefined"`                 // SHA-256 of the deck's seed and starting order; the seed is revealed in the log export
+}
+
+// CardPilesDto holds the number of cards in each pile on the table
+type CardPilesDto struct {
+	Deck         int                           `json:"deck" ts:"number"`                           // Project cards left to draw
+	Discard      int                           `json:"discard" ts:"number"`                        // Discarded project cards
+	Corporations int                           `json:"corporations" ts:"number"`                   // Corporations left to deal
+	Preludes     *int                          `json:"preludes,omitempty" ts:"number | undefined"` // Preludes left to deal (prelude pack only)
+	DeckCycles   int                           `json:"deckCycles" ts:"number"`                     // Times the discard pile was shuffled back into the deck
+	Shortfall    int                           `json:"shortfall" ts:"number"`                      // Cards owed to draw effects after the deck ran out
+	Players      map[string]PlayerCardPilesDto `json:"players" ts:"Record<string, PlayerCardPilesDto>"`
+}
+
+// PlayerCardPilesDto holds the size of a player's played piles
+type PlayerCardPilesDto struct {
+	FaceUp int `json:"faceUp" ts:"number"` // Played non-event cards
+	Events int `json:"events" ts:"number"` // Face-down event pile
 }
 
 // Board-related DTOs for tygo generation
@@ -669,8 +822,9 @@ type TileBonusDto struct {
 
 // TileOccupantDto represents what currently occupies a tile
 type TileOccupantDto struct {
-	Type string   `json:"type" ts:"string"`
-	Tags []string `json:"tags" ts:"string[]"`
+	Type            string   `json:"type" ts:"string"`
+	Tags            []string `json:"tags" ts:"string[]"`
+	SpecialTileType string   `json:"specialTileType,omitempty" ts:"string | undefined"` // Card marker for special tiles, e.g. "nuclear-zone"
 }
 
 // TileDto represents a single hexagonal tile on the game board
@@ -680,9 +834,11 @@ type TileDto struct {
 	Type        string           `json:"type" ts:"string"`
 	Location    string           `json:"location" ts:"string"`
 	DisplayName *string          `json:"displayName,omitempty" ts:"string|null"`
+	Label       string           `json:"label" ts:"string"` // Row and column, e.g. "E5"; accepted in place of coordinates
 	Bonuses     []TileBonusDto   `json:"bonuses" ts:"TileBonusDto[]"`
 	OccupiedBy  *TileOccupantDto `json:"occupiedBy,omitempty" ts:"TileOccupantDto|null"`
 	OwnerID     *string          `json:"ownerId,omitempty" ts:"string|null"`
+	OwnerColor  *string          `json:"ownerColor,omitempty" ts:"string|null"` // Owner's player color, so every client renders ownership alike
 	ReservedBy  *string          `json:"reservedBy,omitempty" ts:"string|null"`
 }
 
@@ -848,3 +1004,17 @@ type GenerationalEventRequirementDto struct {
 	Count  *MinMaxValueDto   `json:"count,omitempty" ts:"MinMaxValueDto | undefined"`
 	Target *TargetType       `json:"target,omitempty" ts:"TargetType | undefined"`
 }
+
+// UsagePeriod is the span a behavior usage limit counts over
+type UsagePeriod string
+
+const (
+	UsagePeriodGeneration UsagePeriod = "generation"
+	UsagePeriodGame       UsagePeriod = "game"
+)
+
+// UsageLimitDto caps how often a behavior may be used per generation or per game
+type UsageLimitDto struct {
+	Per   UsagePeriod `json:"per" ts:"UsagePeriod"`
+	Times int         `json:"times" ts:"number"`
+}
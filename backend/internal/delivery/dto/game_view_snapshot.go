@@ -0,0 +1,109 @@
+package dto
+
+import (
+	"terraforming-mars-backend/internal/cards"
+	"terraforming-mars-backend/internal/game"
+	"terraforming-mars-backend/internal/game/player"
+)
+
+// GameViewSnapshot maps a game once and hands out per-viewer GameDtos
+// Game-level data and each player's public view are built at most once and shared by every
+// viewer; a player's private view is built only if that player actually views the game.
+// Broadcasting an N-player game therefore maps N players instead of N×N.
+// Shared slices and maps in the returned DTOs must be treated as read-only.
+// A snapshot is not safe for concurrent use.
+type GameViewSnapshot struct {
+	game         *game.Game
+	cardRegistry cards.CardRegistry
+	players      []*player.Player
+	base         GameDto
+	public       map[string]OtherPlayerDto
+	private      map[string]PlayerDto
+}
+
+// NewGameViewSnapshot captures the viewer-independent parts of a game
+// Triggered effects are consumed here, so every viewer of the snapshot receives them
+func NewGameViewSnapshot(g *game.Game, cardRegistry cards.CardRegistry) *GameViewSnapshot {
+	return &GameViewSnapshot{
+		game:         g,
+		cardRegistry: cardRegistry,
+		players:      g.GetAllPlayers(),
+		base:         toGameBaseDto(g, cardRegistry, true),
+		public:       make(map[string]OtherPlayerDto),
+		private:      make(map[string]PlayerDto),
+	}
+}
+
+// NewReadOnlyGameViewSnapshot captures a game without consuming its triggered effects
+// Its views carry no triggered effects, which stay queued for the next broadcast
+func NewReadOnlyGameViewSnapshot(g *game.Game, cardRegistry cards.CardRegistry) *GameViewSnapshot {
+	return &GameViewSnapshot{
+		game:         g,
+		cardRegistry: cardRegistry,
+		players:      g.GetAllPlayers(),
+		base:         toGameBaseDto(g, cardRegistry, false),
+		public:       make(map[string]OtherPlayerDto),
+		private:      make(map[string]PlayerDto),
+	}
+}
+
+// ForViewer returns the game as seen by playerID
+// An unknown or empty playerID falls back to the first seated player's view
+// Only the viewer's own seat is private; a pass-and-play device sees another seat's hand
+// after switching to it with control-player
+func (s *GameViewSnapshot) ForViewer(playerID string) GameDto {
+	view := s.base
+	view.OtherPlayers = make([]OtherPlayerDto, 0, len(s.players))
+
+	viewer := -1
+	for i, p := range s.players {
+		if p.ID() == playerID {
+			viewer = i
+			break
+		}
+	}
+	if viewer == -1 && len(s.players) > 0 {
+		viewer = 0
+		playerID = s.players[0].ID()
+	}
+
+	for i, p := range s.players {
+		if i == viewer {
+			view.CurrentPlayer = s.privateView(p)
+			continue
+		}
+		view.OtherPlayers = append(view.OtherPlayers, s.publicView(p))
+	}
+	view.ViewingPlayerID = playerID
+
+	return view
+}
+
+// PublicView returns the game as seen by someone without a seat
+// Every player is mapped from their public view and no private view is built
+func (s *GameViewSnapshot) PublicView() GameDto {
+	view := s.base
+	view.OtherPlayers = make([]OtherPlayerDto, 0, len(s.players))
+	for _, p := range s.players {
+		view.OtherPlayers = append(view.OtherPlayers, s.publicView(p))
+	}
+	return view
+}
+
+func (s *GameViewSnapshot) publicView(p *player.Player) OtherPlayerDto {
+	if cached, ok := s.public[p.ID()]; ok {
+		return cached
+	}
+	mapped := ToOtherPlayerDto(p, s.game, s.cardRegistry)
+	s.public[p.ID()] = mapped
+	return mapped
+}
+
+func (s *GameViewSnapshot) privateView(p *player.Player) PlayerDto {
+	if cached, ok := s.private[p.ID()]; ok {
+		return cached
+	}
+	mapped := ToPlayerDto(p, s.game, s.cardRegistry)
+	s.private[p.ID()] = mapped
+	return mapped
+}
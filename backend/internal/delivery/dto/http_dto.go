@@ -2,9 +2,13 @@ package dto
 
 // CreateGameRequest represents the request body for creating a game
 type CreateGameRequest struct {
-	MaxPlayers      int      `json:"maxPlayers" binding:"required,min=1,max=5" ts:"number"`
-	DevelopmentMode bool     `json:"developmentMode" ts:"boolean"`
-	CardPacks       []string `json:"cardPacks,omitempty" ts:"string[] | undefined"`
+	MaxPlayers      int              `json:"maxPlayers" binding:"required,min=1,max=5" ts:"number"`
+	DevelopmentMode bool             `json:"developmentMode" ts:"boolean"`
+	PassAndPlay     bool             `json:"passAndPlay,omitempty" ts:"boolean | undefined"` // Show every hand to the one device playing all seats
+	Analytics       bool             `json:"analytics,omitempty" ts:"boolean | undefined"`   // Contribute anonymized card and corporation stats
+	CardPacks       []string         `json:"cardPacks,omitempty" ts:"string[] | undefined"`
+	ColorPalette    []string         `json:"colorPalette,omitempty" ts:"string[] | undefined"` // "#RRGGBB" player colors; defaults to a colorblind-safe palette
+	RulesOptions    *RulesOptionsDto `json:"rulesOptions,omitempty" ts:"RulesOptionsDto | undefined"`
 }
 
 // CreateGameResponse represents the response for creating a game
@@ -28,6 +32,74 @@ type GetGameResponse struct {
 	Game GameDto `json:"game" ts:"GameDto"`
 }
 
+// GetGameSummaryResponse represents the response for getting a game's read models
+type GetGameSummaryResponse struct {
+	Summary GameSummaryDto `json:"summary" ts:"GameSummaryDto"`
+}
+
+// GameSummaryDto holds lightweight read models derived from a game, from one player's point of view
+type GameSummaryDto struct {
+	GameID           string                    `json:"gameId" ts:"string"`
+	Status           GameStatus                `json:"status" ts:"GameStatus"`
+	Phase            GamePhase                 `json:"phase" ts:"GamePhase"`
+	Generation       int                       `json:"generation" ts:"number"`
+	Scoreboard       []ScoreboardEntryDto      `json:"scoreboard" ts:"ScoreboardEntryDto[]"`                  // Ordered by terraform rating, highest first
+	Board            BoardSummaryDto           `json:"board" ts:"BoardSummaryDto"`                            // Occupied tile counts and global parameters
+	TagCounts        map[string]map[string]int `json:"tagCounts" ts:"Record<string, Record<string, number>>"` // Player ID -> tag -> count over played cards
+	AvailableActions []string                  `json:"availableActions" ts:"string[]"`                        // What the viewing player can do right now
+}
+
+// ScoreboardEntryDto is one player's row on the scoreboard
+type ScoreboardEntryDto struct {
+	PlayerID        string `json:"playerId" ts:"string"`
+	Name            string `json:"name" ts:"string"`
+	Color           string `json:"color" ts:"string"`
+	TerraformRating int    `json:"terraformRating" ts:"number"`
+	PlayedCards     int    `json:"playedCards" ts:"number"`
+	Passed          bool   `json:"passed" ts:"boolean"`
+}
+
+// BoardSummaryDto counts occupied tiles on the board
+type BoardSummaryDto struct {
+	Temperature  int                       `json:"temperature" ts:"number"`
+	Oxygen       int                       `json:"oxygen" ts:"number"`
+	Oceans       int                       `json:"oceans" ts:"number"`
+	TilesByType  map[string]int            `json:"tilesByType" ts:"Record<string, number>"`                  // Tile type -> count
+	TilesByOwner map[string]map[string]int `json:"tilesByOwner" ts:"Record<string, Record<string, number>>"` // Player ID -> tile type -> count
+	FreeTiles    int                       `json:"freeTiles" ts:"number"`                                    // Unoccupied spaces
+}
+
+// GameOverlayDto is a compact, spectator-safe view of a game for stream overlays
+// Only public information is included: no hands, no pending choices
+type GameOverlayDto struct {
+	GameID           string              `json:"gameId" ts:"string"`
+	Status           GameStatus          `json:"status" ts:"GameStatus"`
+	Phase            GamePhase           `json:"phase" ts:"GamePhase"`
+	Generation       int                 `json:"generation" ts:"number"`
+	GlobalParameters GlobalParametersDto `json:"globalParameters" ts:"GlobalParametersDto"`
+	CurrentTurn      *string             `json:"currentTurn" ts:"string|null"`
+	Players          []OverlayPlayerDto  `json:"players" ts:"OverlayPlayerDto[]"`                       // In turn order
+	LastAction       *RecentActionDto    `json:"lastAction,omitempty" ts:"RecentActionDto | undefined"` // Newest log entry as a summary
+}
+
+// OverlayPlayerDto is one player's public standing on an overlay
+type OverlayPlayerDto struct {
+	PlayerID        string        `json:"playerId" ts:"string"`
+	Name            string        `json:"name" ts:"string"`
+	Color           string        `json:"color" ts:"string"`
+	Corporation     string        `json:"corporation,omitempty" ts:"string | undefined"`
+	TerraformRating int           `json:"terraformRating" ts:"number"`
+	Resources       ResourcesDto  `json:"resources" ts:"ResourcesDto"`
+	Production      ProductionDto `json:"production" ts:"ProductionDto"`
+	HandCardCount   int           `json:"handCardCount" ts:"number"` // Number of cards in hand; the cards stay private
+	Passed          bool          `json:"passed" ts:"boolean"`
+}
+
+// GetGameOverlayResponse represents the response for getting a game's stream overlay
+type GetGameOverlayResponse struct {
+	Overlay GameOverlayDto `json:"overlay" ts:"GameOverlayDto"`
+}
+
 // ListGamesResponse represents the response for listing games
 type ListGamesResponse struct {
 	Games []GameDto `json:"games" ts:"GameDto[]"`
@@ -46,6 +118,128 @@ type ListCardsResponse struct {
 	Limit      int       `json:"limit" ts:"number"`
 }
 
+// ResolveCardsResponse lists the cards a typed name resolved to, best first
+type ResolveCardsResponse struct {
+	Query   string         `json:"query" ts:"string"`
+	Matches []CardMatchDto `json:"matches" ts:"CardMatchDto[]"`
+}
+
+// CardMatchDto is one card a query resolved to
+type CardMatchDto struct {
+	Card      CardDto `json:"card" ts:"CardDto"`
+	Score     int     `json:"score" ts:"number"`     // 100 for an exact ID or name, lower for looser matches
+	MatchedBy string  `json:"matchedBy" ts:"string"` // id, name, alias, prefix, words, substring or fuzzy
+}
+
+// CardGuideResponse is a corporation's "how to play" sheet, for starting selection
+type CardGuideResponse struct {
+	CardID   string   `json:"cardId" ts:"string"`
+	CardName string   `json:"cardName" ts:"string"`
+	Summary  string   `json:"summary" ts:"string"`    // The ability in plain words
+	Strategy []string `json:"strategy" ts:"string[]"` // Strategy tags: economy, plants, space, ...
+	Tips     []string `json:"tips" ts:"string[]"`
+}
+
+// BehaviorClauseDto is one card behavior as rules text, generated from the engine's behavior data
+type BehaviorClauseDto struct {
+	BehaviorIndex int      `json:"behaviorIndex" ts:"number"`                   // Index into the card's behaviors, as actions address them
+	Kind          string   `json:"kind" ts:"string"`                            // immediate, action, effect, start or first-action
+	Label         string   `json:"label" ts:"string"`                           // "When played", "Action", "Effect", "Start", "First action"
+	Condition     string   `json:"condition,omitempty" ts:"string | undefined"` // Effects: "when you play a space tag"
+	Costs         []string `json:"costs" ts:"string[]"`
+	Gains         []string `json:"gains" ts:"string[]"`
+	Choices       []string `json:"choices" ts:"string[]"`                   // One entry per option
+	Limit         string   `json:"limit,omitempty" ts:"string | undefined"` // Only when not the default once per generation
+	Text          string   `json:"text" ts:"string"`                        // The whole clause: "Action: spend 1 energy → gain 1 TR"
+}
+
+// CardBehaviorsExplainResponse is every behavior of a card as rules text, for tooltips
+type CardBehaviorsExplainResponse struct {
+	CardID   string              `json:"cardId" ts:"string"`
+	CardName string              `json:"cardName" ts:"string"`
+	Clauses  []BehaviorClauseDto `json:"clauses" ts:"BehaviorClauseDto[]"`
+}
+
+// PreviewActionType selects what a preview-action request dry-runs
+type PreviewActionType string
+
+const (
+	PreviewActionTypePlayCard   PreviewActionType = "play-card"
+	PreviewActionTypeCardAction PreviewActionType = "card-action"
+)
+
+// PreviewActionRequest is a card play or card action to dry-run, with the same choices the real action takes
+type PreviewActionRequest struct {
+	Type              PreviewActionType `json:"type" ts:"PreviewActionType"`
+	CardID            string            `json:"cardId" ts:"string"`
+	BehaviorIndex     int               `json:"behaviorIndex,omitempty" ts:"number | undefined"`   // card-action only
+	Payment           *CardPaymentDto   `json:"payment,omitempty" ts:"CardPaymentDto | undefined"` // play-card only
+	ChoiceIndex       *int              `json:"choiceIndex,omitempty" ts:"number | undefined"`
+	CardStorageTarget *string           `json:"cardStorageTarget,omitempty" ts:"string | undefined"`
+	TargetPlayerID    *string           `json:"targetPlayerId,omitempty" ts:"string | undefined"`
+	AttackAmount      *int              `json:"attackAmount,omitempty" ts:"number | undefined"`     // How much an "up to" removal takes
+	DiscardCardIDs    []string          `json:"discardCardIds,omitempty" ts:"string[] | undefined"` // Hand cards paying card-discard inputs
+}
+
+// PreviewActionResponse lists the log entries the action would write; nothing is committed
+// Cards entering or leaving hands are left out, so a preview never reveals the deck
+type PreviewActionResponse struct {
+	Diffs []StateDiffDto `json:"diffs" ts:"StateDiffDto[]"`
+}
+
+// CreateInviteRequest asks for an invite link reserving a named lobby seat; only the host may ask
+type CreateInviteRequest struct {
+	PlayerID string `json:"playerId" ts:"string"` // The host's player ID
+	SeatName string `json:"seatName" ts:"string"` // Name the invited player joins under
+}
+
+// InviteDto is an open lobby invite
+type InviteDto struct {
+	Token     string `json:"token" ts:"string"`
+	GameID    string `json:"gameId" ts:"string"`
+	SeatName  string `json:"seatName" ts:"string"`
+	URL       string `json:"url" ts:"string"` // Join link; absolute when the server knows its public URL, otherwise relative to the site
+	CreatedAt string `json:"createdAt" ts:"string"`
+}
+
+// InviteResponse wraps a single invite
+type InviteResponse struct {
+	Invite InviteDto `json:"invite" ts:"InviteDto"`
+}
+
+// ListInvitesResponse lists a lobby's open invites, oldest first
+type ListInvitesResponse struct {
+	Invites []InviteDto `json:"invites" ts:"InviteDto[]"`
+}
+
+// ReadinessResponse reports whether the server can take traffic; served with 503 until every check passes
+type ReadinessResponse struct {
+	Status string              `json:"status" ts:"string"` // "ready" or "not-ready"
+	Checks []ReadinessCheckDto `json:"checks" ts:"ReadinessCheckDto[]"`
+}
+
+// ReadinessCheckDto is the outcome of one readiness check
+type ReadinessCheckDto struct {
+	Name  string `json:"name" ts:"string"`
+	OK    bool   `json:"ok" ts:"boolean"`
+	Error string `json:"error,omitempty" ts:"string | undefined"`
+}
+
+// CapacityResponse reports how full the instance is; game creation answers 503 once active games reach the cap
+type CapacityResponse struct {
+	ActiveGames    int     `json:"activeGames" ts:"number"`    // Games in the lobby or in play
+	MaxActiveGames int     `json:"maxActiveGames" ts:"number"` // 0 means no cap
+	Utilization    float64 `json:"utilization" ts:"number"`    // ActiveGames / MaxActiveGames, 0 without a cap
+}
+
+// BuildInfoResponse identifies the running build, for bug reports
+type BuildInfoResponse struct {
+	GitSHA          string `json:"gitSha" ts:"string"`
+	Modified        bool   `json:"modified" ts:"boolean"` // Built from a tree with uncommitted changes
+	GoVersion       string `json:"goVersion" ts:"string"`
+	CardDataVersion string `json:"cardDataVersion" ts:"string"` // Fingerprint of the loaded card data
+}
+
 // ErrorResponse represents an error response
 type ErrorResponse struct {
 	Error   string `json:"error" ts:"string"`
@@ -65,3 +259,330 @@ type CreateDemoLobbyResponse struct {
 	Game     GameDto `json:"game" ts:"GameDto"`
 	PlayerID string  `json:"playerId" ts:"string"`
 }
+
+// SeedDemoGameRequest represents the request body for seeding a mid-game demo game
+type SeedDemoGameRequest struct {
+	PlayerName string   `json:"playerName,omitempty" ts:"string | undefined"`
+	BotCount   int      `json:"botCount,omitempty" ts:"number | undefined"`   // 1-4, default 2
+	Generation int      `json:"generation,omitempty" ts:"number | undefined"` // 1-10, default 5
+	CardPacks  []string `json:"cardPacks,omitempty" ts:"string[] | undefined"`
+	AccountID  string   `json:"accountId,omitempty" ts:"string | undefined"` // Seats are linked to it, so its owner can issue bot tokens
+}
+
+// SeedDemoGameResponse represents the response for seeding a demo game
+type SeedDemoGameResponse struct {
+	Game     GameDto  `json:"game" ts:"GameDto"`
+	PlayerID string   `json:"playerId" ts:"string"`
+	BotIDs   []string `json:"botIds" ts:"string[]"` // In seat order
+}
+
+// TutorialScenarioDto summarizes a tutorial scenario
+type TutorialScenarioDto struct {
+	ID          string `json:"id" ts:"string"`
+	Name        string `json:"name" ts:"string"`
+	Description string `json:"description" ts:"string"`
+	StepCount   int    `json:"stepCount" ts:"number"`
+}
+
+// ListTutorialsResponse represents the response for listing tutorial scenarios
+type ListTutorialsResponse struct {
+	Tutorials []TutorialScenarioDto `json:"tutorials" ts:"TutorialScenarioDto[]"`
+}
+
+// StartTutorialRequest represents the request body for starting a tutorial or puzzle
+type StartTutorialRequest struct {
+	PlayerName string `json:"playerName,omitempty" ts:"string | undefined"`
+	AccountID  string `json:"accountId,omitempty" ts:"string | undefined"` // Client-chosen ID credited with puzzle completion
+}
+
+// StartTutorialResponse represents the response for starting a tutorial or puzzle
+type StartTutorialResponse struct {
+	Game     GameDto `json:"game" ts:"GameDto"`
+	PlayerID string  `json:"playerId" ts:"string"`
+}
+
+// PuzzleDto summarizes a puzzle and whether the requesting account has solved it
+type PuzzleDto struct {
+	ID           string `json:"id" ts:"string"`
+	Name         string `json:"name" ts:"string"`
+	Description  string `json:"description" ts:"string"`
+	VictoryType  string `json:"victoryType" ts:"string"` // terraform-rating, victory-points
+	Target       int    `json:"target" ts:"number"`
+	ByGeneration int    `json:"byGeneration" ts:"number"`
+	Completed    bool   `json:"completed" ts:"boolean"`
+}
+
+// ListPuzzlesResponse represents the response for listing puzzles
+type ListPuzzlesResponse struct {
+	Puzzles []PuzzleDto `json:"puzzles" ts:"PuzzleDto[]"`
+}
+
+// CardAnalyticsResponse aggregates anonymized stats from games that opted into analytics
+type CardAnalyticsResponse struct {
+	Games        int                       `json:"games" ts:"number"`                           // Finished games recorded
+	Cards        []CardAnalyticsDto        `json:"cards" ts:"CardAnalyticsDto[]"`               // Ordered by card ID
+	Corporations []CorporationAnalyticsDto `json:"corporations" ts:"CorporationAnalyticsDto[]"` // Ordered by corporation ID
+}
+
+// CardAnalyticsDto is one card's research pick rate and finished-game record
+type CardAnalyticsDto struct {
+	CardID         string  `json:"cardId" ts:"string"`
+	Offered        int     `json:"offered" ts:"number"`
+	Bought         int     `json:"bought" ts:"number"`
+	PickRate       float64 `json:"pickRate" ts:"number"` // bought / offered, 0 when never offered
+	Played         int     `json:"played" ts:"number"`
+	PlayedByWinner int     `json:"playedByWinner" ts:"number"`
+	WinRate        float64 `json:"winRate" ts:"number"` // playedByWinner / played, 0 when never played
+}
+
+// CorporationAnalyticsDto is one corporation's finished-game record
+type CorporationAnalyticsDto struct {
+	CorporationID string  `json:"corporationId" ts:"string"`
+	Games         int     `json:"games" ts:"number"`
+	Wins          int     `json:"wins" ts:"number"` // Ties count as no win
+	WinRate       float64 `json:"winRate" ts:"number"`
+}
+
+// GameLogExportResponse is a finished game's complete log with the settings and results needed to archive it
+type GameLogExportResponse struct {
+	GameID          string              `json:"gameId" ts:"string"`
+	Status          GameStatus          `json:"status" ts:"GameStatus"` // completed or abandoned
+	Generation      int                 `json:"generation" ts:"number"`
+	Settings        GameSettingsDto     `json:"settings" ts:"GameSettingsDto"`
+	Players         []GameLogPlayerDto  `json:"players" ts:"GameLogPlayerDto[]"` // Seated at the end, in turn order
+	ConcededPlayers []ConcededPlayerDto `json:"concededPlayers" ts:"ConcededPlayerDto[]"`
+	FinalScores     []FinalScoreDto     `json:"finalScores" ts:"FinalScoreDto[]"`                        // Empty for abandoned games
+	Entries         []RecentActionDto   `json:"entries" ts:"RecentActionDto[]"`                          // Every log entry, oldest first
+	ShuffleProof    *ShuffleProofDto    `json:"shuffleProof,omitempty" ts:"ShuffleProofDto | undefined"` // Revealed deck seed; absent for unseeded decks
+}
+
+// ShuffleProofDto reveals a finished game's deck seed and starting order
+// Re-deriving the order from the seed and hashing both must give the commitment published at game start
+type ShuffleProofDto struct {
+	Algorithm    string   `json:"algorithm" ts:"string"`  // How the seed orders the cards, e.g. chacha8-fisher-yates-v1
+	Commitment   string   `json:"commitment" ts:"string"` // Hex SHA-256 published while the game ran
+	Seed         string   `json:"seed" ts:"string"`       // Hex 32-byte seed
+	ProjectCards []string `json:"projectCards" ts:"string[]"`
+	Corporations []string `json:"corporations" ts:"string[]"`
+	Preludes     []string `json:"preludes" ts:"string[]"`
+}
+
+// GameLogPlayerDto names a player and their corporation in a log export
+type GameLogPlayerDto struct {
+	PlayerID    string `json:"playerId" ts:"string"`
+	PlayerName  string `json:"playerName" ts:"string"`
+	Corporation string `json:"corporation" ts:"string"` // Corporation name; empty if none was chosen
+}
+
+// ListArchivedGamesResponse lists archived games, most recently finished first
+type ListArchivedGamesResponse struct {
+	Games []ArchivedGameDto `json:"games" ts:"ArchivedGameDto[]"`
+}
+
+// ArchivedGameDto is one archived game in the history listing
+type ArchivedGameDto struct {
+	GameID     string              `json:"gameId" ts:"string"`
+	Status     GameStatus          `json:"status" ts:"GameStatus"` // completed or abandoned
+	Generation int                 `json:"generation" ts:"number"`
+	FinishedAt string              `json:"finishedAt" ts:"string"`
+	Players    []ArchivedPlayerDto `json:"players" ts:"ArchivedPlayerDto[]"` // Seated players in turn order, then conceded players
+}
+
+// ArchivedPlayerDto is one seat's outcome in an archived game
+type ArchivedPlayerDto struct {
+	PlayerID    string `json:"playerId" ts:"string"`
+	PlayerName  string `json:"playerName" ts:"string"`
+	Corporation string `json:"corporation" ts:"string"` // Corporation name; empty if none was chosen
+	TotalVP     int    `json:"totalVP" ts:"number"`     // 0 for abandoned games
+	Placement   int    `json:"placement" ts:"number"`   // 0 for abandoned games
+	IsWinner    bool   `json:"isWinner" ts:"boolean"`
+	Conceded    bool   `json:"conceded" ts:"boolean"`
+}
+
+// ArchivedPlayerStatsResponse summarizes a player's completed archived games, matched by name
+type ArchivedPlayerStatsResponse struct {
+	PlayerName string  `json:"playerName" ts:"string"`
+	Games      int     `json:"games" ts:"number"`
+	Wins       int     `json:"wins" ts:"number"`
+	WinRate    float64 `json:"winRate" ts:"number"`
+	BestVP     int     `json:"bestVP" ts:"number"`
+	AverageVP  float64 `json:"averageVP" ts:"number"`
+}
+
+// AccountKeyResponse carries the key that proves ownership of an account; it is only ever returned once
+type AccountKeyResponse struct {
+	AccountKey string `json:"accountKey" ts:"string"`
+}
+
+// DeleteAccountResponse reports what an account data deletion removed
+type DeleteAccountResponse struct {
+	GamesAnonymized          int    `json:"gamesAnonymized" ts:"number"` // Archived games whose seats were renamed
+	PuzzleCompletionsRemoved int    `json:"puzzleCompletionsRemoved" ts:"number"`
+	DeletedAt                string `json:"deletedAt" ts:"string"`
+}
+
+// AdminListGamesResponse represents the admin game listing with memory estimates
+type AdminListGamesResponse struct {
+	Games          []AdminGameFootprintDto `json:"games" ts:"AdminGameFootprintDto[]"` // Largest estimate first
+	ThresholdBytes int64                   `json:"thresholdBytes" ts:"number"`         // Per-game alert threshold
+	HeapAllocBytes uint64                  `json:"heapAllocBytes" ts:"number"`         // Whole-process heap, for comparison with the estimates
+}
+
+// AdminGameFootprintDto is one game's estimated memory footprint
+type AdminGameFootprintDto struct {
+	GameID             string     `json:"gameId" ts:"string"`
+	Status             GameStatus `json:"status" ts:"GameStatus"`
+	Players            int        `json:"players" ts:"number"`
+	HandCards          int        `json:"handCards" ts:"number"`
+	PlayedCards        int        `json:"playedCards" ts:"number"`
+	Effects            int        `json:"effects" ts:"number"`
+	Actions            int        `json:"actions" ts:"number"`
+	Tiles              int        `json:"tiles" ts:"number"`
+	DeckCards          int        `json:"deckCards" ts:"number"`
+	EventSubscriptions int        `json:"eventSubscriptions" ts:"number"`
+	StateDiffs         int        `json:"stateDiffs" ts:"number"`
+	EstimatedBytes     int64      `json:"estimatedBytes" ts:"number"`
+	OverThreshold      bool       `json:"overThreshold" ts:"boolean"`
+}
+
+// AdminGameLogsResponse represents one game's buffered server log lines
+type AdminGameLogsResponse struct {
+	GameID  string                 `json:"gameId" ts:"string"`
+	Level   string                 `json:"level" ts:"string"`                   // Lowest level included
+	Entries []AdminGameLogEntryDto `json:"entries" ts:"AdminGameLogEntryDto[]"` // Oldest first
+}
+
+// AdminGameLogEntryDto is one server log line recorded for a game
+type AdminGameLogEntryDto struct {
+	Time    string                 `json:"time" ts:"string"`
+	Level   string                 `json:"level" ts:"string"`
+	Message string                 `json:"message" ts:"string"`
+	Caller  string                 `json:"caller,omitempty" ts:"string | undefined"`
+	Fields  map[string]interface{} `json:"fields,omitempty" ts:"Record<string, any> | undefined"`
+}
+
+// AdminCollusionFlagsResponse lists suspicious patterns in public games, for an operator to review
+type AdminCollusionFlagsResponse struct {
+	Heuristics []string                `json:"heuristics" ts:"string[]"` // Checks that ran: same-ip, targeted-attacks
+	MinAttacks int                     `json:"minAttacks" ts:"number"`   // Attacks at one opponent before targeted-attacks flags
+	Flags      []AdminCollusionFlagDto `json:"flags" ts:"AdminCollusionFlagDto[]"`
+}
+
+// AdminCollusionFlagDto is one flag raised by a collusion heuristic
+type AdminCollusionFlagDto struct {
+	GameID    string   `json:"gameId" ts:"string"`
+	Kind      string   `json:"kind" ts:"string"`        // same-ip or targeted-attacks
+	PlayerIDs []string `json:"playerIds" ts:"string[]"` // For targeted-attacks: the attacker, then the target
+	Detail    string   `json:"detail" ts:"string"`
+}
+
+// AdminGameAuditResponse lists the conservation invariants a live game breaks; empty when the game is sound
+type AdminGameAuditResponse struct {
+	GameID     string                   `json:"gameId" ts:"string"`
+	Violations []AdminAuditViolationDto `json:"violations" ts:"AdminAuditViolationDto[]"`
+}
+
+// AdminAuditViolationDto is one broken invariant
+type AdminAuditViolationDto struct {
+	Check  string `json:"check" ts:"string"` // cards, oceans, resources or shuffle
+	Detail string `json:"detail" ts:"string"`
+}
+
+// AdminAwardsResponse is every award's standings in a live game
+type AdminAwardsResponse struct {
+	GameID          string                   `json:"gameId" ts:"string"`
+	FreezeAtFunding bool                     `json:"freezeAtFunding" ts:"boolean"` // Awards funded from now on keep their funding-time standings
+	Awards          []AdminAwardStandingsDto `json:"awards" ts:"AdminAwardStandingsDto[]"`
+}
+
+// AdminAwardStandingsDto is one award's standings now and, when they were frozen, at funding
+type AdminAwardStandingsDto struct {
+	AwardType          string                  `json:"awardType" ts:"string"`
+	FundedBy           string                  `json:"fundedBy,omitempty" ts:"string | undefined"`                            // Unset when not funded
+	StandingsAtFunding []AdminAwardStandingDto `json:"standingsAtFunding,omitempty" ts:"AdminAwardStandingDto[] | undefined"` // Set only when frozen; these are what the award scores
+	CurrentStandings   []AdminAwardStandingDto `json:"currentStandings" ts:"AdminAwardStandingDto[]"`
+}
+
+// AdminAwardStandingDto is one player's score and place in an award
+type AdminAwardStandingDto struct {
+	PlayerID  string `json:"playerId" ts:"string"`
+	Score     int    `json:"score" ts:"number"`
+	Placement int    `json:"placement" ts:"number"` // 1, 2, or 0 for no placement
+}
+
+// AdminStateAtResponse is a game's logged state right after one log entry
+type AdminStateAtResponse struct {
+	GameID   string               `json:"gameId" ts:"string"`
+	Sequence int64                `json:"sequence" ts:"number"`
+	Entry    StateDiffDto         `json:"entry" ts:"StateDiffDto"` // The entry the state follows
+	State    AdminGameSnapshotDto `json:"state" ts:"AdminGameSnapshotDto"`
+}
+
+// AdminGameSnapshotDto is the part of a game's state its log records
+type AdminGameSnapshotDto struct {
+	Status              string                   `json:"status" ts:"string"`
+	Phase               string                   `json:"phase" ts:"string"`
+	Generation          int                      `json:"generation" ts:"number"`
+	CurrentTurnPlayerID string                   `json:"currentTurnPlayerId" ts:"string"`
+	Temperature         int                      `json:"temperature" ts:"number"`
+	Oxygen              int                      `json:"oxygen" ts:"number"`
+	Oceans              int                      `json:"oceans" ts:"number"`
+	Players             []AdminPlayerSnapshotDto `json:"players" ts:"AdminPlayerSnapshotDto[]"` // Sorted by player ID
+	Tiles               []AdminTileSnapshotDto   `json:"tiles" ts:"AdminTileSnapshotDto[]"`     // Sorted by hex ID
+}
+
+// AdminPlayerSnapshotDto is one player's logged state
+type AdminPlayerSnapshotDto struct {
+	PlayerID        string        `json:"playerId" ts:"string"`
+	Corporation     string        `json:"corporation" ts:"string"`
+	Resources       ResourcesDto  `json:"resources" ts:"ResourcesDto"`
+	Production      ProductionDto `json:"production" ts:"ProductionDto"`
+	TerraformRating int           `json:"terraformRating" ts:"number"`
+	Passed          bool          `json:"passed" ts:"boolean"`
+	Hand            []string      `json:"hand" ts:"string[]"`
+	PlayedCards     []string      `json:"playedCards" ts:"string[]"`
+}
+
+// AdminTileSnapshotDto is one occupied hex
+type AdminTileSnapshotDto struct {
+	HexID    string `json:"hexId" ts:"string"`
+	HexName  string `json:"hexName" ts:"string"`
+	TileType string `json:"tileType" ts:"string"`
+	OwnerID  string `json:"ownerId" ts:"string"`
+}
+
+// AdminWebSocketStatsResponse represents outgoing WebSocket traffic by wire format and message type
+type AdminWebSocketStatsResponse struct {
+	Connections     int                        `json:"connections" ts:"number"`
+	Wire            []AdminWireStatsDto        `json:"wire" ts:"AdminWireStatsDto[]"` // Only formats that have sent frames
+	Queue           AdminSendQueueStatsDto     `json:"queue" ts:"AdminSendQueueStatsDto"`
+	MessageTypes    []AdminMessageSizeStatsDto `json:"messageTypes" ts:"AdminMessageSizeStatsDto[]"` // Largest total first
+	SizeBudgetBytes int64                      `json:"sizeBudgetBytes" ts:"number"`                  // Single-message JSON size that logs a warning; 0 = off
+}
+
+// AdminWireStatsDto compares payload sizes of one encoding and compression combination
+type AdminWireStatsDto struct {
+	Encoding     string `json:"encoding" ts:"string"`
+	Compressed   bool   `json:"compressed" ts:"boolean"`
+	Messages     int64  `json:"messages" ts:"number"`
+	JSONBytes    int64  `json:"jsonBytes" ts:"number"`    // What the messages would have cost as JSON
+	EncodedBytes int64  `json:"encodedBytes" ts:"number"` // After encoding, before permessage-deflate
+	WireBytes    int64  `json:"wireBytes" ts:"number"`    // Estimated from sampled frames when compressed
+}
+
+// AdminMessageSizeStatsDto summarizes payload sizes of one outgoing message type
+type AdminMessageSizeStatsDto struct {
+	Type         string `json:"type" ts:"string"`
+	Messages     int64  `json:"messages" ts:"number"`
+	JSONBytes    int64  `json:"jsonBytes" ts:"number"`
+	MaxJSONBytes int64  `json:"maxJsonBytes" ts:"number"` // Largest single message
+}
+
+// AdminSendQueueStatsDto summarizes per-connection outgoing queues
+type AdminSendQueueStatsDto struct {
+	Queued          int64 `json:"queued" ts:"number"`
+	Coalesced       int64 `json:"coalesced" ts:"number"`       // Queued game states replaced by a newer one
+	Dropped         int64 `json:"dropped" ts:"number"`         // Discarded because a queue was full
+	SlowEvents      int64 `json:"slowEvents" ts:"number"`      // Times a connection fell behind
+	SlowConnections int64 `json:"slowConnections" ts:"number"` // Connections currently behind
+}
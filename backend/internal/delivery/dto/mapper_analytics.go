@@ -0,0 +1,44 @@
+package dto
+
+import "terraforming-mars-backend/internal/analytics"
+
+// ToCardAnalyticsResponse maps the analytics store's aggregates, adding pick and win rates
+func ToCardAnalyticsResponse(store *analytics.Store) CardAnalyticsResponse {
+	cardStats := store.Cards()
+	cards := make([]CardAnalyticsDto, len(cardStats))
+	for i, stats := range cardStats {
+		cards[i] = CardAnalyticsDto{
+			CardID:         stats.CardID,
+			Offered:        stats.Offered,
+			Bought:         stats.Bought,
+			PickRate:       rate(stats.Bought, stats.Offered),
+			Played:         stats.Played,
+			PlayedByWinner: stats.PlayedByWinner,
+			WinRate:        rate(stats.PlayedByWinner, stats.Played),
+		}
+	}
+
+	corpStats := store.Corporations()
+	corporations := make([]CorporationAnalyticsDto, len(corpStats))
+	for i, stats := range corpStats {
+		corporations[i] = CorporationAnalyticsDto{
+			CorporationID: stats.CorporationID,
+			Games:         stats.Games,
+			Wins:          stats.Wins,
+			WinRate:       rate(stats.Wins, stats.Games),
+		}
+	}
+
+	return CardAnalyticsResponse{
+		Games:        store.Games(),
+		Cards:        cards,
+		Corporations: corporations,
+	}
+}
+
+func rate(count, total int) float64 {
+	if total == 0 {
+		return 0
+	}
+	return float64(count) / float64(total)
+}
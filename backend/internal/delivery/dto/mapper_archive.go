@@ -0,0 +1,118 @@
+package dto
+
+import (
+	"terraforming-mars-backend/internal/archive"
+	"terraforming-mars-backend/internal/cards"
+	"terraforming-mars-backend/internal/game"
+)
+
+// ToListArchivedGamesResponse maps archive index entries, resolving corporation names
+func ToListArchivedGamesResponse(entries []archive.Entry, cardRegistry cards.CardRegistry) ListArchivedGamesResponse {
+	games := make([]ArchivedGameDto, len(entries))
+	for i, entry := range entries {
+		players := make([]ArchivedPlayerDto, len(entry.Players))
+		for j, p := range entry.Players {
+			players[j] = ArchivedPlayerDto{
+				PlayerID:    p.PlayerID,
+				PlayerName:  p.PlayerName,
+				Corporation: corporationName(p.CorporationID, cardRegistry),
+				TotalVP:     p.TotalVP,
+				Placement:   p.Placement,
+				IsWinner:    p.IsWinner,
+				Conceded:    p.Conceded,
+			}
+		}
+		games[i] = ArchivedGameDto{
+			GameID:     entry.GameID,
+			Status:     GameStatus(entry.Status),
+			Generation: entry.Generation,
+			FinishedAt: entry.FinishedAt.UTC().Format("2006-01-02T15:04:05.000Z"),
+			Players:    players,
+		}
+	}
+	return ListArchivedGamesResponse{Games: games}
+}
+
+// ToArchivedPlayerStatsResponse maps a player's archive stats, adding the win rate
+func ToArchivedPlayerStatsResponse(stats archive.PlayerStats) ArchivedPlayerStatsResponse {
+	return ArchivedPlayerStatsResponse{
+		PlayerName: stats.PlayerName,
+		Games:      stats.Games,
+		Wins:       stats.Wins,
+		WinRate:    rate(stats.Wins, stats.Games),
+		BestVP:     stats.BestVP,
+		AverageVP:  stats.AverageVP,
+	}
+}
+
+// ToArchivedGameLogExport maps an archived game to the same export as a finished game still in the hot store
+func ToArchivedGameLogExport(record archive.Record, cardRegistry cards.CardRegistry) GameLogExportResponse {
+	players := make([]GameLogPlayerDto, len(record.Players))
+	for i, p := range record.Players {
+		players[i] = GameLogPlayerDto{
+			PlayerID:    p.PlayerID,
+			PlayerName:  p.PlayerName,
+			Corporation: corporationName(p.CorporationID, cardRegistry),
+		}
+	}
+
+	finalScores := make([]FinalScoreDto, 0)
+	if record.Status == game.GameStatusCompleted {
+		for _, fs := range record.FinalScores {
+			finalScores = append(finalScores, ToFinalScoreDto(fs.PlayerID, fs.PlayerName, fs.Breakdown, fs.IsWinner, fs.Placement))
+		}
+	}
+
+	entries := make([]RecentActionDto, len(record.Log))
+	for i, diff := range record.Log {
+		entries[i] = toRecentActionDto(diff)
+	}
+
+	return GameLogExportResponse{
+		GameID:          record.GameID,
+		Status:          GameStatus(record.Status),
+		Generation:      record.Generation,
+		Settings:        ToGameSettingsDto(record.Settings),
+		Players:         players,
+		ConcededPlayers: ToConcededPlayerDtos(record.ConcededPlayers),
+		FinalScores:     finalScores,
+		Entries:         entries,
+		ShuffleProof:    toArchivedShuffleProofDto(record.ShuffleProof),
+	}
+}
+
+// toArchivedShuffleProofDto maps an archived deck seed; records archived before seeding have none
+func toArchivedShuffleProofDto(proof *archive.ShuffleProof) *ShuffleProofDto {
+	if proof == nil {
+		return nil
+	}
+	return &ShuffleProofDto{
+		Algorithm:    proof.Algorithm,
+		Commitment:   proof.Commitment,
+		Seed:         proof.Seed,
+		ProjectCards: proof.Order.ProjectCards,
+		Corporations: proof.Order.Corporations,
+		Preludes:     proof.Order.Preludes,
+	}
+}
+
+// ToDeleteAccountResponse maps an account deletion's audit record
+func ToDeleteAccountResponse(deletion archive.Deletion) DeleteAccountResponse {
+	return DeleteAccountResponse{
+		GamesAnonymized:          deletion.GamesAnonymized,
+		PuzzleCompletionsRemoved: deletion.PuzzleCompletionsRemoved,
+		DeletedAt:                deletion.DeletedAt.UTC().Format("2006-01-02T15:04:05.000Z"),
+	}
+}
+
+// corporationName resolves a corporation ID to its card name; empty when none was chosen or the card is unknown
+func corporationName(corporationID string, cardRegistry cards.CardRegistry) string {
+	if corporationID == "" {
+		return ""
+	}
+	card, err := cardRegistry.GetByID(corporationID)
+	if err != nil {
+		return ""
+	}
+	return card.Name
+}
@@ -12,6 +12,7 @@ import (
 
 // ToCardDto converts a Card to CardDto
 func ToCardDto(card gamecards.Card) CardDto {
+	startingResources, startingProduction := gamecards.StartingResources(&card)
 	return CardDto{
 		ID:                 card.ID,
 		Name:               card.Name,
@@ -24,8 +25,8 @@ func ToCardDto(card gamecards.Card) CardDto {
 		Behaviors:          mapSlice(card.Behaviors, toCardBehaviorDto),
 		ResourceStorage:    ptrCast(card.ResourceStorage, toResourceStorageDto),
 		VPConditions:       mapSlice(card.VPConditions, toVPConditionDto),
-		StartingResources:  ptrCast(card.StartingResources, toResourceSetDto),
-		StartingProduction: ptrCast(card.StartingProduction, toResourceSetDto),
+		StartingResources:  ptrCast(startingResources, toResourceSetDto),
+		StartingProduction: ptrCast(startingProduction, toResourceSetDto),
 	}
 }
 
@@ -80,6 +81,15 @@ func getPlayedCards(cardIDs []string, cardRegistry cards.CardRegistry) []CardDto
 	return cardDtos
 }
 
+// mapPlayedCards converts a player's played cards, marking the face-down events
+func mapPlayedCards(p *player.Player, cardRegistry cards.CardRegistry) []CardDto {
+	playedCards := getPlayedCards(p.PlayedCards().Cards(), cardRegistry)
+	for i := range playedCards {
+		playedCards[i].IsFaceDown = p.PlayedCards().IsFaceDown(playedCards[i].ID)
+	}
+	return playedCards
+}
+
 // Card-related helper functions for nested DTO conversions
 
 func toCardRequirementsDto(reqs *gamecards.CardRequirements) *CardRequirementsDto {
@@ -111,7 +121,15 @@ func toCardBehaviorDto(behavior shared.CardBehavior) CardBehaviorDto {
 		Outputs:                       mapSlice(behavior.Outputs, toResourceConditionDto),
 		Choices:                       mapSlice(behavior.Choices, toChoiceDto),
 		GenerationalEventRequirements: mapSlice(behavior.GenerationalEventRequirements, toGenerationalEventRequirementDto),
+		UsageLimit:                    toUsageLimitDto(behavior.UsageLimit),
+	}
+}
+
+func toUsageLimitDto(limit *shared.UsageLimit) *UsageLimitDto {
+	if limit == nil {
+		return nil
 	}
+	return &UsageLimitDto{Per: UsagePeriod(limit.Per), Times: limit.Times}
 }
 
 func toGenerationalEventRequirementDto(req shared.GenerationalEventRequirement) GenerationalEventRequirementDto {
@@ -156,9 +174,10 @@ func toMinMaxValueDto(v shared.MinMaxValue) MinMaxValueDto {
 
 func toTileRestrictionsDto(tr shared.TileRestrictions) TileRestrictionsDto {
 	return TileRestrictionsDto{
-		BoardTags:  tr.BoardTags,
-		Adjacency:  tr.Adjacency,
-		OnTileType: tr.OnTileType,
+		BoardTags:    tr.BoardTags,
+		Adjacency:    tr.Adjacency,
+		OnTileType:   tr.OnTileType,
+		CaptureBonus: tr.CaptureBonus,
 	}
 }
 
@@ -181,6 +200,7 @@ func toResourceConditionDto(rc shared.ResourceCondition) ResourceConditionDto {
 		MaxTrigger:       rc.MaxTrigger,
 		Per:              ptrCast(rc.Per, toPerConditionDto),
 		TileRestrictions: ptrCast(rc.TileRestrictions, toTileRestrictionsDto),
+		SpecialTileType:  rc.SpecialTileType,
 	}
 }
 
@@ -0,0 +1,16 @@
+package dto
+
+import "terraforming-mars-backend/internal/cards"
+
+// ToResolveCardsResponse converts card lookup matches, keeping their order
+func ToResolveCardsResponse(query string, matches []cards.CardMatch) ResolveCardsResponse {
+	dtos := make([]CardMatchDto, len(matches))
+	for i, match := range matches {
+		dtos[i] = CardMatchDto{
+			Card:      ToCardDto(match.Card),
+			Score:     match.Score,
+			MatchedBy: string(match.MatchedBy),
+		}
+	}
+	return ResolveCardsResponse{Query: query, Matches: dtos}
+}
@@ -2,59 +2,40 @@ package dto
 
 import (
 	"fmt"
+	"sort"
 
 	"terraforming-mars-backend/internal/cards"
 	"terraforming-mars-backend/internal/game"
 	"terraforming-mars-backend/internal/game/board"
 	gamecards "terraforming-mars-backend/internal/game/cards"
+	"terraforming-mars-backend/internal/game/parameters"
 	"terraforming-mars-backend/internal/game/player"
 )
 
 // ToGameDto converts migration Game to GameDto with personalized view
 // The playerID parameter determines which player is "currentPlayer" vs "otherPlayers"
+// To map the same game for several viewers, build one GameViewSnapshot and call ForViewer instead
 func ToGameDto(g *game.Game, cardRegistry cards.CardRegistry, playerID string) GameDto {
-	players := g.GetAllPlayers()
-
-	var currentPlayer PlayerDto
-	otherPlayers := make([]OtherPlayerDto, 0)
-
-	var viewingPlayer *player.Player
-	for _, p := range players {
-		if p.ID() == playerID {
-			viewingPlayer = p
-			currentPlayer = ToPlayerDto(p, g, cardRegistry)
-		} else {
-			otherPlayers = append(otherPlayers, ToOtherPlayerDto(p, g, cardRegistry))
-		}
-	}
-
-	if viewingPlayer == nil && len(players) > 0 {
-		otherPlayers = make([]OtherPlayerDto, 0)
-		currentPlayer = ToPlayerDto(players[0], g, cardRegistry)
-		for i := 1; i < len(players); i++ {
-			otherPlayers = append(otherPlayers, ToOtherPlayerDto(players[i], g, cardRegistry))
-		}
-		playerID = players[0].ID()
-	}
+	return NewGameViewSnapshot(g, cardRegistry).ForViewer(playerID)
+}
 
-	settings := g.Settings()
-	settingsDto := GameSettingsDto{
-		MaxPlayers:      settings.MaxPlayers,
-		DevelopmentMode: settings.DevelopmentMode,
-		DemoGame:        settings.DemoGame,
-		CardPacks:       settings.CardPacks,
-	}
+// toGameBaseDto maps everything in a GameDto that does not depend on the viewing player
+// Triggered effects are included and cleared only when consumeEffects is set
+func toGameBaseDto(g *game.Game, cardRegistry cards.CardRegistry, consumeEffects bool) GameDto {
+	settingsDto := ToGameSettingsDto(g.Settings())
 
 	globalParams := g.GlobalParameters()
 	globalParamsDto := GlobalParametersDto{
 		Temperature: globalParams.Temperature(),
 		Oxygen:      globalParams.Oxygen(),
 		Oceans:      globalParams.Oceans(),
+		Tracks:      toGlobalParameterTrackDtos(),
 	}
 
-	board := g.Board()
-	tiles := board.Tiles()
+	gameBoard := g.Board()
+	tiles := gameBoard.Tiles()
 	tileDtos := make([]TileDto, len(tiles))
+	playerColors := g.PlayerColors()
 	for i, tile := range tiles {
 		tileDtos[i] = TileDto{
 			Coordinates: HexPositionDto{
@@ -68,12 +49,19 @@ func ToGameDto(g *game.Game, cardRegistry cards.CardRegistry, playerID string) G
 			Bonuses:     convertTileBonuses(tile.Bonuses),
 			Location:    string(tile.Location),
 			DisplayName: tile.DisplayName,
+			Label:       board.HexLabel(tile.Coordinates),
 			ReservedBy:  tile.ReservedBy,
 		}
+		if tile.OwnerID != nil {
+			if color, ok := playerColors[*tile.OwnerID]; ok {
+				tileDtos[i].OwnerColor = &color
+			}
+		}
 		if tile.OccupiedBy != nil {
 			occupant := &TileOccupantDto{
-				Type: string(tile.OccupiedBy.Type),
-				Tags: tile.OccupiedBy.Tags,
+				Type:            string(tile.OccupiedBy.Type),
+				Tags:            tile.OccupiedBy.Tags,
+				SpecialTileType: tile.OccupiedBy.SpecialTileType,
 			}
 			tileDtos[i].OccupiedBy = occupant
 		}
@@ -101,7 +89,10 @@ func ToGameDto(g *game.Game, cardRegistry cards.CardRegistry, playerID string) G
 		}
 	}
 
-	triggeredEffects := g.GetTriggeredEffects()
+	var triggeredEffects []game.TriggeredEffect
+	if consumeEffects {
+		triggeredEffects = g.GetTriggeredEffects()
+	}
 	var triggeredEffectDtos []TriggeredEffectDto
 	if len(triggeredEffects) > 0 {
 		triggeredEffectDtos = make([]TriggeredEffectDto, len(triggeredEffects))
@@ -118,12 +109,21 @@ func ToGameDto(g *game.Game, cardRegistry cards.CardRegistry, playerID string) G
 		HostPlayerID:     g.HostPlayerID(),
 		CurrentPhase:     GamePhase(g.CurrentPhase()),
 		GlobalParameters: globalParamsDto,
-		CurrentPlayer:    currentPlayer,
-		OtherPlayers:     otherPlayers,
-		ViewingPlayerID:  playerID, // The player viewing this game state
 		CurrentTurn:      getCurrentTurnPlayerID(g),
 		Generation:       g.Generation(),
 		TurnOrder:        g.TurnOrder(),
+		RandomizeSeats:   g.RandomizeSeatOrder(),
+		Handicaps:        ToHandicapDtos(g.Handicaps()),
+		Pause:            ToPauseDto(g),
+		AbandonVotes:     g.AbandonVotes(),
+		ConcededPlayers:  ToConcededPlayerDtos(g.ConcededPlayers()),
+		ResearchDeadline: toResearchDeadline(g),
+		StartingDeadline: toStartingDeadline(g),
+		PhaseStartedAt:   g.PhaseStartedAt().UTC().Format("2006-01-02T15:04:05.000Z"),
+		TurnStartedAt:    toTurnStartedAt(g),
+		TurnDeadline:     toTurnDeadline(g),
+		Timebanks:        toTimebanks(g),
+		WaitingOn:        g.WaitingOn(),
 		Board: BoardDto{
 			Tiles: tileDtos,
 		},
@@ -133,6 +133,54 @@ func ToGameDto(g *game.Game, cardRegistry cards.CardRegistry, playerID string) G
 		AwardResults:     ToAwardResultsDto(g, cardRegistry),
 		FinalScores:      finalScoreDtos,
 		TriggeredEffects: triggeredEffectDtos,
+		CardPiles:        ToCardPilesDto(g),
+		DeckCommitment:   deckCommitment(g),
+	}
+}
+
+// deckCommitment returns the commitment to the game's deck order, or "" without a seeded deck
+func deckCommitment(g *game.Game) string {
+	if d := g.Deck(); d != nil {
+		return d.Commitment()
+	}
+	return ""
+}
+
+// ToCardPilesDto counts the cards in the deck piles and in each player's played piles
+func ToCardPilesDto(g *game.Game) CardPilesDto {
+	piles := CardPilesDto{Players: make(map[string]PlayerCardPilesDto)}
+	if d := g.Deck(); d != nil {
+		piles.Deck = d.GetAvailableCardCount()
+		piles.Discard = len(d.DiscardPile())
+		piles.Corporations = len(d.Corporations())
+		piles.DeckCycles = d.ShuffleCount()
+		piles.Shortfall = d.Shortfall()
+		if g.Settings().PreludesEnabled() {
+			preludes := len(d.PreludeCards())
+			piles.Preludes = &preludes
+		}
+	}
+	for _, p := range g.GetAllPlayers() {
+		events := len(p.PlayedCards().FaceDownCards())
+		piles.Players[p.ID()] = PlayerCardPilesDto{
+			FaceUp: p.PlayedCards().Count() - events,
+			Events: events,
+		}
+	}
+	return piles
+}
+
+// ToGameSettingsDto converts game settings to DTO
+func ToGameSettingsDto(settings game.GameSettings) GameSettingsDto {
+	return GameSettingsDto{
+		MaxPlayers:      settings.MaxPlayers,
+		DevelopmentMode: settings.DevelopmentMode,
+		DemoGame:        settings.DemoGame,
+		PassAndPlay:     settings.PassAndPlay,
+		Analytics:       settings.Analytics,
+		CardPacks:       settings.CardPacks,
+		ColorPalette:    settings.ColorPalette,
+		RulesOptions:    ToRulesOptionsDto(settings.RulesOptions),
 	}
 }
 
@@ -229,7 +277,10 @@ func ToAwardResultsDto(g *game.Game, cardRegistry cards.CardRegistry) []AwardRes
 	results := make([]AwardResultDto, 0, len(fundedAwards))
 
 	for _, funded := range fundedAwards {
-		placements := gamecards.ScoreAward(funded.Type, g.GetAllPlayers(), g.Board(), cardRegistry)
+		placements := funded.StandingsAtFunding
+		if placements == nil {
+			placements = gamecards.ScoreAward(funded.Type, g.GetAllPlayers(), g.Board(), cardRegistry)
+		}
 
 		firstPlace := make([]string, 0)
 		secondPlace := make([]string, 0)
@@ -381,3 +432,276 @@ func ToTriggeredEffectDto(effect game.TriggeredEffect) TriggeredEffectDto {
 		Outputs:  outputDtos,
 	}
 }
+
+// ToRulesOptionsDto converts game rules options to DTO format
+func ToRulesOptionsDto(options game.RulesOptions) RulesOptionsDto {
+	return RulesOptionsDto{
+		DraftVariant:      options.DraftVariant,
+		FastMode:          options.FastMode,
+		ShowTimers:        options.ShowTimers,
+		MilestoneAwardSet: options.MilestoneAwardSet,
+		SoloTRDecay:       options.SoloTRDecay,
+
+		SpeedPreset:                     options.SpeedPreset,
+		TurnTimerSeconds:                options.TurnTimerSeconds,
+		TimebankSeconds:                 options.TimebankSeconds,
+		ResearchTimeoutSeconds:          options.ResearchTimeoutSeconds,
+		StartingSelectionTimeoutSeconds: options.StartingSelectionTimeoutSeconds,
+		MulliganStartingHand:            options.MulliganStartingHand,
+
+		SoloGoal:         options.SoloGoal,
+		SoloNeutralTiles: options.SoloNeutralTiles,
+
+		LiveScores:              options.LiveScores,
+		LiveScoresExcludeEvents: options.LiveScoresExcludeEvents,
+
+		MilestoneVP:        options.MilestoneVP,
+		AwardFirstPlaceVP:  options.AwardFirstPlaceVP,
+		AwardSecondPlaceVP: options.AwardSecondPlaceVP,
+	}
+}
+
+// FromRulesOptionsDto converts a rules options DTO to game rules options
+func FromRulesOptionsDto(options RulesOptionsDto) game.RulesOptions {
+	return game.RulesOptions{
+		DraftVariant:      options.DraftVariant,
+		FastMode:          options.FastMode,
+		ShowTimers:        options.ShowTimers,
+		MilestoneAwardSet: options.MilestoneAwardSet,
+		SoloTRDecay:       options.SoloTRDecay,
+
+		SpeedPreset:                     options.SpeedPreset,
+		TurnTimerSeconds:                options.TurnTimerSeconds,
+		TimebankSeconds:                 options.TimebankSeconds,
+		ResearchTimeoutSeconds:          options.ResearchTimeoutSeconds,
+		StartingSelectionTimeoutSeconds: options.StartingSelectionTimeoutSeconds,
+		MulliganStartingHand:            options.MulliganStartingHand,
+
+		SoloGoal:         options.SoloGoal,
+		SoloNeutralTiles: options.SoloNeutralTiles,
+
+		LiveScores:              options.LiveScores,
+		LiveScoresExcludeEvents: options.LiveScoresExcludeEvents,
+
+		MilestoneVP:        options.MilestoneVP,
+		AwardFirstPlaceVP:  options.AwardFirstPlaceVP,
+		AwardSecondPlaceVP: options.AwardSecondPlaceVP,
+	}
+}
+
+// ToHandicapDtos converts per-player handicaps to DTOs
+func ToHandicapDtos(handicaps map[string]game.Handicap) map[string]HandicapDto {
+	result := make(map[string]HandicapDto, len(handicaps))
+	for playerID, h := range handicaps {
+		result[playerID] = HandicapDto{
+			ExtraCredits: h.ExtraCredits,
+			ExtraCards:   h.ExtraCards,
+			ExtraTR:      h.ExtraTR,
+		}
+	}
+	return result
+}
+
+// ToConcededPlayerDtos converts the game's record of conceded players to DTOs
+func ToConcededPlayerDtos(conceded []game.ConcededPlayer) []ConcededPlayerDto {
+	dtos := make([]ConcededPlayerDto, len(conceded))
+	for i, c := range conceded {
+		dtos[i] = ConcededPlayerDto{
+			PlayerID:   c.PlayerID,
+			PlayerName: c.PlayerName,
+			ConcededAt: c.ConcededAt.UTC().Format("2006-01-02T15:04:05.000Z"),
+		}
+	}
+	return dtos
+}
+
+// ToPauseDto converts a game's paused state and pending votes to a DTO
+func ToPauseDto(g *game.Game) PauseDto {
+	pauseDto := PauseDto{Votes: g.PauseVotes()}
+	if state := g.PauseState(); state != nil {
+		pauseDto.Paused = true
+		pauseDto.PausedBy = state.PausedBy
+		pauseDto.PausedAt = state.PausedAt.UTC().Format("2006-01-02T15:04:05.000Z")
+	}
+	return pauseDto
+}
+
+// toResearchDeadline formats the production phase card-buying deadline, or "" when there is none
+func toResearchDeadline(g *game.Game) string {
+	deadline, ok := g.ResearchDeadline()
+	if !ok {
+		return ""
+	}
+	return deadline.UTC().Format("2006-01-02T15:04:05.000Z")
+}
+
+// toStartingDeadline formats the starting selection deadline, or "" when there is none
+func toStartingDeadline(g *game.Game) string {
+	deadline, ok := g.StartingSelectionDeadline()
+	if !ok {
+		return ""
+	}
+	return deadline.UTC().Format("2006-01-02T15:04:05.000Z")
+}
+
+// toTurnStartedAt formats when the current turn began, or "" when nobody has the turn
+func toTurnStartedAt(g *game.Game) string {
+	startedAt, ok := g.TurnStartedAt()
+	if !ok {
+		return ""
+	}
+	return startedAt.UTC().Format("2006-01-02T15:04:05.000Z")
+}
+
+// toTurnDeadline formats when the current turn is skipped for running out of time, or "" when there is none
+func toTurnDeadline(g *game.Game) string {
+	deadline, ok := g.TurnDeadline()
+	if !ok {
+		return ""
+	}
+	return deadline.UTC().Format("2006-01-02T15:04:05.000Z")
+}
+
+// toTimebanks lists each player's remaining timebank in seconds, or nil when the game has no timebank
+func toTimebanks(g *game.Game) map[string]int {
+	if g.Settings().RulesOptions.TimebankSeconds <= 0 {
+		return nil
+	}
+	timebanks := make(map[string]int)
+	for _, p := range g.GetAllPlayers() {
+		timebanks[p.ID()] = int(g.TimebankRemaining(p.ID()).Seconds())
+	}
+	return timebanks
+}
+
+// toGlobalParameterTrackDtos describes the parameters a game tracks, so clients need not hard-code bounds
+func toGlobalParameterTrackDtos() []GlobalParameterTrackDto {
+	tracked := []parameters.Definition{parameters.Temperature, parameters.Oxygen, parameters.Oceans}
+	tracks := make([]GlobalParameterTrackDto, len(tracked))
+	for i, d := range tracked {
+		tracks[i] = GlobalParameterTrackDto{
+			Parameter: d.Name,
+			Unit:      d.Unit,
+			Min:       d.Min,
+			Max:       d.Max,
+			Step:      d.Step,
+			TRPerStep: d.TRPerStep,
+		}
+	}
+	return tracks
+}
+
+// ToGameSummaryDto derives the scoreboard, board summary, tag counts and available actions
+// from an already-mapped game view, so the summary never re-reads the game itself
+func ToGameSummaryDto(view GameDto) GameSummaryDto {
+	summary := GameSummaryDto{
+		GameID:           view.ID,
+		Status:           view.Status,
+		Phase:            view.CurrentPhase,
+		Generation:       view.Generation,
+		Scoreboard:       make([]ScoreboardEntryDto, 0, len(view.OtherPlayers)+1),
+		TagCounts:        make(map[string]map[string]int),
+		AvailableActions: make([]string, 0),
+		Board: BoardSummaryDto{
+			Temperature:  view.GlobalParameters.Temperature,
+			Oxygen:       view.GlobalParameters.Oxygen,
+			Oceans:       view.GlobalParameters.Oceans,
+			TilesByType:  make(map[string]int),
+			TilesByOwner: make(map[string]map[string]int),
+		},
+	}
+
+	if view.CurrentPlayer.ID != "" {
+		p := view.CurrentPlayer
+		summary.Scoreboard = append(summary.Scoreboard, ScoreboardEntryDto{
+			PlayerID:        p.ID,
+			Name:            p.Name,
+			Color:           p.Color,
+			TerraformRating: p.TerraformRating,
+			PlayedCards:     len(p.PlayedCards),
+			Passed:          p.Passed,
+		})
+		summary.TagCounts[p.ID] = countPlayedTags(p.Corporation, p.PlayedCards)
+		summary.AvailableActions = availableActionsFor(p)
+	}
+	for _, p := range view.OtherPlayers {
+		summary.Scoreboard = append(summary.Scoreboard, ScoreboardEntryDto{
+			PlayerID:        p.ID,
+			Name:            p.Name,
+			Color:           p.Color,
+			TerraformRating: p.TerraformRating,
+			PlayedCards:     len(p.PlayedCards),
+			Passed:          p.Passed,
+		})
+		summary.TagCounts[p.ID] = countPlayedTags(p.Corporation, p.PlayedCards)
+	}
+	sort.SliceStable(summary.Scoreboard, func(i, j int) bool {
+		return summary.Scoreboard[i].TerraformRating > summary.Scoreboard[j].TerraformRating
+	})
+
+	for _, tile := range view.Board.Tiles {
+		if tile.OccupiedBy == nil {
+			summary.Board.FreeTiles++
+			continue
+		}
+		summary.Board.TilesByType[tile.OccupiedBy.Type]++
+		if tile.OwnerID != nil {
+			if summary.Board.TilesByOwner[*tile.OwnerID] == nil {
+				summary.Board.TilesByOwner[*tile.OwnerID] = make(map[string]int)
+			}
+			summary.Board.TilesByOwner[*tile.OwnerID][tile.OccupiedBy.Type]++
+		}
+	}
+
+	return summary
+}
+
+func countPlayedTags(corporation *CardDto, playedCards []CardDto) map[string]int {
+	counts := make(map[string]int)
+	if corporation != nil {
+		for _, tag := range corporation.Tags {
+			counts[string(tag)]++
+		}
+	}
+	for _, card := range playedCards {
+		if card.IsFaceDown {
+			counts[string(TagEvent)]++
+			continue
+		}
+		for _, tag := range card.Tags {
+			counts[string(tag)]++
+		}
+	}
+	return counts
+}
+
+// availableActionsFor lists what the player can do now, as "<kind>:<id>" entries
+func availableActionsFor(p PlayerDto) []string {
+	actions := make([]string, 0)
+	for _, card := range p.Cards {
+		if card.Available {
+			actions = append(actions, "play-card:"+card.ID)
+		}
+	}
+	for _, action := range p.Actions {
+		if action.Available {
+			actions = append(actions, fmt.Sprintf("card-action:%s[%d]", action.CardID, action.BehaviorIndex))
+		}
+	}
+	for _, project := range p.StandardProjects {
+		if project.Available {
+			actions = append(actions, "standard-project:"+project.ProjectType)
+		}
+	}
+	for _, milestone := range p.Milestones {
+		if milestone.Available {
+			actions = append(actions, "milestone:"+milestone.Type)
+		}
+	}
+	for _, award := range p.Awards {
+		if award.Available {
+			actions = append(actions, "award:"+award.Type)
+		}
+	}
+	return actions
+}
@@ -0,0 +1,28 @@
+package dto
+
+import (
+	"terraforming-mars-backend/internal/game"
+)
+
+// ToGenerationSummaryPayload maps a generation recap, naming players as the game knows them
+func ToGenerationSummaryPayload(g *game.Game, summary game.GenerationSummary) GenerationSummaryPayload {
+	players := make([]PlayerGenerationSummaryDto, len(summary.Players))
+	for i, ps := range summary.Players {
+		name := ps.PlayerID
+		if p, err := g.GetPlayer(ps.PlayerID); err == nil {
+			name = p.Name()
+		}
+		players[i] = PlayerGenerationSummaryDto{
+			PlayerID:        ps.PlayerID,
+			PlayerName:      name,
+			Income:          toResourcesDto(ps.Income),
+			EnergyConverted: ps.EnergyConverted,
+			CardsBought:     ps.CardsBought,
+			TRDelta:         ps.TRDelta,
+		}
+	}
+	return GenerationSummaryPayload{
+		Generation: summary.Generation,
+		Players:    players,
+	}
+}
@@ -0,0 +1,31 @@
+package dto
+
+import (
+	"net/url"
+
+	"terraforming-mars-backend/internal/game"
+)
+
+// ToInviteDto maps an invite, building its join link on publicURL (relative when empty)
+// The link opens the game's join screen with the seat name filled in, like the lobby's own join link
+func ToInviteDto(gameID string, invite game.Invite, publicURL string) InviteDto {
+	query := url.Values{}
+	query.Set("type", "join")
+	query.Set("invite", invite.Token)
+	return InviteDto{
+		Token:     invite.Token,
+		GameID:    gameID,
+		SeatName:  invite.SeatName,
+		URL:       publicURL + "/game/" + url.PathEscape(gameID) + "?" + query.Encode(),
+		CreatedAt: invite.CreatedAt.UTC().Format("2006-01-02T15:04:05.000Z"),
+	}
+}
+
+// ToListInvitesResponse maps a lobby's open invites
+func ToListInvitesResponse(gameID string, invites []game.Invite, publicURL string) ListInvitesResponse {
+	dtos := make([]InviteDto, len(invites))
+	for i, invite := range invites {
+		dtos[i] = ToInviteDto(gameID, invite, publicURL)
+	}
+	return ListInvitesResponse{Invites: dtos}
+}
@@ -0,0 +1,168 @@
+package dto
+
+import (
+	"fmt"
+	"strings"
+
+	"terraforming-mars-backend/internal/cards"
+	"terraforming-mars-backend/internal/game"
+	"terraforming-mars-backend/internal/game/deck"
+)
+
+// ToGameLogExportResponse maps a finished game and its full log for export
+// Players are listed in final turn order, and final scores are only filled in for completed games
+func ToGameLogExportResponse(g *game.Game, diffs []game.StateDiff, cardRegistry cards.CardRegistry) GameLogExportResponse {
+	players := make([]GameLogPlayerDto, 0, len(g.TurnOrder()))
+	for _, playerID := range g.TurnOrder() {
+		p, err := g.GetPlayer(playerID)
+		if err != nil {
+			continue
+		}
+		entry := GameLogPlayerDto{PlayerID: p.ID(), PlayerName: p.Name()}
+		if corporation := getCorporationCard(p, cardRegistry); corporation != nil {
+			entry.Corporation = corporation.Name
+		}
+		players = append(players, entry)
+	}
+
+	finalScores := make([]FinalScoreDto, 0)
+	if g.Status() == game.GameStatusCompleted {
+		for _, fs := range g.GetFinalScores() {
+			finalScores = append(finalScores, ToFinalScoreDto(fs.PlayerID, fs.PlayerName, fs.Breakdown, fs.IsWinner, fs.Placement))
+		}
+	}
+
+	entries := make([]RecentActionDto, len(diffs))
+	for i, diff := range diffs {
+		entries[i] = toRecentActionDto(diff)
+	}
+
+	return GameLogExportResponse{
+		GameID:          g.ID(),
+		Status:          GameStatus(g.Status()),
+		Generation:      g.Generation(),
+		Settings:        ToGameSettingsDto(g.Settings()),
+		Players:         players,
+		ConcededPlayers: ToConcededPlayerDtos(g.ConcededPlayers()),
+		FinalScores:     finalScores,
+		Entries:         entries,
+		ShuffleProof:    toShuffleProofDto(g),
+	}
+}
+
+// toShuffleProofDto reveals the deck seed; exports only exist for finished games, so nothing is left to draw
+func toShuffleProofDto(g *game.Game) *ShuffleProofDto {
+	d := g.Deck()
+	if d == nil {
+		return nil
+	}
+	seed, order, ok := d.Reveal()
+	if !ok {
+		return nil
+	}
+	return &ShuffleProofDto{
+		Algorithm:    deck.ShuffleAlgorithm,
+		Commitment:   d.Commitment(),
+		Seed:         seed.String(),
+		ProjectCards: order.ProjectCards,
+		Corporations: order.Corporations,
+		Preludes:     order.Preludes,
+	}
+}
+
+// RenderGameLogText renders a log export as plain text, for pasting into chat or archiving league results
+func RenderGameLogText(export GameLogExportResponse) string {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "Terraforming Mars game %s\n", export.GameID)
+	fmt.Fprintf(&b, "Status: %s after generation %d\n", export.Status, export.Generation)
+	if len(export.Settings.CardPacks) > 0 {
+		fmt.Fprintf(&b, "Card packs: %s\n", strings.Join(export.Settings.CardPacks, ", "))
+	}
+	fmt.Fprintf(&b, "Rules: %s\n", rulesLine(export.Settings.RulesOptions))
+	if proof := export.ShuffleProof; proof != nil {
+		fmt.Fprintf(&b, "Deck commitment: %s\n", proof.Commitment)
+		fmt.Fprintf(&b, "Deck seed: %s (%s)\n", proof.Seed, proof.Algorithm)
+	}
+
+	b.WriteString("\nPlayers\n")
+	for _, p := range export.Players {
+		if p.Corporation != "" {
+			fmt.Fprintf(&b, "  %s (%s)\n", p.PlayerName, p.Corporation)
+		} else {
+			fmt.Fprintf(&b, "  %s\n", p.PlayerName)
+		}
+	}
+	for _, p := range export.ConcededPlayers {
+		fmt.Fprintf(&b, "  %s (conceded)\n", p.PlayerName)
+	}
+
+	if len(export.FinalScores) > 0 {
+		b.WriteString("\nFinal scores\n")
+		for _, score := range export.FinalScores {
+			vp := score.VPBreakdown
+			fmt.Fprintf(&b, "  %d. %s %d VP (TR %d, cards %d, milestones %d, awards %d, greeneries %d, cities %d)",
+				score.Placement, score.PlayerName, vp.TotalVP, vp.TerraformRating, vp.CardVP,
+				vp.MilestoneVP, vp.AwardVP, vp.GreeneryVP, vp.CityVP)
+			if score.IsWinner {
+				b.WriteString(" - winner")
+			}
+			b.WriteString("\n")
+		}
+	}
+
+	b.WriteString("\nLog\n")
+	for _, entry := range export.Entries {
+		fmt.Fprintf(&b, "  %4d  %s  %s\n", entry.SequenceNumber, entry.Timestamp, entry.Summary.Text)
+	}
+
+	return b.String()
+}
+
+// rulesLine lists the enabled rule variants: "tharsis milestones and awards, draft variant"
+func rulesLine(rules RulesOptionsDto) string {
+	var parts []string
+	if rules.MilestoneAwardSet != "" {
+		parts = append(parts, rules.MilestoneAwardSet+" milestones and awards")
+	}
+	if rules.DraftVariant {
+		parts = append(parts, "draft variant")
+	}
+	if rules.FastMode {
+		parts = append(parts, "fast mode")
+	}
+	if rules.SoloTRDecay {
+		parts = append(parts, "solo TR decay")
+	}
+	switch rules.SoloGoal {
+	case game.SoloGoalTerraform:
+		parts = append(parts, "solo terraforming goal")
+	case game.SoloGoalTR63:
+		parts = append(parts, "solo TR 63 goal")
+	}
+	if rules.SoloNeutralTiles {
+		parts = append(parts, "solo neutral tiles")
+	}
+	if rules.MulliganStartingHand {
+		parts = append(parts, "starting hand mulligan")
+	}
+	if rules.SpeedPreset != "" {
+		parts = append(parts, rules.SpeedPreset+" speed")
+	}
+	if rules.TurnTimerSeconds > 0 {
+		parts = append(parts, fmt.Sprintf("%ds turn timer", rules.TurnTimerSeconds))
+	}
+	if rules.TimebankSeconds > 0 {
+		parts = append(parts, fmt.Sprintf("%ds timebank", rules.TimebankSeconds))
+	}
+	if rules.ResearchTimeoutSeconds > 0 {
+		parts = append(parts, fmt.Sprintf("%ds research timeout", rules.ResearchTimeoutSeconds))
+	}
+	if rules.StartingSelectionTimeoutSeconds > 0 {
+		parts = append(parts, fmt.Sprintf("%ds starting selection timeout", rules.StartingSelectionTimeoutSeconds))
+	}
+	if len(parts) == 0 {
+		return "standard"
+	}
+	return strings.Join(parts, ", ")
+}
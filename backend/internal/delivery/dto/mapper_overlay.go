@@ -0,0 +1,36 @@
+package dto
+
+// ToGameOverlayDto maps a snapshot to a stream overlay from every player's public view
+// Unlike ForViewer it never maps a private view, so nothing hidden can reach a spectator
+func ToGameOverlayDto(snapshot *GameViewSnapshot) GameOverlayDto {
+	base := snapshot.base
+	overlay := GameOverlayDto{
+		GameID:           base.ID,
+		Status:           base.Status,
+		Phase:            base.CurrentPhase,
+		Generation:       base.Generation,
+		GlobalParameters: base.GlobalParameters,
+		CurrentTurn:      base.CurrentTurn,
+		Players:          make([]OverlayPlayerDto, 0, len(snapshot.players)),
+	}
+
+	for _, p := range snapshot.players {
+		public := snapshot.publicView(p)
+		entry := OverlayPlayerDto{
+			PlayerID:        public.ID,
+			Name:            public.Name,
+			Color:           public.Color,
+			TerraformRating: public.TerraformRating,
+			Resources:       public.Resources,
+			Production:      public.Production,
+			HandCardCount:   public.HandCardCount,
+			Passed:          public.Passed,
+		}
+		if public.Corporation != nil {
+			entry.Corporation = public.Corporation.Name
+		}
+		overlay.Players = append(overlay.Players, entry)
+	}
+
+	return overlay
+}
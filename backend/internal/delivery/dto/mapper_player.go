@@ -52,8 +52,7 @@ func ToPlayerDto(p *player.Player, g *game.Game, cardRegistry cards.CardRegistry
 	production := resourcesComponent.Production()
 
 	corporation := getCorporationCard(p, cardRegistry)
-	playedCardIDs := p.PlayedCards().Cards()
-	playedCards := getPlayedCards(playedCardIDs, cardRegistry)
+	playedCards := mapPlayedCards(p, cardRegistry)
 	handCards := mapPlayerCards(p)
 	standardProjects := mapPlayerStandardProjects(p, g, cardRegistry)
 	milestones := mapPlayerMilestones(p, g, cardRegistry)
@@ -69,6 +68,7 @@ func ToPlayerDto(p *player.Player, g *game.Game, cardRegistry cards.CardRegistry
 	return PlayerDto{
 		ID:               p.ID(),
 		Name:             p.Name(),
+		Color:            g.PlayerColor(p.ID()),
 		Resources:        toResourcesDto(resources),
 		Production:       toProductionDto(production),
 		TerraformRating:  resourcesComponent.TerraformRating(),
@@ -79,26 +79,45 @@ func ToPlayerDto(p *player.Player, g *game.Game, cardRegistry cards.CardRegistry
 		Passed:           p.HasPassed(),
 		AvailableActions: getAvailableActionsForPlayer(g, p.ID()),
 		IsConnected:      p.IsConnected(),
+		AutoPass:         p.AutoPass(),
+		Locale:           p.Locale(),
+		MutedPlayerIDs:   p.MutedPlayers(),
+		ReactionsMuted:   p.ReactionsMuted(),
+		Note:             p.Note(),
+		Preludes:         getPlayedCards(p.Preludes(), cardRegistry),
 		Effects:          convertPlayerEffects(p.Effects().List()),
 		Actions:          convertPlayerActions(p.Actions().List(), p, g),
 		StandardProjects: standardProjects, // PlayerStandardProjectDto[] with state
 		Milestones:       milestones,       // PlayerMilestoneDto[] with eligibility
 		Awards:           awards,           // PlayerAwardDto[] with eligibility
 
-		SelectStartingCardsPhase: convertSelectStartingCardsPhase(g.GetSelectStartingCardsPhase(p.ID()), cardRegistry),
+		SelectStartingCardsPhase: convertSelectStartingCardsPhase(g.GetSelectStartingCardsPhase(p.ID()), g.Settings().RulesOptions.MulliganStartingHand, cardRegistry),
 		ProductionPhase:          convertProductionPhase(g.GetProductionPhase(p.ID()), cardRegistry),
 		StartingCards:            []CardDto{},
 		PendingTileSelection:     pendingTileSelection,
+		PendingCardPlay:          convertPendingCardPlay(g.GetPendingCardPlay(p.ID())),
 		PendingCardSelection:     convertPendingCardSelection(p.Selection().GetPendingCardSelection(), cardRegistry),
 		PendingCardDrawSelection: convertPendingCardDrawSelection(p.Selection().GetPendingCardDrawSelection(), cardRegistry),
+		PendingResponse:          convertPendingResponse(g, p.ID()),
 		ForcedFirstAction:        forcedFirstAction,
 		ResourceStorage:          p.Resources().Storage(),
 		PaymentSubstitutes:       convertPaymentSubstitutes(p.Resources().PaymentSubstitutes()),
 		GenerationalEvents:       convertGenerationalEvents(p.GenerationalEvents().GetAll()),
 		VPGranters:               toVPGranterDtos(p.VPGranters().GetAll()),
+		CardVP:                   gamecards.PreviewCardVP(p, g.Board(), cardRegistry),
+		EstimatedScore:           estimatedScore(p, g, cardRegistry),
 	}
 }
 
+// estimatedScore returns the player's live score estimate when the game broadcasts one
+func estimatedScore(p *player.Player, g *game.Game, cardRegistry cards.CardRegistry) *int {
+	if !g.Settings().RulesOptions.LiveScores {
+		return nil
+	}
+	score := action.EstimatePlayerScore(p, g, cardRegistry)
+	return &score
+}
+
 // ToOtherPlayerDto converts migration Player to OtherPlayerDto
 func ToOtherPlayerDto(p *player.Player, g *game.Game, cardRegistry cards.CardRegistry) OtherPlayerDto {
 	resourcesComponent := p.Resources()
@@ -106,13 +125,13 @@ func ToOtherPlayerDto(p *player.Player, g *game.Game, cardRegistry cards.CardReg
 	production := resourcesComponent.Production()
 
 	corporation := getCorporationCard(p, cardRegistry)
-	playedCardIDs := p.PlayedCards().Cards()
-	playedCards := getPlayedCards(playedCardIDs, cardRegistry)
+	playedCards := mapPlayedCards(p, cardRegistry)
 	handCardCount := len(p.Hand().Cards())
 
 	return OtherPlayerDto{
 		ID:               p.ID(),
 		Name:             p.Name(),
+		Color:            g.PlayerColor(p.ID()),
 		Resources:        toResourcesDto(resources),
 		Production:       toProductionDto(production),
 		TerraformRating:  resourcesComponent.TerraformRating(),
@@ -130,11 +149,13 @@ func ToOtherPlayerDto(p *player.Player, g *game.Game, cardRegistry cards.CardReg
 		ProductionPhase:          convertProductionPhaseForOtherPlayer(g.GetProductionPhase(p.ID())),
 		ResourceStorage:          p.Resources().Storage(),
 		PaymentSubstitutes:       convertPaymentSubstitutes(p.Resources().PaymentSubstitutes()),
+		CardVP:                   action.LiveCardVP(p, g, cardRegistry),
+		EstimatedScore:           estimatedScore(p, g, cardRegistry),
 	}
 }
 
 // convertSelectStartingCardsPhase converts SelectStartingCardsPhase to DTO
-func convertSelectStartingCardsPhase(phase *player.SelectStartingCardsPhase, cardRegistry cards.CardRegistry) *SelectStartingCardsPhaseDto {
+func convertSelectStartingCardsPhase(phase *player.SelectStartingCardsPhase, mulliganEnabled bool, cardRegistry cards.CardRegistry) *SelectStartingCardsPhaseDto {
 	if phase == nil {
 		return nil
 	}
@@ -148,6 +169,10 @@ func convertSelectStartingCardsPhase(phase *player.SelectStartingCardsPhase, car
 	result := &SelectStartingCardsPhaseDto{
 		AvailableCards:        availableCards,
 		AvailableCorporations: availableCorporations,
+		CanMulligan:           mulliganEnabled && !phase.Mulliganed,
+	}
+	if len(phase.AvailablePreludes) > 0 {
+		result.AvailablePreludes = getPlayedCards(phase.AvailablePreludes, cardRegistry)
 	}
 
 	return result
@@ -227,6 +252,7 @@ func convertPlayerActions(actions []player.CardAction, p *player.Player, g *game
 			act.CardID,
 			act.Behavior,
 			act.TimesUsedThisGeneration,
+			act.TimesUsedThisGame,
 			p,
 			g,
 		)
@@ -238,8 +264,10 @@ func convertPlayerActions(actions []player.CardAction, p *player.Player, g *game
 			Behavior:                toCardBehaviorDto(act.Behavior),
 			TimesUsedThisTurn:       act.TimesUsedThisTurn,
 			TimesUsedThisGeneration: act.TimesUsedThisGeneration,
+			TimesUsedThisGame:       act.TimesUsedThisGame,
 			Available:               state.Available(),
 			Errors:                  convertStateErrors(state.Errors),
+			Warnings:                convertStateWarnings(state.Warnings),
 		}
 	}
 	return dtos
@@ -323,6 +351,45 @@ func convertPendingTileSelection(selection *player.PendingTileSelection) *Pendin
 	}
 }
 
+// convertPendingResponse converts the player's pending response to DTO
+func convertPendingResponse(g *game.Game, playerID string) *PendingResponseDto {
+	response := g.GetPendingResponse(playerID)
+	deadline, ok := g.ResponseDeadline(playerID)
+	if response == nil || !ok {
+		return nil
+	}
+
+	options := make([]ResponseOptionDto, len(response.Options))
+	for i, option := range response.Options {
+		options[i] = ResponseOptionDto{ResourceType: ResourceType(option.ResourceType), Amount: option.Amount}
+	}
+	return &PendingResponseDto{
+		SourcePlayerID: response.SourcePlayerID,
+		Source:         response.Source,
+		Options:        options,
+		DefaultOption:  response.DefaultOption,
+		Deadline:       deadline.UTC().Format("2006-01-02T15:04:05.000Z"),
+	}
+}
+
+// convertPendingCardPlay converts a card reservation to DTO
+func convertPendingCardPlay(play *player.PendingCardPlay) *PendingCardPlayDto {
+	if play == nil {
+		return nil
+	}
+
+	placements := play.PendingPlacements
+	if placements == nil {
+		placements = []string{}
+	}
+	return &PendingCardPlayDto{
+		CardID:            play.CardID,
+		EffectiveCost:     play.EffectiveCost,
+		RequiresChoice:    play.RequiresChoice,
+		PendingPlacements: placements,
+	}
+}
+
 // getAvailableActionsForPlayer returns the available actions for a player
 // Actions are now at game level, so only the current player has actions
 func getAvailableActionsForPlayer(g *game.Game, playerID string) int {
@@ -388,6 +455,8 @@ func ToPlayerCardDto(pc *player.PlayerCard) PlayerCardDto {
 		discounts = discountData
 	}
 
+	legalTargets, _ := state.Metadata["legalTargets"].([]string)
+
 	tags := make([]CardTag, len(card.Tags))
 	for i, tag := range card.Tags {
 		tags[i] = CardTag(tag)
@@ -442,6 +511,7 @@ func ToPlayerCardDto(pc *player.PlayerCard) PlayerCardDto {
 		Warnings:        convertStateWarnings(state.Warnings),
 		EffectiveCost:   effectiveCost,
 		Discounts:       discounts,
+		LegalTargets:    legalTargets,
 	}
 }
 
@@ -31,6 +31,47 @@ func ToStateDiffDto(diff *game.StateDiff) StateDiffDto {
 		ChoiceIndex:       diff.ChoiceIndex,
 		CalculatedOutputs: calculatedOutputs,
 		DisplayData:       toLogDisplayDataDto(diff.DisplayData),
+		Summary:           toLogSummaryDto(diff.Summary),
+		EffectResolutions: mapSlice(diff.EffectResolutions, toEffectResolutionDto),
+	}
+}
+
+func toEffectResolutionDto(resolution game.EffectResolution) EffectResolutionDto {
+	return EffectResolutionDto{
+		PlayerID: resolution.PlayerID,
+		CardID:   resolution.CardID,
+		CardName: resolution.CardName,
+		Trigger:  resolution.Trigger,
+	}
+}
+
+func toLogSummaryDto(summary game.LogSummary) LogSummaryDto {
+	params := make(map[string]string, len(summary.Params))
+	for key, value := range summary.Params {
+		params[key] = value
+	}
+	return LogSummaryDto{Key: summary.Key, Params: params, Text: summary.Text}
+}
+
+// ToRecentActionDtos returns the summaries of the last MaxRecentActions log entries, oldest first
+// Summaries never name cards moving in or out of a hand, so every viewer gets the same list
+func ToRecentActionDtos(diffs []game.StateDiff) []RecentActionDto {
+	if len(diffs) > MaxRecentActions {
+		diffs = diffs[len(diffs)-MaxRecentActions:]
+	}
+	result := make([]RecentActionDto, len(diffs))
+	for i, diff := range diffs {
+		result[i] = toRecentActionDto(diff)
+	}
+	return result
+}
+
+func toRecentActionDto(diff game.StateDiff) RecentActionDto {
+	return RecentActionDto{
+		SequenceNumber: diff.SequenceNumber,
+		Timestamp:      diff.Timestamp.Format("2006-01-02T15:04:05.000Z"),
+		PlayerID:       diff.PlayerID,
+		Summary:        toLogSummaryDto(diff.Summary),
 	}
 }
 
@@ -43,6 +84,35 @@ func ToStateDiffDtos(diffs []game.StateDiff) []StateDiffDto {
 	return result
 }
 
+// RedactStateDiffsForViewer hides other players' hand changes from log entries sent to viewerID
+// Cards entering or leaving a hand are private to its owner; played cards stay visible to everyone.
+// An empty viewerID hides every player's hand changes. The input slice is not modified.
+func RedactStateDiffsForViewer(diffs []StateDiffDto, viewerID string) []StateDiffDto {
+	result := make([]StateDiffDto, len(diffs))
+	for i, diff := range diffs {
+		result[i] = diff
+		if diff.Changes == nil || len(diff.Changes.PlayerChanges) == 0 {
+			continue
+		}
+
+		changes := *diff.Changes
+		changes.PlayerChanges = make(map[string]*PlayerChangesDto, len(diff.Changes.PlayerChanges))
+		for playerID, playerChanges := range diff.Changes.PlayerChanges {
+			if playerID == viewerID || playerChanges == nil ||
+				(len(playerChanges.CardsAdded) == 0 && len(playerChanges.CardsRemoved) == 0) {
+				changes.PlayerChanges[playerID] = playerChanges
+				continue
+			}
+			redacted := *playerChanges
+			redacted.CardsAdded = nil
+			redacted.CardsRemoved = nil
+			changes.PlayerChanges[playerID] = &redacted
+		}
+		result[i].Changes = &changes
+	}
+	return result
+}
+
 // ToDiffLogDto converts a domain DiffLog to a DTO
 func ToDiffLogDto(log *game.DiffLog) DiffLogDto {
 	return DiffLogDto{
@@ -142,6 +212,10 @@ func toPlayerChangesDto(changes *game.PlayerChanges) *PlayerChangesDto {
 		dto.HeatProduction = &DiffValueIntDto{Old: changes.HeatProduction.Old, New: changes.HeatProduction.New}
 	}
 
+	if changes.HandSize != nil {
+		dto.HandSize = &DiffValueIntDto{Old: changes.HandSize.Old, New: changes.HandSize.New}
+	}
+
 	if len(changes.CardsAdded) > 0 {
 		dto.CardsAdded = changes.CardsAdded
 	}
@@ -171,6 +245,7 @@ func toBoardChangesDto(changes *game.BoardChanges) *BoardChangesDto {
 	for i, tp := range changes.TilesPlaced {
 		placements[i] = TilePlacementDto{
 			HexID:    tp.HexID,
+			HexName:  tp.HexName,
 			TileType: tp.TileType,
 			OwnerID:  tp.OwnerID,
 		}
@@ -0,0 +1,67 @@
+package dto
+
+import (
+	"sort"
+
+	"terraforming-mars-backend/internal/game"
+)
+
+// ToAdminStateAtResponse maps a replayed game state and the log entry it follows
+func ToAdminStateAtResponse(gameID string, entry game.StateDiff, snapshot *game.GameSnapshot) AdminStateAtResponse {
+	state := AdminGameSnapshotDto{
+		Status:              snapshot.Status,
+		Phase:               snapshot.Phase,
+		Generation:          snapshot.Generation,
+		CurrentTurnPlayerID: snapshot.CurrentTurn,
+		Temperature:         snapshot.Temperature,
+		Oxygen:              snapshot.Oxygen,
+		Oceans:              snapshot.Oceans,
+		Players:             make([]AdminPlayerSnapshotDto, 0, len(snapshot.Players)),
+		Tiles:               make([]AdminTileSnapshotDto, 0, len(snapshot.Tiles)),
+	}
+
+	for playerID, ps := range snapshot.Players {
+		state.Players = append(state.Players, AdminPlayerSnapshotDto{
+			PlayerID:    playerID,
+			Corporation: ps.Corporation,
+			Resources: ResourcesDto{
+				Credits:  ps.Credits,
+				Steel:    ps.Steel,
+				Titanium: ps.Titanium,
+				Plants:   ps.Plants,
+				Energy:   ps.Energy,
+				Heat:     ps.Heat,
+			},
+			Production: ProductionDto{
+				Credits:  ps.CreditsProduction,
+				Steel:    ps.SteelProduction,
+				Titanium: ps.TitaniumProduction,
+				Plants:   ps.PlantsProduction,
+				Energy:   ps.EnergyProduction,
+				Heat:     ps.HeatProduction,
+			},
+			TerraformRating: ps.TerraformRating,
+			Passed:          ps.Passed,
+			Hand:            append([]string{}, ps.HandCardIDs...),
+			PlayedCards:     append([]string{}, ps.PlayedCardIDs...),
+		})
+	}
+	sort.Slice(state.Players, func(i, j int) bool { return state.Players[i].PlayerID < state.Players[j].PlayerID })
+
+	for _, tile := range snapshot.Tiles {
+		state.Tiles = append(state.Tiles, AdminTileSnapshotDto{
+			HexID:    tile.HexID,
+			HexName:  tile.HexName,
+			TileType: tile.TileType,
+			OwnerID:  tile.OwnerID,
+		})
+	}
+	sort.Slice(state.Tiles, func(i, j int) bool { return state.Tiles[i].HexID < state.Tiles[j].HexID })
+
+	return AdminStateAtResponse{
+		GameID:   gameID,
+		Sequence: entry.SequenceNumber,
+		Entry:    ToStateDiffDto(&entry),
+		State:    state,
+	}
+}
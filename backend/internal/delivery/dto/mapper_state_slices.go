@@ -0,0 +1,49 @@
+package dto
+
+import "slices"
+
+// ValidStateSlice reports whether name is a slice a client can subscribe to
+func ValidStateSlice(name string) bool {
+	return slices.Contains(StateSlices, name)
+}
+
+// ToStateSlicesPayload picks the subscribed slices out of an already-mapped game view
+// The scoreboard lists public values only, so any viewer's map yields the same slices
+func ToStateSlicesPayload(view GameDto, subscribed []string) StateSlicesPayload {
+	payload := StateSlicesPayload{
+		GameID: view.ID,
+		Slices: subscribed,
+	}
+
+	for _, slice := range subscribed {
+		switch slice {
+		case StateSliceGlobalParameters:
+			globalParameters := view.GlobalParameters
+			payload.GlobalParameters = &globalParameters
+		case StateSliceScoreboard:
+			payload.Scoreboard = ToGameSummaryDto(view).Scoreboard
+		case StateSliceTurn:
+			payload.Turn = &TurnSliceDto{
+				Status:         view.Status,
+				CurrentPhase:   view.CurrentPhase,
+				Generation:     view.Generation,
+				CurrentTurn:    view.CurrentTurn,
+				WaitingOn:      view.WaitingOn,
+				Paused:         view.Pause.Paused,
+				PhaseStartedAt: view.PhaseStartedAt,
+				TurnStartedAt:  view.TurnStartedAt,
+				TurnDeadline:   view.TurnDeadline,
+			}
+		case StateSliceBoard:
+			board := view.Board
+			payload.Board = &board
+		case StateSliceMilestonesAndAwards:
+			payload.Milestones = view.Milestones
+			payload.Awards = view.Awards
+		case StateSliceRecentActions:
+			payload.RecentActions = view.RecentActions
+		}
+	}
+
+	return payload
+}
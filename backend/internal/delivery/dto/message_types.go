@@ -1,20 +1,37 @@
 package dto
 
+// ProtocolVersion is the WebSocket protocol version; bump it when message types or payloads change
+const ProtocolVersion = "2.29.0"
+
 // MessageType represents different types of WebSocket messages
 type MessageType string
 
 const (
+	MessageTypeHello         MessageType = "hello"
+	MessageTypeHelloAck      MessageType = "hello-ack"
 	MessageTypePlayerConnect MessageType = "player-connect"
 	MessageTypeJoinGame      MessageType = "join-game"
+	MessageTypeSyncRequest   MessageType = "sync-request"
+	MessageTypeSyncResponse  MessageType = "sync-response"
+	MessageTypeSubscribe     MessageType = "subscribe"
+	MessageTypeStateSlices   MessageType = "state-slices"
 
-	MessageTypeGameUpdated            MessageType = "game-updated"
-	MessageTypePlayerConnected        MessageType = "player-connected"
-	MessageTypePlayerReconnected      MessageType = "player-reconnected"
-	MessageTypePlayerDisconnected     MessageType = "player-disconnected"
-	MessageTypeError                  MessageType = "error"
-	MessageTypeFullState              MessageType = "full-state"
-	MessageTypeProductionPhaseStarted MessageType = "production-phase-started"
-	MessageTypeLogUpdate              MessageType = "log-update"
+	MessageTypeGameUpdated                 MessageType = "game-updated"
+	MessageTypePlayerConnected             MessageType = "player-connected"
+	MessageTypePlayerReconnected           MessageType = "player-reconnected"
+	MessageTypePlayerDisconnected          MessageType = "player-disconnected"
+	MessageTypeError                       MessageType = "error"
+	MessageTypeFullState                   MessageType = "full-state"
+	MessageTypeProductionPhaseStarted      MessageType = "production-phase-started"
+	MessageTypeLogUpdate                   MessageType = "log-update"
+	MessageTypeTutorialProgress            MessageType = "tutorial-progress"
+	MessageTypeCardPlayPrepared            MessageType = "card-play-prepared"
+	MessageTypeConfirmPassWithConvertibles MessageType = "confirm-pass-with-convertibles"
+	MessageTypeActionSuccess               MessageType = "action-success"
+	MessageTypeGameCreated                 MessageType = "game-created"
+	MessageTypeReaction                    MessageType = "reaction"
+	MessageTypeGenerationSummary           MessageType = "generation-summary"
+	MessageTypeBatchResult                 MessageType = "batch-result"
 
 	MessageTypeActionSellPatents        MessageType = "action.standard-project.sell-patents"
 	MessageTypeActionConfirmSellPatents MessageType = "action.standard-project.confirm-sell-patents"
@@ -26,11 +43,26 @@ const (
 
 	MessageTypeActionConvertPlantsToGreenery  MessageType = "action.resource-conversion.convert-plants-to-greenery"
 	MessageTypeActionConvertHeatToTemperature MessageType = "action.resource-conversion.convert-heat-to-temperature"
+	MessageTypeActionConvertAll               MessageType = "action.resource-conversion.convert-all"
+
+	MessageTypeCreateGame              MessageType = "create-game"
+	MessageTypeActionStartGame         MessageType = "action.game-management.start-game"
+	MessageTypeActionSkipAction        MessageType = "action.game-management.skip-action"
+	MessageTypeActionConfirmDemoSetup  MessageType = "action.game-management.confirm-demo-setup"
+	MessageTypeActionSetSeatOrder      MessageType = "action.game-management.set-seat-order"
+	MessageTypeActionSetHandicap       MessageType = "action.game-management.set-handicap"
+	MessageTypeActionSetPlayerColor    MessageType = "action.game-management.set-player-color"
+	MessageTypeActionPauseGame         MessageType = "action.game-management.pause-game"
+	MessageTypeActionResumeGame        MessageType = "action.game-management.resume-game"
+	MessageTypeActionConcede           MessageType = "action.game-management.concede"
+	MessageTypeActionVoteAbandon       MessageType = "action.game-management.vote-abandon"
+	MessageTypeActionSetPreferences    MessageType = "action.game-management.set-preferences"
+	MessageTypeActionForceAdvancePhase MessageType = "action.game-management.force-advance-phase"
+	MessageTypeActionSetNote           MessageType = "action.game-management.set-note"
+	MessageTypeActionRunBatch          MessageType = "action.game-management.run-batch"
 
-	MessageTypeCreateGame             MessageType = "create-game"
-	MessageTypeActionStartGame        MessageType = "action.game-management.start-game"
-	MessageTypeActionSkipAction       MessageType = "action.game-management.skip-action"
-	MessageTypeActionConfirmDemoSetup MessageType = "action.game-management.confirm-demo-setup"
+	MessageTypeActionSendReaction  MessageType = "action.reaction.send-reaction"
+	MessageTypeActionMuteReactions MessageType = "action.reaction.mute-reactions"
 
 	MessageTypeActionClaimMilestone MessageType = "action.milestone.claim-milestone"
 	MessageTypeActionFundAward      MessageType = "action.award.fund-award"
@@ -38,15 +70,20 @@ const (
 	MessageTypeActionTileSelected MessageType = "action.tile-selection.tile-selected"
 
 	MessageTypeActionPlayCard               MessageType = "action.card.play-card"
+	MessageTypeActionPreparePlayCard        MessageType = "action.card.prepare-play-card"
+	MessageTypeActionCommitPlayCard         MessageType = "action.card.commit-play-card"
+	MessageTypeActionCancelPlayCard         MessageType = "action.card.cancel-play-card"
 	MessageTypeActionCardAction             MessageType = "action.card.card-action"
 	MessageTypeActionSelectStartingCard     MessageType = "action.card.select-starting-card"
-	MessageTypeActionSelectCards            MessageType = "action.card.select-cards"
+	MessageTypeActionMulliganStartingHand   MessageType = "action.card.mulligan-starting-hand"
 	MessageTypeActionConfirmProductionCards MessageType = "action.card.confirm-production-cards"
 	MessageTypeActionCardDrawConfirmed      MessageType = "action.card.card-draw-confirmed"
+	MessageTypeActionRespondToEffect        MessageType = "action.card.respond-to-effect"
 
 	MessageTypeAdminCommand MessageType = "admin-command"
 
 	MessageTypePlayerTakeover MessageType = "player-takeover"
 	MessageTypeKickPlayer     MessageType = "kick-player"
 	MessageTypePlayerKicked   MessageType = "player-kicked"
+	MessageTypeControlPlayer  MessageType = "control-player"
 )
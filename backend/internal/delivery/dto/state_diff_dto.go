@@ -21,6 +21,7 @@ type DiffValueBoolDto struct {
 // TilePlacementDto records a single tile placement on the board
 type TilePlacementDto struct {
 	HexID    string `json:"hexId" ts:"string"`
+	HexName  string `json:"hexName,omitempty" ts:"string | undefined"` // e.g. "E5" or "Noctis City (G1)"
 	TileType string `json:"tileType" ts:"string"`
 	OwnerID  string `json:"ownerId,omitempty" ts:"string | undefined"`
 }
@@ -45,6 +46,7 @@ type PlayerChangesDto struct {
 	PlantsProduction   *DiffValueIntDto    `json:"plantsProduction,omitempty" ts:"DiffValueIntDto | undefined"`
 	EnergyProduction   *DiffValueIntDto    `json:"energyProduction,omitempty" ts:"DiffValueIntDto | undefined"`
 	HeatProduction     *DiffValueIntDto    `json:"heatProduction,omitempty" ts:"DiffValueIntDto | undefined"`
+	HandSize           *DiffValueIntDto    `json:"handSize,omitempty" ts:"DiffValueIntDto | undefined"` // Public even when cardsAdded/cardsRemoved are redacted
 	CardsAdded         []string            `json:"cardsAdded,omitempty" ts:"string[] | undefined"`
 	CardsRemoved       []string            `json:"cardsRemoved,omitempty" ts:"string[] | undefined"`
 	CardsPlayed        []string            `json:"cardsPlayed,omitempty" ts:"string[] | undefined"`
@@ -79,6 +81,32 @@ type LogDisplayDataDto struct {
 	VPConditions []VPConditionDto  `json:"vpConditions,omitempty" ts:"VPConditionDto[] | undefined"`
 }
 
+// LogSummaryDto is the server-rendered line for a log entry; clients show Text or localize Key with Params
+type LogSummaryDto struct {
+	Key    string            `json:"key" ts:"string"`                    // "log." + source type, e.g. "log.standard-project"
+	Params map[string]string `json:"params" ts:"Record<string, string>"` // player, source, description, and tiles/gains when present
+	Text   string            `json:"text" ts:"string"`                   // English rendering
+}
+
+// MaxRecentActions is how many log entries the recent actions window in the game state holds
+const MaxRecentActions = 20
+
+// RecentActionDto is one entry of the rolling recent actions window; the full history is at /logs
+type RecentActionDto struct {
+	SequenceNumber int64         `json:"sequenceNumber" ts:"number"`
+	Timestamp      string        `json:"timestamp" ts:"string"`
+	PlayerID       string        `json:"playerId" ts:"string"` // Empty for game events
+	Summary        LogSummaryDto `json:"summary" ts:"LogSummaryDto"`
+}
+
+// EffectResolutionDto is a card effect triggered by a log entry; entries are listed in the order they resolved
+type EffectResolutionDto struct {
+	PlayerID string `json:"playerId" ts:"string"`
+	CardID   string `json:"cardId" ts:"string"`
+	CardName string `json:"cardName" ts:"string"`
+	Trigger  string `json:"trigger" ts:"string"` // Condition type that fired the effect, e.g. "city-placed"
+}
+
 // StateDiffDto represents the difference between two consecutive game states
 type StateDiffDto struct {
 	SequenceNumber    int64                 `json:"sequenceNumber" ts:"number"`
@@ -92,6 +120,8 @@ type StateDiffDto struct {
 	ChoiceIndex       *int                  `json:"choiceIndex,omitempty" ts:"number | undefined"`
 	CalculatedOutputs []CalculatedOutputDto `json:"calculatedOutputs,omitempty" ts:"CalculatedOutputDto[] | undefined"`
 	DisplayData       *LogDisplayDataDto    `json:"displayData,omitempty" ts:"LogDisplayDataDto | undefined"`
+	Summary           LogSummaryDto         `json:"summary" ts:"LogSummaryDto"`
+	EffectResolutions []EffectResolutionDto `json:"effectResolutions,omitempty" ts:"EffectResolutionDto[] | undefined"` // Triggered card effects in resolution order
 }
 
 // DiffLogDto contains the complete history of state changes for a game
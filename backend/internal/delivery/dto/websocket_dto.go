@@ -1,48 +1,195 @@
 package dto
 
+import "encoding/json"
+
 // WebSocketMessage represents a WebSocket message
 type WebSocketMessage struct {
 	Type    MessageType `json:"type" ts:"MessageType"`
 	Payload interface{} `json:"payload" ts:"any"`
 	GameID  string      `json:"gameId,omitempty" ts:"string"`
+	// Number of messages the game had started processing when this broadcast was built (server to client only)
+	ActionSequence int64 `json:"actionSequence,omitempty" ts:"number | undefined"`
+}
+
+// HelloPayload is sent by a client right after connecting to agree on the wire format
+// Encodings are in order of preference; unknown ones are ignored
+type HelloPayload struct {
+	Encodings   []string `json:"encodings" ts:"string[]"`
+	Compression bool     `json:"compression" ts:"boolean"`                 // Ask for permessage-deflate on outgoing frames
+	Locale      string   `json:"locale,omitempty" ts:"string | undefined"` // Language of server messages, e.g. "de" or "sv-SE"; a player's saved locale replaces it on join
+}
+
+// HelloAckPayload tells the client which wire format the server uses from the next frame on
+type HelloAckPayload struct {
+	Encoding    string `json:"encoding" ts:"string"`
+	Compression bool   `json:"compression" ts:"boolean"` // False when the client did not negotiate permessage-deflate
+	Locale      string `json:"locale" ts:"string"`       // Language of server messages; "en" when the offered one is unsupported
+}
+
+// Sync response modes
+const (
+	SyncModeDeltas   = "deltas"   // Patch turns the client's state at FromSequence into the current state
+	SyncModeSnapshot = "snapshot" // The client's state is unknown or too old; Game replaces it
+)
+
+// SyncRequestPayload asks for the changes since the last game state the client received
+type SyncRequestPayload struct {
+	SinceSequence    int64 `json:"sinceSequence" ts:"number"`                          // Action sequence of the last game-updated the client applied
+	SinceLogSequence int64 `json:"sinceLogSequence,omitempty" ts:"number | undefined"` // Newest log entry the client has; 0 sends every entry
+}
+
+// SyncResponsePayload brings a client's game state and log up to date
+type SyncResponsePayload struct {
+	Mode           string               `json:"mode" ts:"string"`
+	FromSequence   int64                `json:"fromSequence" ts:"number"`                              // Sequence the patch applies to; 0 for snapshots
+	ActionSequence int64                `json:"actionSequence" ts:"number"`                            // Sequence of the resulting state
+	Patch          []JSONPatchOperation `json:"patch,omitempty" ts:"JSONPatchOperation[] | undefined"` // Applied in order to the client's GameDto
+	Game           *GameDto             `json:"game,omitempty" ts:"GameDto | undefined"`
+	Logs           []StateDiffDto       `json:"logs" ts:"StateDiffDto[]"` // Log entries newer than SinceLogSequence
+}
+
+// JSONPatchOperation is one RFC 6902 operation; only add, remove and replace are produced
+type JSONPatchOperation struct {
+	Op    string          `json:"op" ts:"string"`
+	Path  string          `json:"path" ts:"string"` // JSON pointer into the GameDto
+	Value json.RawMessage `json:"value,omitempty" ts:"any"`
+}
+
+// State slices a client can subscribe to instead of the full game state
+const (
+	StateSliceGlobalParameters    = "globalParameters"    // Temperature, oxygen and oceans
+	StateSliceScoreboard          = "scoreboard"          // Every player's terraform rating, highest first
+	StateSliceTurn                = "turn"                // Status, phase, generation, current turn and pause
+	StateSliceBoard               = "board"               // Tiles and their occupants
+	StateSliceMilestonesAndAwards = "milestonesAndAwards" // Claimed milestones and funded awards
+	StateSliceRecentActions       = "recentActions"       // Latest log entries as summaries
+)
+
+// StateSlices lists every slice a client can subscribe to
+var StateSlices = []string{
+	StateSliceGlobalParameters,
+	StateSliceScoreboard,
+	StateSliceTurn,
+	StateSliceBoard,
+	StateSliceMilestonesAndAwards,
+	StateSliceRecentActions,
+}
+
+// SubscribePayload limits the game state a connection receives to the listed slices
+// An empty list goes back to the full game-updated state
+// A connection without a seat names the game to watch and receives the public view only
+type SubscribePayload struct {
+	Slices []string `json:"slices" ts:"string[]"`
+	GameID string   `json:"gameId,omitempty" ts:"string | undefined"` // Only read from connections that have not joined a game
+}
+
+// StateSlicesPayload carries only the slices a connection subscribed to; the others are omitted
+type StateSlicesPayload struct {
+	GameID           string               `json:"gameId" ts:"string"`
+	Slices           []string             `json:"slices" ts:"string[]"`
+	GlobalParameters *GlobalParametersDto `json:"globalParameters,omitempty" ts:"GlobalParametersDto | undefined"`
+	Scoreboard       []ScoreboardEntryDto `json:"scoreboard,omitempty" ts:"ScoreboardEntryDto[] | undefined"`
+	Turn             *TurnSliceDto        `json:"turn,omitempty" ts:"TurnSliceDto | undefined"`
+	Board            *BoardDto            `json:"board,omitempty" ts:"BoardDto | undefined"`
+	Milestones       []MilestoneDto       `json:"milestones,omitempty" ts:"MilestoneDto[] | undefined"`
+	Awards           []AwardDto           `json:"awards,omitempty" ts:"AwardDto[] | undefined"`
+	RecentActions    []RecentActionDto    `json:"recentActions,omitempty" ts:"RecentActionDto[] | undefined"`
+	ServerTime       string               `json:"serverTime" ts:"string"` // ISO 8601; when the server sent this, for clients to offset their clock
+}
+
+// TurnSliceDto tells where the game is without any player's details
+type TurnSliceDto struct {
+	Status         GameStatus `json:"status" ts:"GameStatus"`
+	CurrentPhase   GamePhase  `json:"currentPhase" ts:"GamePhase"`
+	Generation     int        `json:"generation" ts:"number"`
+	CurrentTurn    *string    `json:"currentTurn" ts:"string|null"`
+	WaitingOn      []string   `json:"waitingOn" ts:"string[]"`
+	Paused         bool       `json:"paused" ts:"boolean"`
+	PhaseStartedAt string     `json:"phaseStartedAt" ts:"string"`                      // ISO 8601; when the current phase began, not counting time paused
+	TurnStartedAt  string     `json:"turnStartedAt,omitempty" ts:"string | undefined"` // ISO 8601; when the current turn began, not counting time paused
+	TurnDeadline   string     `json:"turnDeadline,omitempty" ts:"string | undefined"`  // ISO 8601; action phase only, when the turn is skipped
 }
 
 // PlayerConnectPayload contains player connection data
 type PlayerConnectPayload struct {
-	PlayerName string `json:"playerName" ts:"string"`
-	GameID     string `json:"gameId" ts:"string"`
-	PlayerID   string `json:"playerId,omitempty" ts:"string | undefined"` // Optional: used for reconnection
+	PlayerName  string `json:"playerName" ts:"string"`
+	GameID      string `json:"gameId" ts:"string"`
+	PlayerID    string `json:"playerId,omitempty" ts:"string | undefined"`    // Optional: used for reconnection
+	AccountID   string `json:"accountId,omitempty" ts:"string | undefined"`   // Optional: links the seat to an account for data deletion
+	InviteToken string `json:"inviteToken,omitempty" ts:"string | undefined"` // Optional: claims the seat an invite reserved, under its name
 }
 
 // GameUpdatedPayload contains updated game state
 type GameUpdatedPayload struct {
-	Game GameDto `json:"game" ts:"GameDto"`
+	Game       GameDto `json:"game" ts:"GameDto"`
+	ServerTime string  `json:"serverTime" ts:"string"` // ISO 8601; when the server sent this, for clients to offset their clock
 }
 
-// PlayerConnectedPayload contains data about a newly connected player
+// PlayerConnectedPayload confirms a join or takeover; the game itself follows as game-updated
 type PlayerConnectedPayload struct {
-	PlayerID   string  `json:"playerId" ts:"string"`
-	PlayerName string  `json:"playerName" ts:"string"`
-	Game       GameDto `json:"game" ts:"GameDto"`
+	PlayerID   string `json:"playerId" ts:"string"`
+	PlayerName string `json:"playerName" ts:"string"`
+}
+
+// GameCreatedPayload answers create-game with the new game's ID
+type GameCreatedPayload struct {
+	GameID string `json:"gameId" ts:"string"`
+}
+
+// ActionSuccessPayload acknowledges an action to the player who sent it
+// The resulting state arrives separately as game-updated; the optional fields echo the action's target
+type ActionSuccessPayload struct {
+	Action        string `json:"action" ts:"string"`
+	CardID        string `json:"cardId,omitempty" ts:"string | undefined"`
+	BehaviorIndex *int   `json:"behaviorIndex,omitempty" ts:"number | undefined"`
+	Hex           string `json:"hex,omitempty" ts:"string | undefined"`
+	MilestoneType string `json:"milestoneType,omitempty" ts:"string | undefined"`
+	AwardType     string `json:"awardType,omitempty" ts:"string | undefined"`
+}
+
+// PlayerKickedPayload tells a player they were removed from the lobby
+type PlayerKickedPayload struct {
+	Reason string `json:"reason" ts:"string"`
+}
+
+// ReactionPayload is a reaction sent to the table, delivered to every player who has not muted the sender
+type ReactionPayload struct {
+	PlayerID   string `json:"playerId" ts:"string"`
+	PlayerName string `json:"playerName" ts:"string"`
+	Reaction   string `json:"reaction" ts:"string"`
+	SentAt     string `json:"sentAt" ts:"string"`
 }
 
 // ErrorPayload contains error information
 type ErrorPayload struct {
-	Message string `json:"message" ts:"string"`
-	Code    string `json:"code,omitempty" ts:"string"`
+	Message        string      `json:"message" ts:"string"`
+	Code           string      `json:"code,omitempty" ts:"string"`
+	ExpectedPhases []GamePhase `json:"expectedPhases,omitempty" ts:"GamePhase[] | undefined"` // ERR_WRONG_PHASE only: phases the action is legal in
 }
 
+// Error codes set in ErrorPayload.Code so clients can react without parsing the message
+const (
+	ErrCodeGamePaused       = "ERR_GAME_PAUSED"        // Gameplay actions are rejected until the game is resumed
+	ErrCodeAwaitingResponse = "ERR_AWAITING_RESPONSE"  // Gameplay actions are rejected until every pending response is answered
+	ErrCodeActionTimeout    = "ERR_ACTION_TIMEOUT"     // The action ran past its deadline and was rolled back
+	ErrCodeGameFailed       = "ERR_GAME_FAILED"        // An action hung, so the game takes no further actions
+	ErrCodeWrongPhase       = "ERR_WRONG_PHASE"        // The action is not legal in the game's current phase; see ErrorPayload.ExpectedPhases
+	ErrCodeMilestonesFull   = "ERR_MILESTONES_FULL"    // Every milestone slot is taken; a claim that lost a race gets this and pays nothing
+	ErrCodeServerAtCapacity = "ERR_SERVER_AT_CAPACITY" // The instance runs its maximum of games; creating one may succeed later
+)
+
+// Other codes come from the server message catalog (internal/i18n), which also sets them on
+// engine errors it recognizes and translates the message into the connection's locale
+
 // FullStatePayload contains the complete game state
 type FullStatePayload struct {
 	Game     GameDto `json:"game" ts:"GameDto"`
 	PlayerID string  `json:"playerId" ts:"string"`
 }
 
-// PlayerReconnectedPayload contains data about a reconnected player
+// PlayerReconnectedPayload confirms a reconnect; the game itself follows as game-updated
 type PlayerReconnectedPayload struct {
-	PlayerID   string  `json:"playerId" ts:"string"`
-	PlayerName string  `json:"playerName" ts:"string"`
-	Game       GameDto `json:"game" ts:"GameDto"`
+	PlayerID string `json:"playerId" ts:"string"`
 }
 
 // PlayerDisconnectedPayload contains data about a disconnected player (for internal handler use)
@@ -65,11 +212,88 @@ type ProductionPhaseStartedPayload struct {
 	Game        GameDto                `json:"game" ts:"GameDto"`
 }
 
+// PlayerGenerationSummaryDto recaps one player's generation
+type PlayerGenerationSummaryDto struct {
+	PlayerID        string       `json:"playerId" ts:"string"`
+	PlayerName      string       `json:"playerName" ts:"string"`
+	Income          ResourcesDto `json:"income" ts:"ResourcesDto"` // Credits include terraform rating
+	EnergyConverted int          `json:"energyConverted" ts:"number"`
+	CardsBought     int          `json:"cardsBought" ts:"number"`
+	TRDelta         int          `json:"trDelta" ts:"number"`
+}
+
+// GenerationSummaryPayload recaps a finished generation, sent once when its production phase runs
+type GenerationSummaryPayload struct {
+	Generation int                          `json:"generation" ts:"number"`
+	Players    []PlayerGenerationSummaryDto `json:"players" ts:"PlayerGenerationSummaryDto[]"`
+}
+
 // LogUpdatePayload contains game log entries sent via WebSocket
 type LogUpdatePayload struct {
 	Logs []StateDiffDto `json:"logs" ts:"StateDiffDto[]"`
 }
 
+// TutorialProgressPayload guides a tutorial player to the current objective
+type TutorialProgressPayload struct {
+	ScenarioID   string           `json:"scenarioId" ts:"string"`
+	ScenarioName string           `json:"scenarioName" ts:"string"`
+	StepID       string           `json:"stepId,omitempty" ts:"string | undefined"` // Empty once completed
+	StepIndex    int              `json:"stepIndex" ts:"number"`                    // 0-based; equals totalSteps once completed
+	TotalSteps   int              `json:"totalSteps" ts:"number"`
+	Instruction  string           `json:"instruction,omitempty" ts:"string | undefined"`
+	Completed    bool             `json:"completed" ts:"boolean"`
+	Puzzle       *PuzzleStatusDto `json:"puzzle,omitempty" ts:"PuzzleStatusDto | undefined"` // Set for puzzle scenarios
+}
+
+// PuzzleStatusDto reports progress toward a puzzle's victory condition
+type PuzzleStatusDto struct {
+	VictoryType  string `json:"victoryType" ts:"string"` // terraform-rating, victory-points
+	Target       int    `json:"target" ts:"number"`
+	ByGeneration int    `json:"byGeneration" ts:"number"`
+	Current      int    `json:"current" ts:"number"`
+	Outcome      string `json:"outcome" ts:"string"` // in-progress, solved, failed
+}
+
+// CardPlayPreparedPayload is sent to the preparing player with everything needed to commit the card
+type CardPlayPreparedPayload struct {
+	CardID            string                 `json:"cardId" ts:"string"`
+	CardName          string                 `json:"cardName" ts:"string"`
+	BaseCost          int                    `json:"baseCost" ts:"number"`
+	Discount          int                    `json:"discount" ts:"number"`
+	EffectiveCost     int                    `json:"effectiveCost" ts:"number"`
+	AllowSteel        bool                   `json:"allowSteel" ts:"boolean"`
+	AllowTitanium     bool                   `json:"allowTitanium" ts:"boolean"`
+	SteelRate         int                    `json:"steelRate" ts:"number"`
+	TitaniumRate      int                    `json:"titaniumRate" ts:"number"`
+	Substitutes       []PaymentSubstituteDto `json:"substitutes" ts:"PaymentSubstituteDto[]"`
+	ChoiceCount       int                    `json:"choiceCount" ts:"number"`         // Commit needs a choiceIndex below this when > 0
+	PendingPlacements []string               `json:"pendingPlacements" ts:"string[]"` // Tile types queued on commit, in order
+}
+
+// ConfirmPassWithConvertiblesPayload warns that a pass would leave plants or heat unconverted
+// The client resends skip-action with confirmed set to pass anyway, or sends convert-all first
+type ConfirmPassWithConvertiblesPayload struct {
+	Greeneries       int `json:"greeneries" ts:"number"`       // Greeneries the player's plants could pay for
+	TemperatureSteps int `json:"temperatureSteps" ts:"number"` // Temperature steps the player's heat could buy
+	PlantCost        int `json:"plantCost" ts:"number"`        // Plants per greenery after discounts
+	HeatCost         int `json:"heatCost" ts:"number"`         // Heat per temperature step after discounts
+}
+
+// BatchResultPayload answers run-batch with the outcome of each step
+// When a step failed, nothing in the batch was kept: earlier steps were rolled back and later ones never ran
+type BatchResultPayload struct {
+	Completed bool                 `json:"completed" ts:"boolean"`
+	Steps     []BatchStepResultDto `json:"steps" ts:"BatchStepResultDto[]"`
+}
+
+// BatchStepResultDto is the outcome of one batch step
+type BatchStepResultDto struct {
+	Index  int         `json:"index" ts:"number"`
+	Type   MessageType `json:"type" ts:"MessageType"`
+	Status string      `json:"status" ts:"string"`                      // succeeded, failed or skipped
+	Error  string      `json:"error,omitempty" ts:"string | undefined"` // Why the failed step failed
+}
+
 // ConfirmStartingCardSelectionMessage represents confirm starting card selection message
 type ConfirmStartingCardSelectionMessage struct {
 	GameID   string `json:"gameId" ts:"string"`
@@ -0,0 +1,77 @@
+package http
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+
+	accountaction "terraforming-mars-backend/internal/action/account"
+	"terraforming-mars-backend/internal/archive"
+	"terraforming-mars-backend/internal/delivery/dto"
+
+	"github.com/gorilla/mux"
+	"go.uber.org/zap"
+)
+
+// AccountHandler serves requests about a client-chosen account's personal data
+type AccountHandler struct {
+	*BaseHandler
+	registerAccountAction   *accountaction.RegisterAccountAction
+	deleteAccountDataAction *accountaction.DeleteAccountDataAction
+}
+
+// NewAccountHandler creates a new account handler
+func NewAccountHandler(
+	registerAccountAction *accountaction.RegisterAccountAction,
+	deleteAccountDataAction *accountaction.DeleteAccountDataAction,
+) *AccountHandler {
+	return &AccountHandler{
+		BaseHandler:             NewBaseHandler(),
+		registerAccountAction:   registerAccountAction,
+		deleteAccountDataAction: deleteAccountDataAction,
+	}
+}
+
+// RegisterAccount handles POST /api/v1/players/{accountId}/key
+// The key is returned once; an account that already has one answers 409
+func (h *AccountHandler) RegisterAccount(w http.ResponseWriter, r *http.Request) {
+	accountID := mux.Vars(r)["accountId"]
+	h.logger.Info("📡 HTTP POST /api/v1/players/:accountId/key")
+
+	key, err := h.registerAccountAction.Execute(r.Context(), accountID)
+	if errors.Is(err, archive.ErrAccountRegistered) {
+		h.WriteErrorResponse(w, http.StatusConflict, "Account already has a key")
+		return
+	}
+	if err != nil {
+		h.logger.Error("Failed to register account", zap.Error(err))
+		h.WriteErrorResponse(w, http.StatusInternalServerError, "Failed to register account")
+		return
+	}
+	h.WriteJSONResponse(w, http.StatusCreated, dto.AccountKeyResponse{AccountKey: key})
+}
+
+// DeleteAccount handles DELETE /api/v1/players/{accountId}
+// The caller proves ownership with "Authorization: Bearer <account key>"
+func (h *AccountHandler) DeleteAccount(w http.ResponseWriter, r *http.Request) {
+	accountID := mux.Vars(r)["accountId"]
+	h.logger.Info("📡 HTTP DELETE /api/v1/players/:accountId")
+
+	accountKey, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+	if !ok || accountKey == "" {
+		h.WriteErrorResponse(w, http.StatusUnauthorized, "Account key required")
+		return
+	}
+
+	deletion, err := h.deleteAccountDataAction.Execute(r.Context(), accountID, accountKey)
+	if errors.Is(err, archive.ErrInvalidAccountKey) {
+		h.WriteErrorResponse(w, http.StatusForbidden, "Account key does not match")
+		return
+	}
+	if err != nil {
+		h.logger.Error("Failed to delete account data", zap.Error(err))
+		h.WriteErrorResponse(w, http.StatusInternalServerError, "Failed to delete account data")
+		return
+	}
+	h.WriteJSONResponse(w, http.StatusOK, dto.ToDeleteAccountResponse(deletion))
+}
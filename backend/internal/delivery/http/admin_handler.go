@@ -0,0 +1,309 @@
+package http
+
+import (
+	"errors"
+	"net/http"
+	"runtime"
+	"strconv"
+	"time"
+
+	"terraforming-mars-backend/internal/action/admin"
+	"terraforming-mars-backend/internal/delivery/dto"
+	"terraforming-mars-backend/internal/delivery/websocket/core"
+	"terraforming-mars-backend/internal/game"
+	"terraforming-mars-backend/internal/logger"
+
+	"github.com/gorilla/mux"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+// AdminHandler handles HTTP requests for operator-only endpoints
+// Only mounted when the server runs with admin endpoints enabled
+type AdminHandler struct {
+	*BaseHandler
+	listGameFootprintsAction *admin.ListGameFootprintsAction
+	listCollusionFlagsAction *admin.ListCollusionFlagsAction
+	auditGameAction          *admin.AuditGameAction
+	getStateAtAction         *admin.GetStateAtAction
+	inspectAwardsAction      *admin.InspectAwardsAction
+	hub                      *core.Hub
+	gameLogs                 *logger.GameLogStore
+}
+
+// NewAdminHandler creates a new admin handler
+func NewAdminHandler(
+	listGameFootprintsAction *admin.ListGameFootprintsAction,
+	listCollusionFlagsAction *admin.ListCollusionFlagsAction,
+	auditGameAction *admin.AuditGameAction,
+	getStateAtAction *admin.GetStateAtAction,
+	inspectAwardsAction *admin.InspectAwardsAction,
+	hub *core.Hub,
+	gameLogs *logger.GameLogStore,
+) *AdminHandler {
+	return &AdminHandler{
+		BaseHandler:              NewBaseHandler(),
+		listGameFootprintsAction: listGameFootprintsAction,
+		listCollusionFlagsAction: listCollusionFlagsAction,
+		auditGameAction:          auditGameAction,
+		getStateAtAction:         getStateAtAction,
+		inspectAwardsAction:      inspectAwardsAction,
+		hub:                      hub,
+		gameLogs:                 gameLogs,
+	}
+}
+
+// ListGames handles GET /api/v1/admin/games
+func (h *AdminHandler) ListGames(w http.ResponseWriter, r *http.Request) {
+	footprints, err := h.listGameFootprintsAction.Execute(r.Context())
+	if err != nil {
+		h.logger.Error("Failed to list game footprints", zap.Error(err))
+		h.WriteErrorResponse(w, http.StatusInternalServerError, "Failed to list games")
+		return
+	}
+
+	var mem runtime.MemStats
+	runtime.ReadMemStats(&mem)
+
+	response := dto.AdminListGamesResponse{
+		Games:          make([]dto.AdminGameFootprintDto, 0, len(footprints)),
+		ThresholdBytes: h.listGameFootprintsAction.Threshold(),
+		HeapAllocBytes: mem.HeapAlloc,
+	}
+	for _, entry := range footprints {
+		response.Games = append(response.Games, toAdminGameFootprintDto(entry))
+	}
+
+	h.WriteJSONResponse(w, http.StatusOK, response)
+}
+
+// GetWebSocketStats handles GET /api/v1/admin/websocket
+func (h *AdminHandler) GetWebSocketStats(w http.ResponseWriter, r *http.Request) {
+	wireMetrics := h.hub.WireMetrics()
+	stats := wireMetrics.Snapshot()
+	types := wireMetrics.TypeSnapshot()
+	queue := h.hub.QueueMetrics().Snapshot()
+
+	response := dto.AdminWebSocketStatsResponse{
+		Connections: h.hub.GetManager().GetConnectionCount(),
+		Wire:        make([]dto.AdminWireStatsDto, 0, len(stats)),
+		Queue: dto.AdminSendQueueStatsDto{
+			Queued:          queue.Queued,
+			Coalesced:       queue.Coalesced,
+			Dropped:         queue.Dropped,
+			SlowEvents:      queue.SlowEvents,
+			SlowConnections: queue.SlowConnections,
+		},
+		MessageTypes:    make([]dto.AdminMessageSizeStatsDto, 0, len(types)),
+		SizeBudgetBytes: wireMetrics.SizeBudget(),
+	}
+	for _, entry := range stats {
+		response.Wire = append(response.Wire, dto.AdminWireStatsDto{
+			Encoding:     string(entry.Encoding),
+			Compressed:   entry.Compressed,
+			Messages:     entry.Messages,
+			JSONBytes:    entry.JSONBytes,
+			EncodedBytes: entry.EncodedBytes,
+			WireBytes:    entry.WireBytes,
+		})
+	}
+	for _, entry := range types {
+		response.MessageTypes = append(response.MessageTypes, dto.AdminMessageSizeStatsDto{
+			Type:         string(entry.Type),
+			Messages:     entry.Messages,
+			JSONBytes:    entry.JSONBytes,
+			MaxJSONBytes: entry.MaxJSONBytes,
+		})
+	}
+
+	h.WriteJSONResponse(w, http.StatusOK, response)
+}
+
+// GetGameLogs handles GET /api/v1/admin/games/{gameId}/logs?level=warn
+// Returns the server log lines recorded for one game, oldest first; level defaults to debug
+func (h *AdminHandler) GetGameLogs(w http.ResponseWriter, r *http.Request) {
+	gameID := mux.Vars(r)["gameId"]
+
+	level := zapcore.DebugLevel
+	if levelParam := r.URL.Query().Get("level"); levelParam != "" {
+		parsed, err := zapcore.ParseLevel(levelParam)
+		if err != nil {
+			h.WriteErrorResponse(w, http.StatusBadRequest, "Invalid level parameter")
+			return
+		}
+		level = parsed
+	}
+
+	entries := h.gameLogs.Entries(gameID, level)
+	response := dto.AdminGameLogsResponse{
+		GameID:  gameID,
+		Level:   level.String(),
+		Entries: make([]dto.AdminGameLogEntryDto, 0, len(entries)),
+	}
+	for _, entry := range entries {
+		response.Entries = append(response.Entries, dto.AdminGameLogEntryDto{
+			Time:    entry.Time.UTC().Format(time.RFC3339Nano),
+			Level:   entry.Level.String(),
+			Message: entry.Message,
+			Caller:  entry.Caller,
+			Fields:  entry.Fields,
+		})
+	}
+
+	h.WriteJSONResponse(w, http.StatusOK, response)
+}
+
+// AuditGame handles GET /api/v1/admin/games/{gameId}/audit
+func (h *AdminHandler) AuditGame(w http.ResponseWriter, r *http.Request) {
+	gameID := mux.Vars(r)["gameId"]
+
+	violations, err := h.auditGameAction.Execute(r.Context(), gameID)
+	if errors.Is(err, game.ErrGameNotFound) {
+		h.WriteErrorResponse(w, http.StatusNotFound, "Game not found")
+		return
+	}
+	if err != nil {
+		h.logger.Error("Failed to audit game", zap.String("game_id", gameID), zap.Error(err))
+		h.WriteErrorResponse(w, http.StatusInternalServerError, "Failed to audit game")
+		return
+	}
+
+	response := dto.AdminGameAuditResponse{
+		GameID:     gameID,
+		Violations: make([]dto.AdminAuditViolationDto, 0, len(violations)),
+	}
+	for _, violation := range violations {
+		response.Violations = append(response.Violations, dto.AdminAuditViolationDto{
+			Check:  string(violation.Check),
+			Detail: violation.Detail,
+		})
+	}
+
+	h.WriteJSONResponse(w, http.StatusOK, response)
+}
+
+// GetStateAt handles GET /api/v1/admin/games/{gameId}/state-at?sequence=N
+// Returns the game's logged state right after log entry N, rebuilt from the game log
+func (h *AdminHandler) GetStateAt(w http.ResponseWriter, r *http.Request) {
+	gameID := mux.Vars(r)["gameId"]
+
+	sequence, err := strconv.ParseInt(r.URL.Query().Get("sequence"), 10, 64)
+	if err != nil || sequence < 1 {
+		h.WriteErrorResponse(w, http.StatusBadRequest, "Invalid sequence parameter")
+		return
+	}
+
+	stateAt, err := h.getStateAtAction.Execute(r.Context(), gameID, sequence)
+	switch {
+	case errors.Is(err, game.ErrGameNotFound):
+		h.WriteErrorResponse(w, http.StatusNotFound, "Game not found")
+		return
+	case errors.Is(err, game.ErrSequenceNotFound):
+		h.WriteErrorResponse(w, http.StatusNotFound, "Log entry not found")
+		return
+	case err != nil:
+		h.logger.Error("Failed to replay game log", zap.String("game_id", gameID), zap.Error(err))
+		h.WriteErrorResponse(w, http.StatusInternalServerError, "Failed to replay game log")
+		return
+	}
+
+	h.WriteJSONResponse(w, http.StatusOK, dto.ToAdminStateAtResponse(gameID, stateAt.Entry, stateAt.State))
+}
+
+// InspectAwards handles GET /api/v1/admin/games/{gameId}/awards
+// Shows every award's current standings next to any standings frozen at funding
+func (h *AdminHandler) InspectAwards(w http.ResponseWriter, r *http.Request) {
+	gameID := mux.Vars(r)["gameId"]
+
+	inspection, err := h.inspectAwardsAction.Execute(r.Context(), gameID)
+	if errors.Is(err, game.ErrGameNotFound) {
+		h.WriteErrorResponse(w, http.StatusNotFound, "Game not found")
+		return
+	}
+	if err != nil {
+		h.logger.Error("Failed to inspect awards", zap.String("game_id", gameID), zap.Error(err))
+		h.WriteErrorResponse(w, http.StatusInternalServerError, "Failed to inspect awards")
+		return
+	}
+
+	response := dto.AdminAwardsResponse{
+		GameID:          gameID,
+		FreezeAtFunding: inspection.FreezeAtFunding,
+		Awards:          make([]dto.AdminAwardStandingsDto, 0, len(inspection.Awards)),
+	}
+	for _, award := range inspection.Awards {
+		response.Awards = append(response.Awards, dto.AdminAwardStandingsDto{
+			AwardType:          string(award.Type),
+			FundedBy:           award.FundedBy,
+			StandingsAtFunding: toAdminAwardStandingDtos(award.StandingsAtFunding),
+			CurrentStandings:   toAdminAwardStandingDtos(award.CurrentStandings),
+		})
+	}
+
+	h.WriteJSONResponse(w, http.StatusOK, response)
+}
+
+// ListCollusionFlags handles GET /api/v1/admin/collusion-flags
+// Flags are leads for an operator to review; nothing is done to the flagged players
+func (h *AdminHandler) ListCollusionFlags(w http.ResponseWriter, r *http.Request) {
+	flags, err := h.listCollusionFlagsAction.Execute(r.Context())
+	if err != nil {
+		h.logger.Error("Failed to list collusion flags", zap.Error(err))
+		h.WriteErrorResponse(w, http.StatusInternalServerError, "Failed to list collusion flags")
+		return
+	}
+
+	heuristics := h.listCollusionFlagsAction.Heuristics()
+	response := dto.AdminCollusionFlagsResponse{
+		Heuristics: make([]string, 0, 2),
+		MinAttacks: heuristics.MinAttacks,
+		Flags:      make([]dto.AdminCollusionFlagDto, 0, len(flags)),
+	}
+	if heuristics.SameAddress {
+		response.Heuristics = append(response.Heuristics, string(admin.CollusionFlagSameAddress))
+	}
+	if heuristics.TargetedAttacks {
+		response.Heuristics = append(response.Heuristics, string(admin.CollusionFlagTargetedAttacks))
+	}
+	for _, flag := range flags {
+		response.Flags = append(response.Flags, dto.AdminCollusionFlagDto{
+			GameID:    flag.GameID,
+			Kind:      string(flag.Kind),
+			PlayerIDs: flag.PlayerIDs,
+			Detail:    flag.Detail,
+		})
+	}
+
+	h.WriteJSONResponse(w, http.StatusOK, response)
+}
+
+// toAdminAwardStandingDtos keeps nil as nil, so unfrozen standings are left out of the response
+func toAdminAwardStandingDtos(standings []game.AwardStanding) []dto.AdminAwardStandingDto {
+	if standings == nil {
+		return nil
+	}
+	result := make([]dto.AdminAwardStandingDto, len(standings))
+	for i, s := range standings {
+		result[i] = dto.AdminAwardStandingDto{PlayerID: s.PlayerID, Score: s.Score, Placement: s.Placement}
+	}
+	return result
+}
+
+func toAdminGameFootprintDto(entry admin.GameFootprint) dto.AdminGameFootprintDto {
+	fp := entry.Footprint
+	return dto.AdminGameFootprintDto{
+		GameID:             entry.GameID,
+		Status:             dto.GameStatus(entry.Status),
+		Players:            fp.Players,
+		HandCards:          fp.HandCards,
+		PlayedCards:        fp.PlayedCards,
+		Effects:            fp.Effects,
+		Actions:            fp.Actions,
+		Tiles:              fp.Tiles,
+		DeckCards:          fp.DeckCards,
+		EventSubscriptions: fp.EventSubscriptions,
+		StateDiffs:         entry.StateDiffs,
+		EstimatedBytes:     entry.EstimatedBytes,
+		OverThreshold:      entry.OverThreshold,
+	}
+}
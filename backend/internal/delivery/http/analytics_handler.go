@@ -0,0 +1,29 @@
+package http
+
+import (
+	"net/http"
+
+	"terraforming-mars-backend/internal/analytics"
+	"terraforming-mars-backend/internal/delivery/dto"
+)
+
+// AnalyticsHandler serves aggregated card and corporation stats
+type AnalyticsHandler struct {
+	*BaseHandler
+	store *analytics.Store
+}
+
+// NewAnalyticsHandler creates a new analytics handler
+func NewAnalyticsHandler(store *analytics.Store) *AnalyticsHandler {
+	return &AnalyticsHandler{
+		BaseHandler: NewBaseHandler(),
+		store:       store,
+	}
+}
+
+// GetCardAnalytics handles GET /api/v1/analytics/cards
+// Only games created with analytics enabled contribute
+func (h *AnalyticsHandler) GetCardAnalytics(w http.ResponseWriter, r *http.Request) {
+	h.logger.Info("📡 HTTP GET /api/v1/analytics/cards")
+	h.WriteJSONResponse(w, http.StatusOK, dto.ToCardAnalyticsResponse(h.store))
+}
@@ -0,0 +1,89 @@
+package http
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"terraforming-mars-backend/internal/action/query"
+	"terraforming-mars-backend/internal/archive"
+	"terraforming-mars-backend/internal/cards"
+	"terraforming-mars-backend/internal/delivery/dto"
+	"terraforming-mars-backend/internal/game"
+
+	"github.com/gorilla/mux"
+	"go.uber.org/zap"
+)
+
+// ArchiveHandler serves history and stats of games moved to the archive
+type ArchiveHandler struct {
+	*BaseHandler
+	listArchivedGamesAction *query.ListArchivedGamesAction
+	getArchivedGameAction   *query.GetArchivedGameAction
+	cardRegistry            cards.CardRegistry
+}
+
+// NewArchiveHandler creates a new archive handler
+func NewArchiveHandler(
+	listArchivedGamesAction *query.ListArchivedGamesAction,
+	getArchivedGameAction *query.GetArchivedGameAction,
+	cardRegistry cards.CardRegistry,
+) *ArchiveHandler {
+	return &ArchiveHandler{
+		BaseHandler:             NewBaseHandler(),
+		listArchivedGamesAction: listArchivedGamesAction,
+		getArchivedGameAction:   getArchivedGameAction,
+		cardRegistry:            cardRegistry,
+	}
+}
+
+// ListGames handles GET /api/v1/archive/games
+func (h *ArchiveHandler) ListGames(w http.ResponseWriter, r *http.Request) {
+	q := archive.Query{PlayerName: r.URL.Query().Get("player")}
+	if limitParam := r.URL.Query().Get("limit"); limitParam != "" {
+		if _, err := fmt.Sscanf(limitParam, "%d", &q.Limit); err != nil || q.Limit < 0 {
+			h.WriteErrorResponse(w, http.StatusBadRequest, "Invalid limit")
+			return
+		}
+	}
+
+	h.logger.Info("📡 HTTP GET /api/v1/archive/games", zap.String("player_name", q.PlayerName), zap.Int("limit", q.Limit))
+
+	entries, err := h.listArchivedGamesAction.Execute(r.Context(), q)
+	if err != nil {
+		h.WriteErrorResponse(w, http.StatusInternalServerError, "Failed to list archived games")
+		return
+	}
+	h.WriteJSONResponse(w, http.StatusOK, dto.ToListArchivedGamesResponse(entries, h.cardRegistry))
+}
+
+// GetGame handles GET /api/v1/archive/games/{gameId}
+// The response has the same shape as a finished game's log.json export
+func (h *ArchiveHandler) GetGame(w http.ResponseWriter, r *http.Request) {
+	gameID := mux.Vars(r)["gameId"]
+	h.logger.Info("📡 HTTP GET /api/v1/archive/games/:gameId", zap.String("game_id", gameID))
+
+	record, err := h.getArchivedGameAction.Execute(r.Context(), gameID)
+	switch {
+	case errors.Is(err, game.ErrGameNotFound):
+		h.WriteErrorResponse(w, http.StatusNotFound, "Archived game not found")
+		return
+	case err != nil:
+		h.WriteErrorResponse(w, http.StatusInternalServerError, "Failed to load archived game")
+		return
+	}
+	h.WriteJSONResponse(w, http.StatusOK, dto.ToArchivedGameLogExport(record, h.cardRegistry))
+}
+
+// GetPlayerStats handles GET /api/v1/archive/players/{playerName}/stats
+func (h *ArchiveHandler) GetPlayerStats(w http.ResponseWriter, r *http.Request) {
+	playerName := mux.Vars(r)["playerName"]
+	h.logger.Info("📡 HTTP GET /api/v1/archive/players/:playerName/stats", zap.String("player_name", playerName))
+
+	stats, err := h.listArchivedGamesAction.PlayerStats(r.Context(), playerName)
+	if err != nil {
+		h.WriteErrorResponse(w, http.StatusInternalServerError, "Failed to query player stats")
+		return
+	}
+	h.WriteJSONResponse(w, http.StatusOK, dto.ToArchivedPlayerStatsResponse(stats))
+}
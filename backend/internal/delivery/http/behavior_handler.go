@@ -0,0 +1,68 @@
+package http
+
+import (
+	"net/http"
+
+	"terraforming-mars-backend/internal/cards"
+	"terraforming-mars-backend/internal/delivery/dto"
+	gamecards "terraforming-mars-backend/internal/game/cards"
+
+	"github.com/gorilla/mux"
+	"go.uber.org/zap"
+)
+
+// BehaviorHandler serves card behaviors as rules text, so every client words a card's rules the same
+// way and the text follows the engine data instead of the printed description
+type BehaviorHandler struct {
+	*BaseHandler
+	cardRegistry cards.CardRegistry
+}
+
+// NewBehaviorHandler creates a new behavior handler
+func NewBehaviorHandler(cardRegistry cards.CardRegistry) *BehaviorHandler {
+	return &BehaviorHandler{
+		BaseHandler:  NewBaseHandler(),
+		cardRegistry: cardRegistry,
+	}
+}
+
+// ExplainBehaviors handles GET /api/v1/cards/{cardId}/behaviors/explain
+func (h *BehaviorHandler) ExplainBehaviors(w http.ResponseWriter, r *http.Request) {
+	cardID := mux.Vars(r)["cardId"]
+	h.logger.Info("📡 HTTP GET /api/v1/cards/:cardId/behaviors/explain", zap.String("card_id", cardID))
+
+	card, err := h.cardRegistry.GetByID(cardID)
+	if err != nil {
+		h.WriteErrorResponse(w, http.StatusNotFound, err.Error())
+		return
+	}
+
+	explanations := gamecards.ExplainCard(card)
+	clauses := make([]dto.BehaviorClauseDto, 0, len(explanations))
+	for i, explanation := range explanations {
+		clauses = append(clauses, dto.BehaviorClauseDto{
+			BehaviorIndex: i,
+			Kind:          string(explanation.Kind),
+			Label:         explanation.Kind.Label(),
+			Condition:     explanation.Condition,
+			Costs:         nonNil(explanation.Costs),
+			Gains:         nonNil(explanation.Gains),
+			Choices:       nonNil(explanation.Choices),
+			Limit:         explanation.Limit,
+			Text:          explanation.Text,
+		})
+	}
+	h.WriteJSONResponse(w, http.StatusOK, dto.CardBehaviorsExplainResponse{
+		CardID:   card.ID,
+		CardName: card.Name,
+		Clauses:  clauses,
+	})
+}
+
+// nonNil keeps empty lists as [] rather than null in responses
+func nonNil(items []string) []string {
+	if items == nil {
+		return []string{}
+	}
+	return items
+}
@@ -0,0 +1,47 @@
+package http
+
+import (
+	"net/http"
+
+	"terraforming-mars-backend/internal/delivery/dto"
+
+	"go.uber.org/zap"
+)
+
+// CatalogHandler serves machine-readable descriptions of the client protocol
+type CatalogHandler struct {
+	*BaseHandler
+}
+
+// NewCatalogHandler creates a new catalog handler
+func NewCatalogHandler() *CatalogHandler {
+	return &CatalogHandler{
+		BaseHandler: NewBaseHandler(),
+	}
+}
+
+// GetActionCatalog handles GET /api/v1/action-catalog
+// Optional query parameter "phase" limits the result to actions valid in that phase
+func (h *CatalogHandler) GetActionCatalog(w http.ResponseWriter, r *http.Request) {
+	phaseParam := r.URL.Query().Get("phase")
+
+	h.logger.Info("📡 HTTP GET /api/v1/action-catalog", zap.String("phase", phaseParam))
+
+	if phaseParam == "" {
+		h.WriteJSONResponse(w, http.StatusOK, dto.ActionCatalogResponse{
+			Actions: dto.ActionCatalog(),
+		})
+		return
+	}
+
+	phase := dto.GamePhase(phaseParam)
+	if !dto.IsValidGamePhase(phase) {
+		h.WriteErrorResponse(w, http.StatusBadRequest, "unknown phase: "+phaseParam)
+		return
+	}
+
+	h.WriteJSONResponse(w, http.StatusOK, dto.ActionCatalogResponse{
+		Phase:   &phase,
+		Actions: dto.ActionCatalogForPhase(phase),
+	})
+}
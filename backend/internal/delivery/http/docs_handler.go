@@ -0,0 +1,64 @@
+package http
+
+import (
+	"net/http"
+
+	"terraforming-mars-backend/internal/delivery/apidoc"
+
+	"go.uber.org/zap"
+)
+
+// swaggerUIPage renders Swagger UI against the generated OpenAPI document
+const swaggerUIPage = `<!DOCTYPE html>
+<html lang="en">
+<head>
+  <meta charset="utf-8" />
+  <title>Terraforming Mars API</title>
+  <link rel="stylesheet" href="https://unpkg.com/swagger-ui-dist@5/swagger-ui.css" />
+</head>
+<body>
+  <div id="swagger-ui"></div>
+  <script src="https://unpkg.com/swagger-ui-dist@5/swagger-ui-bundle.js" crossorigin></script>
+  <script>
+    window.onload = () => {
+      window.ui = SwaggerUIBundle({ url: "/api/v1/openapi.json", dom_id: "#swagger-ui" });
+    };
+  </script>
+</body>
+</html>
+`
+
+// DocsHandler serves the generated OpenAPI document, WebSocket catalog and Swagger UI
+type DocsHandler struct {
+	*BaseHandler
+}
+
+// NewDocsHandler creates a new docs handler
+func NewDocsHandler() *DocsHandler {
+	return &DocsHandler{
+		BaseHandler: NewBaseHandler(),
+	}
+}
+
+// GetOpenAPISpec handles GET /api/v1/openapi.json
+func (h *DocsHandler) GetOpenAPISpec(w http.ResponseWriter, r *http.Request) {
+	h.logger.Info("📡 HTTP GET /api/v1/openapi.json")
+
+	h.WriteJSONResponse(w, http.StatusOK, apidoc.BuildOpenAPISpec("/api/v1"))
+}
+
+// GetWSSchema handles GET /api/v1/ws-schema
+func (h *DocsHandler) GetWSSchema(w http.ResponseWriter, r *http.Request) {
+	h.logger.Info("📡 HTTP GET /api/v1/ws-schema")
+
+	h.WriteJSONResponse(w, http.StatusOK, apidoc.BuildWSSchema())
+}
+
+// SwaggerUI handles GET /api/docs
+func (h *DocsHandler) SwaggerUI(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+	if _, err := w.Write([]byte(swaggerUIPage)); err != nil {
+		h.logger.Error("Failed to write Swagger UI page", zap.Error(err))
+	}
+}
@@ -2,9 +2,13 @@ package http
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"net/http"
+	"strconv"
 
+	demoaction "terraforming-mars-backend/internal/action/demo"
 	gameaction "terraforming-mars-backend/internal/action/game"
 	"terraforming-mars-backend/internal/action/query"
 	"terraforming-mars-backend/internal/cards"
@@ -20,10 +24,13 @@ import (
 type GameHandler struct {
 	createGameAction      *gameaction.CreateGameAction
 	createDemoLobbyAction *gameaction.CreateDemoLobbyAction
-	getGameAction         *query.GetGameAction
+	seedDemoGameAction    *demoaction.SeedDemoGameAction
+	gameQueries           *query.GameQueryService
 	getGameLogsAction     *query.GetGameLogsAction
+	exportGameLogAction   *query.ExportGameLogAction
 	listGamesAction       *query.ListGamesAction
 	listCardsAction       *query.ListCardsAction
+	resolveCardsAction    *query.ResolveCardsAction
 	cardRegistry          cards.CardRegistry
 }
 
@@ -31,19 +38,25 @@ type GameHandler struct {
 func NewGameHandler(
 	createGameAction *gameaction.CreateGameAction,
 	createDemoLobbyAction *gameaction.CreateDemoLobbyAction,
-	getGameAction *query.GetGameAction,
+	seedDemoGameAction *demoaction.SeedDemoGameAction,
+	gameQueries *query.GameQueryService,
 	getGameLogsAction *query.GetGameLogsAction,
+	exportGameLogAction *query.ExportGameLogAction,
 	listGamesAction *query.ListGamesAction,
 	listCardsAction *query.ListCardsAction,
+	resolveCardsAction *query.ResolveCardsAction,
 	cardRegistry cards.CardRegistry,
 ) *GameHandler {
 	return &GameHandler{
 		createGameAction:      createGameAction,
 		createDemoLobbyAction: createDemoLobbyAction,
-		getGameAction:         getGameAction,
+		seedDemoGameAction:    seedDemoGameAction,
+		gameQueries:           gameQueries,
 		getGameLogsAction:     getGameLogsAction,
+		exportGameLogAction:   exportGameLogAction,
 		listGamesAction:       listGamesAction,
 		listCardsAction:       listCardsAction,
+		resolveCardsAction:    resolveCardsAction,
 		cardRegistry:          cardRegistry,
 	}
 }
@@ -59,24 +72,24 @@ func (h *GameHandler) GetGame(w http.ResponseWriter, r *http.Request) {
 
 	log.Info("📡 HTTP GET /api/v1/games/:gameId", zap.String("game_id", gameID))
 
-	game, err := h.getGameAction.Execute(ctx, gameID)
+	// Served from the cached projection; rebuilt only after the game changes
+	gameDto, err := h.gameQueries.GameView(ctx, gameID, playerID)
 	if err != nil {
-		log.Warn("Failed to get game", zap.Error(err))
-		http.Error(w, "Game not found", http.StatusNotFound)
-		return
-	}
-
-	// If playerId provided, verify player is in the game
-	if playerID != "" {
-		if _, err := game.GetPlayer(playerID); err != nil {
+		if errors.Is(err, game.ErrGameNotFound) {
+			log.Warn("Failed to get game", zap.Error(err))
+			http.Error(w, "Game not found", http.StatusNotFound)
+			return
+		}
+		if errors.Is(err, query.ErrPlayerNotInGame) {
 			log.Warn("Player not in game", zap.String("player_id", playerID))
 			http.Error(w, "Player not in game", http.StatusNotFound)
 			return
 		}
+		log.Error("Failed to get game", zap.Error(err))
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
 	}
 
-	gameDto := dto.ToGameDto(game, h.cardRegistry, playerID)
-
 	response := dto.GetGameResponse{
 		Game: gameDto,
 	}
@@ -91,6 +104,42 @@ func (h *GameHandler) GetGame(w http.ResponseWriter, r *http.Request) {
 	log.Info("✅ Game retrieved successfully", zap.String("game_id", gameID))
 }
 
+// GetGameSummary handles GET /api/v1/games/{gameId}/summary
+func (h *GameHandler) GetGameSummary(w http.ResponseWriter, r *http.Request) {
+	log := logger.Get()
+	ctx := r.Context()
+
+	vars := mux.Vars(r)
+	gameID := vars["gameId"]
+	playerID := r.URL.Query().Get("playerId")
+
+	log.Debug("📡 HTTP GET /api/v1/games/:gameId/summary", zap.String("game_id", gameID))
+
+	summary, err := h.gameQueries.Summary(ctx, gameID, playerID)
+	if err != nil {
+		if errors.Is(err, game.ErrGameNotFound) {
+			log.Warn("Failed to get game summary", zap.Error(err))
+			http.Error(w, "Game not found", http.StatusNotFound)
+			return
+		}
+		if errors.Is(err, query.ErrPlayerNotInGame) {
+			log.Warn("Player not in game", zap.String("player_id", playerID))
+			http.Error(w, "Player not in game", http.StatusNotFound)
+			return
+		}
+		log.Error("Failed to get game summary", zap.Error(err))
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(dto.GetGameSummaryResponse{Summary: summary}); err != nil {
+		log.Error("Failed to encode response", zap.Error(err))
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+}
+
 // ListGames handles GET /api/v1/games
 func (h *GameHandler) ListGames(w http.ResponseWriter, r *http.Request) {
 	log := logger.Get()
@@ -111,9 +160,13 @@ func (h *GameHandler) ListGames(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	gameDtos := make([]dto.GameDto, len(games))
-	for i, game := range games {
-		gameDtos[i] = dto.ToGameDto(game, h.cardRegistry, "")
+	speed := r.URL.Query().Get("speed")
+	gameDtos := make([]dto.GameDto, 0, len(games))
+	for _, game := range games {
+		if speed != "" && game.Settings().RulesOptions.SpeedPreset != speed {
+			continue
+		}
+		gameDtos = append(gameDtos, dto.ToGameDto(game, h.cardRegistry, ""))
 	}
 
 	response := dto.ListGamesResponse{Games: gameDtos}
@@ -125,7 +178,7 @@ func (h *GameHandler) ListGames(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	log.Info("✅ Games listed successfully", zap.Int("count", len(games)))
+	log.Info("✅ Games listed successfully", zap.Int("count", len(gameDtos)))
 }
 
 // CreateGame handles POST /api/v1/games
@@ -145,11 +198,21 @@ func (h *GameHandler) CreateGame(w http.ResponseWriter, r *http.Request) {
 	settings := game.GameSettings{
 		MaxPlayers:      req.MaxPlayers,
 		DevelopmentMode: req.DevelopmentMode,
+		PassAndPlay:     req.PassAndPlay,
+		Analytics:       req.Analytics,
 		CardPacks:       req.CardPacks,
+		ColorPalette:    req.ColorPalette,
+	}
+	if req.RulesOptions != nil {
+		settings.RulesOptions = dto.FromRulesOptionsDto(*req.RulesOptions)
 	}
 
 	// Execute create game action
 	game, err := h.createGameAction.Execute(ctx, settings)
+	if writeIfAtCapacity(w, err) {
+		log.Warn("Refused game creation, server at capacity")
+		return
+	}
 	if err != nil {
 		log.Error("Failed to create game", zap.Error(err))
 		http.Error(w, "Failed to create game", http.StatusInternalServerError)
@@ -229,6 +292,42 @@ func (h *GameHandler) ListCards(w http.ResponseWriter, r *http.Request) {
 	log.Info("✅ Cards listed successfully", zap.Int("count", len(cardDtos)))
 }
 
+// ResolveCards handles GET /api/v1/cards/resolve?q=art photo
+// Clients that take card names as text use it instead of asking players for card IDs
+func (h *GameHandler) ResolveCards(w http.ResponseWriter, r *http.Request) {
+	log := logger.Get()
+	ctx := r.Context()
+
+	text := r.URL.Query().Get("q")
+	limit := 0
+	if limitParam := r.URL.Query().Get("limit"); limitParam != "" {
+		parsed, err := strconv.Atoi(limitParam)
+		if err != nil || parsed < 1 {
+			http.Error(w, "limit must be a positive number", http.StatusBadRequest)
+			return
+		}
+		limit = parsed
+	}
+
+	matches, err := h.resolveCardsAction.Execute(ctx, text, limit)
+	if err != nil {
+		if errors.Is(err, query.ErrEmptyCardQuery) {
+			http.Error(w, "q is required", http.StatusBadRequest)
+			return
+		}
+		log.Error("Failed to resolve cards", zap.Error(err))
+		http.Error(w, "Failed to resolve cards", http.StatusInternalServerError)
+		return
+	}
+
+	response := dto.ToResolveCardsResponse(text, matches)
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(response); err != nil {
+		log.Error("Failed to encode response", zap.Error(err))
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+	}
+}
+
 // GetGameLogs handles GET /api/v1/games/{gameId}/logs
 func (h *GameHandler) GetGameLogs(w http.ResponseWriter, r *http.Request) {
 	log := logger.Get()
@@ -255,7 +354,8 @@ func (h *GameHandler) GetGameLogs(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	diffsDto := dto.ToStateDiffDtos(diffs)
+	// Hand changes are only shown to their owner; without playerId every hand is hidden
+	diffsDto := dto.RedactStateDiffsForViewer(dto.ToStateDiffDtos(diffs), queryParams.Get("playerId"))
 
 	w.Header().Set("Content-Type", "application/json")
 	if err := json.NewEncoder(w).Encode(diffsDto); err != nil {
@@ -267,6 +367,63 @@ func (h *GameHandler) GetGameLogs(w http.ResponseWriter, r *http.Request) {
 	log.Info("✅ Game logs retrieved successfully", zap.String("game_id", gameID), zap.Int("count", len(diffs)))
 }
 
+// ExportGameLogJSON handles GET /api/v1/games/{gameId}/log.json
+func (h *GameHandler) ExportGameLogJSON(w http.ResponseWriter, r *http.Request) {
+	log := logger.Get()
+	gameID := mux.Vars(r)["gameId"]
+
+	log.Info("📡 HTTP GET /api/v1/games/:gameId/log.json", zap.String("game_id", gameID))
+
+	export, ok := h.exportGameLog(w, r, gameID)
+	if !ok {
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="tm-%s.json"`, gameID))
+	if err := json.NewEncoder(w).Encode(export); err != nil {
+		log.Error("Failed to encode response", zap.Error(err))
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+}
+
+// ExportGameLogText handles GET /api/v1/games/{gameId}/log.txt
+func (h *GameHandler) ExportGameLogText(w http.ResponseWriter, r *http.Request) {
+	log := logger.Get()
+	gameID := mux.Vars(r)["gameId"]
+
+	log.Info("📡 HTTP GET /api/v1/games/:gameId/log.txt", zap.String("game_id", gameID))
+
+	export, ok := h.exportGameLog(w, r, gameID)
+	if !ok {
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="tm-%s.txt"`, gameID))
+	if _, err := w.Write([]byte(dto.RenderGameLogText(export))); err != nil {
+		log.Error("Failed to write response", zap.Error(err))
+	}
+}
+
+// exportGameLog loads the export for both formats, writing the error response when it cannot
+func (h *GameHandler) exportGameLog(w http.ResponseWriter, r *http.Request, gameID string) (dto.GameLogExportResponse, bool) {
+	g, diffs, err := h.exportGameLogAction.Execute(r.Context(), gameID)
+	switch {
+	case errors.Is(err, game.ErrGameNotFound):
+		http.Error(w, "Game not found", http.StatusNotFound)
+		return dto.GameLogExportResponse{}, false
+	case errors.Is(err, query.ErrGameNotFinished):
+		http.Error(w, "The log can be exported once the game has ended", http.StatusConflict)
+		return dto.GameLogExportResponse{}, false
+	case err != nil:
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return dto.GameLogExportResponse{}, false
+	}
+	return dto.ToGameLogExportResponse(g, diffs, h.cardRegistry), true
+}
+
 // CreateDemoLobby handles POST /api/v1/games/demo/lobby
 func (h *GameHandler) CreateDemoLobby(w http.ResponseWriter, r *http.Request) {
 	log := logger.Get()
@@ -288,6 +445,10 @@ func (h *GameHandler) CreateDemoLobby(w http.ResponseWriter, r *http.Request) {
 	}
 
 	result, err := h.createDemoLobbyAction.Execute(ctx, settings)
+	if writeIfAtCapacity(w, err) {
+		log.Warn("Refused demo lobby, server at capacity")
+		return
+	}
 	if err != nil {
 		log.Error("Failed to create demo lobby", zap.Error(err))
 		http.Error(w, err.Error(), http.StatusInternalServerError)
@@ -309,3 +470,46 @@ func (h *GameHandler) CreateDemoLobby(w http.ResponseWriter, r *http.Request) {
 
 	log.Info("Demo lobby created successfully", zap.String("game_id", result.GameDto.ID))
 }
+
+// SeedDemoGame handles POST /api/v1/games/demo/seeded
+// Returns a demo game with bot seats, already in the action phase of a later generation
+func (h *GameHandler) SeedDemoGame(w http.ResponseWriter, r *http.Request) {
+	log := logger.Get()
+	log.Info("POST /api/v1/games/demo/seeded")
+
+	var req dto.SeedDemoGameRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil && !errors.Is(err, io.EOF) {
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	result, err := h.seedDemoGameAction.Execute(r.Context(), demoaction.SeedSettings{
+		PlayerName: req.PlayerName,
+		BotCount:   req.BotCount,
+		Generation: req.Generation,
+		CardPacks:  req.CardPacks,
+		AccountID:  req.AccountID,
+	})
+	if writeIfAtCapacity(w, err) {
+		log.Warn("Refused seeded demo game, server at capacity")
+		return
+	}
+	if err != nil {
+		log.Error("Failed to seed demo game", zap.Error(err))
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	if err := json.NewEncoder(w).Encode(dto.SeedDemoGameResponse{
+		Game:     result.GameDto,
+		PlayerID: result.PlayerID,
+		BotIDs:   result.BotIDs,
+	}); err != nil {
+		log.Error("Failed to encode response", zap.Error(err))
+		return
+	}
+
+	log.Info("Demo game seeded", zap.String("game_id", result.GameDto.ID))
+}
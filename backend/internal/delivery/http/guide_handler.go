@@ -0,0 +1,56 @@
+package http
+
+import (
+	"net/http"
+
+	"terraforming-mars-backend/internal/cards"
+	"terraforming-mars-backend/internal/delivery/dto"
+
+	"github.com/gorilla/mux"
+	"go.uber.org/zap"
+)
+
+// GuideHandler serves corporation guides for new players choosing a corporation
+type GuideHandler struct {
+	*BaseHandler
+	guides       *cards.GuideRegistry
+	cardRegistry cards.CardRegistry
+}
+
+// NewGuideHandler creates a new guide handler
+func NewGuideHandler(guides *cards.GuideRegistry, cardRegistry cards.CardRegistry) *GuideHandler {
+	return &GuideHandler{
+		BaseHandler:  NewBaseHandler(),
+		guides:       guides,
+		cardRegistry: cardRegistry,
+	}
+}
+
+// GetGuide handles GET /api/v1/cards/{cardId}/guide
+func (h *GuideHandler) GetGuide(w http.ResponseWriter, r *http.Request) {
+	cardID := mux.Vars(r)["cardId"]
+	h.logger.Info("📡 HTTP GET /api/v1/cards/:cardId/guide", zap.String("card_id", cardID))
+
+	card, err := h.cardRegistry.GetByID(cardID)
+	if err != nil {
+		h.WriteErrorResponse(w, http.StatusNotFound, err.Error())
+		return
+	}
+	guide, ok := h.guides.GetByCardID(cardID)
+	if !ok {
+		h.WriteErrorResponse(w, http.StatusNotFound, "no guide for card: "+cardID)
+		return
+	}
+
+	tips := guide.Tips
+	if tips == nil {
+		tips = []string{}
+	}
+	h.WriteJSONResponse(w, http.StatusOK, dto.CardGuideResponse{
+		CardID:   card.ID,
+		CardName: card.Name,
+		Summary:  guide.Summary,
+		Strategy: guide.Strategy,
+		Tips:     tips,
+	})
+}
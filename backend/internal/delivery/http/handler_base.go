@@ -2,9 +2,13 @@ package http
 
 import (
 	"encoding/json"
+	"errors"
 	"net/http"
+	"strconv"
+	"time"
 
 	"terraforming-mars-backend/internal/delivery/dto"
+	"terraforming-mars-backend/internal/game"
 	"terraforming-mars-backend/internal/logger"
 
 	"go.uber.org/zap"
@@ -41,6 +45,22 @@ func (h *BaseHandler) WriteErrorResponse(w http.ResponseWriter, statusCode int,
 	h.WriteJSONResponse(w, statusCode, errorResponse)
 }
 
+// CapacityRetryAfter is how long clients are asked to wait before creating a game on a full instance
+const CapacityRetryAfter = 30 * time.Second
+
+// writeIfAtCapacity answers 503 with a Retry-After header when err says the instance runs its maximum
+// of games, and reports whether it did
+func writeIfAtCapacity(w http.ResponseWriter, err error) bool {
+	if !errors.Is(err, game.ErrServerAtCapacity) {
+		return false
+	}
+	w.Header().Set("Retry-After", strconv.Itoa(int(CapacityRetryAfter.Seconds())))
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusServiceUnavailable)
+	_ = json.NewEncoder(w).Encode(dto.ErrorPayload{Message: err.Error(), Code: dto.ErrCodeServerAtCapacity})
+	return true
+}
+
 // ParseJSONRequest parses a JSON request body into the provided struct
 func (h *BaseHandler) ParseJSONRequest(r *http.Request, dest interface{}) error {
 	if err := json.NewDecoder(r.Body).Decode(dest); err != nil {
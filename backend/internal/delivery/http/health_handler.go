@@ -2,20 +2,53 @@ package http
 
 import (
 	"net/http"
+
+	"terraforming-mars-backend/internal/buildinfo"
+	"terraforming-mars-backend/internal/delivery/dto"
+	"terraforming-mars-backend/internal/game"
 )
 
-// HealthHandler handles HTTP health check requests
+// ReadinessCheck is one condition the server needs before it can take traffic
+type ReadinessCheck struct {
+	Name  string
+	Check func() error
+}
+
+// HealthHandler handles HTTP health check requests: liveness, readiness and build info
 type HealthHandler struct {
 	*BaseHandler
+	build    buildinfo.Info
+	checks   []ReadinessCheck
+	capacity func() game.GameCapacity
 }
 
 // NewHealthHandler creates a new health handler
-func NewHealthHandler() *HealthHandler {
+func NewHealthHandler(build buildinfo.Info, checks ...ReadinessCheck) *HealthHandler {
 	return &HealthHandler{
 		BaseHandler: NewBaseHandler(),
+		build:       build,
+		checks:      checks,
 	}
 }
 
+// SetCapacity sets where the capacity endpoint reads running games from
+func (h *HealthHandler) SetCapacity(capacity func() game.GameCapacity) {
+	h.capacity = capacity
+}
+
+// Capacity reports running games against the instance's cap
+func (h *HealthHandler) Capacity(w http.ResponseWriter, r *http.Request) {
+	var capacity game.GameCapacity
+	if h.capacity != nil {
+		capacity = h.capacity()
+	}
+	h.WriteJSONResponse(w, http.StatusOK, dto.CapacityResponse{
+		ActiveGames:    capacity.Active,
+		MaxActiveGames: capacity.Max,
+		Utilization:    capacity.Utilization(),
+	})
+}
+
 // HealthCheck returns the health status of the service
 func (h *HealthHandler) HealthCheck(w http.ResponseWriter, r *http.Request) {
 	response := map[string]string{
@@ -25,3 +58,37 @@ func (h *HealthHandler) HealthCheck(w http.ResponseWriter, r *http.Request) {
 
 	h.WriteJSONResponse(w, http.StatusOK, response)
 }
+
+// Liveness answers the orchestrator's liveness probe: the process is up and serving HTTP
+func (h *HealthHandler) Liveness(w http.ResponseWriter, r *http.Request) {
+	h.WriteJSONResponse(w, http.StatusOK, map[string]string{"status": "ok"})
+}
+
+// Readiness runs every readiness check and answers 503 until all of them pass
+func (h *HealthHandler) Readiness(w http.ResponseWriter, r *http.Request) {
+	response := dto.ReadinessResponse{Status: "ready", Checks: make([]dto.ReadinessCheckDto, 0, len(h.checks))}
+	status := http.StatusOK
+
+	for _, check := range h.checks {
+		result := dto.ReadinessCheckDto{Name: check.Name, OK: true}
+		if err := check.Check(); err != nil {
+			result.OK = false
+			result.Error = err.Error()
+			response.Status = "not-ready"
+			status = http.StatusServiceUnavailable
+		}
+		response.Checks = append(response.Checks, result)
+	}
+
+	h.WriteJSONResponse(w, status, response)
+}
+
+// BuildInfo returns the git SHA and card data version of the running build
+func (h *HealthHandler) BuildInfo(w http.ResponseWriter, r *http.Request) {
+	h.WriteJSONResponse(w, http.StatusOK, dto.BuildInfoResponse{
+		GitSHA:          h.build.GitSHA,
+		Modified:        h.build.Modified,
+		GoVersion:       h.build.GoVersion,
+		CardDataVersion: h.build.CardDataVersion,
+	})
+}
@@ -0,0 +1,151 @@
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+
+	gameaction "terraforming-mars-backend/internal/action/game"
+	"terraforming-mars-backend/internal/delivery/dto"
+	"terraforming-mars-backend/internal/delivery/websocket/core"
+	"terraforming-mars-backend/internal/game"
+
+	"github.com/gorilla/mux"
+	"go.uber.org/zap"
+)
+
+// InviteHandler lets the host hand out links that seat a named player without entering the game ID
+// The host is identified by their player ID, the same way player routes identify a seat.
+type InviteHandler struct {
+	*BaseHandler
+	createInviteAction *gameaction.CreateInviteAction
+	listInvitesAction  *gameaction.ListInvitesAction
+	revokeInviteAction *gameaction.RevokeInviteAction
+	hub                *core.Hub
+	publicURL          string // Base URL join links are built on; empty leaves them relative
+}
+
+// NewInviteHandler creates a new invite handler
+func NewInviteHandler(
+	createInviteAction *gameaction.CreateInviteAction,
+	listInvitesAction *gameaction.ListInvitesAction,
+	revokeInviteAction *gameaction.RevokeInviteAction,
+	hub *core.Hub,
+	publicURL string,
+) *InviteHandler {
+	return &InviteHandler{
+		BaseHandler:        NewBaseHandler(),
+		createInviteAction: createInviteAction,
+		listInvitesAction:  listInvitesAction,
+		revokeInviteAction: revokeInviteAction,
+		hub:                hub,
+		publicURL:          strings.TrimSuffix(publicURL, "/"),
+	}
+}
+
+// CreateInvite handles POST /api/v1/games/{gameId}/invites
+func (h *InviteHandler) CreateInvite(w http.ResponseWriter, r *http.Request) {
+	gameID := mux.Vars(r)["gameId"]
+	log := h.logger.With(zap.String("game_id", gameID))
+	log.Info("📡 HTTP POST /api/v1/games/:gameId/invites")
+
+	var req dto.CreateInviteRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil || req.PlayerID == "" {
+		h.WriteErrorResponse(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	var invite game.Invite
+	var inviteErr error
+	if err := h.hub.RunInGameQueue(r.Context(), gameID, func(ctx context.Context) {
+		invite, inviteErr = h.createInviteAction.Execute(ctx, gameID, req.PlayerID, req.SeatName)
+	}); err != nil {
+		h.WriteErrorResponse(w, http.StatusServiceUnavailable, "Request was cancelled before it ran")
+		return
+	}
+	if inviteErr != nil {
+		h.writeInviteError(w, inviteErr)
+		return
+	}
+
+	h.WriteJSONResponse(w, http.StatusCreated, dto.InviteResponse{
+		Invite: dto.ToInviteDto(gameID, invite, h.publicURL),
+	})
+}
+
+// ListInvites handles GET /api/v1/games/{gameId}/invites?playerId={hostId}
+func (h *InviteHandler) ListInvites(w http.ResponseWriter, r *http.Request) {
+	gameID := mux.Vars(r)["gameId"]
+	playerID := r.URL.Query().Get("playerId")
+	log := h.logger.With(zap.String("game_id", gameID))
+	log.Info("📡 HTTP GET /api/v1/games/:gameId/invites")
+
+	invites, err := h.listInvitesAction.Execute(r.Context(), gameID, playerID)
+	if err != nil {
+		h.writeInviteError(w, err)
+		return
+	}
+
+	h.WriteJSONResponse(w, http.StatusOK, dto.ToListInvitesResponse(gameID, invites, h.publicURL))
+}
+
+// GetInvite handles GET /api/v1/games/{gameId}/invites/{token}
+// Anyone holding the token may look it up, so the join page can show the reserved seat
+func (h *InviteHandler) GetInvite(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	gameID := vars["gameId"]
+	log := h.logger.With(zap.String("game_id", gameID))
+	log.Info("📡 HTTP GET /api/v1/games/:gameId/invites/:token")
+
+	invite, err := h.listInvitesAction.GetInvite(r.Context(), gameID, vars["token"])
+	if err != nil {
+		h.writeInviteError(w, err)
+		return
+	}
+
+	h.WriteJSONResponse(w, http.StatusOK, dto.InviteResponse{
+		Invite: dto.ToInviteDto(gameID, invite, h.publicURL),
+	})
+}
+
+// RevokeInvite handles DELETE /api/v1/games/{gameId}/invites/{token}?playerId={hostId}
+// Answers with the invites still open
+func (h *InviteHandler) RevokeInvite(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	gameID := vars["gameId"]
+	playerID := r.URL.Query().Get("playerId")
+	log := h.logger.With(zap.String("game_id", gameID))
+	log.Info("📡 HTTP DELETE /api/v1/games/:gameId/invites/:token")
+
+	var invites []game.Invite
+	var revokeErr error
+	if err := h.hub.RunInGameQueue(r.Context(), gameID, func(ctx context.Context) {
+		if revokeErr = h.revokeInviteAction.Execute(ctx, gameID, playerID, vars["token"]); revokeErr == nil {
+			invites, revokeErr = h.listInvitesAction.Execute(ctx, gameID, playerID)
+		}
+	}); err != nil {
+		h.WriteErrorResponse(w, http.StatusServiceUnavailable, "Request was cancelled before it ran")
+		return
+	}
+	if revokeErr != nil {
+		h.writeInviteError(w, revokeErr)
+		return
+	}
+
+	h.WriteJSONResponse(w, http.StatusOK, dto.ToListInvitesResponse(gameID, invites, h.publicURL))
+}
+
+func (h *InviteHandler) writeInviteError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, game.ErrGameNotFound):
+		h.WriteErrorResponse(w, http.StatusNotFound, "Game not found")
+	case errors.Is(err, game.ErrInviteNotFound):
+		h.WriteErrorResponse(w, http.StatusNotFound, "Invite not found")
+	case errors.Is(err, gameaction.ErrNotHost):
+		h.WriteErrorResponse(w, http.StatusForbidden, err.Error())
+	default:
+		h.WriteErrorResponse(w, http.StatusConflict, err.Error())
+	}
+}
@@ -0,0 +1,191 @@
+package http
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"terraforming-mars-backend/internal/action/query"
+	"terraforming-mars-backend/internal/delivery/dto"
+	"terraforming-mars-backend/internal/game"
+
+	"github.com/gorilla/mux"
+	"go.uber.org/zap"
+)
+
+// overlayStreamKeepAlive is how long a stream may stay silent before a comment frame keeps proxies from closing it
+const overlayStreamKeepAlive = 15 * time.Second
+
+// OverlayHandler serves spectator-safe game overlays for streams and TV screens
+type OverlayHandler struct {
+	*BaseHandler
+	getGameOverlayAction *query.GetGameOverlayAction
+}
+
+// NewOverlayHandler creates a new overlay handler
+func NewOverlayHandler(getGameOverlayAction *query.GetGameOverlayAction) *OverlayHandler {
+	return &OverlayHandler{
+		BaseHandler:          NewBaseHandler(),
+		getGameOverlayAction: getGameOverlayAction,
+	}
+}
+
+// GetOverlay handles GET /api/v1/games/{gameId}/overlay
+func (h *OverlayHandler) GetOverlay(w http.ResponseWriter, r *http.Request) {
+	gameID := mux.Vars(r)["gameId"]
+
+	overlay, err := h.getGameOverlayAction.Execute(r.Context(), gameID)
+	if err != nil {
+		h.writeOverlayError(w, err)
+		return
+	}
+
+	w.Header().Set("Cache-Control", "no-cache")
+	h.WriteJSONResponse(w, http.StatusOK, dto.GetGameOverlayResponse{Overlay: overlay})
+}
+
+// StreamOverlay handles GET /api/v1/games/{gameId}/overlay/stream
+// Server-sent events: an "overlay" event with the current overlay right away and after every change,
+// an "action" event per log entry written while streaming, and an "end" event once the game is over
+// or goes away. The stream waits on the game's changes and reads only the log entries after the
+// last one it sent.
+func (h *OverlayHandler) StreamOverlay(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	gameID := mux.Vars(r)["gameId"]
+	log := h.logger.With(zap.String("game_id", gameID))
+
+	changes, stopWatching, err := h.getGameOverlayAction.Watch(ctx, gameID)
+	if err != nil {
+		h.writeOverlayError(w, err)
+		return
+	}
+	defer stopWatching()
+
+	overlay, err := h.getGameOverlayAction.Execute(ctx, gameID)
+	if err != nil {
+		h.writeOverlayError(w, err)
+		return
+	}
+
+	// The stream outlives the server's write timeout
+	controller := http.NewResponseController(w)
+	if err := controller.SetWriteDeadline(time.Time{}); err != nil && !errors.Is(err, http.ErrNotSupported) {
+		log.Warn("Failed to lift write deadline for overlay stream", zap.Error(err))
+	}
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+
+	log.Info("📺 Overlay stream opened")
+	defer log.Info("📺 Overlay stream closed")
+
+	stream := &overlayStream{w: w, controller: controller}
+	if _, err := stream.send(overlay, nil); err != nil {
+		log.Debug("Overlay stream write failed", zap.Error(err))
+		return
+	}
+	keepAlive := time.NewTimer(overlayStreamKeepAlive)
+	defer keepAlive.Stop()
+
+	for {
+		wrote := false
+		select {
+		case <-ctx.Done():
+			return
+		case <-keepAlive.C:
+			wrote, err = true, stream.keepAlive()
+		case _, open := <-changes:
+			overlay, actions, readErr := h.getGameOverlayAction.ExecuteSince(ctx, gameID, stream.cursor)
+			if readErr == nil {
+				wrote, err = stream.send(overlay, actions)
+			}
+			// A closed channel means the game is over or gone, so nothing more will change
+			if readErr != nil || !open {
+				stream.end()
+				return
+			}
+		}
+		if err != nil {
+			log.Debug("Overlay stream write failed", zap.Error(err))
+			return
+		}
+		if wrote {
+			keepAlive.Reset(overlayStreamKeepAlive)
+		}
+	}
+}
+
+// overlayStream writes overlay server-sent events and remembers what it already sent
+type overlayStream struct {
+	w          http.ResponseWriter
+	controller *http.ResponseController
+	cursor     int64                // Sequence number of the newest log entry sent
+	lastAction *dto.RecentActionDto // Shown on the overlay until a newer entry is written
+	last       []byte               // Last overlay sent, so unchanged ones are skipped
+}
+
+// send writes an action event per new log entry, then the overlay if it changed
+func (s *overlayStream) send(overlay dto.GameOverlayDto, actions []dto.RecentActionDto) (bool, error) {
+	wrote := false
+	for _, action := range actions {
+		data, err := json.Marshal(action)
+		if err != nil {
+			return wrote, err
+		}
+		if _, err := fmt.Fprintf(s.w, "event: action\ndata: %s\n\n", data); err != nil {
+			return wrote, err
+		}
+		wrote = true
+	}
+
+	if overlay.LastAction == nil {
+		overlay.LastAction = s.lastAction
+	}
+	s.lastAction = overlay.LastAction
+	if s.lastAction != nil {
+		s.cursor = s.lastAction.SequenceNumber
+	}
+
+	data, err := json.Marshal(overlay)
+	if err != nil {
+		return wrote, err
+	}
+	if !bytes.Equal(data, s.last) {
+		if _, err := fmt.Fprintf(s.w, "event: overlay\ndata: %s\n\n", data); err != nil {
+			return wrote, err
+		}
+		s.last = data
+		wrote = true
+	}
+	if !wrote {
+		return false, nil
+	}
+	return true, s.controller.Flush()
+}
+
+func (s *overlayStream) keepAlive() error {
+	if _, err := fmt.Fprint(s.w, ": keep-alive\n\n"); err != nil {
+		return err
+	}
+	return s.controller.Flush()
+}
+
+func (s *overlayStream) end() {
+	fmt.Fprint(s.w, "event: end\ndata: {}\n\n")
+	_ = s.controller.Flush()
+}
+
+func (h *OverlayHandler) writeOverlayError(w http.ResponseWriter, err error) {
+	if errors.Is(err, game.ErrGameNotFound) {
+		http.Error(w, "Game not found", http.StatusNotFound)
+		return
+	}
+	h.logger.Error("Failed to get game overlay", zap.Error(err))
+	http.Error(w, "Internal server error", http.StatusInternalServerError)
+}
@@ -0,0 +1,102 @@
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	cardaction "terraforming-mars-backend/internal/action/card"
+	"terraforming-mars-backend/internal/delivery/dto"
+	"terraforming-mars-backend/internal/delivery/websocket/core"
+	"terraforming-mars-backend/internal/game"
+	"terraforming-mars-backend/internal/game/shared"
+
+	"github.com/gorilla/mux"
+	"go.uber.org/zap"
+)
+
+// PreviewHandler dry-runs card plays and card actions so clients can show their effects before committing
+type PreviewHandler struct {
+	*BaseHandler
+	previewAction *cardaction.PreviewActionAction
+	hub           *core.Hub
+}
+
+// NewPreviewHandler creates a new preview handler
+func NewPreviewHandler(previewAction *cardaction.PreviewActionAction, hub *core.Hub) *PreviewHandler {
+	return &PreviewHandler{
+		BaseHandler:   NewBaseHandler(),
+		previewAction: previewAction,
+		hub:           hub,
+	}
+}
+
+// PreviewAction handles POST /api/v1/games/{gameId}/players/{playerId}/preview-action
+// The preview runs in the game's hub queue, so it never interleaves with a real action.
+// A preview the action would reject answers 422 with the rejection message.
+func (h *PreviewHandler) PreviewAction(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	gameID := vars["gameId"]
+	playerID := vars["playerId"]
+	log := h.logger.With(zap.String("game_id", gameID), zap.String("player_id", playerID))
+	log.Info("📡 HTTP POST /api/v1/games/:gameId/players/:playerId/preview-action")
+
+	var req dto.PreviewActionRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil || req.CardID == "" {
+		h.WriteErrorResponse(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	request := cardaction.PreviewRequest{
+		Kind:              cardaction.PreviewKind(req.Type),
+		CardID:            req.CardID,
+		BehaviorIndex:     req.BehaviorIndex,
+		ChoiceIndex:       req.ChoiceIndex,
+		CardStorageTarget: req.CardStorageTarget,
+		TargetPlayerID:    req.TargetPlayerID,
+		Options: cardaction.PlayOptions{
+			AttackAmount:   req.AttackAmount,
+			DiscardCardIDs: req.DiscardCardIDs,
+		},
+	}
+	switch request.Kind {
+	case cardaction.PreviewKindPlayCard, cardaction.PreviewKindCardAction:
+	default:
+		h.WriteErrorResponse(w, http.StatusBadRequest, "Unknown preview type")
+		return
+	}
+	if req.Payment != nil {
+		request.Payment = cardaction.PaymentRequest{
+			Credits:     req.Payment.Credits,
+			Steel:       req.Payment.Steel,
+			Titanium:    req.Payment.Titanium,
+			Substitutes: make(map[shared.ResourceType]int, len(req.Payment.Substitutes)),
+		}
+		for resourceType, amount := range req.Payment.Substitutes {
+			request.Payment.Substitutes[shared.ResourceType(resourceType)] = amount
+		}
+	}
+
+	var diffs []game.StateDiff
+	var previewErr error
+	if err := h.hub.RunInGameQueue(r.Context(), gameID, func(ctx context.Context) {
+		diffs, previewErr = h.previewAction.Execute(ctx, gameID, playerID, request)
+	}); err != nil {
+		h.WriteErrorResponse(w, http.StatusServiceUnavailable, "Preview was cancelled before it ran")
+		return
+	}
+
+	switch {
+	case errors.Is(previewErr, game.ErrGameNotFound):
+		h.WriteErrorResponse(w, http.StatusNotFound, "Game not found")
+		return
+	case previewErr != nil:
+		h.WriteErrorResponse(w, http.StatusUnprocessableEntity, previewErr.Error())
+		return
+	}
+
+	h.WriteJSONResponse(w, http.StatusOK, dto.PreviewActionResponse{
+		Diffs: dto.RedactStateDiffsForViewer(dto.ToStateDiffDtos(diffs), ""),
+	})
+}
@@ -0,0 +1,42 @@
+package http
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/pprof"
+	"time"
+
+	"github.com/gorilla/mux"
+)
+
+// DefaultProfilingAddr is where the pprof listener binds unless TM_PPROF_ADDR moves it
+const DefaultProfilingAddr = "127.0.0.1:6060"
+
+// NewProfilingServer serves the net/http/pprof endpoints under /debug/pprof/ on their own listener
+// Profiles expose internals and can be expensive, so the address must be loopback: reach it over SSH or from the host
+func NewProfilingServer(addr string) (*http.Server, error) {
+	host, _, err := net.SplitHostPort(addr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid profiling address %q: %w", addr, err)
+	}
+	if ip := net.ParseIP(host); host != "localhost" && (ip == nil || !ip.IsLoopback()) {
+		return nil, fmt.Errorf("profiling address %q is not a loopback address", addr)
+	}
+
+	router := mux.NewRouter()
+	debug := router.PathPrefix("/debug/pprof").Subrouter()
+	debug.HandleFunc("/cmdline", pprof.Cmdline).Methods(http.MethodGet)
+	debug.HandleFunc("/profile", pprof.Profile).Methods(http.MethodGet)
+	debug.HandleFunc("/symbol", pprof.Symbol).Methods(http.MethodGet, http.MethodPost)
+	debug.HandleFunc("/trace", pprof.Trace).Methods(http.MethodGet)
+	// Index also serves named profiles: heap, goroutine, allocs, block, mutex, threadcreate
+	debug.PathPrefix("/").HandlerFunc(pprof.Index).Methods(http.MethodGet)
+
+	// No write timeout: /profile and /trace stream for as long as the caller asks
+	return &http.Server{
+		Addr:              addr,
+		Handler:           router,
+		ReadHeaderTimeout: 15 * time.Second,
+	}, nil
+}
@@ -0,0 +1,94 @@
+package http
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+
+	tutorialaction "terraforming-mars-backend/internal/action/tutorial"
+	"terraforming-mars-backend/internal/delivery/dto"
+	"terraforming-mars-backend/internal/tutorial"
+
+	"github.com/gorilla/mux"
+	"go.uber.org/zap"
+)
+
+// PuzzleHandler serves the puzzle library
+type PuzzleHandler struct {
+	*BaseHandler
+	puzzles           *tutorial.Registry
+	completions       *tutorial.CompletionStore
+	startPuzzleAction *tutorialaction.StartTutorialAction
+}
+
+// NewPuzzleHandler creates a new puzzle handler
+func NewPuzzleHandler(puzzles *tutorial.Registry, completions *tutorial.CompletionStore, startPuzzleAction *tutorialaction.StartTutorialAction) *PuzzleHandler {
+	return &PuzzleHandler{
+		BaseHandler:       NewBaseHandler(),
+		puzzles:           puzzles,
+		completions:       completions,
+		startPuzzleAction: startPuzzleAction,
+	}
+}
+
+// ListPuzzles handles GET /api/v1/puzzles
+// Optional query parameter "accountId" fills in which puzzles that account has solved
+func (h *PuzzleHandler) ListPuzzles(w http.ResponseWriter, r *http.Request) {
+	accountID := r.URL.Query().Get("accountId")
+	h.logger.Info("📡 HTTP GET /api/v1/puzzles", zap.String("account_id", accountID))
+
+	scenarios := h.puzzles.List()
+	puzzles := make([]dto.PuzzleDto, 0, len(scenarios))
+	for _, scenario := range scenarios {
+		if scenario.Victory == nil {
+			continue
+		}
+		puzzles = append(puzzles, dto.PuzzleDto{
+			ID:           scenario.ID,
+			Name:         scenario.Name,
+			Description:  scenario.Description,
+			VictoryType:  scenario.Victory.Type,
+			Target:       scenario.Victory.Target,
+			ByGeneration: scenario.Victory.ByGeneration,
+			Completed:    accountID != "" && h.completions.IsCompleted(accountID, scenario.ID),
+		})
+	}
+
+	h.WriteJSONResponse(w, http.StatusOK, dto.ListPuzzlesResponse{Puzzles: puzzles})
+}
+
+// StartPuzzle handles POST /api/v1/puzzles/{puzzleId}/start
+// The victory condition is reported in the puzzle field of tutorial-progress messages
+func (h *PuzzleHandler) StartPuzzle(w http.ResponseWriter, r *http.Request) {
+	puzzleID := mux.Vars(r)["puzzleId"]
+	log := h.logger.With(zap.String("puzzle_id", puzzleID))
+	log.Info("📡 HTTP POST /api/v1/puzzles/:puzzleId/start")
+
+	var req dto.StartTutorialRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil && !errors.Is(err, io.EOF) {
+		h.WriteErrorResponse(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	if _, err := h.puzzles.GetByID(puzzleID); err != nil {
+		h.WriteErrorResponse(w, http.StatusNotFound, err.Error())
+		return
+	}
+
+	result, err := h.startPuzzleAction.Execute(r.Context(), puzzleID, req.PlayerName, req.AccountID)
+	if writeIfAtCapacity(w, err) {
+		log.Warn("Refused puzzle, server at capacity")
+		return
+	}
+	if err != nil {
+		log.Error("Failed to start puzzle", zap.Error(err))
+		h.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	h.WriteJSONResponse(w, http.StatusCreated, dto.StartTutorialResponse{
+		Game:     result.GameDto,
+		PlayerID: result.PlayerID,
+	})
+}
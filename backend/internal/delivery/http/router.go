@@ -3,10 +3,20 @@ package http
 import (
 	"net/http"
 
+	accountaction "terraforming-mars-backend/internal/action/account"
+	"terraforming-mars-backend/internal/action/admin"
+	cardaction "terraforming-mars-backend/internal/action/card"
+	demoaction "terraforming-mars-backend/internal/action/demo"
 	gameaction "terraforming-mars-backend/internal/action/game"
 	"terraforming-mars-backend/internal/action/query"
+	tutorialaction "terraforming-mars-backend/internal/action/tutorial"
+	"terraforming-mars-backend/internal/analytics"
 	"terraforming-mars-backend/internal/cards"
+	"terraforming-mars-backend/internal/delivery/jsonrpc"
+	"terraforming-mars-backend/internal/delivery/websocket/core"
+	"terraforming-mars-backend/internal/logger"
 	httpmiddleware "terraforming-mars-backend/internal/middleware/http"
+	"terraforming-mars-backend/internal/tutorial"
 
 	"github.com/gorilla/mux"
 )
@@ -16,16 +26,52 @@ import (
 func SetupRouter(
 	createGameAction *gameaction.CreateGameAction,
 	createDemoLobbyAction *gameaction.CreateDemoLobbyAction,
+	seedDemoGameAction *demoaction.SeedDemoGameAction,
 	getGameAction *query.GetGameAction,
+	gameQueries *query.GameQueryService,
 	getGameLogsAction *query.GetGameLogsAction,
+	exportGameLogAction *query.ExportGameLogAction,
+	getGameOverlayAction *query.GetGameOverlayAction,
 	listGamesAction *query.ListGamesAction,
 	listCardsAction *query.ListCardsAction,
+	resolveCardsAction *query.ResolveCardsAction,
 	getPlayerAction *query.GetPlayerAction,
+	listArchivedGamesAction *query.ListArchivedGamesAction,
+	getArchivedGameAction *query.GetArchivedGameAction,
+	registerAccountAction *accountaction.RegisterAccountAction,
+	deleteAccountDataAction *accountaction.DeleteAccountDataAction,
 	cardRegistry cards.CardRegistry,
+	cardGuides *cards.GuideRegistry,
+	rpcServer *jsonrpc.Server,
+	tutorialScenarios *tutorial.Registry,
+	startTutorialAction *tutorialaction.StartTutorialAction,
+	puzzles *tutorial.Registry,
+	puzzleCompletions *tutorial.CompletionStore,
+	startPuzzleAction *tutorialaction.StartTutorialAction,
+	analyticsStore *analytics.Store,
+	hub *core.Hub,
+	healthHandler *HealthHandler,
+	previewActionAction *cardaction.PreviewActionAction,
+	inviteHandler *InviteHandler,
+	listGameFootprintsAction *admin.ListGameFootprintsAction, // nil keeps admin endpoints unmounted
+	listCollusionFlagsAction *admin.ListCollusionFlagsAction,
+	auditGameAction *admin.AuditGameAction,
+	getStateAtAction *admin.GetStateAtAction,
+	inspectAwardsAction *admin.InspectAwardsAction,
 ) *mux.Router {
-	gameHandler := NewGameHandler(createGameAction, createDemoLobbyAction, getGameAction, getGameLogsAction, listGamesAction, listCardsAction, cardRegistry)
+	gameHandler := NewGameHandler(createGameAction, createDemoLobbyAction, seedDemoGameAction, gameQueries, getGameLogsAction, exportGameLogAction, listGamesAction, listCardsAction, resolveCardsAction, cardRegistry)
 	playerHandler := NewPlayerHandler(getPlayerAction, getGameAction, cardRegistry)
-	healthHandler := NewHealthHandler()
+	catalogHandler := NewCatalogHandler()
+	docsHandler := NewDocsHandler()
+	tutorialHandler := NewTutorialHandler(tutorialScenarios, startTutorialAction)
+	puzzleHandler := NewPuzzleHandler(puzzles, puzzleCompletions, startPuzzleAction)
+	guideHandler := NewGuideHandler(cardGuides, cardRegistry)
+	behaviorHandler := NewBehaviorHandler(cardRegistry)
+	analyticsHandler := NewAnalyticsHandler(analyticsStore)
+	overlayHandler := NewOverlayHandler(getGameOverlayAction)
+	archiveHandler := NewArchiveHandler(listArchivedGamesAction, getArchivedGameAction, cardRegistry)
+	accountHandler := NewAccountHandler(registerAccountAction, deleteAccountDataAction)
+	previewHandler := NewPreviewHandler(previewActionAction, hub)
 
 	router := mux.NewRouter()
 	router.Use(httpmiddleware.Recovery)
@@ -37,18 +83,65 @@ func SetupRouter(
 
 	api := router.PathPrefix("/api/v1").Subrouter()
 	api.HandleFunc("/health", healthHandler.HealthCheck).Methods(http.MethodGet)
+	api.HandleFunc("/build-info", healthHandler.BuildInfo).Methods(http.MethodGet)
+	api.HandleFunc("/capacity", healthHandler.Capacity).Methods(http.MethodGet)
 
 	gameRoutes := api.PathPrefix("/games").Subrouter()
 	gameRoutes.HandleFunc("", gameHandler.CreateGame).Methods(http.MethodPost)
 	gameRoutes.HandleFunc("", gameHandler.ListGames).Methods(http.MethodGet)
 	gameRoutes.HandleFunc("/demo/lobby", gameHandler.CreateDemoLobby).Methods(http.MethodPost)
+	gameRoutes.HandleFunc("/demo/seeded", gameHandler.SeedDemoGame).Methods(http.MethodPost)
 	gameRoutes.HandleFunc("/{gameId}", gameHandler.GetGame).Methods(http.MethodGet)
+	gameRoutes.HandleFunc("/{gameId}/summary", gameHandler.GetGameSummary).Methods(http.MethodGet)
 	gameRoutes.HandleFunc("/{gameId}/logs", gameHandler.GetGameLogs).Methods(http.MethodGet)
+	gameRoutes.HandleFunc("/{gameId}/log.json", gameHandler.ExportGameLogJSON).Methods(http.MethodGet)
+	gameRoutes.HandleFunc("/{gameId}/log.txt", gameHandler.ExportGameLogText).Methods(http.MethodGet)
+	gameRoutes.HandleFunc("/{gameId}/overlay", overlayHandler.GetOverlay).Methods(http.MethodGet)
+	gameRoutes.HandleFunc("/{gameId}/overlay/stream", overlayHandler.StreamOverlay).Methods(http.MethodGet)
+	gameRoutes.HandleFunc("/{gameId}/invites", inviteHandler.CreateInvite).Methods(http.MethodPost)
+	gameRoutes.HandleFunc("/{gameId}/invites", inviteHandler.ListInvites).Methods(http.MethodGet)
+	gameRoutes.HandleFunc("/{gameId}/invites/{token}", inviteHandler.GetInvite).Methods(http.MethodGet)
+	gameRoutes.HandleFunc("/{gameId}/invites/{token}", inviteHandler.RevokeInvite).Methods(http.MethodDelete)
 
 	playerRoutes := api.PathPrefix("/games/{gameId}/players").Subrouter()
 	playerRoutes.HandleFunc("/{playerId}", playerHandler.GetPlayer).Methods(http.MethodGet)
+	playerRoutes.HandleFunc("/{playerId}/bot-token", rpcServer.IssueToken).Methods(http.MethodPost)
+	playerRoutes.HandleFunc("/{playerId}/preview-action", previewHandler.PreviewAction).Methods(http.MethodPost)
+
+	archiveRoutes := api.PathPrefix("/archive").Subrouter()
+	archiveRoutes.HandleFunc("/games", archiveHandler.ListGames).Methods(http.MethodGet)
+	archiveRoutes.HandleFunc("/games/{gameId}", archiveHandler.GetGame).Methods(http.MethodGet)
+	archiveRoutes.HandleFunc("/players/{playerName}/stats", archiveHandler.GetPlayerStats).Methods(http.MethodGet)
+
+	api.HandleFunc("/players/{accountId}/key", accountHandler.RegisterAccount).Methods(http.MethodPost)
+	api.HandleFunc("/players/{accountId}", accountHandler.DeleteAccount).Methods(http.MethodDelete)
+
+	api.Handle("/rpc", rpcServer).Methods(http.MethodPost)
+
+	api.HandleFunc("/tutorials", tutorialHandler.ListTutorials).Methods(http.MethodGet)
+	api.HandleFunc("/tutorials/{scenarioId}/start", tutorialHandler.StartTutorial).Methods(http.MethodPost)
+	api.HandleFunc("/puzzles", puzzleHandler.ListPuzzles).Methods(http.MethodGet)
+	api.HandleFunc("/puzzles/{puzzleId}/start", puzzleHandler.StartPuzzle).Methods(http.MethodPost)
 
 	api.HandleFunc("/cards", gameHandler.ListCards).Methods(http.MethodGet)
+	api.HandleFunc("/cards/resolve", gameHandler.ResolveCards).Methods(http.MethodGet)
+	api.HandleFunc("/cards/{cardId}/guide", guideHandler.GetGuide).Methods(http.MethodGet)
+	api.HandleFunc("/cards/{cardId}/behaviors/explain", behaviorHandler.ExplainBehaviors).Methods(http.MethodGet)
+	api.HandleFunc("/analytics/cards", analyticsHandler.GetCardAnalytics).Methods(http.MethodGet)
+	api.HandleFunc("/action-catalog", catalogHandler.GetActionCatalog).Methods(http.MethodGet)
+	api.HandleFunc("/openapi.json", docsHandler.GetOpenAPISpec).Methods(http.MethodGet)
+	api.HandleFunc("/ws-schema", docsHandler.GetWSSchema).Methods(http.MethodGet)
+
+	if listGameFootprintsAction != nil {
+		adminHandler := NewAdminHandler(listGameFootprintsAction, listCollusionFlagsAction, auditGameAction, getStateAtAction, inspectAwardsAction, hub, logger.GameLogs())
+		api.HandleFunc("/admin/games", adminHandler.ListGames).Methods(http.MethodGet)
+		api.HandleFunc("/admin/collusion-flags", adminHandler.ListCollusionFlags).Methods(http.MethodGet)
+		api.HandleFunc("/admin/games/{gameId}/logs", adminHandler.GetGameLogs).Methods(http.MethodGet)
+		api.HandleFunc("/admin/games/{gameId}/audit", adminHandler.AuditGame).Methods(http.MethodGet)
+		api.HandleFunc("/admin/games/{gameId}/state-at", adminHandler.GetStateAt).Methods(http.MethodGet)
+		api.HandleFunc("/admin/games/{gameId}/awards", adminHandler.InspectAwards).Methods(http.MethodGet)
+		api.HandleFunc("/admin/websocket", adminHandler.GetWebSocketStats).Methods(http.MethodGet)
+	}
 
 	return router
 }
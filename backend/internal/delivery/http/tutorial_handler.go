@@ -0,0 +1,84 @@
+package http
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+
+	tutorialaction "terraforming-mars-backend/internal/action/tutorial"
+	"terraforming-mars-backend/internal/delivery/dto"
+	"terraforming-mars-backend/internal/tutorial"
+
+	"github.com/gorilla/mux"
+	"go.uber.org/zap"
+)
+
+// TutorialHandler serves tutorial scenarios
+type TutorialHandler struct {
+	*BaseHandler
+	scenarios           *tutorial.Registry
+	startTutorialAction *tutorialaction.StartTutorialAction
+}
+
+// NewTutorialHandler creates a new tutorial handler
+func NewTutorialHandler(scenarios *tutorial.Registry, startTutorialAction *tutorialaction.StartTutorialAction) *TutorialHandler {
+	return &TutorialHandler{
+		BaseHandler:         NewBaseHandler(),
+		scenarios:           scenarios,
+		startTutorialAction: startTutorialAction,
+	}
+}
+
+// ListTutorials handles GET /api/v1/tutorials
+func (h *TutorialHandler) ListTutorials(w http.ResponseWriter, r *http.Request) {
+	h.logger.Info("📡 HTTP GET /api/v1/tutorials")
+
+	scenarios := h.scenarios.List()
+	tutorials := make([]dto.TutorialScenarioDto, len(scenarios))
+	for i, scenario := range scenarios {
+		tutorials[i] = dto.TutorialScenarioDto{
+			ID:          scenario.ID,
+			Name:        scenario.Name,
+			Description: scenario.Description,
+			StepCount:   len(scenario.Steps),
+		}
+	}
+
+	h.WriteJSONResponse(w, http.StatusOK, dto.ListTutorialsResponse{Tutorials: tutorials})
+}
+
+// StartTutorial handles POST /api/v1/tutorials/{scenarioId}/start
+// Returns a game already in the action phase; the client joins it over the WebSocket as usual
+func (h *TutorialHandler) StartTutorial(w http.ResponseWriter, r *http.Request) {
+	scenarioID := mux.Vars(r)["scenarioId"]
+	log := h.logger.With(zap.String("scenario_id", scenarioID))
+	log.Info("📡 HTTP POST /api/v1/tutorials/:scenarioId/start")
+
+	var req dto.StartTutorialRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil && !errors.Is(err, io.EOF) {
+		h.WriteErrorResponse(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	if _, err := h.scenarios.GetByID(scenarioID); err != nil {
+		h.WriteErrorResponse(w, http.StatusNotFound, err.Error())
+		return
+	}
+
+	result, err := h.startTutorialAction.Execute(r.Context(), scenarioID, req.PlayerName, req.AccountID)
+	if writeIfAtCapacity(w, err) {
+		log.Warn("Refused tutorial, server at capacity")
+		return
+	}
+	if err != nil {
+		log.Error("Failed to start tutorial", zap.Error(err))
+		h.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	h.WriteJSONResponse(w, http.StatusCreated, dto.StartTutorialResponse{
+		Game:     result.GameDto,
+		PlayerID: result.PlayerID,
+	})
+}
@@ -0,0 +1,357 @@
+package jsonrpc
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"time"
+
+	"terraforming-mars-backend/internal/action/query"
+	"terraforming-mars-backend/internal/cards"
+	"terraforming-mars-backend/internal/delivery/dto"
+	"terraforming-mars-backend/internal/delivery/websocket/core"
+	"terraforming-mars-backend/internal/logger"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+	"go.uber.org/zap"
+)
+
+// JSON-RPC 2.0 error codes
+const (
+	CodeParseError     = -32700
+	CodeInvalidRequest = -32600
+	CodeMethodNotFound = -32601
+	CodeInvalidParams  = -32602
+	CodeInternalError  = -32603
+	CodeUnauthorized   = -32001
+)
+
+// Bot methods
+const (
+	MethodObserveState = "observeState"
+	MethodSubmitAction = "submitAction"
+	MethodStreamEvents = "streamEvents"
+	MethodRevokeToken  = "revokeToken"
+)
+
+// Event streaming limits
+const (
+	defaultMaxEvents = 50
+	maxWait          = 10 * time.Second // Below the HTTP server's write timeout
+)
+
+// Request is a JSON-RPC 2.0 request
+type Request struct {
+	JSONRPC string          `json:"jsonrpc"`
+	Method  string          `json:"method"`
+	Params  json.RawMessage `json:"params,omitempty"`
+	ID      interface{}     `json:"id"`
+}
+
+// Response is a JSON-RPC 2.0 response
+type Response struct {
+	JSONRPC string      `json:"jsonrpc"`
+	Result  interface{} `json:"result,omitempty"`
+	Error   *Error      `json:"error,omitempty"`
+	ID      interface{} `json:"id"`
+}
+
+// Error is a JSON-RPC 2.0 error object
+type Error struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+// SubmitActionParams are the params of submitAction
+type SubmitActionParams struct {
+	Type    dto.MessageType `json:"type"`
+	Payload interface{}     `json:"payload"`
+}
+
+// StreamEventsParams are the params of streamEvents
+type StreamEventsParams struct {
+	MaxEvents int `json:"maxEvents"` // Default: 50
+	WaitMs    int `json:"waitMs"`    // Long-poll duration when no events are queued (max 10000)
+}
+
+// IssueTokenResponse is returned when a bot token is issued
+type IssueTokenResponse struct {
+	Token     string    `json:"token"`
+	GameID    string    `json:"gameId"`
+	PlayerID  string    `json:"playerId"`
+	ExpiresAt time.Time `json:"expiresAt"`
+}
+
+// AccountVerifier checks that a key proves ownership of an account
+type AccountVerifier interface {
+	VerifyAccountKey(accountID, key string) error
+}
+
+// Server exposes a JSON-RPC surface for bots controlling a seated player
+// Actions are routed through the WebSocket hub so bots share the same handlers and action layer
+type Server struct {
+	hub           *core.Hub
+	getGameAction *query.GetGameAction
+	cardRegistry  cards.CardRegistry
+	accounts      AccountVerifier
+	sessions      *SessionStore
+	logger        *zap.Logger
+}
+
+// NewServer creates a new JSON-RPC server whose tokens expire after DefaultTokenTTL
+func NewServer(hub *core.Hub, getGameAction *query.GetGameAction, cardRegistry cards.CardRegistry, accounts AccountVerifier) *Server {
+	return &Server{
+		hub:           hub,
+		getGameAction: getGameAction,
+		cardRegistry:  cardRegistry,
+		accounts:      accounts,
+		sessions:      NewSessionStore(DefaultTokenTTL),
+		logger:        logger.Get(),
+	}
+}
+
+// Sessions returns the server's token store
+func (s *Server) Sessions() *SessionStore {
+	return s.sessions
+}
+
+// IssueToken handles POST /api/v1/games/{gameId}/players/{playerId}/bot-token
+// The caller proves the seat is theirs with "Authorization: Bearer <account key>" for the seat's account
+func (s *Server) IssueToken(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	gameID := vars["gameId"]
+	playerID := vars["playerId"]
+
+	log := s.logger.With(zap.String("game_id", gameID), zap.String("player_id", playerID))
+	log.Info("📡 HTTP POST /api/v1/games/:gameId/players/:playerId/bot-token")
+
+	g, err := s.getGameAction.Execute(r.Context(), gameID)
+	if err != nil {
+		http.Error(w, "Game not found", http.StatusNotFound)
+		return
+	}
+	p, err := g.GetPlayer(playerID)
+	if err != nil {
+		http.Error(w, "Player not in game", http.StatusNotFound)
+		return
+	}
+
+	accountKey := bearerToken(r)
+	if accountKey == "" {
+		http.Error(w, "Account key required", http.StatusUnauthorized)
+		return
+	}
+	if p.AccountID() == "" {
+		log.Warn("Refused bot token for a seat without an account")
+		http.Error(w, "Seat is not linked to an account", http.StatusForbidden)
+		return
+	}
+	if err := s.accounts.VerifyAccountKey(p.AccountID(), accountKey); err != nil {
+		log.Warn("Refused bot token, account key does not match the seat")
+		http.Error(w, "Account key does not match the seat", http.StatusForbidden)
+		return
+	}
+
+	s.releaseExpired()
+
+	manager := s.hub.GetManager()
+	connection := core.NewVirtualConnection("bot-"+uuid.New().String(), manager)
+	manager.RegisterConnection(connection)
+	connection.SetPlayer(playerID, gameID)
+
+	session, err := s.sessions.Create(gameID, playerID, connection)
+	if err != nil {
+		log.Error("Failed to create bot session", zap.Error(err))
+		manager.UnregisterConnection(connection)
+		http.Error(w, "Failed to issue token", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	if err := json.NewEncoder(w).Encode(IssueTokenResponse{
+		Token:     session.Token,
+		GameID:    gameID,
+		PlayerID:  playerID,
+		ExpiresAt: session.ExpiresAt,
+	}); err != nil {
+		log.Error("Failed to encode response", zap.Error(err))
+		return
+	}
+
+	log.Info("🤖 Bot token issued", zap.String("connection_id", connection.ID))
+}
+
+// ServeHTTP handles POST /api/v1/rpc
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var req Request
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		s.writeResponse(w, Response{Error: &Error{Code: CodeParseError, Message: "parse error"}})
+		return
+	}
+
+	if req.JSONRPC != "2.0" || req.Method == "" {
+		s.writeResponse(w, Response{ID: req.ID, Error: &Error{Code: CodeInvalidRequest, Message: "invalid request"}})
+		return
+	}
+
+	session := s.sessions.Get(bearerToken(r))
+	if session == nil {
+		s.releaseExpired()
+		s.writeResponse(w, Response{ID: req.ID, Error: &Error{Code: CodeUnauthorized, Message: "missing, invalid or expired bot token"}})
+		return
+	}
+
+	log := s.logger.With(
+		zap.String("game_id", session.GameID),
+		zap.String("player_id", session.PlayerID),
+		zap.String("rpc_method", req.Method),
+	)
+	log.Debug("🤖 Bot RPC call")
+
+	var result interface{}
+	var rpcErr *Error
+	switch req.Method {
+	case MethodObserveState:
+		result, rpcErr = s.observeState(r, session)
+	case MethodSubmitAction:
+		result, rpcErr = s.submitAction(r, session, req.Params)
+	case MethodStreamEvents:
+		result, rpcErr = s.streamEvents(r, session, req.Params)
+	case MethodRevokeToken:
+		result, rpcErr = s.revokeToken(session)
+	default:
+		rpcErr = &Error{Code: CodeMethodNotFound, Message: "method not found: " + req.Method}
+	}
+
+	if rpcErr != nil {
+		log.Warn("Bot RPC call failed", zap.String("error", rpcErr.Message))
+	}
+
+	s.writeResponse(w, Response{ID: req.ID, Result: result, Error: rpcErr})
+}
+
+// observeState returns the game as seen by the bot's player
+func (s *Server) observeState(r *http.Request, session *BotSession) (interface{}, *Error) {
+	g, err := s.getGameAction.Execute(r.Context(), session.GameID)
+	if err != nil {
+		return nil, &Error{Code: CodeInternalError, Message: "game not found: " + session.GameID}
+	}
+	return dto.GetGameResponse{Game: dto.ToGameDto(g, s.cardRegistry, session.PlayerID)}, nil
+}
+
+// submitAction queues a WebSocket message on the hub as if the bot's connection sent it
+// Results and errors are delivered as events (game-updated, error)
+func (s *Server) submitAction(r *http.Request, session *BotSession, rawParams json.RawMessage) (interface{}, *Error) {
+	var params SubmitActionParams
+	if err := json.Unmarshal(rawParams, &params); err != nil || params.Type == "" {
+		return nil, &Error{Code: CodeInvalidParams, Message: "params must include a message type"}
+	}
+	// Connection-level messages (join, takeover, create) would rebind the bot's seat
+	if !strings.HasPrefix(string(params.Type), "action.") {
+		return nil, &Error{Code: CodeInvalidParams, Message: "bots may only submit action.* messages"}
+	}
+	if params.Payload == nil {
+		params.Payload = map[string]interface{}{}
+	}
+
+	hubMessage := core.HubMessage{
+		Connection: session.Connection,
+		Message: dto.WebSocketMessage{
+			Type:    params.Type,
+			Payload: params.Payload,
+			GameID:  session.GameID,
+		},
+	}
+
+	select {
+	case s.hub.Messages <- hubMessage:
+	case <-r.Context().Done():
+		return nil, &Error{Code: CodeInternalError, Message: "request cancelled before action was queued"}
+	}
+
+	return map[string]bool{"accepted": true}, nil
+}
+
+// streamEvents drains queued messages for the bot, long-polling when none are queued
+func (s *Server) streamEvents(r *http.Request, session *BotSession, rawParams json.RawMessage) (interface{}, *Error) {
+	params := StreamEventsParams{MaxEvents: defaultMaxEvents}
+	if len(rawParams) > 0 {
+		if err := json.Unmarshal(rawParams, &params); err != nil {
+			return nil, &Error{Code: CodeInvalidParams, Message: "invalid params"}
+		}
+	}
+	if params.MaxEvents <= 0 {
+		params.MaxEvents = defaultMaxEvents
+	}
+
+	wait := time.Duration(params.WaitMs) * time.Millisecond
+	if wait > maxWait {
+		wait = maxWait
+	}
+
+	// Long-poll only while nothing has been collected yet
+	var timeout <-chan time.Time
+	if wait > 0 {
+		timer := time.NewTimer(wait)
+		defer timer.Stop()
+		timeout = timer.C
+	}
+
+	events := make([]dto.WebSocketMessage, 0)
+	for len(events) < params.MaxEvents {
+		if message, ok := session.Connection.Receive(); ok {
+			events = append(events, message)
+			continue
+		}
+		if len(events) > 0 || timeout == nil {
+			break
+		}
+
+		select {
+		case <-session.Connection.Ready():
+		case <-timeout:
+			return map[string]interface{}{"events": events}, nil
+		case <-r.Context().Done():
+			return map[string]interface{}{"events": events}, nil
+		}
+	}
+
+	return map[string]interface{}{"events": events}, nil
+}
+
+// revokeToken ends the bot session
+func (s *Server) revokeToken(session *BotSession) (interface{}, *Error) {
+	s.sessions.Remove(session.Token)
+	s.hub.GetManager().UnregisterConnection(session.Connection)
+	return map[string]bool{"revoked": true}, nil
+}
+
+// releaseExpired drops expired tokens and unregisters their connections
+func (s *Server) releaseExpired() {
+	manager := s.hub.GetManager()
+	for _, session := range s.sessions.RemoveExpired() {
+		manager.UnregisterConnection(session.Connection)
+		s.logger.Debug("🤖 Bot token expired",
+			zap.String("game_id", session.GameID),
+			zap.String("player_id", session.PlayerID))
+	}
+}
+
+func (s *Server) writeResponse(w http.ResponseWriter, response Response) {
+	response.JSONRPC = "2.0"
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(response); err != nil {
+		s.logger.Error("Failed to encode JSON-RPC response", zap.Error(err))
+	}
+}
+
+func bearerToken(r *http.Request) string {
+	header := r.Header.Get("Authorization")
+	token, found := strings.CutPrefix(header, "Bearer ")
+	if !found {
+		return ""
+	}
+	return strings.TrimSpace(token)
+}
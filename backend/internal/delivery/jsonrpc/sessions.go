@@ -0,0 +1,116 @@
+package jsonrpc
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"sync"
+	"time"
+
+	"terraforming-mars-backend/internal/delivery/websocket/core"
+)
+
+// DefaultTokenTTL is how long a bot token stays valid after it is issued
+const DefaultTokenTTL = 24 * time.Hour
+
+// BotSession binds an API token to a seated player
+// The session's virtual connection receives the same messages a browser would
+type BotSession struct {
+	Token      string
+	GameID     string
+	PlayerID   string
+	ExpiresAt  time.Time
+	Connection *core.Connection
+}
+
+// SessionStore holds active bot sessions keyed by token
+type SessionStore struct {
+	mu       sync.RWMutex
+	sessions map[string]*BotSession
+	ttl      time.Duration
+	now      func() time.Time
+}
+
+// NewSessionStore creates an empty session store whose tokens expire after ttl
+func NewSessionStore(ttl time.Duration) *SessionStore {
+	return &SessionStore{
+		sessions: make(map[string]*BotSession),
+		ttl:      ttl,
+		now:      time.Now,
+	}
+}
+
+// SetClock replaces the store's time source; used by tests to move past a token's expiry
+func (s *SessionStore) SetClock(now func() time.Time) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.now = now
+}
+
+// Create issues a new token for the given seat
+func (s *SessionStore) Create(gameID, playerID string, connection *core.Connection) (*BotSession, error) {
+	token, err := newToken()
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate bot token: %w", err)
+	}
+
+	session := &BotSession{
+		Token:      token,
+		GameID:     gameID,
+		PlayerID:   playerID,
+		Connection: connection,
+	}
+
+	s.mu.Lock()
+	session.ExpiresAt = s.now().Add(s.ttl)
+	s.sessions[token] = session
+	s.mu.Unlock()
+
+	return session, nil
+}
+
+// Get returns the session for a token, or nil if the token is unknown or expired
+func (s *SessionStore) Get(token string) *BotSession {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	session := s.sessions[token]
+	if session == nil || !s.now().Before(session.ExpiresAt) {
+		return nil
+	}
+	return session
+}
+
+// RemoveExpired deletes every expired token and returns their sessions, so their connections can be released
+func (s *SessionStore) RemoveExpired() []*BotSession {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var expired []*BotSession
+	now := s.now()
+	for token, session := range s.sessions {
+		if !now.Before(session.ExpiresAt) {
+			expired = append(expired, session)
+			delete(s.sessions, token)
+		}
+	}
+	return expired
+}
+
+// Remove deletes a token and returns its session, or nil if the token is unknown
+func (s *SessionStore) Remove(token string) *BotSession {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	session := s.sessions[token]
+	delete(s.sessions, token)
+	return session
+}
+
+func newToken() (string, error) {
+	bytes := make([]byte, 32)
+	if _, err := rand.Read(bytes); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(bytes), nil
+}
@@ -0,0 +1,42 @@
+package websocket
+
+import (
+	"context"
+
+	turnAction "terraforming-mars-backend/internal/action/turn_management"
+	"terraforming-mars-backend/internal/delivery/dto"
+	"terraforming-mars-backend/internal/delivery/websocket/core"
+	"terraforming-mars-backend/internal/logger"
+
+	"go.uber.org/zap"
+)
+
+// autoPassHook runs auto-pass after a message that may have started someone's turn
+// Handlers broadcast their own result first; the hook broadcasts again only when it passed for someone
+type autoPassHook struct {
+	next        core.MessageHandler
+	action      *turnAction.AutoPassAction
+	broadcaster *Broadcaster
+}
+
+func newAutoPassHook(next core.MessageHandler, action *turnAction.AutoPassAction, broadcaster *Broadcaster) *autoPassHook {
+	return &autoPassHook{next: next, action: action, broadcaster: broadcaster}
+}
+
+// HandleMessage implements the MessageHandler interface
+func (h *autoPassHook) HandleMessage(ctx context.Context, connection *core.Connection, message dto.WebSocketMessage) {
+	h.next.HandleMessage(ctx, connection, message)
+
+	_, gameID := connection.GetPlayer()
+	if gameID == "" {
+		return
+	}
+
+	passed, err := h.action.Execute(ctx, gameID)
+	if err != nil {
+		logger.Get().Error("Auto-pass failed", zap.String("game_id", gameID), zap.Error(err))
+	}
+	if len(passed) > 0 {
+		h.broadcaster.BroadcastGameState(gameID, nil)
+	}
+}
@@ -2,17 +2,25 @@ package websocket
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
 	"sync"
+	"time"
 
 	"terraforming-mars-backend/internal/cards"
 	"terraforming-mars-backend/internal/delivery/dto"
 	"terraforming-mars-backend/internal/delivery/websocket/core"
 	"terraforming-mars-backend/internal/game"
 	"terraforming-mars-backend/internal/logger"
+	"terraforming-mars-backend/internal/tutorial"
 
 	"go.uber.org/zap"
 )
 
+// broadcastTimeout bounds the repository reads of one broadcast
+// Broadcasts run after the action has finished, so they get their own deadline rather than the action's
+const broadcastTimeout = 5 * time.Second
+
 // Broadcaster handles game state broadcasting to WebSocket clients
 // Called explicitly by WebSocket handlers after actions complete
 type Broadcaster struct {
@@ -20,9 +28,12 @@ type Broadcaster struct {
 	stateRepo           game.GameStateRepository
 	hub                 *core.Hub
 	cardRegistry        cards.CardRegistry
+	tutorialTracker     *tutorial.Tracker
 	logger              *zap.Logger
 	lastBroadcastedSeq  map[string]int64 // gameID -> last broadcasted log sequence
+	lastSummarized      map[string]int   // gameID -> last generation whose summary was sent
 	lastBroadcastedLock sync.RWMutex
+	history             *syncHistory
 }
 
 // NewBroadcaster creates a broadcaster for explicit broadcasting
@@ -31,14 +42,18 @@ func NewBroadcaster(
 	stateRepo game.GameStateRepository,
 	hub *core.Hub,
 	cardRegistry cards.CardRegistry,
+	tutorialTracker *tutorial.Tracker,
 ) *Broadcaster {
 	broadcaster := &Broadcaster{
 		gameRepo:           gameRepo,
 		stateRepo:          stateRepo,
 		hub:                hub,
 		cardRegistry:       cardRegistry,
+		tutorialTracker:    tutorialTracker,
 		logger:             logger.Get(),
 		lastBroadcastedSeq: make(map[string]int64),
+		lastSummarized:     make(map[string]int),
+		history:            newSyncHistory(),
 	}
 
 	broadcaster.logger.Info("📡 Broadcaster initialized")
@@ -50,7 +65,8 @@ func NewBroadcaster(
 // Called explicitly by WebSocket handlers after action execution completes
 // Also broadcasts any new log entries since the last broadcast
 func (b *Broadcaster) BroadcastGameState(gameID string, playerIDs []string) {
-	ctx := context.Background()
+	ctx, cancel := context.WithTimeout(context.Background(), broadcastTimeout)
+	defer cancel()
 	log := b.logger.With(zap.String("game_id", gameID))
 
 	g, err := b.gameRepo.Get(ctx, gameID)
@@ -72,24 +88,36 @@ func (b *Broadcaster) BroadcastGameState(gameID string, playerIDs []string) {
 		log.Debug("📢 Broadcasting to specific players", zap.Strings("player_ids", playerIDs))
 	}
 
+	// One snapshot per broadcast: each player is mapped once and shared by every recipient
+	snapshot := dto.NewGameViewSnapshot(g, b.cardRegistry)
+	recentActions := b.recentActions(ctx, gameID)
 	for _, playerID := range playerIDs {
-		if err := b.sendToPlayer(ctx, g, playerID); err != nil {
+		if err := b.sendToPlayer(ctx, g, snapshot, recentActions, playerID); err != nil {
 			log.Error("Failed to send game state to player",
 				zap.String("player_id", playerID),
 				zap.Error(err))
 			// Continue with other players even if one fails
 		}
 	}
+	b.sendToSeatless(g, snapshot, recentActions)
 
 	// Broadcast any new log entries since the last broadcast
-	b.broadcastNewLogs(gameID, playerIDs)
+	b.broadcastNewLogs(g, playerIDs)
+
+	// Recap the generation once its production phase has run
+	b.broadcastGenerationSummary(g, playerIDs)
+
+	// Guide tutorial players to their next objective
+	b.broadcastTutorialProgress(g, playerIDs)
 
 	log.Debug("✅ Broadcast completed", zap.Int("player_count", len(playerIDs)))
 }
 
 // broadcastNewLogs sends any new log entries to the specified players
-func (b *Broadcaster) broadcastNewLogs(gameID string, playerIDs []string) {
-	ctx := context.Background()
+func (b *Broadcaster) broadcastNewLogs(g *game.Game, playerIDs []string) {
+	ctx, cancel := context.WithTimeout(context.Background(), broadcastTimeout)
+	defer cancel()
+	gameID := g.ID()
 	log := b.logger.With(zap.String("game_id", gameID))
 
 	// Get the last broadcasted sequence for this game
@@ -125,17 +153,22 @@ func (b *Broadcaster) broadcastNewLogs(gameID string, playerIDs []string) {
 	b.lastBroadcastedSeq[gameID] = maxSeq
 	b.lastBroadcastedLock.Unlock()
 
-	// Convert to DTOs and broadcast
+	// Convert to DTOs and broadcast; each player only sees their own hand changes
 	logDtos := dto.ToStateDiffDtos(newLogs)
-	message := dto.WebSocketMessage{
-		Type:   dto.MessageTypeLogUpdate,
-		GameID: gameID,
-		Payload: dto.LogUpdatePayload{
-			Logs: logDtos,
-		},
-	}
-
+	sequence := b.hub.ActionSequence(gameID)
 	for _, playerID := range playerIDs {
+		// Subscribed clients follow the log through the recentActions slice, if at all
+		if b.hub.PlayerSubscription(gameID, playerID) != nil {
+			continue
+		}
+		message := dto.WebSocketMessage{
+			Type:           dto.MessageTypeLogUpdate,
+			GameID:         gameID,
+			ActionSequence: sequence,
+			Payload: dto.LogUpdatePayload{
+				Logs: logsForViewer(g, logDtos, playerID),
+			},
+		}
 		if err := b.hub.SendToPlayer(gameID, playerID, message); err != nil {
 			log.Error("Failed to send log update to player",
 				zap.String("player_id", playerID),
@@ -146,39 +179,354 @@ func (b *Broadcaster) broadcastNewLogs(gameID string, playerIDs []string) {
 	log.Debug("📜 Broadcasted new logs", zap.Int("log_count", len(newLogs)))
 }
 
-// sendToPlayer creates a personalized DTO for a player and sends it via WebSocket
-func (b *Broadcaster) sendToPlayer(ctx context.Context, game *game.Game, playerID string) error {
+// broadcastGenerationSummary sends the latest generation recap the first time a broadcast sees it
+func (b *Broadcaster) broadcastGenerationSummary(g *game.Game, playerIDs []string) {
+	summary := g.LastGenerationSummary()
+	if summary == nil {
+		return
+	}
+
+	b.lastBroadcastedLock.Lock()
+	if b.lastSummarized[g.ID()] >= summary.Generation {
+		b.lastBroadcastedLock.Unlock()
+		return
+	}
+	b.lastSummarized[g.ID()] = summary.Generation
+	b.lastBroadcastedLock.Unlock()
+
+	message := dto.WebSocketMessage{
+		Type:    dto.MessageTypeGenerationSummary,
+		GameID:  g.ID(),
+		Payload: dto.ToGenerationSummaryPayload(g, *summary),
+	}
+	for _, playerID := range playerIDs {
+		if err := b.hub.SendToPlayer(g.ID(), playerID, message); err != nil {
+			b.logger.Error("Failed to send generation summary",
+				zap.String("game_id", g.ID()),
+				zap.String("player_id", playerID),
+				zap.Error(err))
+		}
+	}
+
+	b.logger.Debug("📊 Sent generation summary",
+		zap.String("game_id", g.ID()),
+		zap.Int("generation", summary.Generation))
+}
+
+// broadcastTutorialProgress re-evaluates tutorial objectives and sends progress to the tutorial player
+func (b *Broadcaster) broadcastTutorialProgress(g *game.Game, playerIDs []string) {
+	if b.tutorialTracker == nil {
+		return
+	}
+
+	progress, isTutorial := b.tutorialTracker.Evaluate(g)
+	if !isTutorial {
+		return
+	}
+
+	for _, playerID := range playerIDs {
+		if playerID != progress.PlayerID {
+			continue
+		}
+
+		message := dto.WebSocketMessage{
+			Type:    dto.MessageTypeTutorialProgress,
+			GameID:  g.ID(),
+			Payload: progress.ToPayload(),
+		}
+		if err := b.hub.SendToPlayer(g.ID(), playerID, message); err != nil {
+			b.logger.Error("Failed to send tutorial progress",
+				zap.String("game_id", g.ID()),
+				zap.String("player_id", playerID),
+				zap.Error(err))
+			return
+		}
+
+		b.logger.Debug("🎓 Sent tutorial progress",
+			zap.String("game_id", g.ID()),
+			zap.Int("step_index", progress.StepIndex),
+			zap.Bool("completed", progress.Completed))
+	}
+}
+
+// sendToPlayer creates a personalized DTO for a player from the broadcast snapshot and sends it via WebSocket
+func (b *Broadcaster) sendToPlayer(ctx context.Context, game *game.Game, snapshot *dto.GameViewSnapshot, recentActions []dto.RecentActionDto, playerID string) error {
 	log := b.logger.With(
 		zap.String("game_id", game.ID()),
 		zap.String("player_id", playerID),
 	)
 
-	gameDto := dto.ToGameDto(game, b.cardRegistry, playerID)
+	gameDto := snapshot.ForViewer(playerID)
+	gameDto.RecentActions = recentActions
+	sequence := b.hub.ActionSequence(game.ID())
+
+	// Clients time turns and phases from the timestamps in the state, offset by the server's clock
+	serverTime := time.Now().UTC().Format("2006-01-02T15:04:05.000Z")
+
+	// Lightweight clients get only the slices they subscribed to, which sync never patches
+	if subscribed := b.hub.PlayerSubscription(game.ID(), playerID); subscribed != nil {
+		payload := dto.ToStateSlicesPayload(gameDto, subscribed)
+		payload.ServerTime = serverTime
+		message := dto.WebSocketMessage{
+			Type:           dto.MessageTypeStateSlices,
+			GameID:         game.ID(),
+			ActionSequence: sequence,
+			Payload:        payload,
+		}
+		if err := b.hub.SendToPlayer(game.ID(), playerID, message); err != nil {
+			return err
+		}
+		log.Debug("✅ Sent subscribed state slices to player", zap.Strings("slices", subscribed))
+		return nil
+	}
 
 	message := dto.WebSocketMessage{
-		Type:   dto.MessageTypeGameUpdated,
-		GameID: game.ID(),
+		Type:           dto.MessageTypeGameUpdated,
+		GameID:         game.ID(),
+		ActionSequence: sequence,
 		Payload: dto.GameUpdatedPayload{
-			Game: gameDto,
+			Game:       gameDto,
+			ServerTime: serverTime,
 		},
 	}
 
 	if err := b.hub.SendToPlayer(game.ID(), playerID, message); err != nil {
 		return err
 	}
+	b.recordSentState(game.ID(), playerID, sequence, gameDto)
 
 	log.Debug("✅ Sent personalized game state to player")
 	return nil
 }
 
+// sendToSeatless sends the subscribed slices of the public view to connections watching without a seat
+func (b *Broadcaster) sendToSeatless(g *game.Game, snapshot *dto.GameViewSnapshot, recentActions []dto.RecentActionDto) {
+	connections := b.hub.SeatlessConnections(g.ID())
+	if len(connections) == 0 {
+		return
+	}
+
+	view := snapshot.PublicView()
+	view.RecentActions = recentActions
+	for _, connection := range connections {
+		if subscribed := connection.Subscription(); subscribed != nil {
+			connection.SendMessage(b.publicSlicesMessage(g.ID(), view, subscribed))
+		}
+	}
+	b.logger.Debug("✅ Sent public state slices to seatless connections",
+		zap.String("game_id", g.ID()),
+		zap.Int("connection_count", len(connections)))
+}
+
+func (b *Broadcaster) publicSlicesMessage(gameID string, view dto.GameDto, subscribed []string) dto.WebSocketMessage {
+	payload := dto.ToStateSlicesPayload(view, subscribed)
+	payload.ServerTime = time.Now().UTC().Format("2006-01-02T15:04:05.000Z")
+	return dto.WebSocketMessage{
+		Type:           dto.MessageTypeStateSlices,
+		GameID:         gameID,
+		ActionSequence: b.hub.ActionSequence(gameID),
+		Payload:        payload,
+	}
+}
+
+// SendPublicState sends a seatless connection the public slices it subscribed to
+// Fails without sending when the game does not exist
+func (b *Broadcaster) SendPublicState(gameID string, connection *core.Connection) error {
+	ctx, cancel := context.WithTimeout(context.Background(), broadcastTimeout)
+	defer cancel()
+
+	g, err := b.gameRepo.Get(ctx, gameID)
+	if err != nil {
+		return fmt.Errorf("failed to get game: %w", err)
+	}
+
+	view := dto.NewReadOnlyGameViewSnapshot(g, b.cardRegistry).PublicView()
+	view.RecentActions = b.recentActions(ctx, gameID)
+	connection.SendMessage(b.publicSlicesMessage(gameID, view, connection.Subscription()))
+	return nil
+}
+
+// SendState sends a player the current game state in the form their connection subscribed to
+// Used right after a subscription changes; triggered effects are left for the next broadcast
+func (b *Broadcaster) SendState(gameID, playerID string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), broadcastTimeout)
+	defer cancel()
+
+	g, err := b.gameRepo.Get(ctx, gameID)
+	if err != nil {
+		return fmt.Errorf("failed to get game: %w", err)
+	}
+	if _, err := g.GetPlayer(playerID); err != nil {
+		return fmt.Errorf("player not in game: %w", err)
+	}
+
+	snapshot := dto.NewReadOnlyGameViewSnapshot(g, b.cardRegistry)
+	return b.sendToPlayer(ctx, g, snapshot, b.recentActions(ctx, gameID), playerID)
+}
+
+// SyncState brings a client from the state it received at req.SinceSequence to the current one
+// The response carries a patch when that state is still remembered for the player, and the full
+// game otherwise. Log entries newer than req.SinceLogSequence are always included.
+func (b *Broadcaster) SyncState(gameID, playerID string, req dto.SyncRequestPayload) (dto.SyncResponsePayload, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), broadcastTimeout)
+	defer cancel()
+	log := b.logger.With(
+		zap.String("game_id", gameID),
+		zap.String("player_id", playerID),
+		zap.Int64("since_sequence", req.SinceSequence),
+	)
+
+	g, err := b.gameRepo.Get(ctx, gameID)
+	if err != nil {
+		return dto.SyncResponsePayload{}, fmt.Errorf("failed to get game: %w", err)
+	}
+	if _, err := g.GetPlayer(playerID); err != nil {
+		return dto.SyncResponsePayload{}, fmt.Errorf("player not in game: %w", err)
+	}
+
+	// Triggered effects belong to the next broadcast; a sync must not take them from other players
+	sequence := b.hub.ActionSequence(gameID)
+	gameDto := dto.NewReadOnlyGameViewSnapshot(g, b.cardRegistry).ForViewer(playerID)
+	gameDto.RecentActions = b.recentActions(ctx, gameID)
+	current, err := json.Marshal(gameDto)
+	if err != nil {
+		return dto.SyncResponsePayload{}, fmt.Errorf("failed to encode game state: %w", err)
+	}
+
+	response := dto.SyncResponsePayload{
+		Mode:           dto.SyncModeSnapshot,
+		ActionSequence: sequence,
+		Game:           &gameDto,
+		Logs:           b.logsSince(ctx, g, playerID, req.SinceLogSequence),
+	}
+
+	if previous, ok := b.history.lookup(gameID, playerID, req.SinceSequence); ok {
+		patch, err := diffGameStates(previous, current)
+		if err != nil {
+			log.Warn("Failed to diff game states, sending snapshot", zap.Error(err))
+		} else if encoded, _ := json.Marshal(patch); len(encoded) < len(current) {
+			response.Mode = dto.SyncModeDeltas
+			response.FromSequence = req.SinceSequence
+			response.Patch = patch
+			response.Game = nil
+		}
+	}
+
+	// The client holds this state once the response is applied
+	b.history.record(gameID, playerID, sequence, current, time.Now())
+
+	log.Debug("🔁 Built sync response",
+		zap.String("mode", response.Mode),
+		zap.Int64("action_sequence", sequence),
+		zap.Int("patch_operations", len(response.Patch)),
+		zap.Int("log_count", len(response.Logs)))
+	return response, nil
+}
+
+// recordSentState remembers a state sent to a player so a later sync can be answered with a patch
+func (b *Broadcaster) recordSentState(gameID, playerID string, sequence int64, gameDto dto.GameDto) {
+	state, err := json.Marshal(gameDto)
+	if err != nil {
+		b.logger.Warn("Failed to encode state for sync history",
+			zap.String("game_id", gameID),
+			zap.String("player_id", playerID),
+			zap.Error(err))
+		return
+	}
+	b.history.record(gameID, playerID, sequence, state, time.Now())
+}
+
+// recentActions returns the summaries of the game's latest log entries, shared by every viewer
+func (b *Broadcaster) recentActions(ctx context.Context, gameID string) []dto.RecentActionDto {
+	diffs, err := b.stateRepo.GetDiff(ctx, gameID)
+	if err != nil {
+		return []dto.RecentActionDto{}
+	}
+	return dto.ToRecentActionDtos(diffs)
+}
+
+// logsSince returns the game's log entries with a sequence number above since, as seen by playerID
+func (b *Broadcaster) logsSince(ctx context.Context, g *game.Game, playerID string, since int64) []dto.StateDiffDto {
+	diffs, err := b.stateRepo.GetDiff(ctx, g.ID())
+	if err != nil {
+		return []dto.StateDiffDto{}
+	}
+
+	var newer []game.StateDiff
+	for _, diff := range diffs {
+		if diff.SequenceNumber > since {
+			newer = append(newer, diff)
+		}
+	}
+	return logsForViewer(g, dto.ToStateDiffDtos(newer), playerID)
+}
+
+// logsForViewer hides other players' hand changes and muted reactions from a player's log entries
+// Pass-and-play devices get the same redaction, for the seat they currently control
+func logsForViewer(g *game.Game, logs []dto.StateDiffDto, playerID string) []dto.StateDiffDto {
+	return dto.RedactStateDiffsForViewer(withoutMutedReactions(g, logs, playerID), playerID)
+}
+
+// withoutMutedReactions drops reaction entries from senders the viewer has muted
+func withoutMutedReactions(g *game.Game, logs []dto.StateDiffDto, playerID string) []dto.StateDiffDto {
+	viewer, err := g.GetPlayer(playerID)
+	if err != nil || (!viewer.ReactionsMuted() && len(viewer.MutedPlayers()) == 0) {
+		return logs
+	}
+	kept := make([]dto.StateDiffDto, 0, len(logs))
+	for _, entry := range logs {
+		if entry.SourceType == string(game.SourceTypeReaction) && viewer.HidesReactionsFrom(entry.PlayerID) {
+			continue
+		}
+		kept = append(kept, entry)
+	}
+	return kept
+}
+
+// BroadcastReaction sends a reaction to every player who has not muted its sender
+func (b *Broadcaster) BroadcastReaction(gameID string, payload dto.ReactionPayload) {
+	ctx, cancel := context.WithTimeout(context.Background(), broadcastTimeout)
+	defer cancel()
+	log := b.logger.With(zap.String("game_id", gameID))
+
+	g, err := b.gameRepo.Get(ctx, gameID)
+	if err != nil {
+		log.Error("Failed to get game for reaction broadcast", zap.Error(err))
+		return
+	}
+
+	message := dto.WebSocketMessage{
+		Type:    dto.MessageTypeReaction,
+		GameID:  gameID,
+		Payload: payload,
+	}
+	for _, player := range g.GetAllPlayers() {
+		if player.HidesReactionsFrom(payload.PlayerID) {
+			continue
+		}
+		if err := b.hub.SendToPlayer(gameID, player.ID(), message); err != nil {
+			log.Debug("Failed to send reaction to player",
+				zap.String("player_id", player.ID()),
+				zap.Error(err))
+		}
+	}
+}
+
 // SendInitialLogs sends all game logs to a specific player (used on connect/reconnect)
 func (b *Broadcaster) SendInitialLogs(gameID string, playerID string) {
-	ctx := context.Background()
+	ctx, cancel := context.WithTimeout(context.Background(), broadcastTimeout)
+	defer cancel()
 	log := b.logger.With(
 		zap.String("game_id", gameID),
 		zap.String("player_id", playerID),
 	)
 
+	g, err := b.gameRepo.Get(ctx, gameID)
+	if err != nil {
+		log.Error("Failed to get game for initial logs", zap.Error(err))
+		return
+	}
+
 	diffs, err := b.stateRepo.GetDiff(ctx, gameID)
 	if err != nil {
 		log.Debug("No logs to send (game may be new)", zap.Error(err))
@@ -190,11 +538,12 @@ func (b *Broadcaster) SendInitialLogs(gameID string, playerID string) {
 		return
 	}
 
-	logDtos := dto.ToStateDiffDtos(diffs)
+	logDtos := logsForViewer(g, dto.ToStateDiffDtos(diffs), playerID)
 
 	message := dto.WebSocketMessage{
-		Type:   dto.MessageTypeLogUpdate,
-		GameID: gameID,
+		Type:           dto.MessageTypeLogUpdate,
+		GameID:         gameID,
+		ActionSequence: b.hub.ActionSequence(gameID),
 		Payload: dto.LogUpdatePayload{
 			Logs: logDtos,
 		},
@@ -210,7 +559,8 @@ func (b *Broadcaster) SendInitialLogs(gameID string, playerID string) {
 
 // BroadcastLogUpdate broadcasts a single log entry to all players in a game
 func (b *Broadcaster) BroadcastLogUpdate(gameID string, logEntry *game.StateDiff) {
-	ctx := context.Background()
+	ctx, cancel := context.WithTimeout(context.Background(), broadcastTimeout)
+	defer cancel()
 	log := b.logger.With(zap.String("game_id", gameID))
 
 	g, err := b.gameRepo.Get(ctx, gameID)
@@ -219,17 +569,19 @@ func (b *Broadcaster) BroadcastLogUpdate(gameID string, logEntry *game.StateDiff
 		return
 	}
 
-	logDto := dto.ToStateDiffDto(logEntry)
-	message := dto.WebSocketMessage{
-		Type:   dto.MessageTypeLogUpdate,
-		GameID: gameID,
-		Payload: dto.LogUpdatePayload{
-			Logs: []dto.StateDiffDto{logDto},
-		},
-	}
+	logDtos := []dto.StateDiffDto{dto.ToStateDiffDto(logEntry)}
+	sequence := b.hub.ActionSequence(gameID)
 
 	players := g.GetAllPlayers()
 	for _, player := range players {
+		message := dto.WebSocketMessage{
+			Type:           dto.MessageTypeLogUpdate,
+			GameID:         gameID,
+			ActionSequence: sequence,
+			Payload: dto.LogUpdatePayload{
+				Logs: logsForViewer(g, logDtos, player.ID()),
+			},
+		}
 		if err := b.hub.SendToPlayer(gameID, player.ID(), message); err != nil {
 			log.Error("Failed to send log update to player",
 				zap.String("player_id", player.ID()),
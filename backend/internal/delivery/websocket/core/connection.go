@@ -1,6 +1,8 @@
 package core
 
 import (
+	"encoding/json"
+	"fmt"
 	"sync"
 	"time"
 
@@ -31,40 +33,94 @@ type Connection struct {
 	PlayerID string
 	GameID   string
 	Conn     *websocket.Conn
-	Send     chan dto.WebSocketMessage
 
 	// Callbacks for hub communication
 	onMessage    func(HubMessage)
 	onDisconnect func(*Connection)
 
+	// Seats this connection may act for (hotseat); recorded when joining
+	controlledPlayers map[string]bool
+
+	// State slices this connection receives instead of the full game; empty means the full game
+	subscription []string
+
+	// Locale of server messages sent to this connection; empty means English
+	locale string
+
+	// Client IP address seen at the upgrade; empty for virtual connections
+	remoteIP string
+
 	// Direct reference to manager for game association
 	manager *Manager
 
+	// Bounded outbox drained by WritePump, or by the owner of a virtual connection
+	queue        *sendQueue
+	queueMetrics *QueueMetrics
+
+	// Wire format of outgoing frames; only touched by WritePump once the connection is running
+	format            wireFormat
+	deflateNegotiated bool // Client offered permessage-deflate during the upgrade
+	wireMetrics       *WireMetrics
+	sentMessages      int64
+	sentJSONBytes     int64
+	sentEncodedBytes  int64
+
 	// Synchronization
-	mu         sync.RWMutex
-	logger     *zap.Logger
-	Done       chan struct{}
-	closeOnce  sync.Once
-	sendClosed bool
+	mu        sync.RWMutex
+	logger    *zap.Logger
+	Done      chan struct{}
+	closeOnce sync.Once
 }
 
 // NewConnection creates a new WebSocket connection
 func NewConnection(id string, conn *websocket.Conn, manager *Manager, onMessage func(HubMessage), onDisconnect func(*Connection)) *Connection {
-	return &Connection{
+	c := &Connection{
 		ID:           id,
 		Conn:         conn,
-		Send:         make(chan dto.WebSocketMessage, 256),
 		onMessage:    onMessage,
 		onDisconnect: onDisconnect,
 		manager:      manager,
+		queue:        newSendQueue(sendQueueCapacity),
+		format:       wireFormat{encoding: EncodingJSON},
 		logger:       logger.Get(),
 		Done:         make(chan struct{}),
 	}
+	c.useManagerMetrics()
+	return c
 }
 
-// SetPlayer associates this connection with a player
+// NewVirtualConnection creates a connection without a WebSocket peer
+// Messages sent to it are queued for the owner to drain with Ready and Receive (used by bot sessions)
+func NewVirtualConnection(id string, manager *Manager) *Connection {
+	c := &Connection{
+		ID:      id,
+		manager: manager,
+		queue:   newSendQueue(sendQueueCapacity),
+		format:  wireFormat{encoding: EncodingJSON},
+		logger:  logger.Get(),
+		Done:    make(chan struct{}),
+	}
+	c.useManagerMetrics()
+	return c
+}
+
+func (c *Connection) useManagerMetrics() {
+	if c.manager != nil {
+		c.wireMetrics = c.manager.WireMetrics()
+		c.queueMetrics = c.manager.QueueMetrics()
+	}
+}
+
+// SetPlayer associates this connection with a player and authorizes it to control that seat
+// Joining a different game drops seats authorized for the previous game
 func (c *Connection) SetPlayer(playerID, gameID string) {
 	c.mu.Lock()
+	if c.GameID != gameID || c.controlledPlayers == nil {
+		c.controlledPlayers = make(map[string]bool)
+	}
+	if playerID != "" {
+		c.controlledPlayers[playerID] = true
+	}
 	c.PlayerID = playerID
 	c.GameID = gameID
 	c.mu.Unlock()
@@ -75,6 +131,11 @@ func (c *Connection) SetPlayer(playerID, gameID string) {
 	}
 }
 
+// RemoteIP returns the client address the connection was opened from
+func (c *Connection) RemoteIP() string {
+	return c.remoteIP
+}
+
 // GetPlayer returns the player and game IDs for this connection
 func (c *Connection) GetPlayer() (playerID, gameID string) {
 	c.mu.RLock()
@@ -82,22 +143,84 @@ func (c *Connection) GetPlayer() (playerID, gameID string) {
 	return c.PlayerID, c.GameID
 }
 
-// CloseSend closes the send channel
-func (c *Connection) CloseSend() {
+// ControlPlayer switches the seat subsequent actions are performed for
+// Only seats authorized at join may be selected
+func (c *Connection) ControlPlayer(playerID string) error {
 	c.mu.Lock()
 	defer c.mu.Unlock()
 
-	if !c.sendClosed {
-		close(c.Send)
-		c.sendClosed = true
+	if !c.controlledPlayers[playerID] {
+		return fmt.Errorf("connection is not authorized to control player %s", playerID)
 	}
+	c.PlayerID = playerID
+	return nil
+}
+
+// ControlsPlayer reports whether this connection is authorized to act for the player
+func (c *Connection) ControlsPlayer(playerID string) bool {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.controlledPlayers[playerID]
+}
+
+// ControlledPlayers returns all seats this connection is authorized to act for
+func (c *Connection) ControlledPlayers() []string {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	playerIDs := make([]string, 0, len(c.controlledPlayers))
+	for playerID := range c.controlledPlayers {
+		playerIDs = append(playerIDs, playerID)
+	}
+	return playerIDs
+}
+
+// Subscribe limits the game state this connection receives to the given slices
+// An empty list restores the full game state
+func (c *Connection) Subscribe(slices []string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.subscription = append([]string(nil), slices...)
+}
+
+// Subscription returns the state slices this connection receives, or nil for the full game state
+func (c *Connection) Subscription() []string {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	if len(c.subscription) == 0 {
+		return nil
+	}
+	return append([]string(nil), c.subscription...)
+}
+
+// SetLocale selects the language of server messages sent to this connection
+func (c *Connection) SetLocale(locale string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.locale = locale
+}
+
+// Locale returns the language of server messages sent to this connection, or "" for English
+func (c *Connection) Locale() string {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.locale
+}
+
+// CloseSend stops accepting outgoing messages; WritePump sends a close frame once the queue is drained
+func (c *Connection) CloseSend() {
+	c.queue.close()
+	c.releaseSlow()
 }
 
 // Close closes the connection and signals all associated goroutines
 func (c *Connection) Close() {
 	c.closeOnce.Do(func() {
 		close(c.Done)
-		c.Conn.Close()
+		if c.Conn != nil {
+			c.Conn.Close()
+		}
+		c.releaseSlow()
 	})
 }
 
@@ -150,19 +273,33 @@ func (c *Connection) WritePump() {
 	defer func() {
 		ticker.Stop()
 		c.Conn.Close()
+		c.logWireSummary()
 	}()
 
 	for {
 		select {
-		case message, ok := <-c.Send:
-			c.Conn.SetWriteDeadline(time.Now().Add(writeWait))
-			if !ok {
-				c.Conn.WriteMessage(websocket.CloseMessage, []byte{})
-				return
+		case <-c.Ready():
+			for {
+				message, ok := c.Receive()
+				if !ok {
+					break
+				}
+
+				c.Conn.SetWriteDeadline(time.Now().Add(writeWait))
+				if err := c.writeMessage(message); err != nil {
+					c.logger.Error("WebSocket write error", zap.Error(err), zap.String("connection_id", c.ID))
+					return
+				}
+
+				// The ack itself goes out in the old format; everything after it uses the agreed one
+				if ack, ok := message.Payload.(dto.HelloAckPayload); ok && message.Type == dto.MessageTypeHelloAck {
+					c.setWireFormat(Encoding(ack.Encoding), ack.Compression)
+				}
 			}
 
-			if err := c.Conn.WriteJSON(message); err != nil {
-				c.logger.Error("WebSocket write error", zap.Error(err), zap.String("connection_id", c.ID))
+			if c.queue.isClosed() {
+				c.Conn.SetWriteDeadline(time.Now().Add(writeWait))
+				c.Conn.WriteMessage(websocket.CloseMessage, []byte{})
 				return
 			}
 
@@ -178,27 +315,135 @@ func (c *Connection) WritePump() {
 	}
 }
 
-// SendMessage sends a message to this connection
-func (c *Connection) SendMessage(message dto.WebSocketMessage) {
-	c.mu.RLock()
-	sendClosed := c.sendClosed
-	c.mu.RUnlock()
+// writeMessage encodes a message in the connection's wire format and writes it as one frame
+func (c *Connection) writeMessage(message dto.WebSocketMessage) error {
+	data, err := json.Marshal(message)
+	if err != nil {
+		return fmt.Errorf("failed to marshal %s message: %w", message.Type, err)
+	}
 
-	if sendClosed {
-		c.logger.Debug("Attempted to send message to closed connection", zap.String("connection_id", c.ID))
+	frameType := websocket.TextMessage
+	encoded := data
+	if c.format.encoding == EncodingMsgpack {
+		if encoded, err = jsonToMsgpack(data); err != nil {
+			return fmt.Errorf("failed to encode %s message as msgpack: %w", message.Type, err)
+		}
+		frameType = websocket.BinaryMessage
+	}
+
+	if err := c.Conn.WriteMessage(frameType, encoded); err != nil {
+		return err
+	}
+
+	c.sentMessages++
+	c.sentJSONBytes += int64(len(data))
+	c.sentEncodedBytes += int64(len(encoded))
+	c.wireMetrics.record(c.format, message.Type, len(data), encoded)
+	if playerID, gameID := c.GetPlayer(); gameID != "" && c.wireMetrics.overBudget(gameID, len(data), time.Now()) {
+		c.logger.Warn("📏 Outgoing message over size budget",
+			zap.String("game_id", gameID),
+			zap.String("player_id", playerID),
+			zap.String("message_type", string(message.Type)),
+			zap.Int("json_bytes", len(data)),
+			zap.Int("encoded_bytes", len(encoded)),
+			zap.Int64("budget_bytes", c.wireMetrics.SizeBudget()))
+	}
+	return nil
+}
+
+// setWireFormat switches the encoding and compression of subsequent frames
+// Compression is only enabled when the client negotiated permessage-deflate during the upgrade
+func (c *Connection) setWireFormat(encoding Encoding, compressed bool) {
+	compressed = compressed && c.deflateNegotiated
+	c.format = wireFormat{encoding: encoding, compressed: compressed}
+	if c.Conn != nil {
+		c.Conn.EnableWriteCompression(compressed)
+	}
+
+	c.logger.Info("📦 Connection wire format agreed",
+		zap.String("connection_id", c.ID),
+		zap.String("encoding", string(encoding)),
+		zap.Bool("compressed", compressed))
+}
+
+// DeflateNegotiated reports whether the client offered permessage-deflate when connecting
+func (c *Connection) DeflateNegotiated() bool {
+	return c.deflateNegotiated
+}
+
+func (c *Connection) logWireSummary() {
+	if c.sentMessages == 0 {
 		return
 	}
+	coalesced, dropped, maxDepth := c.queue.totals()
+	c.logger.Info("📦 Connection wire summary",
+		zap.String("connection_id", c.ID),
+		zap.String("encoding", string(c.format.encoding)),
+		zap.Bool("compressed", c.format.compressed),
+		zap.Int64("messages", c.sentMessages),
+		zap.Int64("json_bytes", c.sentJSONBytes),
+		zap.Int64("encoded_bytes", c.sentEncodedBytes),
+		zap.Int64("coalesced", coalesced),
+		zap.Int64("dropped", dropped),
+		zap.Int("max_queued", maxDepth))
+}
+
+// SendMessage queues a message for this connection without blocking
+// A newer game state replaces a queued one for the same viewer; when the queue is full the message may be dropped
+func (c *Connection) SendMessage(message dto.WebSocketMessage) {
+	if message.Type == dto.MessageTypeError {
+		message = localizeError(message, c.Locale())
+	}
 
-	select {
-	case c.Send <- message:
+	result, becameSlow := c.queue.push(message, time.Now())
+	c.queueMetrics.record(result)
+
+	switch result {
+	case pushQueued:
 		c.logger.Debug("💬 Message queued for client",
 			zap.String("connection_id", c.ID),
 			zap.String("message_type", string(message.Type)))
-	case <-c.Done:
-		c.logger.Debug("Connection closing, message not sent", zap.String("connection_id", c.ID))
-	default:
-		c.logger.Warn("Message channel full, dropping message",
+	case pushCoalesced:
+		c.logger.Debug("💬 Message queued for client, replacing an older state",
+			zap.String("connection_id", c.ID),
+			zap.String("message_type", string(message.Type)))
+	case pushDropped:
+		c.logger.Warn("Send queue full, dropping message",
 			zap.String("connection_id", c.ID),
 			zap.String("message_type", string(message.Type)))
+	case pushClosed:
+		c.logger.Debug("Attempted to send message to closed connection", zap.String("connection_id", c.ID))
+	}
+
+	if becameSlow {
+		playerID, gameID := c.GetPlayer()
+		c.queueMetrics.slowChanged(true)
+		c.logger.Warn("🐢 Slow client, outgoing messages are backing up",
+			zap.String("connection_id", c.ID),
+			zap.String("game_id", gameID),
+			zap.String("player_id", playerID),
+			zap.Int("queued", c.queue.depth()))
+	}
+}
+
+// Ready is signaled when messages are queued or sending is closed
+// Owners of virtual connections wait on it and then call Receive until it returns false
+func (c *Connection) Ready() <-chan struct{} {
+	return c.queue.ready
+}
+
+// Receive takes the oldest queued message, returning false when none are queued
+func (c *Connection) Receive() (dto.WebSocketMessage, bool) {
+	message, ok, recovered := c.queue.pop()
+	if recovered {
+		c.queueMetrics.slowChanged(false)
+		c.logger.Info("🐇 Slow client caught up", zap.String("connection_id", c.ID))
+	}
+	return message, ok
+}
+
+func (c *Connection) releaseSlow() {
+	if c.queue.releaseSlow() {
+		c.queueMetrics.slowChanged(false)
 	}
 }
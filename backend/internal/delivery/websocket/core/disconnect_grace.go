@@ -0,0 +1,69 @@
+package core
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// DefaultDisconnectGrace is how long a dropped player may take to reconnect before the table is told they left
+const DefaultDisconnectGrace = 30 * time.Second
+
+// pendingDisconnect is a dropped player's disconnect waiting out the grace period
+type pendingDisconnect struct {
+	timer *time.Timer
+}
+
+// SetDisconnectGrace changes how long a dropped player may take to reconnect before their disconnect
+// is handled (0 handles it right away). Call it before Run
+func (h *Hub) SetDisconnectGrace(grace time.Duration) {
+	h.disconnectGrace = grace
+}
+
+// scheduleDisconnect handles a player's disconnect once the grace period has passed without them reconnecting
+// Reconnecting within the window leaves nothing for other players to see; a second drop restarts the window
+func (h *Hub) scheduleDisconnect(ctx context.Context, hubMessage HubMessage, gameID, playerID string) {
+	if h.disconnectGrace <= 0 {
+		h.dispatch(ctx, hubMessage)
+		return
+	}
+
+	key := gameID + "/" + playerID
+	pending := &pendingDisconnect{}
+
+	h.pendingDisconnectsMu.Lock()
+	defer h.pendingDisconnectsMu.Unlock()
+	if previous, ok := h.pendingDisconnects[key]; ok {
+		previous.timer.Stop()
+	}
+	h.pendingDisconnects[key] = pending
+	pending.timer = time.AfterFunc(h.disconnectGrace, func() {
+		h.pendingDisconnectsMu.Lock()
+		current := h.pendingDisconnects[key]
+		if current == pending {
+			delete(h.pendingDisconnects, key)
+		}
+		h.pendingDisconnectsMu.Unlock()
+		if current != pending {
+			return
+		}
+
+		log := h.logger.With(zap.String("game_id", gameID), zap.String("player_id", playerID))
+		if h.manager.GetConnectionByPlayerID(gameID, playerID) != nil {
+			log.Debug("🔁 Player reconnected within the disconnect grace period")
+			return
+		}
+
+		log.Info("⛓️‍💥 Disconnect grace period over, player is away", zap.Duration("grace", h.disconnectGrace))
+		select {
+		case h.Messages <- hubMessage:
+		case <-ctx.Done():
+		}
+	})
+
+	h.logger.Debug("⏳ Holding disconnect for the grace period",
+		zap.String("game_id", gameID),
+		zap.String("player_id", playerID),
+		zap.Duration("grace", h.disconnectGrace))
+}
@@ -0,0 +1,143 @@
+package core
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"math"
+	"sort"
+)
+
+// Encoding is the wire format of a connection's outgoing frames
+// Every connection starts on JSON; a client may switch with the hello handshake.
+// Incoming messages are always JSON: they are small and the saving would not pay for the extra client code.
+type Encoding string
+
+const (
+	EncodingJSON    Encoding = "json"    // Text frames
+	EncodingMsgpack Encoding = "msgpack" // Binary frames with the same structure as the JSON form
+)
+
+// negotiateEncoding picks the first offered encoding the server supports, falling back to JSON
+func negotiateEncoding(offered []string) Encoding {
+	for _, name := range offered {
+		switch Encoding(name) {
+		case EncodingJSON, EncodingMsgpack:
+			return Encoding(name)
+		}
+	}
+	return EncodingJSON
+}
+
+// jsonToMsgpack re-encodes a JSON document as MessagePack
+// Going through JSON keeps field names and omitempty rules identical to the text encoding.
+// Object keys are sorted so equal messages encode to equal bytes.
+func jsonToMsgpack(data []byte) ([]byte, error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+
+	var value any
+	if err := decoder.Decode(&value); err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	buf.Grow(len(data))
+	if err := writeMsgpack(&buf, value); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func writeMsgpack(buf *bytes.Buffer, value any) error {
+	switch v := value.(type) {
+	case nil:
+		buf.WriteByte(0xc0)
+	case bool:
+		if v {
+			buf.WriteByte(0xc3)
+		} else {
+			buf.WriteByte(0xc2)
+		}
+	case json.Number:
+		if i, err := v.Int64(); err == nil {
+			writeMsgpackInt(buf, i)
+			return nil
+		}
+		f, err := v.Float64()
+		if err != nil {
+			return fmt.Errorf("invalid number %q: %w", v, err)
+		}
+		buf.WriteByte(0xcb)
+		_ = binary.Write(buf, binary.BigEndian, math.Float64bits(f))
+	case string:
+		writeMsgpackHeader(buf, len(v), 0xa0, 32, 0xd9, 0xda, 0xdb)
+		buf.WriteString(v)
+	case []any:
+		writeMsgpackHeader(buf, len(v), 0x90, 16, 0, 0xdc, 0xdd)
+		for _, item := range v {
+			if err := writeMsgpack(buf, item); err != nil {
+				return err
+			}
+		}
+	case map[string]any:
+		keys := make([]string, 0, len(v))
+		for key := range v {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+
+		writeMsgpackHeader(buf, len(v), 0x80, 16, 0, 0xde, 0xdf)
+		for _, key := range keys {
+			if err := writeMsgpack(buf, key); err != nil {
+				return err
+			}
+			if err := writeMsgpack(buf, v[key]); err != nil {
+				return err
+			}
+		}
+	default:
+		return fmt.Errorf("unsupported value type %T", value)
+	}
+	return nil
+}
+
+// writeMsgpackHeader writes a length-prefixed header, using the fix form below fixLimit
+// A zero code8 means the type has no 8-bit length form
+func writeMsgpackHeader(buf *bytes.Buffer, length int, fixBase byte, fixLimit int, code8, code16, code32 byte) {
+	switch {
+	case length < fixLimit:
+		buf.WriteByte(fixBase | byte(length))
+	case code8 != 0 && length <= math.MaxUint8:
+		buf.WriteByte(code8)
+		buf.WriteByte(byte(length))
+	case length <= math.MaxUint16:
+		buf.WriteByte(code16)
+		_ = binary.Write(buf, binary.BigEndian, uint16(length))
+	default:
+		buf.WriteByte(code32)
+		_ = binary.Write(buf, binary.BigEndian, uint32(length))
+	}
+}
+
+func writeMsgpackInt(buf *bytes.Buffer, i int64) {
+	switch {
+	case i >= 0 && i <= 0x7f:
+		buf.WriteByte(byte(i))
+	case i < 0 && i >= -32:
+		buf.WriteByte(byte(int8(i)))
+	case i >= math.MinInt8 && i <= math.MaxInt8:
+		buf.WriteByte(0xd0)
+		buf.WriteByte(byte(int8(i)))
+	case i >= math.MinInt16 && i <= math.MaxInt16:
+		buf.WriteByte(0xd1)
+		_ = binary.Write(buf, binary.BigEndian, int16(i))
+	case i >= math.MinInt32 && i <= math.MaxInt32:
+		buf.WriteByte(0xd2)
+		_ = binary.Write(buf, binary.BigEndian, int32(i))
+	default:
+		buf.WriteByte(0xd3)
+		_ = binary.Write(buf, binary.BigEndian, i)
+	}
+}
@@ -0,0 +1,275 @@
+package core
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"terraforming-mars-backend/internal/delivery/dto"
+	"terraforming-mars-backend/internal/game"
+
+	"go.uber.org/zap"
+)
+
+// DefaultHangTimeout is how long one queued message may run before its game is marked failed
+const DefaultHangTimeout = time.Minute
+
+// gameQueue runs one game's messages strictly in arrival order
+// A goroutine drains the queue while messages are pending and exits when it is empty, so an
+// action never starts before the previous one for the same game has finished, while different
+// games are processed in parallel.
+type gameQueue struct {
+	gameID string
+
+	mu      sync.Mutex
+	pending []HubMessage
+	running bool
+	failed  bool // A message hung; the game takes no further messages
+
+	sequence atomic.Int64 // Messages started for this game; stamped on broadcasts as the action sequence
+}
+
+// push appends a message and reports whether a drain goroutine needs to be started
+// A failed queue refuses the message with game.ErrGameFailed
+func (q *gameQueue) push(message HubMessage) (start bool, err error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if q.failed {
+		return false, game.ErrGameFailed
+	}
+	q.pending = append(q.pending, message)
+	if q.running {
+		return false, nil
+	}
+	q.running = true
+	return true, nil
+}
+
+// next takes the oldest pending message, marking the queue idle when there is none
+func (q *gameQueue) next() (HubMessage, bool) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if len(q.pending) == 0 {
+		q.running = false
+		return HubMessage{}, false
+	}
+
+	message := q.pending[0]
+	q.pending[0] = HubMessage{}
+	q.pending = q.pending[1:]
+	return message, true
+}
+
+// fail marks the queue failed and hands back the messages that were waiting in it
+func (q *gameQueue) fail() []HubMessage {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	q.failed = true
+	waiting := q.pending
+	q.pending = nil
+	return waiting
+}
+
+// dispatch routes a message to its game's queue, or handles it right away when it belongs to no game
+func (h *Hub) dispatch(ctx context.Context, hubMessage HubMessage) {
+	gameID := hubMessage.Message.GameID
+	if gameID == "" {
+		_, gameID = hubMessage.Connection.GetPlayer()
+	}
+
+	// The hello handshake configures the transport, so it never waits behind game actions
+	if gameID == "" || hubMessage.Message.Type == dto.MessageTypeHello {
+		h.routeMessage(ctx, hubMessage)
+		return
+	}
+
+	queue := h.gameQueue(gameID)
+	start, err := queue.push(hubMessage)
+	if err != nil {
+		h.refuse(gameID, hubMessage, err)
+		return
+	}
+	if start {
+		go h.drainGameQueue(ctx, queue)
+	}
+}
+
+func (h *Hub) drainGameQueue(ctx context.Context, queue *gameQueue) {
+	for {
+		hubMessage, ok := queue.next()
+		if !ok {
+			return
+		}
+
+		// Queued work is not a client action, so it does not advance the action sequence
+		if hubMessage.Run != nil {
+			h.runMessage(ctx, queue, hubMessage, hubMessage.Run)
+			continue
+		}
+
+		sequence := queue.sequence.Add(1)
+		h.logger.Debug("🔢 Processing game message",
+			zap.String("game_id", queue.gameID),
+			zap.Int64("action_sequence", sequence),
+			zap.String("message_type", string(hubMessage.Message.Type)))
+
+		h.runMessage(ctx, queue, hubMessage, func(actionCtx context.Context) {
+			h.routeMessage(actionCtx, hubMessage)
+		})
+	}
+}
+
+// runMessage runs one queued message and waits for it to return, so a game's messages never overlap
+// With an action timeout set, the message's context expires at the deadline; actions stop at their next
+// checkpoint (baseaction.RunInTransaction) and report the rollback themselves, so only the action's
+// own result tells the sender what happened. A message still running after the hang timeout is
+// presumed wedged and its game is marked failed.
+func (h *Hub) runMessage(ctx context.Context, queue *gameQueue, hubMessage HubMessage, run func(ctx context.Context)) {
+	actionCtx := ctx
+	if h.actionTimeout > 0 {
+		var cancel context.CancelFunc
+		actionCtx, cancel = context.WithTimeout(ctx, h.actionTimeout)
+		defer cancel()
+	}
+
+	if h.hangTimeout > 0 {
+		watchdog := time.AfterFunc(h.hangTimeout, func() {
+			h.failGame(queue, hubMessage)
+		})
+		defer watchdog.Stop()
+	}
+
+	run(actionCtx)
+}
+
+// failGame refuses every message waiting behind a hung one, tells their senders and the hung
+// message's sender, and hands the game to the failure handler
+// It runs on the watchdog's goroutine, so a failure handler blocked by the hung action holds up nothing else
+func (h *Hub) failGame(queue *gameQueue, hung HubMessage) {
+	waiting := queue.fail()
+	h.logger.Error("🧊 Game message hung, marking the game failed",
+		zap.String("game_id", queue.gameID),
+		zap.String("message_type", string(hung.Message.Type)),
+		zap.Duration("hang_timeout", h.hangTimeout),
+		zap.Int("refused_messages", len(waiting)))
+
+	h.refuse(queue.gameID, hung, game.ErrGameFailed)
+	for _, message := range waiting {
+		h.refuse(queue.gameID, message, game.ErrGameFailed)
+	}
+
+	if h.onGameFailed != nil {
+		h.onGameFailed(queue.gameID)
+	}
+}
+
+// refuse tells a message's sender, or the server-side work waiting on it, that its game will not run it
+func (h *Hub) refuse(gameID string, hubMessage HubMessage, err error) {
+	if hubMessage.reject != nil {
+		hubMessage.reject(err)
+	}
+	if hubMessage.Connection != nil {
+		hubMessage.Connection.SendMessage(dto.WebSocketMessage{
+			Type:   dto.MessageTypeError,
+			GameID: gameID,
+			Payload: dto.ErrorPayload{
+				Message: err.Error(),
+				Code:    dto.ErrCodeGameFailed,
+			},
+		})
+	}
+}
+
+// SetActionTimeout sets the deadline on each queued message's context (0, the default, sets none)
+// Call it before Run
+func (h *Hub) SetActionTimeout(timeout time.Duration) {
+	h.actionTimeout = timeout
+}
+
+// SetHangTimeout changes how long one message may run before its game is marked failed (0 disables it)
+// Call it before Run
+func (h *Hub) SetHangTimeout(timeout time.Duration) {
+	h.hangTimeout = timeout
+}
+
+// SetGameFailedHandler installs the callback run when a game is marked failed; call it before Run
+func (h *Hub) SetGameFailedHandler(onGameFailed func(gameID string)) {
+	h.onGameFailed = onGameFailed
+}
+
+func (h *Hub) gameQueue(gameID string) *gameQueue {
+	h.gameQueuesMu.Lock()
+	defer h.gameQueuesMu.Unlock()
+
+	queue, ok := h.gameQueues[gameID]
+	if !ok {
+		queue = &gameQueue{gameID: gameID}
+		h.gameQueues[gameID] = queue
+	}
+	return queue
+}
+
+// RemoveGameQueue drops a game's queue once the game is gone, so the hub does not keep one for every game ever played
+// A drain still running finishes the messages it holds; a later message for the game starts a new queue.
+func (h *Hub) RemoveGameQueue(gameID string) {
+	h.gameQueuesMu.Lock()
+	defer h.gameQueuesMu.Unlock()
+	delete(h.gameQueues, gameID)
+}
+
+// ActionSequence returns the number of messages started for a game
+// Broadcasts carry it so clients can tell which actions their state reflects and notice
+// when actions were processed without reaching them
+func (h *Hub) ActionSequence(gameID string) int64 {
+	h.gameQueuesMu.Lock()
+	queue, ok := h.gameQueues[gameID]
+	h.gameQueuesMu.Unlock()
+
+	if !ok {
+		return 0
+	}
+	return queue.sequence.Load()
+}
+
+// RunInGameQueue runs fn behind the messages already queued for the game and waits for it to finish,
+// so work that touches the game never interleaves with a player's action
+// fn receives the queue's action context, which carries the action timeout and also ends when ctx does;
+// if ctx ends first, RunInGameQueue returns its error and fn may still run later, with an ended context
+// A failed game never runs fn, and RunInGameQueue returns game.ErrGameFailed
+func (h *Hub) RunInGameQueue(ctx context.Context, gameID string, fn func(ctx context.Context)) error {
+	done := make(chan struct{})
+	rejected := make(chan error, 1)
+	hubMessage := HubMessage{
+		Message: dto.WebSocketMessage{GameID: gameID},
+		Run: func(actionCtx context.Context) {
+			defer close(done)
+			runCtx, cancel := context.WithCancel(actionCtx)
+			defer cancel()
+			stop := context.AfterFunc(ctx, cancel)
+			defer stop()
+			fn(runCtx)
+		},
+		reject: func(err error) {
+			rejected <- err
+		},
+	}
+
+	select {
+	case h.Messages <- hubMessage:
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+
+	select {
+	case <-done:
+		return nil
+	case err := <-rejected:
+		return err
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
@@ -1,7 +1,9 @@
 package core
 
 import (
+	"net"
 	"net/http"
+	"strings"
 	"time"
 
 	"terraforming-mars-backend/internal/logger"
@@ -14,6 +16,8 @@ import (
 var upgrader = websocket.Upgrader{
 	ReadBufferSize:  1024,
 	WriteBufferSize: 1024,
+	// Negotiates permessage-deflate with clients that offer it; frames stay uncompressed until the hello handshake asks for it
+	EnableCompression: true,
 	CheckOrigin: func(r *http.Request) bool {
 		// Allow all origins in development - should be restricted in production
 		return true
@@ -22,8 +26,9 @@ var upgrader = websocket.Upgrader{
 
 // Handler handles WebSocket HTTP upgrade requests
 type Handler struct {
-	hub    *Hub
-	logger *zap.Logger
+	hub            *Hub
+	logger         *zap.Logger
+	clientIPHeader string // Header a trusted proxy puts the client address in; empty uses the peer address
 }
 
 // NewHandler creates a new WebSocket handler
@@ -34,6 +39,12 @@ func NewHandler(hub *Hub) *Handler {
 	}
 }
 
+// TrustClientIPHeader reads client addresses from a header set by the proxy in front of the server,
+// such as CF-Connecting-IP or X-Forwarded-For; only set it when clients cannot reach the server directly
+func (h *Handler) TrustClientIPHeader(header string) {
+	h.clientIPHeader = header
+}
+
 // ServeWS handles WebSocket upgrade requests from clients
 func (h *Handler) ServeWS(w http.ResponseWriter, r *http.Request) {
 	h.logger.Info("🔗 WebSocket connection request received", zap.String("remote_addr", r.RemoteAddr))
@@ -50,6 +61,9 @@ func (h *Handler) ServeWS(w http.ResponseWriter, r *http.Request) {
 		h.hub.GetManager(), // Direct manager reference
 		func(msg HubMessage) { h.hub.Messages <- msg },      // onMessage callback
 		func(conn *Connection) { h.hub.Unregister <- conn }) // onDisconnect callback
+	connection.deflateNegotiated = offersDeflate(r)
+	connection.remoteIP = clientIP(r, h.clientIPHeader)
+	conn.EnableWriteCompression(false)
 
 	h.logger.Info("✅ New WebSocket connection established",
 		zap.String("connection_id", connectionID),
@@ -70,3 +84,33 @@ func (h *Handler) ServeWS(w http.ResponseWriter, r *http.Request) {
 
 	h.logger.Info("🎉 WebSocket connection fully initialized", zap.String("connection_id", connectionID))
 }
+
+// clientIP returns the client's address: the first entry of the trusted header when set and present,
+// otherwise the peer address without its port
+func clientIP(r *http.Request, header string) string {
+	if header != "" {
+		if value := r.Header.Get(header); value != "" {
+			first, _, _ := strings.Cut(value, ",")
+			return strings.TrimSpace(first)
+		}
+	}
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
+
+// offersDeflate reports whether the upgrade request offered permessage-deflate
+// The upgrader accepts the extension whenever it is offered, so this is also whether it was negotiated
+func offersDeflate(r *http.Request) bool {
+	for _, header := range r.Header.Values("Sec-Websocket-Extensions") {
+		for _, extension := range strings.Split(header, ",") {
+			name, _, _ := strings.Cut(strings.TrimSpace(extension), ";")
+			if strings.TrimSpace(name) == "permessage-deflate" {
+				return true
+			}
+		}
+	}
+	return false
+}
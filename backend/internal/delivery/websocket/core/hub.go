@@ -2,7 +2,12 @@ package core
 
 import (
 	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+
 	"terraforming-mars-backend/internal/delivery/dto"
+	"terraforming-mars-backend/internal/i18n"
 	"terraforming-mars-backend/internal/logger"
 
 	"go.uber.org/zap"
@@ -13,10 +18,30 @@ type MessageHandler interface {
 	HandleMessage(ctx context.Context, connection *Connection, message dto.WebSocketMessage)
 }
 
+// StepHandler runs a handler's action as one step of a batch, for the given player
+// It neither answers the sender nor broadcasts; the batch does both once it is done
+type StepHandler interface {
+	RunStep(ctx context.Context, gameID string, playerID string, payload map[string]interface{}) error
+}
+
+// MessageGuard vets every routed message before its handler runs
+// A guard that refuses a message returns false and answers the sender itself
+type MessageGuard interface {
+	Allow(ctx context.Context, connection *Connection, message dto.WebSocketMessage) bool
+}
+
+// InputRecorder keeps each message a handler has handled, so the game it was sent to can be replayed
+type InputRecorder interface {
+	RecordInput(ctx context.Context, connection *Connection, message dto.WebSocketMessage)
+}
+
 // HubMessage represents a message to be processed by the hub
 type HubMessage struct {
 	Connection *Connection
 	Message    dto.WebSocketMessage
+	Run        func(ctx context.Context) // Server-side work queued behind the game's messages instead of a client message
+
+	reject func(err error) // Tells RunInGameQueue its work was refused
 }
 
 // EventHandler interface for handling domain events
@@ -24,6 +49,7 @@ type EventHandler interface {
 }
 
 // Hub manages WebSocket connections and message routing
+// Messages for the same game are handled one at a time in arrival order; different games run in parallel
 type Hub struct {
 	Register   chan *Connection
 	Unregister chan *Connection
@@ -32,6 +58,20 @@ type Hub struct {
 	manager  *Manager
 	logger   *zap.Logger
 	handlers map[dto.MessageType]MessageHandler
+	guard    MessageGuard
+	recorder InputRecorder
+
+	gameQueuesMu  sync.Mutex
+	gameQueues    map[string]*gameQueue
+	actionTimeout time.Duration
+	hangTimeout   time.Duration
+	onGameFailed  func(gameID string)
+
+	pendingDisconnectsMu sync.Mutex
+	pendingDisconnects   map[string]*pendingDisconnect // "gameID/playerID" -> disconnect waiting out the grace period
+	disconnectGrace      time.Duration
+
+	running atomic.Bool
 }
 
 // NewHub creates a new WebSocket hub with clean architecture
@@ -39,12 +79,16 @@ func NewHub() *Hub {
 	manager := NewManager()
 
 	return &Hub{
-		Register:   make(chan *Connection),
-		Unregister: make(chan *Connection),
-		Messages:   make(chan HubMessage),
-		manager:    manager,
-		logger:     logger.Get(),
-		handlers:   make(map[dto.MessageType]MessageHandler),
+		Register:           make(chan *Connection),
+		Unregister:         make(chan *Connection),
+		Messages:           make(chan HubMessage),
+		manager:            manager,
+		logger:             logger.Get(),
+		handlers:           make(map[dto.MessageType]MessageHandler),
+		gameQueues:         make(map[string]*gameQueue),
+		hangTimeout:        DefaultHangTimeout,
+		pendingDisconnects: make(map[string]*pendingDisconnect),
+		disconnectGrace:    DefaultDisconnectGrace,
 	}
 }
 
@@ -52,6 +96,8 @@ func NewHub() *Hub {
 func (h *Hub) Run(ctx context.Context) {
 	h.logger.Info("🚀 Starting WebSocket hub")
 	h.logger.Info("✅ WebSocket hub ready to process messages")
+	h.running.Store(true)
+	defer h.running.Store(false)
 
 	for {
 		select {
@@ -84,27 +130,58 @@ func (h *Hub) Run(ctx context.Context) {
 					Message:    disconnectMessage,
 				}
 
-				// Route the disconnect message through the normal handler system
-				h.routeMessage(ctx, hubMessage)
+				// Queue the disconnect behind the player's in-flight actions once they have had time to come back
+				h.scheduleDisconnect(ctx, hubMessage, gameID, playerID)
 			}
 
 		case hubMessage := <-h.Messages:
-			// Route message to appropriate handler
-			h.routeMessage(ctx, hubMessage)
+			// Queue game messages per game; route the rest immediately
+			h.dispatch(ctx, hubMessage)
 		}
 	}
 }
 
+// Running reports whether Run is processing messages, for the readiness probe
+func (h *Hub) Running() bool {
+	return h.running.Load()
+}
+
 // RegisterHandler registers a message handler for a specific message type
 func (h *Hub) RegisterHandler(messageType dto.MessageType, handler MessageHandler) {
 	h.handlers[messageType] = handler
 }
 
+// SetGuard installs the check every message passes before reaching its handler; call it before Run
+func (h *Hub) SetGuard(guard MessageGuard) {
+	h.guard = guard
+}
+
+// SetInputRecorder installs the recorder every handled message is passed to; call it before Run
+func (h *Hub) SetInputRecorder(recorder InputRecorder) {
+	h.recorder = recorder
+}
+
+// Route hands one message to its handler right away, as the game's queue would
+// It is for tools that drive the hub's handlers one message at a time instead of through Run
+func (h *Hub) Route(ctx context.Context, connection *Connection, message dto.WebSocketMessage) {
+	h.routeMessage(ctx, HubMessage{Connection: connection, Message: message})
+}
+
 // GetManager returns the connection manager
 func (h *Hub) GetManager() *Manager {
 	return h.manager
 }
 
+// WireMetrics returns outgoing payload sizes across all connections
+func (h *Hub) WireMetrics() *WireMetrics {
+	return h.manager.WireMetrics()
+}
+
+// QueueMetrics returns outgoing queue activity across all connections
+func (h *Hub) QueueMetrics() *QueueMetrics {
+	return h.manager.QueueMetrics()
+}
+
 // SendToPlayer sends a message to a specific player via their connection
 func (h *Hub) SendToPlayer(gameID, playerID string, message dto.WebSocketMessage) error {
 	connection := h.manager.GetConnectionByPlayerID(gameID, playerID)
@@ -124,6 +201,26 @@ func (h *Hub) SendToPlayer(gameID, playerID string, message dto.WebSocketMessage
 	return nil
 }
 
+// PlayerSubscription returns the state slices a player's connection subscribed to, or nil for the full game state
+func (h *Hub) PlayerSubscription(gameID, playerID string) []string {
+	connection := h.manager.GetConnectionByPlayerID(gameID, playerID)
+	if connection == nil {
+		return nil
+	}
+	return connection.Subscription()
+}
+
+// SeatlessConnections returns the connections watching a game without a seat
+func (h *Hub) SeatlessConnections(gameID string) []*Connection {
+	var watching []*Connection
+	for connection := range h.manager.GetGameConnections(gameID) {
+		if playerID, _ := connection.GetPlayer(); playerID == "" {
+			watching = append(watching, connection)
+		}
+	}
+	return watching
+}
+
 // RegisterConnectionWithGame registers a connection with a game after player ID is set
 func (h *Hub) RegisterConnectionWithGame(connection *Connection, gameID string) {
 	h.manager.AddToGame(connection, gameID)
@@ -142,10 +239,24 @@ func (h *Hub) routeMessage(ctx context.Context, hubMessage HubMessage) {
 		zap.String("connection_id", connection.ID),
 		zap.String("message_type", string(message.Type)))
 
+	// The hello handshake configures the transport, so it is answered here rather than by a game handler
+	if message.Type == dto.MessageTypeHello {
+		h.handleHello(connection, message)
+		return
+	}
+
 	if handler, exists := h.handlers[message.Type]; exists {
+		if h.guard != nil && !h.guard.Allow(ctx, connection, message) {
+			h.logger.Debug("🚫 Message refused by guard",
+				zap.String("message_type", string(message.Type)))
+			return
+		}
 		h.logger.Debug("🎯 Routing to registered message handler",
 			zap.String("message_type", string(message.Type)))
 		handler.HandleMessage(ctx, connection, message)
+		if h.recorder != nil {
+			h.recorder.RecordInput(ctx, connection, message)
+		}
 	} else {
 		h.logger.Warn("❓ Unknown message type",
 			zap.String("message_type", string(message.Type)))
@@ -153,6 +264,51 @@ func (h *Hub) routeMessage(ctx context.Context, hubMessage HubMessage) {
 	}
 }
 
+// handleHello agrees on the wire format for a connection's outgoing frames
+// The ack is written in the current format and the connection switches right after writing it
+func (h *Hub) handleHello(connection *Connection, message dto.WebSocketMessage) {
+	var offered []string
+	compression := false
+	if payloadMap, ok := message.Payload.(map[string]any); ok {
+		if encodings, ok := payloadMap["encodings"].([]any); ok {
+			for _, encoding := range encodings {
+				if name, ok := encoding.(string); ok {
+					offered = append(offered, name)
+				}
+			}
+		}
+		compression, _ = payloadMap["compression"].(bool)
+		if locale, ok := payloadMap["locale"].(string); ok {
+			if normalized := i18n.Normalize(locale); normalized != "" {
+				connection.SetLocale(normalized)
+			}
+		}
+	}
+
+	locale := connection.Locale()
+	if locale == "" {
+		locale = i18n.DefaultLocale
+	}
+
+	ack := dto.HelloAckPayload{
+		Encoding:    string(negotiateEncoding(offered)),
+		Compression: compression && connection.DeflateNegotiated(),
+		Locale:      locale,
+	}
+
+	h.logger.Debug("🤝 Hello handshake",
+		zap.String("connection_id", connection.ID),
+		zap.Strings("offered_encodings", offered),
+		zap.String("encoding", ack.Encoding),
+		zap.Bool("compression", ack.Compression),
+		zap.String("locale", ack.Locale))
+
+	connection.SendMessage(dto.WebSocketMessage{
+		Type:    dto.MessageTypeHelloAck,
+		Payload: ack,
+	})
+}
+
 // sendError sends an error message to a connection
 func (h *Hub) sendError(connection *Connection, errorMessage string) {
 	_, gameID := connection.GetPlayer()
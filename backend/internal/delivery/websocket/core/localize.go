@@ -0,0 +1,23 @@
+package core
+
+import (
+	"terraforming-mars-backend/internal/delivery/dto"
+	"terraforming-mars-backend/internal/i18n"
+)
+
+// localizeError gives a known engine error its catalog code and translates it into the connection's locale
+// Handlers keep sending the engine's English text; errors the catalog does not know pass through unchanged
+func localizeError(message dto.WebSocketMessage, locale string) dto.WebSocketMessage {
+	payload, ok := message.Payload.(dto.ErrorPayload)
+	if !ok {
+		return message
+	}
+
+	code, text := i18n.LocalizeError(locale, payload.Message)
+	if payload.Code == "" {
+		payload.Code = code
+	}
+	payload.Message = text
+	message.Payload = payload
+	return message
+}
@@ -14,6 +14,13 @@ type Manager struct {
 	gameConnections map[string]map[*Connection]bool
 	mu              sync.RWMutex
 	logger          *zap.Logger
+
+	// Shared by every connection created with this manager
+	wireMetrics  *WireMetrics
+	queueMetrics *QueueMetrics
+
+	// Told which address a seat connected from whenever a connection joins a game
+	addressRecorder func(gameID, playerID, address string)
 }
 
 // NewManager creates a new connection manager
@@ -22,9 +29,21 @@ func NewManager() *Manager {
 		connections:     make(map[*Connection]bool),
 		gameConnections: make(map[string]map[*Connection]bool),
 		logger:          logger.Get(),
+		wireMetrics:     NewWireMetrics(),
+		queueMetrics:    NewQueueMetrics(),
 	}
 }
 
+// WireMetrics returns outgoing payload sizes across all connections
+func (m *Manager) WireMetrics() *WireMetrics {
+	return m.wireMetrics
+}
+
+// QueueMetrics returns outgoing queue activity across all connections
+func (m *Manager) QueueMetrics() *QueueMetrics {
+	return m.queueMetrics
+}
+
 // RegisterConnection registers a new connection
 func (m *Manager) RegisterConnection(connection *Connection) {
 	m.mu.Lock()
@@ -69,15 +88,27 @@ func (m *Manager) UnregisterConnection(connection *Connection) (playerID, gameID
 	return playerID, gameID, shouldBroadcast
 }
 
-// AddToGame adds a connection to a game group
-func (m *Manager) AddToGame(connection *Connection, gameID string) {
+// SetAddressRecorder registers a callback told each seat's address when its connection joins a game
+func (m *Manager) SetAddressRecorder(recorder func(gameID, playerID, address string)) {
 	m.mu.Lock()
 	defer m.mu.Unlock()
+	m.addressRecorder = recorder
+}
 
+// AddToGame adds a connection to a game group
+func (m *Manager) AddToGame(connection *Connection, gameID string) {
+	m.mu.Lock()
 	if m.gameConnections[gameID] == nil {
 		m.gameConnections[gameID] = make(map[*Connection]bool)
 	}
 	m.gameConnections[gameID][connection] = true
+	recorder := m.addressRecorder
+	m.mu.Unlock()
+
+	if recorder != nil && connection.remoteIP != "" {
+		playerID, _ := connection.GetPlayer()
+		recorder(gameID, playerID, connection.remoteIP)
+	}
 }
 
 // GetGameConnections returns all connections for a specific game (read-only copy)
@@ -199,7 +230,8 @@ func (m *Manager) CloseAllConnections() {
 	m.logger.Info("⛓️‍💥 All client connections closed by server")
 }
 
-// GetConnectionByPlayerID finds a connection for a specific player in a game
+// GetConnectionByPlayerID finds a connection controlling a specific player in a game
+// Prefers the connection whose active seat is the player over hotseat connections holding it
 func (m *Manager) GetConnectionByPlayerID(gameID, playerID string) *Connection {
 	m.mu.RLock()
 	defer m.mu.RUnlock()
@@ -209,11 +241,16 @@ func (m *Manager) GetConnectionByPlayerID(gameID, playerID string) *Connection {
 		return nil
 	}
 
+	var controlling *Connection
 	for connection := range gameConnections {
-		if connection.PlayerID == playerID {
+		activePlayerID, _ := connection.GetPlayer()
+		if activePlayerID == playerID {
 			return connection
 		}
+		if controlling == nil && connection.ControlsPlayer(playerID) {
+			controlling = connection
+		}
 	}
 
-	return nil
+	return controlling
 }
@@ -0,0 +1,255 @@
+package core
+
+import (
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"terraforming-mars-backend/internal/delivery/dto"
+)
+
+const (
+	// Maximum messages waiting to be written to one connection
+	sendQueueCapacity = 256
+
+	// A connection whose oldest queued message has waited this long is considered slow
+	slowClientAge = 5 * time.Second
+)
+
+// pushResult tells the sender what happened to an outgoing message
+type pushResult int
+
+const (
+	pushQueued    pushResult = iota // Appended to the queue
+	pushCoalesced                   // Appended after dropping an older state for the same viewer
+	pushDropped                     // Discarded because the queue is full
+	pushClosed                      // Discarded because the connection is closing
+)
+
+type queuedMessage struct {
+	message    dto.WebSocketMessage
+	stateKey   string // Non-empty for game states, which supersede each other
+	enqueuedAt time.Time
+}
+
+// sendQueue is a connection's bounded outbox
+// Game states are coalesced: a queued state is replaced by a newer one for the same viewer, so a
+// client that falls behind skips straight to the latest state instead of replaying every update.
+// When the queue is full, older non-state messages make room for a state and other messages are dropped.
+// Pushing never blocks, so a slow client cannot stall broadcasts to the rest of the game.
+type sendQueue struct {
+	mu       sync.Mutex
+	items    []queuedMessage
+	capacity int
+	ready    chan struct{} // Signaled when messages are queued or the queue closes
+	closed   bool
+	slow     bool
+
+	coalesced int64
+	dropped   int64
+	maxDepth  int
+}
+
+func newSendQueue(capacity int) *sendQueue {
+	return &sendQueue{
+		items:    make([]queuedMessage, 0, 16),
+		capacity: capacity,
+		ready:    make(chan struct{}, 1),
+	}
+}
+
+// push queues a message and reports whether this push made the connection slow
+func (q *sendQueue) push(message dto.WebSocketMessage, now time.Time) (result pushResult, becameSlow bool) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if q.closed {
+		return pushClosed, false
+	}
+
+	result = pushQueued
+	key := stateKey(message)
+	if key != "" && q.remove(func(item queuedMessage) bool { return item.stateKey == key }) {
+		result = pushCoalesced
+		q.coalesced++
+	}
+
+	overflow := len(q.items) >= q.capacity
+	if overflow && key != "" {
+		overflow = !q.remove(func(item queuedMessage) bool { return item.stateKey == "" })
+	}
+	if overflow {
+		q.dropped++
+		return pushDropped, q.markSlow()
+	}
+
+	q.items = append(q.items, queuedMessage{message: message, stateKey: key, enqueuedAt: now})
+	if len(q.items) > q.maxDepth {
+		q.maxDepth = len(q.items)
+	}
+	q.signal()
+
+	if now.Sub(q.items[0].enqueuedAt) >= slowClientAge {
+		return result, q.markSlow()
+	}
+	return result, false
+}
+
+// pop removes the oldest message and reports whether emptying the queue ended a slow spell
+func (q *sendQueue) pop() (message dto.WebSocketMessage, ok bool, recovered bool) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if len(q.items) == 0 {
+		return dto.WebSocketMessage{}, false, false
+	}
+
+	message = q.items[0].message
+	q.items[0] = queuedMessage{}
+	q.items = q.items[1:]
+
+	if len(q.items) == 0 && q.slow {
+		q.slow = false
+		recovered = true
+	}
+	return message, true, recovered
+}
+
+// close stops accepting messages; already queued messages can still be popped
+func (q *sendQueue) close() {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if !q.closed {
+		q.closed = true
+		q.signal()
+	}
+}
+
+// depth returns the number of queued messages
+func (q *sendQueue) depth() int {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return len(q.items)
+}
+
+// totals returns this queue's coalesced and dropped counts and its deepest backlog
+func (q *sendQueue) totals() (coalesced, dropped int64, maxDepth int) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return q.coalesced, q.dropped, q.maxDepth
+}
+
+// releaseSlow clears the slow flag of a connection going away and reports whether it was set
+func (q *sendQueue) releaseSlow() bool {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	wasSlow := q.slow
+	q.slow = false
+	return wasSlow
+}
+
+func (q *sendQueue) isClosed() bool {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return q.closed
+}
+
+// remove deletes the first queued message matching match
+func (q *sendQueue) remove(match func(queuedMessage) bool) bool {
+	for i, item := range q.items {
+		if match(item) {
+			q.items = append(q.items[:i], q.items[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
+func (q *sendQueue) markSlow() bool {
+	if q.slow {
+		return false
+	}
+	q.slow = true
+	return true
+}
+
+func (q *sendQueue) signal() {
+	select {
+	case q.ready <- struct{}{}:
+	default:
+	}
+}
+
+// stateKey identifies game states that supersede each other: same message type, game and viewer
+func stateKey(message dto.WebSocketMessage) string {
+	switch payload := message.Payload.(type) {
+	case dto.GameUpdatedPayload:
+		return string(message.Type) + "/" + message.GameID + "/" + payload.Game.ViewingPlayerID
+	case dto.FullStatePayload:
+		return string(message.Type) + "/" + message.GameID + "/" + payload.PlayerID
+	}
+	return ""
+}
+
+// SendQueueStats summarizes outgoing queue activity across all connections
+type SendQueueStats struct {
+	Queued          int64 // Messages accepted, including coalesced ones
+	Coalesced       int64 // Queued game states replaced by a newer one
+	Dropped         int64 // Messages discarded because a queue was full
+	SlowEvents      int64 // Times a connection became slow
+	SlowConnections int64 // Connections currently slow
+}
+
+// QueueMetrics counts outgoing queue activity; safe for concurrent use
+type QueueMetrics struct {
+	queued          atomic.Int64
+	coalesced       atomic.Int64
+	dropped         atomic.Int64
+	slowEvents      atomic.Int64
+	slowConnections atomic.Int64
+}
+
+// NewQueueMetrics creates empty queue metrics
+func NewQueueMetrics() *QueueMetrics {
+	return &QueueMetrics{}
+}
+
+func (m *QueueMetrics) record(result pushResult) {
+	if m == nil {
+		return
+	}
+	switch result {
+	case pushQueued:
+		m.queued.Add(1)
+	case pushCoalesced:
+		m.queued.Add(1)
+		m.coalesced.Add(1)
+	case pushDropped:
+		m.dropped.Add(1)
+	}
+}
+
+func (m *QueueMetrics) slowChanged(slow bool) {
+	if m == nil {
+		return
+	}
+	if slow {
+		m.slowEvents.Add(1)
+		m.slowConnections.Add(1)
+	} else {
+		m.slowConnections.Add(-1)
+	}
+}
+
+// Snapshot returns the current totals
+func (m *QueueMetrics) Snapshot() SendQueueStats {
+	return SendQueueStats{
+		Queued:          m.queued.Load(),
+		Coalesced:       m.coalesced.Load(),
+		Dropped:         m.dropped.Load(),
+		SlowEvents:      m.slowEvents.Load(),
+		SlowConnections: m.slowConnections.Load(),
+	}
+}
@@ -0,0 +1,270 @@
+package core
+
+import (
+	"compress/flate"
+	"encoding/json"
+	"io"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"terraforming-mars-backend/internal/delivery/dto"
+)
+
+// Every compressed connection's Nth outgoing frame is deflated again to estimate the compression ratio.
+// gorilla/websocket does not report compressed sizes, so measuring every frame would double the cost of compression.
+const compressionSampleRate = 16
+
+// Matches gorilla/websocket's default write compression level
+const compressionLevel = 1
+
+// DefaultSizeBudget is the JSON size above which a single outgoing message logs a warning
+const DefaultSizeBudget = 256 << 10
+
+// A game over budget warns at most once per interval; a broadcast sends one oversized frame per player
+const sizeBudgetWarningInterval = time.Minute
+
+// WireStats summarizes outgoing payload sizes for one encoding and compression combination
+type WireStats struct {
+	Encoding     Encoding
+	Compressed   bool
+	Messages     int64
+	JSONBytes    int64 // What the messages would have cost as JSON text frames
+	EncodedBytes int64 // Size after encoding, before permessage-deflate
+	WireBytes    int64 // Estimated size on the wire; equal to EncodedBytes without compression
+}
+
+// MessageSizeStats summarizes outgoing payload sizes for one message type
+type MessageSizeStats struct {
+	Type         dto.MessageType
+	Messages     int64
+	JSONBytes    int64
+	MaxJSONBytes int64 // Largest single message
+}
+
+// WireMetrics counts outgoing payload sizes per wire format so encodings and compression can be compared,
+// and per message type so payload growth shows up before clients start timing out.
+// Safe for concurrent use by every connection's write pump.
+type WireMetrics struct {
+	buckets map[wireFormat]*wireCounters // Fixed at construction; only the counters change
+	types   sync.Map                     // dto.MessageType -> *messageSizeCounters
+
+	sizeBudget atomic.Int64
+	warnMu     sync.Mutex
+	warnedAt   map[string]time.Time // Game ID -> last budget warning
+}
+
+type wireFormat struct {
+	encoding   Encoding
+	compressed bool
+}
+
+type wireCounters struct {
+	messages     atomic.Int64
+	jsonBytes    atomic.Int64
+	encodedBytes atomic.Int64
+
+	// Sampled frames only, for the compression ratio
+	sampledEncodedBytes    atomic.Int64
+	sampledCompressedBytes atomic.Int64
+}
+
+type messageSizeCounters struct {
+	messages     atomic.Int64
+	jsonBytes    atomic.Int64
+	maxJSONBytes atomic.Int64
+}
+
+// NewWireMetrics creates empty wire metrics
+func NewWireMetrics() *WireMetrics {
+	m := &WireMetrics{
+		buckets:  make(map[wireFormat]*wireCounters),
+		warnedAt: make(map[string]time.Time),
+	}
+	for _, encoding := range []Encoding{EncodingJSON, EncodingMsgpack} {
+		for _, compressed := range []bool{false, true} {
+			m.buckets[wireFormat{encoding, compressed}] = &wireCounters{}
+		}
+	}
+	m.sizeBudget.Store(DefaultSizeBudget)
+	return m
+}
+
+// SetSizeBudget changes the JSON size above which a single message logs a warning; 0 turns the warning off
+func (m *WireMetrics) SetSizeBudget(bytes int64) {
+	m.sizeBudget.Store(bytes)
+}
+
+// SizeBudget returns the JSON size above which a single message logs a warning
+func (m *WireMetrics) SizeBudget() int64 {
+	return m.sizeBudget.Load()
+}
+
+// record counts one outgoing frame
+func (m *WireMetrics) record(format wireFormat, messageType dto.MessageType, jsonBytes int, encoded []byte) {
+	if m == nil {
+		return
+	}
+	m.recordType(messageType, int64(jsonBytes))
+
+	counters, ok := m.buckets[format]
+	if !ok {
+		return
+	}
+
+	n := counters.messages.Add(1)
+	counters.jsonBytes.Add(int64(jsonBytes))
+	counters.encodedBytes.Add(int64(len(encoded)))
+
+	if format.compressed && (n-1)%compressionSampleRate == 0 {
+		counters.sampledEncodedBytes.Add(int64(len(encoded)))
+		counters.sampledCompressedBytes.Add(deflatedSize(encoded))
+	}
+}
+
+func (m *WireMetrics) recordType(messageType dto.MessageType, jsonBytes int64) {
+	entry, ok := m.types.Load(messageType)
+	if !ok {
+		entry, _ = m.types.LoadOrStore(messageType, &messageSizeCounters{})
+	}
+	counters := entry.(*messageSizeCounters)
+	counters.messages.Add(1)
+	counters.jsonBytes.Add(jsonBytes)
+	for {
+		largest := counters.maxJSONBytes.Load()
+		if jsonBytes <= largest || counters.maxJSONBytes.CompareAndSwap(largest, jsonBytes) {
+			return
+		}
+	}
+}
+
+// overBudget reports whether a message of this size should log a budget warning for the game
+// Only the first oversized message of a game in each warning interval does; the rest are counted silently.
+func (m *WireMetrics) overBudget(gameID string, jsonBytes int, now time.Time) bool {
+	if m == nil {
+		return false
+	}
+	budget := m.sizeBudget.Load()
+	if budget <= 0 || int64(jsonBytes) <= budget {
+		return false
+	}
+
+	m.warnMu.Lock()
+	defer m.warnMu.Unlock()
+	if last, ok := m.warnedAt[gameID]; ok && now.Sub(last) < sizeBudgetWarningInterval {
+		return false
+	}
+	for id, last := range m.warnedAt {
+		if now.Sub(last) >= sizeBudgetWarningInterval {
+			delete(m.warnedAt, id)
+		}
+	}
+	m.warnedAt[gameID] = now
+	return true
+}
+
+// TypeSnapshot returns the totals of every message type sent so far, largest total first
+func (m *WireMetrics) TypeSnapshot() []MessageSizeStats {
+	var stats []MessageSizeStats
+	m.types.Range(func(key, value any) bool {
+		counters := value.(*messageSizeCounters)
+		stats = append(stats, MessageSizeStats{
+			Type:         key.(dto.MessageType),
+			Messages:     counters.messages.Load(),
+			JSONBytes:    counters.jsonBytes.Load(),
+			MaxJSONBytes: counters.maxJSONBytes.Load(),
+		})
+		return true
+	})
+	sort.Slice(stats, func(i, j int) bool {
+		if stats[i].JSONBytes != stats[j].JSONBytes {
+			return stats[i].JSONBytes > stats[j].JSONBytes
+		}
+		return stats[i].Type < stats[j].Type
+	})
+	return stats
+}
+
+// Snapshot returns the totals of every wire format that has sent at least one frame
+func (m *WireMetrics) Snapshot() []WireStats {
+	stats := make([]WireStats, 0, len(m.buckets))
+	for _, encoding := range []Encoding{EncodingJSON, EncodingMsgpack} {
+		for _, compressed := range []bool{false, true} {
+			counters := m.buckets[wireFormat{encoding, compressed}]
+			messages := counters.messages.Load()
+			if messages == 0 {
+				continue
+			}
+
+			entry := WireStats{
+				Encoding:     encoding,
+				Compressed:   compressed,
+				Messages:     messages,
+				JSONBytes:    counters.jsonBytes.Load(),
+				EncodedBytes: counters.encodedBytes.Load(),
+			}
+			entry.WireBytes = entry.EncodedBytes
+			if sampled := counters.sampledEncodedBytes.Load(); compressed && sampled > 0 {
+				ratio := float64(counters.sampledCompressedBytes.Load()) / float64(sampled)
+				entry.WireBytes = int64(float64(entry.EncodedBytes) * ratio)
+			}
+			stats = append(stats, entry)
+		}
+	}
+	return stats
+}
+
+// PayloadSizes is what one message costs in each wire format
+type PayloadSizes struct {
+	JSON            int64
+	Msgpack         int64
+	JSONDeflated    int64
+	MsgpackDeflated int64
+}
+
+// MeasurePayload encodes a message in every wire format, to compare them on a known payload
+func MeasurePayload(message dto.WebSocketMessage) (PayloadSizes, error) {
+	data, err := json.Marshal(message)
+	if err != nil {
+		return PayloadSizes{}, err
+	}
+	packed, err := jsonToMsgpack(data)
+	if err != nil {
+		return PayloadSizes{}, err
+	}
+	return PayloadSizes{
+		JSON:            int64(len(data)),
+		Msgpack:         int64(len(packed)),
+		JSONDeflated:    deflatedSize(data),
+		MsgpackDeflated: deflatedSize(packed),
+	}, nil
+}
+
+var flateWriters = sync.Pool{
+	New: func() any {
+		w, _ := flate.NewWriter(io.Discard, compressionLevel)
+		return w
+	},
+}
+
+// deflatedSize returns the size of data compressed the way permessage-deflate compresses a frame
+func deflatedSize(data []byte) int64 {
+	counter := &countingWriter{}
+	w := flateWriters.Get().(*flate.Writer)
+	w.Reset(counter)
+	_, _ = w.Write(data)
+	_ = w.Flush()
+	flateWriters.Put(w)
+	// permessage-deflate strips the 4-byte sync flush trailer
+	return counter.n - 4
+}
+
+type countingWriter struct {
+	n int64
+}
+
+func (w *countingWriter) Write(p []byte) (int, error) {
+	w.n += int64(len(p))
+	return len(p), nil
+}
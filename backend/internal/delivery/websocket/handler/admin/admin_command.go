@@ -30,6 +30,8 @@ type AdminCommandHandler struct {
 	setCorporationAction      *admin.SetCorporationAction
 	startTileSelectionAction  *admin.StartTileSelectionAction
 	setTRAction               *admin.SetTRAction
+	setupTestStateAction      *admin.SetupTestStateAction
+	setAwardFreezeAction      *admin.SetAwardFreezeAction
 	broadcaster               Broadcaster
 	logger                    *zap.Logger
 }
@@ -45,6 +47,8 @@ func NewAdminCommandHandler(
 	setCorporationAction *admin.SetCorporationAction,
 	startTileSelectionAction *admin.StartTileSelectionAction,
 	setTRAction *admin.SetTRAction,
+	setupTestStateAction *admin.SetupTestStateAction,
+	setAwardFreezeAction *admin.SetAwardFreezeAction,
 	broadcaster Broadcaster,
 ) *AdminCommandHandler {
 	return &AdminCommandHandler{
@@ -57,6 +61,8 @@ func NewAdminCommandHandler(
 		setCorporationAction:      setCorporationAction,
 		startTileSelectionAction:  startTileSelectionAction,
 		setTRAction:               setTRAction,
+		setupTestStateAction:      setupTestStateAction,
+		setAwardFreezeAction:      setAwardFreezeAction,
 		broadcaster:               broadcaster,
 		logger:                    logger.Get(),
 	}
@@ -123,6 +129,10 @@ func (h *AdminCommandHandler) HandleMessage(ctx context.Context, connection *cor
 		err = h.handleStartTileSelection(ctx, gameID, commandPayload)
 	case dto.AdminCommandTypeSetTR:
 		err = h.handleSetTR(ctx, gameID, commandPayload)
+	case dto.AdminCommandTypeSetupTestState:
+		err = h.handleSetupTestState(ctx, gameID, commandPayload)
+	case dto.AdminCommandTypeSetAwardFreeze:
+		err = h.handleSetAwardFreeze(ctx, gameID, commandPayload)
 	default:
 		log.Error("Unknown admin command type", zap.String("command_type", commandType))
 		h.sendError(connection, "Unknown admin command type: "+commandType)
@@ -154,7 +164,9 @@ func (h *AdminCommandHandler) handleGiveCard(ctx context.Context, gameID string,
 		return &adminError{message: "Missing playerId or cardId"}
 	}
 
-	return h.giveCardAction.Execute(ctx, gameID, playerID, cardID)
+	allowAnyPack, _ := payloadMap["allowAnyPack"].(bool)
+
+	return h.giveCardAction.Execute(ctx, gameID, playerID, cardID, allowAnyPack)
 }
 
 func (h *AdminCommandHandler) handleSetPhase(ctx context.Context, gameID string, payload interface{}) error {
@@ -309,16 +321,89 @@ func (h *AdminCommandHandler) handleSetTR(ctx context.Context, gameID string, pa
 	return h.setTRAction.Execute(ctx, gameID, playerID, terraformRating)
 }
 
+func (h *AdminCommandHandler) handleSetupTestState(ctx context.Context, gameID string, payload interface{}) error {
+	payloadBytes, err := json.Marshal(payload)
+	if err != nil {
+		return &adminError{message: "Invalid setup-test-state payload"}
+	}
+
+	var command dto.SetupTestStateAdminCommand
+	if err := json.Unmarshal(payloadBytes, &command); err != nil {
+		return &adminError{message: "Invalid setup-test-state payload"}
+	}
+
+	fixture := admin.TestStateFixture{
+		Generation:          command.Generation,
+		Phase:               game.GamePhase(command.Phase),
+		CurrentTurnPlayerID: command.CurrentTurnPlayerID,
+	}
+	if params := command.GlobalParameters; params != nil {
+		fixture.GlobalParameters = &admin.SetGlobalParametersRequest{
+			Temperature: params.Temperature,
+			Oxygen:      params.Oxygen,
+			Oceans:      params.Oceans,
+		}
+	}
+	for _, tile := range command.Tiles {
+		fixture.Tiles = append(fixture.Tiles, admin.FixtureTile{
+			Position: shared.HexPosition{Q: tile.HexPosition.Q, R: tile.HexPosition.R, S: tile.HexPosition.S},
+			TileType: tile.TileType,
+			OwnerID:  tile.OwnerID,
+		})
+	}
+	for _, fp := range command.Players {
+		if fp.PlayerID == "" {
+			return &adminError{message: "Missing playerId"}
+		}
+		player := admin.FixturePlayer{
+			PlayerID:        fp.PlayerID,
+			CorporationID:   fp.CorporationID,
+			TerraformRating: fp.TerraformRating,
+			Hand:            fp.Hand,
+			PlayedCards:     fp.PlayedCards,
+		}
+		if r := fp.Resources; r != nil {
+			player.Resources = &shared.Resources{
+				Credits: r.Credits, Steel: r.Steel, Titanium: r.Titanium,
+				Plants: r.Plants, Energy: r.Energy, Heat: r.Heat,
+			}
+		}
+		if p := fp.Production; p != nil {
+			player.Production = &shared.Production{
+				Credits: p.Credits, Steel: p.Steel, Titanium: p.Titanium,
+				Plants: p.Plants, Energy: p.Energy, Heat: p.Heat,
+			}
+		}
+		fixture.Players = append(fixture.Players, player)
+	}
+
+	return h.setupTestStateAction.Execute(ctx, gameID, fixture)
+}
+
+func (h *AdminCommandHandler) handleSetAwardFreeze(ctx context.Context, gameID string, payload interface{}) error {
+	payloadMap, ok := payload.(map[string]interface{})
+	if !ok {
+		return &adminError{message: "Invalid set-award-freeze payload"}
+	}
+
+	enabled, ok := payloadMap["enabled"].(bool)
+	if !ok {
+		return &adminError{message: "Missing or invalid enabled"}
+	}
+
+	return h.setAwardFreezeAction.Execute(ctx, gameID, enabled)
+}
+
 // sendError sends an error message to the client
 func (h *AdminCommandHandler) sendError(connection *core.Connection, errorMessage string) {
 	_, gameID := connection.GetPlayer()
-	connection.Send <- dto.WebSocketMessage{
+	connection.SendMessage(dto.WebSocketMessage{
 		Type:   dto.MessageTypeError,
 		GameID: gameID,
 		Payload: dto.ErrorPayload{
 			Message: errorMessage,
 		},
-	}
+	})
 }
 
 // adminError is a simple error type for admin command errors
@@ -3,6 +3,7 @@ package award
 import (
 	"context"
 	"encoding/json"
+	"errors"
 
 	awardaction "terraforming-mars-backend/internal/action/award"
 	"terraforming-mars-backend/internal/delivery/dto"
@@ -87,24 +88,30 @@ func (h *FundAwardHandler) HandleMessage(ctx context.Context, connection *core.C
 	log.Debug("📡 Broadcasted game state to all players")
 
 	response := dto.WebSocketMessage{
-		Type:   "action-success",
+		Type:   dto.MessageTypeActionSuccess,
 		GameID: connection.GameID,
-		Payload: map[string]interface{}{
-			"action":    "fund-award",
-			"awardType": payload.AwardType,
-			"success":   true,
+		Payload: dto.ActionSuccessPayload{
+			Action:    "fund-award",
+			AwardType: payload.AwardType,
 		},
 	}
 
-	connection.Send <- response
+	connection.SendMessage(response)
 }
 
 // sendError sends an error message to the client
-func (h *FundAwardHandler) sendError(connection *core.Connection, errorMessage string) {
-	connection.Send <- dto.WebSocketMessage{
-		Type: dto.MessageTypeError,
-		Payload: map[string]interface{}{
-			"error": errorMessage,
-		},
+// RunStep implements the StepHandler interface
+func (h *FundAwardHandler) RunStep(ctx context.Context, gameID string, playerID string, payload map[string]interface{}) error {
+	awardType, _ := payload["awardType"].(string)
+	if awardType == "" {
+		return errors.New("award type is required")
 	}
+	return h.action.Execute(ctx, gameID, playerID, awardType)
+}
+
+func (h *FundAwardHandler) sendError(connection *core.Connection, errorMessage string) {
+	connection.SendMessage(dto.WebSocketMessage{
+		Type:    dto.MessageTypeError,
+		Payload: dto.ErrorPayload{Message: errorMessage},
+	})
 }
@@ -0,0 +1,64 @@
+package card
+
+import (
+	"context"
+
+	cardaction "terraforming-mars-backend/internal/action/card"
+
+	"terraforming-mars-backend/internal/delivery/dto"
+	"terraforming-mars-backend/internal/delivery/websocket/core"
+	"terraforming-mars-backend/internal/logger"
+
+	"go.uber.org/zap"
+)
+
+// CancelPlayCardHandler handles cancel play card requests
+type CancelPlayCardHandler struct {
+	action      *cardaction.CancelPlayCardAction
+	broadcaster Broadcaster
+	logger      *zap.Logger
+}
+
+// NewCancelPlayCardHandler creates a new cancel play card handler
+func NewCancelPlayCardHandler(action *cardaction.CancelPlayCardAction, broadcaster Broadcaster) *CancelPlayCardHandler {
+	return &CancelPlayCardHandler{
+		action:      action,
+		broadcaster: broadcaster,
+		logger:      logger.Get(),
+	}
+}
+
+// HandleMessage implements the MessageHandler interface
+func (h *CancelPlayCardHandler) HandleMessage(ctx context.Context, connection *core.Connection, message dto.WebSocketMessage) {
+	log := h.logger.With(
+		zap.String("connection_id", connection.ID),
+		zap.String("message_type", string(message.Type)),
+	)
+
+	log.Info("🃏 Processing cancel play card request")
+
+	if connection.GameID == "" || connection.PlayerID == "" {
+		log.Error("Missing connection context")
+		h.sendError(connection, "Not connected to a game")
+		return
+	}
+
+	cardID, err := h.action.Execute(ctx, connection.GameID, connection.PlayerID)
+	if err != nil {
+		log.Error("Failed to execute cancel play card action", zap.Error(err))
+		h.sendError(connection, err.Error())
+		return
+	}
+
+	log.Info("✅ Cancel play card action completed successfully", zap.String("card_id", cardID))
+
+	h.broadcaster.BroadcastGameState(connection.GameID, nil)
+	log.Debug("📡 Broadcasted game state to all players")
+}
+
+func (h *CancelPlayCardHandler) sendError(connection *core.Connection, errorMessage string) {
+	connection.SendMessage(dto.WebSocketMessage{
+		Type:    dto.MessageTypeError,
+		Payload: dto.ErrorPayload{Message: errorMessage},
+	})
+}
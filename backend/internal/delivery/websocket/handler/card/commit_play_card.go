@@ -0,0 +1,80 @@
+package card
+
+import (
+	"context"
+
+	cardaction "terraforming-mars-backend/internal/action/card"
+
+	"terraforming-mars-backend/internal/delivery/dto"
+	"terraforming-mars-backend/internal/delivery/websocket/core"
+	"terraforming-mars-backend/internal/logger"
+
+	"go.uber.org/zap"
+)
+
+// CommitPlayCardHandler handles commit play card requests
+type CommitPlayCardHandler struct {
+	action      *cardaction.CommitPlayCardAction
+	broadcaster Broadcaster
+	logger      *zap.Logger
+}
+
+// NewCommitPlayCardHandler creates a new commit play card handler
+func NewCommitPlayCardHandler(action *cardaction.CommitPlayCardAction, broadcaster Broadcaster) *CommitPlayCardHandler {
+	return &CommitPlayCardHandler{
+		action:      action,
+		broadcaster: broadcaster,
+		logger:      logger.Get(),
+	}
+}
+
+// HandleMessage implements the MessageHandler interface
+func (h *CommitPlayCardHandler) HandleMessage(ctx context.Context, connection *core.Connection, message dto.WebSocketMessage) {
+	log := h.logger.With(
+		zap.String("connection_id", connection.ID),
+		zap.String("message_type", string(message.Type)),
+	)
+
+	log.Info("🃏 Processing commit play card request")
+
+	if connection.GameID == "" || connection.PlayerID == "" {
+		log.Error("Missing connection context")
+		h.sendError(connection, "Not connected to a game")
+		return
+	}
+
+	payload, ok := message.Payload.(map[string]interface{})
+	if !ok {
+		payload = map[string]interface{}{}
+	}
+
+	payment, choiceIndex, cardStorageTarget, targetPlayerID, opts := parsePlayCardOptions(payload)
+
+	cardID, err := h.action.ExecuteWithOptions(ctx, connection.GameID, connection.PlayerID, payment, choiceIndex, cardStorageTarget, targetPlayerID, opts)
+	if err != nil {
+		log.Error("Failed to execute commit play card action", zap.Error(err))
+		h.sendError(connection, err.Error())
+		return
+	}
+
+	log.Info("✅ Commit play card action completed successfully")
+
+	h.broadcaster.BroadcastGameState(connection.GameID, nil)
+	log.Debug("📡 Broadcasted game state to all players")
+
+	connection.SendMessage(dto.WebSocketMessage{
+		Type:   dto.MessageTypeActionSuccess,
+		GameID: connection.GameID,
+		Payload: dto.ActionSuccessPayload{
+			Action: "commit-play-card",
+			CardID: cardID,
+		},
+	})
+}
+
+func (h *CommitPlayCardHandler) sendError(connection *core.Connection, errorMessage string) {
+	connection.SendMessage(dto.WebSocketMessage{
+		Type:    dto.MessageTypeError,
+		Payload: dto.ErrorPayload{Message: errorMessage},
+	})
+}
@@ -2,6 +2,7 @@ package card
 
 import (
 	"context"
+	"errors"
 
 	cardaction "terraforming-mars-backend/internal/action/card"
 
@@ -63,6 +64,72 @@ func (h *PlayCardHandler) HandleMessage(ctx context.Context, connection *core.Co
 		return
 	}
 
+	payment, choiceIndex, cardStorageTarget, targetPlayerID, opts := parsePlayCardOptions(payload)
+
+	log.Debug("Payment extracted",
+		zap.Int("credits", payment.Credits),
+		zap.Int("steel", payment.Steel),
+		zap.Int("titanium", payment.Titanium),
+		zap.Any("substitutes", payment.Substitutes))
+	if choiceIndex != nil {
+		log.Debug("Choice index extracted", zap.Int("choice_index", *choiceIndex))
+	}
+	if cardStorageTarget != nil {
+		log.Debug("Card storage target extracted", zap.String("card_storage_target", *cardStorageTarget))
+	}
+	if targetPlayerID != nil {
+		log.Debug("Target player extracted", zap.String("target_player_id", *targetPlayerID))
+	}
+	if opts.AttackAmount != nil {
+		log.Debug("Attack amount extracted", zap.Int("attack_amount", *opts.AttackAmount))
+	}
+	if len(opts.DiscardCardIDs) > 0 {
+		log.Debug("Discard selection extracted", zap.Strings("discard_card_ids", opts.DiscardCardIDs))
+	}
+
+	err := h.action.ExecuteWithOptions(ctx, connection.GameID, connection.PlayerID, cardID, payment, choiceIndex, cardStorageTarget, targetPlayerID, opts)
+	if err != nil {
+		log.Error("Failed to execute play card action", zap.Error(err))
+		h.sendError(connection, err.Error())
+		return
+	}
+
+	log.Info("✅ Play card action completed successfully")
+
+	h.broadcaster.BroadcastGameState(connection.GameID, nil)
+	log.Debug("📡 Broadcasted game state to all players")
+
+	response := dto.WebSocketMessage{
+		Type:   dto.MessageTypeActionSuccess,
+		GameID: connection.GameID,
+		Payload: dto.ActionSuccessPayload{
+			Action: "play-card",
+			CardID: cardID,
+		},
+	}
+
+	connection.SendMessage(response)
+}
+
+// RunStep implements the StepHandler interface
+func (h *PlayCardHandler) RunStep(ctx context.Context, gameID string, playerID string, payload map[string]interface{}) error {
+	cardID, ok := payload["cardId"].(string)
+	if !ok || cardID == "" {
+		return errors.New("missing cardId")
+	}
+	payment, choiceIndex, cardStorageTarget, targetPlayerID, opts := parsePlayCardOptions(payload)
+	return h.action.ExecuteWithOptions(ctx, gameID, playerID, cardID, payment, choiceIndex, cardStorageTarget, targetPlayerID, opts)
+}
+
+func (h *PlayCardHandler) sendError(connection *core.Connection, errorMessage string) {
+	connection.SendMessage(dto.WebSocketMessage{
+		Type:    dto.MessageTypeError,
+		Payload: dto.ErrorPayload{Message: errorMessage},
+	})
+}
+
+// parsePlayCardOptions extracts payment and play options shared by play-card and commit-play-card
+func parsePlayCardOptions(payload map[string]interface{}) (cardaction.PaymentRequest, *int, *string, *string, cardaction.PlayOptions) {
 	payment := cardaction.PaymentRequest{
 		Credits:     0,
 		Steel:       0,
@@ -106,51 +173,22 @@ func (h *PlayCardHandler) HandleMessage(ctx context.Context, connection *core.Co
 		targetPlayerID = &tpID
 	}
 
-	log.Debug("Payment extracted",
-		zap.Int("credits", payment.Credits),
-		zap.Int("steel", payment.Steel),
-		zap.Int("titanium", payment.Titanium),
-		zap.Any("substitutes", payment.Substitutes))
-	if choiceIndex != nil {
-		log.Debug("Choice index extracted", zap.Int("choice_index", *choiceIndex))
-	}
-	if cardStorageTarget != nil {
-		log.Debug("Card storage target extracted", zap.String("card_storage_target", *cardStorageTarget))
-	}
-	if targetPlayerID != nil {
-		log.Debug("Target player extracted", zap.String("target_player_id", *targetPlayerID))
-	}
-
-	err := h.action.Execute(ctx, connection.GameID, connection.PlayerID, cardID, payment, choiceIndex, cardStorageTarget, targetPlayerID)
-	if err != nil {
-		log.Error("Failed to execute play card action", zap.Error(err))
-		h.sendError(connection, err.Error())
-		return
-	}
-
-	log.Info("✅ Play card action completed successfully")
-
-	h.broadcaster.BroadcastGameState(connection.GameID, nil)
-	log.Debug("📡 Broadcasted game state to all players")
-
-	response := dto.WebSocketMessage{
-		Type:   "action-success",
-		GameID: connection.GameID,
-		Payload: map[string]interface{}{
-			"action":  "play-card",
-			"success": true,
-			"cardId":  cardID,
-		},
-	}
-
-	connection.Send <- response
+	return payment, choiceIndex, cardStorageTarget, targetPlayerID, parsePlayOptions(payload)
 }
 
-func (h *PlayCardHandler) sendError(connection *core.Connection, errorMessage string) {
-	connection.Send <- dto.WebSocketMessage{
-		Type: dto.MessageTypeError,
-		Payload: map[string]interface{}{
-			"error": errorMessage,
-		},
+// parsePlayOptions extracts the optional choices shared by play-card, commit-play-card and card-action
+func parsePlayOptions(payload map[string]interface{}) cardaction.PlayOptions {
+	var opts cardaction.PlayOptions
+	if amountFloat, ok := payload["attackAmount"].(float64); ok {
+		amount := int(amountFloat)
+		opts.AttackAmount = &amount
+	}
+	if items, ok := payload["discardCardIds"].([]interface{}); ok {
+		for _, item := range items {
+			if cardID, ok := item.(string); ok && cardID != "" {
+				opts.DiscardCardIDs = append(opts.DiscardCardIDs, cardID)
+			}
+		}
 	}
+	return opts
 }
@@ -0,0 +1,109 @@
+package card
+
+import (
+	"context"
+
+	cardaction "terraforming-mars-backend/internal/action/card"
+
+	"terraforming-mars-backend/internal/delivery/dto"
+	"terraforming-mars-backend/internal/delivery/websocket/core"
+	"terraforming-mars-backend/internal/logger"
+
+	"go.uber.org/zap"
+)
+
+// PreparePlayCardHandler handles prepare play card requests
+type PreparePlayCardHandler struct {
+	action      *cardaction.PreparePlayCardAction
+	broadcaster Broadcaster
+	logger      *zap.Logger
+}
+
+// NewPreparePlayCardHandler creates a new prepare play card handler
+func NewPreparePlayCardHandler(action *cardaction.PreparePlayCardAction, broadcaster Broadcaster) *PreparePlayCardHandler {
+	return &PreparePlayCardHandler{
+		action:      action,
+		broadcaster: broadcaster,
+		logger:      logger.Get(),
+	}
+}
+
+// HandleMessage implements the MessageHandler interface
+func (h *PreparePlayCardHandler) HandleMessage(ctx context.Context, connection *core.Connection, message dto.WebSocketMessage) {
+	log := h.logger.With(
+		zap.String("connection_id", connection.ID),
+		zap.String("message_type", string(message.Type)),
+	)
+
+	log.Info("🃏 Processing prepare play card request")
+
+	if connection.GameID == "" || connection.PlayerID == "" {
+		log.Error("Missing connection context")
+		h.sendError(connection, "Not connected to a game")
+		return
+	}
+
+	payload, ok := message.Payload.(map[string]interface{})
+	if !ok {
+		log.Error("Invalid payload format")
+		h.sendError(connection, "Invalid payload format")
+		return
+	}
+
+	cardID, ok := payload["cardId"].(string)
+	if !ok || cardID == "" {
+		log.Error("Missing or invalid cardId")
+		h.sendError(connection, "Missing cardId")
+		return
+	}
+
+	preview, err := h.action.Execute(ctx, connection.GameID, connection.PlayerID, cardID)
+	if err != nil {
+		log.Error("Failed to execute prepare play card action", zap.Error(err))
+		h.sendError(connection, err.Error())
+		return
+	}
+
+	log.Info("✅ Prepare play card action completed successfully")
+
+	h.broadcaster.BroadcastGameState(connection.GameID, nil)
+	log.Debug("📡 Broadcasted game state to all players")
+
+	substitutes := make([]dto.PaymentSubstituteDto, len(preview.Substitutes))
+	for i, sub := range preview.Substitutes {
+		substitutes[i] = dto.PaymentSubstituteDto{
+			ResourceType:   dto.ResourceType(sub.ResourceType),
+			ConversionRate: sub.ConversionRate,
+		}
+	}
+	placements := preview.PendingPlacements
+	if placements == nil {
+		placements = []string{}
+	}
+
+	connection.SendMessage(dto.WebSocketMessage{
+		Type:   dto.MessageTypeCardPlayPrepared,
+		GameID: connection.GameID,
+		Payload: dto.CardPlayPreparedPayload{
+			CardID:            preview.CardID,
+			CardName:          preview.CardName,
+			BaseCost:          preview.BaseCost,
+			Discount:          preview.Discount,
+			EffectiveCost:     preview.EffectiveCost,
+			AllowSteel:        preview.AllowSteel,
+			AllowTitanium:     preview.AllowTitanium,
+			SteelRate:         preview.SteelRate,
+			TitaniumRate:      preview.TitaniumRate,
+			Substitutes:       substitutes,
+			ChoiceCount:       preview.ChoiceCount,
+			PendingPlacements: placements,
+		},
+	})
+}
+
+func (h *PreparePlayCardHandler) sendError(connection *core.Connection, errorMessage string) {
+	connection.SendMessage(dto.WebSocketMessage{
+		Type:    dto.MessageTypeError,
+		Payload: dto.ErrorPayload{Message: errorMessage},
+	})
+}
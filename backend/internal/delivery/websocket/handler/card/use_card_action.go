@@ -2,6 +2,7 @@ package card
 
 import (
 	"context"
+	"errors"
 
 	cardaction "terraforming-mars-backend/internal/action/card"
 	"terraforming-mars-backend/internal/delivery/dto"
@@ -64,26 +65,7 @@ func (h *UseCardActionHandler) HandleMessage(ctx context.Context, connection *co
 	}
 	behaviorIndex := int(behaviorIndexFloat)
 
-	var choiceIndex *int
-	if choiceIndexFloat, ok := payload["choiceIndex"].(float64); ok {
-		idx := int(choiceIndexFloat)
-		choiceIndex = &idx
-	}
-
-	var cardStorageTarget *string
-	if target, ok := payload["cardStorageTarget"].(string); ok && target != "" {
-		cardStorageTarget = &target
-	}
-
-	var targetPlayerID *string
-	if tpID, ok := payload["targetPlayerId"].(string); ok && tpID != "" {
-		targetPlayerID = &tpID
-	}
-
-	var stealSourceCardID *string
-	if scfi, ok := payload["sourceCardForInput"].(string); ok && scfi != "" {
-		stealSourceCardID = &scfi
-	}
+	choiceIndex, cardStorageTarget, targetPlayerID, stealSourceCardID := parseCardActionTargets(payload)
 
 	log = log.With(
 		zap.String("card_id", cardID),
@@ -102,7 +84,7 @@ func (h *UseCardActionHandler) HandleMessage(ctx context.Context, connection *co
 		log = log.With(zap.String("source_card_for_input", *stealSourceCardID))
 	}
 
-	err := h.action.Execute(ctx, connection.GameID, connection.PlayerID, cardID, behaviorIndex, choiceIndex, cardStorageTarget, targetPlayerID, stealSourceCardID)
+	err := h.action.ExecuteWithOptions(ctx, connection.GameID, connection.PlayerID, cardID, behaviorIndex, choiceIndex, cardStorageTarget, targetPlayerID, stealSourceCardID, parsePlayOptions(payload))
 	if err != nil {
 		log.Error("Failed to execute use card action", zap.Error(err))
 		h.sendError(connection, err.Error())
@@ -115,24 +97,61 @@ func (h *UseCardActionHandler) HandleMessage(ctx context.Context, connection *co
 	log.Debug("📡 Broadcasted game state to all players")
 
 	response := dto.WebSocketMessage{
-		Type:   "action-success",
+		Type:   dto.MessageTypeActionSuccess,
 		GameID: connection.GameID,
-		Payload: map[string]interface{}{
-			"action":        "card-action",
-			"success":       true,
-			"cardId":        cardID,
-			"behaviorIndex": behaviorIndex,
+		Payload: dto.ActionSuccessPayload{
+			Action:        "card-action",
+			CardID:        cardID,
+			BehaviorIndex: &behaviorIndex,
 		},
 	}
 
-	connection.Send <- response
+	connection.SendMessage(response)
+}
+
+// RunStep implements the StepHandler interface
+func (h *UseCardActionHandler) RunStep(ctx context.Context, gameID string, playerID string, payload map[string]interface{}) error {
+	cardID, ok := payload["cardId"].(string)
+	if !ok || cardID == "" {
+		return errors.New("missing cardId")
+	}
+	behaviorIndexFloat, ok := payload["behaviorIndex"].(float64)
+	if !ok {
+		return errors.New("missing behaviorIndex")
+	}
+	choiceIndex, cardStorageTarget, targetPlayerID, stealSourceCardID := parseCardActionTargets(payload)
+	return h.action.ExecuteWithOptions(ctx, gameID, playerID, cardID, int(behaviorIndexFloat), choiceIndex, cardStorageTarget, targetPlayerID, stealSourceCardID, parsePlayOptions(payload))
 }
 
 func (h *UseCardActionHandler) sendError(connection *core.Connection, errorMessage string) {
-	connection.Send <- dto.WebSocketMessage{
-		Type: dto.MessageTypeError,
-		Payload: map[string]interface{}{
-			"error": errorMessage,
-		},
+	connection.SendMessage(dto.WebSocketMessage{
+		Type:    dto.MessageTypeError,
+		Payload: dto.ErrorPayload{Message: errorMessage},
+	})
+}
+
+// parseCardActionTargets extracts the optional choice and targets of a card action
+func parseCardActionTargets(payload map[string]interface{}) (*int, *string, *string, *string) {
+	var choiceIndex *int
+	if choiceIndexFloat, ok := payload["choiceIndex"].(float64); ok {
+		idx := int(choiceIndexFloat)
+		choiceIndex = &idx
 	}
+
+	var cardStorageTarget *string
+	if target, ok := payload["cardStorageTarget"].(string); ok && target != "" {
+		cardStorageTarget = &target
+	}
+
+	var targetPlayerID *string
+	if tpID, ok := payload["targetPlayerId"].(string); ok && tpID != "" {
+		targetPlayerID = &tpID
+	}
+
+	var stealSourceCardID *string
+	if scfi, ok := payload["sourceCardForInput"].(string); ok && scfi != "" {
+		stealSourceCardID = &scfi
+	}
+
+	return choiceIndex, cardStorageTarget, targetPlayerID, stealSourceCardID
 }
@@ -91,22 +91,19 @@ func (h *ConfirmCardDrawHandler) HandleMessage(ctx context.Context, connection *
 	log.Debug("📡 Broadcasted game state to all players")
 
 	response := dto.WebSocketMessage{
-		Type:   "action-success",
+		Type:   dto.MessageTypeActionSuccess,
 		GameID: connection.GameID,
-		Payload: map[string]interface{}{
-			"action":  "confirm-card-draw",
-			"success": true,
+		Payload: dto.ActionSuccessPayload{
+			Action: "confirm-card-draw",
 		},
 	}
 
-	connection.Send <- response
+	connection.SendMessage(response)
 }
 
 func (h *ConfirmCardDrawHandler) sendError(connection *core.Connection, errorMessage string) {
-	connection.Send <- dto.WebSocketMessage{
-		Type: dto.MessageTypeError,
-		Payload: map[string]interface{}{
-			"error": errorMessage,
-		},
-	}
+	connection.SendMessage(dto.WebSocketMessage{
+		Type:    dto.MessageTypeError,
+		Payload: dto.ErrorPayload{Message: errorMessage},
+	})
 }
@@ -75,22 +75,19 @@ func (h *ConfirmProductionCardsHandler) HandleMessage(ctx context.Context, conne
 	log.Debug("📡 Broadcasted game state to all players")
 
 	response := dto.WebSocketMessage{
-		Type:   "action-success",
+		Type:   dto.MessageTypeActionSuccess,
 		GameID: connection.GameID,
-		Payload: map[string]interface{}{
-			"action":  "confirm-production-cards",
-			"success": true,
+		Payload: dto.ActionSuccessPayload{
+			Action: "confirm-production-cards",
 		},
 	}
 
-	connection.Send <- response
+	connection.SendMessage(response)
 }
 
 func (h *ConfirmProductionCardsHandler) sendError(connection *core.Connection, errorMessage string) {
-	connection.Send <- dto.WebSocketMessage{
-		Type: dto.MessageTypeError,
-		Payload: map[string]interface{}{
-			"error": errorMessage,
-		},
-	}
+	connection.SendMessage(dto.WebSocketMessage{
+		Type:    dto.MessageTypeError,
+		Payload: dto.ErrorPayload{Message: errorMessage},
+	})
 }
@@ -75,22 +75,19 @@ func (h *ConfirmSellPatentsHandler) HandleMessage(ctx context.Context, connectio
 	log.Debug("📡 Broadcasted game state to all players")
 
 	response := dto.WebSocketMessage{
-		Type:   "action-success",
+		Type:   dto.MessageTypeActionSuccess,
 		GameID: connection.GameID,
-		Payload: map[string]interface{}{
-			"action":  "confirm-sell-patents",
-			"success": true,
+		Payload: dto.ActionSuccessPayload{
+			Action: "confirm-sell-patents",
 		},
 	}
 
-	connection.Send <- response
+	connection.SendMessage(response)
 }
 
 func (h *ConfirmSellPatentsHandler) sendError(connection *core.Connection, errorMessage string) {
-	connection.Send <- dto.WebSocketMessage{
-		Type: dto.MessageTypeError,
-		Payload: map[string]interface{}{
-			"error": errorMessage,
-		},
-	}
+	connection.SendMessage(dto.WebSocketMessage{
+		Type:    dto.MessageTypeError,
+		Payload: dto.ErrorPayload{Message: errorMessage},
+	})
 }
@@ -0,0 +1,85 @@
+package confirmation
+
+import (
+	"context"
+
+	confirmaction "terraforming-mars-backend/internal/action/confirmation"
+	"terraforming-mars-backend/internal/delivery/dto"
+	"terraforming-mars-backend/internal/delivery/websocket/core"
+	"terraforming-mars-backend/internal/logger"
+
+	"go.uber.org/zap"
+)
+
+// RespondToEffectHandler handles answers to another player's effect
+type RespondToEffectHandler struct {
+	action      *confirmaction.RespondToEffectAction
+	broadcaster Broadcaster
+	logger      *zap.Logger
+}
+
+// NewRespondToEffectHandler creates a new respond to effect handler
+func NewRespondToEffectHandler(action *confirmaction.RespondToEffectAction, broadcaster Broadcaster) *RespondToEffectHandler {
+	return &RespondToEffectHandler{
+		action:      action,
+		broadcaster: broadcaster,
+		logger:      logger.Get(),
+	}
+}
+
+// HandleMessage implements the MessageHandler interface
+func (h *RespondToEffectHandler) HandleMessage(ctx context.Context, connection *core.Connection, message dto.WebSocketMessage) {
+	log := h.logger.With(
+		zap.String("connection_id", connection.ID),
+		zap.String("message_type", string(message.Type)),
+	)
+
+	log.Info("🛡️ Processing respond to effect request")
+
+	playerID, gameID := connection.GetPlayer()
+	if gameID == "" || playerID == "" {
+		log.Error("Missing connection context")
+		h.sendError(connection, "Not connected to a game")
+		return
+	}
+
+	payloadMap, ok := message.Payload.(map[string]interface{})
+	if !ok {
+		log.Error("Invalid payload format")
+		h.sendError(connection, "Invalid payload format")
+		return
+	}
+
+	optionIndexFloat, ok := payloadMap["optionIndex"].(float64)
+	if !ok {
+		log.Error("Missing optionIndex")
+		h.sendError(connection, "optionIndex is required")
+		return
+	}
+
+	if err := h.action.Execute(ctx, gameID, playerID, int(optionIndexFloat)); err != nil {
+		log.Error("Failed to execute respond to effect action", zap.Error(err))
+		h.sendError(connection, err.Error())
+		return
+	}
+
+	log.Info("✅ Respond to effect action completed successfully")
+
+	h.broadcaster.BroadcastGameState(gameID, nil)
+	log.Debug("📡 Broadcasted game state to all players")
+
+	connection.SendMessage(dto.WebSocketMessage{
+		Type:   dto.MessageTypeActionSuccess,
+		GameID: gameID,
+		Payload: dto.ActionSuccessPayload{
+			Action: "respond-to-effect",
+		},
+	})
+}
+
+func (h *RespondToEffectHandler) sendError(connection *core.Connection, errorMessage string) {
+	connection.SendMessage(dto.WebSocketMessage{
+		Type:    dto.MessageTypeError,
+		Payload: dto.ErrorPayload{Message: errorMessage},
+	})
+}
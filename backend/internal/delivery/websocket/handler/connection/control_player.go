@@ -0,0 +1,74 @@
+package connection
+
+import (
+	"context"
+
+	"terraforming-mars-backend/internal/delivery/dto"
+	"terraforming-mars-backend/internal/delivery/websocket/core"
+	"terraforming-mars-backend/internal/logger"
+
+	"go.uber.org/zap"
+)
+
+// ControlPlayerHandler switches which seat a hotseat connection acts for
+// Seats are authorized when the connection joins as that player; this handler only selects among them
+type ControlPlayerHandler struct {
+	broadcaster Broadcaster
+	logger      *zap.Logger
+}
+
+// NewControlPlayerHandler creates a new control player handler
+func NewControlPlayerHandler(broadcaster Broadcaster) *ControlPlayerHandler {
+	return &ControlPlayerHandler{
+		broadcaster: broadcaster,
+		logger:      logger.Get(),
+	}
+}
+
+// HandleMessage implements the MessageHandler interface
+func (h *ControlPlayerHandler) HandleMessage(ctx context.Context, connection *core.Connection, message dto.WebSocketMessage) {
+	log := h.logger.With(
+		zap.String("connection_id", connection.ID),
+		zap.String("message_type", string(message.Type)),
+	)
+
+	log.Info("🪑 Processing control player request")
+
+	if connection.GameID == "" || connection.PlayerID == "" {
+		log.Error("Missing connection context")
+		h.sendError(connection, "not connected to game")
+		return
+	}
+
+	payloadMap, ok := message.Payload.(map[string]any)
+	if !ok {
+		log.Error("Invalid payload format")
+		h.sendError(connection, "invalid payload format")
+		return
+	}
+
+	playerID, _ := payloadMap["playerId"].(string)
+	if playerID == "" {
+		log.Error("Missing playerId in payload")
+		h.sendError(connection, "playerId is required")
+		return
+	}
+
+	if err := connection.ControlPlayer(playerID); err != nil {
+		log.Warn("Connection not authorized for seat", zap.String("player_id", playerID))
+		h.sendError(connection, err.Error())
+		return
+	}
+
+	log.Info("✅ Connection now controlling player", zap.String("player_id", playerID))
+
+	h.broadcaster.BroadcastGameState(connection.GameID, []string{playerID})
+	log.Debug("📡 Sent game state for controlled player")
+}
+
+func (h *ControlPlayerHandler) sendError(connection *core.Connection, errorMessage string) {
+	connection.SendMessage(dto.WebSocketMessage{
+		Type:    dto.MessageTypeError,
+		Payload: dto.ErrorPayload{Message: errorMessage},
+	})
+}
@@ -7,6 +7,7 @@ import (
 	connaction "terraforming-mars-backend/internal/action/connection"
 	"terraforming-mars-backend/internal/delivery/dto"
 	"terraforming-mars-backend/internal/delivery/websocket/core"
+	"terraforming-mars-backend/internal/i18n"
 	"terraforming-mars-backend/internal/logger"
 
 	"go.uber.org/zap"
@@ -67,11 +68,12 @@ func (h *KickPlayerHandler) HandleMessage(ctx context.Context, connection *core.
 
 	// Send player-kicked message to the kicked player before closing their connection
 	kickedConnection := h.hub.GetManager().GetConnectionByPlayerID(connection.GameID, targetPlayerID)
-	if kickedConnection != nil {
+	// A hotseat connection kicking one of its own seats keeps the connection open
+	if kickedConnection != nil && kickedConnection != connection {
 		kickedMessage := dto.WebSocketMessage{
 			Type:    dto.MessageTypePlayerKicked,
 			GameID:  connection.GameID,
-			Payload: map[string]any{"reason": "You were kicked from the game"},
+			Payload: dto.PlayerKickedPayload{Reason: i18n.Message(kickedConnection.Locale(), "notification.kicked", nil)},
 		}
 		kickedConnection.SendMessage(kickedMessage)
 		log.Info("💬 Sent player-kicked message to kicked player", zap.String("target_player_id", targetPlayerID))
@@ -89,10 +91,8 @@ func (h *KickPlayerHandler) HandleMessage(ctx context.Context, connection *core.
 }
 
 func (h *KickPlayerHandler) sendError(connection *core.Connection, errorMessage string) {
-	connection.Send <- dto.WebSocketMessage{
-		Type: dto.MessageTypeError,
-		Payload: map[string]any{
-			"error": errorMessage,
-		},
-	}
+	connection.SendMessage(dto.WebSocketMessage{
+		Type:    dto.MessageTypeError,
+		Payload: dto.ErrorPayload{Message: errorMessage},
+	})
 }
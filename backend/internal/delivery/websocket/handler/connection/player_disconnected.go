@@ -2,6 +2,8 @@ package connection
 
 import (
 	"context"
+	"errors"
+	"fmt"
 
 	connaction "terraforming-mars-backend/internal/action/connection"
 	"terraforming-mars-backend/internal/delivery/dto"
@@ -46,16 +48,36 @@ func (h *PlayerDisconnectedHandler) HandleMessage(ctx context.Context, connectio
 		return
 	}
 
-	err := h.action.Execute(ctx, connection.GameID, connection.PlayerID)
-	if err != nil {
-		log.Error("Failed to execute player disconnected action (connection closing anyway)", zap.Error(err))
-		return
+	if err := h.disconnectSeats(ctx, connection); err != nil {
+		log.Error("Some seats failed to disconnect (connection closing anyway)", zap.Error(err))
+	} else {
+		log.Info("✅ Player disconnected action completed successfully")
 	}
 
-	log.Info("✅ Player disconnected action completed successfully")
-
 	h.broadcaster.BroadcastGameState(connection.GameID, nil)
 	log.Debug("📡 Broadcasted game state to all players")
 
-	// NOTE: Do NOT send response on connection.Send - the connection is being closed
+	// NOTE: Do NOT send a response on the connection - it is being closed
+}
+
+// disconnectSeats runs the disconnect for every seat the connection controls, so one failing seat
+// does not leave the others marked connected; every failure is logged and returned joined
+// Hotseat connections control several seats
+func (h *PlayerDisconnectedHandler) disconnectSeats(ctx context.Context, connection *core.Connection) error {
+	playerIDs := connection.ControlledPlayers()
+	if len(playerIDs) == 0 {
+		playerIDs = []string{connection.PlayerID}
+	}
+
+	var errs []error
+	for _, playerID := range playerIDs {
+		if err := h.action.Execute(ctx, connection.GameID, playerID); err != nil {
+			h.logger.Error("Failed to disconnect seat",
+				zap.String("connection_id", connection.ID),
+				zap.String("player_id", playerID),
+				zap.Error(err))
+			errs = append(errs, fmt.Errorf("player %s: %w", playerID, err))
+		}
+	}
+	return errors.Join(errs...)
 }
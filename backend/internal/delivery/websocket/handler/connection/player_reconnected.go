@@ -52,20 +52,17 @@ func (h *PlayerReconnectedHandler) HandleMessage(ctx context.Context, connection
 	response := dto.WebSocketMessage{
 		Type:   dto.MessageTypePlayerReconnected,
 		GameID: connection.GameID,
-		Payload: map[string]interface{}{
-			"playerId": connection.PlayerID,
-			"success":  true,
+		Payload: dto.PlayerReconnectedPayload{
+			PlayerID: connection.PlayerID,
 		},
 	}
 
-	connection.Send <- response
+	connection.SendMessage(response)
 }
 
 func (h *PlayerReconnectedHandler) sendError(connection *core.Connection, errorMessage string) {
-	connection.Send <- dto.WebSocketMessage{
-		Type: dto.MessageTypeError,
-		Payload: map[string]interface{}{
-			"error": errorMessage,
-		},
-	}
+	connection.SendMessage(dto.WebSocketMessage{
+		Type:    dto.MessageTypeError,
+		Payload: dto.ErrorPayload{Message: errorMessage},
+	})
 }
@@ -70,6 +70,9 @@ func (h *PlayerTakeoverHandler) HandleMessage(ctx context.Context, connection *c
 	}
 
 	connection.SetPlayer(targetPlayerID, gameID)
+	if locale := result.GameDto.CurrentPlayer.Locale; locale != "" {
+		connection.SetLocale(locale)
+	}
 
 	log.Info("✅ Player takeover completed successfully",
 		zap.String("player_id", result.PlayerID),
@@ -81,23 +84,20 @@ func (h *PlayerTakeoverHandler) HandleMessage(ctx context.Context, connection *c
 	response := dto.WebSocketMessage{
 		Type:   dto.MessageTypePlayerConnected,
 		GameID: gameID,
-		Payload: map[string]any{
-			"playerID":   result.PlayerID,
-			"playerName": result.PlayerName,
-			"success":    true,
+		Payload: dto.PlayerConnectedPayload{
+			PlayerID:   result.PlayerID,
+			PlayerName: result.PlayerName,
 		},
 	}
 
-	connection.Send <- response
+	connection.SendMessage(response)
 	log.Info("📤 Sent player takeover confirmation")
 }
 
 // sendError sends an error message to the client
 func (h *PlayerTakeoverHandler) sendError(connection *core.Connection, errorMessage string) {
-	connection.Send <- dto.WebSocketMessage{
-		Type: dto.MessageTypeError,
-		Payload: map[string]any{
-			"error": errorMessage,
-		},
-	}
+	connection.SendMessage(dto.WebSocketMessage{
+		Type:    dto.MessageTypeError,
+		Payload: dto.ErrorPayload{Message: errorMessage},
+	})
 }
@@ -0,0 +1,127 @@
+package connection
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"terraforming-mars-backend/internal/delivery/dto"
+	"terraforming-mars-backend/internal/delivery/websocket/core"
+	"terraforming-mars-backend/internal/logger"
+
+	"go.uber.org/zap"
+)
+
+// StateSender sends a connection the current game state in the form it subscribed to
+type StateSender interface {
+	SendState(gameID, playerID string) error
+	SendPublicState(gameID string, connection *core.Connection) error
+}
+
+// SubscribeHandler lets lightweight clients such as overview screens receive only some state slices
+// A connection without a seat may subscribe too, naming the game; it only ever receives the public view
+type SubscribeHandler struct {
+	sender StateSender
+	logger *zap.Logger
+}
+
+// NewSubscribeHandler creates a new subscribe handler
+func NewSubscribeHandler(sender StateSender) *SubscribeHandler {
+	return &SubscribeHandler{
+		sender: sender,
+		logger: logger.Get(),
+	}
+}
+
+// HandleMessage implements the MessageHandler interface
+func (h *SubscribeHandler) HandleMessage(ctx context.Context, connection *core.Connection, message dto.WebSocketMessage) {
+	log := h.logger.With(
+		zap.String("connection_id", connection.ID),
+		zap.String("message_type", string(message.Type)),
+	)
+
+	payloadMap, ok := message.Payload.(map[string]any)
+	if !ok {
+		log.Error("Invalid payload format")
+		h.sendError(connection, "invalid payload format")
+		return
+	}
+
+	requested, _ := payloadMap["slices"].([]any)
+	subscribed := make([]string, 0, len(requested))
+	for _, value := range requested {
+		slice, _ := value.(string)
+		if !dto.ValidStateSlice(slice) {
+			log.Warn("Unknown state slice", zap.Any("slice", value))
+			h.sendError(connection, fmt.Sprintf("unknown state slice %v", value))
+			return
+		}
+		if !slices.Contains(subscribed, slice) {
+			subscribed = append(subscribed, slice)
+		}
+	}
+
+	playerID, gameID := connection.GetPlayer()
+	if playerID == "" {
+		h.subscribeWithoutSeat(connection, gameID, payloadMap, subscribed, log)
+		return
+	}
+
+	connection.Subscribe(subscribed)
+
+	// Answer with the current state in the new form so the client need not wait for the next change
+	if err := h.sender.SendState(gameID, playerID); err != nil {
+		log.Error("Failed to send state after subscribing", zap.Error(err))
+		h.sendError(connection, err.Error())
+		return
+	}
+
+	log.Info("🔭 Connection subscribed to state slices",
+		zap.String("player_id", playerID),
+		zap.Strings("slices", subscribed))
+}
+
+// subscribeWithoutSeat binds a seatless connection to the game it names and sends the public slices
+// Such a connection has no full game to fall back to, so it must name at least one slice
+func (h *SubscribeHandler) subscribeWithoutSeat(connection *core.Connection, gameID string, payloadMap map[string]any, subscribed []string, log *zap.Logger) {
+	if gameID == "" {
+		gameID, _ = payloadMap["gameId"].(string)
+	}
+	if gameID == "" {
+		log.Warn("Seatless subscription without a game")
+		h.sendError(connection, "gameId is required to subscribe without a seat")
+		return
+	}
+	if len(subscribed) == 0 {
+		log.Warn("Seatless subscription without slices", zap.String("game_id", gameID))
+		h.sendError(connection, "a subscription without a seat needs at least one slice")
+		return
+	}
+
+	previous := connection.Subscription()
+	connection.Subscribe(subscribed)
+	if err := h.sender.SendPublicState(gameID, connection); err != nil {
+		connection.Subscribe(previous)
+		log.Warn("Failed to send public state to seatless subscriber",
+			zap.String("game_id", gameID),
+			zap.Error(err))
+		h.sendError(connection, "game not found")
+		return
+	}
+
+	// Joining the game's connections after the first send means broadcasts reach it from now on
+	if _, boundGameID := connection.GetPlayer(); boundGameID == "" {
+		connection.SetPlayer("", gameID)
+	}
+
+	log.Info("🔭 Seatless connection subscribed to public state slices",
+		zap.String("game_id", gameID),
+		zap.Strings("slices", subscribed))
+}
+
+func (h *SubscribeHandler) sendError(connection *core.Connection, errorMessage string) {
+	connection.SendMessage(dto.WebSocketMessage{
+		Type:    dto.MessageTypeError,
+		Payload: dto.ErrorPayload{Message: errorMessage},
+	})
+}
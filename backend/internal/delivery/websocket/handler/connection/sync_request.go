@@ -0,0 +1,87 @@
+package connection
+
+import (
+	"context"
+
+	"terraforming-mars-backend/internal/delivery/dto"
+	"terraforming-mars-backend/internal/delivery/websocket/core"
+	"terraforming-mars-backend/internal/logger"
+
+	"go.uber.org/zap"
+)
+
+// StateSyncer builds the changes a client missed since a given action sequence
+type StateSyncer interface {
+	SyncState(gameID, playerID string, req dto.SyncRequestPayload) (dto.SyncResponsePayload, error)
+}
+
+// SyncRequestHandler answers sync-request messages from clients resuming after a reconnect
+// The response replaces re-reading the whole game from the player-connected flow
+type SyncRequestHandler struct {
+	syncer StateSyncer
+	logger *zap.Logger
+}
+
+// NewSyncRequestHandler creates a new sync request handler
+func NewSyncRequestHandler(syncer StateSyncer) *SyncRequestHandler {
+	return &SyncRequestHandler{
+		syncer: syncer,
+		logger: logger.Get(),
+	}
+}
+
+// HandleMessage implements the MessageHandler interface
+func (h *SyncRequestHandler) HandleMessage(ctx context.Context, connection *core.Connection, message dto.WebSocketMessage) {
+	log := h.logger.With(
+		zap.String("connection_id", connection.ID),
+		zap.String("message_type", string(message.Type)),
+	)
+
+	playerID, gameID := connection.GetPlayer()
+	if gameID == "" || playerID == "" {
+		log.Error("Missing connection context")
+		h.sendError(connection, "not connected to game")
+		return
+	}
+
+	payloadMap, ok := message.Payload.(map[string]any)
+	if !ok {
+		log.Error("Invalid payload format")
+		h.sendError(connection, "invalid payload format")
+		return
+	}
+
+	var req dto.SyncRequestPayload
+	if since, ok := payloadMap["sinceSequence"].(float64); ok {
+		req.SinceSequence = int64(since)
+	}
+	if since, ok := payloadMap["sinceLogSequence"].(float64); ok {
+		req.SinceLogSequence = int64(since)
+	}
+
+	response, err := h.syncer.SyncState(gameID, playerID, req)
+	if err != nil {
+		log.Error("Failed to build sync response", zap.Error(err))
+		h.sendError(connection, err.Error())
+		return
+	}
+
+	connection.SendMessage(dto.WebSocketMessage{
+		Type:           dto.MessageTypeSyncResponse,
+		GameID:         gameID,
+		ActionSequence: response.ActionSequence,
+		Payload:        response,
+	})
+
+	log.Debug("🔁 Sent sync response",
+		zap.String("player_id", playerID),
+		zap.String("mode", response.Mode),
+		zap.Int64("since_sequence", req.SinceSequence))
+}
+
+func (h *SyncRequestHandler) sendError(connection *core.Connection, errorMessage string) {
+	connection.SendMessage(dto.WebSocketMessage{
+		Type:    dto.MessageTypeError,
+		Payload: dto.ErrorPayload{Message: errorMessage},
+	})
+}
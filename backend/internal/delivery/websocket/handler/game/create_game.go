@@ -2,6 +2,9 @@ package game
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
 
 	gameaction "terraforming-mars-backend/internal/action/game"
 	"terraforming-mars-backend/internal/delivery/dto"
@@ -52,6 +55,12 @@ func (h *CreateGameHandler) HandleMessage(ctx context.Context, connection *core.
 		if maxPlayers, ok := payloadMap["maxPlayers"].(float64); ok {
 			settings.MaxPlayers = int(maxPlayers)
 		}
+		if passAndPlay, ok := payloadMap["passAndPlay"].(bool); ok {
+			settings.PassAndPlay = passAndPlay
+		}
+		if analytics, ok := payloadMap["analytics"].(bool); ok {
+			settings.Analytics = analytics
+		}
 		if cardPacks, ok := payloadMap["cardPacks"].([]interface{}); ok {
 			packs := make([]string, len(cardPacks))
 			for i, pack := range cardPacks {
@@ -63,13 +72,42 @@ func (h *CreateGameHandler) HandleMessage(ctx context.Context, connection *core.
 				settings.CardPacks = packs
 			}
 		}
+		if colorPalette, ok := payloadMap["colorPalette"].([]interface{}); ok {
+			for _, color := range colorPalette {
+				if colorStr, ok := color.(string); ok {
+					settings.ColorPalette = append(settings.ColorPalette, colorStr)
+				}
+			}
+		}
+		if rulesOptions, ok := payloadMap["rulesOptions"]; ok {
+			// A lobby must never start with variants other than the ones requested
+			var optionsDto dto.RulesOptionsDto
+			payloadBytes, err := json.Marshal(rulesOptions)
+			if err == nil {
+				err = json.Unmarshal(payloadBytes, &optionsDto)
+			}
+			if err != nil {
+				log.Warn("Rejected invalid rules options", zap.Error(err))
+				h.sendError(connection, fmt.Sprintf("invalid rules options: %v", err))
+				return
+			}
+			settings.RulesOptions = dto.FromRulesOptionsDto(optionsDto)
+		}
 	}
 
 	log.Debug("Parsed create game settings",
 		zap.Int("max_players", settings.MaxPlayers),
 		zap.Strings("card_packs", settings.CardPacks))
 
-	game, err := h.createGameAction.Execute(ctx, settings)
+	newGame, err := h.createGameAction.Execute(ctx, settings)
+	if errors.Is(err, game.ErrServerAtCapacity) {
+		log.Warn("Refused game creation, server at capacity")
+		connection.SendMessage(dto.WebSocketMessage{
+			Type:    dto.MessageTypeError,
+			Payload: dto.ErrorPayload{Message: err.Error(), Code: dto.ErrCodeServerAtCapacity},
+		})
+		return
+	}
 	if err != nil {
 		log.Error("Failed to execute create game action", zap.Error(err))
 		h.sendError(connection, err.Error())
@@ -77,31 +115,26 @@ func (h *CreateGameHandler) HandleMessage(ctx context.Context, connection *core.
 	}
 
 	log.Info("✅ Create game action completed successfully",
-		zap.String("game_id", game.ID()))
+		zap.String("game_id", newGame.ID()))
 
-	h.broadcaster.BroadcastGameState(game.ID(), nil)
+	h.broadcaster.BroadcastGameState(newGame.ID(), nil)
 	log.Debug("📡 Broadcasted game state to all players")
 
 	response := dto.WebSocketMessage{
-		Type: dto.MessageTypeGameUpdated,
-		Payload: map[string]interface{}{
-			"gameId":  game.ID(),
-			"success": true,
-			"message": "Game created successfully. Join with playerConnect.",
-		},
+		Type:    dto.MessageTypeGameCreated,
+		GameID:  newGame.ID(),
+		Payload: dto.GameCreatedPayload{GameID: newGame.ID()},
 	}
 
-	connection.Send <- response
+	connection.SendMessage(response)
 
 	log.Info("📤 Sent game created response to client")
 }
 
 // sendError sends an error message to the client
 func (h *CreateGameHandler) sendError(connection *core.Connection, errorMessage string) {
-	connection.Send <- dto.WebSocketMessage{
-		Type: dto.MessageTypeError,
-		Payload: map[string]interface{}{
-			"error": errorMessage,
-		},
-	}
+	connection.SendMessage(dto.WebSocketMessage{
+		Type:    dto.MessageTypeError,
+		Payload: dto.ErrorPayload{Message: errorMessage},
+	})
 }
@@ -0,0 +1,79 @@
+package game
+
+import (
+	"context"
+	"encoding/json"
+
+	gameaction "terraforming-mars-backend/internal/action/game"
+	"terraforming-mars-backend/internal/delivery/dto"
+	"terraforming-mars-backend/internal/delivery/websocket/core"
+	"terraforming-mars-backend/internal/logger"
+
+	"go.uber.org/zap"
+)
+
+// ForceAdvancePhaseHandler handles the host's requests to force a stuck production phase forward
+type ForceAdvancePhaseHandler struct {
+	action      *gameaction.ForceAdvancePhaseAction
+	broadcaster Broadcaster
+	logger      *zap.Logger
+}
+
+// NewForceAdvancePhaseHandler creates a new force advance phase handler
+func NewForceAdvancePhaseHandler(action *gameaction.ForceAdvancePhaseAction, broadcaster Broadcaster) *ForceAdvancePhaseHandler {
+	return &ForceAdvancePhaseHandler{
+		action:      action,
+		broadcaster: broadcaster,
+		logger:      logger.Get(),
+	}
+}
+
+// HandleMessage implements the MessageHandler interface
+func (h *ForceAdvancePhaseHandler) HandleMessage(ctx context.Context, connection *core.Connection, message dto.WebSocketMessage) {
+	log := h.logger.With(
+		zap.String("connection_id", connection.ID),
+		zap.String("message_type", string(message.Type)),
+	)
+
+	log.Info("⏩ Host asked to force the production phase forward")
+
+	playerID, gameID := connection.GetPlayer()
+	if gameID == "" || playerID == "" {
+		log.Error("Missing connection context")
+		h.sendError(connection, "Not connected to a game")
+		return
+	}
+
+	payloadBytes, err := json.Marshal(message.Payload)
+	if err != nil {
+		log.Error("Failed to marshal force advance payload", zap.Error(err))
+		h.sendError(connection, "Invalid payload format")
+		return
+	}
+
+	var request dto.ForceAdvancePhaseRequest
+	if err := json.Unmarshal(payloadBytes, &request); err != nil {
+		log.Error("Failed to decode force advance payload", zap.Error(err))
+		h.sendError(connection, "Invalid payload format")
+		return
+	}
+
+	err = h.action.Execute(ctx, gameID, playerID, request.Confirmed)
+	if err != nil {
+		log.Warn("Production phase was not forced forward", zap.Bool("confirmed", request.Confirmed), zap.Error(err))
+		h.sendError(connection, err.Error())
+		return
+	}
+
+	log.Info("✅ Production phase forced forward", zap.String("game_id", gameID), zap.String("player_id", playerID))
+
+	h.broadcaster.BroadcastGameState(gameID, nil)
+	log.Debug("📡 Broadcasted the advanced phase to all players")
+}
+
+func (h *ForceAdvancePhaseHandler) sendError(connection *core.Connection, errorMessage string) {
+	connection.SendMessage(dto.WebSocketMessage{
+		Type:    dto.MessageTypeError,
+		Payload: dto.ErrorPayload{Message: errorMessage},
+	})
+}
@@ -50,6 +50,8 @@ func (h *JoinGameHandler) HandleMessage(ctx context.Context, connection *core.Co
 	gameID, _ := payloadMap["gameId"].(string)
 	playerName, _ := payloadMap["playerName"].(string)
 	playerID, _ := payloadMap["playerId"].(string)
+	accountID, _ := payloadMap["accountId"].(string)
+	inviteToken, _ := payloadMap["inviteToken"].(string)
 
 	if gameID == "" {
 		log.Error("Missing gameId")
@@ -76,7 +78,7 @@ func (h *JoinGameHandler) HandleMessage(ctx context.Context, connection *core.Co
 
 	connection.SetPlayer(playerID, gameID)
 
-	result, err := h.joinGameAction.Execute(ctx, gameID, playerName, playerID)
+	result, err := h.joinGameAction.ExecuteWithInvite(ctx, gameID, playerName, playerID, accountID, inviteToken)
 	if err != nil {
 		log.Error("Failed to execute join game action", zap.Error(err))
 		h.sendError(connection, err.Error())
@@ -86,6 +88,11 @@ func (h *JoinGameHandler) HandleMessage(ctx context.Context, connection *core.Co
 	log.Info("✅ Join game action completed successfully",
 		zap.String("player_id", result.PlayerID))
 
+	// A saved locale follows the player to every device they join from
+	if locale := result.GameDto.CurrentPlayer.Locale; locale != "" {
+		connection.SetLocale(locale)
+	}
+
 	h.broadcaster.BroadcastGameState(gameID, nil)
 	log.Debug("📡 Broadcasted game state to all players")
 
@@ -95,23 +102,20 @@ func (h *JoinGameHandler) HandleMessage(ctx context.Context, connection *core.Co
 	response := dto.WebSocketMessage{
 		Type:   dto.MessageTypePlayerConnected,
 		GameID: gameID,
-		Payload: map[string]interface{}{
-			"playerID":   result.PlayerID,
-			"playerName": playerName,
-			"success":    true,
+		Payload: dto.PlayerConnectedPayload{
+			PlayerID:   result.PlayerID,
+			PlayerName: result.PlayerName,
 		},
 	}
 
-	connection.Send <- response
+	connection.SendMessage(response)
 	log.Info("📤 Sent player connected confirmation")
 }
 
 // sendError sends an error message to the client
 func (h *JoinGameHandler) sendError(connection *core.Connection, errorMessage string) {
-	connection.Send <- dto.WebSocketMessage{
-		Type: dto.MessageTypeError,
-		Payload: map[string]interface{}{
-			"error": errorMessage,
-		},
-	}
+	connection.SendMessage(dto.WebSocketMessage{
+		Type:    dto.MessageTypeError,
+		Payload: dto.ErrorPayload{Message: errorMessage},
+	})
 }
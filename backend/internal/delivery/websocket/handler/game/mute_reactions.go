@@ -0,0 +1,79 @@
+package game
+
+import (
+	"context"
+	"encoding/json"
+
+	gameaction "terraforming-mars-backend/internal/action/game"
+	"terraforming-mars-backend/internal/delivery/dto"
+	"terraforming-mars-backend/internal/delivery/websocket/core"
+	"terraforming-mars-backend/internal/logger"
+
+	"go.uber.org/zap"
+)
+
+// MuteReactionsHandler handles a player's requests to hide or show reactions
+type MuteReactionsHandler struct {
+	action      *gameaction.MuteReactionsAction
+	broadcaster Broadcaster
+	logger      *zap.Logger
+}
+
+// NewMuteReactionsHandler creates a new mute reactions handler
+func NewMuteReactionsHandler(action *gameaction.MuteReactionsAction, broadcaster Broadcaster) *MuteReactionsHandler {
+	return &MuteReactionsHandler{
+		action:      action,
+		broadcaster: broadcaster,
+		logger:      logger.Get(),
+	}
+}
+
+// HandleMessage implements the MessageHandler interface
+func (h *MuteReactionsHandler) HandleMessage(ctx context.Context, connection *core.Connection, message dto.WebSocketMessage) {
+	log := h.logger.With(
+		zap.String("connection_id", connection.ID),
+		zap.String("message_type", string(message.Type)),
+	)
+
+	log.Info("🔇 Processing mute reactions request")
+
+	playerID, gameID := connection.GetPlayer()
+	if gameID == "" || playerID == "" {
+		log.Error("Missing connection context")
+		h.sendError(connection, "Not connected to a game")
+		return
+	}
+
+	payloadBytes, err := json.Marshal(message.Payload)
+	if err != nil {
+		log.Error("Failed to marshal payload", zap.Error(err))
+		h.sendError(connection, "Invalid payload format")
+		return
+	}
+
+	var request dto.MuteReactionsRequest
+	if err := json.Unmarshal(payloadBytes, &request); err != nil {
+		log.Error("Failed to unmarshal payload", zap.Error(err))
+		h.sendError(connection, "Invalid payload format")
+		return
+	}
+
+	if err := h.action.Execute(ctx, gameID, playerID, request.TargetPlayerID, request.Muted); err != nil {
+		log.Error("Failed to execute mute reactions action", zap.Error(err))
+		h.sendError(connection, err.Error())
+		return
+	}
+
+	log.Info("✅ Mute reactions action completed successfully")
+
+	// Mutes only show in the player's own view
+	h.broadcaster.BroadcastGameState(gameID, []string{playerID})
+	log.Debug("📡 Broadcasted game state to player")
+}
+
+func (h *MuteReactionsHandler) sendError(connection *core.Connection, errorMessage string) {
+	connection.SendMessage(dto.WebSocketMessage{
+		Type:    dto.MessageTypeError,
+		Payload: dto.ErrorPayload{Message: errorMessage},
+	})
+}
@@ -0,0 +1,131 @@
+package game
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	gameaction "terraforming-mars-backend/internal/action/game"
+	"terraforming-mars-backend/internal/delivery/dto"
+	"terraforming-mars-backend/internal/delivery/websocket/core"
+	"terraforming-mars-backend/internal/logger"
+
+	"go.uber.org/zap"
+)
+
+// RunBatchHandler handles batches of a player's actions sent in one message
+// Each step is run by the handler registered for its message type, so a step behaves exactly
+// like the same message sent on its own; the batch answers and broadcasts once at the end
+type RunBatchHandler struct {
+	action      *gameaction.RunBatchAction
+	steps       map[dto.MessageType]core.StepHandler
+	broadcaster Broadcaster
+	logger      *zap.Logger
+}
+
+// NewRunBatchHandler creates a new run batch handler; steps lists the message types a batch may contain
+func NewRunBatchHandler(action *gameaction.RunBatchAction, steps map[dto.MessageType]core.StepHandler, broadcaster Broadcaster) *RunBatchHandler {
+	return &RunBatchHandler{
+		action:      action,
+		steps:       steps,
+		broadcaster: broadcaster,
+		logger:      logger.Get(),
+	}
+}
+
+// HandleMessage implements the MessageHandler interface
+func (h *RunBatchHandler) HandleMessage(ctx context.Context, connection *core.Connection, message dto.WebSocketMessage) {
+	log := h.logger.With(
+		zap.String("connection_id", connection.ID),
+		zap.String("message_type", string(message.Type)),
+	)
+
+	log.Info("📦 Processing run batch request")
+
+	playerID, gameID := connection.GetPlayer()
+	if gameID == "" || playerID == "" {
+		log.Error("Missing connection context")
+		h.sendError(connection, "Not connected to a game")
+		return
+	}
+
+	payloadBytes, err := json.Marshal(message.Payload)
+	if err != nil {
+		log.Error("Failed to marshal payload", zap.Error(err))
+		h.sendError(connection, "Invalid payload format")
+		return
+	}
+
+	var request dto.RunBatchRequest
+	if err := json.Unmarshal(payloadBytes, &request); err != nil {
+		log.Error("Failed to unmarshal payload", zap.Error(err))
+		h.sendError(connection, "Invalid payload format")
+		return
+	}
+
+	// Every step type is checked before anything runs, so a typo never leaves half a batch applied
+	steps := make([]gameaction.BatchStep, len(request.Steps))
+	for i, stepRequest := range request.Steps {
+		handler, ok := h.steps[stepRequest.Type]
+		if !ok {
+			log.Warn("Unsupported batch step", zap.Int("step", i+1), zap.String("type", string(stepRequest.Type)))
+			h.sendError(connection, fmt.Sprintf("step %d: %s cannot be batched", i+1, stepRequest.Type))
+			return
+		}
+		payload := stepRequest.Payload
+		if payload == nil {
+			payload = map[string]interface{}{}
+		}
+		steps[i] = gameaction.BatchStep{
+			Type: string(stepRequest.Type),
+			Run: func(ctx context.Context) error {
+				return handler.RunStep(ctx, gameID, playerID, payload)
+			},
+		}
+	}
+
+	results, err := h.action.Execute(ctx, gameID, playerID, steps)
+	if err != nil && !errors.Is(err, gameaction.ErrBatchStepFailed) {
+		log.Error("Failed to execute run batch action", zap.Error(err))
+		h.sendError(connection, err.Error())
+		return
+	}
+
+	completed := err == nil
+	if completed {
+		log.Info("✅ Run batch action completed successfully")
+		h.broadcaster.BroadcastGameState(gameID, nil)
+		log.Debug("📡 Broadcasted game state to all players")
+	} else {
+		log.Info("↩️ Batch rolled back", zap.Error(err))
+	}
+
+	connection.SendMessage(dto.WebSocketMessage{
+		Type:    dto.MessageTypeBatchResult,
+		GameID:  gameID,
+		Payload: toBatchResultPayload(completed, results),
+	})
+}
+
+func (h *RunBatchHandler) sendError(connection *core.Connection, errorMessage string) {
+	connection.SendMessage(dto.WebSocketMessage{
+		Type:    dto.MessageTypeError,
+		Payload: dto.ErrorPayload{Message: errorMessage},
+	})
+}
+
+func toBatchResultPayload(completed bool, results []gameaction.BatchStepResult) dto.BatchResultPayload {
+	steps := make([]dto.BatchStepResultDto, len(results))
+	for i, result := range results {
+		steps[i] = dto.BatchStepResultDto{
+			Index:  i,
+			Type:   dto.MessageType(result.Type),
+			Status: string(result.Status),
+		}
+		if result.Err != nil {
+			steps[i].Error = result.Err.Error()
+		}
+	}
+	return dto.BatchResultPayload{Completed: completed, Steps: steps}
+}
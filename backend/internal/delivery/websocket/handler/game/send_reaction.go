@@ -0,0 +1,87 @@
+package game
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	gameaction "terraforming-mars-backend/internal/action/game"
+	"terraforming-mars-backend/internal/delivery/dto"
+	"terraforming-mars-backend/internal/delivery/websocket/core"
+	"terraforming-mars-backend/internal/game"
+	"terraforming-mars-backend/internal/logger"
+
+	"go.uber.org/zap"
+)
+
+// ReactionBroadcaster delivers reactions to the players who have not muted the sender
+type ReactionBroadcaster interface {
+	BroadcastReaction(gameID string, payload dto.ReactionPayload)
+}
+
+// SendReactionHandler handles reactions sent to the table
+type SendReactionHandler struct {
+	action      *gameaction.SendReactionAction
+	broadcaster ReactionBroadcaster
+	logger      *zap.Logger
+}
+
+// NewSendReactionHandler creates a new send reaction handler
+func NewSendReactionHandler(action *gameaction.SendReactionAction, broadcaster ReactionBroadcaster) *SendReactionHandler {
+	return &SendReactionHandler{
+		action:      action,
+		broadcaster: broadcaster,
+		logger:      logger.Get(),
+	}
+}
+
+// HandleMessage implements the MessageHandler interface
+func (h *SendReactionHandler) HandleMessage(ctx context.Context, connection *core.Connection, message dto.WebSocketMessage) {
+	log := h.logger.With(
+		zap.String("connection_id", connection.ID),
+		zap.String("message_type", string(message.Type)),
+	)
+
+	playerID, gameID := connection.GetPlayer()
+	if gameID == "" || playerID == "" {
+		log.Error("Missing connection context")
+		h.sendError(connection, "Not connected to a game")
+		return
+	}
+
+	payloadBytes, err := json.Marshal(message.Payload)
+	if err != nil {
+		log.Error("Failed to marshal payload", zap.Error(err))
+		h.sendError(connection, "Invalid payload format")
+		return
+	}
+
+	var request dto.SendReactionRequest
+	if err := json.Unmarshal(payloadBytes, &request); err != nil {
+		log.Error("Failed to unmarshal payload", zap.Error(err))
+		h.sendError(connection, "Invalid payload format")
+		return
+	}
+
+	sent, err := h.action.Execute(ctx, gameID, playerID, game.Reaction(request.Reaction))
+	if err != nil {
+		log.Debug("Reaction rejected", zap.Error(err))
+		h.sendError(connection, err.Error())
+		return
+	}
+
+	// The log entry reaches players with their next state update; the reaction itself goes out now
+	h.broadcaster.BroadcastReaction(gameID, dto.ReactionPayload{
+		PlayerID:   sent.PlayerID,
+		PlayerName: sent.PlayerName,
+		Reaction:   string(sent.Reaction),
+		SentAt:     sent.SentAt.Format(time.RFC3339),
+	})
+}
+
+func (h *SendReactionHandler) sendError(connection *core.Connection, errorMessage string) {
+	connection.SendMessage(dto.WebSocketMessage{
+		Type:    dto.MessageTypeError,
+		Payload: dto.ErrorPayload{Message: errorMessage},
+	})
+}
@@ -0,0 +1,85 @@
+package game
+
+import (
+	"context"
+	"encoding/json"
+
+	gameaction "terraforming-mars-backend/internal/action/game"
+	"terraforming-mars-backend/internal/delivery/dto"
+	"terraforming-mars-backend/internal/delivery/websocket/core"
+	internalgame "terraforming-mars-backend/internal/game"
+	"terraforming-mars-backend/internal/logger"
+
+	"go.uber.org/zap"
+)
+
+// SetHandicapHandler handles lobby handicap requests
+type SetHandicapHandler struct {
+	action      *gameaction.SetHandicapAction
+	broadcaster Broadcaster
+	logger      *zap.Logger
+}
+
+// NewSetHandicapHandler creates a new set handicap handler
+func NewSetHandicapHandler(action *gameaction.SetHandicapAction, broadcaster Broadcaster) *SetHandicapHandler {
+	return &SetHandicapHandler{
+		action:      action,
+		broadcaster: broadcaster,
+		logger:      logger.Get(),
+	}
+}
+
+// HandleMessage implements the MessageHandler interface
+func (h *SetHandicapHandler) HandleMessage(ctx context.Context, connection *core.Connection, message dto.WebSocketMessage) {
+	log := h.logger.With(
+		zap.String("connection_id", connection.ID),
+		zap.String("message_type", string(message.Type)),
+	)
+
+	log.Info("⚖️ Processing set handicap request")
+
+	if connection.GameID == "" || connection.PlayerID == "" {
+		log.Error("Handicap request without a seat")
+		h.sendError(connection, "Not connected to a game")
+		return
+	}
+
+	payloadBytes, err := json.Marshal(message.Payload)
+	if err != nil {
+		log.Error("Failed to marshal payload", zap.Error(err))
+		h.sendError(connection, "Invalid payload format")
+		return
+	}
+
+	var request dto.SetHandicapRequest
+	if err := json.Unmarshal(payloadBytes, &request); err != nil {
+		log.Error("Failed to unmarshal payload", zap.Error(err))
+		h.sendError(connection, "Invalid payload format")
+		return
+	}
+
+	handicap := internalgame.Handicap{
+		ExtraCredits: request.ExtraCredits,
+		ExtraCards:   request.ExtraCards,
+		ExtraTR:      request.ExtraTR,
+	}
+
+	err = h.action.Execute(ctx, connection.GameID, connection.PlayerID, request.PlayerID, handicap)
+	if err != nil {
+		log.Error("Failed to execute set handicap action", zap.Error(err))
+		h.sendError(connection, err.Error())
+		return
+	}
+
+	log.Info("✅ Set handicap action completed successfully")
+
+	h.broadcaster.BroadcastGameState(connection.GameID, nil)
+	log.Debug("📡 Broadcasted updated handicaps to the lobby")
+}
+
+func (h *SetHandicapHandler) sendError(connection *core.Connection, errorMessage string) {
+	connection.SendMessage(dto.WebSocketMessage{
+		Type:    dto.MessageTypeError,
+		Payload: dto.ErrorPayload{Message: errorMessage},
+	})
+}
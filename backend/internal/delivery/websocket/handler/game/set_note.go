@@ -0,0 +1,79 @@
+package game
+
+import (
+	"context"
+	"encoding/json"
+
+	gameaction "terraforming-mars-backend/internal/action/game"
+	"terraforming-mars-backend/internal/delivery/dto"
+	"terraforming-mars-backend/internal/delivery/websocket/core"
+	"terraforming-mars-backend/internal/logger"
+
+	"go.uber.org/zap"
+)
+
+// SetNoteHandler handles a player's requests to save their private note
+type SetNoteHandler struct {
+	action      *gameaction.SetNoteAction
+	broadcaster Broadcaster
+	logger      *zap.Logger
+}
+
+// NewSetNoteHandler creates a new set note handler
+func NewSetNoteHandler(action *gameaction.SetNoteAction, broadcaster Broadcaster) *SetNoteHandler {
+	return &SetNoteHandler{
+		action:      action,
+		broadcaster: broadcaster,
+		logger:      logger.Get(),
+	}
+}
+
+// HandleMessage implements the MessageHandler interface
+func (h *SetNoteHandler) HandleMessage(ctx context.Context, connection *core.Connection, message dto.WebSocketMessage) {
+	log := h.logger.With(
+		zap.String("connection_id", connection.ID),
+		zap.String("message_type", string(message.Type)),
+	)
+
+	log.Info("📝 Processing set note request")
+
+	playerID, gameID := connection.GetPlayer()
+	if gameID == "" || playerID == "" {
+		log.Error("Missing connection context")
+		h.sendError(connection, "Not connected to a game")
+		return
+	}
+
+	payloadBytes, err := json.Marshal(message.Payload)
+	if err != nil {
+		log.Error("Failed to marshal payload", zap.Error(err))
+		h.sendError(connection, "Invalid payload format")
+		return
+	}
+
+	var request dto.SetNoteRequest
+	if err := json.Unmarshal(payloadBytes, &request); err != nil {
+		log.Error("Failed to unmarshal payload", zap.Error(err))
+		h.sendError(connection, "Invalid payload format")
+		return
+	}
+
+	if err := h.action.Execute(ctx, gameID, playerID, request.Note); err != nil {
+		log.Error("Failed to execute set note action", zap.Error(err))
+		h.sendError(connection, err.Error())
+		return
+	}
+
+	log.Info("✅ Set note action completed successfully")
+
+	// Notes are private, so only the owner gets the new state
+	h.broadcaster.BroadcastGameState(gameID, []string{playerID})
+	log.Debug("📡 Broadcasted game state to player")
+}
+
+func (h *SetNoteHandler) sendError(connection *core.Connection, errorMessage string) {
+	connection.SendMessage(dto.WebSocketMessage{
+		Type:    dto.MessageTypeError,
+		Payload: dto.ErrorPayload{Message: errorMessage},
+	})
+}
@@ -0,0 +1,88 @@
+package game
+
+import (
+	"context"
+	"encoding/json"
+
+	gameaction "terraforming-mars-backend/internal/action/game"
+	"terraforming-mars-backend/internal/delivery/dto"
+	"terraforming-mars-backend/internal/delivery/websocket/core"
+	"terraforming-mars-backend/internal/i18n"
+	"terraforming-mars-backend/internal/logger"
+
+	"go.uber.org/zap"
+)
+
+// SetPreferencesHandler handles a player's requests to change their own preferences
+type SetPreferencesHandler struct {
+	action      *gameaction.SetPreferencesAction
+	broadcaster Broadcaster
+	logger      *zap.Logger
+}
+
+// NewSetPreferencesHandler creates a new set preferences handler
+func NewSetPreferencesHandler(action *gameaction.SetPreferencesAction, broadcaster Broadcaster) *SetPreferencesHandler {
+	return &SetPreferencesHandler{
+		action:      action,
+		broadcaster: broadcaster,
+		logger:      logger.Get(),
+	}
+}
+
+// HandleMessage implements the MessageHandler interface
+func (h *SetPreferencesHandler) HandleMessage(ctx context.Context, connection *core.Connection, message dto.WebSocketMessage) {
+	log := h.logger.With(
+		zap.String("connection_id", connection.ID),
+		zap.String("message_type", string(message.Type)),
+	)
+
+	log.Info("⚙️ Processing set preferences request")
+
+	playerID, gameID := connection.GetPlayer()
+	if gameID == "" || playerID == "" {
+		log.Error("Missing connection context")
+		h.sendError(connection, "Not connected to a game")
+		return
+	}
+
+	payloadBytes, err := json.Marshal(message.Payload)
+	if err != nil {
+		log.Error("Failed to marshal payload", zap.Error(err))
+		h.sendError(connection, "Invalid payload format")
+		return
+	}
+
+	var request dto.SetPreferencesRequest
+	if err := json.Unmarshal(payloadBytes, &request); err != nil {
+		log.Error("Failed to unmarshal payload", zap.Error(err))
+		h.sendError(connection, "Invalid payload format")
+		return
+	}
+
+	err = h.action.Execute(ctx, gameID, playerID, gameaction.PlayerPreferences{
+		AutoPass: request.AutoPass,
+		Locale:   request.Locale,
+	})
+	if err != nil {
+		log.Error("Failed to execute set preferences action", zap.Error(err))
+		h.sendError(connection, err.Error())
+		return
+	}
+
+	if request.Locale != "" {
+		connection.SetLocale(i18n.Normalize(request.Locale))
+	}
+
+	log.Info("✅ Set preferences action completed successfully")
+
+	// Preferences only show in the player's own view
+	h.broadcaster.BroadcastGameState(gameID, []string{playerID})
+	log.Debug("📡 Broadcasted game state to player")
+}
+
+func (h *SetPreferencesHandler) sendError(connection *core.Connection, errorMessage string) {
+	connection.SendMessage(dto.WebSocketMessage{
+		Type:    dto.MessageTypeError,
+		Payload: dto.ErrorPayload{Message: errorMessage},
+	})
+}
@@ -0,0 +1,64 @@
+package game
+
+import (
+	"context"
+
+	gameaction "terraforming-mars-backend/internal/action/game"
+	"terraforming-mars-backend/internal/delivery/dto"
+	"terraforming-mars-backend/internal/delivery/websocket/core"
+	"terraforming-mars-backend/internal/logger"
+
+	"go.uber.org/zap"
+)
+
+// VoteAbandonHandler handles votes to abandon a game
+type VoteAbandonHandler struct {
+	action      *gameaction.VoteAbandonAction
+	broadcaster Broadcaster
+	logger      *zap.Logger
+}
+
+// NewVoteAbandonHandler creates a new vote abandon handler
+func NewVoteAbandonHandler(action *gameaction.VoteAbandonAction, broadcaster Broadcaster) *VoteAbandonHandler {
+	return &VoteAbandonHandler{
+		action:      action,
+		broadcaster: broadcaster,
+		logger:      logger.Get(),
+	}
+}
+
+// HandleMessage implements the MessageHandler interface
+func (h *VoteAbandonHandler) HandleMessage(ctx context.Context, connection *core.Connection, message dto.WebSocketMessage) {
+	log := h.logger.With(
+		zap.String("connection_id", connection.ID),
+		zap.String("message_type", string(message.Type)),
+	)
+
+	log.Info("🗳️ Processing vote abandon request")
+
+	playerID, gameID := connection.GetPlayer()
+	if gameID == "" || playerID == "" {
+		log.Error("Missing connection context")
+		h.sendError(connection, "Not connected to a game")
+		return
+	}
+
+	if err := h.action.Execute(ctx, gameID, playerID); err != nil {
+		log.Error("Failed to execute vote abandon action", zap.Error(err))
+		h.sendError(connection, err.Error())
+		return
+	}
+
+	log.Info("✅ Vote abandon action completed successfully")
+
+	// Votes are visible to everyone, so the state is broadcast even when the game did not change yet
+	h.broadcaster.BroadcastGameState(gameID, nil)
+	log.Debug("📡 Broadcasted game state to all players")
+}
+
+func (h *VoteAbandonHandler) sendError(connection *core.Connection, errorMessage string) {
+	connection.SendMessage(dto.WebSocketMessage{
+		Type:    dto.MessageTypeError,
+		Payload: dto.ErrorPayload{Message: errorMessage},
+	})
+}
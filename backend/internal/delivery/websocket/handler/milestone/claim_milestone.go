@@ -3,6 +3,7 @@ package milestone
 import (
 	"context"
 	"encoding/json"
+	"errors"
 
 	milestoneaction "terraforming-mars-backend/internal/action/milestone"
 	"terraforming-mars-backend/internal/delivery/dto"
@@ -87,24 +88,30 @@ func (h *ClaimMilestoneHandler) HandleMessage(ctx context.Context, connection *c
 	log.Debug("📡 Broadcasted game state to all players")
 
 	response := dto.WebSocketMessage{
-		Type:   "action-success",
+		Type:   dto.MessageTypeActionSuccess,
 		GameID: connection.GameID,
-		Payload: map[string]interface{}{
-			"action":        "claim-milestone",
-			"milestoneType": payload.MilestoneType,
-			"success":       true,
+		Payload: dto.ActionSuccessPayload{
+			Action:        "claim-milestone",
+			MilestoneType: payload.MilestoneType,
 		},
 	}
 
-	connection.Send <- response
+	connection.SendMessage(response)
 }
 
 // sendError sends an error message to the client
-func (h *ClaimMilestoneHandler) sendError(connection *core.Connection, errorMessage string) {
-	connection.Send <- dto.WebSocketMessage{
-		Type: dto.MessageTypeError,
-		Payload: map[string]interface{}{
-			"error": errorMessage,
-		},
+// RunStep implements the StepHandler interface
+func (h *ClaimMilestoneHandler) RunStep(ctx context.Context, gameID string, playerID string, payload map[string]interface{}) error {
+	milestoneType, _ := payload["milestoneType"].(string)
+	if milestoneType == "" {
+		return errors.New("milestone type is required")
 	}
+	return h.action.Execute(ctx, gameID, playerID, milestoneType)
+}
+
+func (h *ClaimMilestoneHandler) sendError(connection *core.Connection, errorMessage string) {
+	connection.SendMessage(dto.WebSocketMessage{
+		Type:    dto.MessageTypeError,
+		Payload: dto.ErrorPayload{Message: errorMessage},
+	})
 }
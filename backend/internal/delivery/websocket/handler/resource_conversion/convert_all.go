@@ -0,0 +1,79 @@
+package resource_conversion
+
+import (
+	"context"
+
+	resconvaction "terraforming-mars-backend/internal/action/resource_conversion"
+	"terraforming-mars-backend/internal/delivery/dto"
+	"terraforming-mars-backend/internal/delivery/websocket/core"
+	"terraforming-mars-backend/internal/logger"
+
+	"go.uber.org/zap"
+)
+
+// ConvertAllHandler handles convert all requests
+type ConvertAllHandler struct {
+	action      *resconvaction.ConvertAllAction
+	broadcaster Broadcaster
+	logger      *zap.Logger
+}
+
+// NewConvertAllHandler creates a new convert all handler
+func NewConvertAllHandler(action *resconvaction.ConvertAllAction, broadcaster Broadcaster) *ConvertAllHandler {
+	return &ConvertAllHandler{
+		action:      action,
+		broadcaster: broadcaster,
+		logger:      logger.Get(),
+	}
+}
+
+// HandleMessage implements the MessageHandler interface
+func (h *ConvertAllHandler) HandleMessage(ctx context.Context, connection *core.Connection, message dto.WebSocketMessage) {
+	log := h.logger.With(
+		zap.String("connection_id", connection.ID),
+		zap.String("message_type", string(message.Type)),
+	)
+
+	log.Info("♻️ Processing convert all request (migrated)")
+
+	if connection.GameID == "" || connection.PlayerID == "" {
+		log.Error("Missing connection context")
+		h.sendError(connection, "Not connected to a game")
+		return
+	}
+
+	_, err := h.action.Execute(ctx, connection.GameID, connection.PlayerID)
+	if err != nil {
+		log.Error("Failed to execute convert all action", zap.Error(err))
+		h.sendError(connection, err.Error())
+		return
+	}
+
+	log.Info("✅ Convert heat action completed successfully")
+
+	h.broadcaster.BroadcastGameState(connection.GameID, nil)
+	log.Debug("📡 Broadcasted game state to all players")
+
+	response := dto.WebSocketMessage{
+		Type:   dto.MessageTypeActionSuccess,
+		GameID: connection.GameID,
+		Payload: dto.ActionSuccessPayload{
+			Action: "convert-all",
+		},
+	}
+
+	connection.SendMessage(response)
+}
+
+// RunStep implements the StepHandler interface
+func (h *ConvertAllHandler) RunStep(ctx context.Context, gameID string, playerID string, payload map[string]interface{}) error {
+	_, err := h.action.Execute(ctx, gameID, playerID)
+	return err
+}
+
+func (h *ConvertAllHandler) sendError(connection *core.Connection, errorMessage string) {
+	connection.SendMessage(dto.WebSocketMessage{
+		Type:    dto.MessageTypeError,
+		Payload: dto.ErrorPayload{Message: errorMessage},
+	})
+}
@@ -55,22 +55,24 @@ func (h *BuildPowerPlantHandler) HandleMessage(ctx context.Context, connection *
 	log.Debug("📡 Broadcasted game state to all players")
 
 	response := dto.WebSocketMessage{
-		Type:   "action-success",
+		Type:   dto.MessageTypeActionSuccess,
 		GameID: connection.GameID,
-		Payload: map[string]interface{}{
-			"action":  "build-power-plant",
-			"success": true,
+		Payload: dto.ActionSuccessPayload{
+			Action: "build-power-plant",
 		},
 	}
 
-	connection.Send <- response
+	connection.SendMessage(response)
+}
+
+// RunStep implements the StepHandler interface
+func (h *BuildPowerPlantHandler) RunStep(ctx context.Context, gameID string, playerID string, payload map[string]interface{}) error {
+	return h.action.Execute(ctx, gameID, playerID)
 }
 
 func (h *BuildPowerPlantHandler) sendError(connection *core.Connection, errorMessage string) {
-	connection.Send <- dto.WebSocketMessage{
-		Type: dto.MessageTypeError,
-		Payload: map[string]interface{}{
-			"error": errorMessage,
-		},
-	}
+	connection.SendMessage(dto.WebSocketMessage{
+		Type:    dto.MessageTypeError,
+		Payload: dto.ErrorPayload{Message: errorMessage},
+	})
 }
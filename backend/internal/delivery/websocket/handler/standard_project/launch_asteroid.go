@@ -55,23 +55,25 @@ func (h *LaunchAsteroidHandler) HandleMessage(ctx context.Context, connection *c
 	log.Debug("📡 Broadcasted game state to all players")
 
 	response := dto.WebSocketMessage{
-		Type:   "action-success",
+		Type:   dto.MessageTypeActionSuccess,
 		GameID: connection.GameID,
-		Payload: map[string]interface{}{
-			"action":  "launch-asteroid",
-			"success": true,
+		Payload: dto.ActionSuccessPayload{
+			Action: "launch-asteroid",
 		},
 	}
 
-	connection.Send <- response
+	connection.SendMessage(response)
 }
 
 // sendError sends an error message to the client
+// RunStep implements the StepHandler interface
+func (h *LaunchAsteroidHandler) RunStep(ctx context.Context, gameID string, playerID string, payload map[string]interface{}) error {
+	return h.action.Execute(ctx, gameID, playerID)
+}
+
 func (h *LaunchAsteroidHandler) sendError(connection *core.Connection, errorMessage string) {
-	connection.Send <- dto.WebSocketMessage{
-		Type: dto.MessageTypeError,
-		Payload: map[string]interface{}{
-			"error": errorMessage,
-		},
-	}
+	connection.SendMessage(dto.WebSocketMessage{
+		Type:    dto.MessageTypeError,
+		Payload: dto.ErrorPayload{Message: errorMessage},
+	})
 }
@@ -2,6 +2,7 @@ package tile
 
 import (
 	"context"
+	"errors"
 
 	tileaction "terraforming-mars-backend/internal/action/tile"
 	"terraforming-mars-backend/internal/delivery/dto"
@@ -76,23 +77,30 @@ func (h *SelectTileHandler) HandleMessage(ctx context.Context, connection *core.
 	log.Debug("📡 Broadcasted game state to all players")
 
 	response := dto.WebSocketMessage{
-		Type:   "action-success",
+		Type:   dto.MessageTypeActionSuccess,
 		GameID: connection.GameID,
-		Payload: map[string]interface{}{
-			"action":  "select-tile",
-			"success": true,
-			"hex":     selectedHex,
+		Payload: dto.ActionSuccessPayload{
+			Action: "select-tile",
+			Hex:    selectedHex,
 		},
 	}
 
-	connection.Send <- response
+	connection.SendMessage(response)
 }
 
-func (h *SelectTileHandler) sendError(connection *core.Connection, errorMessage string) {
-	connection.Send <- dto.WebSocketMessage{
-		Type: dto.MessageTypeError,
-		Payload: map[string]interface{}{
-			"error": errorMessage,
-		},
+// RunStep implements the StepHandler interface
+func (h *SelectTileHandler) RunStep(ctx context.Context, gameID string, playerID string, payload map[string]interface{}) error {
+	selectedHex, ok := payload["hex"].(string)
+	if !ok || selectedHex == "" {
+		return errors.New("missing hex position")
 	}
+	_, err := h.action.Execute(ctx, gameID, playerID, selectedHex)
+	return err
+}
+
+func (h *SelectTileHandler) sendError(connection *core.Connection, errorMessage string) {
+	connection.SendMessage(dto.WebSocketMessage{
+		Type:    dto.MessageTypeError,
+		Payload: dto.ErrorPayload{Message: errorMessage},
+	})
 }
@@ -0,0 +1,72 @@
+package turn_management
+
+import (
+	"context"
+
+	turnaction "terraforming-mars-backend/internal/action/turn_management"
+	"terraforming-mars-backend/internal/delivery/dto"
+	"terraforming-mars-backend/internal/delivery/websocket/core"
+	"terraforming-mars-backend/internal/logger"
+
+	"go.uber.org/zap"
+)
+
+// ConcedeHandler handles concede requests
+type ConcedeHandler struct {
+	action      *turnaction.ConcedeAction
+	broadcaster Broadcaster
+	logger      *zap.Logger
+}
+
+// NewConcedeHandler creates a new concede handler
+func NewConcedeHandler(action *turnaction.ConcedeAction, broadcaster Broadcaster) *ConcedeHandler {
+	return &ConcedeHandler{
+		action:      action,
+		broadcaster: broadcaster,
+		logger:      logger.Get(),
+	}
+}
+
+// HandleMessage implements the MessageHandler interface
+func (h *ConcedeHandler) HandleMessage(ctx context.Context, connection *core.Connection, message dto.WebSocketMessage) {
+	log := h.logger.With(
+		zap.String("connection_id", connection.ID),
+		zap.String("message_type", string(message.Type)),
+	)
+
+	log.Info("🏳️ Processing concede request")
+
+	playerID, gameID := connection.GetPlayer()
+	if gameID == "" || playerID == "" {
+		log.Error("Missing connection context")
+		h.sendError(connection, "Not connected to a game")
+		return
+	}
+
+	if err := h.action.Execute(ctx, gameID, playerID); err != nil {
+		log.Error("Failed to execute concede action", zap.Error(err))
+		h.sendError(connection, err.Error())
+		return
+	}
+
+	log.Info("✅ Concede action completed successfully")
+
+	// The conceding player is no longer seated, so only the remaining players receive the new state
+	h.broadcaster.BroadcastGameState(gameID, nil)
+	log.Debug("📡 Broadcasted game state to remaining players")
+
+	connection.SendMessage(dto.WebSocketMessage{
+		Type:   dto.MessageTypeActionSuccess,
+		GameID: gameID,
+		Payload: dto.ActionSuccessPayload{
+			Action: "concede",
+		},
+	})
+}
+
+func (h *ConcedeHandler) sendError(connection *core.Connection, errorMessage string) {
+	connection.SendMessage(dto.WebSocketMessage{
+		Type:    dto.MessageTypeError,
+		Payload: dto.ErrorPayload{Message: errorMessage},
+	})
+}
@@ -0,0 +1,71 @@
+package turn_management
+
+import (
+	"context"
+
+	turnaction "terraforming-mars-backend/internal/action/turn_management"
+	"terraforming-mars-backend/internal/delivery/dto"
+	"terraforming-mars-backend/internal/delivery/websocket/core"
+	"terraforming-mars-backend/internal/logger"
+
+	"go.uber.org/zap"
+)
+
+// MulliganStartingHandHandler handles requests to redraw the starting project cards
+type MulliganStartingHandHandler struct {
+	action      *turnaction.MulliganStartingHandAction
+	broadcaster Broadcaster
+	logger      *zap.Logger
+}
+
+// NewMulliganStartingHandHandler creates a new mulligan starting hand handler
+func NewMulliganStartingHandHandler(action *turnaction.MulliganStartingHandAction, broadcaster Broadcaster) *MulliganStartingHandHandler {
+	return &MulliganStartingHandHandler{
+		action:      action,
+		broadcaster: broadcaster,
+		logger:      logger.Get(),
+	}
+}
+
+// HandleMessage implements the MessageHandler interface
+func (h *MulliganStartingHandHandler) HandleMessage(ctx context.Context, connection *core.Connection, message dto.WebSocketMessage) {
+	log := h.logger.With(
+		zap.String("connection_id", connection.ID),
+		zap.String("message_type", string(message.Type)),
+	)
+
+	log.Info("🔁 Processing mulligan starting hand request")
+
+	playerID, gameID := connection.GetPlayer()
+	if gameID == "" || playerID == "" {
+		log.Error("Missing connection context")
+		h.sendError(connection, "Not connected to a game")
+		return
+	}
+
+	if err := h.action.Execute(ctx, gameID, playerID); err != nil {
+		log.Error("Failed to execute mulligan starting hand action", zap.Error(err))
+		h.sendError(connection, err.Error())
+		return
+	}
+
+	log.Info("✅ Mulligan starting hand action completed successfully")
+
+	h.broadcaster.BroadcastGameState(gameID, nil)
+	log.Debug("📡 Broadcasted game state to all players")
+
+	connection.SendMessage(dto.WebSocketMessage{
+		Type:   dto.MessageTypeActionSuccess,
+		GameID: gameID,
+		Payload: dto.ActionSuccessPayload{
+			Action: "mulligan-starting-hand",
+		},
+	})
+}
+
+func (h *MulliganStartingHandHandler) sendError(connection *core.Connection, errorMessage string) {
+	connection.SendMessage(dto.WebSocketMessage{
+		Type:    dto.MessageTypeError,
+		Payload: dto.ErrorPayload{Message: errorMessage},
+	})
+}
@@ -54,23 +54,16 @@ func (h *SelectStartingCardsHandler) HandleMessage(ctx context.Context, connecti
 		return
 	}
 
-	var cardIDs []string
-	if cardIDsInterface, ok := payloadMap["cardIds"].([]interface{}); ok {
-		cardIDs = make([]string, len(cardIDsInterface))
-		for i, cardID := range cardIDsInterface {
-			if cardIDStr, ok := cardID.(string); ok {
-				cardIDs[i] = cardIDStr
-			}
-		}
-	}
-
+	cardIDs := parseStringList(payloadMap["cardIds"])
+	preludeIDs := parseStringList(payloadMap["preludeIds"])
 	corporationID, _ := payloadMap["corporationId"].(string)
 
 	log.Debug("Parsed select starting cards request",
 		zap.Strings("card_ids", cardIDs),
-		zap.String("corporation_id", corporationID))
+		zap.String("corporation_id", corporationID),
+		zap.Strings("prelude_ids", preludeIDs))
 
-	err := h.action.Execute(ctx, connection.GameID, connection.PlayerID, cardIDs, corporationID)
+	err := h.action.Execute(ctx, connection.GameID, connection.PlayerID, cardIDs, corporationID, preludeIDs)
 	if err != nil {
 		log.Error("Failed to execute select starting cards action", zap.Error(err))
 		h.sendError(connection, err.Error())
@@ -83,22 +76,34 @@ func (h *SelectStartingCardsHandler) HandleMessage(ctx context.Context, connecti
 	log.Debug("📡 Broadcasted game state to all players")
 
 	response := dto.WebSocketMessage{
-		Type:   "action-success",
+		Type:   dto.MessageTypeActionSuccess,
 		GameID: connection.GameID,
-		Payload: map[string]interface{}{
-			"action":  "select-starting-cards",
-			"success": true,
+		Payload: dto.ActionSuccessPayload{
+			Action: "select-starting-cards",
 		},
 	}
 
-	connection.Send <- response
+	connection.SendMessage(response)
 }
 
-func (h *SelectStartingCardsHandler) sendError(connection *core.Connection, errorMessage string) {
-	connection.Send <- dto.WebSocketMessage{
-		Type: dto.MessageTypeError,
-		Payload: map[string]interface{}{
-			"error": errorMessage,
-		},
+// parseStringList reads a JSON array of strings from a decoded payload
+func parseStringList(value interface{}) []string {
+	items, ok := value.([]interface{})
+	if !ok {
+		return nil
 	}
+	result := make([]string, len(items))
+	for i, item := range items {
+		if str, ok := item.(string); ok {
+			result[i] = str
+		}
+	}
+	return result
+}
+
+func (h *SelectStartingCardsHandler) sendError(connection *core.Connection, errorMessage string) {
+	connection.SendMessage(dto.WebSocketMessage{
+		Type:    dto.MessageTypeError,
+		Payload: dto.ErrorPayload{Message: errorMessage},
+	})
 }
@@ -2,6 +2,8 @@ package turn_management
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 
 	turnaction "terraforming-mars-backend/internal/action/turn_management"
 	"terraforming-mars-backend/internal/delivery/dto"
@@ -42,7 +44,43 @@ func (h *SkipActionHandler) HandleMessage(ctx context.Context, connection *core.
 		return
 	}
 
-	err := h.action.Execute(ctx, connection.GameID, connection.PlayerID)
+	var request dto.SkipActionRequest
+	if message.Payload != nil {
+		payloadBytes, err := json.Marshal(message.Payload)
+		if err != nil {
+			log.Error("Failed to marshal payload", zap.Error(err))
+			h.sendError(connection, "Invalid payload format")
+			return
+		}
+		if err := json.Unmarshal(payloadBytes, &request); err != nil {
+			log.Error("Failed to unmarshal payload", zap.Error(err))
+			h.sendError(connection, "Invalid payload format")
+			return
+		}
+	}
+
+	var err error
+	if request.Confirmed {
+		err = h.action.ExecuteConfirmed(ctx, connection.GameID, connection.PlayerID)
+	} else {
+		err = h.action.Execute(ctx, connection.GameID, connection.PlayerID)
+	}
+
+	var pending *turnaction.ConvertiblesPendingError
+	if errors.As(err, &pending) {
+		log.Info("🌱 Pass held back, plants or heat can still be converted")
+		connection.SendMessage(dto.WebSocketMessage{
+			Type:   dto.MessageTypeConfirmPassWithConvertibles,
+			GameID: connection.GameID,
+			Payload: dto.ConfirmPassWithConvertiblesPayload{
+				Greeneries:       pending.Convertibles.Greeneries,
+				TemperatureSteps: pending.Convertibles.TemperatureSteps,
+				PlantCost:        pending.Convertibles.PlantCost,
+				HeatCost:         pending.Convertibles.HeatCost,
+			},
+		})
+		return
+	}
 	if err != nil {
 		log.Error("Failed to execute skip action", zap.Error(err))
 		h.sendError(connection, err.Error())
@@ -55,22 +93,27 @@ func (h *SkipActionHandler) HandleMessage(ctx context.Context, connection *core.
 	log.Debug("📡 Broadcasted game state to all players")
 
 	response := dto.WebSocketMessage{
-		Type:   "action-success",
+		Type:   dto.MessageTypeActionSuccess,
 		GameID: connection.GameID,
-		Payload: map[string]interface{}{
-			"action":  "skip-action",
-			"success": true,
+		Payload: dto.ActionSuccessPayload{
+			Action: "skip-action",
 		},
 	}
 
-	connection.Send <- response
+	connection.SendMessage(response)
 }
 
-func (h *SkipActionHandler) sendError(connection *core.Connection, errorMessage string) {
-	connection.Send <- dto.WebSocketMessage{
-		Type: dto.MessageTypeError,
-		Payload: map[string]interface{}{
-			"error": errorMessage,
-		},
+// RunStep implements the StepHandler interface
+func (h *SkipActionHandler) RunStep(ctx context.Context, gameID string, playerID string, payload map[string]interface{}) error {
+	if confirmed, _ := payload["confirmed"].(bool); confirmed {
+		return h.action.ExecuteConfirmed(ctx, gameID, playerID)
 	}
+	return h.action.Execute(ctx, gameID, playerID)
+}
+
+func (h *SkipActionHandler) sendError(connection *core.Connection, errorMessage string) {
+	connection.SendMessage(dto.WebSocketMessage{
+		Type:    dto.MessageTypeError,
+		Payload: dto.ErrorPayload{Message: errorMessage},
+	})
 }
@@ -0,0 +1,65 @@
+package websocket
+
+import (
+	"context"
+	"encoding/json"
+
+	"terraforming-mars-backend/internal/delivery/dto"
+	"terraforming-mars-backend/internal/delivery/websocket/core"
+	"terraforming-mars-backend/internal/game"
+	"terraforming-mars-backend/internal/logger"
+
+	"go.uber.org/zap"
+)
+
+// unrecordedInputs never change what a replay checks, so they are left out of the input journal
+// Games are created from their archived settings, so creation is not replayed either
+var unrecordedInputs = map[dto.MessageType]bool{
+	dto.MessageTypeCreateGame:          true,
+	dto.MessageTypeSyncRequest:         true,
+	dto.MessageTypeSubscribe:           true,
+	dto.MessageTypeActionSetNote:       true,
+	dto.MessageTypeActionMuteReactions: true,
+}
+
+// InputRecorder journals every message a game's handlers have handled, with the state it left behind,
+// so an archived game can be replayed through a later engine (see internal/replay)
+type InputRecorder struct {
+	gameRepo  game.GameRepository
+	stateRepo game.GameStateRepository
+	logger    *zap.Logger
+}
+
+// NewInputRecorder creates the recorder the hub passes every handled message to
+func NewInputRecorder(gameRepo game.GameRepository, stateRepo game.GameStateRepository) *InputRecorder {
+	return &InputRecorder{
+		gameRepo:  gameRepo,
+		stateRepo: stateRepo,
+		logger:    logger.Get(),
+	}
+}
+
+// RecordInput implements the core.InputRecorder interface
+func (r *InputRecorder) RecordInput(ctx context.Context, connection *core.Connection, message dto.WebSocketMessage) {
+	if unrecordedInputs[message.Type] {
+		return
+	}
+	playerID, gameID := connection.GetPlayer()
+	if gameID == "" {
+		return
+	}
+	g, err := r.gameRepo.Get(ctx, gameID)
+	if err != nil {
+		return
+	}
+
+	log := r.logger.With(zap.String("game_id", gameID), zap.String("message_type", string(message.Type)))
+	payload, err := json.Marshal(message.Payload)
+	if err != nil {
+		log.Warn("Failed to record input", zap.Error(err))
+		return
+	}
+	if _, err := r.stateRepo.RecordInput(ctx, gameID, g, playerID, string(message.Type), payload); err != nil {
+		log.Warn("Failed to record input", zap.Error(err))
+	}
+}
@@ -0,0 +1,55 @@
+package websocket
+
+import (
+	"context"
+
+	"terraforming-mars-backend/internal/delivery/dto"
+	"terraforming-mars-backend/internal/delivery/websocket/core"
+	"terraforming-mars-backend/internal/game"
+)
+
+// pauseGuard rejects gameplay messages for a paused game, or for a game waiting on responses to an
+// effect, before they reach their handler. Answering a response and conceding are let through while waiting
+// Actions also refuse these games themselves; the guard only adds the error codes clients rely on
+type pauseGuard struct {
+	next     core.MessageHandler
+	gameRepo game.GameRepository
+}
+
+func newPauseGuard(next core.MessageHandler, gameRepo game.GameRepository) *pauseGuard {
+	return &pauseGuard{next: next, gameRepo: gameRepo}
+}
+
+// HandleMessage implements the MessageHandler interface
+func (g *pauseGuard) HandleMessage(ctx context.Context, connection *core.Connection, message dto.WebSocketMessage) {
+	_, gameID := connection.GetPlayer()
+	if gameID != "" {
+		if current, err := g.gameRepo.Get(ctx, gameID); err == nil {
+			switch {
+			case current.IsPaused():
+				g.reject(connection, gameID, game.ErrGamePaused, dto.ErrCodeGamePaused)
+				return
+			case current.AwaitingResponses() && !allowedWhileAwaitingResponses(message.Type):
+				g.reject(connection, gameID, game.ErrAwaitingResponse, dto.ErrCodeAwaitingResponse)
+				return
+			}
+		}
+	}
+
+	g.next.HandleMessage(ctx, connection, message)
+}
+
+func (g *pauseGuard) reject(connection *core.Connection, gameID string, err error, code string) {
+	connection.SendMessage(dto.WebSocketMessage{
+		Type:   dto.MessageTypeError,
+		GameID: gameID,
+		Payload: dto.ErrorPayload{
+			Message: err.Error(),
+			Code:    code,
+		},
+	})
+}
+
+func allowedWhileAwaitingResponses(messageType dto.MessageType) bool {
+	return messageType == dto.MessageTypeActionRespondToEffect || messageType == dto.MessageTypeActionConcede
+}
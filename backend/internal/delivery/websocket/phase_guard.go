@@ -0,0 +1,59 @@
+package websocket
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"terraforming-mars-backend/internal/delivery/dto"
+	"terraforming-mars-backend/internal/delivery/websocket/core"
+	"terraforming-mars-backend/internal/game"
+)
+
+// PhaseGuard rejects client actions sent in a phase the action phase matrix (dto.AllowedPhases) does not list,
+// with ERR_WRONG_PHASE and the phases the action is legal in, so no handler has to repeat the check
+// Messages the matrix does not cover, and messages for games that cannot be found, pass through to their handlers
+type PhaseGuard struct {
+	gameRepo game.GameRepository
+}
+
+// NewPhaseGuard creates the guard the hub runs before every handler
+func NewPhaseGuard(gameRepo game.GameRepository) *PhaseGuard {
+	return &PhaseGuard{gameRepo: gameRepo}
+}
+
+// Allow implements the core.MessageGuard interface
+func (g *PhaseGuard) Allow(ctx context.Context, connection *core.Connection, message dto.WebSocketMessage) bool {
+	expected, covered := dto.AllowedPhases(message.Type)
+	if !covered {
+		return true
+	}
+	_, gameID := connection.GetPlayer()
+	if gameID == "" {
+		return true
+	}
+	current, err := g.gameRepo.Get(ctx, gameID)
+	if err != nil {
+		return true
+	}
+
+	phase := dto.GamePhase(current.CurrentPhase())
+	if dto.PhaseAllows(message.Type, phase) {
+		return true
+	}
+
+	names := make([]string, len(expected))
+	for i, p := range expected {
+		names[i] = string(p)
+	}
+	connection.SendMessage(dto.WebSocketMessage{
+		Type:   dto.MessageTypeError,
+		GameID: gameID,
+		Payload: dto.ErrorPayload{
+			Message:        fmt.Sprintf("game not in %s phase", strings.Join(names, "/")),
+			Code:           dto.ErrCodeWrongPhase,
+			ExpectedPhases: expected,
+		},
+	})
+	return false
+}
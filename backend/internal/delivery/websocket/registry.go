@@ -38,7 +38,22 @@ func RegisterHandlers(
 	createGameAction *gameAction.CreateGameAction,
 	joinGameAction *gameAction.JoinGameAction,
 	confirmDemoSetupAction *gameAction.ConfirmDemoSetupAction,
+	setSeatOrderAction *gameAction.SetSeatOrderAction,
+	setHandicapAction *gameAction.SetHandicapAction,
+	setPlayerColorAction *gameAction.SetPlayerColorAction,
+	pauseGameAction *gameAction.PauseGameAction,
+	resumeGameAction *gameAction.ResumeGameAction,
+	voteAbandonAction *gameAction.VoteAbandonAction,
+	setPreferencesAction *gameAction.SetPreferencesAction,
+	forceAdvancePhaseAction *gameAction.ForceAdvancePhaseAction,
+	sendReactionAction *gameAction.SendReactionAction,
+	muteReactionsAction *gameAction.MuteReactionsAction,
+	setNoteAction *gameAction.SetNoteAction,
+	runBatchAction *gameAction.RunBatchAction,
 	playCardAction *cardAction.PlayCardAction,
+	preparePlayCardAction *cardAction.PreparePlayCardAction,
+	commitPlayCardAction *cardAction.CommitPlayCardAction,
+	cancelPlayCardAction *cardAction.CancelPlayCardAction,
 	useCardActionAction *cardAction.UseCardActionAction,
 	launchAsteroidAction *stdprojAction.LaunchAsteroidAction,
 	buildPowerPlantAction *stdprojAction.BuildPowerPlantAction,
@@ -48,13 +63,18 @@ func RegisterHandlers(
 	sellPatentsAction *stdprojAction.SellPatentsAction,
 	convertHeatAction *resconvAction.ConvertHeatToTemperatureAction,
 	convertPlantsAction *resconvAction.ConvertPlantsToGreeneryAction,
+	convertAllAction *resconvAction.ConvertAllAction,
 	selectTileAction *tileAction.SelectTileAction,
 	startGameAction *turnAction.StartGameAction,
 	skipActionAction *turnAction.SkipActionAction,
+	concedeAction *turnAction.ConcedeAction,
+	autoPassAction *turnAction.AutoPassAction,
 	selectStartingCardsAction *turnAction.SelectStartingCardsAction,
+	mulliganStartingHandAction *turnAction.MulliganStartingHandAction,
 	confirmSellPatentsAction *confirmAction.ConfirmSellPatentsAction,
 	confirmProductionCardsAction *confirmAction.ConfirmProductionCardsAction,
 	confirmCardDrawAction *confirmAction.ConfirmCardDrawAction,
+	respondToEffectAction *confirmAction.RespondToEffectAction,
 	playerReconnectedAction *connAction.PlayerReconnectedAction,
 	playerDisconnectedAction *connAction.PlayerDisconnectedAction,
 	playerTakeoverAction *connAction.PlayerTakeoverAction,
@@ -70,10 +90,29 @@ func RegisterHandlers(
 	adminSetCorporationAction *adminAction.SetCorporationAction,
 	adminStartTileSelectionAction *adminAction.StartTileSelectionAction,
 	adminSetTRAction *adminAction.SetTRAction,
+	adminSetupTestStateAction *adminAction.SetupTestStateAction,
+	adminSetAwardFreezeAction *adminAction.SetAwardFreezeAction,
 ) {
 	log := logger.Get()
 	log.Info("🔄 Registering migration handlers with explicit broadcasting")
 
+	// Any gameplay message may start a turn, so auto-pass is checked after it
+	withAutoPass := func(handler core.MessageHandler) core.MessageHandler {
+		return newAutoPassHook(handler, autoPassAction, broadcaster)
+	}
+
+	// Gameplay handlers are rejected with ERR_GAME_PAUSED while their game is paused,
+	// and with ERR_AWAITING_RESPONSE while it waits on responses to an effect
+	gameplay := func(handler core.MessageHandler) core.MessageHandler {
+		return newPauseGuard(withAutoPass(handler), broadcaster.gameRepo)
+	}
+
+	// Client actions are checked against the action phase matrix before any handler runs
+	hub.SetGuard(NewPhaseGuard(broadcaster.gameRepo))
+
+	// Every handled message is journaled with the state it left behind, so archived games can be replayed
+	hub.SetInputRecorder(NewInputRecorder(broadcaster.gameRepo, broadcaster.stateRepo))
+
 	createGameHandler := game.NewCreateGameHandler(createGameAction, broadcaster)
 	hub.RegisterHandler(dto.MessageTypeCreateGame, createGameHandler)
 
@@ -84,56 +123,112 @@ func RegisterHandlers(
 	confirmDemoSetupHandler := game.NewConfirmDemoSetupHandler(confirmDemoSetupAction, broadcaster)
 	hub.RegisterHandler(dto.MessageTypeActionConfirmDemoSetup, confirmDemoSetupHandler)
 
+	setSeatOrderHandler := game.NewSetSeatOrderHandler(setSeatOrderAction, broadcaster)
+	hub.RegisterHandler(dto.MessageTypeActionSetSeatOrder, setSeatOrderHandler)
+
+	setHandicapHandler := game.NewSetHandicapHandler(setHandicapAction, broadcaster)
+	hub.RegisterHandler(dto.MessageTypeActionSetHandicap, setHandicapHandler)
+
+	setPlayerColorHandler := game.NewSetPlayerColorHandler(setPlayerColorAction, broadcaster)
+	hub.RegisterHandler(dto.MessageTypeActionSetPlayerColor, setPlayerColorHandler)
+
+	pauseGameHandler := game.NewPauseGameHandler(pauseGameAction, broadcaster)
+	hub.RegisterHandler(dto.MessageTypeActionPauseGame, pauseGameHandler)
+
+	resumeGameHandler := game.NewResumeGameHandler(resumeGameAction, broadcaster)
+	hub.RegisterHandler(dto.MessageTypeActionResumeGame, resumeGameHandler)
+
+	voteAbandonHandler := game.NewVoteAbandonHandler(voteAbandonAction, broadcaster)
+	hub.RegisterHandler(dto.MessageTypeActionVoteAbandon, voteAbandonHandler)
+
+	setPreferencesHandler := game.NewSetPreferencesHandler(setPreferencesAction, broadcaster)
+	hub.RegisterHandler(dto.MessageTypeActionSetPreferences, withAutoPass(setPreferencesHandler))
+
+	forceAdvancePhaseHandler := game.NewForceAdvancePhaseHandler(forceAdvancePhaseAction, broadcaster)
+	hub.RegisterHandler(dto.MessageTypeActionForceAdvancePhase, gameplay(forceAdvancePhaseHandler))
+
+	// Reactions change nothing in the game, so they stay open while paused and in the lobby
+	sendReactionHandler := game.NewSendReactionHandler(sendReactionAction, broadcaster)
+	hub.RegisterHandler(dto.MessageTypeActionSendReaction, sendReactionHandler)
+
+	muteReactionsHandler := game.NewMuteReactionsHandler(muteReactionsAction, broadcaster)
+	hub.RegisterHandler(dto.MessageTypeActionMuteReactions, muteReactionsHandler)
+
+	// Notes are private and change nothing in the game, so they can be edited at any time
+	setNoteHandler := game.NewSetNoteHandler(setNoteAction, broadcaster)
+	hub.RegisterHandler(dto.MessageTypeActionSetNote, setNoteHandler)
+
 	playCardHandler := card.NewPlayCardHandler(playCardAction, broadcaster)
-	hub.RegisterHandler(dto.MessageTypeActionPlayCard, playCardHandler)
+	hub.RegisterHandler(dto.MessageTypeActionPlayCard, gameplay(playCardHandler))
+
+	preparePlayCardHandler := card.NewPreparePlayCardHandler(preparePlayCardAction, broadcaster)
+	hub.RegisterHandler(dto.MessageTypeActionPreparePlayCard, gameplay(preparePlayCardHandler))
+
+	commitPlayCardHandler := card.NewCommitPlayCardHandler(commitPlayCardAction, broadcaster)
+	hub.RegisterHandler(dto.MessageTypeActionCommitPlayCard, gameplay(commitPlayCardHandler))
+
+	cancelPlayCardHandler := card.NewCancelPlayCardHandler(cancelPlayCardAction, broadcaster)
+	hub.RegisterHandler(dto.MessageTypeActionCancelPlayCard, gameplay(cancelPlayCardHandler))
 
 	useCardActionHandler := card.NewUseCardActionHandler(useCardActionAction, broadcaster)
-	hub.RegisterHandler(dto.MessageTypeActionCardAction, useCardActionHandler)
+	hub.RegisterHandler(dto.MessageTypeActionCardAction, gameplay(useCardActionHandler))
 
 	launchAsteroidHandler := standard_project.NewLaunchAsteroidHandler(launchAsteroidAction, broadcaster)
-	hub.RegisterHandler(dto.MessageTypeActionLaunchAsteroid, launchAsteroidHandler)
+	hub.RegisterHandler(dto.MessageTypeActionLaunchAsteroid, gameplay(launchAsteroidHandler))
 
 	buildPowerPlantHandler := standard_project.NewBuildPowerPlantHandler(buildPowerPlantAction, broadcaster)
-	hub.RegisterHandler(dto.MessageTypeActionBuildPowerPlant, buildPowerPlantHandler)
+	hub.RegisterHandler(dto.MessageTypeActionBuildPowerPlant, gameplay(buildPowerPlantHandler))
 
 	buildAquiferHandler := standard_project.NewBuildAquiferHandler(buildAquiferAction, broadcaster)
-	hub.RegisterHandler(dto.MessageTypeActionBuildAquifer, buildAquiferHandler)
+	hub.RegisterHandler(dto.MessageTypeActionBuildAquifer, gameplay(buildAquiferHandler))
 
 	buildCityHandler := standard_project.NewBuildCityHandler(buildCityAction, broadcaster)
-	hub.RegisterHandler(dto.MessageTypeActionBuildCity, buildCityHandler)
+	hub.RegisterHandler(dto.MessageTypeActionBuildCity, gameplay(buildCityHandler))
 
 	plantGreeneryHandler := standard_project.NewPlantGreeneryHandler(plantGreeneryAction, broadcaster)
-	hub.RegisterHandler(dto.MessageTypeActionPlantGreenery, plantGreeneryHandler)
+	hub.RegisterHandler(dto.MessageTypeActionPlantGreenery, gameplay(plantGreeneryHandler))
 
 	sellPatentsHandler := standard_project.NewSellPatentsHandler(sellPatentsAction, broadcaster)
-	hub.RegisterHandler(dto.MessageTypeActionSellPatents, sellPatentsHandler)
+	hub.RegisterHandler(dto.MessageTypeActionSellPatents, gameplay(sellPatentsHandler))
 
 	convertHeatHandler := resource_conversion.NewConvertHeatHandler(convertHeatAction, broadcaster)
-	hub.RegisterHandler(dto.MessageTypeActionConvertHeatToTemperature, convertHeatHandler)
+	hub.RegisterHandler(dto.MessageTypeActionConvertHeatToTemperature, gameplay(convertHeatHandler))
 
 	convertPlantsHandler := resource_conversion.NewConvertPlantsHandler(convertPlantsAction, broadcaster)
-	hub.RegisterHandler(dto.MessageTypeActionConvertPlantsToGreenery, convertPlantsHandler)
+	hub.RegisterHandler(dto.MessageTypeActionConvertPlantsToGreenery, gameplay(convertPlantsHandler))
+
+	convertAllHandler := resource_conversion.NewConvertAllHandler(convertAllAction, broadcaster)
+	hub.RegisterHandler(dto.MessageTypeActionConvertAll, gameplay(convertAllHandler))
 
 	selectTileHandler := tile.NewSelectTileHandler(selectTileAction, broadcaster)
-	hub.RegisterHandler(dto.MessageTypeActionTileSelected, selectTileHandler)
+	hub.RegisterHandler(dto.MessageTypeActionTileSelected, gameplay(selectTileHandler))
 
 	startGameHandler := turn_management.NewStartGameHandler(startGameAction, broadcaster)
 	hub.RegisterHandler(dto.MessageTypeActionStartGame, startGameHandler)
 
 	skipActionHandler := turn_management.NewSkipActionHandler(skipActionAction, broadcaster)
-	hub.RegisterHandler(dto.MessageTypeActionSkipAction, skipActionHandler)
+	hub.RegisterHandler(dto.MessageTypeActionSkipAction, gameplay(skipActionHandler))
+
+	concedeHandler := turn_management.NewConcedeHandler(concedeAction, broadcaster)
+	hub.RegisterHandler(dto.MessageTypeActionConcede, gameplay(concedeHandler))
 
 	selectStartingCardsHandler := turn_management.NewSelectStartingCardsHandler(selectStartingCardsAction, broadcaster)
-	hub.RegisterHandler(dto.MessageTypeActionSelectStartingCard, selectStartingCardsHandler)
+	hub.RegisterHandler(dto.MessageTypeActionSelectStartingCard, gameplay(selectStartingCardsHandler))
+
+	mulliganStartingHandHandler := turn_management.NewMulliganStartingHandHandler(mulliganStartingHandAction, broadcaster)
+	hub.RegisterHandler(dto.MessageTypeActionMulliganStartingHand, gameplay(mulliganStartingHandHandler))
 
 	confirmSellPatentsHandler := confirmation.NewConfirmSellPatentsHandler(confirmSellPatentsAction, broadcaster)
-	hub.RegisterHandler(dto.MessageTypeActionConfirmSellPatents, confirmSellPatentsHandler)
+	hub.RegisterHandler(dto.MessageTypeActionConfirmSellPatents, gameplay(confirmSellPatentsHandler))
 
 	confirmProductionCardsHandler := confirmation.NewConfirmProductionCardsHandler(confirmProductionCardsAction, broadcaster)
-	hub.RegisterHandler(dto.MessageTypeActionConfirmProductionCards, confirmProductionCardsHandler)
+	hub.RegisterHandler(dto.MessageTypeActionConfirmProductionCards, gameplay(confirmProductionCardsHandler))
 
 	confirmCardDrawHandler := confirmation.NewConfirmCardDrawHandler(confirmCardDrawAction, broadcaster)
-	hub.RegisterHandler(dto.MessageTypeActionCardDrawConfirmed, confirmCardDrawHandler)
+	hub.RegisterHandler(dto.MessageTypeActionCardDrawConfirmed, gameplay(confirmCardDrawHandler))
+
+	respondToEffectHandler := confirmation.NewRespondToEffectHandler(respondToEffectAction, broadcaster)
+	hub.RegisterHandler(dto.MessageTypeActionRespondToEffect, gameplay(respondToEffectHandler))
 
 	// NOTE: PlayerReconnectedHandler is NOT registered separately because:
 	// - JoinGameHandler (on 'player-connect') handles BOTH new joins AND reconnections
@@ -151,11 +246,41 @@ func RegisterHandlers(
 	kickPlayerHandler := connection.NewKickPlayerHandler(kickPlayerAction, broadcaster, hub)
 	hub.RegisterHandler(dto.MessageTypeKickPlayer, kickPlayerHandler)
 
+	controlPlayerHandler := connection.NewControlPlayerHandler(broadcaster)
+	hub.RegisterHandler(dto.MessageTypeControlPlayer, controlPlayerHandler)
+
+	syncRequestHandler := connection.NewSyncRequestHandler(broadcaster)
+	hub.RegisterHandler(dto.MessageTypeSyncRequest, syncRequestHandler)
+
+	subscribeHandler := connection.NewSubscribeHandler(broadcaster)
+	hub.RegisterHandler(dto.MessageTypeSubscribe, subscribeHandler)
+
 	claimMilestoneHandler := milestone.NewClaimMilestoneHandler(claimMilestoneAction, broadcaster)
-	hub.RegisterHandler(dto.MessageTypeActionClaimMilestone, claimMilestoneHandler)
+	hub.RegisterHandler(dto.MessageTypeActionClaimMilestone, gameplay(claimMilestoneHandler))
 
 	fundAwardHandler := award.NewFundAwardHandler(fundAwardAction, broadcaster)
-	hub.RegisterHandler(dto.MessageTypeActionFundAward, fundAwardHandler)
+	hub.RegisterHandler(dto.MessageTypeActionFundAward, gameplay(fundAwardHandler))
+
+	// Batches run these handlers' actions as steps; anything that opens a selection for the player
+	// (sell patents, starting cards) or needs another player's answer is left out
+	batchSteps := map[dto.MessageType]core.StepHandler{
+		dto.MessageTypeActionPlayCard:                 playCardHandler,
+		dto.MessageTypeActionCardAction:               useCardActionHandler,
+		dto.MessageTypeActionLaunchAsteroid:           launchAsteroidHandler,
+		dto.MessageTypeActionBuildPowerPlant:          buildPowerPlantHandler,
+		dto.MessageTypeActionBuildAquifer:             buildAquiferHandler,
+		dto.MessageTypeActionBuildCity:                buildCityHandler,
+		dto.MessageTypeActionPlantGreenery:            plantGreeneryHandler,
+		dto.MessageTypeActionConvertHeatToTemperature: convertHeatHandler,
+		dto.MessageTypeActionConvertPlantsToGreenery:  convertPlantsHandler,
+		dto.MessageTypeActionConvertAll:               convertAllHandler,
+		dto.MessageTypeActionTileSelected:             selectTileHandler,
+		dto.MessageTypeActionClaimMilestone:           claimMilestoneHandler,
+		dto.MessageTypeActionFundAward:                fundAwardHandler,
+		dto.MessageTypeActionSkipAction:               skipActionHandler,
+	}
+	runBatchHandler := game.NewRunBatchHandler(runBatchAction, batchSteps, broadcaster)
+	hub.RegisterHandler(dto.MessageTypeActionRunBatch, gameplay(runBatchHandler))
 
 	adminCommandHandler := admin.NewAdminCommandHandler(
 		adminSetPhaseAction,
@@ -167,22 +292,24 @@ func RegisterHandlers(
 		adminSetCorporationAction,
 		adminStartTileSelectionAction,
 		adminSetTRAction,
+		adminSetupTestStateAction,
+		adminSetAwardFreezeAction,
 		broadcaster,
 	)
 	hub.RegisterHandler(dto.MessageTypeAdminCommand, adminCommandHandler)
 
 	log.Info("🎯 Migration handlers registered successfully")
-	log.Info("   ✅ Game Lifecycle (3): create-game, player-connect/join-game, confirm-demo-setup")
-	log.Info("   ✅ Card Actions (2): PlayCard, UseCardAction")
+	log.Info("   ✅ Game Lifecycle (15): create-game, player-connect/join-game, confirm-demo-setup, set-seat-order, set-handicap, set-player-color, pause-game, resume-game, vote-abandon, set-preferences, force-advance-phase, send-reaction, mute-reactions, set-note, run-batch")
+	log.Info("   ✅ Card Actions (5): PlayCard, PreparePlayCard, CommitPlayCard, CancelPlayCard, UseCardAction")
 	log.Info("   ✅ Standard Projects (6): LaunchAsteroid, BuildPowerPlant, BuildAquifer, BuildCity, PlantGreenery, SellPatents")
-	log.Info("   ✅ Resource Conversions (2): ConvertHeat, ConvertPlants")
+	log.Info("   ✅ Resource Conversions (3): ConvertHeat, ConvertPlants, ConvertAll")
 	log.Info("   ✅ Tile Selection (1): SelectTile")
-	log.Info("   ✅ Turn Management (3): StartGame, SkipAction, SelectStartingCards")
-	log.Info("   ✅ Confirmations (3): ConfirmSellPatents, ConfirmProductionCards, ConfirmCardDraw")
-	log.Info("   ✅ Connection (3): PlayerDisconnected, PlayerTakeover, KickPlayer")
+	log.Info("   ✅ Turn Management (5): StartGame, SkipAction, Concede, SelectStartingCards, MulliganStartingHand")
+	log.Info("   ✅ Confirmations (4): ConfirmSellPatents, ConfirmProductionCards, ConfirmCardDraw, RespondToEffect")
+	log.Info("   ✅ Connection (6): PlayerDisconnected, PlayerTakeover, KickPlayer, ControlPlayer, SyncRequest, Subscribe")
 	log.Info("   ✅ Milestones & Awards (2): ClaimMilestone, FundAward")
-	log.Info("   ✅ Admin (1): AdminCommand (routes to 9 sub-commands)")
-	log.Info("   📌 Total: 26 handlers registered")
+	log.Info("   ✅ Admin (1): AdminCommand (routes to 10 sub-commands)")
+	log.Info("   📌 Total: 48 handlers registered")
 }
 
 // MigrateSingleHandler migrates a specific message type from old to new handler
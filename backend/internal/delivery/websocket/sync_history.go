@@ -0,0 +1,205 @@
+package websocket
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"terraforming-mars-backend/internal/delivery/dto"
+)
+
+const (
+	// Game states remembered per viewer; a client further behind gets a snapshot
+	syncHistoryDepth = 4
+
+	// Games without a broadcast for this long are forgotten
+	syncHistoryIdle = time.Hour
+)
+
+type syncEntry struct {
+	sequence  int64
+	state     []byte // Serialized GameDto
+	ambiguous bool   // Different states were sent under the same sequence
+}
+
+type viewerHistory struct {
+	entries []syncEntry // Oldest first
+}
+
+type gameHistory struct {
+	viewers      map[string]*viewerHistory
+	lastRecorded time.Time
+}
+
+// syncHistory remembers the last game states sent to each viewer so a reconnecting client can be
+// brought up to date with a patch instead of a full state
+type syncHistory struct {
+	mu        sync.Mutex
+	games     map[string]*gameHistory
+	lastPrune time.Time
+}
+
+func newSyncHistory() *syncHistory {
+	return &syncHistory{games: make(map[string]*gameHistory)}
+}
+
+// record stores the state a viewer was sent at sequence
+func (h *syncHistory) record(gameID, playerID string, sequence int64, state []byte, now time.Time) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.prune(now)
+
+	game, ok := h.games[gameID]
+	if !ok {
+		game = &gameHistory{viewers: make(map[string]*viewerHistory)}
+		h.games[gameID] = game
+	}
+	game.lastRecorded = now
+
+	viewer, ok := game.viewers[playerID]
+	if !ok {
+		viewer = &viewerHistory{}
+		game.viewers[playerID] = viewer
+	}
+
+	// Broadcasts outside an action repeat the current sequence; the client may hold either state
+	if last := len(viewer.entries) - 1; last >= 0 && viewer.entries[last].sequence == sequence {
+		if !bytes.Equal(viewer.entries[last].state, state) {
+			viewer.entries[last].state = state
+			viewer.entries[last].ambiguous = true
+		}
+		return
+	}
+
+	viewer.entries = append(viewer.entries, syncEntry{sequence: sequence, state: state})
+	if len(viewer.entries) > syncHistoryDepth {
+		viewer.entries[0] = syncEntry{}
+		viewer.entries = viewer.entries[1:]
+	}
+}
+
+// lookup returns the state a viewer was sent at sequence, if it is still known and unambiguous
+func (h *syncHistory) lookup(gameID, playerID string, sequence int64) ([]byte, bool) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	game, ok := h.games[gameID]
+	if !ok {
+		return nil, false
+	}
+	viewer, ok := game.viewers[playerID]
+	if !ok {
+		return nil, false
+	}
+	for _, entry := range viewer.entries {
+		if entry.sequence == sequence {
+			return entry.state, !entry.ambiguous
+		}
+	}
+	return nil, false
+}
+
+func (h *syncHistory) prune(now time.Time) {
+	if now.Sub(h.lastPrune) < syncHistoryIdle/6 {
+		return
+	}
+	h.lastPrune = now
+
+	for gameID, game := range h.games {
+		if now.Sub(game.lastRecorded) > syncHistoryIdle {
+			delete(h.games, gameID)
+		}
+	}
+}
+
+// diffGameStates returns the JSON patch operations that turn the from state into the to state
+// Objects are compared key by key; arrays and scalars that differ are replaced whole
+func diffGameStates(from, to []byte) ([]dto.JSONPatchOperation, error) {
+	fromValue, err := decodeJSON(from)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode previous state: %w", err)
+	}
+	toValue, err := decodeJSON(to)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode current state: %w", err)
+	}
+
+	var ops []dto.JSONPatchOperation
+	if err := diffValues("", fromValue, toValue, &ops); err != nil {
+		return nil, err
+	}
+	return ops, nil
+}
+
+func diffValues(path string, from, to any, ops *[]dto.JSONPatchOperation) error {
+	fromObject, fromIsObject := from.(map[string]any)
+	toObject, toIsObject := to.(map[string]any)
+	if !fromIsObject || !toIsObject {
+		if reflect.DeepEqual(from, to) {
+			return nil
+		}
+		return appendOperation(ops, "replace", path, to)
+	}
+
+	keys := make([]string, 0, len(fromObject)+len(toObject))
+	for key := range fromObject {
+		keys = append(keys, key)
+	}
+	for key := range toObject {
+		if _, ok := fromObject[key]; !ok {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		childPath := path + "/" + escapePointer(key)
+		fromChild, inFrom := fromObject[key]
+		toChild, inTo := toObject[key]
+
+		var err error
+		switch {
+		case !inTo:
+			*ops = append(*ops, dto.JSONPatchOperation{Op: "remove", Path: childPath})
+		case !inFrom:
+			err = appendOperation(ops, "add", childPath, toChild)
+		default:
+			err = diffValues(childPath, fromChild, toChild, ops)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func appendOperation(ops *[]dto.JSONPatchOperation, op, path string, value any) error {
+	encoded, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Errorf("failed to encode patch value at %q: %w", path, err)
+	}
+	*ops = append(*ops, dto.JSONPatchOperation{Op: op, Path: path, Value: encoded})
+	return nil
+}
+
+func decodeJSON(data []byte) (any, error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+
+	var value any
+	if err := decoder.Decode(&value); err != nil {
+		return nil, err
+	}
+	return value, nil
+}
+
+// escapePointer escapes a key for use in a JSON pointer (RFC 6901)
+func escapePointer(key string) string {
+	return strings.ReplaceAll(strings.ReplaceAll(key, "~", "~0"), "/", "~1")
+}
@@ -55,6 +55,14 @@ type GameStateChangedEvent struct {
 	Timestamp time.Time
 }
 
+// LogEntryWrittenEvent is published after an entry is appended to a game's log
+// Lets readers of the log follow it from their last sequence number instead of re-reading it
+type LogEntryWrittenEvent struct {
+	GameID         string
+	SequenceNumber int64
+	Timestamp      time.Time
+}
+
 // TilePlacedEvent is published when a tile is placed on the board
 type TilePlacedEvent struct {
 	GameID    string
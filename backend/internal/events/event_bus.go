@@ -1,7 +1,9 @@
 package events
 
 import (
+	"cmp"
 	"fmt"
+	"slices"
 	"sync"
 
 	"terraforming-mars-backend/internal/logger"
@@ -15,12 +17,18 @@ type SubscriptionID string
 // EventHandler is a type-safe event handler function
 type EventHandler[T any] func(event T)
 
+// ResolutionOrder returns the sort key of an ordered subscription, compared element by element
+// It is evaluated on every publish, so the key can follow state such as whose turn it is
+type ResolutionOrder func() []int
+
 // subscription wraps a handler with its type information
 type subscription struct {
 	id          SubscriptionID
+	seq         uint64          // Subscription order, the tie-breaker when publishing
 	handler     interface{}     // The actual typed handler
 	eventType   string          // Type name for matching
 	handlerFunc func(event any) // Type-erased execution wrapper
+	order       ResolutionOrder // Nil for plain subscriptions
 }
 
 // EventBusImpl implements EventBus with thread-safe operations
@@ -42,10 +50,21 @@ func NewEventBus() *EventBusImpl {
 
 // Subscribe registers a type-safe event handler
 func Subscribe[T any](eb *EventBusImpl, handler EventHandler[T]) SubscriptionID {
+	return subscribe(eb, nil, handler)
+}
+
+// SubscribeOrdered registers a handler that runs after every plain subscriber, sorted by its resolution order
+// Used for card effects, so simultaneous triggers resolve in a deterministic order
+func SubscribeOrdered[T any](eb *EventBusImpl, order ResolutionOrder, handler EventHandler[T]) SubscriptionID {
+	return subscribe(eb, order, handler)
+}
+
+func subscribe[T any](eb *EventBusImpl, order ResolutionOrder, handler EventHandler[T]) SubscriptionID {
 	eb.mutex.Lock()
 	defer eb.mutex.Unlock()
 
-	id := SubscriptionID(fmt.Sprintf("sub-%d", eb.nextID))
+	seq := eb.nextID
+	id := SubscriptionID(fmt.Sprintf("sub-%d", seq))
 	eb.nextID++
 
 	var zero T
@@ -59,9 +78,11 @@ func Subscribe[T any](eb *EventBusImpl, handler EventHandler[T]) SubscriptionID
 
 	sub := &subscription{
 		id:          id,
+		seq:         seq,
 		handler:     handler,
 		eventType:   eventType,
 		handlerFunc: handlerFunc,
+		order:       order,
 	}
 
 	eb.subscriptions[id] = sub
@@ -73,18 +94,71 @@ func Subscribe[T any](eb *EventBusImpl, handler EventHandler[T]) SubscriptionID
 	return id
 }
 
+// anyEventType marks a subscription that receives every event published on the bus
+const anyEventType = "*"
+
+// SubscribeAll registers a handler that receives every event regardless of type
+// Meant for observers such as caches that only need to know "something changed"
+func SubscribeAll(eb *EventBusImpl, handler func(event any)) SubscriptionID {
+	eb.mutex.Lock()
+	defer eb.mutex.Unlock()
+
+	seq := eb.nextID
+	id := SubscriptionID(fmt.Sprintf("sub-%d", seq))
+	eb.nextID++
+
+	eb.subscriptions[id] = &subscription{
+		id:          id,
+		seq:         seq,
+		handler:     handler,
+		eventType:   anyEventType,
+		handlerFunc: handler,
+	}
+
+	eb.logger.Debug("📬 Event handler subscribed to all events",
+		zap.String("subscription_id", string(id)))
+
+	return id
+}
+
 // Publish publishes a type-safe event to all matching subscribers synchronously
+// Plain subscribers run first in subscription order, then ordered subscribers by their resolution order
 func Publish[T any](eb *EventBusImpl, event T) {
 	eb.mutex.RLock()
 	defer eb.mutex.RUnlock()
 
 	eventType := fmt.Sprintf("%T", event)
 
-	var matchingHandlers []func(any)
+	type match struct {
+		sub   *subscription
+		order []int
+	}
+	var matches []match
 	for _, sub := range eb.subscriptions {
-		if sub.eventType == eventType {
-			matchingHandlers = append(matchingHandlers, sub.handlerFunc)
+		if sub.eventType == eventType || sub.eventType == anyEventType {
+			m := match{sub: sub}
+			if sub.order != nil {
+				m.order = sub.order()
+			}
+			matches = append(matches, m)
+		}
+	}
+	slices.SortFunc(matches, func(a, b match) int {
+		if (a.sub.order == nil) != (b.sub.order == nil) {
+			if a.sub.order == nil {
+				return -1
+			}
+			return 1
+		}
+		if c := slices.Compare(a.order, b.order); c != 0 {
+			return c
 		}
+		return cmp.Compare(a.sub.seq, b.sub.seq)
+	})
+
+	matchingHandlers := make([]func(any), len(matches))
+	for i, m := range matches {
+		matchingHandlers[i] = m.sub.handlerFunc
 	}
 
 	if len(matchingHandlers) == 0 {
@@ -122,3 +196,10 @@ func (eb *EventBusImpl) Clear() {
 	eb.subscriptions = make(map[SubscriptionID]*subscription)
 	eb.nextID = 1
 }
+
+// SubscriptionCount returns the number of active subscriptions on the bus
+func (eb *EventBusImpl) SubscriptionCount() int {
+	eb.mutex.RLock()
+	defer eb.mutex.RUnlock()
+	return len(eb.subscriptions)
+}
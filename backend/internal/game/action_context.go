@@ -0,0 +1,48 @@
+package game
+
+import (
+	"context"
+	"errors"
+)
+
+// ErrActionTimedOut is returned when an action runs past its deadline; everything it changed is rolled back
+var ErrActionTimedOut = errors.New("action timed out")
+
+// ErrGameFailed is returned for a game whose action hung; it takes no further actions
+var ErrGameFailed = errors.New("game failed")
+
+// ContextError is ctx's error with an expired deadline reported as ErrActionTimedOut, or nil while ctx is live
+func ContextError(ctx context.Context) error {
+	err := ctx.Err()
+	if errors.Is(err, context.DeadlineExceeded) {
+		return ErrActionTimedOut
+	}
+	return err
+}
+
+// BindActionContext makes ctx the context of the action changing the game until unbind is called
+// Passive effects resolve from event subscribers, which are not handed a context; they read it back
+// with ActionContext so a cancelled or expired action stops resolving effects too
+func (g *Game) BindActionContext(ctx context.Context) (unbind func()) {
+	g.actionCtxMu.Lock()
+	previous := g.actionCtx
+	g.actionCtx = ctx
+	g.actionCtxMu.Unlock()
+
+	return func() {
+		g.actionCtxMu.Lock()
+		g.actionCtx = previous
+		g.actionCtxMu.Unlock()
+	}
+}
+
+// ActionContext returns the context bound by the running action, or context.Background() outside one
+func (g *Game) ActionContext() context.Context {
+	g.actionCtxMu.Lock()
+	defer g.actionCtxMu.Unlock()
+
+	if g.actionCtx == nil {
+		return context.Background()
+	}
+	return g.actionCtx
+}
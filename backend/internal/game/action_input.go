@@ -0,0 +1,77 @@
+package game
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+)
+
+// ActionInput is one message a player sent to a game, kept with the state it left behind
+// so the game can be replayed through a later engine and checked input by input
+type ActionInput struct {
+	Sequence  int64
+	PlayerID  string          // Seat the message was handled for; for a join, the seat it claimed
+	Type      string          // Message type
+	Payload   json.RawMessage // Message payload as the client sent it
+	LogLength int             // Log entries written once the input was handled
+	State     *GameSnapshot   // Game state once the input was handled
+}
+
+// RecordInput snapshots the game and appends the input to its journal
+func (r *InMemoryGameStateRepository) RecordInput(ctx context.Context, gameID string, game *Game, playerID, inputType string, payload json.RawMessage) (*ActionInput, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	if game == nil {
+		return nil, fmt.Errorf("game cannot be nil")
+	}
+
+	state := captureGameSnapshot(game)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	logLength := 0
+	if diffLog, exists := r.diffLogs[gameID]; exists {
+		logLength = len(diffLog.Diffs)
+	}
+	input := ActionInput{
+		Sequence:  int64(len(r.inputs[gameID]) + 1),
+		PlayerID:  playerID,
+		Type:      inputType,
+		Payload:   slices.Clone(payload),
+		LogLength: logLength,
+		State:     state,
+	}
+	r.inputs[gameID] = append(r.inputs[gameID], input)
+	return &input, nil
+}
+
+// GetInputs returns the game's input journal in the order the inputs were handled
+func (r *InMemoryGameStateRepository) GetInputs(ctx context.Context, gameID string) ([]ActionInput, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return slices.Clone(r.inputs[gameID]), nil
+}
+
+// CaptureSnapshot returns the part of the game's state the log and the input journal record
+func CaptureSnapshot(game *Game) *GameSnapshot {
+	return captureGameSnapshot(game)
+}
+
+// DiffSnapshots returns what changed from one snapshot to another, or nil when they hold the same state
+func DiffSnapshots(from, to *GameSnapshot) *GameChanges {
+	fromJSON, fromErr := json.Marshal(from)
+	toJSON, toErr := json.Marshal(to)
+	if fromErr == nil && toErr == nil && string(fromJSON) == string(toJSON) {
+		return nil
+	}
+	return computeSnapshotChanges(from, to)
+}
@@ -44,6 +44,17 @@ type FundedAward struct {
 	FundingOrder   int // 0, 1, or 2 (order in which it was funded)
 	FundingCost    int
 	FundedAt       time.Time
+
+	// StandingsAtFunding is set only when the game freezes award standings at funding (an admin
+	// debug toggle); nil means the award is scored on the final state, as in the official rules
+	StandingsAtFunding []AwardStanding
+}
+
+// AwardStanding is a player's score and place in an award at one point in the game
+type AwardStanding struct {
+	PlayerID  string
+	Score     int
+	Placement int // 1 = first place, 2 = second place, 0 = no placement
 }
 
 // Awards manages the award state for a game
@@ -52,6 +63,8 @@ type Awards struct {
 	gameID   string
 	funded   []FundedAward
 	eventBus *events.EventBusImpl
+
+	freezeAtFunding bool
 }
 
 // NewAwards creates a new Awards instance
@@ -169,6 +182,40 @@ func (a *Awards) FundAward(ctx context.Context, awardType shared.AwardType, play
 	return nil
 }
 
+// SetFreezeAtFunding turns freezing award standings at funding on or off for awards funded from now on
+func (a *Awards) SetFreezeAtFunding(enabled bool) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.freezeAtFunding = enabled
+}
+
+// FreezesAtFunding returns true if newly funded awards keep the standings they were funded at
+func (a *Awards) FreezesAtFunding() bool {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+	return a.freezeAtFunding
+}
+
+// FreezeStandings records the standings a funded award is scored on
+// Returns an error if the award is not funded or its standings are already frozen
+func (a *Awards) FreezeStandings(awardType shared.AwardType, standings []AwardStanding) error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	for i := range a.funded {
+		if a.funded[i].Type != awardType {
+			continue
+		}
+		if a.funded[i].StandingsAtFunding != nil {
+			return fmt.Errorf("award %s standings are already frozen", awardType)
+		}
+		frozen := make([]AwardStanding, len(standings))
+		copy(frozen, standings)
+		a.funded[i].StandingsAtFunding = frozen
+		return nil
+	}
+	return fmt.Errorf("award %s is not funded", awardType)
+}
+
 // GetAwardInfo returns the info for a specific award type
 func GetAwardInfo(awardType shared.AwardType) (AwardInfo, bool) {
 	for _, info := range AllAwards {
@@ -17,14 +17,21 @@ const (
 	TileTypeOcean    = "ocean"
 )
 
-// BoardTag represents a tag on a board tile for reserved areas
+// BoardTag represents a tag on a board tile: a reserved area or a feature such as a volcano
 type BoardTag = string
 
 const (
 	BoardTagNoctisCity     BoardTag = "noctis-city"
 	BoardTagGanymedeColony BoardTag = "ganymede-colony"
+	BoardTagVolcanic       BoardTag = "volcanic"
 )
 
+// reservedAreaTags mark areas only their own card may use; other tags leave an area open to normal placement
+var reservedAreaTags = map[BoardTag]bool{
+	BoardTagNoctisCity:     true,
+	BoardTagGanymedeColony: true,
+}
+
 // TileLocation represents the celestial body where tiles are located
 type TileLocation string
 
@@ -41,8 +48,9 @@ type TileBonus struct {
 
 // TileOccupant represents what currently occupies a tile
 type TileOccupant struct {
-	Type shared.ResourceType `json:"type"`
-	Tags []string            `json:"tags"`
+	Type            shared.ResourceType `json:"type"`
+	Tags            []string            `json:"tags"`
+	SpecialTileType string              `json:"specialTileType,omitempty"` // Card marker when Type is shared.ResourceSpecialTile
 }
 
 // Tile represents a single hexagonal tile on the game board
@@ -58,6 +66,21 @@ type Tile struct {
 	ReservedBy  *string             `json:"reservedBy,omitempty" ts:"reservedBy"`
 }
 
+// IsReservedArea reports whether the tile is an area kept for a specific card, such as Noctis City
+func (t Tile) IsReservedArea() bool {
+	for _, tag := range t.Tags {
+		if reservedAreaTags[tag] {
+			return true
+		}
+	}
+	return false
+}
+
+// IsReservedAreaTag reports whether a board tag marks a reserved area
+func IsReservedAreaTag(tag BoardTag) bool {
+	return reservedAreaTags[tag]
+}
+
 // Board represents the complete game board state with encapsulated tiles
 type Board struct {
 	mu       sync.RWMutex
@@ -122,9 +145,13 @@ func GenerateMarsBoard() []Tile {
 	taggedTiles := map[shared.HexPosition]taggedTileInfo{
 		{Q: -4, R: 2, S: 2}:  {Tags: []string{BoardTagNoctisCity}, DisplayName: "Noctis City"},
 		{Q: 4, R: -2, S: -2}: {Tags: []string{BoardTagGanymedeColony}, DisplayName: "Ganymede Colony"},
+		{Q: 0, R: -3, S: 3}:  {Tags: []string{BoardTagVolcanic}, DisplayName: "Tharsis Tholus"},
+		{Q: -2, R: -2, S: 4}: {Tags: []string{BoardTagVolcanic}, DisplayName: "Ascraeus Mons"},
+		{Q: -2, R: -1, S: 3}: {Tags: []string{BoardTagVolcanic}, DisplayName: "Pavonis Mons"},
+		{Q: -3, R: 0, S: 3}:  {Tags: []string{BoardTagVolcanic}, DisplayName: "Arsia Mons"},
 	}
 
-	radius := 4
+	radius := MarsRadius
 	for q := -radius; q <= radius; q++ {
 		r1 := max(-radius, -q-radius)
 		r2 := min(radius, -q+radius)
@@ -229,6 +256,7 @@ func (b *Board) SetTiles(ctx context.Context, tiles []Tile) error {
 }
 
 // UpdateTileOccupancy updates a tile's occupancy state and publishes TilePlacedEvent
+// An empty ownerID places a neutral tile that belongs to no player
 func (b *Board) UpdateTileOccupancy(ctx context.Context, coords shared.HexPosition, occupant TileOccupant, ownerID string) error {
 	if err := ctx.Err(); err != nil {
 		return err
@@ -239,8 +267,16 @@ func (b *Board) UpdateTileOccupancy(ctx context.Context, coords shared.HexPositi
 	b.mu.Lock()
 	for i := range b.tiles {
 		if b.tiles[i].Coordinates == coords {
+			if reservedBy := b.tiles[i].ReservedBy; reservedBy != nil && ownerID != "" && *reservedBy != ownerID {
+				b.mu.Unlock()
+				return fmt.Errorf("cannot place tile at %v: reserved by another player", coords)
+			}
 			b.tiles[i].OccupiedBy = &occupant
-			b.tiles[i].OwnerID = &ownerID
+			b.tiles[i].OwnerID = nil
+			if ownerID != "" {
+				owner := ownerID
+				b.tiles[i].OwnerID = &owner
+			}
 			b.tiles[i].ReservedBy = nil // Clear reservation when tile is occupied
 			found = true
 			break
@@ -292,6 +328,7 @@ func (b *Board) ClearTileBonuses(ctx context.Context, coords shared.HexPosition)
 }
 
 // ReserveTile reserves a tile for exclusive future placement by a player
+// The reservation lasts until the tile is built on or the player concedes
 func (b *Board) ReserveTile(ctx context.Context, coords shared.HexPosition, playerID string) error {
 	if err := ctx.Err(); err != nil {
 		return err
@@ -374,3 +411,11 @@ func (b *Board) deepCopyTile(tile *Tile) *Tile {
 
 	return &tileCopy
 }
+
+// RestoreTiles replaces the board's tiles without a context check or events (transaction rollback)
+func (b *Board) RestoreTiles(tiles []Tile) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.tiles = make([]Tile, len(tiles))
+	copy(b.tiles, tiles)
+}
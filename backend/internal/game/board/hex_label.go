@@ -0,0 +1,77 @@
+package board
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"terraforming-mars-backend/internal/game/shared"
+)
+
+// MarsRadius is the number of rings around the centre hex of the Mars board
+const MarsRadius = 4
+
+// rowStartQ is the q coordinate of the leftmost hex in row r
+func rowStartQ(r int) int {
+	return max(-MarsRadius, -r-MarsRadius)
+}
+
+// HexLabel names a hex by row and column, e.g. "E5" for the centre: rows A-I run top to bottom
+// (r = -4..4) and columns count from 1 at the left edge of each row
+func HexLabel(pos shared.HexPosition) string {
+	if pos.R < -MarsRadius || pos.R > MarsRadius {
+		return pos.String()
+	}
+	column := pos.Q - rowStartQ(pos.R) + 1
+	if column < 1 || pos.Q > min(MarsRadius, -pos.R+MarsRadius) {
+		return pos.String()
+	}
+	return fmt.Sprintf("%c%d", 'A'+rune(pos.R+MarsRadius), column)
+}
+
+// ParseHexLabel reads a label written by HexLabel, case-insensitively
+func ParseHexLabel(label string) (shared.HexPosition, bool) {
+	label = strings.ToUpper(strings.TrimSpace(label))
+	if len(label) < 2 || label[0] < 'A' || label[0] > 'A'+2*MarsRadius {
+		return shared.HexPosition{}, false
+	}
+	column, err := strconv.Atoi(label[1:])
+	if err != nil {
+		return shared.HexPosition{}, false
+	}
+
+	r := int(label[0]-'A') - MarsRadius
+	q := rowStartQ(r) + column - 1
+	if column < 1 || q > min(MarsRadius, -r+MarsRadius) {
+		return shared.HexPosition{}, false
+	}
+	return shared.HexPosition{Q: q, R: r, S: -q - r}, true
+}
+
+// HexName is the human-readable identifier for a hex: the area name when it has one, then the label
+func HexName(pos shared.HexPosition, displayName *string) string {
+	if displayName != nil && *displayName != "" {
+		return fmt.Sprintf("%s (%s)", *displayName, HexLabel(pos))
+	}
+	return HexLabel(pos)
+}
+
+// ResolveHex finds the hex a player referred to by "q,r,s" coordinates, a label such as "E5",
+// or the name of a named area such as "Noctis City"
+func (b *Board) ResolveHex(id string) (shared.HexPosition, error) {
+	if pos, err := shared.ParseHexPosition(id); err == nil {
+		return pos, nil
+	}
+	if pos, ok := ParseHexLabel(id); ok {
+		return pos, nil
+	}
+
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	for _, tile := range b.tiles {
+		if tile.DisplayName != nil && strings.EqualFold(*tile.DisplayName, strings.TrimSpace(id)) {
+			return tile.Coordinates, nil
+		}
+	}
+	return shared.HexPosition{}, fmt.Errorf("unknown hex %q: expected \"q,r,s\", a label such as \"E5\", or an area name", id)
+}
@@ -0,0 +1,103 @@
+package board
+
+import (
+	"sort"
+
+	"terraforming-mars-backend/internal/game/shared"
+)
+
+// clockwiseFromUpperLeft lists neighbour offsets clockwise around a space, starting with its upper-left neighbour
+var clockwiseFromUpperLeft = []shared.HexPosition{
+	{Q: 0, R: -1, S: 1}, // Upper left
+	{Q: 1, R: -1, S: 0}, // Upper right
+	{Q: 1, R: 0, S: -1}, // Right
+	{Q: 0, R: 1, S: -1}, // Lower right
+	{Q: -1, R: 1, S: 0}, // Lower left
+	{Q: -1, R: 0, S: 1}, // Left
+}
+
+// NeutralCitySpace picks the space for a solo neutral city: the nth eligible space in reading order
+// (top row first, left to right), or counting back from the bottom right when fromEnd is set.
+// Eligible spaces are empty land spaces that are not reserved for a named city and not next to a city;
+// the count wraps around. Returns false when no space is eligible.
+func (b *Board) NeutralCitySpace(n int, fromEnd bool) (shared.HexPosition, bool) {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	cities := make(map[shared.HexPosition]bool)
+	for _, tile := range b.tiles {
+		if tile.OccupiedBy != nil && tile.OccupiedBy.Type == shared.ResourceCityTile {
+			cities[tile.Coordinates] = true
+		}
+	}
+
+	var eligible []shared.HexPosition
+	for _, tile := range b.tiles {
+		if !isFreeLand(tile) || tile.IsReservedArea() {
+			continue
+		}
+		nextToCity := false
+		for _, neighbor := range tile.Coordinates.GetNeighbors() {
+			if cities[neighbor] {
+				nextToCity = true
+				break
+			}
+		}
+		if !nextToCity {
+			eligible = append(eligible, tile.Coordinates)
+		}
+	}
+	if len(eligible) == 0 {
+		return shared.HexPosition{}, false
+	}
+
+	sort.Slice(eligible, func(i, j int) bool {
+		if eligible[i].R != eligible[j].R {
+			return eligible[i].R < eligible[j].R
+		}
+		return eligible[i].Q < eligible[j].Q
+	})
+	index := countIndex(n, len(eligible))
+	if fromEnd {
+		index = len(eligible) - 1 - index
+	}
+	return eligible[index], true
+}
+
+// NeutralGreenerySpace picks the space for a neutral city's greenery: the nth empty land space around
+// the city, counting clockwise from its upper-left neighbour; the count wraps around.
+// Returns false when the city has no empty land neighbour.
+func (b *Board) NeutralGreenerySpace(city shared.HexPosition, n int) (shared.HexPosition, bool) {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	tiles := make(map[shared.HexPosition]Tile, len(b.tiles))
+	for _, tile := range b.tiles {
+		tiles[tile.Coordinates] = tile
+	}
+
+	var eligible []shared.HexPosition
+	for _, offset := range clockwiseFromUpperLeft {
+		pos := shared.HexPosition{Q: city.Q + offset.Q, R: city.R + offset.R, S: city.S + offset.S}
+		if tile, ok := tiles[pos]; ok && isFreeLand(tile) {
+			eligible = append(eligible, pos)
+		}
+	}
+	if len(eligible) == 0 {
+		return shared.HexPosition{}, false
+	}
+	return eligible[countIndex(n, len(eligible))], true
+}
+
+// isFreeLand reports whether a tile is an empty, unreserved land space
+func isFreeLand(tile Tile) bool {
+	return tile.Type == shared.ResourceLandTile && tile.OccupiedBy == nil && tile.ReservedBy == nil
+}
+
+// countIndex turns a count of n spaces (counting the first space as 1) into an index, wrapping past the end
+func countIndex(n, size int) int {
+	if n < 1 {
+		n = 1
+	}
+	return (n - 1) % size
+}
@@ -0,0 +1,65 @@
+package board
+
+import "terraforming-mars-backend/internal/game/shared"
+
+// IsOceanSpace reports whether the tile is an area reserved for ocean
+func (t Tile) IsOceanSpace() bool {
+	return t.Type == shared.ResourceOceanSpace
+}
+
+// AllowsTileType applies the ocean rule to a placement: ocean tiles go only on areas reserved for ocean,
+// and every other tile only on land. onTileType lifts the rule for the cards that place a tile on the other
+// kind of space: shared.OnTileTypeLand for an ocean on land (Artificial Lake), shared.OnTileTypeOcean for
+// another tile on an ocean area (Mangrove)
+func (t Tile) AllowsTileType(tileType string, onTileType string) bool {
+	if t.Type != shared.ResourceOceanSpace && t.Type != shared.ResourceLandTile {
+		return false
+	}
+	// A land claim is a marker, not a tile: it may reserve an ocean area for a later ocean
+	if tileType == string(shared.ResourceLandClaim) {
+		return true
+	}
+	if tileType == TileTypeOcean {
+		if onTileType == shared.OnTileTypeLand {
+			return !t.IsOceanSpace()
+		}
+		return t.IsOceanSpace()
+	}
+	if onTileType == shared.OnTileTypeOcean {
+		return t.IsOceanSpace()
+	}
+	return !t.IsOceanSpace()
+}
+
+// Special tile types: the markers of card-defined tiles. A special tile is queued for placement under its own
+// type and occupies the board as shared.ResourceSpecialTile
+const (
+	SpecialTileNuclearZone     = "nuclear-zone"
+	SpecialTileRestrictedArea  = "restricted-area"
+	SpecialTileMiningArea      = "mining-area"
+	SpecialTileMiningRights    = "mining-rights"
+	SpecialTileNaturalPreserve = "natural-preserve"
+	SpecialTileLavaFlows       = "lava-flows"
+)
+
+// IsSpecialTileType reports whether a queued tile type is a card's special tile rather than
+// a city, greenery, ocean or land claim
+func IsSpecialTileType(tileType string) bool {
+	switch tileType {
+	case "", TileTypeCity, TileTypeGreenery, TileTypeOcean, string(shared.ResourceLandClaim):
+		return false
+	}
+	return true
+}
+
+// BonusOf returns the first of the given resource types among the tile's placement bonuses
+func (t Tile) BonusOf(resourceTypes []string) (shared.ResourceType, bool) {
+	for _, bonus := range t.Bonuses {
+		for _, resourceType := range resourceTypes {
+			if string(bonus.Type) == resourceType {
+				return bonus.Type, true
+			}
+		}
+	}
+	return "", false
+}
@@ -0,0 +1,43 @@
+package board
+
+import (
+	"context"
+	"time"
+
+	"terraforming-mars-backend/internal/events"
+)
+
+// ReleasePlayerReservations lifts every reservation held by a player (e.g. after they concede)
+// and returns how many were lifted
+func (b *Board) ReleasePlayerReservations(ctx context.Context, playerID string) (int, error) {
+	return b.releaseReservations(ctx, func(tile Tile) bool {
+		return *tile.ReservedBy == playerID
+	})
+}
+
+// releaseReservations lifts the reservations matching release, publishing a state change if any were lifted
+func (b *Board) releaseReservations(ctx context.Context, release func(tile Tile) bool) (int, error) {
+	if err := ctx.Err(); err != nil {
+		return 0, err
+	}
+
+	released := 0
+	b.mu.Lock()
+	for i := range b.tiles {
+		if b.tiles[i].ReservedBy == nil || !release(b.tiles[i]) {
+			continue
+		}
+		b.tiles[i].ReservedBy = nil
+		released++
+	}
+	b.mu.Unlock()
+
+	if released > 0 && b.eventBus != nil {
+		events.Publish(b.eventBus, events.GameStateChangedEvent{
+			GameID:    b.gameID,
+			Timestamp: time.Now(),
+		})
+	}
+
+	return released, nil
+}
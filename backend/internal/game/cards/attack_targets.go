@@ -0,0 +1,105 @@
+package cards
+
+import (
+	"fmt"
+	"slices"
+
+	"terraforming-mars-backend/internal/game/player"
+	"terraforming-mars-backend/internal/game/shared"
+)
+
+// IsAttackOutput reports whether an output takes resources or production away from a chosen player:
+// "any-player" removals and production decreases, "steal-any-player" steals and "any-player-choice" options
+func IsAttackOutput(output shared.ResourceCondition) bool {
+	switch output.Target {
+	case "steal-any-player", shared.TargetAnyPlayerChoice:
+		return true
+	case "any-player":
+		if shared.IsProduction(output.ResourceType) {
+			return output.Amount < 0
+		}
+		return output.Amount > 0
+	default:
+		return false
+	}
+}
+
+// CanAbsorbAttack reports whether a player is a legal target for an attack output.
+// Production decreases must fit above the production floor in full; resource removals and steals
+// need at least one of the resource, since they take what the target has.
+func CanAbsorbAttack(p *player.Player, output shared.ResourceCondition) bool {
+	if shared.IsProduction(output.ResourceType) {
+		return p.Resources().CanAddProduction(map[shared.ResourceType]int{output.ResourceType: output.Amount})
+	}
+	return p.Resources().Get().Get(output.ResourceType) > 0
+}
+
+// IsProtectedFrom reports whether opponents may not remove a player's resources of the given type,
+// through a registered defense effect (Protected Habitats). Production is never protected
+func IsProtectedFrom(p *player.Player, resourceType shared.ResourceType) bool {
+	if shared.IsProduction(resourceType) {
+		return false
+	}
+	for _, effect := range p.Effects().List() {
+		for _, output := range effect.Behavior.Outputs {
+			if output.ResourceType == shared.ResourceDefense && slices.Contains(GetResourcesFromSelectors(output.Selectors), string(resourceType)) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// LegalAttackTargets returns the IDs of the players an attacker may aim a behavior's attack outputs at,
+// in the order given. "Any player" may be the attacker, except for steals, which always target someone else.
+// A player is legal when they can absorb at least one attack output; for a set of "any-player-choice"
+// options, when they can absorb any one of them. Opponents protected from losing the resource are excluded.
+func LegalAttackTargets(players []*player.Player, attackerID string, outputs []shared.ResourceCondition) []string {
+	targets := make([]string, 0, len(players))
+	for _, candidate := range players {
+		for _, output := range outputs {
+			if !IsAttackOutput(output) {
+				continue
+			}
+			if output.Target == "steal-any-player" && candidate.ID() == attackerID {
+				continue
+			}
+			if candidate.ID() != attackerID && IsProtectedFrom(candidate, output.ResourceType) {
+				continue
+			}
+			if CanAbsorbAttack(candidate, output) {
+				targets = append(targets, candidate.ID())
+				break
+			}
+		}
+	}
+	return targets
+}
+
+var attackLabels = map[shared.ResourceType]string{
+	shared.ResourceCredit:             "M€",
+	shared.ResourceSteel:              "steel",
+	shared.ResourceTitanium:           "titanium",
+	shared.ResourcePlant:              "plants",
+	shared.ResourceEnergy:             "energy",
+	shared.ResourceHeat:               "heat",
+	shared.ResourceCreditProduction:   "M€ production",
+	shared.ResourceSteelProduction:    "steel production",
+	shared.ResourceTitaniumProduction: "titanium production",
+	shared.ResourcePlantProduction:    "plant production",
+	shared.ResourceEnergyProduction:   "energy production",
+	shared.ResourceHeatProduction:     "heat production",
+}
+
+// attackLabel names what an attack output takes: "3 plants", "1 titanium production"
+func attackLabel(output shared.ResourceCondition) string {
+	amount := output.Amount
+	if amount < 0 {
+		amount = -amount
+	}
+	label, ok := attackLabels[output.ResourceType]
+	if !ok {
+		label = string(output.ResourceType)
+	}
+	return fmt.Sprintf("%d %s", amount, label)
+}
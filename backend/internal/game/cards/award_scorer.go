@@ -3,55 +3,68 @@ package cards
 import (
 	"sort"
 
+	"terraforming-mars-backend/internal/game"
 	"terraforming-mars-backend/internal/game/board"
 	"terraforming-mars-backend/internal/game/player"
 	"terraforming-mars-backend/internal/game/shared"
 )
 
-// Award VP constants
-const (
-	AwardFirstPlaceVP  = 5 // VP for first place in an award
-	AwardSecondPlaceVP = 2 // VP for second place in an award
-)
-
 // AwardPlacement represents a player's placement in an award
-type AwardPlacement struct {
-	PlayerID  string
-	Score     int
-	Placement int // 1 = first place (5 VP), 2 = second place (2 VP), 0 = no placement
+// Placement is 1 for first place, 2 for second place and 0 for no placement.
+type AwardPlacement = game.AwardStanding
+
+// AwardCounts holds everything awards are scored on for one player
+// Scoring works on these counts alone, so it can be run on injected state in tests and tools.
+type AwardCounts struct {
+	PlayerID         string
+	Tiles            int // Tiles on Mars owned by the player
+	CreditProduction int
+	ScienceTags      int
+	Heat             int
+	SteelAndTitanium int
 }
 
-// CalculateAwardScore calculates a player's score for a specific award
-func CalculateAwardScore(
-	awardType shared.AwardType,
-	p *player.Player,
-	b *board.Board,
-	cardRegistry CardRegistryInterface,
-) int {
+// CollectAwardCounts reads a player's award counts from the game state
+func CollectAwardCounts(p *player.Player, b *board.Board, cardRegistry CardRegistryInterface) AwardCounts {
+	resources := p.Resources().Get()
+	return AwardCounts{
+		PlayerID:         p.ID(),
+		Tiles:            CountPlayerTiles(p.ID(), b, nil),
+		CreditProduction: p.Resources().Production().Credits,
+		ScienceTags:      CountPlayerTagsByType(p, cardRegistry, shared.TagScience),
+		Heat:             resources.Heat,
+		SteelAndTitanium: resources.Steel + resources.Titanium,
+	}
+}
+
+// AwardScore returns the score for an award from a player's counts
+func AwardScore(awardType shared.AwardType, counts AwardCounts) int {
 	switch awardType {
 	case shared.AwardLandlord:
-		// Most tiles on Mars (owned by player)
-		return CountPlayerTiles(p.ID(), b, nil)
+		return counts.Tiles
 	case shared.AwardBanker:
-		// Highest MC production
-		production := p.Resources().Production()
-		return production.Credits
+		return counts.CreditProduction
 	case shared.AwardScientist:
-		// Most science tags in play
-		return CountPlayerTagsByType(p, cardRegistry, shared.TagScience)
+		return counts.ScienceTags
 	case shared.AwardThermalist:
-		// Most heat resources
-		resources := p.Resources().Get()
-		return resources.Heat
+		return counts.Heat
 	case shared.AwardMiner:
-		// Most steel + titanium resources
-		resources := p.Resources().Get()
-		return resources.Steel + resources.Titanium
+		return counts.SteelAndTitanium
 	default:
 		return 0
 	}
 }
 
+// CalculateAwardScore calculates a player's score for a specific award
+func CalculateAwardScore(
+	awardType shared.AwardType,
+	p *player.Player,
+	b *board.Board,
+	cardRegistry CardRegistryInterface,
+) int {
+	return AwardScore(awardType, CollectAwardCounts(p, b, cardRegistry))
+}
+
 // ScoreAward calculates placements for all players for an award
 // Returns a slice of AwardPlacement sorted by placement (1st, 2nd, then others)
 func ScoreAward(
@@ -60,15 +73,26 @@ func ScoreAward(
 	b *board.Board,
 	cardRegistry CardRegistryInterface,
 ) []AwardPlacement {
-	placements := make([]AwardPlacement, len(players))
+	counts := make([]AwardCounts, len(players))
 	for i, p := range players {
+		counts[i] = CollectAwardCounts(p, b, cardRegistry)
+	}
+	return RankAward(awardType, counts)
+}
+
+// RankAward places players in an award from their counts alone
+// Ties share a place: everyone on the best score takes first and everyone on the next best takes
+// second. Tied players keep the order they were given in.
+func RankAward(awardType shared.AwardType, counts []AwardCounts) []AwardPlacement {
+	placements := make([]AwardPlacement, len(counts))
+	for i, c := range counts {
 		placements[i] = AwardPlacement{
-			PlayerID: p.ID(),
-			Score:    CalculateAwardScore(awardType, p, b, cardRegistry),
+			PlayerID: c.PlayerID,
+			Score:    AwardScore(awardType, c),
 		}
 	}
 
-	sort.Slice(placements, func(i, j int) bool {
+	sort.SliceStable(placements, func(i, j int) bool {
 		return placements[i].Score > placements[j].Score
 	})
 
@@ -77,51 +101,32 @@ func ScoreAward(
 	}
 
 	firstPlaceScore := placements[0].Score
+	firstPlaceCount := 0
 	for i := range placements {
-		if placements[i].Score == firstPlaceScore {
-			placements[i].Placement = 1
-		} else {
+		if placements[i].Score != firstPlaceScore {
 			break
 		}
-	}
-
-	firstPlaceCount := 0
-	for _, p := range placements {
-		if p.Placement == 1 {
-			firstPlaceCount++
-		}
+		placements[i].Placement = 1
+		firstPlaceCount++
 	}
 
 	if firstPlaceCount < len(placements) {
-		var secondPlaceScore int
-		foundSecond := false
-		for _, p := range placements {
-			if p.Placement != 1 {
-				secondPlaceScore = p.Score
-				foundSecond = true
-				break
-			}
-		}
-
-		if foundSecond {
-			for i := range placements {
-				if placements[i].Placement == 0 && placements[i].Score == secondPlaceScore {
-					placements[i].Placement = 2
-				}
-			}
+		secondPlaceScore := placements[firstPlaceCount].Score
+		for i := firstPlaceCount; i < len(placements) && placements[i].Score == secondPlaceScore; i++ {
+			placements[i].Placement = 2
 		}
 	}
 
 	return placements
 }
 
-// GetAwardVP returns the VP for a specific placement
-func GetAwardVP(placement int) int {
+// GetAwardVP returns the VP for a specific placement under the game's scoring
+func GetAwardVP(placement int, scoring game.ScoringVP) int {
 	switch placement {
 	case 1:
-		return AwardFirstPlaceVP
+		return scoring.AwardFirstPlace
 	case 2:
-		return AwardSecondPlaceVP
+		return scoring.AwardSecondPlace
 	default:
 		return 0
 	}
@@ -2,7 +2,9 @@ package cards
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"slices"
 
 	"go.uber.org/zap"
 
@@ -11,6 +13,9 @@ import (
 	"terraforming-mars-backend/internal/game/shared"
 )
 
+// ErrDiscardSelectionRequired means a card-discard input was applied without the cards to discard
+var ErrDiscardSelectionRequired = errors.New("discard selection required")
+
 // BehaviorApplier handles applying card behavior inputs and outputs
 // This is the single source of truth for all input/output application
 type BehaviorApplier struct {
@@ -20,9 +25,13 @@ type BehaviorApplier struct {
 	sourceCardID      string                // Card ID for self-card targeting (optional)
 	targetCardID      string                // Card ID for any-card targeting (optional, set by caller)
 	targetPlayerID    string                // Player ID for any-player targeting (optional, set by caller)
+	attackAmount      *int                  // How many of an "up to" removal to take (optional, set by caller; nil takes the full amount)
+	discardCardIDs    []string              // Cards from hand paying card-discard inputs (optional, set by caller)
 	stealSourceCardID string                // Card ID to steal resources from for steal-from-any-card outputs (optional)
 	sourceBehaviorIdx int                   // Behavior index for card draw selection tracking
 	cardRegistry      CardRegistryInterface // Card registry for tag counting in per conditions (optional)
+	notes             []string              // Game log notes, e.g. attacks skipped for lack of a legal target
+	takenCards        []string              // Project cards card-search outputs took from the deck, for the caller to put in hand
 	logger            *zap.Logger
 }
 
@@ -42,6 +51,25 @@ func NewBehaviorApplier(
 	}
 }
 
+// playerID returns the applying player's ID, or "" when the applier has no player
+func (a *BehaviorApplier) playerID() string {
+	if a.player == nil {
+		return ""
+	}
+	return a.player.ID()
+}
+
+// Notes returns game log notes gathered while applying outputs, e.g. "no player could lose 3 plants"
+func (a *BehaviorApplier) Notes() []string {
+	return a.notes
+}
+
+// TakenCards returns the project cards card-search outputs took from the deck
+// The caller adds them to the player's hand, where they need cached PlayerCards the applier cannot build
+func (a *BehaviorApplier) TakenCards() []string {
+	return a.takenCards
+}
+
 // WithSourceCardID sets the source card ID for self-card targeting
 func (a *BehaviorApplier) WithSourceCardID(cardID string) *BehaviorApplier {
 	a.sourceCardID = cardID
@@ -66,6 +94,19 @@ func (a *BehaviorApplier) WithTargetPlayerID(playerID string) *BehaviorApplier {
 	return a
 }
 
+// WithAttackAmount sets how many resources an "up to" removal takes from the target player
+// Zero spares the target; more than the card allows is rejected when the removal is applied
+func (a *BehaviorApplier) WithAttackAmount(amount int) *BehaviorApplier {
+	a.attackAmount = &amount
+	return a
+}
+
+// WithDiscardCardIDs sets the cards from hand that pay card-discard inputs
+func (a *BehaviorApplier) WithDiscardCardIDs(cardIDs []string) *BehaviorApplier {
+	a.discardCardIDs = cardIDs
+	return a
+}
+
 // WithStealSourceCardID sets the source card ID for steal-from-any-card outputs
 func (a *BehaviorApplier) WithStealSourceCardID(cardID string) *BehaviorApplier {
 	a.stealSourceCardID = cardID
@@ -101,8 +142,11 @@ func (a *BehaviorApplier) ApplyInputs(
 
 	resources := a.player.Resources().Get()
 
+	discardCount := 0
 	for _, input := range inputs {
 		switch input.ResourceType {
+		case shared.ResourceCardDiscard:
+			discardCount += input.Amount
 		case shared.ResourceCredit:
 			if resources.Credits < input.Amount {
 				return fmt.Errorf("insufficient credits: need %d, have %d", input.Amount, resources.Credits)
@@ -127,10 +171,15 @@ func (a *BehaviorApplier) ApplyInputs(
 			if resources.Heat < input.Amount {
 				return fmt.Errorf("insufficient heat: need %d, have %d", input.Amount, resources.Heat)
 			}
+		case shared.ResourceInfluence, shared.ResourceDelegate:
+			a.skipTurmoilResource(input, log)
 		default:
 			log.Warn("⚠️ Unhandled input type", zap.String("type", string(input.ResourceType)))
 		}
 	}
+	if err := a.validateDiscardSelection(discardCount); err != nil {
+		return err
+	}
 
 	for _, input := range inputs {
 		switch input.ResourceType {
@@ -172,6 +221,38 @@ func (a *BehaviorApplier) ApplyInputs(
 		}
 	}
 
+	if discardCount > 0 {
+		for _, cardID := range a.discardCardIDs {
+			a.player.Hand().RemoveCard(cardID)
+		}
+		if a.game != nil && a.game.Deck() != nil {
+			if err := a.game.Deck().Discard(ctx, a.discardCardIDs); err != nil {
+				return fmt.Errorf("failed to discard cards: %w", err)
+			}
+		}
+		log.Info("🗑️ Discarded cards from hand", zap.Strings("card_ids", a.discardCardIDs))
+	}
+
+	return nil
+}
+
+// validateDiscardSelection checks the chosen cards can pay card-discard inputs worth count cards:
+// exactly count distinct cards, all in the player's hand
+func (a *BehaviorApplier) validateDiscardSelection(count int) error {
+	if count == 0 {
+		return nil
+	}
+	if len(a.discardCardIDs) != count {
+		return fmt.Errorf("%w: choose %d cards from hand to discard, got %d", ErrDiscardSelectionRequired, count, len(a.discardCardIDs))
+	}
+	for i, cardID := range a.discardCardIDs {
+		if !a.player.Hand().HasCard(cardID) {
+			return fmt.Errorf("cannot discard %s: card not in hand", cardID)
+		}
+		if slices.Contains(a.discardCardIDs[:i], cardID) {
+			return fmt.Errorf("cannot discard %s twice", cardID)
+		}
+	}
 	return nil
 }
 
@@ -204,7 +285,24 @@ func (a *BehaviorApplier) ApplyOutputsAndGetCalculated(
 
 	var calculatedOutputs []game.CalculatedOutput
 
+	// Outputs the target player chooses between are not applied here: the target answers a pending response
+	var responseOptions []shared.ResourceCondition
+	for _, output := range outputs {
+		if output.Target == shared.TargetAnyPlayerChoice {
+			responseOptions = append(responseOptions, output)
+		}
+	}
+	if len(responseOptions) > 0 {
+		if err := a.openTargetResponse(ctx, responseOptions, log); err != nil {
+			return nil, err
+		}
+	}
+
 	for _, output := range outputs {
+		if output.Target == shared.TargetAnyPlayerChoice {
+			continue
+		}
+
 		// Calculate the actual amount if this output has a Per condition
 		actualAmount := output.Amount
 		isScaled := false
@@ -347,6 +445,14 @@ func (a *BehaviorApplier) ApplyCardDrawOutputs(
 		return false, fmt.Errorf("failed to draw cards: %w", err)
 	}
 
+	// An exhausted deck deals fewer cards; the choice shrinks to what was dealt
+	if len(drawnCards) == 0 {
+		log.Warn("🂠 No cards left to draw, skipping card draw selection", zap.Int("peek_amount", peekAmount))
+		return false, nil
+	}
+	takeAmount = min(takeAmount, len(drawnCards))
+	buyAmount = min(buyAmount, len(drawnCards)-takeAmount)
+
 	log.Info("🃏 Drew cards for peek selection",
 		zap.Int("peek_amount", peekAmount),
 		zap.Int("take_amount", takeAmount),
@@ -378,44 +484,80 @@ func (a *BehaviorApplier) ApplyCardDrawOutputs(
 	return true, nil
 }
 
+// resolveAttackTarget returns the player an attack hits, or nil when the attack is skipped.
+// Without a chosen target (solo mode) the attack is skipped. A target who cannot absorb the attack is
+// rejected while someone else could; when no player can, the attack is skipped and noted for the game log.
+func (a *BehaviorApplier) resolveAttackTarget(outputs []shared.ResourceCondition, log *zap.Logger) (*player.Player, error) {
+	if a.game == nil {
+		if a.targetPlayerID == "" {
+			log.Debug("⏭️ Skipping attack: no target player (solo mode)")
+			return nil, nil
+		}
+		return nil, fmt.Errorf("cannot resolve attack target: no game context")
+	}
+
+	var targetPlayer *player.Player
+	if a.targetPlayerID != "" {
+		p, err := a.game.GetPlayer(a.targetPlayerID)
+		if err != nil {
+			return nil, fmt.Errorf("target player not found: %w", err)
+		}
+		targetPlayer = p
+	}
+
+	attacks := make([]shared.ResourceCondition, 0, len(outputs))
+	for _, output := range outputs {
+		if IsAttackOutput(output) {
+			attacks = append(attacks, output)
+		}
+	}
+	if len(attacks) == 0 {
+		// Not an attack (e.g., a production increase for any player): any chosen player may receive it
+		if targetPlayer == nil {
+			log.Debug("⏭️ Skipping any-player output: no target player (solo mode)")
+		}
+		return targetPlayer, nil
+	}
+
+	players := a.game.GetAllPlayers()
+	attackerID := ""
+	if a.player != nil {
+		attackerID = a.player.ID()
+	}
+	legalTargets := LegalAttackTargets(players, attackerID, attacks)
+	if len(legalTargets) == 0 {
+		if len(players) > 1 {
+			a.notes = append(a.notes, fmt.Sprintf("no player could lose %s", attackLabel(attacks[0])))
+		}
+		log.Info("⏭️ Skipping attack: no legal target", zap.String("attack", attackLabel(attacks[0])))
+		return nil, nil
+	}
+	if targetPlayer == nil {
+		log.Debug("⏭️ Skipping attack: no target player (solo mode)")
+		return nil, nil
+	}
+	if !slices.Contains(legalTargets, targetPlayer.ID()) {
+		return nil, fmt.Errorf("%s cannot lose %s", targetPlayer.Name(), attackLabel(attacks[0]))
+	}
+	return targetPlayer, nil
+}
+
 // stealAnyPlayerResource removes resources from the target player and adds them to self
 func (a *BehaviorApplier) stealAnyPlayerResource(
 	resourceType shared.ResourceType,
 	amount int,
 	log *zap.Logger,
 ) error {
-	if a.targetPlayerID == "" {
-		log.Debug("⏭️ Skipping steal: no target player (solo mode)",
-			zap.String("resource_type", string(resourceType)))
-		return nil
-	}
-	if a.game == nil {
-		return fmt.Errorf("cannot steal resource: no game context")
-	}
-	if a.player == nil {
+	if a.targetPlayerID != "" && a.player == nil {
 		return fmt.Errorf("cannot steal resource: no player context")
 	}
-	targetPlayer, err := a.game.GetPlayer(a.targetPlayerID)
-	if err != nil {
-		return fmt.Errorf("target player not found: %w", err)
+	attack := shared.ResourceCondition{ResourceType: resourceType, Amount: amount, Target: "steal-any-player"}
+	targetPlayer, err := a.resolveAttackTarget([]shared.ResourceCondition{attack}, log)
+	if err != nil || targetPlayer == nil {
+		return err
 	}
 
-	resources := targetPlayer.Resources().Get()
-	var current int
-	switch resourceType {
-	case shared.ResourceCredit:
-		current = resources.Credits
-	case shared.ResourceSteel:
-		current = resources.Steel
-	case shared.ResourceTitanium:
-		current = resources.Titanium
-	case shared.ResourcePlant:
-		current = resources.Plants
-	case shared.ResourceEnergy:
-		current = resources.Energy
-	case shared.ResourceHeat:
-		current = resources.Heat
-	}
+	current := targetPlayer.Resources().Get().Get(resourceType)
 
 	stolenAmount := min(amount, current)
 
@@ -436,42 +578,32 @@ func (a *BehaviorApplier) stealAnyPlayerResource(
 	return nil
 }
 
-// applyAnyPlayerResource removes resources from the target player (clamped to what they have)
+// applyAnyPlayerResource removes up to amount resources from the target player (clamped to what they have)
+// The attacker may take fewer through WithAttackAmount, including none
 func (a *BehaviorApplier) applyAnyPlayerResource(
 	resourceType shared.ResourceType,
 	amount int,
 	log *zap.Logger,
 ) error {
-	if a.targetPlayerID == "" {
-		log.Debug("⏭️ Skipping any-player resource removal: no target player (solo mode)",
-			zap.String("resource_type", string(resourceType)))
-		return nil
-	}
-	if a.game == nil {
-		return fmt.Errorf("cannot apply any-player resource: no game context")
-	}
-	targetPlayer, err := a.game.GetPlayer(a.targetPlayerID)
-	if err != nil {
-		return fmt.Errorf("target player not found: %w", err)
+	if a.attackAmount != nil {
+		if *a.attackAmount < 0 || *a.attackAmount > amount {
+			return fmt.Errorf("can remove up to %d %s, not %d", amount, resourceType, *a.attackAmount)
+		}
+		if *a.attackAmount == 0 {
+			log.Info("⏭️ Attacker chose to remove nothing", zap.String("resource_type", string(resourceType)))
+			return nil
+		}
+		amount = *a.attackAmount
 	}
 
-	resources := targetPlayer.Resources().Get()
-	var current int
-	switch resourceType {
-	case shared.ResourceCredit:
-		current = resources.Credits
-	case shared.ResourceSteel:
-		current = resources.Steel
-	case shared.ResourceTitanium:
-		current = resources.Titanium
-	case shared.ResourcePlant:
-		current = resources.Plants
-	case shared.ResourceEnergy:
-		current = resources.Energy
-	case shared.ResourceHeat:
-		current = resources.Heat
+	attack := shared.ResourceCondition{ResourceType: resourceType, Amount: amount, Target: "any-player"}
+	targetPlayer, err := a.resolveAttackTarget([]shared.ResourceCondition{attack}, log)
+	if err != nil || targetPlayer == nil {
+		return err
 	}
 
+	current := targetPlayer.Resources().Get().Get(resourceType)
+
 	removeAmount := min(amount, current)
 
 	if removeAmount > 0 {
@@ -488,25 +620,54 @@ func (a *BehaviorApplier) applyAnyPlayerResource(
 	return nil
 }
 
+// openTargetResponse asks the target player which of the options to lose; the card lists its default first
+// Resolution happens when they answer or the response times out
+func (a *BehaviorApplier) openTargetResponse(
+	ctx context.Context,
+	options []shared.ResourceCondition,
+	log *zap.Logger,
+) error {
+	targetPlayer, err := a.resolveAttackTarget(options, log)
+	if err != nil || targetPlayer == nil {
+		return err
+	}
+
+	responseOptions := make([]player.ResponseOption, len(options))
+	for i, option := range options {
+		// Card data writes removals as negative amounts; an option is the amount the target loses
+		responseOptions[i] = player.ResponseOption{ResourceType: option.ResourceType, Amount: abs(option.Amount)}
+	}
+	sourcePlayerID := ""
+	if a.player != nil {
+		sourcePlayerID = a.player.ID()
+	}
+
+	if err := a.game.OpenPendingResponse(ctx, targetPlayer.ID(), &player.PendingResponse{
+		SourcePlayerID: sourcePlayerID,
+		Source:         a.source,
+		Options:        responseOptions,
+	}); err != nil {
+		return fmt.Errorf("failed to open response for target player: %w", err)
+	}
+
+	log.Info("⏳ Waiting on target player response",
+		zap.String("target_player_id", a.targetPlayerID),
+		zap.Int("option_count", len(responseOptions)))
+	return nil
+}
+
 // applyAnyPlayerProduction applies production changes to the target player.
 // Card data uses negative amounts for decreases (e.g., Asteroid Mining Consortium: amount=-1).
-// The amount is applied directly via AddProduction (which handles clamping to minimums).
+// A decrease the target cannot fully take (MC production floor -5, others 0) is rejected, not clamped.
 func (a *BehaviorApplier) applyAnyPlayerProduction(
 	productionType shared.ResourceType,
 	amount int,
 	log *zap.Logger,
 ) error {
-	if a.targetPlayerID == "" {
-		log.Debug("⏭️ Skipping any-player production change: no target player (solo mode)",
-			zap.String("production_type", string(productionType)))
-		return nil
-	}
-	if a.game == nil {
-		return fmt.Errorf("cannot apply any-player production: no game context")
-	}
-	targetPlayer, err := a.game.GetPlayer(a.targetPlayerID)
-	if err != nil {
-		return fmt.Errorf("target player not found: %w", err)
+	attack := shared.ResourceCondition{ResourceType: productionType, Amount: amount, Target: "any-player"}
+	targetPlayer, err := a.resolveAttackTarget([]shared.ResourceCondition{attack}, log)
+	if err != nil || targetPlayer == nil {
+		return err
 	}
 
 	targetPlayer.Resources().AddProduction(map[shared.ResourceType]int{
@@ -526,6 +687,13 @@ func (a *BehaviorApplier) applyOutput(
 	output shared.ResourceCondition,
 	log *zap.Logger,
 ) error {
+	// Own production decreases are a precondition of the effect: they apply in full or not at all
+	if shared.IsProduction(output.ResourceType) && output.Amount < 0 && output.Target != "any-player" && a.player != nil {
+		if !a.player.Resources().CanAddProduction(map[shared.ResourceType]int{output.ResourceType: output.Amount}) {
+			return fmt.Errorf("not enough %s to lose %d", output.ResourceType, -output.Amount)
+		}
+	}
+
 	switch output.ResourceType {
 	case shared.ResourceCredit:
 		if output.Target == "steal-any-player" {
@@ -700,7 +868,7 @@ func (a *BehaviorApplier) applyOutput(
 		if a.game == nil {
 			return fmt.Errorf("cannot apply oxygen: no game context")
 		}
-		actualSteps, err := a.game.GlobalParameters().IncreaseOxygen(ctx, output.Amount)
+		actualSteps, err := a.game.RaiseOxygen(ctx, a.playerID(), output.Amount)
 		if err != nil {
 			return fmt.Errorf("failed to increase oxygen: %w", err)
 		}
@@ -710,7 +878,7 @@ func (a *BehaviorApplier) applyOutput(
 		if a.game == nil {
 			return fmt.Errorf("cannot apply temperature: no game context")
 		}
-		actualSteps, err := a.game.GlobalParameters().IncreaseTemperature(ctx, output.Amount)
+		actualSteps, err := a.game.RaiseTemperature(ctx, a.playerID(), output.Amount)
 		if err != nil {
 			return fmt.Errorf("failed to increase temperature: %w", err)
 		}
@@ -767,9 +935,10 @@ func (a *BehaviorApplier) applyOutput(
 		var tileRestrictions *shared.TileRestrictions
 		if output.TileRestrictions != nil {
 			tileRestrictions = &shared.TileRestrictions{
-				BoardTags:  output.TileRestrictions.BoardTags,
-				Adjacency:  output.TileRestrictions.Adjacency,
-				OnTileType: output.TileRestrictions.OnTileType,
+				BoardTags:    output.TileRestrictions.BoardTags,
+				Adjacency:    output.TileRestrictions.Adjacency,
+				OnTileType:   output.TileRestrictions.OnTileType,
+				CaptureBonus: output.TileRestrictions.CaptureBonus,
 			}
 		}
 
@@ -783,6 +952,32 @@ func (a *BehaviorApplier) applyOutput(
 			zap.Int("count", output.Amount),
 			zap.Any("tile_restrictions", tileRestrictions))
 
+	case shared.ResourceSpecialPlacement:
+		if a.game == nil {
+			return fmt.Errorf("cannot apply special tile placement: no game context")
+		}
+		if a.player == nil {
+			return fmt.Errorf("cannot apply special tile placement: no player context")
+		}
+		if output.SpecialTileType == "" {
+			return fmt.Errorf("special tile placement has no special tile type")
+		}
+
+		// The special tile is queued under its own type, so the board shows the card's marker
+		tileTypes := make([]string, output.Amount)
+		for i := 0; i < output.Amount; i++ {
+			tileTypes[i] = output.SpecialTileType
+		}
+
+		if err := a.game.AppendToPendingTileSelectionQueue(ctx, a.player.ID(), tileTypes, a.source, output.TileRestrictions); err != nil {
+			return fmt.Errorf("failed to append special tile to pending tile selection queue: %w", err)
+		}
+
+		log.Info("🏗️ Added special tile placement to queue",
+			zap.String("special_tile_type", output.SpecialTileType),
+			zap.Int("count", output.Amount),
+			zap.Any("tile_restrictions", output.TileRestrictions))
+
 	case shared.ResourcePaymentSubstitute:
 		if a.player == nil {
 			return fmt.Errorf("cannot apply payment substitute: no player context")
@@ -807,6 +1002,11 @@ func (a *BehaviorApplier) applyOutput(
 			zap.Int("amount", output.Amount),
 			zap.Any("selectors", output.Selectors))
 
+	case shared.ResourceDefense:
+		// Defenses are registered as effects and checked by LegalAttackTargets (IsProtectedFrom)
+		log.Info("🛡️ Defense effect registered",
+			zap.Any("selectors", output.Selectors))
+
 	case shared.ResourceValueModifier:
 		if a.player == nil {
 			return fmt.Errorf("cannot apply value modifier: no player context")
@@ -898,12 +1098,43 @@ func (a *BehaviorApplier) applyOutput(
 			}
 		}
 
+	case shared.ResourceCardSearch:
+		if a.game == nil || a.game.Deck() == nil {
+			return fmt.Errorf("cannot search the deck: no game context")
+		}
+		if a.player == nil {
+			return fmt.Errorf("cannot search the deck: no player context")
+		}
+		if a.cardRegistry == nil {
+			return fmt.Errorf("cannot search the deck: no card registry")
+		}
+
+		taken, discarded, err := a.game.Deck().RevealUntil(ctx, output.Amount, func(cardID string) bool {
+			card, err := a.cardRegistry.GetByID(cardID)
+			return err == nil && MatchesAnySelector(card, output.Selectors)
+		})
+		if err != nil {
+			return fmt.Errorf("failed to reveal cards from the deck: %w", err)
+		}
+		a.takenCards = append(a.takenCards, taken...)
+		if len(taken) < output.Amount {
+			a.notes = append(a.notes, fmt.Sprintf("the deck ran out after %d of %d matching cards", len(taken), output.Amount))
+		}
+
+		log.Info("🔍 Revealed cards from the deck",
+			zap.Int("taken", len(taken)),
+			zap.Int("discarded", len(discarded)),
+			zap.Int("wanted", output.Amount))
+
 	case shared.ResourceCardPeek, shared.ResourceCardTake, shared.ResourceCardBuy:
 		// Handled by ApplyCardDrawOutputs - skip here
 		log.Debug("🃏 Skipping card draw output (handled by ApplyCardDrawOutputs)",
 			zap.String("type", string(output.ResourceType)),
 			zap.Int("amount", output.Amount))
 
+	case shared.ResourceInfluence, shared.ResourceDelegate:
+		a.skipTurmoilResource(output, log)
+
 	default:
 		log.Warn("⚠️ Unhandled output type",
 			zap.String("type", string(output.ResourceType)))
@@ -911,3 +1142,12 @@ func (a *BehaviorApplier) applyOutput(
 
 	return nil
 }
+
+// skipTurmoilResource resolves an influence or delegate condition as a no-op until Turmoil is implemented
+// The game log notes it, so players can see the card did not forget part of its text
+func (a *BehaviorApplier) skipTurmoilResource(cond shared.ResourceCondition, log *zap.Logger) {
+	log.Warn("🗳️ Turmoil resource has no effect until Turmoil is implemented",
+		zap.String("type", string(cond.ResourceType)),
+		zap.Int("amount", cond.Amount))
+	a.notes = append(a.notes, fmt.Sprintf("%d %s has no effect until Turmoil is supported", cond.Amount, cond.ResourceType))
+}
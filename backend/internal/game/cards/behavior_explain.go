@@ -0,0 +1,664 @@
+package cards
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"terraforming-mars-backend/internal/game/shared"
+)
+
+// BehaviorKind names when a behavior applies, the way printed rules text labels it
+type BehaviorKind string
+
+const (
+	BehaviorKindImmediate   BehaviorKind = "immediate"    // Applies once, when the card is played
+	BehaviorKindAction      BehaviorKind = "action"       // Blue card action the player activates
+	BehaviorKindEffect      BehaviorKind = "effect"       // Ongoing: reacts to events or modifies later play
+	BehaviorKindStart       BehaviorKind = "start"        // Corporation starting bonus
+	BehaviorKindFirstAction BehaviorKind = "first-action" // Corporation's forced first action
+)
+
+var behaviorKindLabels = map[BehaviorKind]string{
+	BehaviorKindImmediate:   "When played",
+	BehaviorKindAction:      "Action",
+	BehaviorKindEffect:      "Effect",
+	BehaviorKindStart:       "Start",
+	BehaviorKindFirstAction: "First action",
+}
+
+// Label is the prefix rules text puts before a clause of this kind: "Action", "Effect"
+func (k BehaviorKind) Label() string {
+	return behaviorKindLabels[k]
+}
+
+// BehaviorExplanation is one behavior rendered as English rules text, built from the engine data
+// rather than the card's description so every client shows what the engine actually does
+type BehaviorExplanation struct {
+	Kind      BehaviorKind
+	Condition string   // Effects only: "when you play a space tag"
+	Costs     []string // "spend 1 energy", "remove 1 science resource from this card"
+	Gains     []string // "gain 1 TR", "add 1 animal to this card"
+	Choices   []string // One entry per option, each "costs → gains"
+	Limit     string   // "once per game"; empty for the once-per-generation default of actions
+	Text      string   // The whole clause: "Action: spend 1 energy → gain 1 TR"
+}
+
+// ExplainCard explains every behavior of a card, in behavior index order
+func ExplainCard(card *Card) []BehaviorExplanation {
+	explanations := make([]BehaviorExplanation, 0, len(card.Behaviors))
+	for _, behavior := range card.Behaviors {
+		explanations = append(explanations, ExplainBehavior(behavior))
+	}
+	return explanations
+}
+
+// ExplainBehavior renders one behavior into structured rules text
+func ExplainBehavior(behavior shared.CardBehavior) BehaviorExplanation {
+	explanation := BehaviorExplanation{
+		Kind:  behaviorKind(behavior),
+		Costs: explainInputs(behavior.Inputs),
+	}
+	outputs := behavior.Outputs
+	if explanation.Kind == BehaviorKindAction {
+		// What an action takes from the player's own stock reads as its cost
+		var paid []shared.ResourceCondition
+		paid, outputs = splitPayments(outputs)
+		for _, payment := range paid {
+			explanation.Costs = append(explanation.Costs, "spend "+quantity(payment.ResourceType, -payment.Amount))
+		}
+	}
+	explanation.Gains = explainOutputs(outputs)
+	if explanation.Kind == BehaviorKindEffect && !isModifierOnly(behavior) {
+		explanation.Condition = explainTriggers(behavior.Triggers)
+	}
+	paymentChoices := len(behavior.Choices) > 0
+	for _, choice := range behavior.Choices {
+		text := exchangeText(explainInputs(choice.Inputs), explainOutputs(choice.Outputs))
+		if text == "" {
+			text = "nothing"
+		}
+		explanation.Choices = append(explanation.Choices, text)
+		paymentChoices = paymentChoices && len(choice.Outputs) == 0 && len(choice.Inputs) > 0
+	}
+	explanation.Limit = explainLimit(behavior.UsageLimit)
+
+	var body string
+	switch {
+	case paymentChoices:
+		// Alternative payments for the same gain: "spend 1 plant or spend 1 steel → gain 7 M€"
+		body = exchangeText(append(explanation.Costs, strings.Join(explanation.Choices, " or ")), explanation.Gains)
+	case len(explanation.Choices) > 0:
+		body = exchangeText(explanation.Costs, explanation.Gains)
+		options := "choose one: " + strings.Join(explanation.Choices, " or ")
+		if behavior.TargetChooses() {
+			options = "any player chooses one: " + strings.Join(explanation.Choices, " or ")
+		}
+		switch {
+		case body == "":
+			body = options
+		case len(explanation.Gains) == 0:
+			body += " → " + options
+		default:
+			body += ", then " + options
+		}
+	default:
+		body = exchangeText(explanation.Costs, explanation.Gains)
+	}
+	for _, requirement := range behavior.GenerationalEventRequirements {
+		body = explainGenerationalRequirement(requirement) + ", " + body
+	}
+	if explanation.Condition != "" {
+		body = explanation.Condition + ", " + body
+	}
+	if explanation.Limit != "" {
+		body += " (" + explanation.Limit + ")"
+	}
+	explanation.Text = explanation.Kind.Label() + ": " + body
+	return explanation
+}
+
+// behaviorKind classifies a behavior by its triggers; unconditional auto behaviors that only modify
+// later play (discounts, lenience, defense) are effects, not one-off gains
+func behaviorKind(behavior shared.CardBehavior) BehaviorKind {
+	switch {
+	case HasManualTrigger(behavior):
+		return BehaviorKindAction
+	case HasCorporationStartTrigger(behavior):
+		return BehaviorKindStart
+	case HasCorporationFirstActionTrigger(behavior):
+		return BehaviorKindFirstAction
+	case HasConditionalTrigger(behavior), isModifierOnly(behavior):
+		return BehaviorKindEffect
+	default:
+		return BehaviorKindImmediate
+	}
+}
+
+var modifierOutputs = []shared.ResourceType{
+	shared.ResourceDiscount,
+	shared.ResourceValueModifier,
+	shared.ResourcePaymentSubstitute,
+	shared.ResourceDefense,
+	shared.ResourceGlobalParameterLenience,
+	shared.ResourceVenusLenience,
+	shared.ResourceOceanAdjacencyBonus,
+}
+
+// splitPayments separates outputs that take basic resources from the player's own stock
+func splitPayments(outputs []shared.ResourceCondition) (paid, rest []shared.ResourceCondition) {
+	for _, output := range outputs {
+		if output.Amount < 0 && output.Target == "self-player" && isBasicResource(output.ResourceType) {
+			paid = append(paid, output)
+			continue
+		}
+		rest = append(rest, output)
+	}
+	return paid, rest
+}
+
+// isModifierOnly reports whether every output changes how later play works; such behaviors may carry
+// a trigger condition, but only so the engine re-evaluates them, and rules text shows no condition
+func isModifierOnly(behavior shared.CardBehavior) bool {
+	if len(behavior.Outputs) == 0 {
+		return false
+	}
+	for _, output := range behavior.Outputs {
+		if !slices.Contains(modifierOutputs, output.ResourceType) {
+			return false
+		}
+	}
+	return true
+}
+
+// exchangeText joins costs and gains the way cards print them: "spend 1 energy → gain 1 TR"
+func exchangeText(costs, gains []string) string {
+	switch {
+	case len(costs) == 0:
+		return joinPhrases(gains)
+	case len(gains) == 0:
+		return joinPhrases(costs)
+	default:
+		return joinPhrases(costs) + " → " + joinPhrases(gains)
+	}
+}
+
+// joinPhrases merges neighbouring phrases with the same verb, so "gain 3 M€" and "gain 3 heat"
+// read "gain 3 M€ and 3 heat", then joins them as English prose
+func joinPhrases(phrases []string) string {
+	var merged []string
+	lastVerb := ""
+	for _, phrase := range phrases {
+		verb, rest, _ := strings.Cut(phrase, " ")
+		if len(merged) > 0 && verb == lastVerb && (verb == "gain" || verb == "lose" || verb == "spend") {
+			merged[len(merged)-1] += " and " + rest
+			continue
+		}
+		merged = append(merged, phrase)
+		lastVerb = verb
+	}
+	switch len(merged) {
+	case 0:
+		return ""
+	case 1:
+		return merged[0]
+	default:
+		return strings.Join(merged[:len(merged)-1], ", ") + " and " + merged[len(merged)-1]
+	}
+}
+
+func explainInputs(inputs []shared.ResourceCondition) []string {
+	phrases := make([]string, 0, len(inputs))
+	for _, input := range inputs {
+		phrases = append(phrases, explainInput(input))
+	}
+	return phrases
+}
+
+func explainInput(input shared.ResourceCondition) string {
+	amount := abs(input.Amount)
+	switch {
+	case isCardResource(input.ResourceType) && input.Target == "self-card":
+		return fmt.Sprintf("remove %s from this card", quantity(input.ResourceType, amount))
+	case input.ResourceType == shared.ResourceCardDiscard:
+		return "discard " + countNoun(amount, "a card", "cards") + " from your hand"
+	case isProduction(input.ResourceType):
+		return fmt.Sprintf("decrease your %s production %s", productionBase(input.ResourceType), steps(amount))
+	default:
+		return "spend " + quantity(input.ResourceType, amount)
+	}
+}
+
+func explainOutputs(outputs []shared.ResourceCondition) []string {
+	// A peek is printed first, directly followed by what may be taken or bought from it
+	ordered := slices.Clone(outputs)
+	slices.SortStableFunc(ordered, func(a, b shared.ResourceCondition) int {
+		return peekRank(a.ResourceType) - peekRank(b.ResourceType)
+	})
+
+	phrases := make([]string, 0, len(ordered))
+	for _, output := range ordered {
+		phrases = append(phrases, explainOutput(output)+explainPer(output))
+	}
+	return phrases
+}
+
+func explainOutput(output shared.ResourceCondition) string {
+	amount := abs(output.Amount)
+	negative := output.Amount < 0
+	rt := output.ResourceType
+
+	switch {
+	case isCardResource(rt):
+		return explainCardResource(output, amount, negative)
+	case output.Target == "steal-any-player":
+		return fmt.Sprintf("steal up to %s from any player", quantity(rt, amount))
+	case output.Target == "any-player" && negative && isProduction(rt):
+		return fmt.Sprintf("decrease any player's %s production %s", productionBase(rt), steps(amount))
+	case output.Target == "any-player" && negative:
+		return fmt.Sprintf("remove up to %s from any player", quantity(rt, amount))
+	case output.Target == shared.TargetAnyPlayerChoice:
+		return fmt.Sprintf("lose up to %s", quantity(rt, amount))
+	}
+
+	switch rt {
+	case shared.ResourceCardDraw:
+		return "draw " + countNoun(amount, "a card", "cards")
+	case shared.ResourceCardPeek:
+		return "look at the top " + countNoun(amount, "card", "cards")
+	case shared.ResourceCardTake:
+		return fmt.Sprintf("take %d of them", amount)
+	case shared.ResourceCardBuy:
+		return fmt.Sprintf("buy %d of them", amount)
+	case shared.ResourceCardSearch:
+		return fmt.Sprintf("reveal cards until %d %s, take those and discard the rest", amount, selectorNoun(output.Selectors, amount != 1))
+	case shared.ResourceOceanPlacement, shared.ResourceOcean:
+		return "place " + countNoun(amount, "an ocean tile", "ocean tiles")
+	case shared.ResourceCityPlacement:
+		return "place " + countNoun(amount, "a city tile", "city tiles")
+	case shared.ResourceGreeneryPlacement:
+		return "place " + countNoun(amount, "a greenery tile", "greenery tiles")
+	case shared.ResourceSpecialPlacement:
+		return explainSpecialPlacement(output)
+	case shared.ResourceLandClaim:
+		return "claim " + countNoun(amount, "a non-reserved area", "non-reserved areas")
+	case shared.ResourceTemperature:
+		return "raise the temperature " + steps(amount)
+	case shared.ResourceOxygen:
+		return "raise oxygen " + steps(amount)
+	case shared.ResourceVenus:
+		return "raise Venus " + steps(amount)
+	case shared.ResourceDiscount:
+		return fmt.Sprintf("pay %d M€ less for %s", amount, discountTarget(output.Selectors))
+	case shared.ResourceValueModifier:
+		return fmt.Sprintf("your %s is worth %d M€ extra", selectorResources(output.Selectors), amount)
+	case shared.ResourcePaymentSubstitute:
+		return fmt.Sprintf("you may use %s as M€", selectorResources(output.Selectors))
+	case shared.ResourceGlobalParameterLenience:
+		return fmt.Sprintf("your global requirements are +%d or -%d steps", amount, amount)
+	case shared.ResourceVenusLenience:
+		return fmt.Sprintf("your Venus requirements are +%d or -%d steps", amount, amount)
+	case shared.ResourceDefense:
+		if output.Target == "self-card" && len(output.Selectors) == 1 {
+			return selectorResources(output.Selectors) + " here may not be removed"
+		}
+		return "opponents may not remove your " + selectorResources(output.Selectors)
+	}
+
+	if isProduction(rt) {
+		verb := "increase"
+		if negative {
+			verb = "decrease"
+		}
+		return fmt.Sprintf("%s your %s production %s", verb, productionBase(rt), steps(amount))
+	}
+	if negative {
+		return "lose " + quantity(rt, amount)
+	}
+	return "gain " + quantity(rt, amount)
+}
+
+func explainCardResource(output shared.ResourceCondition, amount int, negative bool) string {
+	what := quantity(output.ResourceType, amount)
+	switch {
+	case output.Target == "steal-from-any-card":
+		return fmt.Sprintf("remove %s from any card and add it here", what)
+	case output.Target == "any-card" && len(output.Selectors) > 0:
+		return fmt.Sprintf("add %s to %s", what, withArticle(selectorNoun(output.Selectors, false)))
+	case output.Target == "any-card":
+		return fmt.Sprintf("add %s to another card", what)
+	case negative:
+		return fmt.Sprintf("remove %s from this card", what)
+	default:
+		return fmt.Sprintf("add %s to this card", what)
+	}
+}
+
+func explainSpecialPlacement(output shared.ResourceCondition) string {
+	tile := "special"
+	if output.SpecialTileType != "" {
+		tile = strings.ReplaceAll(output.SpecialTileType, "-", " ")
+	}
+	text := "place the " + tile + " tile"
+	if output.TileRestrictions != nil && output.TileRestrictions.Adjacency == shared.AdjacencyNone {
+		text += " next to no other tile"
+	}
+	return text
+}
+
+// explainPer renders a per condition: " per city tile on Mars", " per 2 jovian tags you have (max 4)"
+func explainPer(output shared.ResourceCondition) string {
+	per := output.Per
+	if per == nil {
+		return ""
+	}
+	singular, plural := resourceNames(per.ResourceType)
+	if per.ResourceType == shared.ResourceTag && per.Tag != nil {
+		singular, plural = string(*per.Tag)+" tag", string(*per.Tag)+" tags"
+	}
+	text := " per " + singular
+	if per.Amount > 1 {
+		text = fmt.Sprintf(" per %d %s", per.Amount, plural)
+	}
+
+	switch {
+	case per.Location != nil && *per.Location == "mars":
+		text += " on Mars"
+	case per.Target != nil && *per.Target == "self-card":
+		text += " here"
+	case per.Target != nil && *per.Target == "self-player":
+		text += " you have"
+	case per.Target != nil && *per.Target == "any-player":
+		text += " in play"
+	}
+	if output.MaxTrigger != nil {
+		text += fmt.Sprintf(" (max %d)", *output.MaxTrigger)
+	}
+	return text
+}
+
+// explainTriggers renders the conditions of an effect: "when you play a space tag"
+func explainTriggers(triggers []shared.Trigger) string {
+	var conditions []string
+	for _, trigger := range triggers {
+		if trigger.Condition != nil {
+			conditions = append(conditions, explainCondition(trigger.Condition))
+		}
+	}
+	return strings.Join(conditions, " or ")
+}
+
+func explainCondition(condition *shared.ResourceTriggerCondition) string {
+	anyone := condition.Target != nil && *condition.Target == "any-player"
+	subject := func(you, they string) string {
+		if anyone {
+			return "anyone " + they
+		}
+		return "you " + you
+	}
+	where := ""
+	if condition.Location != nil && *condition.Location == "mars" {
+		where = " on Mars"
+	}
+
+	switch TriggerType(condition.Type) {
+	case TriggerCardPlayed:
+		return "when " + subject("play", "plays") + " " + withArticle(selectorNoun(condition.Selectors, false))
+	case TriggerTagPlayed:
+		return "when " + subject("play", "plays") + " " + withArticle(selectorTags(condition.Selectors)+" tag")
+	case TriggerStandardProjectPlayed:
+		return "when " + subject("use", "uses") + " a standard project" + costPhrase(condition.Selectors)
+	case TriggerOceanPlaced:
+		return "when " + subject("place", "places") + " an ocean tile" + where
+	case TriggerCityPlaced:
+		return "when " + subject("place", "places") + " a city tile" + where
+	case TriggerGreeneryPlaced:
+		return "when " + subject("place", "places") + " a greenery tile" + where
+	case TriggerTilePlaced:
+		return "when " + subject("place", "places") + " a tile" + where
+	case TriggerPlacementBonusGained:
+		return "when " + subject("gain", "gains") + " " + withArticle(selectorResourceList(condition.Selectors)+" placement bonus")
+	case TriggerProductionIncreased:
+		return "when " + subject("increase your", "increases their") + " production"
+	case TriggerTemperatureRaise:
+		return "when the temperature is raised"
+	case TriggerOxygenRaise:
+		return "when oxygen is raised"
+	default:
+		return "when " + strings.ReplaceAll(condition.Type, "-", " ")
+	}
+}
+
+func explainLimit(limit *shared.UsageLimit) string {
+	if limit == nil {
+		return ""
+	}
+	times := "once"
+	if limit.Times != 1 {
+		times = fmt.Sprintf("%d times", limit.Times)
+	}
+	return times + " per " + string(limit.Per)
+}
+
+func explainGenerationalRequirement(requirement shared.GenerationalEventRequirement) string {
+	switch requirement.Event {
+	case shared.GenerationalEventTRRaise:
+		return "if your TR was raised this generation"
+	default:
+		return "if you made a " + strings.ReplaceAll(string(requirement.Event), "-", " ") + " this generation"
+	}
+}
+
+// selectorNoun describes the cards selectors match: "space event", "jovian card",
+// "card with a basic cost of 20 M€ or more"; alternatives are joined with "or"
+func selectorNoun(selectors []shared.Selector, plural bool) string {
+	if len(selectors) == 0 {
+		if plural {
+			return "cards"
+		}
+		return "card"
+	}
+	nouns := make([]string, 0, len(selectors))
+	for _, selector := range selectors {
+		words := make([]string, 0, len(selector.Tags)+1)
+		for _, tag := range selector.Tags {
+			words = append(words, string(tag))
+		}
+		noun := "card"
+		if len(selector.CardTypes) > 0 {
+			noun = strings.Join(selector.CardTypes, " or ")
+		}
+		if plural {
+			noun += "s"
+		}
+		words = append(words, noun)
+		nouns = append(nouns, strings.Join(words, " ")+costPhrase([]shared.Selector{selector}))
+	}
+	return strings.Join(nouns, " or ")
+}
+
+// discountTarget names what a discount applies to: "space cards", "standard projects"
+func discountTarget(selectors []shared.Selector) string {
+	for _, selector := range selectors {
+		if len(selector.StandardProjects) > 0 {
+			names := make([]string, 0, len(selector.StandardProjects))
+			for _, project := range selector.StandardProjects {
+				names = append(names, strings.ReplaceAll(string(project), "-", " "))
+			}
+			return "the " + strings.Join(names, " or ") + " standard project"
+		}
+	}
+	return selectorNoun(selectors, true)
+}
+
+func selectorTags(selectors []shared.Selector) string {
+	var tags []string
+	for _, selector := range selectors {
+		for _, tag := range selector.Tags {
+			tags = append(tags, string(tag))
+		}
+	}
+	if len(tags) == 0 {
+		return "any"
+	}
+	return strings.Join(tags, " or ")
+}
+
+func selectorResourceList(selectors []shared.Selector) string {
+	var resources []string
+	for _, selector := range selectors {
+		resources = append(resources, selector.Resources...)
+	}
+	return strings.Join(resources, " or ")
+}
+
+// selectorResources names the resources selectors match, in plural: "steel", "plants, microbes or animals"
+func selectorResources(selectors []shared.Selector) string {
+	var names []string
+	for _, selector := range selectors {
+		for _, resource := range selector.Resources {
+			_, plural := resourceNames(shared.ResourceType(resource))
+			names = append(names, plural)
+		}
+	}
+	switch len(names) {
+	case 0:
+		return "resources"
+	case 1:
+		return names[0]
+	default:
+		return strings.Join(names[:len(names)-1], ", ") + " or " + names[len(names)-1]
+	}
+}
+
+func costPhrase(selectors []shared.Selector) string {
+	for _, selector := range selectors {
+		cost := selector.RequiredOriginalCost
+		if cost == nil {
+			continue
+		}
+		switch {
+		case cost.Min != nil:
+			return fmt.Sprintf(" with a basic cost of %d M€ or more", *cost.Min)
+		case cost.Max != nil:
+			return fmt.Sprintf(" with a basic cost of %d M€ or less", *cost.Max)
+		}
+	}
+	return ""
+}
+
+var resourceNameTable = map[shared.ResourceType][2]string{
+	shared.ResourceCredit:        {"M€", "M€"},
+	shared.ResourceSteel:         {"steel", "steel"},
+	shared.ResourceTitanium:      {"titanium", "titanium"},
+	shared.ResourcePlant:         {"plant", "plants"},
+	shared.ResourceEnergy:        {"energy", "energy"},
+	shared.ResourceHeat:          {"heat", "heat"},
+	shared.ResourceMicrobe:       {"microbe", "microbes"},
+	shared.ResourceAnimal:        {"animal", "animals"},
+	shared.ResourceFloater:       {"floater", "floaters"},
+	shared.ResourceScience:       {"science resource", "science resources"},
+	shared.ResourceAsteroid:      {"asteroid", "asteroids"},
+	shared.ResourceDisease:       {"disease", "diseases"},
+	shared.ResourceTR:            {"TR", "TR"},
+	shared.ResourceCardDraw:      {"card", "cards"},
+	shared.ResourceCityTile:      {"city tile", "city tiles"},
+	shared.ResourceOceanTile:     {"ocean tile", "ocean tiles"},
+	shared.ResourceGreeneryTile:  {"greenery tile", "greenery tiles"},
+	shared.ResourceColonyTile:    {"colony", "colonies"},
+	shared.ResourceSpecialTile:   {"special tile", "special tiles"},
+	shared.ResourceAnyProduction: {"production of any kind", "production of any kind"},
+}
+
+func resourceNames(rt shared.ResourceType) (singular, plural string) {
+	if names, ok := resourceNameTable[rt]; ok {
+		return names[0], names[1]
+	}
+	name := strings.ReplaceAll(string(rt), "-", " ")
+	return name, name
+}
+
+// quantity renders an amount of a resource: "1 plant", "3 plants", "2 M€"
+func quantity(rt shared.ResourceType, amount int) string {
+	if isProduction(rt) {
+		return fmt.Sprintf("%d %s production", amount, productionBase(rt))
+	}
+	singular, plural := resourceNames(rt)
+	if amount == 1 {
+		return "1 " + singular
+	}
+	return fmt.Sprintf("%d %s", amount, plural)
+}
+
+var productionBases = map[shared.ResourceType]string{
+	shared.ResourceCreditProduction:   "M€",
+	shared.ResourceSteelProduction:    "steel",
+	shared.ResourceTitaniumProduction: "titanium",
+	shared.ResourcePlantProduction:    "plant",
+	shared.ResourceEnergyProduction:   "energy",
+	shared.ResourceHeatProduction:     "heat",
+}
+
+func isProduction(rt shared.ResourceType) bool {
+	_, ok := productionBases[rt]
+	return ok
+}
+
+func productionBase(rt shared.ResourceType) string {
+	return productionBases[rt]
+}
+
+func isBasicResource(rt shared.ResourceType) bool {
+	switch rt {
+	case shared.ResourceCredit, shared.ResourceSteel, shared.ResourceTitanium,
+		shared.ResourcePlant, shared.ResourceEnergy, shared.ResourceHeat:
+		return true
+	}
+	return false
+}
+
+func isCardResource(rt shared.ResourceType) bool {
+	switch rt {
+	case shared.ResourceMicrobe, shared.ResourceAnimal, shared.ResourceFloater,
+		shared.ResourceScience, shared.ResourceAsteroid, shared.ResourceDisease:
+		return true
+	}
+	return false
+}
+
+func steps(amount int) string {
+	return countNoun(amount, "1 step", "steps")
+}
+
+// countNoun renders the singular phrase for 1, otherwise the amount and the plural
+func countNoun(amount int, singular, plural string) string {
+	if amount == 1 {
+		return singular
+	}
+	return fmt.Sprintf("%d %s", amount, plural)
+}
+
+func withArticle(noun string) string {
+	if noun != "" && strings.ContainsRune("aeiou", rune(noun[0])) {
+		return "an " + noun
+	}
+	return "a " + noun
+}
+
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
+
+func peekRank(rt shared.ResourceType) int {
+	switch rt {
+	case shared.ResourceCardPeek:
+		return 0
+	case shared.ResourceCardTake, shared.ResourceCardBuy:
+		return 1
+	default:
+		return 2
+	}
+}
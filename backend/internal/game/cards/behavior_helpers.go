@@ -88,12 +88,12 @@ func GetPassiveBehaviors(card *Card) []shared.CardBehavior {
 }
 
 // HasPersistentEffects checks if a behavior has persistent outputs that should be
-// registered as effects (e.g., discount, payment-substitute)
+// registered as effects (e.g., discount, payment-substitute, defense)
 // These are different from immediate resource gains - they modify future actions
 func HasPersistentEffects(behavior shared.CardBehavior) bool {
 	for _, output := range behavior.Outputs {
 		switch output.ResourceType {
-		case shared.ResourceDiscount, shared.ResourcePaymentSubstitute:
+		case shared.ResourceDiscount, shared.ResourcePaymentSubstitute, shared.ResourceDefense:
 			return true
 		}
 	}
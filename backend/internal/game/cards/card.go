@@ -28,9 +28,6 @@ type Card struct {
 	Behaviors       []shared.CardBehavior   `json:"behaviors"`
 	ResourceStorage *ResourceStorage        `json:"resourceStorage"`
 	VPConditions    []VictoryPointCondition `json:"vpConditions"`
-
-	StartingResources  *shared.ResourceSet `json:"startingResources"`
-	StartingProduction *shared.ResourceSet `json:"startingProduction"`
 }
 
 // DeepCopy creates a deep copy of the Card
@@ -62,31 +59,17 @@ func (c Card) DeepCopy() Card {
 		resourceStorage = &rs
 	}
 
-	var startingResources *shared.ResourceSet
-	if c.StartingResources != nil {
-		rs := *c.StartingResources
-		startingResources = &rs
-	}
-
-	var startingProduction *shared.ResourceSet
-	if c.StartingProduction != nil {
-		sp := *c.StartingProduction
-		startingProduction = &sp
-	}
-
 	return Card{
-		ID:                 c.ID,
-		Name:               c.Name,
-		Type:               c.Type,
-		Cost:               c.Cost,
-		Description:        c.Description,
-		Pack:               c.Pack,
-		Tags:               tags,
-		Requirements:       requirements,
-		Behaviors:          behaviors,
-		ResourceStorage:    resourceStorage,
-		VPConditions:       vpConditions,
-		StartingResources:  startingResources,
-		StartingProduction: startingProduction,
+		ID:              c.ID,
+		Name:            c.Name,
+		Type:            c.Type,
+		Cost:            c.Cost,
+		Description:     c.Description,
+		Pack:            c.Pack,
+		Tags:            tags,
+		Requirements:    requirements,
+		Behaviors:       behaviors,
+		ResourceStorage: resourceStorage,
+		VPConditions:    vpConditions,
 	}
 }
@@ -46,18 +46,6 @@ func ValidateCardJSON(card *Card) []error {
 		}
 	}
 
-	if card.StartingResources != nil {
-		if rsErr := validateResourceSet(card.ID, "starting resources", *card.StartingResources); rsErr != nil {
-			errors = append(errors, rsErr)
-		}
-	}
-
-	if card.StartingProduction != nil {
-		if prodErr := validateResourceSet(card.ID, "starting production", *card.StartingProduction); prodErr != nil {
-			errors = append(errors, prodErr)
-		}
-	}
-
 	return errors
 }
 
@@ -118,6 +106,15 @@ func validateBehavior(cardID string, index int, behavior shared.CardBehavior) []
 		}
 	}
 
+	if limit := behavior.UsageLimit; limit != nil {
+		if limit.Per != shared.UsagePeriodGeneration && limit.Per != shared.UsagePeriodGame {
+			errors = append(errors, fmt.Errorf("card %s: behavior[%d].usageLimit has invalid period: %s", cardID, index, limit.Per))
+		}
+		if limit.Times < 1 {
+			errors = append(errors, fmt.Errorf("card %s: behavior[%d].usageLimit must allow at least one use", cardID, index))
+		}
+	}
+
 	return errors
 }
 
@@ -149,10 +146,6 @@ func validateVictoryPointCondition(cardID string, index int, vp VictoryPointCond
 	return nil
 }
 
-func validateResourceSet(cardID, fieldName string, rs shared.ResourceSet) error {
-	return nil
-}
-
 func isValidCardType(ct CardType) bool {
 	switch ct {
 	case CardTypeCorporation, CardTypeAutomated, CardTypeActive, CardTypeEvent, CardTypePrelude:
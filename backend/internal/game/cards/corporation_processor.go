@@ -64,6 +64,76 @@ func (p *CorporationProcessor) ApplyStartingEffects(
 	return nil
 }
 
+// StartingCredits returns the M€ a corporation grants through its auto-corporation-start behaviors,
+// so a starting selection can be checked for affordability before anything is applied
+func (p *CorporationProcessor) StartingCredits(card *Card) int {
+	resources, _ := StartingResources(card)
+	if resources == nil {
+		return 0
+	}
+	return resources.Credits
+}
+
+// StartingResources sums the resources and production a corporation's auto-corporation-start behaviors grant.
+// They are read from the behavior data, the same outputs ApplyStartingEffects applies; either set is nil when empty.
+func StartingResources(card *Card) (resources *shared.ResourceSet, production *shared.ResourceSet) {
+	var res, prod shared.ResourceSet
+	for _, behavior := range card.Behaviors {
+		if !HasCorporationStartTrigger(behavior) {
+			continue
+		}
+		for _, output := range behavior.Outputs {
+			if output.Per != nil || output.Target != string(TargetSelfPlayer) {
+				continue
+			}
+			if field := resourceSetField(&res, &prod, output.ResourceType); field != nil {
+				*field += output.Amount
+			}
+		}
+	}
+
+	if res != (shared.ResourceSet{}) {
+		resources = &res
+	}
+	if prod != (shared.ResourceSet{}) {
+		production = &prod
+	}
+	return resources, production
+}
+
+// resourceSetField points at the field of the resource or production set a resource type adds to,
+// or returns nil for types outside the six standard resources
+func resourceSetField(res, prod *shared.ResourceSet, resourceType shared.ResourceType) *int {
+	switch resourceType {
+	case shared.ResourceCredit:
+		return &res.Credits
+	case shared.ResourceSteel:
+		return &res.Steel
+	case shared.ResourceTitanium:
+		return &res.Titanium
+	case shared.ResourcePlant:
+		return &res.Plants
+	case shared.ResourceEnergy:
+		return &res.Energy
+	case shared.ResourceHeat:
+		return &res.Heat
+	case shared.ResourceCreditProduction:
+		return &prod.Credits
+	case shared.ResourceSteelProduction:
+		return &prod.Steel
+	case shared.ResourceTitaniumProduction:
+		return &prod.Titanium
+	case shared.ResourcePlantProduction:
+		return &prod.Plants
+	case shared.ResourceEnergyProduction:
+		return &prod.Energy
+	case shared.ResourceHeatProduction:
+		return &prod.Heat
+	default:
+		return nil
+	}
+}
+
 // ApplyAutoEffects processes auto triggers WITHOUT conditions
 // (e.g., payment-substitute for Helion)
 func (p *CorporationProcessor) ApplyAutoEffects(
@@ -27,12 +27,23 @@ func CountPlayerTiles(playerID string, b *board.Board, tileType *shared.Resource
 	return count
 }
 
-// CountPlayerTagsByType counts tags of a specific type across all played cards for a player.
+// CountPlayerTagsByType counts tags of a specific type across a player's corporation and played cards.
+// Used for tag requirements, milestones, awards, VP and per-tag effects alike.
+// Face-down events show only their event tag.
 func CountPlayerTagsByType(p *player.Player, cardRegistry CardRegistryInterface, tagType shared.CardTag) int {
 	count := 0
-	playedCardIDs := p.PlayedCards().Cards()
+	cardIDs := p.PlayedCards().Cards()
+	if corporationID := p.CorporationID(); corporationID != "" {
+		cardIDs = append(cardIDs, corporationID)
+	}
 
-	for _, cardID := range playedCardIDs {
+	for _, cardID := range cardIDs {
+		if p.PlayedCards().IsFaceDown(cardID) {
+			if tagType == shared.TagEvent {
+				count++
+			}
+			continue
+		}
 		card, err := cardRegistry.GetByID(cardID)
 		if err != nil {
 			continue // Skip cards not in registry
@@ -3,14 +3,12 @@ package cards
 import (
 	"fmt"
 
+	"terraforming-mars-backend/internal/game"
 	"terraforming-mars-backend/internal/game/board"
 	"terraforming-mars-backend/internal/game/player"
 	"terraforming-mars-backend/internal/game/shared"
 )
 
-// Milestone VP constant
-const MilestoneClaimedVP = 5 // VP for each claimed milestone
-
 // CardVPConditionDetail represents the detailed calculation of a single VP condition
 type CardVPConditionDetail struct {
 	ConditionType  string `json:"conditionType"`  // "fixed", "per", "once"
@@ -75,7 +73,20 @@ type AwardsInterface interface {
 
 // FundedAwardInfo represents info about a funded award
 type FundedAwardInfo struct {
-	Type string
+	Type      string
+	Standings []AwardPlacement // Frozen at funding; nil scores the award on the current state
+}
+
+// ToFundedAwardInfo converts a game's funded awards to the format expected by the VP calculator
+func ToFundedAwardInfo(funded []game.FundedAward) []FundedAwardInfo {
+	result := make([]FundedAwardInfo, len(funded))
+	for i, a := range funded {
+		result[i] = FundedAwardInfo{
+			Type:      string(a.Type),
+			Standings: a.StandingsAtFunding,
+		}
+	}
+	return result
 }
 
 // CalculatePlayerVP computes the total VP for a player with detailed breakdown
@@ -86,6 +97,7 @@ func CalculatePlayerVP(
 	fundedAwards []FundedAwardInfo,
 	allPlayers []*player.Player,
 	cardRegistry CardRegistryInterface,
+	scoring game.ScoringVP,
 ) VPBreakdown {
 	breakdown := VPBreakdown{}
 
@@ -100,11 +112,11 @@ func CalculatePlayerVP(
 		breakdown.CardVP += detail.TotalVP
 	}
 
-	// 3. Milestone VP (5 VP per claimed milestone)
-	breakdown.MilestoneVP = calculateMilestoneVP(p.ID(), claimedMilestones)
+	// 3. Milestone VP (per claimed milestone, 5 VP unless a house rule changes it)
+	breakdown.MilestoneVP = calculateMilestoneVP(p.ID(), claimedMilestones, scoring)
 
 	// 4. Award VP
-	breakdown.AwardVP = calculateAwardVP(p.ID(), fundedAwards, allPlayers, b, cardRegistry)
+	breakdown.AwardVP = calculateAwardVP(p.ID(), fundedAwards, allPlayers, b, cardRegistry, scoring)
 
 	// 5. Greenery VP (1 VP per greenery tile owned) with detailed breakdown
 	greeneryDetails := calculateGreeneryVPDetailed(p.ID(), b)
@@ -132,6 +144,21 @@ func CalculatePlayerVP(
 	return breakdown
 }
 
+// PreviewCardVP returns the current VP value of each of the player's played cards, scored the same way
+// as at game end. Values are cached on the player until the game invalidates them.
+func PreviewCardVP(p *player.Player, b *board.Board, cardRegistry CardRegistryInterface) map[string]int {
+	if cardVP, ok := p.VPPreview().Get(); ok {
+		return cardVP
+	}
+
+	cardVP := make(map[string]int)
+	for _, detail := range calculateCardVPDetailed(p, b, cardRegistry) {
+		cardVP[detail.CardID] = detail.TotalVP
+	}
+	p.VPPreview().Set(cardVP)
+	return cardVP
+}
+
 // calculateCardVPDetailed calculates VP from all played cards with detailed breakdown
 func calculateCardVPDetailed(p *player.Player, b *board.Board, cardRegistry CardRegistryInterface) []CardVPDetail {
 	var details []CardVPDetail
@@ -289,11 +316,11 @@ func countPerCondition(
 }
 
 // calculateMilestoneVP calculates VP from claimed milestones
-func calculateMilestoneVP(playerID string, claimedMilestones []ClaimedMilestoneInfo) int {
+func calculateMilestoneVP(playerID string, claimedMilestones []ClaimedMilestoneInfo, scoring game.ScoringVP) int {
 	vp := 0
 	for _, milestone := range claimedMilestones {
 		if milestone.PlayerID == playerID {
-			vp += MilestoneClaimedVP
+			vp += scoring.Milestone
 		}
 	}
 	return vp
@@ -306,14 +333,18 @@ func calculateAwardVP(
 	allPlayers []*player.Player,
 	b *board.Board,
 	cardRegistry CardRegistryInterface,
+	scoring game.ScoringVP,
 ) int {
 	totalVP := 0
 
 	for _, award := range fundedAwards {
-		placements := ScoreAward(shared.AwardType(award.Type), allPlayers, b, cardRegistry)
+		placements := award.Standings
+		if placements == nil {
+			placements = ScoreAward(shared.AwardType(award.Type), allPlayers, b, cardRegistry)
+		}
 		for _, placement := range placements {
 			if placement.PlayerID == playerID {
-				totalVP += GetAwardVP(placement.Placement)
+				totalVP += GetAwardVP(placement.Placement, scoring)
 				break
 			}
 		}
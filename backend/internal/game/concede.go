@@ -0,0 +1,12 @@
+package game
+
+import "time"
+
+// ConcededPlayer records a player who left a running game by conceding
+// Their tiles stay on the board under their ID, so the record keeps the name for display
+type ConcededPlayer struct {
+	PlayerID   string
+	PlayerName string
+	AccountID  string // Account the seat belonged to, if any
+	ConcededAt time.Time
+}
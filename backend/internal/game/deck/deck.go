@@ -2,8 +2,16 @@ package deck
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/binary"
 	"fmt"
+	"math/rand/v2"
+	"slices"
 	"sync"
+
+	"go.uber.org/zap"
+
+	"terraforming-mars-backend/internal/logger"
 )
 
 // Deck represents the card deck state for a game with encapsulated state
@@ -16,11 +24,54 @@ type Deck struct {
 	removedCards   []string // Cards removed from game permanently
 	preludeCards   []string // Available prelude card IDs
 	drawnCardCount int      // Total cards drawn (for statistics)
-	shuffleCount   int      // Number of times deck was shuffled
+	shuffleCount   int      // Number of times the discard pile was shuffled back in (deck cycles)
+	shortfall      int      // Cards asked for while both the draw and discard piles were empty
+
+	// Every shuffle draws from one ChaCha8 stream, so a seeded deck's whole history follows from its seed
+	source       *rand.ChaCha8
+	rng          *rand.Rand
+	seed         Seed
+	initialOrder *Order // Starting order; nil unless the deck was shuffled from a seed
+	commitment   string
 }
 
-// NewDeck creates a new game deck with all cards available
+// NewDeck creates a new game deck with all cards available, keeping the given order
+// Later reshuffles are unpredictable, but the deck has no commitment to verify against
 func NewDeck(gameID string, projectCardIDs, corpIDs, preludeIDs []string) *Deck {
+	var seed Seed
+	for i := 0; i < len(seed); i += 8 {
+		binary.LittleEndian.PutUint64(seed[i:], rand.Uint64())
+	}
+	return newDeck(gameID, projectCardIDs, corpIDs, preludeIDs, seed)
+}
+
+// NewShuffledDeck creates a new game deck shuffled from a fresh cryptographic seed
+func NewShuffledDeck(gameID string, projectCardIDs, corpIDs, preludeIDs []string) (*Deck, error) {
+	seed, err := NewSeed()
+	if err != nil {
+		return nil, err
+	}
+	return NewSeededDeck(gameID, projectCardIDs, corpIDs, preludeIDs, seed), nil
+}
+
+// NewSeededDeck creates a new game deck in the order ShuffleOrder derives from the seed,
+// and commits to that order so players can check it once the seed is revealed
+func NewSeededDeck(gameID string, projectCardIDs, corpIDs, preludeIDs []string, seed Seed) *Deck {
+	d := newDeck(gameID, nil, nil, nil, seed)
+	order := Order{
+		ProjectCards: shuffledCopy(d.rng, projectCardIDs),
+		Corporations: shuffledCopy(d.rng, corpIDs),
+		Preludes:     shuffledCopy(d.rng, preludeIDs),
+	}
+	d.projectCards = slices.Clone(order.ProjectCards)
+	d.corporations = slices.Clone(order.Corporations)
+	d.preludeCards = slices.Clone(order.Preludes)
+	d.initialOrder = &order
+	d.commitment = order.Commitment(seed)
+	return d
+}
+
+func newDeck(gameID string, projectCardIDs, corpIDs, preludeIDs []string, seed Seed) *Deck {
 	projectCopy := make([]string, len(projectCardIDs))
 	copy(projectCopy, projectCardIDs)
 
@@ -30,6 +81,7 @@ func NewDeck(gameID string, projectCardIDs, corpIDs, preludeIDs []string) *Deck
 	preludeCopy := make([]string, len(preludeIDs))
 	copy(preludeCopy, preludeIDs)
 
+	source := rand.NewChaCha8(seed)
 	return &Deck{
 		gameID:         gameID,
 		projectCards:   projectCopy,
@@ -39,7 +91,33 @@ func NewDeck(gameID string, projectCardIDs, corpIDs, preludeIDs []string) *Deck
 		removedCards:   make([]string, 0),
 		drawnCardCount: 0,
 		shuffleCount:   0,
+		source:         source,
+		rng:            rand.New(source),
+		seed:           seed,
+	}
+}
+
+// Commitment returns the hash published for the deck's starting order, or "" for an unseeded deck
+func (d *Deck) Commitment() string {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	return d.commitment
+}
+
+// Reveal returns the seed and starting order behind the commitment; false for an unseeded deck
+// Only reveal them once the game has ended: the seed predicts every card still to be drawn
+func (d *Deck) Reveal() (Seed, Order, bool) {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	if d.initialOrder == nil {
+		return Seed{}, Order{}, false
+	}
+	order := Order{
+		ProjectCards: slices.Clone(d.initialOrder.ProjectCards),
+		Corporations: slices.Clone(d.initialOrder.Corporations),
+		Preludes:     slices.Clone(d.initialOrder.Preludes),
 	}
+	return d.seed, order, true
 }
 
 // GameID returns the game ID this deck belongs to
@@ -101,13 +179,21 @@ func (d *Deck) DrawnCardCount() int {
 	return d.drawnCardCount
 }
 
-// ShuffleCount returns the number of times the deck was shuffled
+// ShuffleCount returns the number of times the discard pile was shuffled back into the draw pile,
+// which is how many times the deck has cycled
 func (d *Deck) ShuffleCount() int {
 	d.mu.RLock()
 	defer d.mu.RUnlock()
 	return d.shuffleCount
 }
 
+// Shortfall returns how many requested cards could not be drawn because the deck was exhausted
+func (d *Deck) Shortfall() int {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	return d.shortfall
+}
+
 // GetAvailableCardCount returns the number of available project cards
 func (d *Deck) GetAvailableCardCount() int {
 	d.mu.RLock()
@@ -116,7 +202,9 @@ func (d *Deck) GetAvailableCardCount() int {
 }
 
 // DrawProjectCards draws N project cards from the deck
-// Returns the drawn card IDs or error if not enough cards available
+// When the draw pile runs short, the discard pile is shuffled in under it. If the deck is exhausted
+// even then, every card left is drawn: callers get fewer cards rather than an error, and the shortfall
+// is logged and counted.
 func (d *Deck) DrawProjectCards(ctx context.Context, count int) ([]string, error) {
 	if err := ctx.Err(); err != nil {
 		return nil, err
@@ -125,15 +213,28 @@ func (d *Deck) DrawProjectCards(ctx context.Context, count int) ([]string, error
 	d.mu.Lock()
 	defer d.mu.Unlock()
 
-	available := len(d.projectCards)
-	if count > available {
-		return nil, fmt.Errorf("not enough cards available: requested %d, have %d", count, available)
+	if count > len(d.projectCards) && len(d.discardPile) > 0 {
+		d.shuffleDiscardPile()
+		logger.Get().Info("🔀 Shuffled the discard pile into the deck",
+			zap.String("game_id", d.gameID),
+			zap.Int("deck_cycles", d.shuffleCount),
+			zap.Int("cards", len(d.projectCards)))
 	}
 
-	drawnCards := make([]string, count)
-	copy(drawnCards, d.projectCards[:count])
-	d.projectCards = d.projectCards[count:]
-	d.drawnCardCount += count
+	drawn := min(count, len(d.projectCards))
+	if drawn < count {
+		d.shortfall += count - drawn
+		logger.Get().Warn("🂠 Deck exhausted, drew fewer cards than requested",
+			zap.String("game_id", d.gameID),
+			zap.Int("requested", count),
+			zap.Int("drawn", drawn),
+			zap.Int("deck_cycles", d.shuffleCount))
+	}
+
+	drawnCards := make([]string, drawn)
+	copy(drawnCards, d.projectCards[:drawn])
+	d.projectCards = d.projectCards[drawn:]
+	d.drawnCardCount += drawn
 
 	return drawnCards, nil
 }
@@ -160,6 +261,28 @@ func (d *Deck) DrawCorporations(ctx context.Context, count int) ([]string, error
 	return drawnCorps, nil
 }
 
+// DrawPreludes draws N prelude cards
+// Returns the drawn prelude IDs or error if not enough available
+func (d *Deck) DrawPreludes(ctx context.Context, count int) ([]string, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	available := len(d.preludeCards)
+	if count > available {
+		return nil, fmt.Errorf("not enough preludes available: requested %d, have %d", count, available)
+	}
+
+	drawnPreludes := make([]string, count)
+	copy(drawnPreludes, d.preludeCards[:count])
+	d.preludeCards = d.preludeCards[count:]
+
+	return drawnPreludes, nil
+}
+
 // Discard adds cards to the discard pile
 func (d *Deck) Discard(ctx context.Context, cardIDs []string) error {
 	if err := ctx.Err(); err != nil {
@@ -186,7 +309,7 @@ func (d *Deck) Remove(ctx context.Context, cardIDs []string) error {
 	return nil
 }
 
-// Shuffle reshuffles the discard pile back into the project cards
+// Shuffle reshuffles the discard pile and puts it under the project cards
 func (d *Deck) Shuffle(ctx context.Context) error {
 	if err := ctx.Err(); err != nil {
 		return err
@@ -195,9 +318,171 @@ func (d *Deck) Shuffle(ctx context.Context) error {
 	d.mu.Lock()
 	defer d.mu.Unlock()
 
+	d.shuffleDiscardPile()
+	return nil
+}
+
+// shuffleDiscardPile shuffles the discard pile and puts it under the draw pile; the caller holds the lock
+func (d *Deck) shuffleDiscardPile() {
+	d.shuffleCards(d.discardPile)
 	d.projectCards = append(d.projectCards, d.discardPile...)
 	d.discardPile = make([]string, 0)
 	d.shuffleCount++
+}
 
-	return nil
+// ShuffleSeats returns the seats in a random order drawn from the deck's seed
+// The stream is derived from the seed apart from the card stream, so seating never changes which
+// cards are dealt, and replaying a game with its revealed seed seats the players the same way
+func (d *Deck) ShuffleSeats(playerIDs []string) []string {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+
+	seats := slices.Clone(playerIDs)
+	rng := rand.New(rand.NewChaCha8(sha256.Sum256(append(d.seed[:], "seats"...))))
+	rng.Shuffle(len(seats), func(i, j int) {
+		seats[i], seats[j] = seats[j], seats[i]
+	})
+	return seats
+}
+
+// RevealUntil reveals project cards from the top of the draw pile until count of them match, taking the
+// matches and discarding the rest, the way "reveal cards until you reveal 2 space cards" plays at the table.
+// If the draw pile runs out, the discard pile is shuffled in once; the cards revealed so far stay aside
+// and are discarded after. Fewer than count are taken when the deck runs out first, counted as a shortfall.
+// Only taken cards count as drawn
+func (d *Deck) RevealUntil(ctx context.Context, count int, match func(cardID string) bool) (taken, discarded []string, err error) {
+	if err := ctx.Err(); err != nil {
+		return nil, nil, err
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	taken = make([]string, 0, count)
+	discarded = make([]string, 0)
+	reshuffled := false
+	for len(taken) < count {
+		if len(d.projectCards) == 0 {
+			if reshuffled || len(d.discardPile) == 0 {
+				break
+			}
+			d.shuffleDiscardPile()
+			reshuffled = true
+			logger.Get().Info("🔀 Shuffled the discard pile into the deck",
+				zap.String("game_id", d.gameID),
+				zap.Int("deck_cycles", d.shuffleCount),
+				zap.Int("cards", len(d.projectCards)))
+			continue
+		}
+
+		cardID := d.projectCards[0]
+		d.projectCards = d.projectCards[1:]
+		if match(cardID) {
+			taken = append(taken, cardID)
+		} else {
+			discarded = append(discarded, cardID)
+		}
+	}
+	d.discardPile = append(d.discardPile, discarded...)
+	d.drawnCardCount += len(taken)
+
+	if len(taken) < count {
+		d.shortfall += count - len(taken)
+		logger.Get().Warn("🂠 Deck exhausted before enough matching cards were revealed",
+			zap.String("game_id", d.gameID),
+			zap.Int("requested", count),
+			zap.Int("taken", len(taken)),
+			zap.Int("deck_cycles", d.shuffleCount))
+	}
+
+	return taken, discarded, nil
+}
+
+// Take pulls one project card out of the draw pile or the discard pile, leaving the rest in order
+// Reports whether the card was found in either
+func (d *Deck) Take(ctx context.Context, cardID string) (bool, error) {
+	if err := ctx.Err(); err != nil {
+		return false, err
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	for _, pile := range []*[]string{&d.projectCards, &d.discardPile} {
+		for i, id := range *pile {
+			if id == cardID {
+				*pile = append((*pile)[:i:i], (*pile)[i+1:]...)
+				return true, nil
+			}
+		}
+	}
+	return false, nil
+}
+
+// shuffleCards shuffles card IDs in place from the deck's stream
+func (d *Deck) shuffleCards(cardIDs []string) {
+	d.rng.Shuffle(len(cardIDs), func(i, j int) {
+		cardIDs[i], cardIDs[j] = cardIDs[j], cardIDs[i]
+	})
+}
+
+// Checkpoint is a copy of the deck's piles and counters
+type Checkpoint struct {
+	projectCards   []string
+	corporations   []string
+	discardPile    []string
+	removedCards   []string
+	preludeCards   []string
+	drawnCardCount int
+	shuffleCount   int
+	shortfall      int
+	rngState       []byte
+}
+
+// Checkpoint captures the deck's current state
+func (d *Deck) Checkpoint() Checkpoint {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	// Restoring the stream keeps a rolled-back shuffle from changing the shuffles after it
+	rngState, _ := d.source.MarshalBinary()
+	return Checkpoint{
+		projectCards:   append([]string{}, d.projectCards...),
+		corporations:   append([]string{}, d.corporations...),
+		discardPile:    append([]string{}, d.discardPile...),
+		removedCards:   append([]string{}, d.removedCards...),
+		preludeCards:   append([]string{}, d.preludeCards...),
+		drawnCardCount: d.drawnCardCount,
+		shuffleCount:   d.shuffleCount,
+		shortfall:      d.shortfall,
+		rngState:       rngState,
+	}
+}
+
+// Restore puts the deck back to a checkpoint
+func (d *Deck) Restore(cp Checkpoint) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.projectCards = append([]string{}, cp.projectCards...)
+	d.corporations = append([]string{}, cp.corporations...)
+	d.discardPile = append([]string{}, cp.discardPile...)
+	d.removedCards = append([]string{}, cp.removedCards...)
+	d.preludeCards = append([]string{}, cp.preludeCards...)
+	d.drawnCardCount = cp.drawnCardCount
+	d.shuffleCount = cp.shuffleCount
+	d.shortfall = cp.shortfall
+	if cp.rngState != nil {
+		_ = d.source.UnmarshalBinary(cp.rngState)
+	}
+}
+
+// Clone returns an independent deck in the same state, drawing from the same shuffle stream
+// Draws from the clone never reach this deck
+func (d *Deck) Clone() *Deck {
+	clone := newDeck(d.gameID, nil, nil, nil, d.seed)
+	clone.Restore(d.Checkpoint())
+	d.mu.RLock()
+	clone.initialOrder = d.initialOrder
+	clone.commitment = d.commitment
+	d.mu.RUnlock()
+	return clone
 }
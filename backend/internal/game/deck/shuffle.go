@@ -0,0 +1,103 @@
+package deck
+
+import (
+	crand "crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"math/rand/v2"
+	"slices"
+	"strings"
+)
+
+// ShuffleAlgorithm names how a seed turns a card pool into a deck order, so exports stay verifiable
+// if the algorithm ever changes
+const ShuffleAlgorithm = "chacha8-fisher-yates-v1"
+
+// Seed is the secret a game's deck order is derived from
+// It is committed to at game creation and only revealed once the game has ended
+type Seed [32]byte
+
+// NewSeed draws a seed from the operating system's cryptographic randomness
+func NewSeed() (Seed, error) {
+	var seed Seed
+	if _, err := crand.Read(seed[:]); err != nil {
+		return Seed{}, fmt.Errorf("failed to read random seed: %w", err)
+	}
+	return seed, nil
+}
+
+// ParseSeed decodes a hex-encoded seed
+func ParseSeed(s string) (Seed, error) {
+	var seed Seed
+	raw, err := hex.DecodeString(s)
+	if err != nil {
+		return Seed{}, fmt.Errorf("invalid seed: %w", err)
+	}
+	if len(raw) != len(seed) {
+		return Seed{}, fmt.Errorf("invalid seed: want %d bytes, got %d", len(seed), len(raw))
+	}
+	copy(seed[:], raw)
+	return seed, nil
+}
+
+// String hex-encodes the seed
+func (s Seed) String() string {
+	return hex.EncodeToString(s[:])
+}
+
+// Order is a deck's starting order: the top card of each pile comes first
+type Order struct {
+	ProjectCards []string
+	Corporations []string
+	Preludes     []string
+}
+
+// ShuffleOrder derives the starting order of a card pool from a seed
+// Each pile is sorted first, so the order depends only on the seed and which cards are in the pool,
+// then shuffled with a ChaCha8 stream keyed by the seed: project cards, corporations, then preludes
+func ShuffleOrder(seed Seed, projectCardIDs, corpIDs, preludeIDs []string) Order {
+	rng := rand.New(rand.NewChaCha8(seed))
+	return Order{
+		ProjectCards: shuffledCopy(rng, projectCardIDs),
+		Corporations: shuffledCopy(rng, corpIDs),
+		Preludes:     shuffledCopy(rng, preludeIDs),
+	}
+}
+
+func shuffledCopy(rng *rand.Rand, cardIDs []string) []string {
+	pile := slices.Clone(cardIDs)
+	slices.Sort(pile)
+	rng.Shuffle(len(pile), func(i, j int) {
+		pile[i], pile[j] = pile[j], pile[i]
+	})
+	return pile
+}
+
+// Commitment is the hex SHA-256 of the seed and the order it produced, one line each:
+// "seed:<hex>", "projects:<ids>", "corporations:<ids>", "preludes:<ids>" with comma-separated IDs
+// Publishing it before the first card is dealt binds the server to both the seed and the order
+func (o Order) Commitment(seed Seed) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "seed:%s\n", seed)
+	fmt.Fprintf(&b, "projects:%s\n", strings.Join(o.ProjectCards, ","))
+	fmt.Fprintf(&b, "corporations:%s\n", strings.Join(o.Corporations, ","))
+	fmt.Fprintf(&b, "preludes:%s\n", strings.Join(o.Preludes, ","))
+	sum := sha256.Sum256([]byte(b.String()))
+	return hex.EncodeToString(sum[:])
+}
+
+// VerifyShuffle checks a revealed seed and starting order against the commitment published at game start:
+// the order must be the one the seed derives from its own cards, and must hash to the commitment
+func VerifyShuffle(seed Seed, order Order, commitment string) error {
+	expected := ShuffleOrder(seed, order.ProjectCards, order.Corporations, order.Preludes)
+	if !slices.Equal(expected.ProjectCards, order.ProjectCards) ||
+		!slices.Equal(expected.Corporations, order.Corporations) ||
+		!slices.Equal(expected.Preludes, order.Preludes) {
+		return fmt.Errorf("deck order does not follow from the seed")
+	}
+	if got := order.Commitment(seed); got != commitment {
+		return fmt.Errorf("commitment mismatch: published %s, revealed data hashes to %s", commitment, got)
+	}
+	return nil
+}
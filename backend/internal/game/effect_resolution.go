@@ -0,0 +1,60 @@
+package game
+
+import "slices"
+
+// EffectResolution records a triggered card effect in the order it resolved
+type EffectResolution struct {
+	PlayerID string
+	CardID   string
+	CardName string
+	Trigger  string // Condition type that fired the effect, e.g. "city-placed"
+}
+
+// ResolutionOrder is the sort key for a player's card effect when one event triggers several effects:
+// the active player first, then the others clockwise in turn order; within a player, the corporation
+// first, then cards in the order they were played
+func (g *Game) ResolutionOrder(playerID, cardID string) []int {
+	g.mu.RLock()
+	turnOrder := g.turnOrder
+	activeID := ""
+	if g.currentTurn != nil {
+		activeID = g.currentTurn.PlayerID()
+	}
+	p := g.players[playerID]
+	g.mu.RUnlock()
+
+	seat := len(turnOrder)
+	if index := slices.Index(turnOrder, playerID); index >= 0 {
+		active := max(slices.Index(turnOrder, activeID), 0)
+		seat = (index - active + len(turnOrder)) % len(turnOrder)
+	}
+
+	if p == nil {
+		return []int{seat, 0}
+	}
+	if p.CorporationID() == cardID {
+		return []int{seat, -1}
+	}
+	played := p.PlayedCards().Cards()
+	position := slices.Index(played, cardID)
+	if position < 0 {
+		position = len(played)
+	}
+	return []int{seat, position}
+}
+
+// RecordEffectResolution appends a resolved effect to the list the next log entry picks up
+func (g *Game) RecordEffectResolution(resolution EffectResolution) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.effectResolutions = append(g.effectResolutions, resolution)
+}
+
+// TakeEffectResolutions returns the effects resolved since the last call, in resolution order, and clears them
+func (g *Game) TakeEffectResolutions() []EffectResolution {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	resolutions := g.effectResolutions
+	g.effectResolutions = nil
+	return resolutions
+}
@@ -0,0 +1,60 @@
+package game
+
+// Rough per-item sizes used by Footprint; calibrate against /debug/pprof/heap when they drift
+// Card behaviors are weighted heaviest: every effect and action holds its own copy of the card's behavior tree
+const (
+	footprintBytesPerGame         = 16 * 1024
+	footprintBytesPerPlayer       = 4 * 1024
+	footprintBytesPerCardID       = 48
+	footprintBytesPerBehaviorCopy = 1536
+	footprintBytesPerTile         = 256
+	footprintBytesPerSubscription = 192
+)
+
+// Footprint is an estimate of how much memory a game holds
+// It counts what a game accumulates over time; exact heap usage is only available from pprof
+type Footprint struct {
+	Players            int
+	HandCards          int
+	PlayedCards        int
+	Effects            int
+	Actions            int
+	Tiles              int
+	DeckCards          int
+	EventSubscriptions int
+	EstimatedBytes     int64
+}
+
+// Footprint estimates the game's in-memory size from its players, cards, board, deck and event bus
+func (g *Game) Footprint() Footprint {
+	var fp Footprint
+
+	players := g.GetAllPlayers()
+	fp.Players = len(players)
+	for _, p := range players {
+		fp.HandCards += len(p.Hand().Cards())
+		fp.PlayedCards += len(p.PlayedCards().Cards())
+		fp.Effects += len(p.Effects().List())
+		fp.Actions += len(p.Actions().List())
+	}
+
+	fp.Tiles = len(g.Board().Tiles())
+
+	if d := g.Deck(); d != nil {
+		fp.DeckCards = len(d.ProjectCards()) + len(d.Corporations()) + len(d.PreludeCards()) +
+			len(d.DiscardPile()) + len(d.RemovedCards())
+	}
+
+	if bus := g.EventBus(); bus != nil {
+		fp.EventSubscriptions = bus.SubscriptionCount()
+	}
+
+	fp.EstimatedBytes = int64(footprintBytesPerGame +
+		fp.Players*footprintBytesPerPlayer +
+		(fp.HandCards+fp.PlayedCards+fp.DeckCards)*footprintBytesPerCardID +
+		(fp.Effects+fp.Actions)*footprintBytesPerBehaviorCopy +
+		fp.Tiles*footprintBytesPerTile +
+		fp.EventSubscriptions*footprintBytesPerSubscription)
+
+	return fp
+}
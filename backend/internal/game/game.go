@@ -3,6 +3,8 @@ package game
 import (
 	"context"
 	"fmt"
+	"slices"
+	"sort"
 	"sync"
 	"time"
 
@@ -50,7 +52,17 @@ func (ctx *gameVPRecalculationContext) CountPlayerTagsByType(playerID string, ta
 	if ctx.game.vpCardLookup == nil {
 		return 0
 	}
-	for _, cardID := range p.PlayedCards().Cards() {
+	cardIDs := p.PlayedCards().Cards()
+	if corporationID := p.CorporationID(); corporationID != "" {
+		cardIDs = append(cardIDs, corporationID)
+	}
+	for _, cardID := range cardIDs {
+		if p.PlayedCards().IsFaceDown(cardID) {
+			if tagType == shared.TagEvent {
+				count++
+			}
+			continue
+		}
 		cardInfo, err := ctx.game.vpCardLookup.LookupVPCard(cardID)
 		if err != nil {
 			continue
@@ -86,8 +98,11 @@ type Game struct {
 	settings         GameSettings
 	hostPlayerID     string
 	currentPhase     GamePhase
+	phaseStartedAt   time.Time // When the current phase began, pushed back by time spent paused
 	globalParameters *global_parameters.GlobalParameters
-	currentTurn      *Turn // Tracks active player and available actions (nullable)
+	currentTurn      *Turn                    // Tracks active player and available actions (nullable)
+	turnStartedAt    time.Time                // When the current turn began, pushed back by time spent paused
+	timebankUsed     map[string]time.Duration // playerID -> timebank spent by turns that ran over the turn timer
 	generation       int
 	board            *board.Board
 	deck             *deck.Deck
@@ -95,6 +110,17 @@ type Game struct {
 	turnOrder        []string // Ordered list of player IDs for turn sequence
 	eventBus         *events.EventBusImpl
 
+	randomizeSeatOrder bool                // Shuffle turn order at game start instead of using lobby seating
+	handicaps          map[string]Handicap // playerID -> starting bonus; kept after start as a record
+	playerColors       map[string]string   // playerID -> palette color, unique per game
+	invites            map[string]Invite   // token -> open lobby invite
+
+	pause      *PauseState     // Non-nil while the game is paused
+	pauseVotes map[string]bool // Players asking to pause (while running) or resume (while paused)
+
+	abandonVotes map[string]bool  // Players voting to mark the game abandoned
+	conceded     []ConcededPlayer // Players who conceded, in the order they left
+
 	milestones *Milestones
 	awards     *Awards
 
@@ -104,13 +130,22 @@ type Game struct {
 
 	vpCardLookup VPCardLookup
 
-	triggeredEffects []TriggeredEffect
+	triggeredEffects  []TriggeredEffect
+	effectResolutions []EffectResolution
 
 	pendingTileSelections      map[string]*player.PendingTileSelection
 	pendingTileSelectionQueues map[string]*player.PendingTileSelectionQueue
 	forcedFirstActions         map[string]*player.ForcedFirstAction
+	pendingCardPlays           map[string]*player.PendingCardPlay
+	pendingResponses           map[string]*player.PendingResponse
 	productionPhases           map[string]*player.ProductionPhase
 	selectStartingCardsPhases  map[string]*player.SelectStartingCardsPhase
+
+	generationTallies     map[string]*GenerationTally
+	lastGenerationSummary *GenerationSummary
+
+	actionCtxMu sync.Mutex      // Separate from mu: effects read the context while mu may be held
+	actionCtx   context.Context // Context of the action changing the game, see BindActionContext
 }
 
 // NewGame creates a new game with the given settings
@@ -136,6 +171,9 @@ func NewGame(
 	if settings.Oceans != nil {
 		initOcean = *settings.Oceans
 	}
+	if len(settings.ColorPalette) == 0 {
+		settings.ColorPalette = DefaultColorPalette()
+	}
 
 	g := &Game{
 		id:                         id,
@@ -145,6 +183,7 @@ func NewGame(
 		settings:                   settings,
 		hostPlayerID:               hostPlayerID,
 		currentPhase:               GamePhaseWaitingForGameStart,
+		phaseStartedAt:             now,
 		globalParameters:           global_parameters.NewGlobalParametersWithValues(id, initTemp, initOxy, initOcean, eventBus),
 		generation:                 1,
 		board:                      board.NewBoardWithTiles(id, board.GenerateMarsBoard(), eventBus),
@@ -152,16 +191,27 @@ func NewGame(
 		players:                    make(map[string]*player.Player),
 		turnOrder:                  []string{},
 		eventBus:                   eventBus,
+		randomizeSeatOrder:         true,
+		handicaps:                  make(map[string]Handicap),
+		playerColors:               make(map[string]string),
+		invites:                    make(map[string]Invite),
+		pauseVotes:                 make(map[string]bool),
+		timebankUsed:               make(map[string]time.Duration),
+		abandonVotes:               make(map[string]bool),
 		milestones:                 NewMilestones(id, eventBus),
 		awards:                     NewAwards(id, eventBus),
 		pendingTileSelections:      make(map[string]*player.PendingTileSelection),
 		pendingTileSelectionQueues: make(map[string]*player.PendingTileSelectionQueue),
 		forcedFirstActions:         make(map[string]*player.ForcedFirstAction),
+		pendingCardPlays:           make(map[string]*player.PendingCardPlay),
+		pendingResponses:           make(map[string]*player.PendingResponse),
 		productionPhases:           make(map[string]*player.ProductionPhase),
 		selectStartingCardsPhases:  make(map[string]*player.SelectStartingCardsPhase),
+		generationTallies:          make(map[string]*GenerationTally),
 	}
 
 	g.subscribeToGenerationalEvents()
+	g.subscribeToVPPreviewEvents()
 
 	return g
 }
@@ -218,6 +268,88 @@ func (g *Game) CurrentPhase() GamePhase {
 	return g.currentPhase
 }
 
+// PhaseStartedAt returns when the current phase began, not counting time spent paused
+func (g *Game) PhaseStartedAt() time.Time {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+	return g.phaseStartedAt
+}
+
+// TurnStartedAt returns when the current turn began, not counting time spent paused
+// ok is false while nobody has the turn
+func (g *Game) TurnStartedAt() (startedAt time.Time, ok bool) {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+	if g.currentTurn == nil {
+		return time.Time{}, false
+	}
+	return g.turnStartedAt, true
+}
+
+// ResearchDeadline returns when the production phase stops waiting for card purchases
+// ok is false outside the production phase or when the game has no research timeout
+func (g *Game) ResearchDeadline() (deadline time.Time, ok bool) {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+	timeout := g.settings.RulesOptions.ResearchTimeoutSeconds
+	if g.currentPhase != GamePhaseProductionAndCardDraw || timeout <= 0 {
+		return time.Time{}, false
+	}
+	return g.phaseStartedAt.Add(time.Duration(timeout) * time.Second), true
+}
+
+// TurnDeadline returns when the current action phase turn runs out: the turn timer plus whatever is
+// left of the player's timebank
+// ok is false outside the action phase or when the game has no turn timer
+func (g *Game) TurnDeadline() (deadline time.Time, ok bool) {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+	timer := g.settings.RulesOptions.TurnTimerSeconds
+	if g.currentPhase != GamePhaseAction || g.currentTurn == nil || timer <= 0 {
+		return time.Time{}, false
+	}
+	remaining := g.timebankRemaining(g.currentTurn.PlayerID())
+	return g.turnStartedAt.Add(time.Duration(timer)*time.Second + remaining), true
+}
+
+// TimebankRemaining returns how much of a player's timebank is left, not counting the turn in progress
+func (g *Game) TimebankRemaining(playerID string) time.Duration {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+	return g.timebankRemaining(playerID)
+}
+
+func (g *Game) timebankRemaining(playerID string) time.Duration {
+	bank := time.Duration(g.settings.RulesOptions.TimebankSeconds) * time.Second
+	return max(bank-g.timebankUsed[playerID], 0)
+}
+
+// chargeTimebank spends the timebank of the player whose action phase turn is ending, for the time
+// it ran over the turn timer; the caller holds the write lock
+func (g *Game) chargeTimebank(now time.Time) {
+	timer := time.Duration(g.settings.RulesOptions.TurnTimerSeconds) * time.Second
+	if g.currentPhase != GamePhaseAction || g.currentTurn == nil || timer <= 0 {
+		return
+	}
+	if overrun := now.Sub(g.turnStartedAt) - timer; overrun > 0 {
+		playerID := g.currentTurn.PlayerID()
+		bank := time.Duration(g.settings.RulesOptions.TimebankSeconds) * time.Second
+		g.timebankUsed[playerID] = min(g.timebankUsed[playerID]+overrun, bank)
+	}
+}
+
+// StartingSelectionDeadline returns when the starting selection stops waiting for players to choose
+// ok is false outside the starting card selection or when the game has no starting selection timeout
+func (g *Game) StartingSelectionDeadline() (deadline time.Time, ok bool) {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+	timeout := g.settings.RulesOptions.StartingSelectionTimeoutSeconds
+	if g.currentPhase != GamePhaseStartingCardSelection || timeout <= 0 {
+		return time.Time{}, false
+	}
+	return g.phaseStartedAt.Add(time.Duration(timeout) * time.Second), true
+}
+
 // Generation returns the current generation number
 func (g *Game) Generation() int {
 	g.mu.RLock()
@@ -322,14 +454,23 @@ func (g *Game) GetPlayer(playerID string) (*player.Player, error) {
 	return p, nil
 }
 
-// GetAllPlayers returns all players in the game
+// GetAllPlayers returns all players in the game in seat (turn) order
 func (g *Game) GetAllPlayers() []*player.Player {
 	g.mu.RLock()
 	defer g.mu.RUnlock()
 
 	players := make([]*player.Player, 0, len(g.players))
-	for _, p := range g.players {
-		players = append(players, p)
+	seated := make(map[string]bool, len(g.turnOrder))
+	for _, id := range g.turnOrder {
+		if p, exists := g.players[id]; exists && !seated[id] {
+			players = append(players, p)
+			seated[id] = true
+		}
+	}
+	for id, p := range g.players {
+		if !seated[id] {
+			players = append(players, p)
+		}
 	}
 	return players
 }
@@ -347,6 +488,10 @@ func (g *Game) AddPlayer(ctx context.Context, p *player.Player) error {
 	}
 
 	g.players[p.ID()] = p
+	g.turnOrder = append(g.turnOrder, p.ID())
+	if color := g.nextFreeColorLocked(); color != "" {
+		g.playerColors[p.ID()] = color
+	}
 	g.updatedAt = time.Now()
 	g.mu.Unlock()
 
@@ -373,6 +518,14 @@ func (g *Game) RemovePlayer(ctx context.Context, playerID string) error {
 	}
 
 	delete(g.players, playerID)
+	delete(g.handicaps, playerID)
+	delete(g.playerColors, playerID)
+	for i, id := range g.turnOrder {
+		if id == playerID {
+			g.turnOrder = append(g.turnOrder[:i:i], g.turnOrder[i+1:]...)
+			break
+		}
+	}
 	g.updatedAt = time.Now()
 	g.mu.Unlock()
 
@@ -390,6 +543,10 @@ func (g *Game) UpdateStatus(ctx context.Context, newStatus GameStatus) error {
 	g.mu.Lock()
 	oldStatus = g.status
 	g.status = newStatus
+	if newStatus.Ended() {
+		// Reserved cards can no longer be committed
+		clear(g.pendingCardPlays)
+	}
 	g.updatedAt = time.Now()
 	g.mu.Unlock()
 
@@ -440,6 +597,9 @@ func (g *Game) UpdatePhase(ctx context.Context, newPhase GamePhase) error {
 	oldPhase = g.currentPhase
 	g.currentPhase = newPhase
 	g.updatedAt = time.Now()
+	if oldPhase != newPhase {
+		g.phaseStartedAt = g.updatedAt
+	}
 	g.mu.Unlock()
 
 	if g.eventBus != nil && oldPhase != newPhase {
@@ -513,8 +673,11 @@ func (g *Game) SetCurrentTurn(ctx context.Context, playerID string, actionsRemai
 	}
 
 	g.mu.Lock()
+	now := time.Now()
+	g.chargeTimebank(now)
 	g.currentTurn = NewTurn(playerID, actionsRemaining)
-	g.updatedAt = time.Now()
+	g.updatedAt = now
+	g.turnStartedAt = now
 	g.mu.Unlock()
 
 	if g.eventBus != nil {
@@ -549,6 +712,366 @@ func (g *Game) SetTurnOrder(ctx context.Context, turnOrder []string) error {
 	return nil
 }
 
+// RandomizeSeatOrder returns whether the turn order is shuffled when the game starts
+func (g *Game) RandomizeSeatOrder() bool {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+	return g.randomizeSeatOrder
+}
+
+// SetRandomizeSeatOrder sets whether the turn order is shuffled when the game starts
+func (g *Game) SetRandomizeSeatOrder(ctx context.Context, randomize bool) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	g.mu.Lock()
+	g.randomizeSeatOrder = randomize
+	g.updatedAt = time.Now()
+	g.mu.Unlock()
+
+	if g.eventBus != nil {
+		events.Publish(g.eventBus, events.GameStateChangedEvent{
+			GameID:    g.id,
+			Timestamp: time.Now(),
+		})
+	}
+
+	return nil
+}
+
+// Handicaps returns a copy of the per-player handicaps
+func (g *Game) Handicaps() map[string]Handicap {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+	handicaps := make(map[string]Handicap, len(g.handicaps))
+	for playerID, h := range g.handicaps {
+		handicaps[playerID] = h
+	}
+	return handicaps
+}
+
+// Handicap returns the handicap for a player (zero if none)
+func (g *Game) Handicap(playerID string) Handicap {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+	return g.handicaps[playerID]
+}
+
+// SetHandicap sets a player's handicap; a zero handicap clears it
+func (g *Game) SetHandicap(ctx context.Context, playerID string, handicap Handicap) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if err := handicap.Validate(); err != nil {
+		return err
+	}
+
+	g.mu.Lock()
+	if _, exists := g.players[playerID]; !exists {
+		g.mu.Unlock()
+		return fmt.Errorf("player %s not found in game %s", playerID, g.id)
+	}
+	if handicap.IsZero() {
+		delete(g.handicaps, playerID)
+	} else {
+		g.handicaps[playerID] = handicap
+	}
+	g.updatedAt = time.Now()
+	g.mu.Unlock()
+
+	if g.eventBus != nil {
+		events.Publish(g.eventBus, events.GameStateChangedEvent{
+			GameID:    g.id,
+			Timestamp: time.Now(),
+		})
+	}
+
+	return nil
+}
+
+// IsPaused reports whether the game is paused
+func (g *Game) IsPaused() bool {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+	return g.pause != nil
+}
+
+// PauseState returns who paused the game and when, or nil while the game is running
+func (g *Game) PauseState() *PauseState {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+	if g.pause == nil {
+		return nil
+	}
+	stateCopy := *g.pause
+	return &stateCopy
+}
+
+// PauseVotes returns the players asking to pause a running game, or to resume a paused one, sorted
+func (g *Game) PauseVotes() []string {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+	votes := make([]string, 0, len(g.pauseVotes))
+	for playerID := range g.pauseVotes {
+		votes = append(votes, playerID)
+	}
+	sort.Strings(votes)
+	return votes
+}
+
+// AddPauseVote records a player's vote to toggle the paused state
+// Votes are cleared whenever the game is paused or resumed
+func (g *Game) AddPauseVote(ctx context.Context, playerID string) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	g.mu.Lock()
+	if _, exists := g.players[playerID]; !exists {
+		g.mu.Unlock()
+		return fmt.Errorf("player %s not found in game %s", playerID, g.id)
+	}
+	g.pauseVotes[playerID] = true
+	g.updatedAt = time.Now()
+	g.mu.Unlock()
+
+	if g.eventBus != nil {
+		events.Publish(g.eventBus, events.GameStateChangedEvent{
+			GameID:    g.id,
+			Timestamp: time.Now(),
+		})
+	}
+
+	return nil
+}
+
+// Pause stops the game until Resume is called
+func (g *Game) Pause(ctx context.Context, pausedBy string) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	g.mu.Lock()
+	if g.pause != nil {
+		g.mu.Unlock()
+		return fmt.Errorf("game %s is already paused", g.id)
+	}
+	now := time.Now()
+	g.pause = &PauseState{PausedBy: pausedBy, PausedAt: now}
+	g.pauseVotes = make(map[string]bool)
+	g.updatedAt = now
+	g.mu.Unlock()
+
+	if g.eventBus != nil {
+		events.Publish(g.eventBus, events.GameStateChangedEvent{
+			GameID:    g.id,
+			Timestamp: now,
+		})
+	}
+
+	return nil
+}
+
+// Resume continues a paused game
+func (g *Game) Resume(ctx context.Context) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	g.mu.Lock()
+	if g.pause == nil {
+		g.mu.Unlock()
+		return fmt.Errorf("game %s is not paused", g.id)
+	}
+	// Time spent paused does not count against the phase and turn clocks
+	pausedFor := time.Since(g.pause.PausedAt)
+	g.phaseStartedAt = g.phaseStartedAt.Add(pausedFor)
+	if g.currentTurn != nil {
+		g.turnStartedAt = g.turnStartedAt.Add(pausedFor)
+	}
+	for _, response := range g.pendingResponses {
+		response.OpenedAt = response.OpenedAt.Add(pausedFor)
+	}
+	g.pause = nil
+	g.pauseVotes = make(map[string]bool)
+	g.updatedAt = time.Now()
+	g.mu.Unlock()
+
+	if g.eventBus != nil {
+		events.Publish(g.eventBus, events.GameStateChangedEvent{
+			GameID:    g.id,
+			Timestamp: time.Now(),
+		})
+	}
+
+	return nil
+}
+
+// AbandonVotes returns the IDs of players who voted to abandon the game, sorted
+func (g *Game) AbandonVotes() []string {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+	votes := make([]string, 0, len(g.abandonVotes))
+	for playerID := range g.abandonVotes {
+		votes = append(votes, playerID)
+	}
+	sort.Strings(votes)
+	return votes
+}
+
+// AddAbandonVote records a player's vote to abandon the game
+func (g *Game) AddAbandonVote(ctx context.Context, playerID string) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	g.mu.Lock()
+	if _, exists := g.players[playerID]; !exists {
+		g.mu.Unlock()
+		return fmt.Errorf("player %s not found in game %s", playerID, g.id)
+	}
+	g.abandonVotes[playerID] = true
+	g.updatedAt = time.Now()
+	g.mu.Unlock()
+
+	if g.eventBus != nil {
+		events.Publish(g.eventBus, events.GameStateChangedEvent{
+			GameID:    g.id,
+			Timestamp: time.Now(),
+		})
+	}
+
+	return nil
+}
+
+// ConcededPlayers returns the players who conceded, in the order they left
+func (g *Game) ConcededPlayers() []ConcededPlayer {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+	conceded := make([]ConcededPlayer, len(g.conceded))
+	copy(conceded, g.conceded)
+	return conceded
+}
+
+// Concede removes a player from a running game and records them as conceded
+// Their tiles, color and handicap stay as a record; their pending selections, votes and land claims are dropped,
+// and their project cards are removed from the game.
+// Moving the turn, the host and any production is left to the caller
+func (g *Game) Concede(ctx context.Context, playerID string) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	g.mu.Lock()
+	p, exists := g.players[playerID]
+	if !exists {
+		g.mu.Unlock()
+		return fmt.Errorf("player %s not found in game %s", playerID, g.id)
+	}
+
+	// The seat's project cards leave the game with it: hand, tableau and any cards still being chosen from
+	leavingCards := append(p.Hand().Cards(), p.PlayedCards().Cards()...)
+	if phase := g.productionPhases[playerID]; phase != nil && !phase.SelectionComplete {
+		leavingCards = append(leavingCards, phase.AvailableCards...)
+	}
+	if phase := g.selectStartingCardsPhases[playerID]; phase != nil {
+		leavingCards = append(leavingCards, phase.AvailableCards...)
+	}
+	if selection := p.Selection().GetPendingCardDrawSelection(); selection != nil {
+		leavingCards = append(leavingCards, selection.AvailableCards...)
+	}
+
+	now := time.Now()
+	g.conceded = append(g.conceded, ConcededPlayer{
+		PlayerID:   playerID,
+		PlayerName: p.Name(),
+		AccountID:  p.AccountID(),
+		ConcededAt: now,
+	})
+	delete(g.players, playerID)
+	delete(g.pauseVotes, playerID)
+	delete(g.abandonVotes, playerID)
+	delete(g.pendingTileSelections, playerID)
+	delete(g.pendingTileSelectionQueues, playerID)
+	delete(g.forcedFirstActions, playerID)
+	delete(g.pendingCardPlays, playerID)
+	delete(g.pendingResponses, playerID)
+	delete(g.productionPhases, playerID)
+	delete(g.selectStartingCardsPhases, playerID)
+	for i, id := range g.turnOrder {
+		if id == playerID {
+			g.turnOrder = append(g.turnOrder[:i:i], g.turnOrder[i+1:]...)
+			break
+		}
+	}
+	g.updatedAt = now
+	b := g.board
+	d := g.deck
+	g.mu.Unlock()
+
+	if b != nil {
+		if _, err := b.ReleasePlayerReservations(ctx, playerID); err != nil {
+			return fmt.Errorf("failed to release land claims: %w", err)
+		}
+	}
+	if d != nil && len(leavingCards) > 0 {
+		if err := d.Remove(ctx, leavingCards); err != nil {
+			return fmt.Errorf("failed to remove conceded cards: %w", err)
+		}
+	}
+
+	if g.eventBus != nil {
+		events.Publish(g.eventBus, events.GameStateChangedEvent{
+			GameID:    g.id,
+			Timestamp: now,
+		})
+	}
+
+	return nil
+}
+
+// SetSeatOrder arranges lobby seating manually and disables seat randomization at game start
+// The seat order must contain every player in the game exactly once
+func (g *Game) SetSeatOrder(ctx context.Context, seatOrder []string) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	g.mu.Lock()
+	if len(seatOrder) != len(g.players) {
+		g.mu.Unlock()
+		return fmt.Errorf("seat order must contain all %d players, got %d", len(g.players), len(seatOrder))
+	}
+	seen := make(map[string]bool, len(seatOrder))
+	for _, id := range seatOrder {
+		if _, exists := g.players[id]; !exists {
+			g.mu.Unlock()
+			return fmt.Errorf("player %s not found in game %s", id, g.id)
+		}
+		if seen[id] {
+			g.mu.Unlock()
+			return fmt.Errorf("player %s appears more than once in seat order", id)
+		}
+		seen[id] = true
+	}
+
+	g.turnOrder = make([]string, len(seatOrder))
+	copy(g.turnOrder, seatOrder)
+	g.randomizeSeatOrder = false
+	g.updatedAt = time.Now()
+	g.mu.Unlock()
+
+	if g.eventBus != nil {
+		events.Publish(g.eventBus, events.GameStateChangedEvent{
+			GameID:    g.id,
+			Timestamp: time.Now(),
+		})
+	}
+
+	return nil
+}
+
 // SetHostPlayerID sets the host player ID
 func (g *Game) SetHostPlayerID(ctx context.Context, playerID string) error {
 	if err := ctx.Err(); err != nil {
@@ -769,6 +1292,45 @@ func (g *Game) SetForcedFirstAction(ctx context.Context, playerID string, action
 	return nil
 }
 
+// GetPendingCardPlay returns the card a player has reserved with prepare-play-card
+func (g *Game) GetPendingCardPlay(playerID string) *player.PendingCardPlay {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+
+	play, exists := g.pendingCardPlays[playerID]
+	if !exists || play == nil {
+		return nil
+	}
+	playCopy := *play
+	return &playCopy
+}
+
+// SetPendingCardPlay reserves a card for a player (nil releases the reservation)
+func (g *Game) SetPendingCardPlay(ctx context.Context, playerID string, play *player.PendingCardPlay) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	g.mu.Lock()
+	if play == nil {
+		delete(g.pendingCardPlays, playerID)
+	} else {
+		playCopy := *play
+		g.pendingCardPlays[playerID] = &playCopy
+	}
+	g.updatedAt = time.Now()
+	g.mu.Unlock()
+
+	if g.eventBus != nil {
+		events.Publish(g.eventBus, events.GameStateChangedEvent{
+			GameID:    g.id,
+			Timestamp: time.Now(),
+		})
+	}
+
+	return nil
+}
+
 // GetProductionPhase returns the production phase state for a player
 func (g *Game) GetProductionPhase(playerID string) *player.ProductionPhase {
 	g.mu.RLock()
@@ -882,19 +1444,24 @@ func (g *Game) ProcessNextTile(ctx context.Context, playerID string) error {
 	availableHexes := g.calculateAvailableHexesForTile(nextTileType, playerID, tileRestrictions)
 
 	err := g.SetPendingTileSelection(ctx, playerID, &player.PendingTileSelection{
-		TileType:       nextTileType,
-		AvailableHexes: availableHexes,
-		Source:         source,
-		OnComplete:     onComplete,
+		TileType:         nextTileType,
+		AvailableHexes:   availableHexes,
+		Source:           source,
+		OnComplete:       onComplete,
+		TileRestrictions: tileRestrictions,
 	})
 
 	return err
 }
 
 // calculateAvailableHexesForTile returns a list of valid hex positions for placing a tile
-// tileRestrictions controls placement rules:
+// Every placement follows the ocean rule (board.Tile.AllowsTileType): oceans only on areas reserved for ocean,
+// everything else only on land. tileRestrictions controls placement rules:
 //   - BoardTags: restricts to tiles with matching tags (e.g., Noctis City)
-//   - Adjacency: "none" means no adjacent occupied tiles allowed (Research Outpost)
+//   - Adjacency: "none" means no adjacent occupied tiles allowed (Research Outpost);
+//     "own" means next to one of the player's tiles (Mining Area, special tiles only)
+//   - OnTileType: places the tile on the other kind of space (Mangrove, Artificial Lake)
+//   - CaptureBonus: restricts special tiles to areas with one of these placement bonuses (Mining Area)
 //
 // For cities: if BoardTags is set, only matching tiles are valid (ignoring adjacency);
 // if Adjacency is "none", tiles must have no adjacent occupied tiles;
@@ -913,9 +1480,11 @@ func (g *Game) calculateAvailableHexesForTile(tileType string, playerID string,
 	// Extract restrictions
 	var boardTags []string
 	var adjacency string
+	var onTileType string
 	if tileRestrictions != nil {
 		boardTags = tileRestrictions.BoardTags
 		adjacency = tileRestrictions.Adjacency
+		onTileType = tileRestrictions.OnTileType
 	}
 
 	// Helper to check if tile has any of the required board tags
@@ -930,9 +1499,9 @@ func (g *Game) calculateAvailableHexesForTile(tileType string, playerID string,
 		return false
 	}
 
-	// Helper to check if tile has any tags (is a reserved area)
-	tileHasAnyTag := func(tile board.Tile) bool {
-		return len(tile.Tags) > 0
+	// Helper to check if tile is a reserved area (Noctis City); feature tags such as volcanic don't reserve
+	isReservedArea := func(tile board.Tile) bool {
+		return tile.IsReservedArea()
 	}
 
 	// Helper to check if a tile has any adjacent occupied tiles
@@ -947,6 +1516,18 @@ func (g *Game) calculateAvailableHexesForTile(tileType string, playerID string,
 		return false
 	}
 
+	// Helper to check if a tile has an adjacent tile owned by the placing player
+	hasAdjacentOwned := func(tile board.Tile) bool {
+		for _, neighborPos := range tile.Coordinates.GetNeighbors() {
+			for _, neighborTile := range tiles {
+				if neighborTile.Coordinates.Equals(neighborPos) && neighborTile.OwnerID != nil && *neighborTile.OwnerID == playerID {
+					return true
+				}
+			}
+		}
+		return false
+	}
+
 	// Helper to check if tile is reserved by another player
 	isReservedByOther := func(tile board.Tile) bool {
 		return tile.ReservedBy != nil && *tile.ReservedBy != playerID
@@ -960,12 +1541,12 @@ func (g *Game) calculateAvailableHexesForTile(tileType string, playerID string,
 
 		switch tileType {
 		case "land-claim":
-			// Land claim can only be placed on unoccupied, unreserved land tiles
-			if tile.Type != shared.ResourceLandTile {
+			// Land claim marks any unoccupied, unreserved area, land or ocean
+			if !tile.AllowsTileType(tileType, "") {
 				continue
 			}
 			// Exclude reserved areas (tagged tiles like Noctis City)
-			if tileHasAnyTag(tile) {
+			if isReservedArea(tile) {
 				continue
 			}
 			// Exclude tiles already reserved by anyone
@@ -975,7 +1556,7 @@ func (g *Game) calculateAvailableHexesForTile(tileType string, playerID string,
 			availableHexes = append(availableHexes, tile.Coordinates.String())
 
 		case "city":
-			if tile.Type != shared.ResourceLandTile {
+			if !tile.AllowsTileType(tileType, "") {
 				continue
 			}
 
@@ -996,7 +1577,7 @@ func (g *Game) calculateAvailableHexesForTile(tileType string, playerID string,
 			}
 
 			// Normal city placement: exclude reserved areas (tagged tiles)
-			if tileHasAnyTag(tile) {
+			if isReservedArea(tile) {
 				logger.Get().Debug("⏭️ Skipping reserved tile for normal city placement",
 					zap.String("tile", tile.Coordinates.String()),
 					zap.Strings("tile_tags", tile.Tags))
@@ -1004,7 +1585,7 @@ func (g *Game) calculateAvailableHexesForTile(tileType string, playerID string,
 			}
 
 			// Handle "no adjacent tiles" restriction (Research Outpost)
-			if adjacency == "none" {
+			if adjacency == shared.AdjacencyNone {
 				if !hasAnyAdjacentOccupied(tile) {
 					availableHexes = append(availableHexes, tile.Coordinates.String())
 					logger.Get().Debug("✅ Tile available for city (no adjacent tiles)",
@@ -1060,8 +1641,8 @@ func (g *Game) calculateAvailableHexesForTile(tileType string, playerID string,
 
 		case "greenery":
 			// Check if restricted to ocean tiles (Mangrove card)
-			if tileRestrictions != nil && tileRestrictions.OnTileType == "ocean" {
-				if tile.Type == shared.ResourceOceanSpace {
+			if onTileType == shared.OnTileTypeOcean {
+				if tile.AllowsTileType(tileType, onTileType) && !isReservedByOther(tile) {
 					availableHexes = append(availableHexes, tile.Coordinates.String())
 				}
 				continue
@@ -1073,17 +1654,22 @@ func (g *Game) calculateAvailableHexesForTile(tileType string, playerID string,
 			}
 
 			// Exclude reserved areas from normal greenery placement
-			if len(boardTags) == 0 && tileHasAnyTag(tile) {
+			if len(boardTags) == 0 && isReservedArea(tile) {
 				continue
 			}
-			if tile.Type == shared.ResourceLandTile {
+			if tile.AllowsTileType(tileType, "") {
 				availableHexes = append(availableHexes, tile.Coordinates.String())
 			}
 
 		case "ocean":
-			if tile.Type == shared.ResourceOceanSpace {
-				availableHexes = append(availableHexes, tile.Coordinates.String())
+			if !tile.AllowsTileType(tileType, onTileType) {
+				continue
 			}
+			// Another player's land claim blocks the area; an ocean on land (Artificial Lake) also keeps clear of tagged areas
+			if isReservedByOther(tile) || (!tile.IsOceanSpace() && isReservedArea(tile)) {
+				continue
+			}
+			availableHexes = append(availableHexes, tile.Coordinates.String())
 
 		default:
 			// Skip tiles reserved by other players (current player can use their own reserved tiles)
@@ -1091,18 +1677,34 @@ func (g *Game) calculateAvailableHexesForTile(tileType string, playerID string,
 				continue
 			}
 			// Exclude reserved areas from normal placement
-			if len(boardTags) == 0 && tileHasAnyTag(tile) {
+			if len(boardTags) == 0 && isReservedArea(tile) {
 				continue
 			}
-			if tile.Type == shared.ResourceLandTile {
-				availableHexes = append(availableHexes, tile.Coordinates.String())
+			if !tile.AllowsTileType(tileType, "") {
+				continue
+			}
+			// Special tiles carry their card's own placement rules
+			if len(boardTags) > 0 && !tileHasRequiredTag(tile, boardTags) {
+				continue
+			}
+			if adjacency == shared.AdjacencyNone && hasAnyAdjacentOccupied(tile) {
+				continue
+			}
+			if adjacency == shared.AdjacencyOwn && !hasAdjacentOwned(tile) {
+				continue
+			}
+			if tileRestrictions != nil && len(tileRestrictions.CaptureBonus) > 0 {
+				if _, ok := tile.BonusOf(tileRestrictions.CaptureBonus); !ok {
+					continue
+				}
 			}
+			availableHexes = append(availableHexes, tile.Coordinates.String())
 		}
 	}
 
-	// If boardTags specified but no matching tiles found (e.g., Noctis City already occupied),
-	// fall back to normal placement rules
-	if len(boardTags) > 0 && len(availableHexes) == 0 {
+	// If a reserved area was required but is taken (e.g., Noctis City already occupied),
+	// fall back to normal placement rules; feature tags such as volcanic have no fallback
+	if slices.ContainsFunc(boardTags, board.IsReservedAreaTag) && len(availableHexes) == 0 {
 		logger.Get().Info("🔄 No tiles match board tags, falling back to normal placement",
 			zap.Strings("board_tags", boardTags),
 			zap.String("tile_type", tileType))
@@ -1245,8 +1847,44 @@ func (g *Game) subscribeToVPEvents() {
 	})
 }
 
+// invalidateVPPreview drops a player's cached per-card VP
+func (g *Game) invalidateVPPreview(playerID string) {
+	p, err := g.GetPlayer(playerID)
+	if err != nil {
+		return
+	}
+	p.VPPreview().Invalidate()
+}
+
+// subscribeToVPPreviewEvents invalidates cached per-card VP whenever something a card scores on changes.
+// Tiles are counted across the whole board, so a placement invalidates every player.
+func (g *Game) subscribeToVPPreviewEvents() {
+	events.Subscribe(g.eventBus, func(e events.CardPlayedEvent) {
+		g.invalidateVPPreview(e.PlayerID)
+	})
+
+	events.Subscribe(g.eventBus, func(e events.ResourceStorageChangedEvent) {
+		g.invalidateVPPreview(e.PlayerID)
+	})
+
+	events.Subscribe(g.eventBus, func(e events.TagPlayedEvent) {
+		g.invalidateVPPreview(e.PlayerID)
+	})
+
+	events.Subscribe(g.eventBus, func(e events.CorporationSelectedEvent) {
+		g.invalidateVPPreview(e.PlayerID)
+	})
+
+	events.Subscribe(g.eventBus, func(e events.TilePlacedEvent) {
+		for _, p := range g.GetAllPlayers() {
+			p.VPPreview().Invalidate()
+		}
+	})
+}
+
 func (g *Game) subscribeToGenerationalEvents() {
 	events.Subscribe(g.eventBus, func(e events.TerraformRatingChangedEvent) {
+		g.addTRDelta(e.PlayerID, e.NewRating-e.OldRating)
 		if e.NewRating > e.OldRating {
 			p, err := g.GetPlayer(e.PlayerID)
 			if err != nil {
@@ -1278,3 +1916,80 @@ func (g *Game) subscribeToGenerationalEvents() {
 		}
 	})
 }
+
+// PlayerColor returns the color assigned to a player ("" if none)
+func (g *Game) PlayerColor(playerID string) string {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+	return g.playerColors[playerID]
+}
+
+// PlayerColors returns a copy of the player color assignments
+func (g *Game) PlayerColors() map[string]string {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+	colors := make(map[string]string, len(g.playerColors))
+	for playerID, color := range g.playerColors {
+		colors[playerID] = color
+	}
+	return colors
+}
+
+// SetPlayerColor overrides a player's color with another palette color that no other player holds
+func (g *Game) SetPlayerColor(ctx context.Context, playerID string, color string) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	color = NormalizeColor(color)
+
+	g.mu.Lock()
+	if _, exists := g.players[playerID]; !exists {
+		g.mu.Unlock()
+		return fmt.Errorf("player %s not found in game %s", playerID, g.id)
+	}
+	if !g.inPaletteLocked(color) {
+		g.mu.Unlock()
+		return fmt.Errorf("color %s is not in the game's palette", color)
+	}
+	for otherID, otherColor := range g.playerColors {
+		if otherID != playerID && otherColor == color {
+			g.mu.Unlock()
+			return fmt.Errorf("color %s is already used by player %s", color, otherID)
+		}
+	}
+	g.playerColors[playerID] = color
+	g.updatedAt = time.Now()
+	g.mu.Unlock()
+
+	if g.eventBus != nil {
+		events.Publish(g.eventBus, events.GameStateChangedEvent{
+			GameID:    g.id,
+			Timestamp: time.Now(),
+		})
+	}
+
+	return nil
+}
+
+// nextFreeColorLocked returns the first palette color not yet assigned (caller holds the lock)
+func (g *Game) nextFreeColorLocked() string {
+	used := make(map[string]bool, len(g.playerColors))
+	for _, color := range g.playerColors {
+		used[color] = true
+	}
+	for _, color := range g.settings.ColorPalette {
+		if normalized := NormalizeColor(color); !used[normalized] {
+			return normalized
+		}
+	}
+	return ""
+}
+
+func (g *Game) inPaletteLocked(color string) bool {
+	for _, paletteColor := range g.settings.ColorPalette {
+		if NormalizeColor(paletteColor) == color {
+			return true
+		}
+	}
+	return false
+}
@@ -1,7 +1,10 @@
 package game
 
 import (
-	"terraforming-mars-backend/internal/game/global_parameters"
+	"errors"
+	"fmt"
+
+	"terraforming-mars-backend/internal/game/parameters"
 )
 
 // GameSettings contains configurable game parameters (all optional)
@@ -12,23 +15,83 @@ type GameSettings struct {
 	Oceans          *int     // Default: 0
 	DevelopmentMode bool     // Default: false
 	DemoGame        bool     // Default: false - enables lobby corp/card selection
+	PassAndPlay     bool     // Default: false - one shared device plays every seat, so all hands are shown to it
+	Analytics       bool     // Default: false - contribute anonymized card and corporation stats
 	CardPacks       []string // Default: ["base-game"]
+	ColorPalette    []string // Default: DefaultColorPalette() - player colors, assigned in join order
+	RulesOptions    RulesOptions
 }
 
 // Card pack constants
 const (
-	PackBaseGame = "base-game" // Tested simple cards only
-	PackFuture   = "future"    // Untested/complex cards for future implementation
+	PackBaseGame     = "base-game"     // Tested simple cards only
+	PackFuture       = "future"        // Untested/complex cards for future implementation
+	PackPrelude      = "prelude"       // Prelude expansion; enables dealing preludes with the starting selection
+	PackCorporateEra = "corporate-era" // More project cards and corporations; no rules of its own
+	PackPromo        = "promo"         // Promo cards; no rules of their own
+	PackVenusNext    = "venus-next"    // Venus Next expansion: Venus track and its cards
+	PackColonies     = "colonies"      // Colonies expansion: colony tiles and trades
+	PackTurmoil      = "turmoil"       // Turmoil expansion: parties and delegates
 )
 
+// expansionPacks are the packs that bring rules of their own; their cards and actions need the pack enabled
+var expansionPacks = map[string]bool{
+	PackPrelude:   true,
+	PackVenusNext: true,
+	PackColonies:  true,
+	PackTurmoil:   true,
+}
+
+// IsExpansionPack reports whether a pack brings rules of its own
+func IsExpansionPack(pack string) bool {
+	return expansionPacks[pack]
+}
+
+// ErrExpansionDisabled is matched by every ExpansionDisabledError
+var ErrExpansionDisabled = errors.New("expansion is not enabled in this game")
+
+// ExpansionDisabledError rejects an action or card tied to an expansion the game was not created with
+type ExpansionDisabledError struct {
+	Pack string
+}
+
+func (e *ExpansionDisabledError) Error() string {
+	return fmt.Sprintf("the %s %s", e.Pack, ErrExpansionDisabled)
+}
+
+// Is lets errors.Is match ErrExpansionDisabled
+func (e *ExpansionDisabledError) Is(target error) bool {
+	return target == ErrExpansionDisabled
+}
+
 // Default values for game settings
 const (
 	DefaultMaxPlayers  = 5
-	DefaultTemperature = global_parameters.MinTemperature // -30°C
-	DefaultOxygen      = global_parameters.MinOxygen      // 0%
-	DefaultOceans      = global_parameters.MinOceans      // 0
+	DefaultTemperature = parameters.MinTemperature // -30°C
+	DefaultOxygen      = parameters.MinOxygen      // 0%
+	DefaultOceans      = parameters.MinOceans      // 0
 )
 
+// PackEnabled reports whether a card pack is selected
+func (s GameSettings) PackEnabled(pack string) bool {
+	for _, selected := range s.CardPacks {
+		if selected == pack {
+			return true
+		}
+	}
+	return false
+}
+
+// PreludesEnabled reports whether the prelude pack is selected
+func (s GameSettings) PreludesEnabled() bool {
+	return s.PackEnabled(PackPrelude)
+}
+
+// IsPublic reports whether the game is played across devices for real, not pass-and-play, a demo or a dev game
+func (s GameSettings) IsPublic() bool {
+	return !s.PassAndPlay && !s.DemoGame && !s.DevelopmentMode
+}
+
 // DefaultCardPacks returns the default card packs
 func DefaultCardPacks() []string {
 	return []string{PackBaseGame}
@@ -7,4 +7,11 @@ const (
 	GameStatusLobby     GameStatus = "lobby"
 	GameStatusActive    GameStatus = "active"
 	GameStatusCompleted GameStatus = "completed"
+	GameStatusAbandoned GameStatus = "abandoned" // Ended by a majority vote or a solo concession; no winner
+	GameStatusFailed    GameStatus = "failed"    // An action hung; the game takes no further actions
 )
+
+// Ended reports whether a game in this status takes no further actions
+func (s GameStatus) Ended() bool {
+	return s == GameStatusCompleted || s == GameStatusAbandoned || s == GameStatusFailed
+}
@@ -0,0 +1,94 @@
+package game
+
+import (
+	"context"
+	"time"
+
+	"terraforming-mars-backend/internal/game/shared"
+)
+
+// GenerationTally counts what a player did during the current generation
+type GenerationTally struct {
+	TRDelta     int // Net terraform rating change, losses included
+	CardsBought int // Cards paid for during research or card draws
+}
+
+// PlayerGenerationSummary recaps one player's generation
+type PlayerGenerationSummary struct {
+	PlayerID        string
+	Income          shared.Resources // Production received; credits include terraform rating
+	EnergyConverted int
+	CardsBought     int
+	TRDelta         int
+}
+
+// GenerationSummary recaps a finished generation, built during its production phase
+type GenerationSummary struct {
+	Generation int
+	Players    []PlayerGenerationSummary
+}
+
+// GenerationTally returns the player's tally for the current generation
+func (g *Game) GenerationTally(playerID string) GenerationTally {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+	if tally, ok := g.generationTallies[playerID]; ok {
+		return *tally
+	}
+	return GenerationTally{}
+}
+
+// RecordCardsBought adds bought cards to the player's tally for the current generation
+func (g *Game) RecordCardsBought(playerID string, count int) {
+	if count <= 0 {
+		return
+	}
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.tallyLocked(playerID).CardsBought += count
+}
+
+// ResetGenerationTallies starts every player's tally from zero
+// Called once setup is done, so starting bonuses are not counted as first-generation gains
+func (g *Game) ResetGenerationTallies() {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.generationTallies = make(map[string]*GenerationTally)
+}
+
+// CloseGeneration stores the summary of the generation being produced and resets the tallies
+func (g *Game) CloseGeneration(ctx context.Context, summary GenerationSummary) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.lastGenerationSummary = &summary
+	g.generationTallies = make(map[string]*GenerationTally)
+	g.updatedAt = time.Now()
+	return nil
+}
+
+// LastGenerationSummary returns the summary of the most recently produced generation, or nil
+func (g *Game) LastGenerationSummary() *GenerationSummary {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+	return g.lastGenerationSummary
+}
+
+func (g *Game) addTRDelta(playerID string, delta int) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.tallyLocked(playerID).TRDelta += delta
+}
+
+// tallyLocked returns the player's tally, creating it; the caller holds g.mu
+func (g *Game) tallyLocked(playerID string) *GenerationTally {
+	tally, ok := g.generationTallies[playerID]
+	if !ok {
+		tally = &GenerationTally{}
+		g.generationTallies[playerID] = tally
+	}
+	return tally
+}
@@ -4,25 +4,16 @@ import (
 	"context"
 	"sync"
 	"terraforming-mars-backend/internal/events"
-)
-
-// Constants for terraforming limits
-const (
-	MinTemperature = -30
-	MaxTemperature = 8
-	MinOxygen      = 0
-	MaxOxygen      = 14
-	MinOceans      = 0
-	MaxOceans      = 9
+	"terraforming-mars-backend/internal/game/parameters"
 )
 
 // GlobalParameters represents the terraforming progress with encapsulated state
 type GlobalParameters struct {
 	mu          sync.RWMutex
 	gameID      string
-	temperature int // Range: -30 to +8°C
-	oxygen      int // Range: 0-14%
-	oceans      int // Range: 0-9
+	temperature int // parameters.Temperature: -30 to +8°C
+	oxygen      int // parameters.Oxygen: 0-14%
+	oceans      int // parameters.Oceans: 0-9
 	eventBus    *events.EventBusImpl
 }
 
@@ -30,9 +21,9 @@ type GlobalParameters struct {
 func NewGlobalParameters(gameID string, eventBus *events.EventBusImpl) *GlobalParameters {
 	return &GlobalParameters{
 		gameID:      gameID,
-		temperature: MinTemperature,
-		oxygen:      MinOxygen,
-		oceans:      MinOceans,
+		temperature: parameters.MinTemperature,
+		oxygen:      parameters.MinOxygen,
+		oceans:      parameters.MinOceans,
 		eventBus:    eventBus,
 	}
 }
@@ -73,13 +64,13 @@ func (gp *GlobalParameters) Oceans() int {
 func (gp *GlobalParameters) IsMaxed() bool {
 	gp.mu.RLock()
 	defer gp.mu.RUnlock()
-	return gp.temperature >= MaxTemperature &&
-		gp.oxygen >= MaxOxygen &&
-		gp.oceans >= MaxOceans
+	return parameters.Temperature.IsMaxed(gp.temperature) &&
+		parameters.Oxygen.IsMaxed(gp.oxygen) &&
+		parameters.Oceans.IsMaxed(gp.oceans)
 }
 
 // IncreaseTemperature raises the temperature by the specified number of steps
-// Each step is 2 degrees. Returns the actual number of steps raised (may be less if limit reached)
+// Each step is parameters.Temperature.Step degrees. Returns the actual number of steps raised (may be less if limit reached)
 // Publishes TemperatureChangedEvent after state change
 func (gp *GlobalParameters) IncreaseTemperature(ctx context.Context, steps int) (int, error) {
 	if err := ctx.Err(); err != nil {
@@ -92,12 +83,8 @@ func (gp *GlobalParameters) IncreaseTemperature(ctx context.Context, steps int)
 	// Critical: Capture values while holding lock, publish AFTER releasing
 	gp.mu.Lock()
 	oldTemp = gp.temperature
-	newTemp = gp.temperature + (steps * 2) // Each step is 2 degrees
-	if newTemp > MaxTemperature {
-		newTemp = MaxTemperature
-	}
+	newTemp, actualSteps = parameters.Temperature.Raise(gp.temperature, steps)
 	gp.temperature = newTemp
-	actualSteps = (newTemp - oldTemp) / 2
 	gp.mu.Unlock()
 
 	// Publish event AFTER releasing lock to avoid deadlocks
@@ -121,16 +108,12 @@ func (gp *GlobalParameters) IncreaseOxygen(ctx context.Context, steps int) (int,
 		return 0, err
 	}
 
-	var oldOxygen, newOxygen int
+	var oldOxygen, newOxygen, actualSteps int
 
 	gp.mu.Lock()
 	oldOxygen = gp.oxygen
-	newOxygen = gp.oxygen + steps
-	if newOxygen > MaxOxygen {
-		newOxygen = MaxOxygen
-	}
+	newOxygen, actualSteps = parameters.Oxygen.Raise(gp.oxygen, steps)
 	gp.oxygen = newOxygen
-	actualSteps := newOxygen - oldOxygen
 	gp.mu.Unlock()
 
 	// Publish event AFTER releasing lock
@@ -159,7 +142,7 @@ func (gp *GlobalParameters) PlaceOcean(ctx context.Context) (bool, error) {
 
 	gp.mu.Lock()
 	oldOceans = gp.oceans
-	if gp.oceans >= MaxOceans {
+	if parameters.Oceans.IsMaxed(gp.oceans) {
 		success = false
 	} else {
 		gp.oceans++
@@ -258,3 +241,26 @@ func (gp *GlobalParameters) SetOceans(ctx context.Context, newOceans int) error
 
 	return nil
 }
+
+// Checkpoint is a copy of the global parameter values
+type Checkpoint struct {
+	temperature int
+	oxygen      int
+	oceans      int
+}
+
+// Checkpoint captures the current values
+func (gp *GlobalParameters) Checkpoint() Checkpoint {
+	gp.mu.RLock()
+	defer gp.mu.RUnlock()
+	return Checkpoint{temperature: gp.temperature, oxygen: gp.oxygen, oceans: gp.oceans}
+}
+
+// Restore puts the values back to a checkpoint without publishing change events
+func (gp *GlobalParameters) Restore(cp Checkpoint) {
+	gp.mu.Lock()
+	defer gp.mu.Unlock()
+	gp.temperature = cp.temperature
+	gp.oxygen = cp.oxygen
+	gp.oceans = cp.oceans
+}
@@ -0,0 +1,36 @@
+package game
+
+import "fmt"
+
+// Handicap caps keep handicaps within a balancing range rather than deciding the game
+const (
+	MaxHandicapCredits = 20
+	MaxHandicapCards   = 5
+	MaxHandicapTR      = 5
+)
+
+// Handicap is a per-seat starting bonus configured by the host in the lobby
+type Handicap struct {
+	ExtraCredits int // Added to starting MC
+	ExtraCards   int // Extra project cards dealt for starting selection
+	ExtraTR      int // Added to starting terraform rating
+}
+
+// IsZero reports whether the handicap grants nothing
+func (h Handicap) IsZero() bool {
+	return h.ExtraCredits == 0 && h.ExtraCards == 0 && h.ExtraTR == 0
+}
+
+// Validate checks that each bonus is non-negative and within its cap
+func (h Handicap) Validate() error {
+	if h.ExtraCredits < 0 || h.ExtraCredits > MaxHandicapCredits {
+		return fmt.Errorf("extra credits must be between 0 and %d, got %d", MaxHandicapCredits, h.ExtraCredits)
+	}
+	if h.ExtraCards < 0 || h.ExtraCards > MaxHandicapCards {
+		return fmt.Errorf("extra cards must be between 0 and %d, got %d", MaxHandicapCards, h.ExtraCards)
+	}
+	if h.ExtraTR < 0 || h.ExtraTR > MaxHandicapTR {
+		return fmt.Errorf("extra TR must be between 0 and %d, got %d", MaxHandicapTR, h.ExtraTR)
+	}
+	return nil
+}
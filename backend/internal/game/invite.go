@@ -0,0 +1,154 @@
+package game
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+// ErrInviteNotFound is returned for invite tokens that were never issued, were revoked or were already claimed
+var ErrInviteNotFound = errors.New("invite not found")
+
+// Invite reserves a named lobby seat for whoever holds its token
+// The token is claimed on join and then forgotten, so each invite seats one player.
+type Invite struct {
+	Token     string
+	SeatName  string // Name the invited player joins under; no one else may take it while the invite is open
+	CreatedBy string // Host who issued the invite
+	CreatedAt time.Time
+}
+
+// Invites returns the open invites, oldest first
+func (g *Game) Invites() []Invite {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+	invites := make([]Invite, 0, len(g.invites))
+	for _, invite := range g.invites {
+		invites = append(invites, invite)
+	}
+	sort.Slice(invites, func(i, j int) bool {
+		return invites[i].CreatedAt.Before(invites[j].CreatedAt)
+	})
+	return invites
+}
+
+// Invite returns the open invite for a token
+func (g *Game) Invite(token string) (Invite, error) {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+	invite, exists := g.invites[token]
+	if !exists {
+		return Invite{}, ErrInviteNotFound
+	}
+	return invite, nil
+}
+
+// ReservedSeats returns how many seats open invites hold
+func (g *Game) ReservedSeats() int {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+	return len(g.invites)
+}
+
+// IsSeatNameReserved reports whether an open invite holds the name, ignoring case
+func (g *Game) IsSeatNameReserved(name string) bool {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+	return g.seatNameReservedLocked(name)
+}
+
+// AddInvite opens an invite, reserving one of the free seats under its name
+func (g *Game) AddInvite(ctx context.Context, invite Invite) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if invite.Token == "" {
+		return fmt.Errorf("invite token is required")
+	}
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if _, exists := g.invites[invite.Token]; exists {
+		return fmt.Errorf("invite %s already exists in game %s", invite.Token, g.id)
+	}
+	for _, p := range g.players {
+		if strings.EqualFold(p.Name(), invite.SeatName) {
+			return fmt.Errorf("a player named %s is already seated", invite.SeatName)
+		}
+	}
+	if g.seatNameReservedLocked(invite.SeatName) {
+		return fmt.Errorf("seat %s is already reserved", invite.SeatName)
+	}
+	maxPlayers := g.settings.MaxPlayers
+	if maxPlayers == 0 {
+		maxPlayers = DefaultMaxPlayers
+	}
+	if len(g.players)+len(g.invites) >= maxPlayers {
+		return fmt.Errorf("no free seats left to reserve")
+	}
+
+	g.invites[invite.Token] = invite
+	g.updatedAt = time.Now()
+	return nil
+}
+
+// RevokeInvite closes an open invite, freeing its seat
+func (g *Game) RevokeInvite(ctx context.Context, token string) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if _, exists := g.invites[token]; !exists {
+		return ErrInviteNotFound
+	}
+	delete(g.invites, token)
+	g.updatedAt = time.Now()
+	return nil
+}
+
+// ClaimInvite closes an open invite and returns it, so the caller can seat the invited player
+func (g *Game) ClaimInvite(ctx context.Context, token string) (Invite, error) {
+	if err := ctx.Err(); err != nil {
+		return Invite{}, err
+	}
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	invite, exists := g.invites[token]
+	if !exists {
+		return Invite{}, ErrInviteNotFound
+	}
+	delete(g.invites, token)
+	g.updatedAt = time.Now()
+	return invite, nil
+}
+
+// ReleaseInvite reopens a claimed invite whose player could not be seated, so its token works again
+func (g *Game) ReleaseInvite(ctx context.Context, invite Invite) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if _, exists := g.invites[invite.Token]; exists {
+		return fmt.Errorf("invite %s is already open in game %s", invite.Token, g.id)
+	}
+	g.invites[invite.Token] = invite
+	g.updatedAt = time.Now()
+	return nil
+}
+
+func (g *Game) seatNameReservedLocked(name string) bool {
+	for _, invite := range g.invites {
+		if strings.EqualFold(invite.SeatName, name) {
+			return true
+		}
+	}
+	return false
+}
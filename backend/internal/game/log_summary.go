@@ -0,0 +1,152 @@
+package game
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// LogSummary is the server-rendered one-line description of a log entry, so every client shows the same text
+// Text is the English rendering; Key and Params let a client render the same line from its own translations
+type LogSummary struct {
+	Key    string            // "log." + source type in kebab case, e.g. "log.standard-project"
+	Params map[string]string // player, source, description, and when present tiles and gains
+	Text   string
+}
+
+// SummarizeLogEntry renders the summary of a log entry from its description and the changes it made:
+// "Alice built city, placed a City at (2,-1,-1) and gained 2 steel"
+// playerNames maps player IDs to display names; entries without a player use the description alone
+func SummarizeLogEntry(sourceType SourceType, source, playerID, description string, changes *GameChanges, playerNames map[string]string) LogSummary {
+	params := map[string]string{
+		"source":      source,
+		"description": description,
+	}
+
+	var clauses []string
+	if tiles := tileClause(changes, playerID); tiles != "" {
+		params["tiles"] = tiles
+		clauses = append(clauses, "placed "+tiles)
+	}
+	if gains := gainsClause(changes, playerID); gains != "" {
+		params["gains"] = gains
+		clauses = append(clauses, "gained "+gains)
+	}
+
+	text := description
+	if playerID != "" {
+		name := playerNames[playerID]
+		if name == "" {
+			name = playerID
+		}
+		params["player"] = name
+		text = strings.TrimSpace(name + " " + lowerFirst(description))
+	}
+	if text == "" {
+		text = source
+	}
+	switch len(clauses) {
+	case 1:
+		text += " and " + clauses[0]
+	case 2:
+		text += ", " + clauses[0] + " and " + clauses[1]
+	}
+
+	return LogSummary{
+		Key:    "log." + strings.ReplaceAll(string(sourceType), "_", "-"),
+		Params: params,
+		Text:   text,
+	}
+}
+
+// tileClause lists the tiles the player placed, ordered by hex: "a City at D6"
+func tileClause(changes *GameChanges, playerID string) string {
+	if changes == nil || changes.BoardChanges == nil || playerID == "" {
+		return ""
+	}
+
+	placements := append([]TilePlacement{}, changes.BoardChanges.TilesPlaced...)
+	sort.Slice(placements, func(i, j int) bool { return placements[i].HexID < placements[j].HexID })
+
+	var parts []string
+	for _, placement := range placements {
+		if placement.OwnerID != playerID && placement.OwnerID != "" {
+			continue
+		}
+		parts = append(parts, fmt.Sprintf("a %s at %s", upperFirst(placement.TileType), placementHexName(placement)))
+	}
+	return joinClause(parts)
+}
+
+// placementHexName falls back to the raw coordinates for placements recorded without a name
+func placementHexName(placement TilePlacement) string {
+	if placement.HexName != "" {
+		return placement.HexName
+	}
+	return "(" + placement.HexID + ")"
+}
+
+// gainsClause lists what the player gained, in a fixed order: "1 TR, 2 steel and 1 energy production"
+func gainsClause(changes *GameChanges, playerID string) string {
+	if changes == nil || playerID == "" {
+		return ""
+	}
+	pc := changes.PlayerChanges[playerID]
+	if pc == nil {
+		return ""
+	}
+
+	gains := []struct {
+		label string
+		diff  *DiffValueInt
+	}{
+		{"TR", pc.TerraformRating},
+		{"M€", pc.Credits},
+		{"steel", pc.Steel},
+		{"titanium", pc.Titanium},
+		{"plants", pc.Plants},
+		{"energy", pc.Energy},
+		{"heat", pc.Heat},
+		{"M€ production", pc.CreditsProduction},
+		{"steel production", pc.SteelProduction},
+		{"titanium production", pc.TitaniumProduction},
+		{"plant production", pc.PlantsProduction},
+		{"energy production", pc.EnergyProduction},
+		{"heat production", pc.HeatProduction},
+	}
+
+	var parts []string
+	for _, gain := range gains {
+		if gain.diff != nil && gain.diff.New > gain.diff.Old {
+			parts = append(parts, fmt.Sprintf("%d %s", gain.diff.New-gain.diff.Old, gain.label))
+		}
+	}
+	return joinClause(parts)
+}
+
+// joinClause joins parts as English prose: "a", "a and b", "a, b and c"
+func joinClause(parts []string) string {
+	switch len(parts) {
+	case 0:
+		return ""
+	case 1:
+		return parts[0]
+	default:
+		return strings.Join(parts[:len(parts)-1], ", ") + " and " + parts[len(parts)-1]
+	}
+}
+
+func lowerFirst(s string) string {
+	for i, r := range s {
+		return string(unicode.ToLower(r)) + s[i+len(string(r)):]
+	}
+	return s
+}
+
+func upperFirst(s string) string {
+	for i, r := range s {
+		return string(unicode.ToUpper(r)) + s[i+len(string(r)):]
+	}
+	return s
+}
@@ -17,6 +17,9 @@ const (
 	MilestoneVP          = 5 // VP awarded for each claimed milestone
 )
 
+// ErrMilestonesFull is returned for a claim once every milestone slot is taken
+var ErrMilestonesFull = fmt.Errorf("maximum milestones (%d) already claimed", MaxClaimedMilestones)
+
 // MilestoneInfo contains display information about a milestone
 type MilestoneInfo struct {
 	Type        shared.MilestoneType
@@ -131,7 +134,7 @@ func (m *Milestones) ClaimMilestone(ctx context.Context, milestoneType shared.Mi
 
 	if len(m.claimed) >= MaxClaimedMilestones {
 		m.mu.Unlock()
-		return fmt.Errorf("maximum milestones (%d) already claimed", MaxClaimedMilestones)
+		return ErrMilestonesFull
 	}
 
 	for _, claimed := range m.claimed {
@@ -0,0 +1,70 @@
+package game
+
+import (
+	"context"
+	"fmt"
+
+	"terraforming-mars-backend/internal/game/parameters"
+	"terraforming-mars-backend/internal/game/shared"
+)
+
+// parameterBonusSource is the tile selection source of an ocean granted by the temperature track
+const parameterBonusSource = "Temperature bonus"
+
+// RaiseTemperature raises the temperature and grants the raising player the track bonuses the new steps reach
+// Returns the actual number of steps raised; terraform rating for those steps stays with the caller
+func (g *Game) RaiseTemperature(ctx context.Context, playerID string, steps int) (int, error) {
+	oldValue := g.globalParameters.Temperature()
+	actualSteps, err := g.globalParameters.IncreaseTemperature(ctx, steps)
+	if err != nil || actualSteps == 0 {
+		return actualSteps, err
+	}
+	return actualSteps, g.grantParameterBonuses(ctx, playerID, parameters.Temperature, oldValue, g.globalParameters.Temperature())
+}
+
+// RaiseOxygen raises the oxygen and grants the raising player the track bonuses the new steps reach
+// Returns the actual number of steps raised; terraform rating for those steps stays with the caller
+func (g *Game) RaiseOxygen(ctx context.Context, playerID string, steps int) (int, error) {
+	oldValue := g.globalParameters.Oxygen()
+	actualSteps, err := g.globalParameters.IncreaseOxygen(ctx, steps)
+	if err != nil || actualSteps == 0 {
+		return actualSteps, err
+	}
+	return actualSteps, g.grantParameterBonuses(ctx, playerID, parameters.Oxygen, oldValue, g.globalParameters.Oxygen())
+}
+
+// grantParameterBonuses gives a player every bonus between two values of a track
+// A raise with no player behind it (playerID empty or unknown) grants nothing
+func (g *Game) grantParameterBonuses(ctx context.Context, playerID string, track parameters.Definition, oldValue, newValue int) error {
+	bonuses := track.BonusesReached(oldValue, newValue)
+	if len(bonuses) == 0 {
+		return nil
+	}
+	p, err := g.GetPlayer(playerID)
+	if err != nil {
+		return nil
+	}
+
+	for _, bonus := range bonuses {
+		switch bonus.Reward {
+		case parameters.BonusHeatProduction:
+			p.Resources().AddProduction(map[shared.ResourceType]int{shared.ResourceHeatProduction: 1})
+		case parameters.BonusOcean:
+			if parameters.Oceans.IsMaxed(g.globalParameters.Oceans()) {
+				continue
+			}
+			if err := g.AppendToPendingTileSelectionQueue(ctx, playerID, []string{"ocean"}, parameterBonusSource, nil); err != nil {
+				return fmt.Errorf("failed to queue %s bonus ocean: %w", track.Name, err)
+			}
+		case parameters.BonusTemperature:
+			steps, err := g.RaiseTemperature(ctx, playerID, 1)
+			if err != nil {
+				return fmt.Errorf("failed to raise %s bonus temperature: %w", track.Name, err)
+			}
+			if steps > 0 {
+				p.Resources().UpdateTerraformRating(1)
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,126 @@
+package parameters
+
+import "fmt"
+
+// Bounds of each global parameter track
+const (
+	MinTemperature = -30
+	MaxTemperature = 8
+	MinOxygen      = 0
+	MaxOxygen      = 14
+	MinOceans      = 0
+	MaxOceans      = 9
+	MinVenus       = 0
+	MaxVenus       = 30
+)
+
+// BonusReward is what a player gets for raising a parameter to a bonus threshold
+type BonusReward string
+
+const (
+	BonusHeatProduction BonusReward = "heat-production" // +1 heat production
+	BonusOcean          BonusReward = "ocean"           // Place an ocean tile
+	BonusTemperature    BonusReward = "temperature"     // Raise the temperature one step
+)
+
+// Bonus is a reward for the player whose step reaches At
+type Bonus struct {
+	At     int
+	Reward BonusReward
+}
+
+// Definition describes one global parameter track: its bounds, step size and what each step is worth
+// Every value on the track is Min plus a whole number of steps, up to Max
+type Definition struct {
+	Name      string
+	Unit      string // Suffix for displayed values: "°C", "%", or empty for a count
+	Min       int
+	Max       int
+	Step      int // Change in value per step
+	TRPerStep int // Terraform rating gained per step raised
+	Bonuses   []Bonus
+}
+
+var (
+	// Temperature is raised in 2°C steps from -30°C to +8°C
+	Temperature = Definition{
+		Name: "temperature", Unit: "°C", Min: MinTemperature, Max: MaxTemperature, Step: 2, TRPerStep: 1,
+		Bonuses: []Bonus{{At: -24, Reward: BonusHeatProduction}, {At: -20, Reward: BonusHeatProduction}, {At: 0, Reward: BonusOcean}},
+	}
+
+	// Oxygen is raised in 1% steps from 0% to 14%
+	Oxygen = Definition{
+		Name: "oxygen", Unit: "%", Min: MinOxygen, Max: MaxOxygen, Step: 1, TRPerStep: 1,
+		Bonuses: []Bonus{{At: 8, Reward: BonusTemperature}},
+	}
+
+	// Oceans counts placed ocean tiles, up to 9
+	Oceans = Definition{
+		Name: "oceans", Min: MinOceans, Max: MaxOceans, Step: 1, TRPerStep: 1,
+	}
+
+	// Venus is the Venus Next track, raised in 2% steps from 0% to 30%; games do not track it yet,
+	// so its bonuses (a card at 8%, TR at 16%) come with the track
+	Venus = Definition{
+		Name: "venus", Unit: "%", Min: MinVenus, Max: MaxVenus, Step: 2, TRPerStep: 1,
+	}
+)
+
+// All returns every parameter definition, in display order
+func All() []Definition {
+	return []Definition{Temperature, Oxygen, Oceans, Venus}
+}
+
+// Steps returns the number of steps from Min to Max
+func (d Definition) Steps() int {
+	return (d.Max - d.Min) / d.Step
+}
+
+// RemainingSteps returns how many steps value can still be raised
+func (d Definition) RemainingSteps(value int) int {
+	if value >= d.Max {
+		return 0
+	}
+	return (d.Max - value) / d.Step
+}
+
+// IsMaxed reports whether value is at the top of the track
+func (d Definition) IsMaxed(value int) bool {
+	return value >= d.Max
+}
+
+// Raise returns value raised by up to steps, stopping at Max, and the number of steps actually raised
+func (d Definition) Raise(value, steps int) (raised int, actualSteps int) {
+	actualSteps = min(steps, d.RemainingSteps(value))
+	if actualSteps <= 0 {
+		return value, 0
+	}
+	return value + actualSteps*d.Step, actualSteps
+}
+
+// BonusesReached returns the bonuses a raise from oldValue to newValue reaches, in track order
+func (d Definition) BonusesReached(oldValue, newValue int) []Bonus {
+	var reached []Bonus
+	for _, bonus := range d.Bonuses {
+		if bonus.At > oldValue && bonus.At <= newValue {
+			reached = append(reached, bonus)
+		}
+	}
+	return reached
+}
+
+// Validate checks that value is on the track: within bounds and a whole number of steps from Min
+func (d Definition) Validate(value int) error {
+	if value < d.Min || value > d.Max {
+		return fmt.Errorf("%s must be between %s and %s, got %s", d.Name, d.Format(d.Min), d.Format(d.Max), d.Format(value))
+	}
+	if (value-d.Min)%d.Step != 0 {
+		return fmt.Errorf("%s moves in steps of %d from %s, got %s", d.Name, d.Step, d.Format(d.Min), d.Format(value))
+	}
+	return nil
+}
+
+// Format returns value with the parameter's unit, e.g. "-30°C" or "14%"
+func (d Definition) Format(value int) string {
+	return fmt.Sprintf("%d%s", value, d.Unit)
+}
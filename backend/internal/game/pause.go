@@ -0,0 +1,15 @@
+package game
+
+import (
+	"errors"
+	"time"
+)
+
+// ErrGamePaused is returned for gameplay actions attempted while the game is paused
+var ErrGamePaused = errors.New("game is paused")
+
+// PauseState records who paused a game and when
+type PauseState struct {
+	PausedBy string // Player whose request paused the game (the host, or the last voter)
+	PausedAt time.Time
+}
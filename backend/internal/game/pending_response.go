@@ -0,0 +1,107 @@
+package game
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"terraforming-mars-backend/internal/events"
+	"terraforming-mars-backend/internal/game/player"
+)
+
+// ResponseTimeout is how long a player has to answer a pending response before its default option is applied
+const ResponseTimeout = 30 * time.Second
+
+// ErrAwaitingResponse is returned for gameplay actions attempted while an effect waits on a player's response
+var ErrAwaitingResponse = errors.New("waiting for a player to respond to an effect")
+
+// GetPendingResponse returns the response a player still owes, or nil
+func (g *Game) GetPendingResponse(playerID string) *player.PendingResponse {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+
+	response, exists := g.pendingResponses[playerID]
+	if !exists || response == nil {
+		return nil
+	}
+	responseCopy := *response
+	return &responseCopy
+}
+
+// OpenPendingResponse asks a player to answer an effect; a player owes at most one response at a time
+func (g *Game) OpenPendingResponse(ctx context.Context, playerID string, response *player.PendingResponse) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if response == nil || len(response.Options) == 0 {
+		return fmt.Errorf("a pending response needs at least one option")
+	}
+	if response.DefaultOption < 0 || response.DefaultOption >= len(response.Options) {
+		return fmt.Errorf("default option %d out of range", response.DefaultOption)
+	}
+
+	g.mu.Lock()
+	if _, exists := g.players[playerID]; !exists {
+		g.mu.Unlock()
+		return fmt.Errorf("player %s not found in game %s", playerID, g.id)
+	}
+	if _, exists := g.pendingResponses[playerID]; exists {
+		g.mu.Unlock()
+		return fmt.Errorf("player %s already has a pending response", playerID)
+	}
+	responseCopy := *response
+	if responseCopy.OpenedAt.IsZero() {
+		responseCopy.OpenedAt = time.Now()
+	}
+	g.pendingResponses[playerID] = &responseCopy
+	g.updatedAt = time.Now()
+	g.mu.Unlock()
+
+	if g.eventBus != nil {
+		events.Publish(g.eventBus, events.GameStateChangedEvent{
+			GameID:    g.id,
+			Timestamp: time.Now(),
+		})
+	}
+	return nil
+}
+
+// ClearPendingResponse removes a player's pending response once it is resolved
+func (g *Game) ClearPendingResponse(ctx context.Context, playerID string) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	g.mu.Lock()
+	delete(g.pendingResponses, playerID)
+	g.updatedAt = time.Now()
+	g.mu.Unlock()
+
+	if g.eventBus != nil {
+		events.Publish(g.eventBus, events.GameStateChangedEvent{
+			GameID:    g.id,
+			Timestamp: time.Now(),
+		})
+	}
+	return nil
+}
+
+// AwaitingResponses reports whether any player still owes a response
+func (g *Game) AwaitingResponses() bool {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+	return len(g.pendingResponses) > 0
+}
+
+// ResponseDeadline returns when a player's pending response times out; false if they owe none
+func (g *Game) ResponseDeadline(playerID string) (time.Time, bool) {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+
+	response, exists := g.pendingResponses[playerID]
+	if !exists || response == nil {
+		return time.Time{}, false
+	}
+	return response.OpenedAt.Add(ResponseTimeout), true
+}
@@ -46,8 +46,24 @@ func (a *Actions) AddAction(action CardAction) {
 	a.actions = append(a.actions, action)
 }
 
+// RecordUse counts one use of a card action towards its turn, generation and game totals
+// Returns false when the player has no such action
+func (a *Actions) RecordUse(cardID string, behaviorIndex int) bool {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	for i := range a.actions {
+		if a.actions[i].CardID == cardID && a.actions[i].BehaviorIndex == behaviorIndex {
+			a.actions[i].TimesUsedThisTurn++
+			a.actions[i].TimesUsedThisGeneration++
+			a.actions[i].TimesUsedThisGame++
+			return true
+		}
+	}
+	return false
+}
+
 // ResetGenerationCounts resets the generation counts for all actions to 0
-// Called at the start of each new generation
+// Called at the start of each new generation; game counts are never reset
 func (a *Actions) ResetGenerationCounts() {
 	a.mu.Lock()
 	defer a.mu.Unlock()
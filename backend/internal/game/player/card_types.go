@@ -34,6 +34,7 @@ type CardAction struct {
 	Behavior                shared.CardBehavior
 	TimesUsedThisTurn       int
 	TimesUsedThisGeneration int
+	TimesUsedThisGame       int
 }
 
 // DeepCopy creates a deep copy of the CardAction
@@ -49,5 +50,11 @@ func (pa *CardAction) DeepCopy() *CardAction {
 		Behavior:                pa.Behavior.DeepCopy(),
 		TimesUsedThisTurn:       pa.TimesUsedThisTurn,
 		TimesUsedThisGeneration: pa.TimesUsedThisGeneration,
+		TimesUsedThisGame:       pa.TimesUsedThisGame,
 	}
 }
+
+// UsageLimitReached reports whether the action has been used up for its generation or game limit
+func (pa *CardAction) UsageLimitReached() bool {
+	return pa.Behavior.UsageLimitReached(pa.TimesUsedThisGeneration, pa.TimesUsedThisGame)
+}
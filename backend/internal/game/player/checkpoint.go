@@ -0,0 +1,197 @@
+package player
+
+import (
+	"terraforming-mars-backend/internal/events"
+	"terraforming-mars-backend/internal/game/shared"
+)
+
+// Checkpoint is a copy of everything an action can change on a player
+// Restoring writes the values back directly, without publishing domain events,
+// so passive effects never fire for a rolled-back change
+type Checkpoint struct {
+	corporationID      string
+	hasPassed          bool
+	demoSetupConfirmed bool
+	preludes           []string
+
+	handCards   []string
+	playerCards map[string]*PlayerCard
+	playedCards []string
+	faceDown    []string
+
+	resources          shared.Resources
+	production         shared.Production
+	terraformRating    int
+	resourceStorage    map[string]int
+	paymentSubstitutes []shared.PaymentSubstitute
+	valueModifiers     map[shared.ResourceType]int
+
+	selectStartingCardsPhase *SelectStartingCardsPhase
+	pendingCardSelection     *PendingCardSelection
+	pendingCardDrawSelection *PendingCardDrawSelection
+
+	actions            []CardAction
+	effects            []CardEffect
+	subscriptions      map[string][]events.SubscriptionID
+	generationalEvents map[shared.GenerationalEvent]int
+	vpGranters         []VPGranter
+}
+
+// Checkpoint captures the player's current state
+func (p *Player) Checkpoint() Checkpoint {
+	cp := Checkpoint{
+		corporationID:      p.corporationID,
+		hasPassed:          p.hasPassed,
+		demoSetupConfirmed: p.demoSetupConfirmed,
+		preludes:           p.Preludes(),
+	}
+
+	p.hand.mu.RLock()
+	cp.handCards = append([]string{}, p.hand.cards...)
+	cp.playerCards = make(map[string]*PlayerCard, len(p.hand.playerCards))
+	for cardID, pc := range p.hand.playerCards {
+		cp.playerCards[cardID] = pc
+	}
+	p.hand.mu.RUnlock()
+
+	cp.playedCards = p.playedCards.Cards()
+	cp.faceDown = p.playedCards.FaceDownCards()
+
+	p.resources.mu.RLock()
+	cp.resources = p.resources.resources
+	cp.production = p.resources.production
+	cp.terraformRating = p.resources.terraformRating
+	cp.resourceStorage = make(map[string]int, len(p.resources.resourceStorage))
+	for cardID, amount := range p.resources.resourceStorage {
+		cp.resourceStorage[cardID] = amount
+	}
+	cp.paymentSubstitutes = append([]shared.PaymentSubstitute{}, p.resources.paymentSubstitutes...)
+	cp.valueModifiers = make(map[shared.ResourceType]int, len(p.resources.valueModifiers))
+	for resourceType, amount := range p.resources.valueModifiers {
+		cp.valueModifiers[resourceType] = amount
+	}
+	p.resources.mu.RUnlock()
+
+	p.selection.mu.RLock()
+	cp.selectStartingCardsPhase = p.selection.selectStartingCardsPhase
+	cp.pendingCardSelection = p.selection.pendingCardSelection
+	cp.pendingCardDrawSelection = p.selection.pendingCardDrawSelection
+	p.selection.mu.RUnlock()
+
+	cp.actions = p.actions.List()
+
+	p.effects.mu.RLock()
+	cp.effects = append([]CardEffect{}, p.effects.effects...)
+	cp.subscriptions = make(map[string][]events.SubscriptionID, len(p.effects.subscriptions))
+	for cardID, subs := range p.effects.subscriptions {
+		cp.subscriptions[cardID] = append([]events.SubscriptionID{}, subs...)
+	}
+	p.effects.mu.RUnlock()
+
+	p.generationalEvents.mu.RLock()
+	cp.generationalEvents = make(map[shared.GenerationalEvent]int, len(p.generationalEvents.counts))
+	for event, count := range p.generationalEvents.counts {
+		cp.generationalEvents[event] = count
+	}
+	p.generationalEvents.mu.RUnlock()
+
+	cp.vpGranters = p.vpGranters.GetAll()
+
+	return cp
+}
+
+// Restore puts the player back to a checkpoint
+// Event subscriptions registered after the checkpoint are unsubscribed
+func (p *Player) Restore(cp Checkpoint) {
+	p.corporationID = cp.corporationID
+	p.hasPassed = cp.hasPassed
+	p.demoSetupConfirmed = cp.demoSetupConfirmed
+	p.SetPreludes(cp.preludes)
+
+	p.hand.mu.Lock()
+	p.hand.cards = append([]string{}, cp.handCards...)
+	p.hand.playerCards = make(map[string]*PlayerCard, len(cp.playerCards))
+	for cardID, pc := range cp.playerCards {
+		p.hand.playerCards[cardID] = pc
+	}
+	p.hand.mu.Unlock()
+
+	p.playedCards.SetCards(cp.playedCards, cp.faceDown)
+
+	p.resources.mu.Lock()
+	p.resources.resources = cp.resources
+	p.resources.production = cp.production
+	p.resources.terraformRating = cp.terraformRating
+	p.resources.resourceStorage = make(map[string]int, len(cp.resourceStorage))
+	for cardID, amount := range cp.resourceStorage {
+		p.resources.resourceStorage[cardID] = amount
+	}
+	p.resources.paymentSubstitutes = append([]shared.PaymentSubstitute{}, cp.paymentSubstitutes...)
+	p.resources.valueModifiers = make(map[shared.ResourceType]int, len(cp.valueModifiers))
+	for resourceType, amount := range cp.valueModifiers {
+		p.resources.valueModifiers[resourceType] = amount
+	}
+	p.resources.mu.Unlock()
+
+	p.selection.mu.Lock()
+	p.selection.selectStartingCardsPhase = cp.selectStartingCardsPhase
+	p.selection.pendingCardSelection = cp.pendingCardSelection
+	p.selection.pendingCardDrawSelection = cp.pendingCardDrawSelection
+	p.selection.mu.Unlock()
+
+	p.actions.SetActions(cp.actions)
+
+	p.effects.mu.Lock()
+	kept := make(map[events.SubscriptionID]bool)
+	for _, subs := range cp.subscriptions {
+		for _, subID := range subs {
+			kept[subID] = true
+		}
+	}
+	for _, subs := range p.effects.subscriptions {
+		for _, subID := range subs {
+			if !kept[subID] && p.effects.eventBus != nil {
+				p.effects.eventBus.Unsubscribe(subID)
+			}
+		}
+	}
+	p.effects.effects = append([]CardEffect{}, cp.effects...)
+	p.effects.subscriptions = make(map[string][]events.SubscriptionID, len(cp.subscriptions))
+	for cardID, subs := range cp.subscriptions {
+		p.effects.subscriptions[cardID] = append([]events.SubscriptionID{}, subs...)
+	}
+	p.effects.mu.Unlock()
+
+	p.generationalEvents.mu.Lock()
+	p.generationalEvents.counts = make(map[shared.GenerationalEvent]int, len(cp.generationalEvents))
+	for event, count := range cp.generationalEvents {
+		p.generationalEvents.counts[event] = count
+	}
+	p.generationalEvents.mu.Unlock()
+
+	p.vpGranters.mu.Lock()
+	p.vpGranters.granters = append([]VPGranter{}, cp.vpGranters...)
+	p.vpGranters.mu.Unlock()
+
+	p.vpPreview.Invalidate()
+}
+
+// Clone returns a copy of the player whose components publish on another event bus
+// Passive effect subscriptions and cached hand PlayerCards are bound to this player's bus,
+// so the clone starts without them and the caller re-creates both on the new bus
+func (p *Player) Clone(eventBus *events.EventBusImpl) *Player {
+	clone := NewPlayer(eventBus, p.gameID, p.id, p.name)
+	clone.connected = p.connected
+	clone.autoPass = p.autoPass
+	clone.locale = p.locale
+	clone.accountID = p.accountID
+	clone.mutedPlayers = append([]string{}, p.mutedPlayers...)
+	clone.reactionsMuted = p.reactionsMuted
+	clone.note = p.note
+
+	cp := p.Checkpoint()
+	cp.subscriptions = nil
+	cp.playerCards = nil
+	clone.Restore(cp)
+	return clone
+}
@@ -14,6 +14,13 @@ type Player struct {
 	corporationID      string
 	hasPassed          bool
 	demoSetupConfirmed bool
+	autoPass           bool     // Preference: pass automatically when a turn starts with no legal action
+	locale             string   // Preference: language of server messages; empty for English
+	accountID          string   // Optional client-chosen account the seat belongs to; never sent to other players
+	preludes           []string // Preludes kept from the starting selection
+	mutedPlayers       []string // Preference: players whose reactions are hidden from this player
+	reactionsMuted     bool     // Preference: hide every player's reactions
+	note               string   // Private note for this game; only ever sent to this player
 
 	hand               *Hand
 	playedCards        *PlayedCards
@@ -23,6 +30,7 @@ type Player struct {
 	effects            *Effects
 	generationalEvents *GenerationalEvents
 	vpGranters         *VPGranters
+	vpPreview          *VPPreview
 }
 
 // NewPlayer creates a new player with initialized components
@@ -44,6 +52,7 @@ func NewPlayer(eventBus *events.EventBusImpl, gameID, playerID, name string) *Pl
 		effects:            NewEffects(eventBus),
 		generationalEvents: newGenerationalEvents(),
 		vpGranters:         NewVPGranters(eventBus, gameID, playerID),
+		vpPreview:          newVPPreview(),
 	}
 }
 
@@ -114,6 +123,11 @@ func (p *Player) VPGranters() *VPGranters {
 	return p.vpGranters
 }
 
+// VPPreview holds the cached VP value of each played card
+func (p *Player) VPPreview() *VPPreview {
+	return p.vpPreview
+}
+
 func (p *Player) HasPassed() bool {
 	return p.hasPassed
 }
@@ -125,6 +139,86 @@ func (p *Player) SetPassed(passed bool) {
 	}
 }
 
+// AutoPass reports whether the player asked to pass automatically when they can take no legal action
+func (p *Player) AutoPass() bool {
+	return p.autoPass
+}
+
+func (p *Player) SetAutoPass(autoPass bool) {
+	p.autoPass = autoPass
+}
+
+// Locale returns the language the player reads server messages in, or "" for English
+func (p *Player) Locale() string {
+	return p.locale
+}
+
+func (p *Player) SetLocale(locale string) {
+	p.locale = locale
+}
+
+// MutedPlayers returns a copy of the players whose reactions this player hides
+func (p *Player) MutedPlayers() []string {
+	return append([]string{}, p.mutedPlayers...)
+}
+
+// SetPlayerMuted hides or shows another player's reactions
+func (p *Player) SetPlayerMuted(playerID string, muted bool) {
+	kept := make([]string, 0, len(p.mutedPlayers)+1)
+	for _, id := range p.mutedPlayers {
+		if id != playerID {
+			kept = append(kept, id)
+		}
+	}
+	if muted {
+		kept = append(kept, playerID)
+	}
+	p.mutedPlayers = kept
+}
+
+// ReactionsMuted reports whether the player hides every player's reactions
+func (p *Player) ReactionsMuted() bool {
+	return p.reactionsMuted
+}
+
+func (p *Player) SetReactionsMuted(muted bool) {
+	p.reactionsMuted = muted
+}
+
+// HidesReactionsFrom reports whether reactions from the sender are hidden from this player
+// Players always see their own reactions
+func (p *Player) HidesReactionsFrom(senderID string) bool {
+	if senderID == p.id {
+		return false
+	}
+	if p.reactionsMuted {
+		return true
+	}
+	for _, id := range p.mutedPlayers {
+		if id == senderID {
+			return true
+		}
+	}
+	return false
+}
+
+// Note returns the player's private note for this game
+func (p *Player) Note() string {
+	return p.note
+}
+
+func (p *Player) SetNote(note string) {
+	p.note = note
+}
+
+func (p *Player) AccountID() string {
+	return p.accountID
+}
+
+func (p *Player) SetAccountID(accountID string) {
+	p.accountID = accountID
+}
+
 func (p *Player) DemoSetupConfirmed() bool {
 	return p.demoSetupConfirmed
 }
@@ -132,3 +226,12 @@ func (p *Player) DemoSetupConfirmed() bool {
 func (p *Player) SetDemoSetupConfirmed(confirmed bool) {
 	p.demoSetupConfirmed = confirmed
 }
+
+// Preludes returns a copy of the preludes the player kept from the starting selection
+func (p *Player) Preludes() []string {
+	return append([]string{}, p.preludes...)
+}
+
+func (p *Player) SetPreludes(preludeIDs []string) {
+	p.preludes = append([]string{}, preludeIDs...)
+}
@@ -15,6 +15,7 @@ type PlayerCardAction struct {
 
 	timesUsedThisTurn       int
 	timesUsedThisGeneration int
+	timesUsedThisGame       int
 
 	mu    sync.RWMutex
 	state EntityState
@@ -34,6 +35,7 @@ func NewPlayerCardAction(
 		behavior:                behavior,
 		timesUsedThisTurn:       0,
 		timesUsedThisGeneration: 0,
+		timesUsedThisGame:       0,
 		state: EntityState{
 			Errors:   []StateError{},
 			Metadata: make(map[string]interface{}),
@@ -85,13 +87,14 @@ func (pca *PlayerCardAction) IsAvailable() bool {
 	return pca.state.Available()
 }
 
-// IncrementPlayCount increments both turn and generation play counts.
+// IncrementPlayCount increments the turn, generation and game play counts.
 // Called when the action is used.
 func (pca *PlayerCardAction) IncrementPlayCount() {
 	pca.mu.Lock()
 	defer pca.mu.Unlock()
 	pca.timesUsedThisTurn++
 	pca.timesUsedThisGeneration++
+	pca.timesUsedThisGame++
 }
 
 // ResetTurnPlayCount resets the turn play count (called at turn end).
@@ -122,6 +125,13 @@ func (pca *PlayerCardAction) TimesUsedThisGeneration() int {
 	return pca.timesUsedThisGeneration
 }
 
+// TimesUsedThisGame returns the number of times this action has been used this game.
+func (pca *PlayerCardAction) TimesUsedThisGame() int {
+	pca.mu.RLock()
+	defer pca.mu.RUnlock()
+	return pca.timesUsedThisGame
+}
+
 // Cleanup unsubscribes all event listeners.
 // Called when action is removed to prevent memory leaks.
 func (pca *PlayerCardAction) Cleanup() {
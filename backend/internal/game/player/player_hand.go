@@ -118,7 +118,6 @@ func (h *Hand) RemoveCard(cardID string) bool {
 			CardIDs:   cardsCopy,
 			Timestamp: time.Now(),
 		})
-
 	}
 
 	return removed
@@ -1,15 +1,21 @@
 package player
 
 import (
+	"slices"
 	"sync"
 	"terraforming-mars-backend/internal/events"
 	"time"
 )
 
-// PlayedCards manages all cards a player has played, including corporation
+// eventCardType is the card type played face-down
+const eventCardType = "event"
+
+// PlayedCards manages all cards a player has played, including corporation.
+// Events go to a face-down pile: they stay played cards (VP, storage) but hide their tags
 type PlayedCards struct {
 	mu       sync.RWMutex
 	cards    []string // Includes ALL played cards (corporation + project cards)
+	faceDown []string // Events among cards, played face-down
 	eventBus *events.EventBusImpl
 	gameID   string
 	playerID string
@@ -18,6 +24,7 @@ type PlayedCards struct {
 func newPlayedCards(eventBus *events.EventBusImpl, gameID, playerID string) *PlayedCards {
 	return &PlayedCards{
 		cards:    []string{},
+		faceDown: []string{},
 		eventBus: eventBus,
 		gameID:   gameID,
 		playerID: playerID,
@@ -45,7 +52,24 @@ func (pc *PlayedCards) Contains(cardID string) bool {
 	return false
 }
 
-// AddCard adds a card to played cards (used for both corporation and project cards)
+// IsFaceDown checks if a played card lies in the face-down event pile
+func (pc *PlayedCards) IsFaceDown(cardID string) bool {
+	pc.mu.RLock()
+	defer pc.mu.RUnlock()
+	return slices.Contains(pc.faceDown, cardID)
+}
+
+// FaceDownCards returns a copy of the played cards in the face-down event pile
+func (pc *PlayedCards) FaceDownCards() []string {
+	pc.mu.RLock()
+	defer pc.mu.RUnlock()
+	faceDownCopy := make([]string, len(pc.faceDown))
+	copy(faceDownCopy, pc.faceDown)
+	return faceDownCopy
+}
+
+// AddCard adds a card to played cards (used for both corporation and project cards).
+// Event cards are played face-down.
 // Parameters:
 //   - cardID: The unique identifier of the card
 //   - cardName: The display name of the card
@@ -54,6 +78,9 @@ func (pc *PlayedCards) Contains(cardID string) bool {
 func (pc *PlayedCards) AddCard(cardID, cardName, cardType string, tags []string) {
 	pc.mu.Lock()
 	pc.cards = append(pc.cards, cardID)
+	if cardType == eventCardType {
+		pc.faceDown = append(pc.faceDown, cardID)
+	}
 	pc.mu.Unlock()
 
 	if pc.eventBus != nil {
@@ -86,22 +113,20 @@ func (pc *PlayedCards) RemoveCard(cardID string) bool {
 	for i, id := range pc.cards {
 		if id == cardID {
 			pc.cards = append(pc.cards[:i], pc.cards[i+1:]...)
+			pc.faceDown = slices.DeleteFunc(pc.faceDown, func(faceDownID string) bool { return faceDownID == cardID })
 			return true
 		}
 	}
 	return false
 }
 
-// SetCards replaces all played cards (used for initialization/loading)
-func (pc *PlayedCards) SetCards(cards []string) {
+// SetCards replaces all played cards (used for initialization/loading).
+// faceDown lists the events among them
+func (pc *PlayedCards) SetCards(cards []string, faceDown []string) {
 	pc.mu.Lock()
 	defer pc.mu.Unlock()
-	if cards == nil {
-		pc.cards = []string{}
-	} else {
-		pc.cards = make([]string, len(cards))
-		copy(pc.cards, cards)
-	}
+	pc.cards = append([]string{}, cards...)
+	pc.faceDown = append([]string{}, faceDown...)
 }
 
 // Count returns the number of played cards
@@ -226,6 +226,23 @@ func (r *PlayerResources) Add(changes map[shared.ResourceType]int) {
 	}
 }
 
+// CanAddProduction reports whether every change keeps production at or above its floor.
+// A production decrease that would cross the floor cannot be applied at all.
+func (r *PlayerResources) CanAddProduction(changes map[shared.ResourceType]int) bool {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	for productionType, amount := range changes {
+		if amount >= 0 || !shared.IsProduction(productionType) {
+			continue
+		}
+		if r.production.Get(productionType)+amount < shared.ProductionFloor(productionType) {
+			return false
+		}
+	}
+	return true
+}
+
+// AddProduction applies production changes, clamping each at its floor
 func (r *PlayerResources) AddProduction(changes map[shared.ResourceType]int) {
 	r.mu.Lock()
 	oldProduction := r.production
@@ -2,6 +2,8 @@ package player
 
 import (
 	"sync"
+	"time"
+
 	"terraforming-mars-backend/internal/events"
 	"terraforming-mars-backend/internal/game/shared"
 )
@@ -95,6 +97,8 @@ type PendingCardDrawSelection struct {
 type SelectStartingCardsPhase struct {
 	AvailableCards        []string
 	AvailableCorporations []string
+	AvailablePreludes     []string // Empty unless the prelude pack is enabled
+	Mulliganed            bool     // The player used their one redraw under the mulligan house rule
 	SelectionComplete     bool
 }
 
@@ -116,10 +120,11 @@ type TileCompletionCallback struct {
 
 // PendingTileSelection represents a pending tile placement action
 type PendingTileSelection struct {
-	TileType       string
-	AvailableHexes []string
-	Source         string
-	OnComplete     *TileCompletionCallback
+	TileType         string
+	AvailableHexes   []string
+	Source           string
+	OnComplete       *TileCompletionCallback
+	TileRestrictions *shared.TileRestrictions // Placement rules the hexes were computed with; CaptureBonus also applies on placement
 }
 
 // PendingTileSelectionQueue represents a queue of tile placements
@@ -130,6 +135,31 @@ type PendingTileSelectionQueue struct {
 	TileRestrictions *shared.TileRestrictions
 }
 
+// PendingCardPlay is a card reserved by prepare-play-card until it is committed or cancelled
+// Nothing is paid or applied while the card is reserved; commit re-validates everything
+type PendingCardPlay struct {
+	CardID            string
+	EffectiveCost     int
+	RequiresChoice    bool     // Commit must include a choiceIndex
+	PendingPlacements []string // Tile types the card queues once committed (city, greenery, ocean or a special tile type)
+}
+
+// PendingResponse is a choice another player's effect asks of this player, such as which of their
+// own resources to lose to an attack. The game waits on it until it is answered or times out
+type PendingResponse struct {
+	SourcePlayerID string // Player whose effect opened the response
+	Source         string // Card name
+	Options        []ResponseOption
+	DefaultOption  int       // Applied when the response times out
+	OpenedAt       time.Time // Pushed back by time spent paused
+}
+
+// ResponseOption is one answer to a pending response: lose Amount of ResourceType (clamped to what the player has)
+type ResponseOption struct {
+	ResourceType shared.ResourceType
+	Amount       int
+}
+
 // ForcedFirstAction represents an action that must be completed as first action
 type ForcedFirstAction struct {
 	ActionType    string
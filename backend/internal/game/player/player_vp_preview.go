@@ -0,0 +1,44 @@
+package player
+
+import (
+	"maps"
+	"sync"
+)
+
+// VPPreview caches the current VP value of each of the player's played cards
+// The game invalidates it whenever storage, tags or tiles change, and the next read recomputes it
+type VPPreview struct {
+	mu     sync.RWMutex
+	cardVP map[string]int
+	valid  bool
+}
+
+func newVPPreview() *VPPreview {
+	return &VPPreview{}
+}
+
+// Get returns the cached per-card VP, and false when it must be recomputed
+func (vp *VPPreview) Get() (map[string]int, bool) {
+	vp.mu.RLock()
+	defer vp.mu.RUnlock()
+	if !vp.valid {
+		return nil, false
+	}
+	return maps.Clone(vp.cardVP), true
+}
+
+// Set caches freshly computed per-card VP
+func (vp *VPPreview) Set(cardVP map[string]int) {
+	vp.mu.Lock()
+	defer vp.mu.Unlock()
+	vp.cardVP = maps.Clone(cardVP)
+	vp.valid = true
+}
+
+// Invalidate drops the cached values so the next read recomputes them
+func (vp *VPPreview) Invalidate() {
+	vp.mu.Lock()
+	defer vp.mu.Unlock()
+	vp.cardVP = nil
+	vp.valid = false
+}
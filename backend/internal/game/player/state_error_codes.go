@@ -29,9 +29,11 @@ const (
 	ErrorCodeNoOceanTiles         StateErrorCode = "no-ocean-tiles"
 	ErrorCodeNoCityPlacements     StateErrorCode = "no-city-placements"
 	ErrorCodeNoGreeneryPlacements StateErrorCode = "no-greenery-placements"
+	ErrorCodeNoSpecialPlacements  StateErrorCode = "no-special-placements"
 	ErrorCodeNoCardsInHand        StateErrorCode = "no-cards-in-hand"
 	ErrorCodeInvalidProjectType   StateErrorCode = "invalid-project-type"
 	ErrorCodeInvalidRequirement   StateErrorCode = "invalid-requirement"
+	ErrorCodeExpansionDisabled    StateErrorCode = "expansion-disabled"
 
 	ErrorCodeMilestoneAlreadyClaimed    StateErrorCode = "milestone-already-claimed"
 	ErrorCodeMaxMilestonesClaimed       StateErrorCode = "max-milestones-claimed"
@@ -68,5 +70,6 @@ const (
 type StateWarningCode string
 
 const (
-	WarningCodeNoValidTilePlacements StateWarningCode = "no-valid-tile-placements"
+	WarningCodeNoValidTilePlacements    StateWarningCode = "no-valid-tile-placements"
+	WarningCodeDiscardSelectionRequired StateWarningCode = "discard-selection-required"
 )
@@ -0,0 +1,53 @@
+package game
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// defaultColorPalette is the Okabe-Ito palette, which stays distinguishable under the common
+// color vision deficiencies. Black is swapped for a light grey so it shows on the dark board.
+var defaultColorPalette = []string{
+	"#E69F00", // orange
+	"#56B4E9", // sky blue
+	"#009E73", // bluish green
+	"#F0E442", // yellow
+	"#0072B2", // blue
+	"#D55E00", // vermillion
+	"#CC79A7", // reddish purple
+	"#BBBBBB", // grey
+}
+
+var hexColorPattern = regexp.MustCompile(`^#[0-9A-F]{6}$`)
+
+// DefaultColorPalette returns the default colorblind-safe player color palette
+func DefaultColorPalette() []string {
+	palette := make([]string, len(defaultColorPalette))
+	copy(palette, defaultColorPalette)
+	return palette
+}
+
+// NormalizeColor upper-cases a hex color so palette comparisons are case-insensitive
+func NormalizeColor(color string) string {
+	return strings.ToUpper(strings.TrimSpace(color))
+}
+
+// ValidateColorPalette checks that a palette holds unique "#RRGGBB" colors, enough for maxPlayers
+func ValidateColorPalette(palette []string, maxPlayers int) error {
+	if len(palette) < maxPlayers {
+		return fmt.Errorf("color palette needs at least %d colors, got %d", maxPlayers, len(palette))
+	}
+	seen := make(map[string]bool, len(palette))
+	for _, color := range palette {
+		normalized := NormalizeColor(color)
+		if !hexColorPattern.MatchString(normalized) {
+			return fmt.Errorf("invalid color %q: expected #RRGGBB", color)
+		}
+		if seen[normalized] {
+			return fmt.Errorf("duplicate color in palette: %s", normalized)
+		}
+		seen[normalized] = true
+	}
+	return nil
+}
@@ -0,0 +1,52 @@
+package game
+
+import "errors"
+
+// ErrUnknownReaction is returned for reactions outside the fixed set
+var ErrUnknownReaction = errors.New("unknown reaction")
+
+// Reaction is a canned emote a player sends to the table instead of free chat
+type Reaction string
+
+const (
+	ReactionThumbsUp       Reaction = "thumbs-up"
+	ReactionNiceMove       Reaction = "nice-move"
+	ReactionWellPlayed     Reaction = "well-played"
+	ReactionWow            Reaction = "wow"
+	ReactionThinking       Reaction = "thinking"
+	ReactionOops           Reaction = "oops"
+	ReactionFrownyAsteroid Reaction = "frowny-asteroid"
+)
+
+// reactionLabels are the English renderings used in the game log
+var reactionLabels = map[Reaction]string{
+	ReactionThumbsUp:       "👍",
+	ReactionNiceMove:       "Nice move!",
+	ReactionWellPlayed:     "Well played",
+	ReactionWow:            "😮 Wow",
+	ReactionThinking:       "🤔",
+	ReactionOops:           "Oops",
+	ReactionFrownyAsteroid: "☄️😞",
+}
+
+// Reactions lists every reaction in the order clients show them
+func Reactions() []Reaction {
+	return []Reaction{
+		ReactionThumbsUp,
+		ReactionNiceMove,
+		ReactionWellPlayed,
+		ReactionWow,
+		ReactionThinking,
+		ReactionOops,
+		ReactionFrownyAsteroid,
+	}
+}
+
+// Label returns the reaction's log text, or ErrUnknownReaction
+func (r Reaction) Label() (string, error) {
+	label, ok := reactionLabels[r]
+	if !ok {
+		return "", ErrUnknownReaction
+	}
+	return label, nil
+}
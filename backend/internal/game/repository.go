@@ -2,11 +2,51 @@ package game
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"sync"
+
+	"terraforming-mars-backend/internal/logger"
+)
+
+var (
+	// ErrGameNotFound is returned (wrapped) when no game is stored under the requested ID
+	ErrGameNotFound = errors.New("game not found")
+	// ErrGameAlreadyExists is returned (wrapped) when creating a game whose ID is already stored
+	ErrGameAlreadyExists = errors.New("game already exists")
+	// ErrServerAtCapacity is returned (wrapped) when creating a game while the instance holds its maximum of running games
+	ErrServerAtCapacity = errors.New("server is at capacity")
 )
 
-// GameRepository manages the collection of active games
+// GameCapacity is how many running games an instance holds against its cap
+// Running games are those in the lobby or in play; finished games stay until archived but do not count
+type GameCapacity struct {
+	Active int
+	Max    int // 0 means no cap
+}
+
+// Utilization returns Active as a fraction of Max, or 0 without a cap
+func (c GameCapacity) Utilization() float64 {
+	if c.Max <= 0 {
+		return 0
+	}
+	return float64(c.Active) / float64(c.Max)
+}
+
+// GameRepository is the single storage interface for the Game aggregate
+// Players, board, deck and global parameters are owned by their Game and are always
+// read and written through it; there is no separate player store.
+//
+// Contract every implementation must honour:
+//   - Get returns the live aggregate, not a copy; mutations go through Game methods,
+//     which publish their own events. Missing IDs return an error wrapping ErrGameNotFound.
+//   - Create rejects nil games and duplicate IDs (wrapping ErrGameAlreadyExists).
+//     An implementation with a cap on running games rejects games past it (wrapping ErrServerAtCapacity).
+//   - Delete removes the game; missing IDs return an error wrapping ErrGameNotFound.
+//   - List returns every stored game, filtered by status when status is non-nil, in no particular order.
+//   - Exists never errors; it reports false for unknown IDs.
+//   - Every method except Exists returns ctx.Err() when the context is already done.
+//   - All methods are safe for concurrent use.
 type GameRepository interface {
 	Get(ctx context.Context, gameID string) (*Game, error)
 	Create(ctx context.Context, game *Game) error
@@ -15,10 +55,14 @@ type GameRepository interface {
 	Exists(ctx context.Context, gameID string) bool
 }
 
+var _ GameRepository = (*InMemoryGameRepository)(nil)
+
 // InMemoryGameRepository implements GameRepository using in-memory storage
 type InMemoryGameRepository struct {
-	mu    sync.RWMutex
-	games map[string]*Game
+	mu             sync.RWMutex
+	games          map[string]*Game
+	maxActiveGames int
+	onDelete       []func(gameID string)
 }
 
 // NewInMemoryGameRepository creates a new in-memory game repository
@@ -39,7 +83,7 @@ func (r *InMemoryGameRepository) Get(ctx context.Context, gameID string) (*Game,
 
 	game, exists := r.games[gameID]
 	if !exists {
-		return nil, fmt.Errorf("game %s not found", gameID)
+		return nil, fmt.Errorf("game %s: %w", gameID, ErrGameNotFound)
 	}
 
 	return game, nil
@@ -59,13 +103,51 @@ func (r *InMemoryGameRepository) Create(ctx context.Context, game *Game) error {
 	defer r.mu.Unlock()
 
 	if _, exists := r.games[game.ID()]; exists {
-		return fmt.Errorf("game %s already exists", game.ID())
+		return fmt.Errorf("game %s: %w", game.ID(), ErrGameAlreadyExists)
+	}
+	if r.maxActiveGames > 0 {
+		if active := r.countActive(); active >= r.maxActiveGames {
+			return fmt.Errorf("%d of %d games running: %w", active, r.maxActiveGames, ErrServerAtCapacity)
+		}
 	}
 
 	r.games[game.ID()] = game
 	return nil
 }
 
+// SetMaxActiveGames caps how many running games the repository holds; 0 removes the cap
+func (r *InMemoryGameRepository) SetMaxActiveGames(max int) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.maxActiveGames = max
+}
+
+// OnDelete registers a callback run after a game is deleted, so per-game state kept elsewhere can be released
+// Call it during setup; callbacks run on the deleting goroutine without the repository lock held
+func (r *InMemoryGameRepository) OnDelete(fn func(gameID string)) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.onDelete = append(r.onDelete, fn)
+}
+
+// Capacity reports the running games against the cap
+func (r *InMemoryGameRepository) Capacity() GameCapacity {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return GameCapacity{Active: r.countActive(), Max: r.maxActiveGames}
+}
+
+// countActive counts games in the lobby or in play; the caller holds the lock
+func (r *InMemoryGameRepository) countActive() int {
+	active := 0
+	for _, game := range r.games {
+		if status := game.Status(); status == GameStatusLobby || status == GameStatusActive {
+			active++
+		}
+	}
+	return active
+}
+
 // Delete removes a game from the repository
 func (r *InMemoryGameRepository) Delete(ctx context.Context, gameID string) error {
 	if err := ctx.Err(); err != nil {
@@ -73,13 +155,18 @@ func (r *InMemoryGameRepository) Delete(ctx context.Context, gameID string) erro
 	}
 
 	r.mu.Lock()
-	defer r.mu.Unlock()
-
 	if _, exists := r.games[gameID]; !exists {
-		return fmt.Errorf("game %s not found", gameID)
+		r.mu.Unlock()
+		return fmt.Errorf("game %s: %w", gameID, ErrGameNotFound)
 	}
-
 	delete(r.games, gameID)
+	onDelete := r.onDelete
+	r.mu.Unlock()
+
+	logger.GameLogs().Forget(gameID)
+	for _, fn := range onDelete {
+		fn(gameID)
+	}
 	return nil
 }
 
@@ -104,8 +191,12 @@ func (r *InMemoryGameRepository) List(ctx context.Context, status *GameStatus) (
 	return games, nil
 }
 
-// Exists checks if a game exists
+// Exists checks if a game exists; an ended ctx reports false like the other lookups fail
 func (r *InMemoryGameRepository) Exists(ctx context.Context, gameID string) bool {
+	if ctx.Err() != nil {
+		return false
+	}
+
 	r.mu.RLock()
 	defer r.mu.RUnlock()
 
@@ -0,0 +1,205 @@
+package game
+
+import "fmt"
+
+// Milestone/award set constants
+const (
+	MilestoneAwardSetTharsis = "tharsis" // Base game milestones and awards
+	MilestoneAwardSetRandom  = "random"  // Randomly drawn milestones and awards; not supported yet
+)
+
+// MaxResearchTimeoutSeconds caps the production phase card-buying timer
+const MaxResearchTimeoutSeconds = 3600
+
+// MaxStartingSelectionTimeoutSeconds caps the starting selection timer; a day leaves room for async games
+const MaxStartingSelectionTimeoutSeconds = 86400
+
+// MaxTurnTimerSeconds caps the action phase turn timer; a day leaves room for async games
+const MaxTurnTimerSeconds = 86400
+
+// MaxTimebankSeconds caps each player's timebank
+const MaxTimebankSeconds = 86400
+
+// Speed preset constants; a preset fills in every timer the creator left unset
+const (
+	SpeedPresetBlitz    = "blitz"
+	SpeedPresetStandard = "standard"
+	SpeedPresetRelaxed  = "relaxed"
+)
+
+// SpeedTimers are the timer settings a speed preset bundles, in seconds
+type SpeedTimers struct {
+	TurnTimer                int
+	Timebank                 int
+	ResearchTimeout          int
+	StartingSelectionTimeout int
+}
+
+var speedPresets = map[string]SpeedTimers{
+	SpeedPresetBlitz:    {TurnTimer: 60, Timebank: 300, ResearchTimeout: 60, StartingSelectionTimeout: 120},
+	SpeedPresetStandard: {TurnTimer: 180, Timebank: 900, ResearchTimeout: 180, StartingSelectionTimeout: 300},
+	SpeedPresetRelaxed:  {TurnTimer: 600, Timebank: 3600, ResearchTimeout: 600, StartingSelectionTimeout: 900},
+}
+
+// SpeedPresetTimers returns the timers of a speed preset; ok is false for unknown presets
+func SpeedPresetTimers(preset string) (timers SpeedTimers, ok bool) {
+	timers, ok = speedPresets[preset]
+	return timers, ok
+}
+
+// Solo goal constants; an empty goal plays solo games without a generation limit
+const (
+	SoloGoalTerraform = "terraform" // Complete terraforming by the end of generation 14
+	SoloGoalTR63      = "tr63"      // Reach a terraform rating of 63 by the end of generation 14
+)
+
+// Solo rules
+const (
+	SoloGenerations   = 14 // Generations a solo game with a goal lasts
+	SoloTRGoal        = 63 // Terraform rating needed under the TR-63 goal
+	SoloNeutralCities = 2  // Neutral cities placed at setup, each with an adjacent greenery
+)
+
+// MaxScoringVP caps what the scoring house rules can make a milestone or an award place worth
+const MaxScoringVP = 10
+
+// FastModeCreditProduction is the extra MC production each player starts with in fast mode
+const FastModeCreditProduction = 3
+
+// RulesOptions bundles the official rule variants enabled for a game
+type RulesOptions struct {
+	DraftVariant      bool   // Default: false - draft project cards each generation; not supported yet
+	FastMode          bool   // Default: false - players start with +3 MC production
+	ShowTimers        bool   // Default: false - display variant: clients show per-player turn timers from the broadcast turn timestamps
+	MilestoneAwardSet string // Default: "tharsis"
+	SoloTRDecay       bool   // Default: false - solo TR decay rule; not supported yet
+	SoloGoal          string // Default: "" - no goal or generation limit; see SoloGoal* constants
+	SoloNeutralTiles  bool   // Default: false - place neutral cities and greeneries at solo setup
+
+	MulliganStartingHand bool // Default: false - house rule: each player may redraw their starting project cards once
+
+	SpeedPreset                     string // Default: "" - no preset; see SpeedPreset* constants
+	TurnTimerSeconds                int    // Default: 0 - no limit; an action phase turn may run this long before the player's timebank is spent
+	TimebankSeconds                 int    // Default: 0 - no timebank; extra time per player for the whole game; once it is spent, the turn is passed for them
+	ResearchTimeoutSeconds          int    // Default: 0 - no limit; players who have not bought cards by then buy none
+	StartingSelectionTimeoutSeconds int    // Default: 0 - no limit; players still choosing by then get the first corporation and no cards
+
+	LiveScores              bool // Default: false - broadcast an estimated score per player; otherwise only TR is shown
+	LiveScoresExcludeEvents bool // Default: false - leave face-down event cards out of the live estimate
+
+	// Scoring house rules are pointers so 0 VP can be chosen; nil means the default
+	MilestoneVP        *int // Default: 5 - house rule: VP per claimed milestone
+	AwardFirstPlaceVP  *int // Default: 5 - house rule: VP for first place in a funded award
+	AwardSecondPlaceVP *int // Default: 2, or first place's VP if lower - house rule: VP for second place, at most first place's
+}
+
+// ScoringVP is what milestones and award places are worth in a game
+type ScoringVP struct {
+	Milestone        int
+	AwardFirstPlace  int
+	AwardSecondPlace int
+}
+
+// DefaultRulesOptions returns the rules options used when none are provided
+func DefaultRulesOptions() RulesOptions {
+	return RulesOptions{
+		MilestoneAwardSet:  MilestoneAwardSetTharsis,
+		MilestoneVP:        intPtr(MilestoneVP),
+		AwardFirstPlaceVP:  intPtr(AwardFirstVP),
+		AwardSecondPlaceVP: intPtr(AwardSecondVP),
+	}
+}
+
+// WithDefaults returns a copy with unset fields filled in from the defaults
+func (o RulesOptions) WithDefaults() RulesOptions {
+	if timers, ok := SpeedPresetTimers(o.SpeedPreset); ok {
+		if o.TurnTimerSeconds == 0 {
+			o.TurnTimerSeconds = timers.TurnTimer
+		}
+		if o.TimebankSeconds == 0 {
+			o.TimebankSeconds = timers.Timebank
+		}
+		if o.ResearchTimeoutSeconds == 0 {
+			o.ResearchTimeoutSeconds = timers.ResearchTimeout
+		}
+		if o.StartingSelectionTimeoutSeconds == 0 {
+			o.StartingSelectionTimeoutSeconds = timers.StartingSelectionTimeout
+		}
+	}
+	if o.MilestoneAwardSet == "" {
+		o.MilestoneAwardSet = MilestoneAwardSetTharsis
+	}
+	if o.MilestoneVP == nil {
+		o.MilestoneVP = intPtr(MilestoneVP)
+	}
+	if o.AwardFirstPlaceVP == nil {
+		o.AwardFirstPlaceVP = intPtr(AwardFirstVP)
+	}
+	if o.AwardSecondPlaceVP == nil {
+		o.AwardSecondPlaceVP = intPtr(min(AwardSecondVP, *o.AwardFirstPlaceVP))
+	}
+	return o
+}
+
+// ScoringVP returns what the options make milestones and award places worth, defaults filled in
+func (o RulesOptions) ScoringVP() ScoringVP {
+	o = o.WithDefaults()
+	return ScoringVP{
+		Milestone:        *o.MilestoneVP,
+		AwardFirstPlace:  *o.AwardFirstPlaceVP,
+		AwardSecondPlace: *o.AwardSecondPlaceVP,
+	}
+}
+
+func intPtr(v int) *int {
+	return &v
+}
+
+// Validate checks that the rules options reference known variants the engine enforces
+// Variants that are part of the options but not implemented yet are rejected, so no game is created with a rule that does nothing
+func (o RulesOptions) Validate() error {
+	if o.DraftVariant {
+		return fmt.Errorf("draft variant is not supported yet")
+	}
+	if o.SoloTRDecay {
+		return fmt.Errorf("solo TR decay is not supported yet")
+	}
+	if _, ok := SpeedPresetTimers(o.SpeedPreset); o.SpeedPreset != "" && !ok {
+		return fmt.Errorf("unknown speed preset: %s", o.SpeedPreset)
+	}
+	if o.TurnTimerSeconds < 0 || o.TurnTimerSeconds > MaxTurnTimerSeconds {
+		return fmt.Errorf("turn timer must be between 0 and %d seconds", MaxTurnTimerSeconds)
+	}
+	if o.TimebankSeconds < 0 || o.TimebankSeconds > MaxTimebankSeconds {
+		return fmt.Errorf("timebank must be between 0 and %d seconds", MaxTimebankSeconds)
+	}
+	if o.ResearchTimeoutSeconds < 0 || o.ResearchTimeoutSeconds > MaxResearchTimeoutSeconds {
+		return fmt.Errorf("research timeout must be between 0 and %d seconds", MaxResearchTimeoutSeconds)
+	}
+	if o.StartingSelectionTimeoutSeconds < 0 || o.StartingSelectionTimeoutSeconds > MaxStartingSelectionTimeoutSeconds {
+		return fmt.Errorf("starting selection timeout must be between 0 and %d seconds", MaxStartingSelectionTimeoutSeconds)
+	}
+	scoring := o.ScoringVP()
+	if scoring.Milestone < 0 || scoring.Milestone > MaxScoringVP {
+		return fmt.Errorf("milestone VP must be between 0 and %d", MaxScoringVP)
+	}
+	if scoring.AwardFirstPlace < 0 || scoring.AwardFirstPlace > MaxScoringVP {
+		return fmt.Errorf("award first place VP must be between 0 and %d", MaxScoringVP)
+	}
+	if scoring.AwardSecondPlace < 0 || scoring.AwardSecondPlace > scoring.AwardFirstPlace {
+		return fmt.Errorf("award second place VP must be between 0 and first place's VP")
+	}
+	switch o.SoloGoal {
+	case "", SoloGoalTerraform, SoloGoalTR63:
+	default:
+		return fmt.Errorf("unknown solo goal: %s", o.SoloGoal)
+	}
+	switch o.MilestoneAwardSet {
+	case "", MilestoneAwardSetTharsis:
+		return nil
+	case MilestoneAwardSetRandom:
+		return fmt.Errorf("random milestones and awards are not supported yet")
+	default:
+		return fmt.Errorf("unknown milestone/award set: %s", o.MilestoneAwardSet)
+	}
+}
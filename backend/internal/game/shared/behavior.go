@@ -8,6 +8,7 @@ type CardBehavior struct {
 	Outputs                       []ResourceCondition            `json:"outputs,omitempty"`
 	Choices                       []Choice                       `json:"choices,omitempty"`
 	GenerationalEventRequirements []GenerationalEventRequirement `json:"generationalEventRequirements,omitempty" ts:"GenerationalEventRequirement[] | undefined"`
+	UsageLimit                    *UsageLimit                    `json:"usageLimit,omitempty" ts:"UsageLimit | undefined"`
 }
 
 // DeepCopy creates a deep copy of the CardBehavior
@@ -67,12 +68,37 @@ func (cb CardBehavior) DeepCopy() CardBehavior {
 		}
 	}
 
+	if cb.UsageLimit != nil {
+		limit := *cb.UsageLimit
+		result.UsageLimit = &limit
+	}
+
 	return result
 }
 
+// TargetChooses reports whether the behavior's choices belong to its target: every choice is a set of
+// TargetAnyPlayerChoice outputs, so the player applying it picks no choiceIndex
+func (cb CardBehavior) TargetChooses() bool {
+	if len(cb.Choices) == 0 {
+		return false
+	}
+	for _, choice := range cb.Choices {
+		if len(choice.Inputs) > 0 || len(choice.Outputs) == 0 {
+			return false
+		}
+		for _, output := range choice.Outputs {
+			if output.Target != TargetAnyPlayerChoice {
+				return false
+			}
+		}
+	}
+	return true
+}
+
 // ExtractInputsOutputs extracts the combined inputs and outputs for a behavior,
 // optionally incorporating a selected choice. Returns base + choice inputs/outputs.
 // If choiceIndex is nil or out of range, only base inputs/outputs are returned.
+// When the target chooses (TargetChooses), every choice's outputs are returned for the target to pick from.
 func (cb CardBehavior) ExtractInputsOutputs(choiceIndex *int) (inputs []ResourceCondition, outputs []ResourceCondition) {
 	if len(cb.Inputs) > 0 {
 		inputs = make([]ResourceCondition, len(cb.Inputs))
@@ -83,6 +109,13 @@ func (cb CardBehavior) ExtractInputsOutputs(choiceIndex *int) (inputs []Resource
 		copy(outputs, cb.Outputs)
 	}
 
+	if cb.TargetChooses() {
+		for _, choice := range cb.Choices {
+			outputs = append(outputs, choice.Outputs...)
+		}
+		return inputs, outputs
+	}
+
 	if choiceIndex != nil && *choiceIndex >= 0 && *choiceIndex < len(cb.Choices) {
 		selectedChoice := cb.Choices[*choiceIndex]
 
@@ -41,11 +41,28 @@ type ResourceTriggerCondition struct {
 
 // TileRestrictions represents restrictions for tile placement
 type TileRestrictions struct {
-	BoardTags  []string `json:"boardTags,omitempty" ts:"string[]"`
-	Adjacency  string   `json:"adjacency,omitempty" ts:"string"`  // "none" = no adjacent occupied tiles
-	OnTileType string   `json:"onTileType,omitempty" ts:"string"` // "ocean" = only on ocean spaces
+	BoardTags    []string `json:"boardTags,omitempty" ts:"string[]"`
+	Adjacency    string   `json:"adjacency,omitempty" ts:"string"`      // "none" = no adjacent occupied tiles, "own" = next to one of your tiles
+	OnTileType   string   `json:"onTileType,omitempty" ts:"string"`     // OnTileTypeOcean or OnTileTypeLand: place on the other kind of space
+	CaptureBonus []string `json:"captureBonus,omitempty" ts:"string[]"` // Only areas with one of these placement bonuses; that production rises 1 step (Mining Area)
 }
 
+// Adjacency values for tile restrictions
+const (
+	AdjacencyNone = "none" // No occupied neighbours (Research Outpost, Natural Preserve)
+	AdjacencyOwn  = "own"  // At least one neighbour owned by the placing player (Mining Area)
+)
+
+// OnTileType values: a tile placed on the kind of space the ocean rule normally forbids for it
+const (
+	OnTileTypeOcean = "ocean" // A non-ocean tile on an area reserved for ocean (Mangrove)
+	OnTileTypeLand  = "land"  // An ocean tile on an area not reserved for ocean (Artificial Lake)
+)
+
+// TargetAnyPlayerChoice marks choice outputs picked by the player the behavior targets instead of by the
+// player applying it, such as an attack whose victim chooses what to lose. The first choice is the default
+const TargetAnyPlayerChoice = "any-player-choice"
+
 // ResourceCondition represents a resource amount (input or output)
 type ResourceCondition struct {
 	ResourceType     ResourceType      `json:"type"`
@@ -55,6 +72,7 @@ type ResourceCondition struct {
 	MaxTrigger       *int              `json:"maxTrigger,omitempty"`
 	Per              *PerCondition     `json:"per,omitempty"`
 	TileRestrictions *TileRestrictions `json:"tileRestrictions,omitempty" ts:"TileRestrictions | undefined"`
+	SpecialTileType  string            `json:"specialTileType,omitempty" ts:"string | undefined"` // Marker placed by a special-placement output, e.g. "nuclear-zone"
 }
 
 // PerCondition represents what to count for conditional resource gains
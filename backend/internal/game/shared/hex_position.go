@@ -1,6 +1,10 @@
 package shared
 
-import "fmt"
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
 
 // HexPosition represents a position on the Mars board using cube coordinates
 type HexPosition struct {
@@ -14,6 +18,35 @@ func (h HexPosition) String() string {
 	return fmt.Sprintf("%d,%d,%d", h.Q, h.R, h.S)
 }
 
+// ParseHexPosition parses the "q,r,s" form written by String
+func ParseHexPosition(hexStr string) (HexPosition, error) {
+	parts := strings.Split(hexStr, ",")
+	if len(parts) != 3 {
+		return HexPosition{}, fmt.Errorf("expected 3 coordinates, got %d", len(parts))
+	}
+
+	q, err := strconv.Atoi(strings.TrimSpace(parts[0]))
+	if err != nil {
+		return HexPosition{}, fmt.Errorf("invalid q coordinate: %w", err)
+	}
+
+	r, err := strconv.Atoi(strings.TrimSpace(parts[1]))
+	if err != nil {
+		return HexPosition{}, fmt.Errorf("invalid r coordinate: %w", err)
+	}
+
+	s, err := strconv.Atoi(strings.TrimSpace(parts[2]))
+	if err != nil {
+		return HexPosition{}, fmt.Errorf("invalid s coordinate: %w", err)
+	}
+
+	if q+r+s != 0 {
+		return HexPosition{}, fmt.Errorf("invalid cube coordinates: q+r+s must equal 0")
+	}
+
+	return HexPosition{Q: q, R: r, S: s}, nil
+}
+
 // GetNeighbors returns all 6 adjacent hex positions using cube coordinate system
 func (h HexPosition) GetNeighbors() []HexPosition {
 	directions := []HexPosition{
@@ -7,6 +7,25 @@ const (
 	MinOtherProduction = 0
 )
 
+// IsProduction reports whether a resource type is one of the six production tracks
+func IsProduction(resourceType ResourceType) bool {
+	switch resourceType {
+	case ResourceCreditProduction, ResourceSteelProduction, ResourceTitaniumProduction,
+		ResourcePlantProduction, ResourceEnergyProduction, ResourceHeatProduction:
+		return true
+	default:
+		return false
+	}
+}
+
+// ProductionFloor returns the lowest value a production type may reach: -5 for MC, 0 for everything else
+func ProductionFloor(productionType ResourceType) int {
+	if productionType == ResourceCreditProduction {
+		return MinCreditProduction
+	}
+	return MinOtherProduction
+}
+
 // Production represents a player's production values
 type Production struct {
 	Credits  int
@@ -17,6 +36,26 @@ type Production struct {
 	Heat     int
 }
 
+// Get returns the value of a production type, or 0 for non-production types
+func (p Production) Get(productionType ResourceType) int {
+	switch productionType {
+	case ResourceCreditProduction:
+		return p.Credits
+	case ResourceSteelProduction:
+		return p.Steel
+	case ResourceTitaniumProduction:
+		return p.Titanium
+	case ResourcePlantProduction:
+		return p.Plants
+	case ResourceEnergyProduction:
+		return p.Energy
+	case ResourceHeatProduction:
+		return p.Heat
+	default:
+		return 0
+	}
+}
+
 // DeepCopy creates a deep copy of the Production struct
 func (p Production) DeepCopy() Production {
 	return Production{
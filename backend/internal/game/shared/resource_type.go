@@ -22,14 +22,19 @@ const (
 	ResourceCardPeek ResourceType = "card-peek"
 	ResourceCardBuy  ResourceType = "card-buy"
 
+	ResourceCardSearch  ResourceType = "card-search"  // Reveal from the deck until amount cards match the selectors; take those, discard the rest
+	ResourceCardDiscard ResourceType = "card-discard" // Input: discard cards of the player's choice from hand
+
 	ResourceCityPlacement     ResourceType = "city-placement"
 	ResourceOceanPlacement    ResourceType = "ocean-placement"
 	ResourceGreeneryPlacement ResourceType = "greenery-placement"
+	ResourceSpecialPlacement  ResourceType = "special-placement"
 
 	ResourceCityTile     ResourceType = "city-tile"
 	ResourceOceanTile    ResourceType = "ocean-tile"
 	ResourceGreeneryTile ResourceType = "greenery-tile"
 	ResourceColonyTile   ResourceType = "colony-tile"
+	ResourceSpecialTile  ResourceType = "special-tile"
 
 	ResourceLandTile   ResourceType = "land"
 	ResourceOceanSpace ResourceType = "ocean-space"
@@ -62,4 +67,13 @@ const (
 	ResourceOceanAdjacencyBonus     ResourceType = "ocean-adjacency-bonus"
 
 	ResourceLandClaim ResourceType = "land-claim"
+
+	// Turmoil is not implemented yet; cards carrying these resolve them as no-ops with a game log note
+	ResourceInfluence ResourceType = "influence"
+	ResourceDelegate  ResourceType = "delegate"
 )
+
+// IsTurmoilResource reports whether a resource only means something once Turmoil is implemented
+func IsTurmoilResource(rt ResourceType) bool {
+	return rt == ResourceInfluence || rt == ResourceDelegate
+}
@@ -16,6 +16,26 @@ func (r Resources) IsZero() bool {
 		r.Plants == 0 && r.Energy == 0 && r.Heat == 0
 }
 
+// Get returns the amount of a basic resource, or 0 for anything else
+func (r Resources) Get(resourceType ResourceType) int {
+	switch resourceType {
+	case ResourceCredit:
+		return r.Credits
+	case ResourceSteel:
+		return r.Steel
+	case ResourceTitanium:
+		return r.Titanium
+	case ResourcePlant:
+		return r.Plants
+	case ResourceEnergy:
+		return r.Energy
+	case ResourceHeat:
+		return r.Heat
+	default:
+		return 0
+	}
+}
+
 // DeepCopy creates a deep copy of the Resources struct
 func (r Resources) DeepCopy() Resources {
 	return Resources{
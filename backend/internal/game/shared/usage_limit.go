@@ -0,0 +1,46 @@
+package shared
+
+// UsagePeriod is the span a usage limit counts over
+type UsagePeriod string
+
+const (
+	UsagePeriodGeneration UsagePeriod = "generation"
+	UsagePeriodGame       UsagePeriod = "game"
+)
+
+// UsageLimit caps how often a behavior may be used within a period
+type UsageLimit struct {
+	Per   UsagePeriod `json:"per" ts:"UsagePeriod"`
+	Times int         `json:"times" ts:"number"`
+}
+
+// OncePerGeneration is the limit of every manual action without an explicit one
+var OncePerGeneration = UsageLimit{Per: UsagePeriodGeneration, Times: 1}
+
+// EffectiveUsageLimit returns the behavior's usage limit: its own, the once-per-generation default
+// for manual actions, or nil for behaviors that are not limited
+func (cb CardBehavior) EffectiveUsageLimit() *UsageLimit {
+	if cb.UsageLimit != nil {
+		limit := *cb.UsageLimit
+		return &limit
+	}
+	for _, trigger := range cb.Triggers {
+		if trigger.Type == TriggerTypeManual {
+			limit := OncePerGeneration
+			return &limit
+		}
+	}
+	return nil
+}
+
+// UsageLimitReached reports whether the behavior has been used up for its period
+func (cb CardBehavior) UsageLimitReached(timesUsedThisGeneration, timesUsedThisGame int) bool {
+	limit := cb.EffectiveUsageLimit()
+	if limit == nil {
+		return false
+	}
+	if limit.Per == UsagePeriodGame {
+		return timesUsedThisGame >= limit.Times
+	}
+	return timesUsedThisGeneration >= limit.Times
+}
@@ -1,6 +1,7 @@
 package game
 
 import (
+	"sort"
 	"time"
 
 	"terraforming-mars-backend/internal/game/shared"
@@ -27,6 +28,7 @@ type DiffValueBool struct {
 // TilePlacement records a single tile placement on the board
 type TilePlacement struct {
 	HexID    string
+	HexName  string // Label such as "E5", after the area name for named areas
 	TileType string
 	OwnerID  string
 }
@@ -51,6 +53,7 @@ type PlayerChanges struct {
 	PlantsProduction   *DiffValueInt
 	EnergyProduction   *DiffValueInt
 	HeatProduction     *DiffValueInt
+	HandSize           *DiffValueInt // Public even when the cards themselves are not
 	CardsAdded         []string
 	CardsRemoved       []string
 	CardsPlayed        []string
@@ -84,6 +87,8 @@ const (
 	SourceTypeInitial         SourceType = "initial"
 	SourceTypeAward           SourceType = "award"
 	SourceTypeMilestone       SourceType = "milestone"
+	SourceTypeReaction        SourceType = "reaction" // A player's emote; changes nothing
+	SourceTypeAdmin           SourceType = "admin"    // A development-mode admin command
 )
 
 // CalculatedOutput represents an actual output value that was applied
@@ -121,6 +126,8 @@ type StateDiff struct {
 	ChoiceIndex       *int               // For cards with choices, which choice was selected (0-indexed)
 	CalculatedOutputs []CalculatedOutput // Actual values applied (for scaled outputs like "per X tags")
 	DisplayData       *LogDisplayData    // Pre-computed display information for log entries
+	Summary           LogSummary         // Human-readable line shown in action history
+	EffectResolutions []EffectResolution // Card effects this entry triggered, in the order they resolved
 }
 
 // DiffLog contains the complete history of state changes for a game
@@ -181,6 +188,16 @@ func (dl *DiffLog) GetAll() []StateDiff {
 	return result
 }
 
+// Since returns the diffs with a sequence number above afterSequence, in chronological order
+func (dl *DiffLog) Since(afterSequence int64) []StateDiff {
+	start := sort.Search(len(dl.Diffs), func(i int) bool {
+		return dl.Diffs[i].SequenceNumber > afterSequence
+	})
+	result := make([]StateDiff, len(dl.Diffs)-start)
+	copy(result, dl.Diffs[start:])
+	return result
+}
+
 // diffInt compares two integers and returns a DiffValueInt if different
 func diffInt(old, new int) *DiffValueInt {
 	if old == new {
@@ -0,0 +1,116 @@
+package game
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+)
+
+// ErrSequenceNotFound is returned when a game log has no entry with the requested sequence number
+var ErrSequenceNotFound = errors.New("sequence not found")
+
+// ReplaySnapshot rebuilds the snapshot the state repository held right after entry sequence was written
+// Only what the log records is rebuilt: globals, turn, player resources, production, TR, cards and tiles.
+func ReplaySnapshot(diffs []StateDiff, sequence int64) (*GameSnapshot, error) {
+	if sequence < 1 || len(diffs) == 0 || diffs[len(diffs)-1].SequenceNumber < sequence {
+		return nil, fmt.Errorf("entry %d: %w", sequence, ErrSequenceNotFound)
+	}
+
+	snapshot := NewReplaySnapshot()
+	for _, diff := range diffs {
+		if diff.SequenceNumber > sequence {
+			break
+		}
+		snapshot.Apply(diff)
+	}
+	return snapshot, nil
+}
+
+// NewReplaySnapshot returns the empty snapshot a log replay starts from
+func NewReplaySnapshot() *GameSnapshot {
+	return &GameSnapshot{
+		Players: make(map[string]*PlayerSnapshot),
+		Tiles:   make(map[string]*TileSnapshot),
+	}
+}
+
+// Player returns a player's replayed state, starting it at zero if the log has not mentioned them yet
+func (s *GameSnapshot) Player(playerID string) *PlayerSnapshot {
+	ps, ok := s.Players[playerID]
+	if !ok {
+		ps = &PlayerSnapshot{HandCardIDs: []string{}, PlayedCardIDs: []string{}}
+		s.Players[playerID] = ps
+	}
+	return ps
+}
+
+// Apply replays one log entry onto the snapshot
+func (s *GameSnapshot) Apply(diff StateDiff) {
+	changes := diff.Changes
+	if changes == nil {
+		return
+	}
+	applyString(&s.Status, changes.Status)
+	applyString(&s.Phase, changes.Phase)
+	applyInt(&s.Generation, changes.Generation)
+	applyString(&s.CurrentTurn, changes.CurrentTurnPlayerID)
+	applyInt(&s.Temperature, changes.Temperature)
+	applyInt(&s.Oxygen, changes.Oxygen)
+	applyInt(&s.Oceans, changes.Oceans)
+
+	for playerID, pc := range changes.PlayerChanges {
+		if pc == nil {
+			continue
+		}
+		applyPlayerChanges(s.Player(playerID), pc)
+	}
+
+	if changes.BoardChanges != nil {
+		for _, placement := range changes.BoardChanges.TilesPlaced {
+			s.Tiles[placement.HexID] = &TileSnapshot{
+				HexID:    placement.HexID,
+				HexName:  placement.HexName,
+				TileType: placement.TileType,
+				OwnerID:  placement.OwnerID,
+			}
+		}
+	}
+}
+
+func applyPlayerChanges(ps *PlayerSnapshot, pc *PlayerChanges) {
+	applyInt(&ps.Credits, pc.Credits)
+	applyInt(&ps.Steel, pc.Steel)
+	applyInt(&ps.Titanium, pc.Titanium)
+	applyInt(&ps.Plants, pc.Plants)
+	applyInt(&ps.Energy, pc.Energy)
+	applyInt(&ps.Heat, pc.Heat)
+	applyInt(&ps.TerraformRating, pc.TerraformRating)
+	applyInt(&ps.CreditsProduction, pc.CreditsProduction)
+	applyInt(&ps.SteelProduction, pc.SteelProduction)
+	applyInt(&ps.TitaniumProduction, pc.TitaniumProduction)
+	applyInt(&ps.PlantsProduction, pc.PlantsProduction)
+	applyInt(&ps.EnergyProduction, pc.EnergyProduction)
+	applyInt(&ps.HeatProduction, pc.HeatProduction)
+	applyString(&ps.Corporation, pc.Corporation)
+	if pc.Passed != nil {
+		ps.Passed = pc.Passed.New
+	}
+
+	ps.HandCardIDs = slices.DeleteFunc(ps.HandCardIDs, func(cardID string) bool {
+		return slices.Contains(pc.CardsRemoved, cardID)
+	})
+	ps.HandCardIDs = append(ps.HandCardIDs, pc.CardsAdded...)
+	ps.PlayedCardIDs = append(ps.PlayedCardIDs, pc.CardsPlayed...)
+}
+
+func applyInt(field *int, value *DiffValueInt) {
+	if value != nil {
+		*field = value.New
+	}
+}
+
+func applyString(field *string, value *DiffValueString) {
+	if value != nil {
+		*field = value.New
+	}
+}
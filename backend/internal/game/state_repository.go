@@ -2,20 +2,50 @@ package game
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"sync"
+
+	"terraforming-mars-backend/internal/events"
+	"terraforming-mars-backend/internal/game/board"
 )
 
-// GameStateRepository manages game state with diff tracking
+// GameStateRepository is the single storage interface for a game's state history (the diff log)
+// It never stores or returns the Game itself; use GameRepository for that.
+//
+// Contract every implementation must honour:
+//   - WriteFull snapshots the game, appends the diff against the previous snapshot and returns it;
+//     sequence numbers start at 1 and increase by one per write. A nil game is rejected.
+//   - WriteFull publishes LogEntryWrittenEvent on the game's event bus once the entry is stored.
+//   - GetDiff returns all diffs in write order; unknown IDs return an error wrapping ErrGameNotFound.
+//     GetDiffSince returns only those after a sequence number, with the same errors.
+//   - RecordInput snapshots the game and appends an input to its journal, numbered from 1 like the log;
+//     GetInputs returns the journal in handling order, and nothing for a game no input was recorded for.
+//   - Delete drops a game's snapshot, diff log and input journal; unknown IDs return an error wrapping ErrGameNotFound.
+//   - Every method returns ctx.Err() when the context is already done and is safe for concurrent use.
 type GameStateRepository interface {
-	Write(ctx context.Context, gameID string, game *Game, source string, sourceType SourceType, playerID, description string) (*StateDiff, error)
-	WriteWithChoice(ctx context.Context, gameID string, game *Game, source string, sourceType SourceType, playerID, description string, choiceIndex *int) (*StateDiff, error)
-	WriteWithChoiceAndOutputs(ctx context.Context, gameID string, game *Game, source string, sourceType SourceType, playerID, description string, choiceIndex *int, calculatedOutputs []CalculatedOutput) (*StateDiff, error)
 	WriteFull(ctx context.Context, gameID string, game *Game, source string, sourceType SourceType, playerID, description string, choiceIndex *int, calculatedOutputs []CalculatedOutput, displayData *LogDisplayData) (*StateDiff, error)
-	Get(ctx context.Context, gameID string) (*Game, error)
 	GetDiff(ctx context.Context, gameID string) ([]StateDiff, error)
+	GetDiffSince(ctx context.Context, gameID string, afterSequence int64) ([]StateDiff, error)
+	RecordInput(ctx context.Context, gameID string, game *Game, playerID, inputType string, payload json.RawMessage) (*ActionInput, error)
+	GetInputs(ctx context.Context, gameID string) ([]ActionInput, error)
+	Delete(ctx context.Context, gameID string) error
+	Checkpoint(gameID string) LogCheckpoint
+	Rewind(cp LogCheckpoint)
+}
+
+// LogCheckpoint marks a point in a game's log that Rewind can return to
+// Taken next to a game Transaction, it lets a rolled-back unit of work drop the entries it wrote
+type LogCheckpoint struct {
+	gameID   string
+	exists   bool
+	length   int
+	sequence int64
+	snapshot *GameSnapshot
 }
 
+var _ GameStateRepository = (*InMemoryGameStateRepository)(nil)
+
 // GameSnapshot represents a serializable snapshot of game state for diffing
 type GameSnapshot struct {
 	Status      string
@@ -53,6 +83,7 @@ type PlayerSnapshot struct {
 // TileSnapshot represents a serializable snapshot of a tile
 type TileSnapshot struct {
 	HexID    string
+	HexName  string
 	TileType string
 	OwnerID  string
 }
@@ -62,6 +93,7 @@ type InMemoryGameStateRepository struct {
 	mu        sync.RWMutex
 	snapshots map[string]*GameSnapshot
 	diffLogs  map[string]*DiffLog
+	inputs    map[string][]ActionInput
 }
 
 // NewInMemoryGameStateRepository creates a new in-memory game state repository
@@ -69,25 +101,12 @@ func NewInMemoryGameStateRepository() *InMemoryGameStateRepository {
 	return &InMemoryGameStateRepository{
 		snapshots: make(map[string]*GameSnapshot),
 		diffLogs:  make(map[string]*DiffLog),
+		inputs:    make(map[string][]ActionInput),
 	}
 }
 
-// Write stores the current game state and computes a diff from the previous state
-func (r *InMemoryGameStateRepository) Write(ctx context.Context, gameID string, game *Game, source string, sourceType SourceType, playerID, description string) (*StateDiff, error) {
-	return r.WriteWithChoice(ctx, gameID, game, source, sourceType, playerID, description, nil)
-}
-
-// WriteWithChoice stores the current game state with an optional choice index
-func (r *InMemoryGameStateRepository) WriteWithChoice(ctx context.Context, gameID string, game *Game, source string, sourceType SourceType, playerID, description string, choiceIndex *int) (*StateDiff, error) {
-	return r.WriteWithChoiceAndOutputs(ctx, gameID, game, source, sourceType, playerID, description, choiceIndex, nil)
-}
-
-// WriteWithChoiceAndOutputs stores the current game state with optional choice index and calculated outputs
-func (r *InMemoryGameStateRepository) WriteWithChoiceAndOutputs(ctx context.Context, gameID string, game *Game, source string, sourceType SourceType, playerID, description string, choiceIndex *int, calculatedOutputs []CalculatedOutput) (*StateDiff, error) {
-	return r.WriteFull(ctx, gameID, game, source, sourceType, playerID, description, choiceIndex, calculatedOutputs, nil)
-}
-
-// WriteFull stores the current game state with all optional fields including display data
+// WriteFull stores the current game state and appends the diff from the previous state; the optional
+// choice index, calculated outputs and display data are nil when the entry has none
 func (r *InMemoryGameStateRepository) WriteFull(ctx context.Context, gameID string, game *Game, source string, sourceType SourceType, playerID, description string, choiceIndex *int, calculatedOutputs []CalculatedOutput, displayData *LogDisplayData) (*StateDiff, error) {
 	if err := ctx.Err(); err != nil {
 		return nil, err
@@ -98,10 +117,16 @@ func (r *InMemoryGameStateRepository) WriteFull(ctx context.Context, gameID stri
 	}
 
 	newSnapshot := captureGameSnapshot(game)
+	resolutions := game.TakeEffectResolutions()
+	playerNames := make(map[string]string)
+	for _, p := range game.GetAllPlayers() {
+		playerNames[p.ID()] = p.Name()
+	}
+	for _, conceded := range game.ConcededPlayers() {
+		playerNames[conceded.PlayerID] = conceded.PlayerName
+	}
 
 	r.mu.Lock()
-	defer r.mu.Unlock()
-
 	oldSnapshot := r.snapshots[gameID]
 	changes := computeSnapshotChanges(oldSnapshot, newSnapshot)
 
@@ -109,12 +134,20 @@ func (r *InMemoryGameStateRepository) WriteFull(ctx context.Context, gameID stri
 		r.diffLogs[gameID] = NewDiffLog(gameID)
 	}
 
-	seqNum := r.diffLogs[gameID].AppendFull(changes, source, sourceType, playerID, description, choiceIndex, calculatedOutputs, displayData)
+	diffLog := r.diffLogs[gameID]
+	seqNum := diffLog.AppendFull(changes, source, sourceType, playerID, description, choiceIndex, calculatedOutputs, displayData)
+	// The first entry of a game has no earlier snapshot, so its changes are the whole state rather than gains
+	summaryChanges := changes
+	if oldSnapshot == nil {
+		summaryChanges = nil
+	}
+	summary := SummarizeLogEntry(sourceType, source, playerID, description, summaryChanges, playerNames)
+	diffLog.Diffs[len(diffLog.Diffs)-1].Summary = summary
+	diffLog.Diffs[len(diffLog.Diffs)-1].EffectResolutions = resolutions
 	r.snapshots[gameID] = newSnapshot
-
-	return &StateDiff{
+	written := &StateDiff{
 		SequenceNumber:    seqNum,
-		Timestamp:         r.diffLogs[gameID].Diffs[len(r.diffLogs[gameID].Diffs)-1].Timestamp,
+		Timestamp:         diffLog.Diffs[len(diffLog.Diffs)-1].Timestamp,
 		GameID:            gameID,
 		Changes:           changes,
 		Source:            source,
@@ -124,13 +157,21 @@ func (r *InMemoryGameStateRepository) WriteFull(ctx context.Context, gameID stri
 		ChoiceIndex:       choiceIndex,
 		CalculatedOutputs: calculatedOutputs,
 		DisplayData:       displayData,
-	}, nil
-}
+		Summary:           summary,
+		EffectResolutions: resolutions,
+	}
+	r.mu.Unlock()
 
-// Get retrieves the current game from the main GameRepository
-// Note: This repository only tracks state history; use GameRepository for current game access
-func (r *InMemoryGameStateRepository) Get(ctx context.Context, gameID string) (*Game, error) {
-	return nil, fmt.Errorf("GameStateRepository.Get not implemented - use GameRepository.Get instead")
+	// Published without the lock so subscribers may read the log
+	if bus := game.EventBus(); bus != nil {
+		events.Publish(bus, events.LogEntryWrittenEvent{
+			GameID:         gameID,
+			SequenceNumber: seqNum,
+			Timestamp:      written.Timestamp,
+		})
+	}
+
+	return written, nil
 }
 
 // GetDiff retrieves all diffs for the specified game in chronological order
@@ -144,12 +185,82 @@ func (r *InMemoryGameStateRepository) GetDiff(ctx context.Context, gameID string
 
 	diffLog, exists := r.diffLogs[gameID]
 	if !exists {
-		return nil, fmt.Errorf("game %s not found", gameID)
+		return nil, fmt.Errorf("game %s: %w", gameID, ErrGameNotFound)
 	}
 
 	return diffLog.GetAll(), nil
 }
 
+// GetDiffSince retrieves the diffs written after afterSequence, in chronological order
+func (r *InMemoryGameStateRepository) GetDiffSince(ctx context.Context, gameID string, afterSequence int64) ([]StateDiff, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	diffLog, exists := r.diffLogs[gameID]
+	if !exists {
+		return nil, fmt.Errorf("game %s: %w", gameID, ErrGameNotFound)
+	}
+
+	return diffLog.Since(afterSequence), nil
+}
+
+// Delete drops the game's snapshot, diff log and input journal
+func (r *InMemoryGameStateRepository) Delete(ctx context.Context, gameID string) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	_, hasLog := r.diffLogs[gameID]
+	_, hasInputs := r.inputs[gameID]
+	if !hasLog && !hasInputs {
+		return fmt.Errorf("game %s: %w", gameID, ErrGameNotFound)
+	}
+	delete(r.diffLogs, gameID)
+	delete(r.snapshots, gameID)
+	delete(r.inputs, gameID)
+	return nil
+}
+
+// Checkpoint marks the end of the game's log
+func (r *InMemoryGameStateRepository) Checkpoint(gameID string) LogCheckpoint {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	cp := LogCheckpoint{gameID: gameID, snapshot: r.snapshots[gameID]}
+	if diffLog, exists := r.diffLogs[gameID]; exists {
+		cp.exists = true
+		cp.length = len(diffLog.Diffs)
+		cp.sequence = diffLog.CurrentSequence
+	}
+	return cp
+}
+
+// Rewind drops every entry written after cp and restores the snapshot the next entry is diffed against
+func (r *InMemoryGameStateRepository) Rewind(cp LogCheckpoint) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if !cp.exists {
+		delete(r.diffLogs, cp.gameID)
+		delete(r.snapshots, cp.gameID)
+		return
+	}
+	diffLog, exists := r.diffLogs[cp.gameID]
+	if !exists || len(diffLog.Diffs) < cp.length {
+		return
+	}
+	diffLog.Diffs = diffLog.Diffs[:cp.length]
+	diffLog.CurrentSequence = cp.sequence
+	r.snapshots[cp.gameID] = cp.snapshot
+}
+
 // captureGameSnapshot creates a snapshot of the current game state
 func captureGameSnapshot(game *Game) *GameSnapshot {
 	snapshot := &GameSnapshot{
@@ -207,6 +318,7 @@ func captureGameSnapshot(game *Game) *GameSnapshot {
 			hexID := tile.Coordinates.String()
 			tileSnapshot := &TileSnapshot{
 				HexID:    hexID,
+				HexName:  board.HexName(tile.Coordinates, tile.DisplayName),
 				TileType: string(tile.OccupiedBy.Type),
 			}
 			if tile.OwnerID != nil {
@@ -387,6 +499,11 @@ func computePlayerSnapshotChange(old, new *PlayerSnapshot) *PlayerChanges {
 		hasChanges = true
 	}
 
+	if d := diffInt(len(oldHand), len(new.HandCardIDs)); d != nil {
+		pc.HandSize = d
+		hasChanges = true
+	}
+
 	added, removed := diffStringSlice(oldHand, new.HandCardIDs)
 	if len(added) > 0 {
 		pc.CardsAdded = added
@@ -419,6 +536,7 @@ func computeInitialSnapshotBoardChanges(snapshot *GameSnapshot) *BoardChanges {
 	for _, tile := range snapshot.Tiles {
 		placements = append(placements, TilePlacement{
 			HexID:    tile.HexID,
+			HexName:  tile.HexName,
 			TileType: tile.TileType,
 			OwnerID:  tile.OwnerID,
 		})
@@ -435,6 +553,7 @@ func computeSnapshotBoardChanges(old, new *GameSnapshot) *BoardChanges {
 		if _, exists := old.Tiles[hexID]; !exists {
 			placements = append(placements, TilePlacement{
 				HexID:    newTile.HexID,
+				HexName:  newTile.HexName,
 				TileType: newTile.TileType,
 				OwnerID:  newTile.OwnerID,
 			})
@@ -0,0 +1,254 @@
+package game
+
+import (
+	"maps"
+	"time"
+
+	"terraforming-mars-backend/internal/events"
+	"terraforming-mars-backend/internal/game/board"
+	"terraforming-mars-backend/internal/game/deck"
+	"terraforming-mars-backend/internal/game/global_parameters"
+	"terraforming-mars-backend/internal/game/player"
+)
+
+// Transaction is a unit of work over a single game
+// Actions change the game through many small writes (pay, move card, apply outputs, consume action);
+// a transaction captures everything those writes can touch so a failure part-way through
+// can put the game back exactly as it was. Players joining or leaving are not covered.
+type Transaction struct {
+	game       *Game
+	checkpoint gameCheckpoint
+	finished   bool
+}
+
+type gameCheckpoint struct {
+	status       GameStatus
+	currentPhase GamePhase
+	phaseStarted time.Time
+	turnStarted  time.Time
+	timebankUsed map[string]time.Duration
+	generation   int
+	turnOrder    []string
+	hasTurn      bool
+	turnPlayerID string
+	turnActions  int
+
+	finalScores       []FinalScore
+	winnerID          string
+	isTie             bool
+	triggeredEffects  []TriggeredEffect
+	effectResolutions []EffectResolution
+
+	pendingTileSelections      map[string]player.PendingTileSelection
+	pendingTileSelectionQueues map[string]player.PendingTileSelectionQueue
+	forcedFirstActions         map[string]player.ForcedFirstAction
+	productionPhases           map[string]player.ProductionPhase
+	selectStartingCardsPhases  map[string]player.SelectStartingCardsPhase
+	pendingCardPlays           map[string]player.PendingCardPlay
+	pendingResponses           map[string]player.PendingResponse
+
+	generationTallies     map[string]GenerationTally
+	lastGenerationSummary *GenerationSummary
+
+	claimedMilestones []ClaimedMilestone
+	fundedAwards      []FundedAward
+
+	tiles            []board.Tile
+	hasDeck          bool
+	deck             deck.Checkpoint
+	globalParameters global_parameters.Checkpoint
+	players          map[string]player.Checkpoint
+}
+
+// BeginTransaction captures the game's current state
+// Call Commit when the action succeeds or Rollback when it fails
+func (g *Game) BeginTransaction() *Transaction {
+	return &Transaction{game: g, checkpoint: g.checkpoint()}
+}
+
+func (g *Game) checkpoint() gameCheckpoint {
+	g.mu.RLock()
+	cp := gameCheckpoint{
+		status:                     g.status,
+		currentPhase:               g.currentPhase,
+		phaseStarted:               g.phaseStartedAt,
+		turnStarted:                g.turnStartedAt,
+		timebankUsed:               maps.Clone(g.timebankUsed),
+		generation:                 g.generation,
+		turnOrder:                  append([]string{}, g.turnOrder...),
+		finalScores:                append([]FinalScore{}, g.finalScores...),
+		winnerID:                   g.winnerID,
+		isTie:                      g.isTie,
+		triggeredEffects:           append([]TriggeredEffect{}, g.triggeredEffects...),
+		effectResolutions:          append([]EffectResolution{}, g.effectResolutions...),
+		pendingTileSelections:      copyPending(g.pendingTileSelections),
+		pendingTileSelectionQueues: copyPending(g.pendingTileSelectionQueues),
+		forcedFirstActions:         copyPending(g.forcedFirstActions),
+		productionPhases:           copyPending(g.productionPhases),
+		selectStartingCardsPhases:  copyPending(g.selectStartingCardsPhases),
+		pendingCardPlays:           copyPending(g.pendingCardPlays),
+		pendingResponses:           copyPending(g.pendingResponses),
+		generationTallies:          copyPending(g.generationTallies),
+		lastGenerationSummary:      g.lastGenerationSummary,
+		players:                    make(map[string]player.Checkpoint, len(g.players)),
+	}
+	if g.currentTurn != nil {
+		cp.hasTurn = true
+		cp.turnPlayerID = g.currentTurn.PlayerID()
+		cp.turnActions = g.currentTurn.ActionsRemaining()
+	}
+	for playerID, p := range g.players {
+		cp.players[playerID] = p.Checkpoint()
+	}
+	gameDeck := g.deck
+	g.mu.RUnlock()
+
+	cp.claimedMilestones = g.milestones.ClaimedMilestones()
+	cp.fundedAwards = g.awards.FundedAwards()
+	cp.tiles = g.board.Tiles()
+	cp.globalParameters = g.globalParameters.Checkpoint()
+	if gameDeck != nil {
+		cp.hasDeck = true
+		cp.deck = gameDeck.Checkpoint()
+	}
+	return cp
+}
+
+// Commit ends the transaction, keeping every change
+func (tx *Transaction) Commit() {
+	tx.finished = true
+}
+
+// Rollback restores the state captured by BeginTransaction
+// Domain events are not replayed; a single GameStateChangedEvent tells listeners to refresh
+// Calling Rollback after Commit (or twice) does nothing
+func (tx *Transaction) Rollback() {
+	if tx.finished {
+		return
+	}
+	tx.finished = true
+
+	g := tx.game
+	g.restore(tx.checkpoint)
+
+	if g.eventBus != nil {
+		events.Publish(g.eventBus, events.GameStateChangedEvent{
+			GameID:    g.id,
+			Timestamp: time.Now(),
+		})
+	}
+}
+
+// restore writes a checkpoint back without publishing domain events
+func (g *Game) restore(cp gameCheckpoint) {
+	g.mu.Lock()
+	g.status = cp.status
+	g.currentPhase = cp.currentPhase
+	g.phaseStartedAt = cp.phaseStarted
+	g.turnStartedAt = cp.turnStarted
+	g.timebankUsed = cp.timebankUsed
+	g.generation = cp.generation
+	g.turnOrder = append([]string{}, cp.turnOrder...)
+	g.currentTurn = nil
+	if cp.hasTurn {
+		g.currentTurn = NewTurn(cp.turnPlayerID, cp.turnActions)
+	}
+	g.finalScores = cp.finalScores
+	g.winnerID = cp.winnerID
+	g.isTie = cp.isTie
+	g.triggeredEffects = cp.triggeredEffects
+	g.effectResolutions = cp.effectResolutions
+	g.pendingTileSelections = restorePending(cp.pendingTileSelections)
+	g.pendingTileSelectionQueues = restorePending(cp.pendingTileSelectionQueues)
+	g.forcedFirstActions = restorePending(cp.forcedFirstActions)
+	g.productionPhases = restorePending(cp.productionPhases)
+	g.selectStartingCardsPhases = restorePending(cp.selectStartingCardsPhases)
+	g.pendingCardPlays = restorePending(cp.pendingCardPlays)
+	g.pendingResponses = restorePending(cp.pendingResponses)
+	g.generationTallies = restorePending(cp.generationTallies)
+	g.lastGenerationSummary = cp.lastGenerationSummary
+	for playerID, p := range g.players {
+		if playerCheckpoint, ok := cp.players[playerID]; ok {
+			p.Restore(playerCheckpoint)
+		}
+	}
+	gameDeck := g.deck
+	g.updatedAt = time.Now()
+	g.mu.Unlock()
+
+	g.milestones.mu.Lock()
+	g.milestones.claimed = cp.claimedMilestones
+	g.milestones.mu.Unlock()
+
+	g.awards.mu.Lock()
+	g.awards.funded = cp.fundedAwards
+	g.awards.mu.Unlock()
+
+	g.board.RestoreTiles(cp.tiles)
+	g.globalParameters.Restore(cp.globalParameters)
+	if cp.hasDeck && gameDeck != nil {
+		gameDeck.Restore(cp.deck)
+	}
+}
+
+// Clone returns an independent copy of the game on its own event bus
+// Nothing done to the clone reaches this game or its listeners. Subscriptions made by actions
+// (passive card effects, cached hand cards, corporation forced actions) are not copied;
+// action.CloneGame re-creates the ones an action needs.
+func (g *Game) Clone() *Game {
+	cp := g.checkpoint()
+	clone := NewGame(g.id, g.hostPlayerID, g.Settings())
+
+	g.mu.RLock()
+	clone.createdAt = g.createdAt
+	clone.updatedAt = g.updatedAt
+	clone.randomizeSeatOrder = g.randomizeSeatOrder
+	clone.handicaps = maps.Clone(g.handicaps)
+	clone.playerColors = maps.Clone(g.playerColors)
+	clone.invites = maps.Clone(g.invites)
+	if g.pause != nil {
+		pause := *g.pause
+		clone.pause = &pause
+	}
+	clone.pauseVotes = maps.Clone(g.pauseVotes)
+	clone.abandonVotes = maps.Clone(g.abandonVotes)
+	clone.conceded = append([]ConcededPlayer{}, g.conceded...)
+	for playerID, p := range g.players {
+		clone.players[playerID] = p.Clone(clone.eventBus)
+	}
+	if g.deck != nil {
+		clone.deck = g.deck.Clone()
+	}
+	lookup := g.vpCardLookup
+	g.mu.RUnlock()
+
+	// The players were cloned with their state, so only the game-level part of the checkpoint is applied
+	cp.players = nil
+	cp.hasDeck = false
+	clone.restore(cp)
+	clone.updatedAt = g.UpdatedAt()
+	if lookup != nil {
+		clone.SetVPCardLookup(lookup)
+	}
+	return clone
+}
+
+// copyPending copies a per-player pending-state map by value so later writes cannot leak in
+func copyPending[T any](pending map[string]*T) map[string]T {
+	copied := make(map[string]T, len(pending))
+	for playerID, value := range pending {
+		if value != nil {
+			copied[playerID] = *value
+		}
+	}
+	return copied
+}
+
+func restorePending[T any](copied map[string]T) map[string]*T {
+	restored := make(map[string]*T, len(copied))
+	for playerID, value := range copied {
+		v := value
+		restored[playerID] = &v
+	}
+	return restored
+}
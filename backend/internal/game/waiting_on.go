@@ -0,0 +1,44 @@
+package game
+
+// WaitingOn returns the players the game is waiting for in its current phase, in turn order:
+// unfinished starting card selections or demo setups, unconfirmed production card purchases, and in
+// the action phase the current player along with anyone who still has a tile to place
+// While an effect waits on responses, the action phase waits on the responders alone
+// Lobby and finished games wait on no one
+func (g *Game) WaitingOn() []string {
+	phase := g.CurrentPhase()
+	currentTurn := g.CurrentTurn()
+	awaitingResponses := g.AwaitingResponses()
+
+	waiting := make([]string, 0)
+	for _, playerID := range g.TurnOrder() {
+		p, err := g.GetPlayer(playerID)
+		if err != nil {
+			continue
+		}
+
+		var isWaiting bool
+		switch phase {
+		case GamePhaseStartingCardSelection:
+			selection := g.GetSelectStartingCardsPhase(playerID)
+			isWaiting = selection != nil && !selection.SelectionComplete
+		case GamePhaseDemoSetup:
+			isWaiting = !p.DemoSetupConfirmed()
+		case GamePhaseProductionAndCardDraw:
+			production := g.GetProductionPhase(playerID)
+			isWaiting = production != nil && !production.SelectionComplete
+		case GamePhaseAction:
+			if awaitingResponses {
+				isWaiting = g.GetPendingResponse(playerID) != nil
+				break
+			}
+			isWaiting = (currentTurn != nil && currentTurn.PlayerID() == playerID) ||
+				g.GetPendingTileSelection(playerID) != nil
+		}
+
+		if isWaiting {
+			waiting = append(waiting, playerID)
+		}
+	}
+	return waiting
+}
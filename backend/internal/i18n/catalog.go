@@ -0,0 +1,178 @@
+package i18n
+
+import (
+	"slices"
+	"strings"
+)
+
+// DefaultLocale is used when a player has not chosen one; engine errors are already written in it
+const DefaultLocale = "en"
+
+// SupportedLocales lists the locales with a translated catalog
+var SupportedLocales = []string{"en", "de", "sv"}
+
+// entry is one message of the catalog
+// Errors also list the engine's English error texts they stand for, written as templates with the
+// same {param} placeholders; an engine text may follow "...: " prefixes added when wrapping
+type entry struct {
+	code      string
+	engine    []string
+	templates map[string]string // Locale -> template with {param} placeholders
+
+	engineSegments [][]segment // engine, parsed
+}
+
+// segment is a literal run of a template, or one {param} placeholder
+type segment struct {
+	literal string
+	param   string
+}
+
+var entriesByCode = func() map[string]*entry {
+	byCode := make(map[string]*entry, len(catalog))
+	for i := range catalog {
+		e := &catalog[i]
+		for _, template := range e.engine {
+			e.engineSegments = append(e.engineSegments, parseTemplate(template))
+		}
+		byCode[e.code] = e
+	}
+	return byCode
+}()
+
+// parseTemplate splits a template into literals and {param} placeholders
+func parseTemplate(template string) []segment {
+	var segments []segment
+	for template != "" {
+		open := strings.IndexByte(template, '{')
+		if open < 0 {
+			break
+		}
+		end := strings.IndexByte(template[open:], '}')
+		if end < 0 {
+			break
+		}
+		if open > 0 {
+			segments = append(segments, segment{literal: template[:open]})
+		}
+		segments = append(segments, segment{param: template[open+1 : open+end]})
+		template = template[open+end+1:]
+	}
+	if template != "" {
+		segments = append(segments, segment{literal: template})
+	}
+	return segments
+}
+
+// Normalize maps a client locale such as "de-DE" or "sv_SE" to a supported locale, or "" if there is none
+func Normalize(locale string) string {
+	language := strings.ToLower(locale)
+	if i := strings.IndexAny(language, "-_"); i >= 0 {
+		language = language[:i]
+	}
+	if slices.Contains(SupportedLocales, language) {
+		return language
+	}
+	return ""
+}
+
+// Message renders the message for code in locale, falling back to English and then to the code itself
+func Message(locale, code string, params map[string]string) string {
+	e, ok := entriesByCode[code]
+	if !ok {
+		return code
+	}
+	template, ok := e.templates[locale]
+	if !ok {
+		template = e.templates[DefaultLocale]
+	}
+	return render(template, locale, params)
+}
+
+// Resolve finds the catalog code of an engine error message and the parameters it carries
+func Resolve(text string) (code string, params map[string]string, ok bool) {
+	for i := range catalog {
+		e := &catalog[i]
+		for _, segments := range e.engineSegments {
+			if params, ok := matchWrapped(segments, text); ok {
+				return e.code, params, true
+			}
+		}
+	}
+	return "", nil, false
+}
+
+// matchWrapped matches the whole text, or the part after any "...: " wrapping prefix, against a template
+func matchWrapped(segments []segment, text string) (map[string]string, bool) {
+	for {
+		params := make(map[string]string)
+		if matchSegments(segments, text, params) {
+			return params, true
+		}
+		i := strings.Index(text, ": ")
+		if i < 0 {
+			return nil, false
+		}
+		text = text[i+2:]
+	}
+}
+
+// matchSegments reports whether text is exactly the template, filling params with the placeholder values
+// A placeholder takes at least one character, up to an occurrence of the literal that follows it
+func matchSegments(segments []segment, text string, params map[string]string) bool {
+	if len(segments) == 0 {
+		return text == ""
+	}
+	first := segments[0]
+	if first.param == "" {
+		rest, found := strings.CutPrefix(text, first.literal)
+		return found && matchSegments(segments[1:], rest, params)
+	}
+	if len(segments) == 1 {
+		params[first.param] = text
+		return text != ""
+	}
+	next := segments[1].literal
+	for offset := 1; offset < len(text); offset++ {
+		i := strings.Index(text[offset:], next)
+		if i < 0 {
+			return false
+		}
+		offset += i
+		if matchSegments(segments[1:], text[offset:], params) {
+			params[first.param] = text[:offset]
+			return true
+		}
+	}
+	return false
+}
+
+// LocalizeError returns the code of an engine error message and its text in locale
+// Unknown messages keep their text and get no code; English keeps the engine's own wording
+func LocalizeError(locale, text string) (code, localized string) {
+	code, params, ok := Resolve(text)
+	if !ok {
+		return "", text
+	}
+	if locale == "" || locale == DefaultLocale {
+		return code, text
+	}
+	return code, Message(locale, code, params)
+}
+
+// render fills {param} placeholders; a resource parameter is itself translated
+func render(template, locale string, params map[string]string) string {
+	if len(params) == 0 {
+		return template
+	}
+	replacements := make([]string, 0, len(params)*2)
+	for name, value := range params {
+		if name == "resource" {
+			if _, known := entriesByCode["resource."+value]; known {
+				value = Message(locale, "resource."+value, nil)
+			}
+		}
+		replacements = append(replacements, "{"+name+"}", value)
+	}
+	return strings.NewReplacer(replacements...).Replace(template)
+}
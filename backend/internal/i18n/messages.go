@@ -0,0 +1,292 @@
+package i18n
+
+// catalog holds every user-facing server message; errors are keyed by the code sent in ErrorPayload.Code
+// Engine templates are tried in order, so more specific ones come first
+var catalog = []entry{
+	// Connection and protocol
+	{
+		code:   "ERR_NOT_CONNECTED",
+		engine: []string{"Not connected to a game", "not connected to game"},
+		templates: map[string]string{
+			"en": "Not connected to a game",
+			"de": "Nicht mit einem Spiel verbunden",
+			"sv": "Inte ansluten till ett spel",
+		},
+	},
+	{
+		code:   "ERR_INVALID_PAYLOAD",
+		engine: []string{"Invalid payload format", "invalid payload format"},
+		templates: map[string]string{
+			"en": "Invalid payload format",
+			"de": "Ungültiges Nachrichtenformat",
+			"sv": "Ogiltigt meddelandeformat",
+		},
+	},
+	{
+		code:   "ERR_UNKNOWN_MESSAGE_TYPE",
+		engine: []string{"Unknown message type"},
+		templates: map[string]string{
+			"en": "Unknown message type",
+			"de": "Unbekannter Nachrichtentyp",
+			"sv": "Okänd meddelandetyp",
+		},
+	},
+	{
+		code:   "ERR_UNSUPPORTED_LOCALE",
+		engine: []string{"unsupported locale: {locale}"},
+		templates: map[string]string{
+			"en": "Unsupported locale: {locale}",
+			"de": "Nicht unterstützte Sprache: {locale}",
+			"sv": "Språket stöds inte: {locale}",
+		},
+	},
+
+	// Game state
+	{
+		code:   "ERR_GAME_PAUSED",
+		engine: []string{"game is paused"},
+		templates: map[string]string{
+			"en": "Game is paused",
+			"de": "Das Spiel ist pausiert",
+			"sv": "Spelet är pausat",
+		},
+	},
+	{
+		code:   "ERR_AWAITING_RESPONSE",
+		engine: []string{"waiting for a player to respond to an effect"},
+		templates: map[string]string{
+			"en": "Waiting for a player to respond to an effect",
+			"de": "Warten auf die Reaktion eines Spielers auf einen Effekt",
+			"sv": "Väntar på att en spelare svarar på en effekt",
+		},
+	},
+	{
+		code:   "ERR_ACTION_TIMEOUT",
+		engine: []string{"action timed out"},
+		templates: map[string]string{
+			"en": "The action took too long and was cancelled",
+			"de": "Die Aktion hat zu lange gedauert und wurde abgebrochen",
+			"sv": "Åtgärden tog för lång tid och avbröts",
+		},
+	},
+	{
+		code:   "ERR_GAME_FAILED",
+		engine: []string{"game failed"},
+		templates: map[string]string{
+			"en": "The game stopped responding and can no longer be played",
+			"de": "Das Spiel reagiert nicht mehr und kann nicht weitergespielt werden",
+			"sv": "Spelet slutade svara och kan inte längre spelas",
+		},
+	},
+	{
+		code:   "ERR_SERVER_AT_CAPACITY",
+		engine: []string{"server is at capacity"},
+		templates: map[string]string{
+			"en": "The server is full right now, try again in a moment",
+			"de": "Der Server ist gerade voll, versuche es gleich noch einmal",
+			"sv": "Servern är full just nu, försök igen om en stund",
+		},
+	},
+	{
+		code:   "ERR_GAME_NOT_FOUND",
+		engine: []string{"game not found", "game not found: {detail}"},
+		templates: map[string]string{
+			"en": "Game not found",
+			"de": "Spiel nicht gefunden",
+			"sv": "Spelet hittades inte",
+		},
+	},
+	{
+		code:   "ERR_PLAYER_NOT_FOUND",
+		engine: []string{"player not found", "player not found: {detail}", "player not found in game", "player {player} not found in game {game}"},
+		templates: map[string]string{
+			"en": "Player not found",
+			"de": "Spieler nicht gefunden",
+			"sv": "Spelaren hittades inte",
+		},
+	},
+	{
+		code:   "ERR_GAME_ENDED",
+		engine: []string{"game has ended: {detail}"},
+		templates: map[string]string{
+			"en": "The game has ended",
+			"de": "Das Spiel ist beendet",
+			"sv": "Spelet är slut",
+		},
+	},
+	{
+		code:   "ERR_WRONG_STATUS",
+		engine: []string{"game not in {status} status"},
+		templates: map[string]string{
+			"en": "Game not in {status} status",
+			"de": "Das Spiel hat nicht den Status {status}",
+			"sv": "Spelet har inte statusen {status}",
+		},
+	},
+	{
+		code:   "ERR_WRONG_PHASE",
+		engine: []string{"game not in {phase} phase"},
+		templates: map[string]string{
+			"en": "Game not in {phase} phase",
+			"de": "Das Spiel ist nicht in der Phase {phase}",
+			"sv": "Spelet är inte i fasen {phase}",
+		},
+	},
+	{
+		code:   "ERR_EXPANSION_DISABLED",
+		engine: []string{"the {pack} expansion is not enabled in this game"},
+		templates: map[string]string{
+			"en": "The {pack} expansion is not enabled in this game",
+			"de": "Die Erweiterung {pack} ist in diesem Spiel nicht aktiviert",
+			"sv": "Expansionen {pack} är inte aktiverad i det här spelet",
+		},
+	},
+	{
+		code:   "ERR_HOST_ONLY",
+		engine: []string{"only the host can perform this action"},
+		templates: map[string]string{
+			"en": "Only the host can perform this action",
+			"de": "Nur der Gastgeber kann diese Aktion ausführen",
+			"sv": "Endast värden kan utföra den här handlingen",
+		},
+	},
+
+	// Turns and payment
+	{
+		code:   "ERR_NOT_YOUR_TURN",
+		engine: []string{"not your turn"},
+		templates: map[string]string{
+			"en": "Not your turn",
+			"de": "Du bist nicht am Zug",
+			"sv": "Det är inte din tur",
+		},
+	},
+	{
+		code:   "ERR_NO_ACTIONS_REMAINING",
+		engine: []string{"no actions remaining"},
+		templates: map[string]string{
+			"en": "No actions remaining",
+			"de": "Keine Aktionen mehr übrig",
+			"sv": "Inga handlingar kvar",
+		},
+	},
+	{
+		code:   "ERR_INSUFFICIENT_RESOURCE",
+		engine: []string{"insufficient {resource}: need {need}, have {have}"},
+		templates: map[string]string{
+			"en": "Insufficient {resource}: need {need}, have {have}",
+			"de": "Nicht genug {resource}: benötigt {need}, vorhanden {have}",
+			"sv": "Inte tillräckligt med {resource}: behöver {need}, har {have}",
+		},
+	},
+
+	// Cards, board, milestones and awards
+	{
+		code:   "ERR_CARD_NOT_IN_HAND",
+		engine: []string{"card {card} not in hand"},
+		templates: map[string]string{
+			"en": "Card {card} not in hand",
+			"de": "Die Karte {card} ist nicht auf deiner Hand",
+			"sv": "Kortet {card} finns inte på handen",
+		},
+	},
+	{
+		code:   "ERR_INVALID_HEX",
+		engine: []string{"selected hex {hex} is not valid for placement"},
+		templates: map[string]string{
+			"en": "Selected hex {hex} is not valid for placement",
+			"de": "Das Feld {hex} ist für diese Platzierung nicht gültig",
+			"sv": "Rutan {hex} är inte giltig för placering",
+		},
+	},
+	{
+		code:   "ERR_MILESTONE_CLAIMED",
+		engine: []string{"milestone {milestone} is already claimed"},
+		templates: map[string]string{
+			"en": "Milestone {milestone} is already claimed",
+			"de": "Der Meilenstein {milestone} wurde bereits beansprucht",
+			"sv": "Milstolpen {milestone} är redan tagen",
+		},
+	},
+	{
+		code:   "ERR_MILESTONES_FULL",
+		engine: []string{"maximum milestones ({max}) already claimed"},
+		templates: map[string]string{
+			"en": "Maximum milestones ({max}) already claimed",
+			"de": "Es wurden bereits {max} Meilensteine beansprucht",
+			"sv": "{max} milstolpar är redan tagna",
+		},
+	},
+	{
+		code:   "ERR_AWARD_FUNDED",
+		engine: []string{"award {award} is already funded"},
+		templates: map[string]string{
+			"en": "Award {award} is already funded",
+			"de": "Die Auszeichnung {award} wurde bereits finanziert",
+			"sv": "Utmärkelsen {award} är redan finansierad",
+		},
+	},
+	{
+		code:   "ERR_MAX_AWARDS",
+		engine: []string{"maximum awards ({max}) already funded"},
+		templates: map[string]string{
+			"en": "Maximum awards ({max}) already funded",
+			"de": "Es wurden bereits {max} Auszeichnungen finanziert",
+			"sv": "{max} utmärkelser är redan finansierade",
+		},
+	},
+	{
+		code:   "ERR_NOTE_TOO_LONG",
+		engine: []string{"note is too long (max {max} characters)"},
+		templates: map[string]string{
+			"en": "Note is too long (max {max} characters)",
+			"de": "Die Notiz ist zu lang (max. {max} Zeichen)",
+			"sv": "Anteckningen är för lång (max {max} tecken)",
+		},
+	},
+	{
+		code:   "ERR_BATCH_SIZE",
+		engine: []string{"batch must have between 1 and {max} steps"},
+		templates: map[string]string{
+			"en": "A batch must have between 1 and {max} steps",
+			"de": "Ein Stapel muss zwischen 1 und {max} Schritte enthalten",
+			"sv": "En batch måste ha mellan 1 och {max} steg",
+		},
+	},
+	{
+		code:   "ERR_UNKNOWN_REACTION",
+		engine: []string{"unknown reaction: {reaction}"},
+		templates: map[string]string{
+			"en": "Unknown reaction: {reaction}",
+			"de": "Unbekannte Reaktion: {reaction}",
+			"sv": "Okänd reaktion: {reaction}",
+		},
+	},
+	{
+		code:   "ERR_REACTION_RATE_LIMITED",
+		engine: []string{"sending reactions too fast"},
+		templates: map[string]string{
+			"en": "You are sending reactions too fast",
+			"de": "Du sendest Reaktionen zu schnell",
+			"sv": "Du skickar reaktioner för snabbt",
+		},
+	},
+
+	// Notifications
+	{
+		code: "notification.kicked",
+		templates: map[string]string{
+			"en": "You were kicked from the game",
+			"de": "Du wurdest aus dem Spiel entfernt",
+			"sv": "Du blev utsparkad ur spelet",
+		},
+	},
+
+	// Resource names, substituted into {resource}
+	{code: "resource.credits", templates: map[string]string{"en": "credits", "de": "M€", "sv": "M€"}},
+	{code: "resource.steel", templates: map[string]string{"en": "steel", "de": "Stahl", "sv": "stål"}},
+	{code: "resource.titanium", templates: map[string]string{"en": "titanium", "de": "Titan", "sv": "titan"}},
+	{code: "resource.plants", templates: map[string]string{"en": "plants", "de": "Pflanzen", "sv": "växter"}},
+	{code: "resource.energy", templates: map[string]string{"en": "energy", "de": "Energie", "sv": "energi"}},
+	{code: "resource.heat", templates: map[string]string{"en": "heat", "de": "Wärme", "sv": "värme"}},
+}
@@ -0,0 +1,318 @@
+package logger
+
+import (
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"go.uber.org/zap/zapcore"
+)
+
+const (
+	// DefaultGameLogCapacity is how many entries each game's buffer keeps
+	DefaultGameLogCapacity = 500
+	// DefaultMaxGameLogs bounds how many games keep a buffer; the oldest buffer is dropped first
+	DefaultMaxGameLogs = 2000
+	// DefaultMaxGameLogBytes is the estimated memory all buffers together may hold (64 MiB)
+	DefaultMaxGameLogBytes int64 = 64 << 20
+
+	gameIDField = "game_id"
+
+	// Rough in-memory sizes behind approxSize; they only need to be proportional for the byte budget
+	entryOverhead = 96
+	valueOverhead = 16
+)
+
+// GameLogEntry is one log line recorded for a game
+type GameLogEntry struct {
+	Time    time.Time
+	Level   zapcore.Level
+	Message string
+	Caller  string
+	Fields  map[string]interface{}
+}
+
+// approxSize estimates the memory an entry holds
+func (e GameLogEntry) approxSize() int64 {
+	size := entryOverhead + len(e.Message) + len(e.Caller)
+	for key, value := range e.Fields {
+		size += len(key) + valueSize(value)
+	}
+	return int64(size)
+}
+
+func valueSize(value interface{}) int {
+	switch v := value.(type) {
+	case string:
+		return valueOverhead + len(v)
+	case []byte:
+		return valueOverhead + len(v)
+	case []interface{}:
+		size := valueOverhead
+		for _, item := range v {
+			size += valueSize(item)
+		}
+		return size
+	case map[string]interface{}:
+		size := valueOverhead
+		for key, item := range v {
+			size += len(key) + valueSize(item)
+		}
+		return size
+	default:
+		return valueOverhead
+	}
+}
+
+// gameLogBuffer holds a game's most recent entries, oldest first
+type gameLogBuffer struct {
+	entries []GameLogEntry
+	sizes   []int64
+	bytes   int64
+}
+
+func (b *gameLogBuffer) push(entry GameLogEntry, size int64) {
+	b.entries = append(b.entries, entry)
+	b.sizes = append(b.sizes, size)
+	b.bytes += size
+}
+
+// dropOldest removes the oldest entry and returns its size
+func (b *gameLogBuffer) dropOldest() int64 {
+	size := b.sizes[0]
+	b.entries[0] = GameLogEntry{}
+	b.entries = b.entries[1:]
+	b.sizes = b.sizes[1:]
+	b.bytes -= size
+	return size
+}
+
+// GameLogStore keeps per-game buffers of every log line carrying a game_id field,
+// so one game can be diagnosed without searching the whole server log.
+// Each game keeps its last capacity lines, at most maxGames games keep a buffer, and all buffers
+// together stay within maxBytes; past that the oldest games' lines go first.
+type GameLogStore struct {
+	enabled atomic.Bool
+
+	mu       sync.Mutex
+	capacity int
+	maxGames int
+	maxBytes int64
+	bytes    int64
+	buffers  map[string]*gameLogBuffer
+	order    []string // Game IDs by buffer creation, for eviction
+}
+
+// NewGameLogStore creates a recording store keeping capacity entries for at most maxGames games,
+// within maxBytes in total
+func NewGameLogStore(capacity, maxGames int, maxBytes int64) *GameLogStore {
+	s := &GameLogStore{
+		capacity: capacity,
+		maxGames: maxGames,
+		maxBytes: maxBytes,
+		buffers:  make(map[string]*gameLogBuffer),
+	}
+	s.enabled.Store(true)
+	return s
+}
+
+// Configure turns recording on or off and sets the byte budget shared by every game's buffer
+// Turning recording off drops everything recorded so far
+func (s *GameLogStore) Configure(enabled bool, maxBytes int64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.enabled.Store(enabled)
+	s.maxBytes = maxBytes
+	if !enabled {
+		s.buffers = make(map[string]*gameLogBuffer)
+		s.order = nil
+		s.bytes = 0
+		return
+	}
+	s.evictLocked("")
+}
+
+// Enabled reports whether the store is recording
+func (s *GameLogStore) Enabled() bool {
+	return s.enabled.Load()
+}
+
+// Bytes returns the estimated memory all buffers hold
+func (s *GameLogStore) Bytes() int64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.bytes
+}
+
+// Record appends an entry to a game's buffer
+// An entry larger than the whole byte budget is not kept
+func (s *GameLogStore) Record(gameID string, entry GameLogEntry) {
+	if !s.enabled.Load() {
+		return
+	}
+	size := entry.approxSize()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if size > s.maxBytes {
+		return
+	}
+	buffer, ok := s.buffers[gameID]
+	if !ok {
+		if len(s.order) >= s.maxGames {
+			s.dropLocked(s.order[0])
+		}
+		buffer = &gameLogBuffer{}
+		s.buffers[gameID] = buffer
+		s.order = append(s.order, gameID)
+	}
+	buffer.push(entry, size)
+	s.bytes += size
+	if len(buffer.entries) > s.capacity {
+		s.bytes -= buffer.dropOldest()
+	}
+	s.evictLocked(gameID)
+}
+
+// evictLocked brings the store back within its byte budget: other games' buffers go oldest first,
+// then the oldest lines of the game being recorded
+func (s *GameLogStore) evictLocked(gameID string) {
+	for s.bytes > s.maxBytes {
+		victim := ""
+		for _, id := range s.order {
+			if id != gameID {
+				victim = id
+				break
+			}
+		}
+		if victim != "" {
+			s.dropLocked(victim)
+			continue
+		}
+		buffer, ok := s.buffers[gameID]
+		if !ok || len(buffer.entries) == 0 {
+			return
+		}
+		s.bytes -= buffer.dropOldest()
+	}
+}
+
+func (s *GameLogStore) dropLocked(gameID string) {
+	buffer, ok := s.buffers[gameID]
+	if !ok {
+		return
+	}
+	s.bytes -= buffer.bytes
+	delete(s.buffers, gameID)
+	for i, id := range s.order {
+		if id == gameID {
+			s.order = append(s.order[:i], s.order[i+1:]...)
+			break
+		}
+	}
+}
+
+// Entries returns a game's buffered entries at or above minLevel, oldest first
+func (s *GameLogStore) Entries(gameID string, minLevel zapcore.Level) []GameLogEntry {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	buffer, ok := s.buffers[gameID]
+	if !ok {
+		return nil
+	}
+	entries := make([]GameLogEntry, 0, len(buffer.entries))
+	for _, entry := range buffer.entries {
+		if entry.Level >= minLevel {
+			entries = append(entries, entry)
+		}
+	}
+	return entries
+}
+
+// Forget drops a game's buffer, once the game has left the server
+func (s *GameLogStore) Forget(gameID string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.dropLocked(gameID)
+}
+
+// Core returns a zap core that records game-scoped entries into the store;
+// tee it with the server's core so every logger carrying game_id feeds its game's buffer
+func (s *GameLogStore) Core(level zapcore.LevelEnabler) zapcore.Core {
+	return &gameLogCore{LevelEnabler: level, store: s}
+}
+
+// gameLogCore picks the game_id out of a logger's context or an entry's fields
+type gameLogCore struct {
+	zapcore.LevelEnabler
+	store  *GameLogStore
+	gameID string
+	fields []zapcore.Field
+}
+
+func (c *gameLogCore) With(fields []zapcore.Field) zapcore.Core {
+	clone := &gameLogCore{
+		LevelEnabler: c.LevelEnabler,
+		store:        c.store,
+		gameID:       c.gameID,
+		fields:       append(append([]zapcore.Field(nil), c.fields...), fields...),
+	}
+	if gameID := gameIDOf(fields); gameID != "" {
+		clone.gameID = gameID
+	}
+	return clone
+}
+
+func (c *gameLogCore) Check(entry zapcore.Entry, checked *zapcore.CheckedEntry) *zapcore.CheckedEntry {
+	if c.store.Enabled() && c.Enabled(entry.Level) {
+		return checked.AddCore(entry, c)
+	}
+	return checked
+}
+
+func (c *gameLogCore) Write(entry zapcore.Entry, fields []zapcore.Field) error {
+	gameID := c.gameID
+	if id := gameIDOf(fields); id != "" {
+		gameID = id
+	}
+	if gameID == "" {
+		return nil
+	}
+
+	encoder := zapcore.NewMapObjectEncoder()
+	for _, field := range c.fields {
+		field.AddTo(encoder)
+	}
+	for _, field := range fields {
+		field.AddTo(encoder)
+	}
+	delete(encoder.Fields, gameIDField)
+
+	record := GameLogEntry{
+		Time:    entry.Time,
+		Level:   entry.Level,
+		Message: entry.Message,
+		Fields:  encoder.Fields,
+	}
+	if entry.Caller.Defined {
+		record.Caller = entry.Caller.TrimmedPath()
+	}
+	c.store.Record(gameID, record)
+	return nil
+}
+
+func (c *gameLogCore) Sync() error {
+	return nil
+}
+
+func gameIDOf(fields []zapcore.Field) string {
+	for _, field := range fields {
+		if field.Key == gameIDField && field.Type == zapcore.StringType && field.String != "" {
+			return field.String
+		}
+	}
+	return ""
+}
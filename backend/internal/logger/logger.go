@@ -4,10 +4,21 @@ import (
 	"os"
 
 	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
 )
 
 var globalLogger *zap.Logger
 
+// gameLogs receives every entry logged with a game_id, whatever logger it came from
+// It records nothing until GameLogs().Configure turns it on
+var gameLogs = newDisabledGameLogStore()
+
+func newDisabledGameLogStore() *GameLogStore {
+	store := NewGameLogStore(DefaultGameLogCapacity, DefaultMaxGameLogs, DefaultMaxGameLogBytes)
+	store.Configure(false, DefaultMaxGameLogBytes)
+	return store
+}
+
 // Init initializes the global logger
 func Init(logLevel *string) error {
 	var err error
@@ -42,7 +53,7 @@ func Init(logLevel *string) error {
 		config.Level = zap.NewAtomicLevelAt(zap.InfoLevel)
 	}
 
-	globalLogger, err = config.Build(zap.AddStacktrace(zap.ErrorLevel))
+	globalLogger, err = config.Build(zap.AddStacktrace(zap.ErrorLevel), teeGameLogs(config.Level))
 	if err != nil {
 		return err
 	}
@@ -54,11 +65,22 @@ func Init(logLevel *string) error {
 func Get() *zap.Logger {
 	if globalLogger == nil {
 		// Fallback to development logger if not initialized
-		globalLogger, _ = zap.NewDevelopment()
+		globalLogger, _ = zap.NewDevelopment(teeGameLogs(zapcore.DebugLevel))
 	}
 	return globalLogger
 }
 
+// GameLogs returns the per-game log buffers fed by the global logger
+func GameLogs() *GameLogStore {
+	return gameLogs
+}
+
+func teeGameLogs(level zapcore.LevelEnabler) zap.Option {
+	return zap.WrapCore(func(core zapcore.Core) zapcore.Core {
+		return zapcore.NewTee(core, gameLogs.Core(level))
+	})
+}
+
 // Sync flushes the logger
 func Sync() error {
 	if globalLogger != nil {
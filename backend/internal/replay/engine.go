@@ -0,0 +1,117 @@
+package replay
+
+import (
+	admin "terraforming-mars-backend/internal/action/admin"
+	awardAction "terraforming-mars-backend/internal/action/award"
+	cardAction "terraforming-mars-backend/internal/action/card"
+	confirmAction "terraforming-mars-backend/internal/action/confirmation"
+	connAction "terraforming-mars-backend/internal/action/connection"
+	gameAction "terraforming-mars-backend/internal/action/game"
+	milestoneAction "terraforming-mars-backend/internal/action/milestone"
+	resconvAction "terraforming-mars-backend/internal/action/resource_conversion"
+	stdprojAction "terraforming-mars-backend/internal/action/standard_project"
+	tileAction "terraforming-mars-backend/internal/action/tile"
+	turnAction "terraforming-mars-backend/internal/action/turn_management"
+	"terraforming-mars-backend/internal/analytics"
+	"terraforming-mars-backend/internal/cards"
+	wsHandler "terraforming-mars-backend/internal/delivery/websocket"
+	"terraforming-mars-backend/internal/delivery/websocket/core"
+	"terraforming-mars-backend/internal/game"
+
+	"go.uber.org/zap"
+)
+
+// engine is the server's message handling wired up as cmd/server wires it, minus the network
+// Replayed messages go through the same guards, handlers and auto-pass hook as live ones
+type engine struct {
+	hub       *core.Hub
+	gameRepo  game.GameRepository
+	stateRepo game.GameStateRepository
+}
+
+func newEngine(cardRegistry cards.CardRegistry, log *zap.Logger) *engine {
+	gameRepo := game.NewInMemoryGameRepository()
+	stateRepo := game.NewInMemoryGameStateRepository()
+	analyticsStore := analytics.NewStore()
+	hub := core.NewHub()
+	broadcaster := wsHandler.NewBroadcaster(gameRepo, stateRepo, hub, cardRegistry, nil)
+
+	finalScoringAction := gameAction.NewFinalScoringAction(gameRepo, cardRegistry, analyticsStore, log)
+	playCardAction := cardAction.NewPlayCardAction(gameRepo, cardRegistry, stateRepo, log)
+	skipActionAction := turnAction.NewSkipActionAction(gameRepo, cardRegistry, finalScoringAction, log)
+	confirmProductionCardsAction := confirmAction.NewConfirmProductionCardsAction(gameRepo, cardRegistry, analyticsStore, log)
+
+	wsHandler.RegisterHandlers(
+		hub,
+		broadcaster,
+		// Game lifecycle
+		gameAction.NewCreateGameAction(gameRepo, cardRegistry, log),
+		gameAction.NewJoinGameAction(gameRepo, cardRegistry, log),
+		gameAction.NewConfirmDemoSetupAction(gameRepo, cardRegistry, log),
+		gameAction.NewSetSeatOrderAction(gameRepo, log),
+		gameAction.NewSetHandicapAction(gameRepo, log),
+		gameAction.NewSetPlayerColorAction(gameRepo, log),
+		gameAction.NewPauseGameAction(gameRepo, log),
+		gameAction.NewResumeGameAction(gameRepo, log),
+		gameAction.NewVoteAbandonAction(gameRepo, log),
+		gameAction.NewSetPreferencesAction(gameRepo, log),
+		gameAction.NewForceAdvancePhaseAction(gameRepo, stateRepo, confirmProductionCardsAction, log),
+		gameAction.NewSendReactionAction(gameRepo, stateRepo, log),
+		gameAction.NewMuteReactionsAction(gameRepo, log),
+		gameAction.NewSetNoteAction(gameRepo, log),
+		gameAction.NewRunBatchAction(gameRepo, stateRepo, log),
+		// Card actions
+		playCardAction,
+		cardAction.NewPreparePlayCardAction(gameRepo, cardRegistry, log),
+		cardAction.NewCommitPlayCardAction(gameRepo, playCardAction, log),
+		cardAction.NewCancelPlayCardAction(gameRepo, log),
+		cardAction.NewUseCardActionAction(gameRepo, cardRegistry, stateRepo, log),
+		// Standard projects
+		stdprojAction.NewLaunchAsteroidAction(gameRepo, stateRepo, log),
+		stdprojAction.NewBuildPowerPlantAction(gameRepo, cardRegistry, stateRepo, log),
+		stdprojAction.NewBuildAquiferAction(gameRepo, stateRepo, log),
+		stdprojAction.NewBuildCityAction(gameRepo, stateRepo, log),
+		stdprojAction.NewPlantGreeneryAction(gameRepo, stateRepo, log),
+		stdprojAction.NewSellPatentsAction(gameRepo, stateRepo, log),
+		// Resource conversions
+		resconvAction.NewConvertHeatToTemperatureAction(gameRepo, cardRegistry, stateRepo, log),
+		resconvAction.NewConvertPlantsToGreeneryAction(gameRepo, cardRegistry, stateRepo, log),
+		resconvAction.NewConvertAllAction(gameRepo, cardRegistry, stateRepo, log),
+		// Tile selection
+		tileAction.NewSelectTileAction(gameRepo, cardRegistry, stateRepo, log),
+		// Turn management
+		turnAction.NewStartGameAction(gameRepo, cardRegistry, log),
+		skipActionAction,
+		turnAction.NewConcedeAction(gameRepo, skipActionAction),
+		turnAction.NewAutoPassAction(gameRepo, skipActionAction, cardRegistry, log),
+		turnAction.NewSelectStartingCardsAction(gameRepo, cardRegistry, log),
+		turnAction.NewMulliganStartingHandAction(gameRepo, stateRepo, log),
+		// Confirmations
+		confirmAction.NewConfirmSellPatentsAction(gameRepo, log),
+		confirmProductionCardsAction,
+		confirmAction.NewConfirmCardDrawAction(gameRepo, cardRegistry, log),
+		confirmAction.NewRespondToEffectAction(gameRepo, stateRepo, log),
+		// Connection
+		connAction.NewPlayerReconnectedAction(gameRepo, log),
+		connAction.NewPlayerDisconnectedAction(gameRepo, log),
+		connAction.NewPlayerTakeoverAction(gameRepo, cardRegistry, log),
+		connAction.NewKickPlayerAction(gameRepo, log),
+		// Milestones & Awards
+		milestoneAction.NewClaimMilestoneAction(gameRepo, cardRegistry, stateRepo, log),
+		awardAction.NewFundAwardAction(gameRepo, cardRegistry, stateRepo, log),
+		// Admin actions
+		admin.NewSetPhaseAction(gameRepo, log),
+		admin.NewSetCurrentTurnAction(gameRepo, log),
+		admin.NewSetResourcesAction(gameRepo, log),
+		admin.NewSetProductionAction(gameRepo, log),
+		admin.NewSetGlobalParametersAction(gameRepo, log),
+		admin.NewGiveCardAction(gameRepo, cardRegistry, stateRepo, log),
+		admin.NewSetCorporationAction(gameRepo, cardRegistry, log),
+		admin.NewStartTileSelectionAction(gameRepo, log),
+		admin.NewSetTRAction(gameRepo, log),
+		admin.NewSetupTestStateAction(gameRepo, cardRegistry, log),
+		admin.NewSetAwardFreezeAction(gameRepo, log),
+	)
+
+	return &engine{hub: hub, gameRepo: gameRepo, stateRepo: stateRepo}
+}
@@ -0,0 +1,184 @@
+// Package replay reruns an archived game's recorded inputs through the current engine and reports
+// the first input whose resulting state differs from the state recorded when the game was played
+package replay
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"terraforming-mars-backend/internal/archive"
+	"terraforming-mars-backend/internal/cards"
+	"terraforming-mars-backend/internal/delivery/dto"
+	"terraforming-mars-backend/internal/delivery/websocket/core"
+	"terraforming-mars-backend/internal/game"
+	"terraforming-mars-backend/internal/game/deck"
+
+	"go.uber.org/zap"
+)
+
+var (
+	// ErrNoInputs is returned for games archived before their inputs were recorded
+	ErrNoInputs = errors.New("game was archived without its inputs")
+	// ErrNoShuffleProof is returned for games whose deck seed was never revealed, so their cards cannot be dealt again
+	ErrNoShuffleProof = errors.New("game has no revealed deck seed")
+	// ErrCardPoolChanged is returned when the current cards no longer shuffle into the deck the game committed to
+	ErrCardPoolChanged = errors.New("card pool no longer matches the deck commitment")
+)
+
+// Divergence is the first input whose replay left a different state or log than it did when played
+type Divergence struct {
+	Input       game.ActionInput
+	Changes     *game.GameChanges // From the recorded state to the replayed one; nil when only the log differs
+	RecordedLog []game.StateDiff  // Entries written between the previous input and this one when the game was played
+	ReplayedLog []game.StateDiff  // Entries the input wrote in the replay
+	Errors      []string          // Errors the replayed handlers answered with
+}
+
+// Result is the outcome of replaying one game
+type Result struct {
+	GameID     string
+	Replayed   int         // Inputs replayed, including the divergent one
+	Divergence *Divergence // nil when every input reproduced its recorded state
+}
+
+// Run replays the record's inputs in order and stops at the first one that diverges
+// The game is rebuilt from its settings and revealed deck seed, and every input is routed through the same
+// guards and handlers as a live message. Server-side work between inputs, such as turn timeouts, is not
+// replayed: it shows up as recorded log entries the replay did not write.
+func Run(ctx context.Context, cardRegistry cards.CardRegistry, record archive.Record, log *zap.Logger) (*Result, error) {
+	if len(record.Inputs) == 0 {
+		return nil, ErrNoInputs
+	}
+
+	e := newEngine(cardRegistry, log)
+	if err := createGame(ctx, e, cardRegistry, record); err != nil {
+		return nil, err
+	}
+
+	result := &Result{GameID: record.GameID}
+	connections := make(map[string]*core.Connection)
+	recordedLength, replayedLength := 0, 0
+	for _, input := range record.Inputs {
+		message, err := toMessage(record.GameID, input)
+		if err != nil {
+			return nil, fmt.Errorf("input %d: %w", input.Sequence, err)
+		}
+
+		connection, ok := connections[input.PlayerID]
+		if !ok {
+			connection = core.NewVirtualConnection("replay-"+input.PlayerID, e.hub.GetManager())
+			connections[input.PlayerID] = connection
+		}
+		if !isJoin(message.Type) {
+			connection.SetPlayer(input.PlayerID, record.GameID)
+		}
+
+		e.hub.Route(ctx, connection, message)
+		result.Replayed++
+
+		g, err := e.gameRepo.Get(ctx, record.GameID)
+		if err != nil {
+			return nil, fmt.Errorf("input %d: %w", input.Sequence, err)
+		}
+		replayedDiffs, err := e.stateRepo.GetDiff(ctx, record.GameID)
+		if err != nil && !errors.Is(err, game.ErrGameNotFound) {
+			return nil, err
+		}
+
+		recordedLog := record.Log[min(recordedLength, len(record.Log)):min(input.LogLength, len(record.Log))]
+		replayedLog := replayedDiffs[min(replayedLength, len(replayedDiffs)):]
+		changes := game.DiffSnapshots(input.State, game.CaptureSnapshot(g))
+		if changes != nil || !sameEntries(recordedLog, replayedLog) {
+			result.Divergence = &Divergence{
+				Input:       input,
+				Changes:     changes,
+				RecordedLog: recordedLog,
+				ReplayedLog: replayedLog,
+				Errors:      drainErrors(connection),
+			}
+			return result, nil
+		}
+		drainErrors(connection)
+		recordedLength, replayedLength = input.LogLength, len(replayedDiffs)
+	}
+	return result, nil
+}
+
+// createGame rebuilds the game as it was created: same ID and settings, and a deck dealt from the revealed seed
+func createGame(ctx context.Context, e *engine, cardRegistry cards.CardRegistry, record archive.Record) error {
+	proof := record.ShuffleProof
+	if proof == nil {
+		return ErrNoShuffleProof
+	}
+	seed, err := deck.ParseSeed(proof.Seed)
+	if err != nil {
+		return err
+	}
+
+	projectCardIDs, corpIDs, preludeIDs := cards.GetCardIDsByPacks(cardRegistry, record.Settings.CardPacks)
+	gameDeck := deck.NewSeededDeck(record.GameID, projectCardIDs, corpIDs, preludeIDs, seed)
+	if gameDeck.Commitment() != proof.Commitment {
+		return ErrCardPoolChanged
+	}
+
+	g := game.NewGame(record.GameID, "", record.Settings)
+	g.SetDeck(gameDeck)
+	g.SetVPCardLookup(cards.NewVPCardLookupAdapter(cardRegistry))
+	return e.gameRepo.Create(ctx, g)
+}
+
+// toMessage rebuilds the message an input was sent as
+// A join that let the server pick the player ID claims the ID it got when the game was played
+func toMessage(gameID string, input game.ActionInput) (dto.WebSocketMessage, error) {
+	message := dto.WebSocketMessage{Type: dto.MessageType(input.Type), GameID: gameID}
+	if len(input.Payload) > 0 {
+		if err := json.Unmarshal(input.Payload, &message.Payload); err != nil {
+			return dto.WebSocketMessage{}, fmt.Errorf("invalid payload: %w", err)
+		}
+	}
+	if payload, ok := message.Payload.(map[string]interface{}); ok && isJoin(message.Type) {
+		if playerID, _ := payload["playerId"].(string); playerID == "" {
+			payload["playerId"] = input.PlayerID
+		}
+	}
+	return message, nil
+}
+
+func isJoin(messageType dto.MessageType) bool {
+	return messageType == dto.MessageTypeJoinGame || messageType == dto.MessageTypePlayerConnect
+}
+
+// sameEntries reports whether two runs of an input wrote the same log entries
+// Descriptions are not compared: archived logs may have had player names anonymized
+func sameEntries(recorded, replayed []game.StateDiff) bool {
+	if len(recorded) != len(replayed) {
+		return false
+	}
+	for i := range recorded {
+		if recorded[i].Source != replayed[i].Source ||
+			recorded[i].SourceType != replayed[i].SourceType ||
+			recorded[i].PlayerID != replayed[i].PlayerID {
+			return false
+		}
+	}
+	return true
+}
+
+// drainErrors empties a replay connection, returning the errors its handlers answered with
+func drainErrors(connection *core.Connection) []string {
+	var errs []string
+	for {
+		message, ok := connection.Receive()
+		if !ok {
+			return errs
+		}
+		if message.Type != dto.MessageTypeError {
+			continue
+		}
+		if payload, ok := message.Payload.(dto.ErrorPayload); ok {
+			errs = append(errs, payload.Message)
+		}
+	}
+}
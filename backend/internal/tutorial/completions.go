@@ -0,0 +1,49 @@
+package tutorial
+
+import "sync"
+
+// CompletionStore records which puzzles each account has solved
+// Accounts are identified by a client-chosen ID; there is no authentication
+type CompletionStore struct {
+	mu        sync.RWMutex
+	completed map[string]map[string]bool // accountID -> scenarioID -> solved
+}
+
+// NewCompletionStore creates an empty completion store
+func NewCompletionStore() *CompletionStore {
+	return &CompletionStore{
+		completed: make(map[string]map[string]bool),
+	}
+}
+
+// Record marks a scenario as solved by an account
+func (s *CompletionStore) Record(accountID, scenarioID string) {
+	if accountID == "" {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.completed[accountID] == nil {
+		s.completed[accountID] = make(map[string]bool)
+	}
+	s.completed[accountID][scenarioID] = true
+}
+
+// IsCompleted reports whether an account has solved a scenario
+func (s *CompletionStore) IsCompleted(accountID, scenarioID string) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.completed[accountID][scenarioID]
+}
+
+// Forget removes every puzzle an account has solved and returns how many were removed
+func (s *CompletionStore) Forget(accountID string) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	removed := len(s.completed[accountID])
+	delete(s.completed, accountID)
+	return removed
+}
@@ -0,0 +1,103 @@
+package tutorial
+
+import (
+	"terraforming-mars-backend/internal/game"
+	"terraforming-mars-backend/internal/game/shared"
+)
+
+// objectiveResources lists the resource names objectives may refer to
+var objectiveResources = map[string]bool{
+	string(shared.ResourceCredit):   true,
+	string(shared.ResourceSteel):    true,
+	string(shared.ResourceTitanium): true,
+	string(shared.ResourcePlant):    true,
+	string(shared.ResourceEnergy):   true,
+	string(shared.ResourceHeat):     true,
+}
+
+// IsSatisfied reports whether the objective holds for the player in the current game state
+func (o Objective) IsSatisfied(g *game.Game, playerID string) bool {
+	p, err := g.GetPlayer(playerID)
+	if err != nil {
+		return false
+	}
+
+	switch o.Type {
+	case ObjectivePlayCard:
+		return p.PlayedCards().Contains(o.CardID)
+
+	case ObjectiveResourceAtLeast:
+		return resourceAmount(p.Resources().Get(), o.Resource) >= o.Amount
+
+	case ObjectiveProductionAtLeast:
+		return productionAmount(p.Resources().Production(), o.Resource) >= o.Amount
+
+	case ObjectiveGlobalParameterAtLeast:
+		gp := g.GlobalParameters()
+		switch o.Parameter {
+		case ParameterTemperature:
+			return gp.Temperature() >= o.Amount
+		case ParameterOxygen:
+			return gp.Oxygen() >= o.Amount
+		case ParameterOceans:
+			return gp.Oceans() >= o.Amount
+		}
+		return false
+
+	case ObjectiveTerraformRatingAtLeast:
+		return p.Resources().TerraformRating() >= o.Amount
+
+	case ObjectiveTileCountAtLeast:
+		count := 0
+		for _, tile := range g.Board().Tiles() {
+			if tile.OccupiedBy == nil || tile.OwnerID == nil || *tile.OwnerID != playerID {
+				continue
+			}
+			if string(tile.OccupiedBy.Type) == o.TileType {
+				count++
+			}
+		}
+		return count >= o.Amount
+
+	case ObjectiveGenerationAtLeast:
+		return g.Generation() >= o.Amount
+	}
+
+	return false
+}
+
+func resourceAmount(r shared.Resources, resource string) int {
+	switch shared.ResourceType(resource) {
+	case shared.ResourceCredit:
+		return r.Credits
+	case shared.ResourceSteel:
+		return r.Steel
+	case shared.ResourceTitanium:
+		return r.Titanium
+	case shared.ResourcePlant:
+		return r.Plants
+	case shared.ResourceEnergy:
+		return r.Energy
+	case shared.ResourceHeat:
+		return r.Heat
+	}
+	return 0
+}
+
+func productionAmount(p shared.Production, resource string) int {
+	switch shared.ResourceType(resource) {
+	case shared.ResourceCredit:
+		return p.Credits
+	case shared.ResourceSteel:
+		return p.Steel
+	case shared.ResourceTitanium:
+		return p.Titanium
+	case shared.ResourcePlant:
+		return p.Plants
+	case shared.ResourceEnergy:
+		return p.Energy
+	case shared.ResourceHeat:
+		return p.Heat
+	}
+	return 0
+}
@@ -0,0 +1,71 @@
+package tutorial
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+)
+
+// LoadScenariosFromDir loads every *.json scenario file in dir
+// Each file holds a single scenario
+func LoadScenariosFromDir(dir string) ([]Scenario, error) {
+	paths, err := filepath.Glob(filepath.Join(dir, "*.json"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list scenario files: %w", err)
+	}
+
+	scenarios := make([]Scenario, 0, len(paths))
+	for _, path := range paths {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read scenario file: %w", err)
+		}
+
+		var scenario Scenario
+		if err := json.Unmarshal(data, &scenario); err != nil {
+			return nil, fmt.Errorf("failed to parse scenario %s: %w", filepath.Base(path), err)
+		}
+		if err := scenario.Validate(); err != nil {
+			return nil, fmt.Errorf("invalid scenario %s: %w", filepath.Base(path), err)
+		}
+
+		scenarios = append(scenarios, scenario)
+	}
+
+	return scenarios, nil
+}
+
+// Registry provides lookup of loaded scenarios
+type Registry struct {
+	scenarios map[string]Scenario
+}
+
+// NewRegistry creates a registry from a slice of scenarios
+func NewRegistry(scenarios []Scenario) *Registry {
+	scenarioMap := make(map[string]Scenario, len(scenarios))
+	for _, scenario := range scenarios {
+		scenarioMap[scenario.ID] = scenario
+	}
+	return &Registry{scenarios: scenarioMap}
+}
+
+// GetByID retrieves a scenario by its ID
+func (r *Registry) GetByID(scenarioID string) (*Scenario, error) {
+	scenario, exists := r.scenarios[scenarioID]
+	if !exists {
+		return nil, fmt.Errorf("scenario not found: %s", scenarioID)
+	}
+	return &scenario, nil
+}
+
+// List returns all scenarios ordered by ID
+func (r *Registry) List() []Scenario {
+	list := make([]Scenario, 0, len(r.scenarios))
+	for _, scenario := range r.scenarios {
+		list = append(list, scenario)
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i].ID < list[j].ID })
+	return list
+}
@@ -0,0 +1,135 @@
+package tutorial
+
+import (
+	"fmt"
+
+	"terraforming-mars-backend/internal/delivery/dto"
+)
+
+// ObjectiveType identifies how a tutorial step is validated against game state
+type ObjectiveType string
+
+const (
+	ObjectivePlayCard               ObjectiveType = "play-card"
+	ObjectiveResourceAtLeast        ObjectiveType = "resource-at-least"
+	ObjectiveProductionAtLeast      ObjectiveType = "production-at-least"
+	ObjectiveGlobalParameterAtLeast ObjectiveType = "global-parameter-at-least"
+	ObjectiveTerraformRatingAtLeast ObjectiveType = "terraform-rating-at-least"
+	ObjectiveTileCountAtLeast       ObjectiveType = "tile-count-at-least"
+	ObjectiveGenerationAtLeast      ObjectiveType = "generation-at-least"
+)
+
+// Global parameters an objective can target
+const (
+	ParameterTemperature = "temperature"
+	ParameterOxygen      = "oxygen"
+	ParameterOceans      = "oceans"
+)
+
+// Victory condition types for puzzles
+const (
+	VictoryTerraformRating = "terraform-rating"
+	VictoryPoints          = "victory-points"
+)
+
+// VictoryCondition turns a scenario into a puzzle: reach Target of Type by the end of ByGeneration
+type VictoryCondition struct {
+	Type         string `json:"type"`
+	Target       int    `json:"target"`
+	ByGeneration int    `json:"byGeneration"`
+}
+
+// Objective is the condition that completes a tutorial step
+// Only the fields relevant to Type are read
+type Objective struct {
+	Type      ObjectiveType `json:"type"`
+	CardID    string        `json:"cardId,omitempty"`    // play-card
+	Resource  string        `json:"resource,omitempty"`  // resource-at-least, production-at-least (credit, steel, ...)
+	Parameter string        `json:"parameter,omitempty"` // global-parameter-at-least (temperature, oxygen, oceans)
+	TileType  string        `json:"tileType,omitempty"`  // tile-count-at-least (city-tile, greenery-tile)
+	Amount    int           `json:"amount,omitempty"`
+}
+
+// Step is a single instruction shown to the player
+type Step struct {
+	ID          string    `json:"id"`
+	Instruction string    `json:"instruction"`
+	Objective   Objective `json:"objective"`
+}
+
+// Scenario is a scripted single-player setup with ordered objectives
+// Setup is applied through the demo setup flow, so it uses the same format as confirm-demo-setup
+// Scenarios with a Victory condition are puzzles; their steps are optional hints
+type Scenario struct {
+	ID          string                      `json:"id"`
+	Name        string                      `json:"name"`
+	Description string                      `json:"description"`
+	CardPacks   []string                    `json:"cardPacks,omitempty"`
+	Setup       dto.ConfirmDemoSetupRequest `json:"setup"`
+	Steps       []Step                      `json:"steps,omitempty"`
+	Victory     *VictoryCondition           `json:"victory,omitempty"`
+}
+
+// IsPuzzle reports whether the scenario has a victory condition
+func (s Scenario) IsPuzzle() bool {
+	return s.Victory != nil
+}
+
+// Validate checks that the scenario is well formed
+func (s Scenario) Validate() error {
+	if s.ID == "" {
+		return fmt.Errorf("scenario is missing an id")
+	}
+	if len(s.Steps) == 0 && s.Victory == nil {
+		return fmt.Errorf("scenario %s has no steps or victory condition", s.ID)
+	}
+	if s.Victory != nil {
+		if err := s.Victory.validate(); err != nil {
+			return fmt.Errorf("scenario %s victory: %w", s.ID, err)
+		}
+	}
+	for i, step := range s.Steps {
+		if err := step.Objective.validate(); err != nil {
+			return fmt.Errorf("scenario %s step %d: %w", s.ID, i+1, err)
+		}
+	}
+	return nil
+}
+
+func (v VictoryCondition) validate() error {
+	if v.Type != VictoryTerraformRating && v.Type != VictoryPoints {
+		return fmt.Errorf("unknown victory type: %q", v.Type)
+	}
+	if v.Target <= 0 {
+		return fmt.Errorf("target must be positive, got %d", v.Target)
+	}
+	if v.ByGeneration < 1 {
+		return fmt.Errorf("byGeneration must be at least 1, got %d", v.ByGeneration)
+	}
+	return nil
+}
+
+func (o Objective) validate() error {
+	switch o.Type {
+	case ObjectivePlayCard:
+		if o.CardID == "" {
+			return fmt.Errorf("%s objective requires cardId", o.Type)
+		}
+	case ObjectiveResourceAtLeast, ObjectiveProductionAtLeast:
+		if !objectiveResources[o.Resource] {
+			return fmt.Errorf("%s objective has unknown resource: %q", o.Type, o.Resource)
+		}
+	case ObjectiveGlobalParameterAtLeast:
+		if o.Parameter != ParameterTemperature && o.Parameter != ParameterOxygen && o.Parameter != ParameterOceans {
+			return fmt.Errorf("%s objective has unknown parameter: %q", o.Type, o.Parameter)
+		}
+	case ObjectiveTileCountAtLeast:
+		if o.TileType == "" {
+			return fmt.Errorf("%s objective requires tileType", o.Type)
+		}
+	case ObjectiveTerraformRatingAtLeast, ObjectiveGenerationAtLeast:
+	default:
+		return fmt.Errorf("unknown objective type: %q", o.Type)
+	}
+	return nil
+}
@@ -0,0 +1,127 @@
+package tutorial
+
+import (
+	"sync"
+
+	"terraforming-mars-backend/internal/cards"
+	"terraforming-mars-backend/internal/delivery/dto"
+	"terraforming-mars-backend/internal/game"
+)
+
+// Progress is a player's position within a tutorial scenario
+type Progress struct {
+	Scenario  Scenario
+	PlayerID  string
+	AccountID string // Optional; solved puzzles are recorded against it
+	StepIndex int    // Index of the current step; equals len(Steps) once completed
+	Completed bool
+
+	// Puzzle state (only when Scenario.Victory is set)
+	VictoryValue int
+	Outcome      PuzzleOutcome
+}
+
+// CurrentStep returns the step the player is working on, or nil when the tutorial is complete
+func (p Progress) CurrentStep() *Step {
+	if p.Completed || p.StepIndex >= len(p.Scenario.Steps) {
+		return nil
+	}
+	return &p.Scenario.Steps[p.StepIndex]
+}
+
+// ToPayload converts progress into the tutorial-progress message payload
+func (p Progress) ToPayload() dto.TutorialProgressPayload {
+	payload := dto.TutorialProgressPayload{
+		ScenarioID:   p.Scenario.ID,
+		ScenarioName: p.Scenario.Name,
+		StepIndex:    p.StepIndex,
+		TotalSteps:   len(p.Scenario.Steps),
+		Completed:    p.Completed,
+	}
+	if step := p.CurrentStep(); step != nil {
+		payload.StepID = step.ID
+		payload.Instruction = step.Instruction
+	}
+	if victory := p.Scenario.Victory; victory != nil {
+		payload.Puzzle = &dto.PuzzleStatusDto{
+			VictoryType:  victory.Type,
+			Target:       victory.Target,
+			ByGeneration: victory.ByGeneration,
+			Current:      p.VictoryValue,
+			Outcome:      string(p.Outcome),
+		}
+	}
+	return payload
+}
+
+// Tracker follows tutorial and puzzle progress for games started from a scenario
+type Tracker struct {
+	mu           sync.Mutex
+	games        map[string]*Progress // gameID -> progress
+	cardRegistry cards.CardRegistry
+	completions  *CompletionStore
+}
+
+// NewTracker creates an empty tracker
+func NewTracker(cardRegistry cards.CardRegistry, completions *CompletionStore) *Tracker {
+	return &Tracker{
+		games:        make(map[string]*Progress),
+		cardRegistry: cardRegistry,
+		completions:  completions,
+	}
+}
+
+// Start begins tracking a scenario for the player in a game
+func (t *Tracker) Start(gameID, playerID, accountID string, scenario Scenario) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	progress := &Progress{Scenario: scenario, PlayerID: playerID, AccountID: accountID}
+	if scenario.IsPuzzle() {
+		progress.Outcome = PuzzleInProgress
+	}
+	t.games[gameID] = progress
+}
+
+// Get returns the progress for a game, or false if the game is not a tutorial
+func (t *Tracker) Get(gameID string) (Progress, bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	progress, exists := t.games[gameID]
+	if !exists {
+		return Progress{}, false
+	}
+	return *progress, true
+}
+
+// Evaluate advances past every satisfied step in order and settles the puzzle outcome
+// Returns false if the game is not a tutorial
+func (t *Tracker) Evaluate(g *game.Game) (Progress, bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	progress, exists := t.games[g.ID()]
+	if !exists {
+		return Progress{}, false
+	}
+
+	for !progress.Completed {
+		step := progress.CurrentStep()
+		if step == nil || !step.Objective.IsSatisfied(g, progress.PlayerID) {
+			break
+		}
+		progress.StepIndex++
+		progress.Completed = progress.StepIndex >= len(progress.Scenario.Steps)
+	}
+
+	if victory := progress.Scenario.Victory; victory != nil && progress.Outcome == PuzzleInProgress {
+		progress.VictoryValue = victory.CurrentValue(g, progress.PlayerID, t.cardRegistry)
+		progress.Outcome = victory.Outcome(g, progress.VictoryValue)
+		if progress.Outcome == PuzzleSolved {
+			t.completions.Record(progress.AccountID, progress.Scenario.ID)
+		}
+	}
+
+	return *progress, true
+}
@@ -0,0 +1,50 @@
+package tutorial
+
+import (
+	"terraforming-mars-backend/internal/cards"
+	"terraforming-mars-backend/internal/game"
+	gamecards "terraforming-mars-backend/internal/game/cards"
+)
+
+// PuzzleOutcome is the result of a puzzle attempt
+type PuzzleOutcome string
+
+const (
+	PuzzleInProgress PuzzleOutcome = "in-progress"
+	PuzzleSolved     PuzzleOutcome = "solved"
+	PuzzleFailed     PuzzleOutcome = "failed"
+)
+
+// CurrentValue returns the player's current value for the victory metric
+func (v VictoryCondition) CurrentValue(g *game.Game, playerID string, cardRegistry cards.CardRegistry) int {
+	p, err := g.GetPlayer(playerID)
+	if err != nil {
+		return 0
+	}
+
+	if v.Type == VictoryTerraformRating {
+		return p.Resources().TerraformRating()
+	}
+
+	claimed := g.Milestones().ClaimedMilestones()
+	claimedInfo := make([]gamecards.ClaimedMilestoneInfo, len(claimed))
+	for i, m := range claimed {
+		claimedInfo[i] = gamecards.ClaimedMilestoneInfo{Type: string(m.Type), PlayerID: m.PlayerID}
+	}
+	fundedInfo := gamecards.ToFundedAwardInfo(g.Awards().FundedAwards())
+
+	breakdown := gamecards.CalculatePlayerVP(p, g.Board(), claimedInfo, fundedInfo, g.GetAllPlayers(), cardRegistry, g.Settings().RulesOptions.ScoringVP())
+	return breakdown.TotalVP
+}
+
+// Outcome decides the puzzle result from the current value
+// The target must be reached before the game moves past ByGeneration or ends
+func (v VictoryCondition) Outcome(g *game.Game, current int) PuzzleOutcome {
+	if current >= v.Target && g.Generation() <= v.ByGeneration {
+		return PuzzleSolved
+	}
+	if g.Generation() > v.ByGeneration || g.Status() == game.GameStatusCompleted {
+		return PuzzleFailed
+	}
+	return PuzzleInProgress
+}
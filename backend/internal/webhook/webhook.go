@@ -0,0 +1,238 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// Event names a game lifecycle event sent to webhooks
+type Event string
+
+const (
+	EventGameCreated  Event = "game.created"
+	EventGameStarted  Event = "game.started"
+	EventGameFinished Event = "game.finished"
+)
+
+// Format selects the body sent to an endpoint
+type Format string
+
+const (
+	// FormatJSON posts the full Payload
+	FormatJSON Format = "json"
+	// FormatDiscord posts the payload's summary as a Discord message, for Discord channel webhooks
+	FormatDiscord Format = "discord"
+)
+
+// SignatureHeader carries the hex HMAC-SHA256 of the body, keyed by the shared secret, as "sha256=<hex>"
+const SignatureHeader = "X-TM-Signature"
+
+// EventHeader names the event, so receivers can route without decoding the body
+const EventHeader = "X-TM-Event"
+
+const (
+	queueSize   = 256
+	maxAttempts = 3
+)
+
+// Endpoint is one registered webhook
+type Endpoint struct {
+	URL    string
+	Format Format
+}
+
+// ParseEndpoints reads a comma-separated list of webhook URLs
+// A "discord:" prefix posts Discord messages instead of JSON payloads,
+// e.g. "https://example.org/tm-hook,discord:https://discord.com/api/webhooks/..."
+func ParseEndpoints(raw string) ([]Endpoint, error) {
+	endpoints := make([]Endpoint, 0)
+	for _, entry := range strings.Split(raw, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		endpoint := Endpoint{URL: entry, Format: FormatJSON}
+		if rest, ok := strings.CutPrefix(entry, "discord:"); ok {
+			endpoint = Endpoint{URL: rest, Format: FormatDiscord}
+		}
+		parsed, err := url.Parse(endpoint.URL)
+		if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+			return nil, fmt.Errorf("invalid webhook URL %q", endpoint.URL)
+		}
+		endpoints = append(endpoints, endpoint)
+	}
+	return endpoints, nil
+}
+
+// Payload is the JSON body posted for a lifecycle event
+type Payload struct {
+	Event     Event         `json:"event"`
+	Timestamp time.Time     `json:"timestamp"`
+	Game      GameSummary   `json:"game"`
+	Results   []PlayerScore `json:"results,omitempty"` // game.finished only, by placement
+	Summary   string        `json:"summary"`           // One line for humans, e.g. for a chat post
+}
+
+// GameSummary describes the game an event is about
+type GameSummary struct {
+	ID         string   `json:"id"`
+	Status     string   `json:"status"`
+	Generation int      `json:"generation"`
+	MaxPlayers int      `json:"maxPlayers"`
+	CardPacks  []string `json:"cardPacks"`
+	Players    []string `json:"players"`           // Seated player names, in seat order
+	JoinURL    string   `json:"joinUrl,omitempty"` // Set for open lobbies when the server knows its public URL
+	WinnerName string   `json:"winnerName,omitempty"`
+	IsTie      bool     `json:"isTie,omitempty"`
+}
+
+// PlayerScore is one player's result in a finished game
+type PlayerScore struct {
+	PlayerName string `json:"playerName"`
+	TotalVP    int    `json:"totalVp"`
+	Placement  int    `json:"placement"`
+	IsWinner   bool   `json:"isWinner"`
+}
+
+// Sender posts payloads to every registered endpoint
+// Deliveries run on a background worker so a slow endpoint never holds up the server; each is retried
+// a few times with backoff, and payloads are dropped when the queue is full.
+type Sender struct {
+	endpoints []Endpoint
+	secret    []byte
+	client    *http.Client
+	logger    *zap.Logger
+	queue     chan Payload
+}
+
+// NewSender creates a sender for the endpoints; an empty secret leaves deliveries unsigned
+func NewSender(endpoints []Endpoint, secret string, logger *zap.Logger) *Sender {
+	return &Sender{
+		endpoints: endpoints,
+		secret:    []byte(secret),
+		client:    &http.Client{Timeout: 10 * time.Second},
+		logger:    logger,
+		queue:     make(chan Payload, queueSize),
+	}
+}
+
+// Enabled reports whether any endpoint is registered
+func (s *Sender) Enabled() bool {
+	return s != nil && len(s.endpoints) > 0
+}
+
+// Enqueue schedules a payload for delivery to every endpoint
+func (s *Sender) Enqueue(payload Payload) {
+	if !s.Enabled() {
+		return
+	}
+	select {
+	case s.queue <- payload:
+	default:
+		s.logger.Warn("Webhook queue full, dropping event",
+			zap.String("event", string(payload.Event)),
+			zap.String("game_id", payload.Game.ID))
+	}
+}
+
+// Run delivers queued payloads until the context is cancelled
+func (s *Sender) Run(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case payload := <-s.queue:
+			for _, endpoint := range s.endpoints {
+				s.deliver(ctx, endpoint, payload)
+			}
+		}
+	}
+}
+
+func (s *Sender) deliver(ctx context.Context, endpoint Endpoint, payload Payload) {
+	body, err := encodeBody(endpoint.Format, payload)
+	if err != nil {
+		s.logger.Error("Failed to encode webhook payload", zap.Error(err))
+		return
+	}
+
+	log := s.logger.With(
+		zap.String("event", string(payload.Event)),
+		zap.String("game_id", payload.Game.ID),
+		zap.String("webhook_host", hostOf(endpoint.URL)),
+	)
+	backoff := time.Second
+	for attempt := 1; attempt <= maxAttempts; attempt++ {
+		err = s.post(ctx, endpoint.URL, payload.Event, body)
+		if err == nil {
+			log.Debug("🪝 Webhook delivered", zap.Int("attempt", attempt))
+			return
+		}
+		if attempt == maxAttempts {
+			break
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(backoff):
+		}
+		backoff *= 4
+	}
+	log.Warn("Webhook delivery failed", zap.Int("attempts", maxAttempts), zap.Error(err))
+}
+
+func (s *Sender) post(ctx context.Context, endpointURL string, event Event, body []byte) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpointURL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(EventHeader, string(event))
+	if len(s.secret) > 0 {
+		req.Header.Set(SignatureHeader, Sign(s.secret, body))
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("webhook returned %s", resp.Status)
+	}
+	return nil
+}
+
+// Sign returns the signature header value for a body: "sha256=" and the hex HMAC-SHA256 keyed by the secret
+func Sign(secret, body []byte) string {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+func encodeBody(format Format, payload Payload) ([]byte, error) {
+	if format == FormatDiscord {
+		return json.Marshal(map[string]string{"content": payload.Summary})
+	}
+	return json.Marshal(payload)
+}
+
+// hostOf keeps webhook paths, which often hold tokens, out of the log
+func hostOf(endpointURL string) string {
+	parsed, err := url.Parse(endpointURL)
+	if err != nil {
+		return ""
+	}
+	return parsed.Host
+}
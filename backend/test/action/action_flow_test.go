@@ -226,8 +226,8 @@ func TestSkipWithOneActionAdvancesToNextPlayer(t *testing.T) {
 	testutil.AssertNoError(t, err, "Setting turn should succeed")
 
 	// Create skip action
-	finalScoringAction := gameaction.NewFinalScoringAction(repo, cardRegistry, logger)
-	skipAction := turnmgmt.NewSkipActionAction(repo, finalScoringAction, logger)
+	finalScoringAction := gameaction.NewFinalScoringAction(repo, cardRegistry, nil, logger)
+	skipAction := turnmgmt.NewSkipActionAction(repo, cardRegistry, finalScoringAction, logger)
 
 	// Player 1 SKIPs with 1 action
 	err = skipAction.Execute(context.Background(), testGame.ID(), player1ID)
@@ -283,8 +283,8 @@ func TestSkipGrantsUnlimitedActionsToLastNonPassedPlayer(t *testing.T) {
 	testutil.AssertNoError(t, err, "Setting turn should succeed")
 
 	// Create skip action
-	finalScoringAction := gameaction.NewFinalScoringAction(repo, cardRegistry, logger)
-	skipAction := turnmgmt.NewSkipActionAction(repo, finalScoringAction, logger)
+	finalScoringAction := gameaction.NewFinalScoringAction(repo, cardRegistry, nil, logger)
+	skipAction := turnmgmt.NewSkipActionAction(repo, cardRegistry, finalScoringAction, logger)
 
 	// Player 1 SKIPs
 	err = skipAction.Execute(context.Background(), testGame.ID(), player1ID)
@@ -305,8 +305,8 @@ func TestTurnOrderRotatesAfterGeneration(t *testing.T) {
 	testutil.AssertEqual(t, player2ID, initialTurnOrder[1], "Player 2 should be second in initial turn order")
 
 	// Both players pass to trigger production phase
-	finalScoringAction := gameaction.NewFinalScoringAction(repo, cardRegistry, logger)
-	skipAction := turnmgmt.NewSkipActionAction(repo, finalScoringAction, logger)
+	finalScoringAction := gameaction.NewFinalScoringAction(repo, cardRegistry, nil, logger)
+	skipAction := turnmgmt.NewSkipActionAction(repo, cardRegistry, finalScoringAction, logger)
 
 	// Player 1 passes (2 actions = pass)
 	err := skipAction.Execute(context.Background(), testGame.ID(), player1ID)
@@ -0,0 +1,80 @@
+package action_test
+
+import (
+	"context"
+	"testing"
+
+	"terraforming-mars-backend/internal/action/confirmation"
+	gameAction "terraforming-mars-backend/internal/action/game"
+	"terraforming-mars-backend/internal/analytics"
+	"terraforming-mars-backend/internal/delivery/dto"
+	"terraforming-mars-backend/internal/game"
+	"terraforming-mars-backend/internal/game/player"
+	"terraforming-mars-backend/test/testutil"
+)
+
+// createAnalyticsGame creates an active two-player game with the analytics setting
+func createAnalyticsGame(t *testing.T, repo game.GameRepository, id string, optIn bool) *game.Game {
+	t.Helper()
+	ctx := context.Background()
+	settings := game.GameSettings{MaxPlayers: 4, Analytics: optIn}
+	testGame := game.NewGame(id, "player-1", settings)
+	testutil.AssertNoError(t, repo.Create(ctx, testGame), "Failed to create game")
+	for _, playerID := range []string{"player-1", "player-2"} {
+		testutil.AssertNoError(t, testGame.AddPlayer(ctx, player.NewPlayer(testGame.EventBus(), testGame.ID(), playerID, playerID)), "Failed to add player")
+	}
+	testutil.AssertNoError(t, testGame.UpdateStatus(ctx, game.GameStatusActive), "Failed to start game")
+	return testGame
+}
+
+func TestAnalytics_ResearchPicksOnlyFromOptedInGames(t *testing.T) {
+	ctx := context.Background()
+	repo := game.NewInMemoryGameRepository()
+	store := analytics.NewStore()
+	confirmAction := confirmation.NewConfirmProductionCardsAction(repo, testutil.CreateTestCardRegistry(), store, testutil.TestLogger())
+	forceAction := gameAction.NewForceAdvancePhaseAction(repo, nil, confirmAction, testutil.TestLogger())
+
+	optedIn := createAnalyticsGame(t, repo, "opted-in", true)
+	enterResearch(t, optedIn)
+	testutil.AssertNoError(t, confirmAction.Execute(ctx, optedIn.ID(), "player-2", nil), "Player 2 buys nothing")
+	testutil.AssertNoError(t, forceAction.Execute(ctx, optedIn.ID(), "player-1", true), "Host forces the phase")
+
+	optedOut := createAnalyticsGame(t, repo, "opted-out", false)
+	enterResearch(t, optedOut)
+	testutil.AssertNoError(t, confirmAction.Execute(ctx, optedOut.ID(), "player-2", nil), "Player 2 buys nothing")
+
+	cards := store.Cards()
+	testutil.AssertEqual(t, 2, len(cards), "Both offered cards are counted")
+	testutil.AssertEqual(t, "card-a", cards[0].CardID, "Cards are ordered by ID")
+	testutil.AssertEqual(t, 1, cards[0].Offered, "Forced skips and opted-out games are not counted")
+	testutil.AssertEqual(t, 0, cards[0].Bought, "Nothing was bought")
+}
+
+func TestAnalytics_FinalScoringRecordsCorporationsAndPlayedCards(t *testing.T) {
+	ctx := context.Background()
+	repo := game.NewInMemoryGameRepository()
+	store := analytics.NewStore()
+	finalScoring := gameAction.NewFinalScoringAction(repo, testutil.CreateTestCardRegistry(), store, testutil.TestLogger())
+
+	testGame := createAnalyticsGame(t, repo, "finished", true)
+	p1, _ := testGame.GetPlayer("player-1")
+	p2, _ := testGame.GetPlayer("player-2")
+	p1.SetCorporationID("corp-a")
+	p2.SetCorporationID("corp-b")
+	p1.PlayedCards().AddCard("card-x", "Card X", "automated", nil)
+	p2.PlayedCards().AddCard("card-x", "Card X", "automated", nil)
+	p1.Resources().SetTerraformRating(30)
+
+	testutil.AssertNoError(t, finalScoring.Execute(ctx, testGame.ID()), "Final scoring should succeed")
+
+	response := dto.ToCardAnalyticsResponse(store)
+	testutil.AssertEqual(t, 1, response.Games, "One finished game")
+	testutil.AssertEqual(t, 2, len(response.Corporations), "Both corporations are counted")
+	testutil.AssertEqual(t, 1, response.Corporations[0].Wins, "Corp A won")
+	testutil.AssertEqual(t, 1.0, response.Corporations[0].WinRate, "Corp A win rate")
+	testutil.AssertEqual(t, 0, response.Corporations[1].Wins, "Corp B lost")
+	testutil.AssertEqual(t, 1, len(response.Cards), "One played card")
+	testutil.AssertEqual(t, 2, response.Cards[0].Played, "Played by both seats")
+	testutil.AssertEqual(t, 0.5, response.Cards[0].WinRate, "Half of its plays won")
+	testutil.AssertEqual(t, 0.0, response.Cards[0].PickRate, "Never offered in research")
+}
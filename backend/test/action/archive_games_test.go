@@ -0,0 +1,60 @@
+package action_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	gameAction "terraforming-mars-backend/internal/action/game"
+	"terraforming-mars-backend/internal/archive"
+	"terraforming-mars-backend/internal/game"
+	"terraforming-mars-backend/test/testutil"
+)
+
+func TestArchiveGames_MovesFinishedGamesOutOfTheHotStore(t *testing.T) {
+	ctx := context.Background()
+	finished, repo := testutil.CreateTestGameWithPlayers(t, 2, testutil.NewMockBroadcaster())
+	testutil.StartTestGame(t, finished)
+	stateRepo := game.NewInMemoryGameStateRepository()
+
+	_, err := stateRepo.WriteFull(ctx, finished.ID(), finished, "Standard Project: City", game.SourceTypeStandardProject,
+		"player-1", "Built city", nil, nil, nil)
+	testutil.AssertNoError(t, err, "Write should succeed")
+
+	scores := []game.FinalScore{
+		{PlayerID: "player-1", PlayerName: "Player A", Placement: 1, IsWinner: true, Breakdown: game.VPBreakdown{TerraformRating: 30, TotalVP: 42}},
+		{PlayerID: "player-2", PlayerName: "Player B", Placement: 2, Breakdown: game.VPBreakdown{TerraformRating: 25, TotalVP: 31}},
+	}
+	testutil.AssertNoError(t, finished.SetFinalScores(ctx, scores, "player-1", false), "Failed to set scores")
+	testutil.AssertNoError(t, finished.UpdateStatus(ctx, game.GameStatusCompleted), "Failed to complete game")
+
+	running := game.NewGame("game-running", "", game.GameSettings{MaxPlayers: 4})
+	testutil.AssertNoError(t, repo.Create(ctx, running), "Failed to create running game")
+
+	gameArchive, err := archive.Open(ctx, archive.NewMemoryStore())
+	testutil.AssertNoError(t, err, "Archive should open")
+	archiveAction := gameAction.NewArchiveGamesAction(repo, stateRepo, gameArchive, time.Hour, testutil.TestLogger())
+
+	archived := archiveAction.ArchiveFinished(ctx, time.Now())
+	testutil.AssertEqual(t, 0, len(archived), "Recently finished games stay in the hot store")
+
+	archived = archiveAction.ArchiveFinished(ctx, time.Now().Add(2*time.Hour))
+	testutil.AssertEqual(t, 1, len(archived), "Only the finished game is archived")
+	testutil.AssertEqual(t, finished.ID(), archived[0], "The finished game is archived")
+
+	testutil.AssertFalse(t, repo.Exists(ctx, finished.ID()), "Archived game leaves the game repository")
+	testutil.AssertTrue(t, repo.Exists(ctx, running.ID()), "Running games are untouched")
+	_, err = stateRepo.GetDiff(ctx, finished.ID())
+	testutil.AssertTrue(t, errors.Is(err, game.ErrGameNotFound), "Archived game's history leaves the state repository")
+
+	record, err := gameArchive.Load(ctx, finished.ID())
+	testutil.AssertNoError(t, err, "Archived record loads")
+	testutil.AssertEqual(t, 2, len(record.Players), "Every seat is recorded")
+	testutil.AssertEqual(t, "player-1", record.WinnerID, "Winner is recorded")
+	testutil.AssertEqual(t, 1, len(record.Log), "The full log is recorded")
+
+	stats := gameArchive.PlayerStats("Player A")
+	testutil.AssertEqual(t, 1, stats.Wins, "History queries see the archived game")
+	testutil.AssertEqual(t, 42, stats.BestVP, "Final scores are indexed")
+}
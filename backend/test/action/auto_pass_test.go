@@ -0,0 +1,74 @@
+package action_test
+
+import (
+	"context"
+	"testing"
+
+	gameAction "terraforming-mars-backend/internal/action/game"
+	turnAction "terraforming-mars-backend/internal/action/turn_management"
+	"terraforming-mars-backend/internal/game"
+	"terraforming-mars-backend/test/testutil"
+)
+
+func newAutoPassAction(repo game.GameRepository) *turnAction.AutoPassAction {
+	logger := testutil.TestLogger()
+	cardRegistry := testutil.CreateTestCardRegistry()
+	finalScoring := gameAction.NewFinalScoringAction(repo, cardRegistry, nil, logger)
+	return turnAction.NewAutoPassAction(repo, turnAction.NewSkipActionAction(repo, cardRegistry, finalScoring, logger), cardRegistry, logger)
+}
+
+func TestAutoPassAction_PassesPlayerWithNoLegalAction(t *testing.T) {
+	testGame, repo := testutil.CreateTestGameWithPlayers(t, 2, testutil.NewMockBroadcaster())
+	testutil.StartTestGame(t, testGame)
+	ctx := context.Background()
+
+	first := testGame.CurrentTurn().PlayerID()
+	p, _ := testGame.GetPlayer(first)
+	testutil.SetPlayerCredits(ctx, p, 0)
+
+	err := gameAction.NewSetPreferencesAction(repo, testutil.TestLogger()).Execute(ctx, testGame.ID(), first, gameAction.PlayerPreferences{AutoPass: true})
+	testutil.AssertNoError(t, err, "Preference should be stored")
+	testutil.AssertTrue(t, p.AutoPass(), "Player should have auto-pass enabled")
+
+	passed, err := newAutoPassAction(repo).Execute(ctx, testGame.ID())
+	testutil.AssertNoError(t, err, "Auto-pass should succeed")
+	testutil.AssertEqual(t, 1, len(passed), "Only the player who opted in is passed")
+	testutil.AssertTrue(t, p.HasPassed(), "Player should have passed")
+	testutil.AssertTrue(t, testGame.CurrentTurn().PlayerID() != first, "Turn should move on")
+}
+
+func TestAutoPassAction_KeepsPlayersWhoCanAct(t *testing.T) {
+	testGame, repo := testutil.CreateTestGameWithPlayers(t, 2, testutil.NewMockBroadcaster())
+	testutil.StartTestGame(t, testGame)
+	ctx := context.Background()
+
+	p, _ := testGame.GetPlayer(testGame.CurrentTurn().PlayerID())
+	p.SetAutoPass(true)
+	testutil.SetPlayerCredits(ctx, p, 30)
+
+	passed, err := newAutoPassAction(repo).Execute(ctx, testGame.ID())
+	testutil.AssertNoError(t, err, "Auto-pass should succeed")
+	testutil.AssertEqual(t, 0, len(passed), "Player who can afford a standard project keeps the turn")
+
+	p.SetAutoPass(false)
+	testutil.SetPlayerCredits(ctx, p, 0)
+	passed, err = newAutoPassAction(repo).Execute(ctx, testGame.ID())
+	testutil.AssertNoError(t, err, "Auto-pass should succeed")
+	testutil.AssertEqual(t, 0, len(passed), "Players without the preference are never passed")
+}
+
+func TestAutoPassAction_OnlyAtTurnStart(t *testing.T) {
+	testGame, repo := testutil.CreateTestGameWithPlayers(t, 2, testutil.NewMockBroadcaster())
+	testutil.StartTestGame(t, testGame)
+	ctx := context.Background()
+
+	playerID := testGame.CurrentTurn().PlayerID()
+	p, _ := testGame.GetPlayer(playerID)
+	p.SetAutoPass(true)
+	testutil.SetPlayerCredits(ctx, p, 0)
+	testutil.AssertNoError(t, testGame.SetCurrentTurn(ctx, playerID, 1), "Failed to set turn")
+
+	passed, err := newAutoPassAction(repo).Execute(ctx, testGame.ID())
+	testutil.AssertNoError(t, err, "Auto-pass should succeed")
+	testutil.AssertEqual(t, 0, len(passed), "A turn already in progress is left to the player")
+}
@@ -0,0 +1,77 @@
+package action_test
+
+import (
+	"context"
+	"testing"
+
+	"terraforming-mars-backend/internal/action/admin"
+	awardAction "terraforming-mars-backend/internal/action/award"
+	"terraforming-mars-backend/internal/game"
+	gamecards "terraforming-mars-backend/internal/game/cards"
+	"terraforming-mars-backend/internal/game/shared"
+	"terraforming-mars-backend/test/testutil"
+)
+
+func TestFundAward_FreezesStandingsWhenToggledOn(t *testing.T) {
+	g, repo := testutil.CreateTestGameWithPlayers(t, 2, testutil.NewMockBroadcaster())
+	testutil.StartTestGame(t, g)
+	registry := testutil.CreateTestCardRegistry()
+	logger := testutil.TestLogger()
+	ctx := context.Background()
+
+	first, _ := g.GetPlayer("player-1")
+	second, _ := g.GetPlayer("player-2")
+	testutil.SetPlayerCredits(ctx, first, 50)
+	testutil.SetPlayerHeat(ctx, first, 8)
+	testutil.SetPlayerHeat(ctx, second, 3)
+
+	err := admin.NewSetAwardFreezeAction(repo, logger).Execute(ctx, g.ID(), true)
+	testutil.AssertNoError(t, err, "Toggle should apply")
+
+	fund := awardAction.NewFundAwardAction(repo, registry, game.NewInMemoryGameStateRepository(), logger)
+	testutil.AssertNoError(t, fund.Execute(ctx, g.ID(), first.ID(), string(shared.AwardThermalist)), "Funding should succeed")
+
+	// The standings change after funding; the frozen ones still decide the award
+	testutil.SetPlayerHeat(ctx, first, 0)
+	testutil.SetPlayerHeat(ctx, second, 12)
+
+	funded := g.Awards().FundedAwards()
+	testutil.AssertEqual(t, 2, len(funded[0].StandingsAtFunding), "Standings are recorded for every player")
+	testutil.AssertEqual(t, first.ID(), funded[0].StandingsAtFunding[0].PlayerID, "Funder led when funding")
+
+	awards := gamecards.ToFundedAwardInfo(funded)
+	scoring := g.Settings().RulesOptions.ScoringVP()
+	firstVP := gamecards.CalculatePlayerVP(first, g.Board(), nil, awards, g.GetAllPlayers(), registry, scoring).AwardVP
+	secondVP := gamecards.CalculatePlayerVP(second, g.Board(), nil, awards, g.GetAllPlayers(), registry, scoring).AwardVP
+	testutil.AssertEqual(t, scoring.AwardFirstPlace, firstVP, "Frozen leader keeps first place")
+	testutil.AssertEqual(t, scoring.AwardSecondPlace, secondVP, "Frozen runner-up keeps second place")
+
+	inspection, err := admin.NewInspectAwardsAction(repo, registry, logger).Execute(ctx, g.ID())
+	testutil.AssertNoError(t, err, "Inspection should succeed")
+	testutil.AssertTrue(t, inspection.FreezeAtFunding, "Inspection reports the toggle")
+	for _, award := range inspection.Awards {
+		if award.Type == shared.AwardThermalist {
+			testutil.AssertEqual(t, first.ID(), award.StandingsAtFunding[0].PlayerID, "Frozen standings are shown")
+			testutil.AssertEqual(t, second.ID(), award.CurrentStandings[0].PlayerID, "Current standings are shown next to them")
+		} else {
+			testutil.AssertTrue(t, award.StandingsAtFunding == nil, "Unfunded awards have nothing frozen")
+		}
+	}
+}
+
+func TestFundAward_ScoresAtGameEndByDefault(t *testing.T) {
+	g, repo := testutil.CreateTestGameWithPlayers(t, 2, testutil.NewMockBroadcaster())
+	testutil.StartTestGame(t, g)
+	registry := testutil.CreateTestCardRegistry()
+	ctx := context.Background()
+
+	first, _ := g.GetPlayer("player-1")
+	testutil.SetPlayerCredits(ctx, first, 50)
+
+	fund := awardAction.NewFundAwardAction(repo, registry, game.NewInMemoryGameStateRepository(), testutil.TestLogger())
+	testutil.AssertNoError(t, fund.Execute(ctx, g.ID(), first.ID(), string(shared.AwardMiner)), "Funding should succeed")
+
+	funded := g.Awards().FundedAwards()
+	testutil.AssertTrue(t, funded[0].StandingsAtFunding == nil, "Official rules record nothing at funding")
+	testutil.AssertError(t, g.Awards().FreezeStandings(shared.AwardBanker, nil), "Unfunded awards cannot be frozen")
+}
@@ -0,0 +1,101 @@
+package action_test
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+
+	"terraforming-mars-backend/internal/action"
+	cardAction "terraforming-mars-backend/internal/action/card"
+	"terraforming-mars-backend/internal/cards"
+	"terraforming-mars-backend/internal/game"
+	gamecards "terraforming-mars-backend/internal/game/cards"
+	"terraforming-mars-backend/internal/game/deck"
+	"terraforming-mars-backend/internal/game/player"
+	"terraforming-mars-backend/internal/game/shared"
+	"terraforming-mars-backend/test/testutil"
+)
+
+// cardDiscardRegistry holds a card that costs a discard from hand, and filler cards to discard
+func cardDiscardRegistry() cards.CardRegistry {
+	return cards.NewInMemoryCardRegistry([]gamecards.Card{
+		{
+			ID:   "card-discard-for-plants",
+			Name: "Discard For Plants",
+			Type: gamecards.CardTypeAutomated,
+			Pack: "base",
+			Cost: 1,
+			Behaviors: []shared.CardBehavior{{
+				Triggers: []shared.Trigger{{Type: shared.TriggerTypeAuto}},
+				Inputs:   []shared.ResourceCondition{{ResourceType: shared.ResourceCardDiscard, Amount: 1, Target: "self-player"}},
+				Outputs:  []shared.ResourceCondition{{ResourceType: shared.ResourcePlant, Amount: 3, Target: "self-player"}},
+			}},
+		},
+		{ID: "card-filler-1", Name: "Filler 1", Type: gamecards.CardTypeAutomated, Pack: "base"},
+		{ID: "card-filler-2", Name: "Filler 2", Type: gamecards.CardTypeAutomated, Pack: "base"},
+	})
+}
+
+// setupCardDiscardGame starts an action phase turn for player-1 holding the discard card
+func setupCardDiscardGame(t *testing.T) (*game.Game, game.GameRepository, *player.Player) {
+	t.Helper()
+	testGame, repo := testutil.CreateTestGameWithPlayers(t, 2, testutil.NewMockBroadcaster())
+	ctx := context.Background()
+
+	p, _ := testGame.GetPlayer("player-1")
+	testutil.AssertNoError(t, testGame.UpdateStatus(ctx, game.GameStatusActive), "Failed to start game")
+	testutil.AssertNoError(t, testGame.UpdatePhase(ctx, game.GamePhaseAction), "Failed to enter action phase")
+	testutil.AssertNoError(t, testGame.SetCurrentTurn(ctx, p.ID(), 2), "Failed to set turn")
+	testGame.SetDeck(deck.NewDeck(testGame.ID(), []string{}, nil, nil))
+	p.Resources().Add(map[shared.ResourceType]int{shared.ResourceCredit: 10})
+	p.Hand().AddCard("card-discard-for-plants")
+	return testGame, repo, p
+}
+
+func TestPlayCard_DiscardsTheChosenCardFromHand(t *testing.T) {
+	testGame, repo, p := setupCardDiscardGame(t)
+	ctx := context.Background()
+	p.Hand().AddCard("card-filler-1")
+	p.Hand().AddCard("card-filler-2")
+
+	playCard := cardAction.NewPlayCardAction(repo, cardDiscardRegistry(), nil, testutil.TestLogger())
+	err := playCard.ExecuteWithOptions(ctx, testGame.ID(), p.ID(), "card-discard-for-plants", cardAction.PaymentRequest{Credits: 1},
+		nil, nil, nil, cardAction.PlayOptions{DiscardCardIDs: []string{"card-filler-2"}})
+	testutil.AssertNoError(t, err, "Failed to play the card")
+
+	testutil.AssertTrue(t, slices.Equal([]string{"card-filler-1"}, p.Hand().Cards()), "Only the chosen card leaves the hand")
+	testutil.AssertTrue(t, slices.Contains(testGame.Deck().DiscardPile(), "card-filler-2"), "The chosen card is discarded")
+	testutil.AssertEqual(t, 3, p.Resources().Get().Plants, "The card's outputs are applied")
+}
+
+func TestPlayCard_DiscardSelectionMustComeFromHand(t *testing.T) {
+	testGame, repo, p := setupCardDiscardGame(t)
+	ctx := context.Background()
+	p.Hand().AddCard("card-filler-1")
+	playCard := cardAction.NewPlayCardAction(repo, cardDiscardRegistry(), nil, testutil.TestLogger())
+
+	err := playCard.Execute(ctx, testGame.ID(), p.ID(), "card-discard-for-plants", cardAction.PaymentRequest{Credits: 1}, nil, nil, nil)
+	testutil.AssertTrue(t, errors.Is(err, gamecards.ErrDiscardSelectionRequired), "A discard selection is required")
+
+	err = playCard.ExecuteWithOptions(ctx, testGame.ID(), p.ID(), "card-discard-for-plants", cardAction.PaymentRequest{Credits: 1},
+		nil, nil, nil, cardAction.PlayOptions{DiscardCardIDs: []string{"card-filler-2"}})
+	testutil.AssertError(t, err, "A card not in hand cannot be discarded")
+	testutil.AssertTrue(t, p.Hand().HasCard("card-discard-for-plants"), "The card stays in hand after a failed play")
+	testutil.AssertEqual(t, 0, p.Resources().Get().Plants, "No outputs are applied after a failed play")
+}
+
+func TestCalculatePlayerCardState_DiscardInputs(t *testing.T) {
+	testGame, _, p := setupCardDiscardGame(t)
+	registry := cardDiscardRegistry()
+	card, _ := registry.GetByID("card-discard-for-plants")
+
+	state := action.CalculatePlayerCardState(card, p, testGame, registry)
+	testutil.AssertFalse(t, state.Available(), "The card itself cannot pay its discard")
+	testutil.AssertEqual(t, player.ErrorCodeNoCardsInHand, state.Errors[0].Code, "Missing discards are reported")
+
+	p.Hand().AddCard("card-filler-1")
+	state = action.CalculatePlayerCardState(card, p, testGame, registry)
+	testutil.AssertTrue(t, state.Available(), "One other card pays the discard")
+	testutil.AssertEqual(t, player.WarningCodeDiscardSelectionRequired, state.Warnings[0].Code, "The player is asked to choose")
+}
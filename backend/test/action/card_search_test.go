@@ -0,0 +1,78 @@
+package action_test
+
+import (
+	"context"
+	"encoding/json"
+	"slices"
+	"testing"
+
+	cardAction "terraforming-mars-backend/internal/action/card"
+	"terraforming-mars-backend/internal/cards"
+	"terraforming-mars-backend/internal/delivery/dto"
+	"terraforming-mars-backend/internal/game"
+	gamecards "terraforming-mars-backend/internal/game/cards"
+	"terraforming-mars-backend/internal/game/deck"
+	"terraforming-mars-backend/internal/game/shared"
+	"terraforming-mars-backend/test/testutil"
+)
+
+// cardSearchRegistry holds a card that searches the deck for a space card, and the cards it can find
+func cardSearchRegistry() cards.CardRegistry {
+	return cards.NewInMemoryCardRegistry([]gamecards.Card{
+		{
+			ID:   "card-space-search",
+			Name: "Space Search",
+			Type: gamecards.CardTypeEvent,
+			Pack: "base",
+			Cost: 1,
+			Behaviors: []shared.CardBehavior{{
+				Triggers: []shared.Trigger{{Type: shared.TriggerTypeAuto}},
+				Outputs: []shared.ResourceCondition{{
+					ResourceType: shared.ResourceCardSearch,
+					Amount:       1,
+					Target:       "self-player",
+					Selectors:    []shared.Selector{{Tags: []shared.CardTag{shared.TagSpace}}},
+				}},
+			}},
+		},
+		{ID: "card-space-1", Name: "Space 1", Type: gamecards.CardTypeAutomated, Pack: "base", Tags: []shared.CardTag{shared.TagSpace}},
+		{ID: "card-space-2", Name: "Space 2", Type: gamecards.CardTypeAutomated, Pack: "base", Tags: []shared.CardTag{shared.TagSpace}},
+		{ID: "card-plant", Name: "Plant", Type: gamecards.CardTypeAutomated, Pack: "base", Tags: []shared.CardTag{shared.TagPlant}},
+	})
+}
+
+func TestPlayCard_RevealsUntilEnoughCardsMatch(t *testing.T) {
+	testGame, repo := testutil.CreateTestGameWithPlayers(t, 2, testutil.NewMockBroadcaster())
+	registry := cardSearchRegistry()
+	ctx := context.Background()
+
+	p, _ := testGame.GetPlayer("player-1")
+	other, _ := testGame.GetPlayer("player-2")
+	testutil.AssertNoError(t, testGame.UpdateStatus(ctx, game.GameStatusActive), "Failed to start game")
+	testutil.AssertNoError(t, testGame.UpdatePhase(ctx, game.GamePhaseAction), "Failed to enter action phase")
+	testutil.AssertNoError(t, testGame.SetCurrentTurn(ctx, p.ID(), 2), "Failed to set turn")
+	testGame.SetDeck(deck.NewDeck(testGame.ID(), []string{"card-plant", "card-space-1", "card-space-2"}, nil, nil))
+	p.Resources().Add(map[shared.ResourceType]int{shared.ResourceCredit: 10})
+	p.Hand().AddCard("card-space-search")
+
+	playCard := cardAction.NewPlayCardAction(repo, registry, nil, testutil.TestLogger())
+	err := playCard.Execute(ctx, testGame.ID(), p.ID(), "card-space-search", cardAction.PaymentRequest{Credits: 1}, nil, nil, nil)
+	testutil.AssertNoError(t, err, "Failed to play the search card")
+
+	testutil.AssertTrue(t, p.Selection().GetPendingCardDrawSelection() == nil, "Nothing is left to choose")
+	testutil.AssertTrue(t, p.Hand().HasCard("card-space-1"), "The first space card goes to hand")
+	testutil.AssertFalse(t, p.Hand().HasCard("card-space-2"), "Revealing stops at the first match")
+	testutil.AssertTrue(t, slices.Equal([]string{"card-plant"}, testGame.Deck().DiscardPile()), "The card revealed on the way is discarded")
+	testutil.AssertTrue(t, slices.Equal([]string{"card-space-2"}, testGame.Deck().ProjectCards()), "The rest of the deck is untouched")
+
+	otherView, err := json.Marshal(dto.ToGameDto(testGame, registry, other.ID()))
+	testutil.AssertNoError(t, err, "Failed to serialize the other player's view")
+	testutil.AssertNoHiddenCards(t, testGame, other.ID(), otherView, "Other players do not see the taken card")
+
+	view := dto.ToGameDto(testGame, registry, p.ID())
+	found := false
+	for _, card := range view.CurrentPlayer.Cards {
+		found = found || card.ID == "card-space-1"
+	}
+	testutil.AssertTrue(t, found, "The taken card is shown in the player's hand")
+}
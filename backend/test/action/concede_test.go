@@ -0,0 +1,92 @@
+package action_test
+
+import (
+	"context"
+	"testing"
+
+	gameAction "terraforming-mars-backend/internal/action/game"
+	turnAction "terraforming-mars-backend/internal/action/turn_management"
+	"terraforming-mars-backend/internal/game"
+	"terraforming-mars-backend/internal/game/shared"
+	"terraforming-mars-backend/test/testutil"
+)
+
+func newConcedeAction(repo game.GameRepository) *turnAction.ConcedeAction {
+	logger := testutil.TestLogger()
+	cardRegistry := testutil.CreateTestCardRegistry()
+	finalScoring := gameAction.NewFinalScoringAction(repo, cardRegistry, nil, logger)
+	return turnAction.NewConcedeAction(repo, turnAction.NewSkipActionAction(repo, cardRegistry, finalScoring, logger))
+}
+
+func TestConcedeAction_SoloGameIsAbandoned(t *testing.T) {
+	testGame, repo := testutil.CreateTestGameWithPlayers(t, 1, testutil.NewMockBroadcaster())
+	testutil.StartTestGame(t, testGame)
+
+	err := newConcedeAction(repo).Execute(context.Background(), testGame.ID(), "player-1")
+	testutil.AssertNoError(t, err, "Solo player should be able to concede")
+	testutil.AssertEqual(t, game.GameStatusAbandoned, testGame.Status(), "Solo concession abandons the game")
+}
+
+func TestConcedeAction_TwoPlayerGameEndsWithOpponentWinning(t *testing.T) {
+	testGame, repo := testutil.CreateTestGameWithPlayers(t, 2, testutil.NewMockBroadcaster())
+	testutil.StartTestGame(t, testGame)
+
+	loser, _ := testGame.GetPlayer("player-1")
+	loser.Resources().SetTerraformRating(60)
+
+	err := newConcedeAction(repo).Execute(context.Background(), testGame.ID(), "player-1")
+	testutil.AssertNoError(t, err, "Player should be able to concede")
+	testutil.AssertEqual(t, game.GameStatusCompleted, testGame.Status(), "Two-player concession ends the game")
+	testutil.AssertEqual(t, "player-2", testGame.GetWinnerID(), "Opponent wins regardless of score")
+	testutil.AssertEqual(t, 1, len(testGame.ConcededPlayers()), "Concession should be recorded")
+}
+
+func TestConcedeAction_MultiplayerRedistributesProductionAndPassesTurn(t *testing.T) {
+	testGame, repo := testutil.CreateTestGameWithPlayers(t, 3, testutil.NewMockBroadcaster())
+	testutil.StartTestGame(t, testGame)
+	ctx := context.Background()
+
+	turnOrder := testGame.TurnOrder()
+	conceding := turnOrder[0]
+	testutil.AssertNoError(t, testGame.SetCurrentTurn(ctx, conceding, 2), "Failed to set turn")
+
+	p, _ := testGame.GetPlayer(conceding)
+	p.Resources().SetProduction(shared.Production{Credits: 3, Heat: 2})
+	before := make(map[string]shared.Production)
+	for _, id := range turnOrder[1:] {
+		other, _ := testGame.GetPlayer(id)
+		before[id] = other.Resources().Production()
+	}
+
+	err := newConcedeAction(repo).Execute(ctx, testGame.ID(), conceding)
+	testutil.AssertNoError(t, err, "Player should be able to concede")
+	testutil.AssertEqual(t, game.GameStatusActive, testGame.Status(), "Game continues with the remaining players")
+	testutil.AssertEqual(t, 2, len(testGame.TurnOrder()), "Conceding player leaves the turn order")
+	testutil.AssertEqual(t, turnOrder[1], testGame.CurrentTurn().PlayerID(), "Turn passes to the next seat")
+	testutil.AssertTrue(t, testGame.HostPlayerID() != conceding, "Host moves to a remaining player")
+
+	// 3 M€ then 2 heat are dealt one step at a time starting with the next seat
+	next, _ := testGame.GetPlayer(turnOrder[1])
+	after, _ := testGame.GetPlayer(turnOrder[2])
+	testutil.AssertEqual(t, before[turnOrder[1]].Credits+2, next.Resources().Production().Credits, "Next seat gets two M€ production")
+	testutil.AssertEqual(t, before[turnOrder[1]].Heat+1, next.Resources().Production().Heat, "Next seat gets one heat production")
+	testutil.AssertEqual(t, before[turnOrder[2]].Credits+1, after.Resources().Production().Credits, "Following seat gets one M€ production")
+	testutil.AssertEqual(t, before[turnOrder[2]].Heat+1, after.Resources().Production().Heat, "Following seat gets one heat production")
+}
+
+func TestVoteAbandonAction_RequiresMajority(t *testing.T) {
+	testGame, repo := testutil.CreateTestGameWithPlayers(t, 4, testutil.NewMockBroadcaster())
+	testutil.StartTestGame(t, testGame)
+	ctx := context.Background()
+	action := gameAction.NewVoteAbandonAction(repo, testutil.TestLogger())
+
+	testutil.AssertNoError(t, action.Execute(ctx, testGame.ID(), "player-1"), "Vote should be recorded")
+	testutil.AssertNoError(t, action.Execute(ctx, testGame.ID(), "player-2"), "Vote should be recorded")
+	testutil.AssertEqual(t, game.GameStatusActive, testGame.Status(), "Half of the table is not a majority")
+
+	testutil.AssertNoError(t, action.Execute(ctx, testGame.ID(), "player-3"), "Vote should be recorded")
+	testutil.AssertEqual(t, game.GameStatusAbandoned, testGame.Status(), "Majority abandons the game")
+
+	err := action.Execute(ctx, testGame.ID(), "player-4")
+	testutil.AssertError(t, err, "Abandoned games take no more votes")
+}
@@ -0,0 +1,128 @@
+package action_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	baseaction "terraforming-mars-backend/internal/action"
+	gameAction "terraforming-mars-backend/internal/action/game"
+	resconvAction "terraforming-mars-backend/internal/action/resource_conversion"
+	turnAction "terraforming-mars-backend/internal/action/turn_management"
+	"terraforming-mars-backend/internal/game"
+	"terraforming-mars-backend/internal/game/shared"
+	"terraforming-mars-backend/test/testutil"
+)
+
+func newSkipAction(repo game.GameRepository) *turnAction.SkipActionAction {
+	logger := testutil.TestLogger()
+	cardRegistry := testutil.CreateTestCardRegistry()
+	finalScoring := gameAction.NewFinalScoringAction(repo, cardRegistry, nil, logger)
+	return turnAction.NewSkipActionAction(repo, cardRegistry, finalScoring, logger)
+}
+
+func TestSkipAction_PassWithConvertiblesNeedsConfirmation(t *testing.T) {
+	testGame, repo := testutil.CreateTestGameWithPlayers(t, 2, testutil.NewMockBroadcaster())
+	testutil.StartTestGame(t, testGame)
+	ctx := context.Background()
+
+	playerID := testGame.CurrentTurn().PlayerID()
+	p, _ := testGame.GetPlayer(playerID)
+	p.Resources().Add(map[shared.ResourceType]int{shared.ResourceHeat: 17})
+
+	err := newSkipAction(repo).Execute(ctx, testGame.ID(), playerID)
+	var pending *turnAction.ConvertiblesPendingError
+	testutil.AssertTrue(t, errors.As(err, &pending), "Passing with heat to spend should ask for confirmation")
+	testutil.AssertEqual(t, 2, pending.Convertibles.TemperatureSteps, "17 heat buys two temperature steps")
+	testutil.AssertEqual(t, 0, pending.Convertibles.Greeneries, "No plants to convert")
+	testutil.AssertFalse(t, p.HasPassed(), "Player should not have passed yet")
+
+	err = newSkipAction(repo).ExecuteConfirmed(ctx, testGame.ID(), playerID)
+	testutil.AssertNoError(t, err, "Confirmed pass should succeed")
+	testutil.AssertTrue(t, p.HasPassed(), "Player should have passed")
+}
+
+func TestSkipAction_ConfirmationStartsAtEightPlantsOrHeat(t *testing.T) {
+	cases := []struct {
+		resource shared.ResourceType
+		amount   int
+		confirm  bool
+	}{
+		{shared.ResourceHeat, baseaction.ConvertiblesReminderThreshold - 1, false},
+		{shared.ResourceHeat, baseaction.ConvertiblesReminderThreshold, true},
+		{shared.ResourcePlant, baseaction.ConvertiblesReminderThreshold - 1, false},
+		{shared.ResourcePlant, baseaction.ConvertiblesReminderThreshold, true},
+	}
+	for _, c := range cases {
+		testGame, repo := testutil.CreateTestGameWithPlayers(t, 2, testutil.NewMockBroadcaster())
+		testutil.StartTestGame(t, testGame)
+		playerID := testGame.CurrentTurn().PlayerID()
+		p, _ := testGame.GetPlayer(playerID)
+		p.Resources().Add(map[shared.ResourceType]int{c.resource: c.amount})
+
+		err := newSkipAction(repo).Execute(context.Background(), testGame.ID(), playerID)
+		var pending *turnAction.ConvertiblesPendingError
+		label := fmt.Sprintf("%d %s", c.amount, c.resource)
+		testutil.AssertEqual(t, c.confirm, errors.As(err, &pending), label+": confirmation asked")
+		testutil.AssertEqual(t, !c.confirm, p.HasPassed(), label+": passed without confirmation")
+	}
+}
+
+func TestSkipAction_EndingTurnNeedsNoConfirmation(t *testing.T) {
+	testGame, repo := testutil.CreateTestGameWithPlayers(t, 2, testutil.NewMockBroadcaster())
+	testutil.StartTestGame(t, testGame)
+	ctx := context.Background()
+
+	playerID := testGame.CurrentTurn().PlayerID()
+	p, _ := testGame.GetPlayer(playerID)
+	p.Resources().Add(map[shared.ResourceType]int{shared.ResourceHeat: 8})
+	testutil.AssertNoError(t, testGame.SetCurrentTurn(ctx, playerID, 1), "Failed to set turn")
+
+	err := newSkipAction(repo).Execute(ctx, testGame.ID(), playerID)
+	testutil.AssertNoError(t, err, "Ending a turn after an action is not a pass")
+	testutil.AssertFalse(t, p.HasPassed(), "Player should still be in the generation")
+}
+
+func TestConvertAllAction_SpendsHeatAndQueuesGreeneries(t *testing.T) {
+	testGame, repo := testutil.CreateTestGameWithPlayers(t, 2, testutil.NewMockBroadcaster())
+	testutil.StartTestGame(t, testGame)
+	ctx := context.Background()
+
+	playerID := testGame.CurrentTurn().PlayerID()
+	p, _ := testGame.GetPlayer(playerID)
+	p.Resources().Add(map[shared.ResourceType]int{
+		shared.ResourceHeat:  17,
+		shared.ResourcePlant: 17,
+	})
+	startTemp := testGame.GlobalParameters().Temperature()
+	startTR := p.Resources().TerraformRating()
+
+	action := resconvAction.NewConvertAllAction(repo, testutil.CreateTestCardRegistry(), nil, testutil.TestLogger())
+	converted, err := action.Execute(ctx, testGame.ID(), playerID)
+	testutil.AssertNoError(t, err, "Convert all should succeed")
+	testutil.AssertEqual(t, 2, converted.TemperatureSteps, "Two temperature steps")
+	testutil.AssertEqual(t, 2, converted.Greeneries, "Two greeneries")
+
+	testutil.AssertEqual(t, startTemp+4, testGame.GlobalParameters().Temperature(), "Temperature should rise two steps")
+	testutil.AssertEqual(t, startTR+2, p.Resources().TerraformRating(), "Each temperature step gives TR")
+	testutil.AssertEqual(t, 1, p.Resources().Get().Heat, "Leftover heat stays")
+	testutil.AssertEqual(t, 1, p.Resources().Get().Plants, "Leftover plants stay")
+
+	testutil.AssertTrue(t, testGame.GetPendingTileSelection(playerID) != nil, "First greenery placement should be offered")
+	queue := testGame.GetPendingTileSelectionQueue(playerID)
+	testutil.AssertTrue(t, queue != nil, "Second greenery placement should be queued")
+	testutil.AssertEqual(t, 1, len(queue.Items), "One placement left in the queue")
+	testutil.AssertEqual(t, 1, testGame.CurrentTurn().ActionsRemaining(), "Convert all costs one action")
+}
+
+func TestConvertAllAction_NothingToConvert(t *testing.T) {
+	testGame, repo := testutil.CreateTestGameWithPlayers(t, 2, testutil.NewMockBroadcaster())
+	testutil.StartTestGame(t, testGame)
+	ctx := context.Background()
+
+	playerID := testGame.CurrentTurn().PlayerID()
+	action := resconvAction.NewConvertAllAction(repo, testutil.CreateTestCardRegistry(), nil, testutil.TestLogger())
+	_, err := action.Execute(ctx, testGame.ID(), playerID)
+	testutil.AssertError(t, err, "Convert all with nothing useful should fail")
+}
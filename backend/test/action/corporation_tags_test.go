@@ -0,0 +1,122 @@
+package action_test
+
+import (
+	"context"
+	"testing"
+
+	cardAction "terraforming-mars-backend/internal/action/card"
+	"terraforming-mars-backend/internal/cards"
+	"terraforming-mars-backend/internal/game"
+	gamecards "terraforming-mars-backend/internal/game/cards"
+	"terraforming-mars-backend/internal/game/shared"
+	"terraforming-mars-backend/test/testutil"
+)
+
+// corporationTagRegistry holds a science/building corporation, science cards and a card that needs three science tags
+func corporationTagRegistry() cards.CardRegistry {
+	threeScience := 3
+	scienceTag := shared.TagScience
+	return cards.NewInMemoryCardRegistry([]gamecards.Card{
+		{
+			ID:   "corp-science-builders",
+			Name: "Science Builders",
+			Type: gamecards.CardTypeCorporation,
+			Pack: "base",
+			Tags: []shared.CardTag{shared.TagScience, shared.TagBuilding},
+		},
+		{
+			ID:   "card-research",
+			Name: "Research",
+			Type: gamecards.CardTypeAutomated,
+			Pack: "base",
+			Cost: 11,
+			Tags: []shared.CardTag{shared.TagScience, shared.TagScience},
+		},
+		{
+			ID:   "card-science-event",
+			Name: "Science Event",
+			Type: gamecards.CardTypeEvent,
+			Pack: "base",
+			Cost: 4,
+			Tags: []shared.CardTag{shared.TagScience},
+		},
+		{
+			ID:   "card-lab",
+			Name: "Lab",
+			Type: gamecards.CardTypeAutomated,
+			Pack: "base",
+			Cost: 5,
+			Requirements: &gamecards.CardRequirements{Items: []gamecards.Requirement{
+				{Type: gamecards.RequirementTags, Tag: &scienceTag, Min: &threeScience},
+			}},
+		},
+	})
+}
+
+func TestCountPlayerTags_IncludesCorporation(t *testing.T) {
+	testGame, _ := testutil.CreateTestGameWithPlayers(t, 2, testutil.NewMockBroadcaster())
+	registry := corporationTagRegistry()
+
+	p, _ := testGame.GetPlayer("player-1")
+	other, _ := testGame.GetPlayer("player-2")
+	testutil.AssertEqual(t, 0, gamecards.CountPlayerTagsByType(p, registry, shared.TagScience), "No tags before a corporation")
+
+	p.SetCorporationID("corp-science-builders")
+	p.PlayedCards().AddCard("card-research", "Research", "automated", []string{"science", "science"})
+	testutil.AssertEqual(t, 3, gamecards.CountPlayerTagsByType(p, registry, shared.TagScience), "Corporation tag adds to played tags")
+	testutil.AssertEqual(t, 1, gamecards.CountPlayerTagsByType(p, registry, shared.TagBuilding), "Corporation-only tags count")
+
+	testutil.AssertEqual(t, 1, gamecards.GetPlayerMilestoneProgress(shared.MilestoneBuilder, p, testGame.Board(), registry),
+		"Builder progress includes the corporation")
+
+	other.PlayedCards().AddCard("card-research", "Research", "automated", []string{"science", "science"})
+	placements := gamecards.ScoreAward(shared.AwardScientist, testGame.GetAllPlayers(), testGame.Board(), registry)
+	testutil.AssertEqual(t, p.ID(), placements[0].PlayerID, "Corporation tag breaks the Scientist tie")
+}
+
+func TestPlayCard_CorporationTagSatisfiesRequirement(t *testing.T) {
+	testGame, repo := testutil.CreateTestGameWithPlayers(t, 1, testutil.NewMockBroadcaster())
+	registry := corporationTagRegistry()
+	ctx := context.Background()
+
+	p := testGame.GetAllPlayers()[0]
+	testutil.AssertNoError(t, testGame.UpdateStatus(ctx, game.GameStatusActive), "Failed to start game")
+	testutil.AssertNoError(t, testGame.UpdatePhase(ctx, game.GamePhaseAction), "Failed to enter action phase")
+	testutil.AssertNoError(t, testGame.SetCurrentTurn(ctx, p.ID(), 2), "Failed to set turn")
+	p.Resources().Add(map[shared.ResourceType]int{shared.ResourceCredit: 20})
+	p.Hand().AddCard("card-lab")
+	p.PlayedCards().AddCard("card-research", "Research", "automated", []string{"science", "science"})
+
+	playCard := cardAction.NewPlayCardAction(repo, registry, nil, testutil.TestLogger())
+	payment := cardAction.PaymentRequest{Credits: 5}
+
+	err := playCard.Execute(ctx, testGame.ID(), p.ID(), "card-lab", payment, nil, nil, nil)
+	testutil.AssertError(t, err, "Two played science tags are not enough")
+	testutil.AssertEqual(t, "cannot play card: tag requirement not met: need 3 science tags, have 2", err.Error(), "Rejection counts played tags")
+
+	p.SetCorporationID("corp-science-builders")
+	err = playCard.Execute(ctx, testGame.ID(), p.ID(), "card-lab", payment, nil, nil, nil)
+	testutil.AssertNoError(t, err, "Played and corporation tags satisfy the requirement")
+	testutil.AssertTrue(t, p.PlayedCards().Contains("card-lab"), "Lab is played")
+}
+
+func TestCountPlayerTags_FaceDownEventsShowOnlyTheEventTag(t *testing.T) {
+	testGame, _ := testutil.CreateTestGameWithPlayers(t, 1, testutil.NewMockBroadcaster())
+	registry := corporationTagRegistry()
+
+	p := testGame.GetAllPlayers()[0]
+	p.PlayedCards().AddCard("card-research", "Research", "automated", []string{"science", "science"})
+	p.PlayedCards().AddCard("card-science-event", "Science Event", "event", []string{"science"})
+
+	testutil.AssertTrue(t, p.PlayedCards().IsFaceDown("card-science-event"), "Events are played face-down")
+	testutil.AssertFalse(t, p.PlayedCards().IsFaceDown("card-research"), "Other cards are played face-up")
+	testutil.AssertTrue(t, p.PlayedCards().Contains("card-science-event"), "Face-down events are still played cards")
+	testutil.AssertEqual(t, 2, gamecards.CountPlayerTagsByType(p, registry, shared.TagScience), "Face-down tags do not count")
+	testutil.AssertEqual(t, 1, gamecards.CountPlayerTagsByType(p, registry, shared.TagEvent), "Each event counts as an event tag")
+
+	checkpoint := p.Checkpoint()
+	p.PlayedCards().RemoveCard("card-science-event")
+	testutil.AssertEqual(t, 0, len(p.PlayedCards().FaceDownCards()), "Removing an event clears it from the face-down pile")
+	p.Restore(checkpoint)
+	testutil.AssertTrue(t, p.PlayedCards().IsFaceDown("card-science-event"), "Rollback restores the face-down pile")
+}
@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	gameAction "terraforming-mars-backend/internal/action/game"
+	"terraforming-mars-backend/internal/delivery/dto"
 	"terraforming-mars-backend/internal/game"
 	"terraforming-mars-backend/test/testutil"
 )
@@ -124,3 +125,98 @@ func TestCreateGameAction_BoardInitialization(t *testing.T) {
 	board := createdGame.Board()
 	testutil.AssertTrue(t, board != nil, "Board should be initialized")
 }
+
+func TestCreateGameAction_RulesOptions(t *testing.T) {
+	// Setup
+	repo := game.NewInMemoryGameRepository()
+	cardRegistry := testutil.CreateTestCardRegistry()
+	logger := testutil.TestLogger()
+
+	createAction := gameAction.NewCreateGameAction(repo, cardRegistry, logger)
+
+	// Execute with variants enabled but no milestone/award set
+	settings := game.GameSettings{
+		RulesOptions: game.RulesOptions{
+			FastMode:             true,
+			MulliganStartingHand: true,
+		},
+	}
+	createdGame, err := createAction.Execute(context.Background(), settings)
+
+	// Assert variants are kept and defaults are filled in
+	testutil.AssertNoError(t, err, "Failed to create game with rules options")
+	options := createdGame.Settings().RulesOptions
+	testutil.AssertTrue(t, options.FastMode, "Fast mode should be enabled")
+	testutil.AssertTrue(t, options.MulliganStartingHand, "Mulligan should be enabled")
+	testutil.AssertFalse(t, options.ShowTimers, "Show timers should be disabled")
+	testutil.AssertEqual(t, game.MilestoneAwardSetTharsis, options.MilestoneAwardSet, "Should use default milestone/award set")
+}
+
+func TestCreateGameAction_ShowTimersIsADisplayVariant(t *testing.T) {
+	repo := game.NewInMemoryGameRepository()
+	createAction := gameAction.NewCreateGameAction(repo, testutil.CreateTestCardRegistry(), testutil.TestLogger())
+
+	createdGame, err := createAction.Execute(context.Background(), game.GameSettings{
+		RulesOptions: game.RulesOptions{ShowTimers: true},
+	})
+
+	testutil.AssertNoError(t, err, "Show timers needs nothing enforced, so the game is created")
+	testutil.AssertTrue(t, createdGame.Settings().RulesOptions.ShowTimers, "Show timers is kept for clients")
+	testutil.AssertTrue(t, dto.ToRulesOptionsDto(createdGame.Settings().RulesOptions).ShowTimers, "Show timers is exposed in the game meta")
+}
+
+func TestCreateGameAction_RejectsVariantsTheEngineDoesNotEnforce(t *testing.T) {
+	repo := game.NewInMemoryGameRepository()
+	createAction := gameAction.NewCreateGameAction(repo, testutil.CreateTestCardRegistry(), testutil.TestLogger())
+
+	for name, options := range map[string]game.RulesOptions{
+		"draft":      {DraftVariant: true},
+		"solo decay": {SoloTRDecay: true},
+		"random set": {MilestoneAwardSet: game.MilestoneAwardSetRandom},
+	} {
+		_, err := createAction.Execute(context.Background(), game.GameSettings{RulesOptions: options})
+		testutil.AssertError(t, err, "Should reject the "+name+" variant until it is implemented")
+	}
+	games, _ := repo.List(context.Background(), nil)
+	testutil.AssertEqual(t, 0, len(games), "No game is created with a rule that does nothing")
+}
+
+func TestCreateGameAction_UnknownMilestoneAwardSet(t *testing.T) {
+	// Setup
+	repo := game.NewInMemoryGameRepository()
+	cardRegistry := testutil.CreateTestCardRegistry()
+	logger := testutil.TestLogger()
+
+	createAction := gameAction.NewCreateGameAction(repo, cardRegistry, logger)
+
+	// Execute
+	settings := game.GameSettings{
+		RulesOptions: game.RulesOptions{MilestoneAwardSet: "hellas-and-elysium"},
+	}
+	_, err := createAction.Execute(context.Background(), settings)
+
+	// Assert
+	testutil.AssertError(t, err, "Should reject unknown milestone/award set")
+}
+
+func TestCreateGameAction_SpeedPresetFillsUnsetTimers(t *testing.T) {
+	repo := game.NewInMemoryGameRepository()
+	createAction := gameAction.NewCreateGameAction(repo, testutil.CreateTestCardRegistry(), testutil.TestLogger())
+
+	createdGame, err := createAction.Execute(context.Background(), game.GameSettings{
+		RulesOptions: game.RulesOptions{SpeedPreset: game.SpeedPresetBlitz, ResearchTimeoutSeconds: 90},
+	})
+	testutil.AssertNoError(t, err, "Failed to create blitz game")
+
+	blitz, _ := game.SpeedPresetTimers(game.SpeedPresetBlitz)
+	options := createdGame.Settings().RulesOptions
+	testutil.AssertEqual(t, blitz.TurnTimer, options.TurnTimerSeconds, "Preset sets the turn timer")
+	testutil.AssertEqual(t, blitz.Timebank, options.TimebankSeconds, "Preset sets the timebank")
+	testutil.AssertEqual(t, blitz.StartingSelectionTimeout, options.StartingSelectionTimeoutSeconds, "Preset sets the starting selection timeout")
+	testutil.AssertEqual(t, 90, options.ResearchTimeoutSeconds, "Explicit timers win over the preset")
+
+	_, err = createAction.Execute(context.Background(), game.GameSettings{
+		RulesOptions: game.RulesOptions{SpeedPreset: "ludicrous"},
+	})
+	testutil.AssertError(t, err, "Should reject unknown speed presets")
+}
@@ -0,0 +1,159 @@
+package action_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	accountAction "terraforming-mars-backend/internal/action/account"
+	gameAction "terraforming-mars-backend/internal/action/game"
+	"terraforming-mars-backend/internal/archive"
+	"terraforming-mars-backend/internal/delivery/dto"
+	"terraforming-mars-backend/internal/game"
+	"terraforming-mars-backend/internal/tutorial"
+	"terraforming-mars-backend/test/testutil"
+)
+
+// accountRecord is an archived two-seat game where Alice's seat belongs to the given account
+func accountRecord(gameID, accountID string, finishedAt time.Time) archive.Record {
+	return archive.Record{
+		GameID: gameID,
+		Status: game.GameStatusCompleted,
+		Players: []archive.PlayerRecord{
+			{PlayerID: gameID + "-alice", PlayerName: "Alice", AccountID: accountID},
+			{PlayerID: gameID + "-bob", PlayerName: "Bob"},
+		},
+		FinalScores: []game.FinalScore{
+			{PlayerID: gameID + "-alice", PlayerName: "Alice", Placement: 1, IsWinner: true, Breakdown: game.VPBreakdown{TotalVP: 50}},
+			{PlayerID: gameID + "-bob", PlayerName: "Bob", Placement: 2, Breakdown: game.VPBreakdown{TotalVP: 45}},
+		},
+		FinishedAt: finishedAt,
+		Log: []game.StateDiff{{
+			SequenceNumber: 1,
+			PlayerID:       gameID + "-alice",
+			Description:    "Built city",
+			Summary:        game.LogSummary{Text: "Alice built city", Params: map[string]string{"player": "Alice", "description": "Built city"}},
+		}},
+		Inputs: []game.ActionInput{{
+			Sequence: 1,
+			PlayerID: gameID + "-alice",
+			Type:     "player-connect",
+			Payload:  json.RawMessage(`{"playerName":"Alice","gameId":"` + gameID + `","accountId":"` + accountID + `"}`),
+		}},
+	}
+}
+
+func TestDeleteAccountData_AnonymizesArchivedGamesAndAudits(t *testing.T) {
+	ctx := context.Background()
+	store := archive.NewMemoryStore()
+	gameArchive, err := archive.Open(ctx, store)
+	testutil.AssertNoError(t, err, "Archive should open")
+	now := time.Now()
+	testutil.AssertNoError(t, gameArchive.Save(ctx, accountRecord("game-1", "account-alice", now)), "Save should succeed")
+	testutil.AssertNoError(t, gameArchive.Save(ctx, accountRecord("game-2", "", now)), "Save should succeed")
+
+	completions := tutorial.NewCompletionStore()
+	completions.Record("account-alice", "puzzle-1")
+	completions.Record("account-alice", "puzzle-2")
+
+	key, err := accountAction.NewRegisterAccountAction(gameArchive, testutil.TestLogger()).Execute(ctx, "account-alice")
+	testutil.AssertNoError(t, err, "Registration should succeed")
+
+	deleteAction := accountAction.NewDeleteAccountDataAction(gameArchive, completions, testutil.TestLogger())
+	deletion, err := deleteAction.Execute(ctx, "account-alice", key)
+	testutil.AssertNoError(t, err, "Deletion should succeed")
+	testutil.AssertEqual(t, 1, deletion.GamesAnonymized, "Only the account's game is rewritten")
+	testutil.AssertEqual(t, 2, deletion.PuzzleCompletionsRemoved, "Puzzle completions are removed")
+	testutil.AssertFalse(t, completions.IsCompleted("account-alice", "puzzle-1"), "Completions are forgotten")
+
+	record, err := gameArchive.Load(ctx, "game-1")
+	testutil.AssertNoError(t, err, "Anonymized game still loads")
+	testutil.AssertEqual(t, archive.AnonymousName, record.Players[0].PlayerName, "Seat is renamed")
+	testutil.AssertEqual(t, "", record.Players[0].AccountID, "Account link is dropped")
+	testutil.AssertEqual(t, "Bob", record.Players[1].PlayerName, "Other seats keep their names")
+	testutil.AssertEqual(t, archive.AnonymousName, record.FinalScores[0].PlayerName, "Score is renamed")
+	testutil.AssertEqual(t, 50, record.FinalScores[0].Breakdown.TotalVP, "Scores are kept")
+	testutil.AssertFalse(t, strings.Contains(record.Log[0].Summary.Text, "Alice"), "Log text no longer names the player")
+	testutil.AssertEqual(t, archive.AnonymousName, record.Log[0].Summary.Params["player"], "Log params are renamed")
+	testutil.AssertEqual(t, archive.AnonymousName+" built city", record.Log[0].Summary.Text, "The rest of the summary is kept")
+
+	var join dto.PlayerConnectPayload
+	testutil.AssertNoError(t, json.Unmarshal(record.Inputs[0].Payload, &join), "Join input still decodes")
+	testutil.AssertEqual(t, archive.AnonymousName, join.PlayerName, "Join input is renamed")
+	testutil.AssertEqual(t, "game-1", join.GameID, "Other join fields are kept")
+
+	data, err := json.Marshal(record)
+	testutil.AssertNoError(t, err, "Record should serialize")
+	testutil.AssertFalse(t, strings.Contains(string(data), "Alice"), "No original name remains anywhere in the record")
+	testutil.AssertFalse(t, strings.Contains(string(data), "account-alice"), "No account ID remains anywhere in the record")
+
+	other, err := gameArchive.Load(ctx, "game-2")
+	testutil.AssertNoError(t, err, "Unlinked game loads")
+	testutil.AssertEqual(t, "Alice", other.Players[0].PlayerName, "Seats without the account are untouched")
+
+	stats := gameArchive.PlayerStats("Alice")
+	testutil.AssertEqual(t, 1, stats.Games, "Stats only find the unlinked seat")
+
+	reopened, err := archive.Open(ctx, store)
+	testutil.AssertNoError(t, err, "Archive should reopen")
+	audit := reopened.Deletions()
+	testutil.AssertEqual(t, 1, len(audit), "The deletion is audited")
+	testutil.AssertEqual(t, archive.HashAccount("account-alice"), audit[0].AccountHash, "The audit holds a hash, not the account ID")
+}
+
+func TestDeleteAccountData_GamesArchivedLaterAreAnonymized(t *testing.T) {
+	ctx := context.Background()
+	live, repo := testutil.CreateTestGameWithPlayers(t, 2, testutil.NewMockBroadcaster())
+	testutil.StartTestGame(t, live)
+	p, _ := live.GetPlayer("player-1")
+	p.SetAccountID("account-late")
+
+	gameArchive, err := archive.Open(ctx, archive.NewMemoryStore())
+	testutil.AssertNoError(t, err, "Archive should open")
+	deleteAction := accountAction.NewDeleteAccountDataAction(gameArchive, tutorial.NewCompletionStore(), testutil.TestLogger())
+	key, err := accountAction.NewRegisterAccountAction(gameArchive, testutil.TestLogger()).Execute(ctx, "account-late")
+	testutil.AssertNoError(t, err, "Registration should succeed")
+	_, err = deleteAction.Execute(ctx, "account-late", key)
+	testutil.AssertNoError(t, err, "Deletion should succeed")
+
+	testutil.AssertNoError(t, live.UpdateStatus(ctx, game.GameStatusAbandoned), "Failed to abandon game")
+	archiveAction := gameAction.NewArchiveGamesAction(repo, game.NewInMemoryGameStateRepository(), gameArchive, time.Minute, testutil.TestLogger())
+	testutil.AssertEqual(t, 1, len(archiveAction.ArchiveFinished(ctx, time.Now().Add(time.Hour))), "The game is archived")
+
+	record, err := gameArchive.Load(ctx, live.ID())
+	testutil.AssertNoError(t, err, "Archived game loads")
+	for _, seat := range record.Players {
+		if seat.PlayerID == "player-1" {
+			testutil.AssertEqual(t, archive.AnonymousName, seat.PlayerName, "The deleted account's seat is anonymized on archive")
+		}
+	}
+}
+
+func TestDeleteAccountData_RequiresTheAccountKey(t *testing.T) {
+	ctx := context.Background()
+	gameArchive, err := archive.Open(ctx, archive.NewMemoryStore())
+	testutil.AssertNoError(t, err, "Archive should open")
+	testutil.AssertNoError(t, gameArchive.Save(ctx, accountRecord("game-1", "account-alice", time.Now())), "Save should succeed")
+	deleteAction := accountAction.NewDeleteAccountDataAction(gameArchive, tutorial.NewCompletionStore(), testutil.TestLogger())
+
+	_, err = deleteAction.Execute(ctx, "account-alice", "guess")
+	testutil.AssertTrue(t, errors.Is(err, archive.ErrInvalidAccountKey), "An unregistered account cannot be deleted")
+
+	registerAction := accountAction.NewRegisterAccountAction(gameArchive, testutil.TestLogger())
+	key, err := registerAction.Execute(ctx, "account-alice")
+	testutil.AssertNoError(t, err, "Registration should succeed")
+	_, err = registerAction.Execute(ctx, "account-alice")
+	testutil.AssertTrue(t, errors.Is(err, archive.ErrAccountRegistered), "An account gets a key only once")
+
+	_, err = deleteAction.Execute(ctx, "account-alice", "guess")
+	testutil.AssertTrue(t, errors.Is(err, archive.ErrInvalidAccountKey), "A wrong key is refused")
+	record, err := gameArchive.Load(ctx, "game-1")
+	testutil.AssertNoError(t, err, "Game loads")
+	testutil.AssertEqual(t, "Alice", record.Players[0].PlayerName, "A refused deletion changes nothing")
+
+	_, err = deleteAction.Execute(ctx, "account-alice", key)
+	testutil.AssertNoError(t, err, "The registered key deletes the account's data")
+}
@@ -0,0 +1,89 @@
+package action_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"terraforming-mars-backend/internal/action"
+	"terraforming-mars-backend/internal/events"
+	"terraforming-mars-backend/internal/game"
+	"terraforming-mars-backend/internal/game/player"
+	"terraforming-mars-backend/internal/game/shared"
+	"terraforming-mars-backend/test/testutil"
+)
+
+func cityPlacedEffect(cardID string) player.CardEffect {
+	anyPlayer := "any-player"
+	return player.CardEffect{
+		CardID:   cardID,
+		CardName: cardID,
+		Behavior: shared.CardBehavior{
+			Triggers: []shared.Trigger{{
+				Type:      shared.TriggerTypeAuto,
+				Condition: &shared.ResourceTriggerCondition{Type: "city-placed", Target: &anyPlayer},
+			}},
+			Outputs: []shared.ResourceCondition{{ResourceType: shared.ResourceCredit, Amount: 1, Target: "self-player"}},
+		},
+	}
+}
+
+func TestPassiveEffects_ResolveActivePlayerFirstThenClockwise(t *testing.T) {
+	testGame, _ := testutil.CreateTestGameWithPlayers(t, 3, testutil.NewMockBroadcaster())
+	testutil.StartTestGame(t, testGame)
+	ctx := context.Background()
+	logger := testutil.TestLogger()
+
+	turnOrder := testGame.TurnOrder()
+	testutil.AssertNoError(t, testGame.SetCurrentTurn(ctx, turnOrder[1], 2), "Failed to set current turn")
+
+	// Subscribed in reverse seat order; turnOrder[1] played card-b before card-a
+	last, _ := testGame.GetPlayer(turnOrder[0])
+	second, _ := testGame.GetPlayer(turnOrder[2])
+	active, _ := testGame.GetPlayer(turnOrder[1])
+	active.PlayedCards().AddCard("card-b", "card-b", "active", nil)
+	active.PlayedCards().AddCard("card-a", "card-a", "active", nil)
+	action.SubscribePassiveEffectToEvents(ctx, testGame, last, cityPlacedEffect("card-last"), logger)
+	action.SubscribePassiveEffectToEvents(ctx, testGame, second, cityPlacedEffect("card-second"), logger)
+	action.SubscribePassiveEffectToEvents(ctx, testGame, active, cityPlacedEffect("card-a"), logger)
+	action.SubscribePassiveEffectToEvents(ctx, testGame, active, cityPlacedEffect("card-b"), logger)
+	testGame.TakeEffectResolutions()
+
+	events.Publish(testGame.EventBus(), events.TilePlacedEvent{
+		GameID:    testGame.ID(),
+		PlayerID:  turnOrder[0],
+		TileType:  string(shared.ResourceCityTile),
+		Timestamp: time.Now(),
+	})
+
+	resolutions := testGame.TakeEffectResolutions()
+	expected := []game.EffectResolution{
+		{PlayerID: turnOrder[1], CardID: "card-b", CardName: "card-b", Trigger: "city-placed"},
+		{PlayerID: turnOrder[1], CardID: "card-a", CardName: "card-a", Trigger: "city-placed"},
+		{PlayerID: turnOrder[2], CardID: "card-second", CardName: "card-second", Trigger: "city-placed"},
+		{PlayerID: turnOrder[0], CardID: "card-last", CardName: "card-last", Trigger: "city-placed"},
+	}
+	testutil.AssertEqual(t, len(expected), len(resolutions), "Every triggered effect is recorded")
+	for i := range expected {
+		testutil.AssertEqual(t, expected[i], resolutions[i], "Effects resolve active player first, then clockwise, then in play order")
+	}
+	testutil.AssertEqual(t, 0, len(testGame.TakeEffectResolutions()), "Taking the resolutions clears them")
+}
+
+func TestStateLog_RecordsEffectResolutionOrder(t *testing.T) {
+	testGame, _ := testutil.CreateTestGameWithPlayers(t, 2, testutil.NewMockBroadcaster())
+	ctx := context.Background()
+	stateRepo := game.NewInMemoryGameStateRepository()
+
+	testGame.RecordEffectResolution(game.EffectResolution{PlayerID: "player-1", CardID: "card-a", CardName: "A", Trigger: "city-placed"})
+	testGame.RecordEffectResolution(game.EffectResolution{PlayerID: "player-2", CardID: "card-b", CardName: "B", Trigger: "city-placed"})
+
+	diff, err := stateRepo.WriteFull(ctx, testGame.ID(), testGame, "City", game.SourceTypeStandardProject, "player-1", "Built a city", nil, nil, nil)
+	testutil.AssertNoError(t, err, "Failed to write state log")
+	testutil.AssertEqual(t, 2, len(diff.EffectResolutions), "The entry lists the effects it triggered")
+	testutil.AssertEqual(t, "card-a", diff.EffectResolutions[0].CardID, "Effects are listed in resolution order")
+
+	diff, err = stateRepo.WriteFull(ctx, testGame.ID(), testGame, "Pass", game.SourceTypeGameEvent, "player-1", "Passed", nil, nil, nil)
+	testutil.AssertNoError(t, err, "Failed to write state log")
+	testutil.AssertEqual(t, 0, len(diff.EffectResolutions), "Effects are not repeated on later entries")
+}
@@ -0,0 +1,40 @@
+package action_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	baseaction "terraforming-mars-backend/internal/action"
+	cardAction "terraforming-mars-backend/internal/action/card"
+	"terraforming-mars-backend/internal/cards"
+	"terraforming-mars-backend/internal/game"
+	gamecards "terraforming-mars-backend/internal/game/cards"
+	"terraforming-mars-backend/internal/game/player"
+	"terraforming-mars-backend/internal/i18n"
+	"terraforming-mars-backend/test/testutil"
+)
+
+func TestPlayCard_RejectsCardsFromDisabledExpansions(t *testing.T) {
+	testGame, repo, registry, playerID := setupSoloGame(t)
+	ctx := context.Background()
+	venusCard := gamecards.Card{ID: "card-venus-test", Name: "Venus Test", Type: gamecards.CardTypeAutomated, Pack: game.PackVenusNext}
+	registry = cards.NewInMemoryCardRegistry(append(registry.GetAll(), venusCard))
+
+	p, _ := testGame.GetPlayer(playerID)
+	p.Hand().AddCard(venusCard.ID)
+
+	playAction := cardAction.NewPlayCardAction(repo, registry, nil, testutil.TestLogger())
+	err := playAction.Execute(ctx, testGame.ID(), playerID, venusCard.ID, cardAction.PaymentRequest{}, nil, nil, nil)
+	testutil.AssertTrue(t, errors.Is(err, game.ErrExpansionDisabled), "Venus cards need the venus-next pack")
+	testutil.AssertTrue(t, p.Hand().HasCard(venusCard.ID), "The card stays in hand")
+
+	code, _ := i18n.LocalizeError("en", err.Error())
+	testutil.AssertEqual(t, "ERR_EXPANSION_DISABLED", code, "The rejection carries its own error code")
+
+	state := baseaction.CalculatePlayerCardState(&venusCard, p, testGame, registry)
+	testutil.AssertEqual(t, player.ErrorCodeExpansionDisabled, state.Errors[0].Code, "The card shows as unplayable")
+
+	testutil.AssertNoError(t, baseaction.ValidateExpansionEnabled(testGame, game.PackCorporateEra, testutil.TestLogger()),
+		"Packs without rules of their own are never gated")
+}
@@ -0,0 +1,47 @@
+package action_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"terraforming-mars-backend/internal/action/query"
+	"terraforming-mars-backend/internal/delivery/dto"
+	"terraforming-mars-backend/internal/game"
+	"terraforming-mars-backend/test/testutil"
+)
+
+func TestExportGameLog_OnlyAfterTheGameEnds(t *testing.T) {
+	testGame, repo := testutil.CreateTestGameWithPlayers(t, 2, testutil.NewMockBroadcaster())
+	testutil.StartTestGame(t, testGame)
+	ctx := context.Background()
+	stateRepo := game.NewInMemoryGameStateRepository()
+
+	_, err := stateRepo.WriteFull(ctx, testGame.ID(), testGame, "Standard Project: City", game.SourceTypeStandardProject,
+		"player-1", "Built city", nil, nil, nil)
+	testutil.AssertNoError(t, err, "Write should succeed")
+
+	exportAction := query.NewExportGameLogAction(repo, stateRepo, testutil.TestLogger())
+	_, _, err = exportAction.Execute(ctx, testGame.ID())
+	testutil.AssertEqual(t, query.ErrGameNotFinished, err, "Running games do not export")
+
+	scores := []game.FinalScore{
+		{PlayerID: "player-2", PlayerName: "Player B", Placement: 1, IsWinner: true, Breakdown: game.VPBreakdown{TerraformRating: 30, CardVP: 4, TotalVP: 34}},
+		{PlayerID: "player-1", PlayerName: "Player A", Placement: 2, Breakdown: game.VPBreakdown{TerraformRating: 25, TotalVP: 25}},
+	}
+	testutil.AssertNoError(t, testGame.SetFinalScores(ctx, scores, "player-2", false), "Failed to set scores")
+	testutil.AssertNoError(t, testGame.UpdateStatus(ctx, game.GameStatusCompleted), "Failed to complete game")
+
+	g, diffs, err := exportAction.Execute(ctx, testGame.ID())
+	testutil.AssertNoError(t, err, "Finished games export")
+	export := dto.ToGameLogExportResponse(g, diffs, testutil.CreateTestCardRegistry())
+	testutil.AssertEqual(t, 2, len(export.Players), "Every seat is listed")
+	testutil.AssertEqual(t, 2, len(export.FinalScores), "Final scores are included")
+	testutil.AssertEqual(t, len(diffs), len(export.Entries), "Every log entry is included")
+
+	text := dto.RenderGameLogText(export)
+	testutil.AssertTrue(t, strings.Contains(text, "1. Player B 34 VP (TR 30, cards 4, milestones 0, awards 0, greeneries 0, cities 0) - winner"),
+		"Text lists the final scores")
+	testutil.AssertTrue(t, strings.Contains(text, "Player A built city"), "Text lists the log summaries")
+	testutil.AssertTrue(t, strings.Contains(text, "Rules: standard"), "Text names the rules")
+}
@@ -0,0 +1,109 @@
+package action_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"terraforming-mars-backend/internal/action/confirmation"
+	gameAction "terraforming-mars-backend/internal/action/game"
+	"terraforming-mars-backend/internal/game"
+	"terraforming-mars-backend/internal/game/player"
+	"terraforming-mars-backend/internal/game/shared"
+	"terraforming-mars-backend/test/testutil"
+)
+
+func newForceAdvancePhaseAction(repo game.GameRepository, stateRepo game.GameStateRepository) *gameAction.ForceAdvancePhaseAction {
+	logger := testutil.TestLogger()
+	confirmAction := confirmation.NewConfirmProductionCardsAction(repo, testutil.CreateTestCardRegistry(), nil, logger)
+	return gameAction.NewForceAdvancePhaseAction(repo, stateRepo, confirmAction, logger)
+}
+
+// enterResearch puts the game into the production phase with every player still choosing cards
+func enterResearch(t *testing.T, g *game.Game) {
+	t.Helper()
+	ctx := context.Background()
+	testutil.AssertNoError(t, g.UpdatePhase(ctx, game.GamePhaseProductionAndCardDraw), "Failed to set phase")
+	for _, p := range g.GetAllPlayers() {
+		phase := &player.ProductionPhase{AvailableCards: []string{"card-a", "card-b"}}
+		testutil.AssertNoError(t, g.SetProductionPhase(ctx, p.ID(), phase), "Failed to set production phase")
+	}
+}
+
+func TestForceAdvancePhaseAction_HostSkipsWaitingPlayers(t *testing.T) {
+	testGame, repo := testutil.CreateTestGameWithPlayers(t, 3, testutil.NewMockBroadcaster())
+	testutil.StartTestGame(t, testGame)
+	enterResearch(t, testGame)
+	ctx := context.Background()
+	stateRepo := game.NewInMemoryGameStateRepository()
+	action := newForceAdvancePhaseAction(repo, stateRepo)
+
+	confirmAction := confirmation.NewConfirmProductionCardsAction(repo, testutil.CreateTestCardRegistry(), nil, testutil.TestLogger())
+	testutil.AssertNoError(t, confirmAction.Execute(ctx, testGame.ID(), "player-2", nil), "Player 2 confirms")
+
+	err := action.Execute(ctx, testGame.ID(), "player-2", true)
+	testutil.AssertError(t, err, "Only the host can force the phase")
+
+	err = action.Execute(ctx, testGame.ID(), "player-1", false)
+	testutil.AssertError(t, err, "Forcing needs confirmation")
+	testutil.AssertEqual(t, game.GamePhaseProductionAndCardDraw, testGame.CurrentPhase(), "Unconfirmed force changes nothing")
+
+	err = action.Execute(ctx, testGame.ID(), "player-1", true)
+	testutil.AssertNoError(t, err, "Host force should succeed")
+	testutil.AssertEqual(t, game.GamePhaseAction, testGame.CurrentPhase(), "Game moves to the action phase")
+
+	diffs, _ := stateRepo.GetDiff(ctx, testGame.ID())
+	testutil.AssertEqual(t, 2, len(diffs), "Each skipped player is recorded in the game log")
+	for i, playerID := range []string{"player-1", "player-3"} {
+		testutil.AssertEqual(t, playerID, diffs[i].PlayerID, "Entry is logged under the skipped player")
+		testutil.AssertEqual(t, "Host advanced the production phase; bought no cards", diffs[i].Description, "Description holds no player name")
+	}
+}
+
+func TestForceAdvancePhaseAction_ResearchTimeout(t *testing.T) {
+	ctx := context.Background()
+	repo := game.NewInMemoryGameRepository()
+	settings := game.GameSettings{MaxPlayers: 4, RulesOptions: game.RulesOptions{ResearchTimeoutSeconds: 60}}
+	testGame := game.NewGame("research-timeout", "", settings)
+	testutil.AssertNoError(t, repo.Create(ctx, testGame), "Failed to create game")
+	for _, id := range []string{"player-1", "player-2"} {
+		testutil.AssertNoError(t, testGame.AddPlayer(ctx, player.NewPlayer(testGame.EventBus(), testGame.ID(), id, id)), "Failed to add player")
+	}
+	testutil.AssertNoError(t, testGame.UpdateStatus(ctx, game.GameStatusActive), "Failed to start game")
+	enterResearch(t, testGame)
+
+	deadline, ok := testGame.ResearchDeadline()
+	testutil.AssertTrue(t, ok, "Production phase should have a deadline")
+
+	action := newForceAdvancePhaseAction(repo, nil)
+	advanced := action.ExpireResearch(ctx, deadline.Add(time.Second), func(ctx context.Context, gameID string, fn func(context.Context)) error {
+		return game.ErrGameFailed
+	})
+	testutil.AssertEqual(t, 0, len(advanced), "A game whose queue refuses work is not advanced")
+
+	advanced = action.ExpireResearch(ctx, deadline.Add(-time.Second), testutil.RunInGame)
+	testutil.AssertEqual(t, 0, len(advanced), "Nothing happens before the deadline")
+
+	testutil.AssertNoError(t, testGame.Pause(ctx, "player-1"), "Failed to pause")
+	advanced = action.ExpireResearch(ctx, deadline.Add(time.Second), testutil.RunInGame)
+	testutil.AssertEqual(t, 0, len(advanced), "Paused games are not timed out")
+	testutil.AssertNoError(t, testGame.Resume(ctx), "Failed to resume")
+
+	resumedDeadline, _ := testGame.ResearchDeadline()
+	testutil.AssertTrue(t, resumedDeadline.After(deadline), "Time spent paused extends the deadline")
+
+	confirmAction := confirmation.NewConfirmProductionCardsAction(repo, testutil.CreateTestCardRegistry(), nil, testutil.TestLogger())
+	second, _ := testGame.GetPlayer("player-2")
+	second.Resources().Add(map[shared.ResourceType]int{shared.ResourceCredit: 3})
+	advanced = action.ExpireResearch(ctx, resumedDeadline.Add(time.Second), func(ctx context.Context, gameID string, fn func(context.Context)) error {
+		// Player 2's confirmation was queued ahead of the timeout, so it runs first
+		testutil.AssertNoError(t, confirmAction.Execute(ctx, gameID, "player-2", []string{"card-a"}), "Player 2 confirms")
+		fn(ctx)
+		return nil
+	})
+	testutil.AssertEqual(t, 1, len(advanced), "Expired game is advanced")
+	testutil.AssertTrue(t, second.Hand().HasCard("card-a"), "A purchase queued ahead of the timeout is kept")
+	testutil.AssertEqual(t, game.GamePhaseAction, testGame.CurrentPhase(), "Game moves to the action phase")
+	_, ok = testGame.ResearchDeadline()
+	testutil.AssertFalse(t, ok, "No deadline outside the production phase")
+}
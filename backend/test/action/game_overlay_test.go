@@ -0,0 +1,45 @@
+package action_test
+
+import (
+	"context"
+	"testing"
+
+	"terraforming-mars-backend/internal/action/query"
+	"terraforming-mars-backend/internal/game"
+	"terraforming-mars-backend/internal/game/shared"
+	"terraforming-mars-backend/test/testutil"
+)
+
+func TestGameOverlay_PublicStandingAndLastAction(t *testing.T) {
+	testGame, repo := testutil.CreateTestGameWithPlayers(t, 2, testutil.NewMockBroadcaster())
+	testutil.StartTestGame(t, testGame)
+	ctx := context.Background()
+	stateRepo := game.NewInMemoryGameStateRepository()
+	service := query.NewGameQueryService(repo, testutil.CreateTestCardRegistry(), 0, testutil.TestLogger())
+	overlayAction := query.NewGetGameOverlayAction(service, stateRepo, testutil.TestLogger())
+
+	overlay, err := overlayAction.Execute(ctx, testGame.ID())
+	testutil.AssertNoError(t, err, "Overlay should build")
+	testutil.AssertEqual(t, 2, len(overlay.Players), "Every player is on the overlay")
+	testutil.AssertEqual(t, testGame.TurnOrder()[0], overlay.Players[0].PlayerID, "Players are in turn order")
+	testutil.AssertTrue(t, overlay.LastAction == nil, "Nothing logged yet")
+
+	p, _ := testGame.GetPlayer("player-2")
+	p.Resources().Add(map[shared.ResourceType]int{shared.ResourceHeat: 5})
+	_, err = stateRepo.WriteFull(ctx, testGame.ID(), testGame, "Standard Project: City", game.SourceTypeStandardProject,
+		"player-2", "Built city", nil, nil, nil)
+	testutil.AssertNoError(t, err, "Write should succeed")
+
+	overlay, err = overlayAction.Execute(ctx, testGame.ID())
+	testutil.AssertNoError(t, err, "Overlay should rebuild")
+	for _, entry := range overlay.Players {
+		if entry.PlayerID == "player-2" {
+			testutil.AssertEqual(t, 5, entry.Resources.Heat, "Resources follow the game")
+		}
+	}
+	testutil.AssertTrue(t, overlay.LastAction != nil, "The newest log entry is included")
+	testutil.AssertEqual(t, "player-2", overlay.LastAction.PlayerID, "Last action names its player")
+
+	_, err = overlayAction.Execute(ctx, "missing")
+	testutil.AssertError(t, err, "Unknown games have no overlay")
+}
@@ -0,0 +1,146 @@
+package action_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"terraforming-mars-backend/internal/action/query"
+	"terraforming-mars-backend/internal/game"
+	"terraforming-mars-backend/internal/game/shared"
+	"terraforming-mars-backend/test/testutil"
+)
+
+func TestGameQueryService_RebuildsAfterEvent(t *testing.T) {
+	testGame, repo := testutil.CreateTestGameWithPlayers(t, 2, testutil.NewMockBroadcaster())
+	ctx := context.Background()
+	service := query.NewGameQueryService(repo, testutil.CreateTestCardRegistry(), time.Hour, testutil.TestLogger())
+
+	p := testGame.GetAllPlayers()[0]
+	view, err := service.GameView(ctx, testGame.ID(), p.ID())
+	testutil.AssertNoError(t, err, "View should build")
+	testutil.AssertEqual(t, 0, view.CurrentPlayer.Resources.Credits, "Initial credits")
+
+	p.Resources().Add(map[shared.ResourceType]int{shared.ResourceCredit: 7})
+
+	view, err = service.GameView(ctx, testGame.ID(), p.ID())
+	testutil.AssertNoError(t, err, "View should rebuild")
+	testutil.AssertEqual(t, 7, view.CurrentPlayer.Resources.Credits, "Event should invalidate the cached view")
+}
+
+func TestGameQueryService_Errors(t *testing.T) {
+	testGame, repo := testutil.CreateTestGameWithPlayers(t, 1, testutil.NewMockBroadcaster())
+	ctx := context.Background()
+	service := query.NewGameQueryService(repo, testutil.CreateTestCardRegistry(), 0, testutil.TestLogger())
+
+	_, err := service.GameView(ctx, "missing", "")
+	testutil.AssertTrue(t, errors.Is(err, game.ErrGameNotFound), "Unknown game should wrap ErrGameNotFound")
+
+	_, err = service.GameView(ctx, testGame.ID(), "stranger")
+	testutil.AssertError(t, err, "Unknown viewer should be rejected")
+	testutil.AssertFalse(t, errors.Is(err, game.ErrGameNotFound), "Unknown viewer is not a missing game")
+	testutil.AssertTrue(t, errors.Is(err, query.ErrPlayerNotInGame), "Unknown viewer should wrap ErrPlayerNotInGame")
+}
+
+func TestGameQueryService_EvictsEndedAndDeletedGames(t *testing.T) {
+	ctx := context.Background()
+	repo := game.NewInMemoryGameRepository()
+	service := query.NewGameQueryService(repo, testutil.CreateTestCardRegistry(), 0, testutil.TestLogger())
+	repo.OnDelete(service.Invalidate)
+
+	ending := game.NewGame("ending", "", game.GameSettings{MaxPlayers: 2})
+	deleted := game.NewGame("deleted", "", game.GameSettings{MaxPlayers: 2})
+	testutil.AssertNoError(t, repo.Create(ctx, ending), "Game should be created")
+	testutil.AssertNoError(t, repo.Create(ctx, deleted), "Game should be created")
+	for _, gameID := range []string{"ending", "deleted"} {
+		_, err := service.GameView(ctx, gameID, "")
+		testutil.AssertNoError(t, err, "View should build")
+	}
+	testutil.AssertEqual(t, 2, service.CachedGameCount(), "Both games are cached")
+
+	testutil.AssertNoError(t, repo.Delete(ctx, "deleted"), "Game should be deleted")
+	testutil.AssertEqual(t, 1, service.CachedGameCount(), "A deleted game is evicted")
+
+	testutil.AssertNoError(t, ending.UpdateStatus(ctx, game.GameStatusCompleted), "Game should end")
+	deadline := time.Now().Add(2 * time.Second)
+	for service.CachedGameCount() > 0 && time.Now().Before(deadline) {
+		time.Sleep(5 * time.Millisecond)
+	}
+	testutil.AssertEqual(t, 0, service.CachedGameCount(), "An ended game is evicted")
+
+	_, err := service.GameView(ctx, "ending", "")
+	testutil.AssertNoError(t, err, "An ended game can still be read")
+	testutil.AssertEqual(t, 0, service.CachedGameCount(), "Reading an ended game does not cache it again")
+}
+
+func TestGameQueryService_Summary(t *testing.T) {
+	testGame, repo := testutil.CreateTestGameWithPlayers(t, 2, testutil.NewMockBroadcaster())
+	ctx := context.Background()
+	service := query.NewGameQueryService(repo, testutil.CreateTestCardRegistry(), 0, testutil.TestLogger())
+
+	players := testGame.GetAllPlayers()
+	players[1].Resources().SetTerraformRating(25)
+	players[1].PlayedCards().AddCard("card-artificial-photosynthesis", "Artificial Photosynthesis", "automated", []string{string(shared.TagScience)})
+
+	summary, err := service.Summary(ctx, testGame.ID(), players[0].ID())
+	testutil.AssertNoError(t, err, "Summary should build")
+	testutil.AssertEqual(t, 2, len(summary.Scoreboard), "Scoreboard should list every player")
+	testutil.AssertEqual(t, players[1].ID(), summary.Scoreboard[0].PlayerID, "Highest TR should lead the scoreboard")
+	testutil.AssertEqual(t, 1, summary.Scoreboard[0].PlayedCards, "Played cards should be counted")
+	testutil.AssertEqual(t, 1, summary.TagCounts[players[1].ID()][string(shared.TagScience)], "Science tag should be counted")
+	testutil.AssertEqual(t, 0, len(summary.Board.TilesByType), "No tiles placed yet")
+}
+
+func TestGameQueryService_ConcurrentReads(t *testing.T) {
+	repo := game.NewInMemoryGameRepository()
+	ctx := context.Background()
+	service := query.NewGameQueryService(repo, testutil.CreateTestCardRegistry(), 0, testutil.TestLogger())
+
+	const gameCount = 200
+	for i := 0; i < gameCount; i++ {
+		g := game.NewGame(fmt.Sprintf("game-%d", i), "", game.GameSettings{MaxPlayers: 4})
+		testutil.AssertNoError(t, repo.Create(ctx, g), "Create should succeed")
+	}
+
+	var wg sync.WaitGroup
+	errs := make(chan error, gameCount*5)
+	for i := 0; i < gameCount; i++ {
+		for r := 0; r < 5; r++ {
+			wg.Add(1)
+			go func(gameID string) {
+				defer wg.Done()
+				if _, err := service.GameView(ctx, gameID, ""); err != nil {
+					errs <- err
+				}
+			}(fmt.Sprintf("game-%d", i))
+		}
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		t.Fatalf("Concurrent read failed: %v", err)
+	}
+}
+
+func BenchmarkGameQueryService_CachedView(b *testing.B) {
+	repo := game.NewInMemoryGameRepository()
+	ctx := context.Background()
+	service := query.NewGameQueryService(repo, testutil.CreateTestCardRegistry(), time.Hour, testutil.TestLogger())
+
+	g := game.NewGame("bench-game", "", game.GameSettings{MaxPlayers: 4})
+	if err := repo.Create(ctx, g); err != nil {
+		b.Fatal(err)
+	}
+
+	b.ResetTimer()
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			if _, err := service.GameView(ctx, "bench-game", ""); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
@@ -0,0 +1,64 @@
+package action_test
+
+import (
+	"context"
+	"testing"
+
+	"terraforming-mars-backend/internal/action/confirmation"
+	"terraforming-mars-backend/internal/game/shared"
+	"terraforming-mars-backend/test/testutil"
+)
+
+func TestGenerationSummary_RecapsProductionAndTRChange(t *testing.T) {
+	testGame, repo := testutil.CreateTestGameWithPlayers(t, 2, testutil.NewMockBroadcaster())
+	testutil.StartTestGame(t, testGame)
+	ctx := context.Background()
+	testGame.ResetGenerationTallies()
+
+	p1, _ := testGame.GetPlayer("player-1")
+	p1.Resources().SetProduction(shared.Production{Credits: 3, Steel: 2, Energy: 1})
+	p1.Resources().Add(map[shared.ResourceType]int{shared.ResourceEnergy: 4})
+	p1.Resources().UpdateTerraformRating(3)
+	p1.Resources().UpdateTerraformRating(-1)
+	testGame.RecordCardsBought("player-1", 2)
+
+	skip := newSkipAction(repo)
+	testutil.AssertNoError(t, skip.Execute(ctx, testGame.ID(), "player-1"), "Player 1 passes")
+	testutil.AssertNoError(t, skip.Execute(ctx, testGame.ID(), "player-2"), "Player 2 passes")
+
+	summary := testGame.LastGenerationSummary()
+	testutil.AssertTrue(t, summary != nil, "Production should leave a generation summary")
+	testutil.AssertEqual(t, 1, summary.Generation, "Summary covers the generation that ended")
+	testutil.AssertEqual(t, 2, len(summary.Players), "Every player is summarized")
+
+	recap := summary.Players[0]
+	testutil.AssertEqual(t, "player-1", recap.PlayerID, "Players keep their order")
+	testutil.AssertEqual(t, 3+p1.Resources().TerraformRating(), recap.Income.Credits, "Credit income includes TR")
+	testutil.AssertEqual(t, 2, recap.Income.Steel, "Steel income is production")
+	testutil.AssertEqual(t, 4, recap.EnergyConverted, "Leftover energy is converted")
+	testutil.AssertEqual(t, 2, recap.CardsBought, "Bought cards are counted")
+	testutil.AssertEqual(t, 2, recap.TRDelta, "TR change is net of losses")
+
+	testutil.AssertEqual(t, 0, testGame.GenerationTally("player-1").TRDelta, "The next generation starts a fresh tally")
+}
+
+func TestGenerationSummary_ResearchCountsTowardsNewGeneration(t *testing.T) {
+	testGame, repo := testutil.CreateTestGameWithPlayers(t, 2, testutil.NewMockBroadcaster())
+	testutil.StartTestGame(t, testGame)
+	ctx := context.Background()
+	testGame.ResetGenerationTallies()
+
+	skip := newSkipAction(repo)
+	testutil.AssertNoError(t, skip.Execute(ctx, testGame.ID(), "player-1"), "Player 1 passes")
+	testutil.AssertNoError(t, skip.Execute(ctx, testGame.ID(), "player-2"), "Player 2 passes")
+
+	research := testGame.GetProductionPhase("player-1")
+	testutil.AssertTrue(t, research != nil && len(research.AvailableCards) > 0, "Research should offer cards")
+
+	confirmAction := confirmation.NewConfirmProductionCardsAction(repo, testutil.CreateTestCardRegistry(), nil, testutil.TestLogger())
+	err := confirmAction.Execute(ctx, testGame.ID(), "player-1", research.AvailableCards[:1])
+	testutil.AssertNoError(t, err, "Buying a research card should succeed")
+
+	testutil.AssertEqual(t, 0, testGame.LastGenerationSummary().Players[0].CardsBought, "The closed summary is unchanged")
+	testutil.AssertEqual(t, 1, testGame.GenerationTally("player-1").CardsBought, "Research purchases belong to the new generation")
+}
@@ -0,0 +1,52 @@
+package action_test
+
+import (
+	"context"
+	"testing"
+
+	adminAction "terraforming-mars-backend/internal/action/admin"
+	"terraforming-mars-backend/internal/game"
+	"terraforming-mars-backend/test/testutil"
+)
+
+func TestAdminGiveCard_TakesCardFromDeckAndLogs(t *testing.T) {
+	ctx := context.Background()
+	testGame, repo := testutil.CreateTestGameWithPlayers(t, 2, testutil.NewMockBroadcaster())
+	stateRepo := game.NewInMemoryGameStateRepository()
+	action := adminAction.NewGiveCardAction(repo, testutil.CreateTestCardRegistry(), stateRepo, testutil.TestLogger())
+
+	deckSize := len(testGame.Deck().ProjectCards())
+	err := action.Execute(ctx, testGame.ID(), "player-1", "card-power-plant", false)
+	testutil.AssertNoError(t, err, "A base card should be given")
+
+	p, _ := testGame.GetPlayer("player-1")
+	testutil.AssertTrue(t, p.Hand().HasCard("card-power-plant"), "Card should be in hand")
+	_, cached := p.Hand().GetPlayerCard("card-power-plant")
+	testutil.AssertTrue(t, cached, "Card should get its living instance")
+	testutil.AssertEqual(t, deckSize-1, len(testGame.Deck().ProjectCards()), "Card should leave the deck")
+
+	diffs, _ := stateRepo.GetDiff(ctx, testGame.ID())
+	testutil.AssertEqual(t, 1, len(diffs), "Giving a card should be logged")
+	testutil.AssertEqual(t, game.SourceTypeAdmin, diffs[0].SourceType, "Log entry should be marked as admin")
+
+	err = action.Execute(ctx, testGame.ID(), "player-2", "card-power-plant", false)
+	testutil.AssertError(t, err, "A held card cannot be given again")
+}
+
+func TestAdminGiveCard_ChecksPacksAndCardTypes(t *testing.T) {
+	ctx := context.Background()
+	testGame, repo := testutil.CreateTestGameWithPlayers(t, 1, testutil.NewMockBroadcaster())
+	action := adminAction.NewGiveCardAction(repo, testutil.CreateTestCardRegistry(), game.NewInMemoryGameStateRepository(), testutil.TestLogger())
+
+	err := action.Execute(ctx, testGame.ID(), "player-1", "card-does-not-exist", false)
+	testutil.AssertError(t, err, "Unknown cards are rejected")
+
+	err = action.Execute(ctx, testGame.ID(), "player-1", "corp-credicor", false)
+	testutil.AssertError(t, err, "Corporations are not project cards")
+
+	err = action.Execute(ctx, testGame.ID(), "player-1", "card-space-station", false)
+	testutil.AssertError(t, err, "Cards from packs outside the game are rejected")
+
+	err = action.Execute(ctx, testGame.ID(), "player-1", "card-space-station", true)
+	testutil.AssertNoError(t, err, "The override allows any pack")
+}
@@ -0,0 +1,121 @@
+package action_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	gameAction "terraforming-mars-backend/internal/action/game"
+	"terraforming-mars-backend/internal/game"
+	"terraforming-mars-backend/test/testutil"
+
+	"github.com/google/uuid"
+)
+
+func TestInvite_ClaimsReservedSeatOnce(t *testing.T) {
+	testGame, repo := testutil.CreateTestGameWithPlayers(t, 1, testutil.NewMockBroadcaster())
+	logger := testutil.TestLogger()
+	ctx := context.Background()
+
+	createAction := gameAction.NewCreateInviteAction(repo, logger)
+	joinAction := gameAction.NewJoinGameAction(repo, testutil.CreateTestCardRegistry(), logger)
+
+	invite, err := createAction.Execute(ctx, testGame.ID(), "player-1", "  Carol ")
+	testutil.AssertNoError(t, err, "Host should be able to create an invite")
+	testutil.AssertEqual(t, "Carol", invite.SeatName, "Seat name should be trimmed")
+	testutil.AssertEqual(t, 1, testGame.ReservedSeats(), "The invite should reserve a seat")
+
+	_, err = joinAction.Execute(ctx, testGame.ID(), "carol", uuid.New().String())
+	testutil.AssertError(t, err, "Others cannot take a reserved name")
+
+	playerID := uuid.New().String()
+	result, err := joinAction.ExecuteWithInvite(ctx, testGame.ID(), "Anything", playerID, "", invite.Token)
+	testutil.AssertNoError(t, err, "Invited player should join")
+	testutil.AssertEqual(t, "Carol", result.PlayerName, "Invited player should take the seat name")
+	testutil.AssertEqual(t, 0, testGame.ReservedSeats(), "Claiming should free the reservation")
+
+	joined, _ := testGame.GetPlayer(playerID)
+	testutil.AssertEqual(t, "Carol", joined.Name(), "Seated player should carry the invite's name")
+
+	_, err = joinAction.ExecuteWithInvite(ctx, testGame.ID(), "Mallory", uuid.New().String(), "", invite.Token)
+	testutil.AssertTrue(t, errors.Is(err, game.ErrInviteNotFound), "A claimed invite cannot be used again")
+}
+
+func TestInvite_ReservedSeatsCountTowardsMaxPlayers(t *testing.T) {
+	testGame, repo := testutil.CreateTestGameWithPlayers(t, 2, testutil.NewMockBroadcaster())
+	logger := testutil.TestLogger()
+	ctx := context.Background()
+
+	createAction := gameAction.NewCreateInviteAction(repo, logger)
+	joinAction := gameAction.NewJoinGameAction(repo, testutil.CreateTestCardRegistry(), logger)
+
+	first, err := createAction.Execute(ctx, testGame.ID(), "player-1", "Carol")
+	testutil.AssertNoError(t, err, "First invite should be created")
+	_, err = createAction.Execute(ctx, testGame.ID(), "player-1", "Dave")
+	testutil.AssertNoError(t, err, "Second invite should be created")
+
+	_, err = createAction.Execute(ctx, testGame.ID(), "player-1", "Erin")
+	testutil.AssertError(t, err, "No seats are left to reserve")
+
+	_, err = joinAction.Execute(ctx, testGame.ID(), "Walk-in", uuid.New().String())
+	testutil.AssertError(t, err, "Reserved seats are not open to other joiners")
+
+	_, err = joinAction.ExecuteWithInvite(ctx, testGame.ID(), "", uuid.New().String(), "", first.Token)
+	testutil.AssertNoError(t, err, "Invited player should take their reserved seat")
+	testutil.AssertEqual(t, 3, len(testGame.GetAllPlayers()), "Invited player should be seated")
+}
+
+func TestInvite_HostManagesInvites(t *testing.T) {
+	testGame, repo := testutil.CreateTestGameWithPlayers(t, 2, testutil.NewMockBroadcaster())
+	logger := testutil.TestLogger()
+	ctx := context.Background()
+
+	createAction := gameAction.NewCreateInviteAction(repo, logger)
+	listAction := gameAction.NewListInvitesAction(repo, logger)
+	revokeAction := gameAction.NewRevokeInviteAction(repo, logger)
+
+	_, err := createAction.Execute(ctx, testGame.ID(), "player-2", "Carol")
+	testutil.AssertTrue(t, errors.Is(err, gameAction.ErrNotHost), "Only the host can create invites")
+	_, err = createAction.Execute(ctx, testGame.ID(), "player-1", "Player B")
+	testutil.AssertError(t, err, "A seated player's name cannot be reserved")
+
+	invite, err := createAction.Execute(ctx, testGame.ID(), "player-1", "Carol")
+	testutil.AssertNoError(t, err, "Host should be able to create an invite")
+
+	_, err = listAction.Execute(ctx, testGame.ID(), "player-2")
+	testutil.AssertTrue(t, errors.Is(err, gameAction.ErrNotHost), "Only the host can list invites")
+	invites, err := listAction.Execute(ctx, testGame.ID(), "player-1")
+	testutil.AssertNoError(t, err, "Host should be able to list invites")
+	testutil.AssertEqual(t, 1, len(invites), "The open invite should be listed")
+
+	err = revokeAction.Execute(ctx, testGame.ID(), "player-2", invite.Token)
+	testutil.AssertTrue(t, errors.Is(err, gameAction.ErrNotHost), "Only the host can revoke invites")
+	err = revokeAction.Execute(ctx, testGame.ID(), "player-1", invite.Token)
+	testutil.AssertNoError(t, err, "Host should be able to revoke an invite")
+	err = revokeAction.Execute(ctx, testGame.ID(), "player-1", invite.Token)
+	testutil.AssertTrue(t, errors.Is(err, game.ErrInviteNotFound), "A revoked invite is gone")
+
+	_, err = listAction.GetInvite(ctx, testGame.ID(), invite.Token)
+	testutil.AssertTrue(t, errors.Is(err, game.ErrInviteNotFound), "A revoked invite cannot be looked up")
+}
+
+func TestInvite_ReleasedInviteCanBeClaimedAgain(t *testing.T) {
+	testGame, repo := testutil.CreateTestGameWithPlayers(t, 1, testutil.NewMockBroadcaster())
+	ctx := context.Background()
+
+	createAction := gameAction.NewCreateInviteAction(repo, testutil.TestLogger())
+	invite, err := createAction.Execute(ctx, testGame.ID(), "player-1", "Carol")
+	testutil.AssertNoError(t, err, "Host should be able to create an invite")
+
+	claimed, err := testGame.ClaimInvite(ctx, invite.Token)
+	testutil.AssertNoError(t, err, "Invite should be claimed")
+	testutil.AssertEqual(t, 0, testGame.ReservedSeats(), "Claiming should free the reservation")
+
+	testutil.AssertNoError(t, testGame.ReleaseInvite(ctx, claimed), "A claimed invite should reopen")
+	testutil.AssertEqual(t, 1, testGame.ReservedSeats(), "The reopened invite should hold its seat again")
+	testutil.AssertTrue(t, testGame.IsSeatNameReserved("Carol"), "The seat name should be reserved again")
+	testutil.AssertError(t, testGame.ReleaseInvite(ctx, claimed), "An open invite cannot be reopened")
+
+	_, err = testGame.ClaimInvite(ctx, invite.Token)
+	testutil.AssertNoError(t, err, "The reopened token should work again")
+}
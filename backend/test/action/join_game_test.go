@@ -163,3 +163,20 @@ func TestJoinGameAction_SetHostForFirstPlayer(t *testing.T) {
 	fetchedGame, _ := repo.Get(context.Background(), testGame.ID())
 	testutil.AssertEqual(t, result.PlayerID, fetchedGame.HostPlayerID(), "First player should be host")
 }
+
+func TestJoinGameAction_LinksAccount(t *testing.T) {
+	testGame, repo := testutil.CreateTestGameWithPlayers(t, 0, testutil.NewMockBroadcaster())
+	joinAction := gameAction.NewJoinGameAction(repo, testutil.CreateTestCardRegistry(), testutil.TestLogger())
+
+	playerID := uuid.New().String()
+	_, err := joinAction.ExecuteForAccount(context.Background(), testGame.ID(), "Alice", playerID, "account-alice")
+	testutil.AssertNoError(t, err, "Failed to join game")
+
+	p, err := testGame.GetPlayer(playerID)
+	testutil.AssertNoError(t, err, "Player should be seated")
+	testutil.AssertEqual(t, "account-alice", p.AccountID(), "Seat is linked to the account")
+
+	_, err = joinAction.Execute(context.Background(), testGame.ID(), "Alice", playerID)
+	testutil.AssertNoError(t, err, "Rejoining should succeed")
+	testutil.AssertEqual(t, "account-alice", p.AccountID(), "Rejoining without an account keeps the link")
+}
@@ -0,0 +1,54 @@
+package action_test
+
+import (
+	"context"
+	"testing"
+
+	tileAction "terraforming-mars-backend/internal/action/tile"
+	"terraforming-mars-backend/internal/game"
+	"terraforming-mars-backend/internal/game/board"
+	"terraforming-mars-backend/internal/game/shared"
+	"terraforming-mars-backend/test/testutil"
+)
+
+func TestSelectTileAction_LandClaimReservesOceanSpace(t *testing.T) {
+	ctx := context.Background()
+	testGame, repo := testutil.CreateTestGameWithPlayers(t, 2, testutil.NewMockBroadcaster())
+	testutil.StartTestGame(t, testGame)
+	playerID := testGame.TurnOrder()[0]
+	otherID := testGame.TurnOrder()[1]
+	oceansBefore := testGame.CountAvailableHexesForTile(board.TileTypeOcean, otherID, nil)
+
+	testutil.AssertNoError(t, testGame.AppendToPendingTileSelectionQueue(ctx, playerID,
+		[]string{string(shared.ResourceLandClaim)}, "Land Claim", nil), "Failed to queue claim")
+	testutil.AssertNoError(t, testGame.ProcessNextTile(ctx, playerID), "Failed to process claim")
+
+	oceanSpace := shared.HexPosition{Q: -4, R: 0, S: 4}
+	selectTile := tileAction.NewSelectTileAction(repo, testutil.CreateTestCardRegistry(), game.NewInMemoryGameStateRepository(), testutil.TestLogger())
+	_, err := selectTile.Execute(ctx, testGame.ID(), playerID, formatHexCoords(oceanSpace))
+	testutil.AssertNoError(t, err, "Land claim may mark an ocean space")
+
+	tile, _ := testGame.Board().GetTile(oceanSpace)
+	testutil.AssertEqual(t, playerID, *tile.ReservedBy, "Ocean space is claimed")
+	testutil.AssertEqual(t, oceansBefore-1, testGame.CountAvailableHexesForTile(board.TileTypeOcean, otherID, nil),
+		"Other players cannot place an ocean on the claim")
+	testutil.AssertEqual(t, oceansBefore, testGame.CountAvailableHexesForTile(board.TileTypeOcean, playerID, nil),
+		"The claimer still can")
+
+	testutil.AssertNoError(t, testGame.AdvanceGeneration(ctx), "Failed to advance generation")
+	tile, _ = testGame.Board().GetTile(oceanSpace)
+	testutil.AssertTrue(t, tile.ReservedBy != nil, "Claim holds until built on")
+}
+
+func TestConcede_ReleasesLandClaims(t *testing.T) {
+	ctx := context.Background()
+	testGame, _ := testutil.CreateTestGameWithPlayers(t, 2, testutil.NewMockBroadcaster())
+	testutil.StartTestGame(t, testGame)
+	pos := shared.HexPosition{Q: 0, R: 0, S: 0}
+
+	testutil.AssertNoError(t, testGame.Board().ReserveTile(ctx, pos, "player-1"), "Failed to reserve tile")
+	testutil.AssertNoError(t, testGame.Concede(ctx, "player-1"), "Failed to concede")
+
+	tile, _ := testGame.Board().GetTile(pos)
+	testutil.AssertTrue(t, tile.ReservedBy == nil, "A conceded player's claims are released")
+}
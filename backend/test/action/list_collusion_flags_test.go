@@ -0,0 +1,80 @@
+package action_test
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"terraforming-mars-backend/internal/action/admin"
+	"terraforming-mars-backend/internal/game"
+	"terraforming-mars-backend/internal/game/shared"
+	"terraforming-mars-backend/test/testutil"
+)
+
+func TestListCollusionFlags_SameAddress(t *testing.T) {
+	testGame, repo := testutil.CreateTestGameWithPlayers(t, 3, testutil.NewMockBroadcaster())
+	addresses := admin.NewPlayerAddresses()
+	action := admin.NewListCollusionFlagsAction(repo, nil, addresses, admin.DefaultCollusionHeuristics(), testutil.TestLogger())
+
+	addresses.Record(testGame.ID(), "player-1", "203.0.113.7")
+	addresses.Record(testGame.ID(), "player-2", "198.51.100.2")
+	flags, err := action.Execute(context.Background())
+	testutil.AssertNoError(t, err, "Listing should succeed")
+	testutil.AssertEqual(t, 0, len(flags), "Distinct addresses raise nothing")
+
+	addresses.Record(testGame.ID(), "player-3", "203.0.113.7")
+	flags, err = action.Execute(context.Background())
+	testutil.AssertNoError(t, err, "Listing should succeed")
+	testutil.AssertEqual(t, 1, len(flags), "A shared address raises one flag")
+	testutil.AssertEqual(t, admin.CollusionFlagSameAddress, flags[0].Kind, "Flag names the heuristic")
+	testutil.AssertTrue(t, slices.Equal([]string{"player-1", "player-3"}, flags[0].PlayerIDs), "Flag names both seats")
+
+	heuristics, err := admin.ParseCollusionHeuristics("targeted-attacks", 0)
+	testutil.AssertNoError(t, err, "Known heuristics parse")
+	action = admin.NewListCollusionFlagsAction(repo, nil, addresses, heuristics, testutil.TestLogger())
+	flags, err = action.Execute(context.Background())
+	testutil.AssertNoError(t, err, "Listing should succeed")
+	testutil.AssertEqual(t, 0, len(flags), "Disabled heuristics raise nothing")
+
+	_, err = admin.ParseCollusionHeuristics("same-ip,auto-ban", 0)
+	testutil.AssertError(t, err, "Unknown heuristics are rejected")
+}
+
+func TestListCollusionFlags_AttacksAimedAtOneOpponent(t *testing.T) {
+	testGame, repo := testutil.CreateTestGameWithPlayers(t, 3, testutil.NewMockBroadcaster())
+	ctx := context.Background()
+	stateRepo := game.NewInMemoryGameStateRepository()
+	action := admin.NewListCollusionFlagsAction(repo, stateRepo, nil, admin.DefaultCollusionHeuristics(), testutil.TestLogger())
+
+	target, _ := testGame.GetPlayer("player-3")
+	target.Resources().Add(map[shared.ResourceType]int{shared.ResourcePlant: 10})
+	_, err := stateRepo.WriteFull(ctx, testGame.ID(), testGame, "Setup", game.SourceTypeInitial, "", "Setup", nil, nil, nil)
+	testutil.AssertNoError(t, err, "State write should succeed")
+
+	attack := func() {
+		target.Resources().Add(map[shared.ResourceType]int{shared.ResourcePlant: -2})
+		_, err := stateRepo.WriteFull(ctx, testGame.ID(), testGame, "Birds", game.SourceTypeCardPlay, "player-1", "Played Birds", nil, nil, nil)
+		testutil.AssertNoError(t, err, "State write should succeed")
+	}
+
+	attack()
+	attack()
+	flags, err := action.Execute(ctx)
+	testutil.AssertNoError(t, err, "Listing should succeed")
+	testutil.AssertEqual(t, 0, len(flags), "Two attacks are below the minimum")
+
+	attack()
+	flags, err = action.Execute(ctx)
+	testutil.AssertNoError(t, err, "Listing should succeed")
+	testutil.AssertEqual(t, 1, len(flags), "Three attacks at one opponent raise a flag")
+	testutil.AssertEqual(t, admin.CollusionFlagTargetedAttacks, flags[0].Kind, "Flag names the heuristic")
+	testutil.AssertTrue(t, slices.Equal([]string{"player-1", "player-3"}, flags[0].PlayerIDs), "Attacker comes first, then the target")
+
+	other, _ := testGame.GetPlayer("player-2")
+	other.Resources().Add(map[shared.ResourceType]int{shared.ResourceCredit: -1})
+	_, err = stateRepo.WriteFull(ctx, testGame.ID(), testGame, "Hackers", game.SourceTypeCardPlay, "player-1", "Played Hackers", nil, nil, nil)
+	testutil.AssertNoError(t, err, "State write should succeed")
+	flags, err = action.Execute(ctx)
+	testutil.AssertNoError(t, err, "Listing should succeed")
+	testutil.AssertEqual(t, 0, len(flags), "Attacking a second opponent clears the pattern")
+}
@@ -0,0 +1,47 @@
+package action_test
+
+import (
+	"context"
+	"testing"
+
+	"terraforming-mars-backend/internal/action/admin"
+	"terraforming-mars-backend/internal/game"
+	"terraforming-mars-backend/internal/game/player"
+	"terraforming-mars-backend/internal/game/shared"
+	"terraforming-mars-backend/test/testutil"
+)
+
+func TestListGameFootprints_EstimatesGrowWithState(t *testing.T) {
+	testGame, repo := testutil.CreateTestGameWithPlayers(t, 2, testutil.NewMockBroadcaster())
+	ctx := context.Background()
+	stateRepo := game.NewInMemoryGameStateRepository()
+	action := admin.NewListGameFootprintsAction(repo, stateRepo, 0, testutil.TestLogger())
+
+	before, err := action.Execute(ctx)
+	testutil.AssertNoError(t, err, "Listing should succeed")
+	testutil.AssertEqual(t, 1, len(before), "One game should be listed")
+	testutil.AssertEqual(t, 2, before[0].Footprint.Players, "Players should be counted")
+	testutil.AssertTrue(t, before[0].Footprint.DeckCards > 0, "Deck cards should be counted")
+	testutil.AssertFalse(t, before[0].OverThreshold, "Fresh game should be under the default threshold")
+
+	p := testGame.GetAllPlayers()[0]
+	p.Actions().AddAction(player.CardAction{CardID: "card-a", Behavior: shared.CardBehavior{}})
+	_, err = stateRepo.WriteFull(ctx, testGame.ID(), testGame, "Test", game.SourceTypeInitial, "", "Setup", nil, nil, nil)
+	testutil.AssertNoError(t, err, "State write should succeed")
+
+	after, err := action.Execute(ctx)
+	testutil.AssertNoError(t, err, "Listing should succeed")
+	testutil.AssertEqual(t, 1, after[0].Footprint.Actions, "Card actions should be counted")
+	testutil.AssertEqual(t, 1, after[0].StateDiffs, "State history should be counted")
+	testutil.AssertTrue(t, after[0].EstimatedBytes > before[0].EstimatedBytes, "Estimate should grow with state")
+}
+
+func TestListGameFootprints_FlagsGamesOverThreshold(t *testing.T) {
+	_, repo := testutil.CreateTestGameWithPlayers(t, 1, testutil.NewMockBroadcaster())
+	action := admin.NewListGameFootprintsAction(repo, nil, 1, testutil.TestLogger())
+
+	footprints, err := action.Execute(context.Background())
+	testutil.AssertNoError(t, err, "Listing should succeed")
+	testutil.AssertTrue(t, footprints[0].OverThreshold, "Game should exceed a 1-byte threshold")
+	testutil.AssertEqual(t, int64(1), action.Threshold(), "Configured threshold should be kept")
+}
@@ -0,0 +1,36 @@
+package action_test
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	gameAction "terraforming-mars-backend/internal/action/game"
+	"terraforming-mars-backend/internal/delivery/dto"
+	"terraforming-mars-backend/test/testutil"
+)
+
+func TestSetNote_OnlyOwnerSeesIt(t *testing.T) {
+	testGame, repo := testutil.CreateTestGameWithPlayers(t, 2, testutil.NewMockBroadcaster())
+	action := gameAction.NewSetNoteAction(repo, testutil.TestLogger())
+	ctx := context.Background()
+	registry := testutil.CreateTestCardRegistry()
+
+	err := action.Execute(ctx, testGame.ID(), "player-1", "  plan: rush Tharsis milestone next gen\n")
+	testutil.AssertNoError(t, err, "Note should be saved")
+
+	ownView := dto.ToGameDto(testGame, registry, "player-1")
+	testutil.AssertEqual(t, "plan: rush Tharsis milestone next gen", ownView.CurrentPlayer.Note, "Owner sees the trimmed note")
+
+	otherView, err := json.Marshal(dto.ToGameDto(testGame, registry, "player-2"))
+	testutil.AssertNoError(t, err, "View should serialize")
+	testutil.AssertFalse(t, strings.Contains(string(otherView), "Tharsis milestone"), "Other players never see the note")
+
+	err = action.Execute(ctx, testGame.ID(), "player-1", strings.Repeat("x", gameAction.MaxNoteLength+1))
+	testutil.AssertError(t, err, "Overlong notes are rejected")
+	testutil.AssertEqual(t, "plan: rush Tharsis milestone next gen", dto.ToGameDto(testGame, registry, "player-1").CurrentPlayer.Note, "A rejected note keeps the old one")
+
+	testutil.AssertNoError(t, action.Execute(ctx, testGame.ID(), "player-1", ""), "Empty note should clear it")
+	testutil.AssertEqual(t, "", dto.ToGameDto(testGame, registry, "player-1").CurrentPlayer.Note, "Note is cleared")
+}
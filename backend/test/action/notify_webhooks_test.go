@@ -0,0 +1,62 @@
+package action_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	gameaction "terraforming-mars-backend/internal/action/game"
+	"terraforming-mars-backend/internal/game"
+	"terraforming-mars-backend/internal/webhook"
+	"terraforming-mars-backend/test/testutil"
+)
+
+func TestNotifyWebhooks_AnnouncesLifecycleOnce(t *testing.T) {
+	ctx := context.Background()
+	g, repo := testutil.CreateTestGameWithPlayers(t, 2, testutil.NewMockBroadcaster())
+	sender := webhook.NewSender(nil, "", testutil.TestLogger())
+	action := gameaction.NewNotifyWebhooksAction(repo, sender, "https://mars.example.org/", testutil.TestLogger())
+
+	payloads := action.Notify(ctx, time.Now())
+	testutil.AssertEqual(t, 1, len(payloads), "A new lobby is announced")
+	testutil.AssertEqual(t, webhook.EventGameCreated, payloads[0].Event, "A new lobby is announced as created")
+	testutil.AssertEqual(t, "https://mars.example.org/join?code="+g.ID(), payloads[0].Game.JoinURL, "Lobbies link to the join page")
+	testutil.AssertEqual(t, 0, len(action.Notify(ctx, time.Now())), "An unchanged lobby is not announced again")
+
+	testutil.StartTestGame(t, g)
+	payloads = action.Notify(ctx, time.Now())
+	testutil.AssertEqual(t, 1, len(payloads), "The start is announced")
+	testutil.AssertEqual(t, webhook.EventGameStarted, payloads[0].Event, "The start is announced as started")
+	testutil.AssertEqual(t, "", payloads[0].Game.JoinURL, "Running games have no join link")
+
+	scores := []game.FinalScore{
+		{PlayerID: "player-2", PlayerName: "Player B", Placement: 1, IsWinner: true, Breakdown: game.VPBreakdown{TotalVP: 71}},
+		{PlayerID: "player-1", PlayerName: "Player A", Placement: 2, Breakdown: game.VPBreakdown{TotalVP: 64}},
+	}
+	testutil.AssertNoError(t, g.SetFinalScores(ctx, scores, "player-2", false), "Failed to set final scores")
+	testutil.AssertNoError(t, g.UpdateStatus(ctx, game.GameStatusCompleted), "Failed to complete game")
+	payloads = action.Notify(ctx, time.Now())
+	testutil.AssertEqual(t, 1, len(payloads), "The result is announced")
+	testutil.AssertEqual(t, webhook.EventGameFinished, payloads[0].Event, "The result is announced as finished")
+	testutil.AssertEqual(t, 2, len(payloads[0].Results), "Every player's result is included")
+	testutil.AssertEqual(t, "Player B", payloads[0].Game.WinnerName, "The winner is named")
+	testutil.AssertTrue(t, strings.Contains(payloads[0].Summary, "Player B wins: 1. Player B 71 VP, 2. Player A 64 VP"), "The summary lists the results")
+	testutil.AssertEqual(t, 0, len(action.Notify(ctx, time.Now())), "A finished game is announced once")
+}
+
+func TestNotifyWebhooks_SkipsPrivateGames(t *testing.T) {
+	ctx := context.Background()
+	repo := game.NewInMemoryGameRepository()
+	for i, settings := range []game.GameSettings{
+		{MaxPlayers: 2, PassAndPlay: true},
+		{MaxPlayers: 2, DemoGame: true},
+		{MaxPlayers: 2, DevelopmentMode: true},
+	} {
+		g := game.NewGame(fmt.Sprintf("private-%d", i), "", settings)
+		testutil.AssertNoError(t, repo.Create(ctx, g), "Failed to create game")
+	}
+	action := gameaction.NewNotifyWebhooksAction(repo, webhook.NewSender(nil, "", testutil.TestLogger()), "", testutil.TestLogger())
+	testutil.AssertEqual(t, 0, len(action.Notify(ctx, time.Now())), "Pass-and-play, demo and development games are never announced")
+}
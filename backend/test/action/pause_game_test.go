@@ -0,0 +1,70 @@
+package action_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	gameAction "terraforming-mars-backend/internal/action/game"
+	turnAction "terraforming-mars-backend/internal/action/turn_management"
+	"terraforming-mars-backend/internal/game"
+	"terraforming-mars-backend/test/testutil"
+)
+
+func TestPauseGameAction_HostPausesAndGameplayIsRejected(t *testing.T) {
+	testGame, repo := testutil.CreateTestGameWithPlayers(t, 2, testutil.NewMockBroadcaster())
+	testutil.StartTestGame(t, testGame)
+	logger := testutil.TestLogger()
+	ctx := context.Background()
+
+	pauseAction := gameAction.NewPauseGameAction(repo, logger)
+	err := pauseAction.Execute(ctx, testGame.ID(), "player-1")
+	testutil.AssertNoError(t, err, "Host should pause immediately")
+	testutil.AssertTrue(t, testGame.IsPaused(), "Game should be paused")
+	testutil.AssertEqual(t, "player-1", testGame.PauseState().PausedBy, "Pause should record the host")
+
+	skipAction := turnAction.NewSkipActionAction(repo, nil, nil, logger)
+	err = skipAction.Execute(ctx, testGame.ID(), testGame.CurrentTurn().PlayerID())
+	testutil.AssertTrue(t, errors.Is(err, game.ErrGamePaused), "Gameplay should be rejected while paused")
+
+	err = pauseAction.Execute(ctx, testGame.ID(), "player-1")
+	testutil.AssertError(t, err, "A paused game cannot be paused again")
+
+	err = gameAction.NewResumeGameAction(repo, logger).Execute(ctx, testGame.ID(), "player-1")
+	testutil.AssertNoError(t, err, "Host should resume immediately")
+	testutil.AssertFalse(t, testGame.IsPaused(), "Game should be running again")
+}
+
+func TestPauseGameAction_UnanimousVote(t *testing.T) {
+	testGame, repo := testutil.CreateTestGameWithPlayers(t, 3, testutil.NewMockBroadcaster())
+	testutil.StartTestGame(t, testGame)
+	logger := testutil.TestLogger()
+	ctx := context.Background()
+
+	// The host stepped away; the connected players decide together
+	for _, p := range testGame.GetAllPlayers() {
+		p.SetConnected(p.ID() != "player-1")
+	}
+
+	pauseAction := gameAction.NewPauseGameAction(repo, logger)
+	testutil.AssertNoError(t, pauseAction.Execute(ctx, testGame.ID(), "player-2"), "Vote should be recorded")
+	testutil.AssertFalse(t, testGame.IsPaused(), "One vote of two should not pause")
+	testutil.AssertEqual(t, 1, len(testGame.PauseVotes()), "Vote should be visible")
+
+	testutil.AssertNoError(t, pauseAction.Execute(ctx, testGame.ID(), "player-3"), "Vote should be recorded")
+	testutil.AssertTrue(t, testGame.IsPaused(), "Every connected player voted, so the game pauses")
+	testutil.AssertEqual(t, 0, len(testGame.PauseVotes()), "Votes reset once the game pauses")
+
+	resumeAction := gameAction.NewResumeGameAction(repo, logger)
+	testutil.AssertNoError(t, resumeAction.Execute(ctx, testGame.ID(), "player-3"), "Vote should be recorded")
+	testutil.AssertTrue(t, testGame.IsPaused(), "One resume vote of two should not resume")
+	testutil.AssertNoError(t, resumeAction.Execute(ctx, testGame.ID(), "player-2"), "Vote should be recorded")
+	testutil.AssertFalse(t, testGame.IsPaused(), "Every connected player voted, so the game resumes")
+}
+
+func TestPauseGameAction_RequiresActiveGame(t *testing.T) {
+	testGame, repo := testutil.CreateTestGameWithPlayers(t, 2, testutil.NewMockBroadcaster())
+
+	err := gameAction.NewPauseGameAction(repo, testutil.TestLogger()).Execute(context.Background(), testGame.ID(), "player-1")
+	testutil.AssertError(t, err, "Lobby games cannot be paused")
+}
@@ -4,8 +4,11 @@ import (
 	"context"
 	"testing"
 
+	"terraforming-mars-backend/internal/action"
 	cardAction "terraforming-mars-backend/internal/action/card"
 	"terraforming-mars-backend/internal/game"
+	gamecards "terraforming-mars-backend/internal/game/cards"
+	"terraforming-mars-backend/internal/game/player"
 	"terraforming-mars-backend/internal/game/shared"
 	"terraforming-mars-backend/test/testutil"
 )
@@ -310,3 +313,184 @@ func TestPlayCardAction_StealPartialAmount(t *testing.T) {
 	attackerResources := attacker.Resources().Get()
 	testutil.AssertEqual(t, 1, attackerResources.Steel, "Attacker should have gained only 1 steel")
 }
+
+func TestPlayCardAction_ProductionAttackNeedsTargetProduction(t *testing.T) {
+	broadcaster := testutil.NewMockBroadcaster()
+	testGame, repo := testutil.CreateTestGameWithPlayers(t, 2, broadcaster)
+	cardRegistry := testutil.CreateTestCardRegistry()
+	ctx := context.Background()
+
+	players := testGame.GetAllPlayers()
+	attacker := players[0]
+	target := players[1]
+
+	testGame.UpdateStatus(ctx, game.GameStatusActive)
+	testGame.UpdatePhase(ctx, game.GamePhaseAction)
+	testGame.SetCurrentTurn(ctx, attacker.ID(), 2)
+
+	attacker.Resources().Add(map[shared.ResourceType]int{shared.ResourceCredit: 100})
+	attacker.Resources().AddProduction(map[shared.ResourceType]int{shared.ResourceTitaniumProduction: 1})
+	attacker.Hand().AddCard("card-asteroid-mining-consortium")
+
+	playCardAction := cardAction.NewPlayCardAction(repo, cardRegistry, nil, testutil.TestLogger())
+	payment := cardAction.PaymentRequest{Credits: 13}
+	targetID := target.ID()
+	err := playCardAction.Execute(ctx, testGame.ID(), attacker.ID(), "card-asteroid-mining-consortium", payment, nil, nil, &targetID)
+	testutil.AssertError(t, err, "Target without titanium production cannot be attacked")
+
+	testutil.AssertEqual(t, 0, target.Resources().Production().Titanium, "Target production is unchanged")
+	testutil.AssertEqual(t, 1, attacker.Resources().Production().Titanium, "Attacker production is rolled back")
+	testutil.AssertEqual(t, 100, attacker.Resources().Get().Credits, "Payment is rolled back")
+	testutil.AssertTrue(t, attacker.Hand().HasCard("card-asteroid-mining-consortium"), "Card returns to hand")
+
+	// The attacker may target themselves when no one else has the production
+	selfID := attacker.ID()
+	err = playCardAction.Execute(ctx, testGame.ID(), attacker.ID(), "card-asteroid-mining-consortium", payment, nil, nil, &selfID)
+	testutil.AssertNoError(t, err, "Attacker can take the decrease themselves")
+	testutil.AssertEqual(t, 1, attacker.Resources().Production().Titanium, "Gain and loss cancel out")
+}
+
+func TestPlayCardAction_AttackTargetMustAbsorbTheLoss(t *testing.T) {
+	testGame, repo := testutil.CreateTestGameWithPlayers(t, 3, testutil.NewMockBroadcaster())
+	cardRegistry := testutil.CreateTestCardRegistry()
+	ctx := context.Background()
+
+	players := testGame.GetAllPlayers()
+	attacker, empty, stocked := players[0], players[1], players[2]
+
+	testGame.UpdateStatus(ctx, game.GameStatusActive)
+	testGame.UpdatePhase(ctx, game.GamePhaseAction)
+	testGame.SetCurrentTurn(ctx, attacker.ID(), 2)
+
+	attacker.Resources().Add(map[shared.ResourceType]int{shared.ResourceCredit: 100, shared.ResourcePlant: 1})
+	stocked.Resources().Add(map[shared.ResourceType]int{shared.ResourcePlant: 4})
+	attacker.Hand().AddCard("card-asteroid")
+
+	asteroid, err := cardRegistry.GetByID("card-asteroid")
+	testutil.AssertNoError(t, err, "Asteroid should be registered")
+	state := action.CalculatePlayerCardState(asteroid, attacker, testGame, cardRegistry)
+	targets, _ := state.Metadata["legalTargets"].([]string)
+	testutil.AssertEqual(t, 2, len(targets), "Players with plants are legal targets")
+	testutil.AssertEqual(t, attacker.ID(), targets[0], "Any player may be you")
+	testutil.AssertEqual(t, stocked.ID(), targets[1], "Players are listed in turn order")
+
+	playCardAction := cardAction.NewPlayCardAction(repo, cardRegistry, nil, testutil.TestLogger())
+	payment := cardAction.PaymentRequest{Credits: 14}
+	emptyID := empty.ID()
+	err = playCardAction.Execute(ctx, testGame.ID(), attacker.ID(), "card-asteroid", payment, nil, nil, &emptyID)
+	testutil.AssertError(t, err, "A player without plants is not a legal target")
+	testutil.AssertTrue(t, attacker.Hand().HasCard("card-asteroid"), "Rejected play is rolled back")
+
+	stockedID := stocked.ID()
+	err = playCardAction.Execute(ctx, testGame.ID(), attacker.ID(), "card-asteroid", payment, nil, nil, &stockedID)
+	testutil.AssertNoError(t, err, "A player with plants can be targeted")
+	testutil.AssertEqual(t, 1, stocked.Resources().Get().Plants, "Target loses 3 plants")
+}
+
+func TestPlayCardAction_AttackWithoutLegalTargetIsSkippedAndLogged(t *testing.T) {
+	testGame, repo := testutil.CreateTestGameWithPlayers(t, 2, testutil.NewMockBroadcaster())
+	cardRegistry := testutil.CreateTestCardRegistry()
+	stateRepo := game.NewInMemoryGameStateRepository()
+	ctx := context.Background()
+
+	players := testGame.GetAllPlayers()
+	attacker, target := players[0], players[1]
+
+	testGame.UpdateStatus(ctx, game.GameStatusActive)
+	testGame.UpdatePhase(ctx, game.GamePhaseAction)
+	testGame.SetCurrentTurn(ctx, attacker.ID(), 2)
+
+	attacker.Resources().Add(map[shared.ResourceType]int{shared.ResourceCredit: 100})
+	attacker.Hand().AddCard("card-asteroid")
+
+	playCardAction := cardAction.NewPlayCardAction(repo, cardRegistry, stateRepo, testutil.TestLogger())
+	payment := cardAction.PaymentRequest{Credits: 14}
+	targetID := target.ID()
+	err := playCardAction.Execute(ctx, testGame.ID(), attacker.ID(), "card-asteroid", payment, nil, nil, &targetID)
+	testutil.AssertNoError(t, err, "Card is still played when nobody can lose plants")
+	testutil.AssertEqual(t, 2, attacker.Resources().Get().Titanium, "Other behaviors still apply")
+
+	diffs, err := stateRepo.GetDiff(ctx, testGame.ID())
+	testutil.AssertNoError(t, err, "Play should be logged")
+	testutil.AssertEqual(t, "Played Asteroid for 14 credits; no player could lose 3 plants", diffs[len(diffs)-1].Description,
+		"Log names the skipped attack")
+}
+
+func TestPlayCardAction_AttackerMayRemoveFewerPlants(t *testing.T) {
+	testGame, repo := testutil.CreateTestGameWithPlayers(t, 2, testutil.NewMockBroadcaster())
+	cardRegistry := testutil.CreateTestCardRegistry()
+	ctx := context.Background()
+
+	players := testGame.GetAllPlayers()
+	attacker, target := players[0], players[1]
+
+	testGame.UpdateStatus(ctx, game.GameStatusActive)
+	testGame.UpdatePhase(ctx, game.GamePhaseAction)
+	testGame.SetCurrentTurn(ctx, attacker.ID(), 2)
+
+	attacker.Resources().Add(map[shared.ResourceType]int{shared.ResourceCredit: 100})
+	target.Resources().Add(map[shared.ResourceType]int{shared.ResourcePlant: 5})
+	attacker.Hand().AddCard("card-asteroid")
+
+	playCardAction := cardAction.NewPlayCardAction(repo, cardRegistry, nil, testutil.TestLogger())
+	payment := cardAction.PaymentRequest{Credits: 14}
+	targetID := target.ID()
+
+	tooMany := 4
+	err := playCardAction.ExecuteWithOptions(ctx, testGame.ID(), attacker.ID(), "card-asteroid", payment, nil, nil, &targetID, cardAction.PlayOptions{AttackAmount: &tooMany})
+	testutil.AssertError(t, err, "Cannot remove more plants than the card allows")
+
+	one := 1
+	err = playCardAction.ExecuteWithOptions(ctx, testGame.ID(), attacker.ID(), "card-asteroid", payment, nil, nil, &targetID, cardAction.PlayOptions{AttackAmount: &one})
+	testutil.AssertNoError(t, err, "Attacker may remove fewer plants")
+	testutil.AssertEqual(t, 4, target.Resources().Get().Plants, "Target loses only the chosen plants")
+	testutil.AssertEqual(t, 2, attacker.Resources().Get().Titanium, "Other behaviors still apply")
+}
+
+func TestPlayCardAction_AttackerMayRemoveNothing(t *testing.T) {
+	testGame, repo := testutil.CreateTestGameWithPlayers(t, 2, testutil.NewMockBroadcaster())
+	cardRegistry := testutil.CreateTestCardRegistry()
+	ctx := context.Background()
+
+	players := testGame.GetAllPlayers()
+	attacker, target := players[0], players[1]
+
+	testGame.UpdateStatus(ctx, game.GameStatusActive)
+	testGame.UpdatePhase(ctx, game.GamePhaseAction)
+	testGame.SetCurrentTurn(ctx, attacker.ID(), 2)
+
+	attacker.Resources().Add(map[shared.ResourceType]int{shared.ResourceCredit: 100})
+	target.Resources().Add(map[shared.ResourceType]int{shared.ResourcePlant: 5})
+	attacker.Hand().AddCard("card-asteroid")
+
+	playCardAction := cardAction.NewPlayCardAction(repo, cardRegistry, nil, testutil.TestLogger())
+	payment := cardAction.PaymentRequest{Credits: 14}
+	none := 0
+	err := playCardAction.ExecuteWithOptions(ctx, testGame.ID(), attacker.ID(), "card-asteroid", payment, nil, nil, nil, cardAction.PlayOptions{AttackAmount: &none})
+	testutil.AssertNoError(t, err, "Removing nothing needs no target")
+	testutil.AssertEqual(t, 5, target.Resources().Get().Plants, "Target keeps their plants")
+}
+
+func TestLegalAttackTargets_SkipsProtectedPlayers(t *testing.T) {
+	testGame, _ := testutil.CreateTestGameWithPlayers(t, 3, testutil.NewMockBroadcaster())
+	players := testGame.GetAllPlayers()
+	attacker, protected, exposed := players[0], players[1], players[2]
+
+	for _, p := range players {
+		p.Resources().Add(map[shared.ResourceType]int{shared.ResourcePlant: 5})
+	}
+	protected.Effects().AddEffect(player.CardEffect{
+		CardID:   "card-protected-habitats",
+		CardName: "Protected Habitats",
+		Behavior: shared.CardBehavior{Outputs: []shared.ResourceCondition{{
+			ResourceType: shared.ResourceDefense,
+			Selectors:    []shared.Selector{{Resources: []string{"plant", "animal", "microbe"}}},
+		}}},
+	})
+
+	plantAttack := shared.ResourceCondition{ResourceType: shared.ResourcePlant, Amount: 3, Target: "any-player"}
+	targets := gamecards.LegalAttackTargets(players, attacker.ID(), []shared.ResourceCondition{plantAttack})
+	testutil.AssertEqual(t, 2, len(targets), "The protected opponent is not a legal target")
+	testutil.AssertEqual(t, attacker.ID(), targets[0], "The attacker may still target themselves")
+	testutil.AssertEqual(t, exposed.ID(), targets[1], "Unprotected opponents remain targets")
+}
@@ -0,0 +1,115 @@
+package action_test
+
+import (
+	"context"
+	"testing"
+
+	cardAction "terraforming-mars-backend/internal/action/card"
+	"terraforming-mars-backend/internal/cards"
+	"terraforming-mars-backend/internal/events"
+	"terraforming-mars-backend/internal/game"
+	gamecards "terraforming-mars-backend/internal/game/cards"
+	"terraforming-mars-backend/internal/game/shared"
+	"terraforming-mars-backend/test/testutil"
+)
+
+func previewRegistry() cards.CardRegistry {
+	return cards.NewInMemoryCardRegistry([]gamecards.Card{{
+		ID:   "card-greenhouse-gas",
+		Name: "Greenhouse Gas",
+		Type: gamecards.CardTypeAutomated,
+		Pack: "base",
+		Cost: 10,
+		Behaviors: []shared.CardBehavior{{
+			Triggers: []shared.Trigger{{Type: "auto"}},
+			Outputs: []shared.ResourceCondition{
+				{ResourceType: shared.ResourcePlantProduction, Amount: 2, Target: "self-player"},
+				{ResourceType: shared.ResourceOxygen, Amount: 1, Target: "none"},
+			},
+		}},
+	}})
+}
+
+func TestPreviewAction_ReturnsDiffsWithoutCommitting(t *testing.T) {
+	testGame, repo, _, playerID := setupSoloGame(t)
+	registry := previewRegistry()
+	ctx := context.Background()
+
+	p, _ := testGame.GetPlayer(playerID)
+	testutil.SetPlayerCredits(ctx, p, 30)
+	p.Hand().AddCard("card-greenhouse-gas")
+	oxygenBefore := testGame.GlobalParameters().Oxygen()
+
+	preview := cardAction.NewPreviewActionAction(repo, registry, testutil.TestLogger())
+	diffs, err := preview.Execute(ctx, testGame.ID(), playerID, cardAction.PreviewRequest{
+		Kind:    cardAction.PreviewKindPlayCard,
+		CardID:  "card-greenhouse-gas",
+		Payment: cardAction.PaymentRequest{Credits: 10},
+	})
+	testutil.AssertNoError(t, err, "Preview should succeed")
+	testutil.AssertTrue(t, len(diffs) > 0, "Preview returns the action's log entries")
+
+	changes := diffs[len(diffs)-1].Changes
+	testutil.AssertEqual(t, 2, changes.PlayerChanges[playerID].PlantsProduction.New-changes.PlayerChanges[playerID].PlantsProduction.Old,
+		"Diff shows +2 plant production")
+	testutil.AssertEqual(t, oxygenBefore+1, changes.Oxygen.New, "Diff shows the oxygen raise")
+
+	testutil.AssertEqual(t, 30, p.Resources().Get().Credits, "Nothing is paid")
+	testutil.AssertEqual(t, 0, p.Resources().Production().Plants, "Production is unchanged")
+	testutil.AssertEqual(t, oxygenBefore, testGame.GlobalParameters().Oxygen(), "Oxygen is unchanged")
+	testutil.AssertTrue(t, p.Hand().HasCard("card-greenhouse-gas"), "Card stays in hand")
+}
+
+func TestPreviewAction_ReportsRejection(t *testing.T) {
+	testGame, repo, _, playerID := setupSoloGame(t)
+	ctx := context.Background()
+
+	p, _ := testGame.GetPlayer(playerID)
+	testutil.SetPlayerCredits(ctx, p, 5)
+	p.Hand().AddCard("card-greenhouse-gas")
+
+	preview := cardAction.NewPreviewActionAction(repo, previewRegistry(), testutil.TestLogger())
+	_, err := preview.Execute(ctx, testGame.ID(), playerID, cardAction.PreviewRequest{
+		Kind:    cardAction.PreviewKindPlayCard,
+		CardID:  "card-greenhouse-gas",
+		Payment: cardAction.PaymentRequest{Credits: 10},
+	})
+	testutil.AssertError(t, err, "Preview fails like the real action when the player cannot pay")
+	testutil.AssertEqual(t, 5, p.Resources().Get().Credits, "Nothing is paid")
+}
+
+func TestPreviewAction_RunsOnACloneWithThePlayOptions(t *testing.T) {
+	testGame, repo := testutil.CreateTestGameWithPlayers(t, 2, testutil.NewMockBroadcaster())
+	ctx := context.Background()
+
+	players := testGame.GetAllPlayers()
+	attacker, target := players[0], players[1]
+	testGame.UpdateStatus(ctx, game.GameStatusActive)
+	testGame.UpdatePhase(ctx, game.GamePhaseAction)
+	testGame.SetCurrentTurn(ctx, attacker.ID(), 2)
+	attacker.Resources().Add(map[shared.ResourceType]int{shared.ResourceCredit: 100})
+	target.Resources().Add(map[shared.ResourceType]int{shared.ResourcePlant: 5})
+	attacker.Hand().AddCard("card-asteroid")
+
+	published := 0
+	events.Subscribe(testGame.EventBus(), func(events.ResourcesChangedEvent) { published++ })
+
+	one := 1
+	targetID := target.ID()
+	preview := cardAction.NewPreviewActionAction(repo, testutil.CreateTestCardRegistry(), testutil.TestLogger())
+	diffs, err := preview.Execute(ctx, testGame.ID(), attacker.ID(), cardAction.PreviewRequest{
+		Kind:           cardAction.PreviewKindPlayCard,
+		CardID:         "card-asteroid",
+		Payment:        cardAction.PaymentRequest{Credits: 14},
+		TargetPlayerID: &targetID,
+		Options:        cardAction.PlayOptions{AttackAmount: &one},
+	})
+	testutil.AssertNoError(t, err, "Preview should succeed")
+
+	plants := diffs[len(diffs)-1].Changes.PlayerChanges[target.ID()].Plants
+	testutil.AssertEqual(t, 4, plants.New, "The chosen attack amount is previewed")
+	testutil.AssertEqual(t, 0, published, "No event reaches the live game's listeners")
+	testutil.AssertEqual(t, 5, target.Resources().Get().Plants, "The target keeps their plants")
+	testutil.AssertEqual(t, 100, attacker.Resources().Get().Credits, "Nothing is paid")
+	testutil.AssertTrue(t, attacker.Hand().HasCard("card-asteroid"), "Card stays in hand")
+}
@@ -0,0 +1,66 @@
+package action_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	gameAction "terraforming-mars-backend/internal/action/game"
+	"terraforming-mars-backend/internal/game"
+	"terraforming-mars-backend/test/testutil"
+)
+
+func TestSendReaction_LogsAndRateLimits(t *testing.T) {
+	testGame, repo := testutil.CreateTestGameWithPlayers(t, 2, testutil.NewMockBroadcaster())
+	stateRepo := game.NewInMemoryGameStateRepository()
+	action := gameAction.NewSendReactionAction(repo, stateRepo, testutil.TestLogger())
+	ctx := context.Background()
+
+	sent, err := action.Execute(ctx, testGame.ID(), "player-1", game.ReactionNiceMove)
+	testutil.AssertNoError(t, err, "Known reaction should be sent")
+	testutil.AssertEqual(t, "Player A", sent.PlayerName, "Reaction should carry the sender's name")
+
+	diffs, err := stateRepo.GetDiff(ctx, testGame.ID())
+	testutil.AssertNoError(t, err, "Reaction should be logged")
+	testutil.AssertEqual(t, 1, len(diffs), "One log entry should be written")
+	testutil.AssertEqual(t, game.SourceTypeReaction, diffs[0].SourceType, "Log entry should be marked as a reaction")
+
+	_, err = action.Execute(ctx, testGame.ID(), "player-1", game.Reaction("rude-gesture"))
+	testutil.AssertTrue(t, errors.Is(err, game.ErrUnknownReaction), "Reactions outside the set are rejected")
+
+	for i := 1; i < gameAction.ReactionBurst; i++ {
+		_, err = action.Execute(ctx, testGame.ID(), "player-1", game.ReactionThumbsUp)
+		testutil.AssertNoError(t, err, "Reactions within the burst should be sent")
+	}
+	_, err = action.Execute(ctx, testGame.ID(), "player-1", game.ReactionThumbsUp)
+	testutil.AssertTrue(t, errors.Is(err, gameAction.ErrReactionRateLimited), "Reactions beyond the burst are rate limited")
+
+	_, err = action.Execute(ctx, testGame.ID(), "player-2", game.ReactionWow)
+	testutil.AssertNoError(t, err, "Each player has their own limit")
+}
+
+func TestMuteReactions_HidesChosenSenders(t *testing.T) {
+	testGame, repo := testutil.CreateTestGameWithPlayers(t, 3, testutil.NewMockBroadcaster())
+	action := gameAction.NewMuteReactionsAction(repo, testutil.TestLogger())
+	ctx := context.Background()
+
+	err := action.Execute(ctx, testGame.ID(), "player-1", "player-1", true)
+	testutil.AssertError(t, err, "Players cannot mute themselves")
+	err = action.Execute(ctx, testGame.ID(), "player-1", "player-9", true)
+	testutil.AssertError(t, err, "Only seated players can be muted")
+
+	err = action.Execute(ctx, testGame.ID(), "player-1", "player-2", true)
+	testutil.AssertNoError(t, err, "Another player can be muted")
+	viewer, _ := testGame.GetPlayer("player-1")
+	testutil.AssertTrue(t, viewer.HidesReactionsFrom("player-2"), "Muted player's reactions are hidden")
+	testutil.AssertTrue(t, !viewer.HidesReactionsFrom("player-3"), "Other players' reactions still show")
+
+	err = action.Execute(ctx, testGame.ID(), "player-1", "", true)
+	testutil.AssertNoError(t, err, "All reactions can be muted")
+	testutil.AssertTrue(t, viewer.HidesReactionsFrom("player-3"), "Muting all hides every sender")
+	testutil.AssertTrue(t, !viewer.HidesReactionsFrom("player-1"), "A player always sees their own reactions")
+
+	err = action.Execute(ctx, testGame.ID(), "player-1", "", false)
+	testutil.AssertNoError(t, err, "Reactions can be unmuted")
+	testutil.AssertTrue(t, viewer.HidesReactionsFrom("player-2"), "Per-player mutes survive unmuting all")
+}
@@ -0,0 +1,114 @@
+package action_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	confirmAction "terraforming-mars-backend/internal/action/confirmation"
+	stdprojAction "terraforming-mars-backend/internal/action/standard_project"
+	"terraforming-mars-backend/internal/cards"
+	"terraforming-mars-backend/internal/game"
+	gamecards "terraforming-mars-backend/internal/game/cards"
+	"terraforming-mars-backend/internal/game/player"
+	"terraforming-mars-backend/internal/game/shared"
+	"terraforming-mars-backend/test/testutil"
+)
+
+// openSabotage applies Sabotage from the card file ("lose 3 titanium or 4 steel or 7 M€") from attacker to target
+func openSabotage(t *testing.T, g *game.Game, attacker *player.Player, targetID string) {
+	t.Helper()
+	allCards, err := cards.LoadCardsFromJSON("../../assets/terraforming_mars_cards.json")
+	testutil.AssertNoError(t, err, "Card file should load")
+	var sabotage *gamecards.Card
+	for i := range allCards {
+		if allCards[i].Name == "Sabotage" {
+			sabotage = &allCards[i]
+		}
+	}
+	testutil.AssertTrue(t, sabotage != nil && len(sabotage.Behaviors) == 1, "Sabotage should have one behavior")
+	behavior := sabotage.Behaviors[0]
+	testutil.AssertTrue(t, behavior.TargetChooses(), "Sabotage's target chooses what to lose")
+
+	_, outputs := behavior.ExtractInputsOutputs(nil)
+	applier := gamecards.NewBehaviorApplier(attacker, g, sabotage.Name, testutil.TestLogger()).WithTargetPlayerID(targetID)
+	testutil.AssertNoError(t, applier.ApplyOutputs(context.Background(), outputs), "Attack should open a response")
+}
+
+func TestRespondToEffect_TargetChoosesWhatToLose(t *testing.T) {
+	testGame, repo := testutil.CreateTestGameWithPlayers(t, 2, testutil.NewMockBroadcaster())
+	testutil.StartTestGame(t, testGame)
+	ctx := context.Background()
+
+	attacker, _ := testGame.GetPlayer(testGame.CurrentTurn().PlayerID())
+	targetID := "player-1"
+	if attacker.ID() == targetID {
+		targetID = "player-2"
+	}
+	target, _ := testGame.GetPlayer(targetID)
+	target.Resources().Add(map[shared.ResourceType]int{shared.ResourceSteel: 2, shared.ResourceCredit: 20})
+	attacker.Resources().Add(map[shared.ResourceType]int{shared.ResourceCredit: 20})
+
+	openSabotage(t, testGame, attacker, targetID)
+
+	testutil.AssertEqual(t, 2, target.Resources().Get().Steel, "Nothing is lost before the target answers")
+	waiting := testGame.WaitingOn()
+	testutil.AssertEqual(t, 1, len(waiting), "Only the target is waited on")
+	testutil.AssertEqual(t, targetID, waiting[0], "The target owes the response")
+
+	launchAsteroid := stdprojAction.NewLaunchAsteroidAction(repo, nil, testutil.TestLogger())
+	err := launchAsteroid.Execute(ctx, testGame.ID(), attacker.ID())
+	testutil.AssertError(t, err, "Gameplay waits on the response")
+	testutil.AssertEqual(t, game.ErrAwaitingResponse, err, "Rejection names the pending response")
+
+	respond := confirmAction.NewRespondToEffectAction(repo, nil, testutil.TestLogger())
+	testutil.AssertError(t, respond.Execute(ctx, testGame.ID(), attacker.ID(), 0), "The attacker owes no response")
+	testutil.AssertError(t, respond.Execute(ctx, testGame.ID(), targetID, 3), "Option index must be in range")
+
+	testutil.AssertNoError(t, respond.Execute(ctx, testGame.ID(), targetID, 1), "Target should answer")
+	testutil.AssertEqual(t, 0, target.Resources().Get().Steel, "Steel loss is clamped to what the target has")
+	testutil.AssertEqual(t, 20, target.Resources().Get().Credits, "Other options are not applied")
+	testutil.AssertFalse(t, testGame.AwaitingResponses(), "Response is cleared")
+
+	testutil.AssertNoError(t, launchAsteroid.Execute(ctx, testGame.ID(), attacker.ID()), "Gameplay resumes after the response")
+}
+
+func TestRespondToEffect_TimeoutAppliesDefaultAndHonorsPause(t *testing.T) {
+	testGame, repo := testutil.CreateTestGameWithPlayers(t, 2, testutil.NewMockBroadcaster())
+	testutil.StartTestGame(t, testGame)
+	ctx := context.Background()
+
+	attacker, _ := testGame.GetPlayer("player-1")
+	target, _ := testGame.GetPlayer("player-2")
+	target.Resources().Add(map[shared.ResourceType]int{shared.ResourceTitanium: 5})
+
+	openSabotage(t, testGame, attacker, target.ID())
+	deadline, ok := testGame.ResponseDeadline(target.ID())
+	testutil.AssertTrue(t, ok, "Open response has a deadline")
+
+	respond := confirmAction.NewRespondToEffectAction(repo, nil, testutil.TestLogger())
+	testutil.AssertEqual(t, 0, len(respond.ExpireResponses(ctx, deadline.Add(-time.Second), testutil.RunInGame)), "Nothing expires before the deadline")
+
+	testutil.AssertNoError(t, testGame.Pause(ctx, attacker.ID()), "Failed to pause")
+	testutil.AssertEqual(t, 0, len(respond.ExpireResponses(ctx, deadline.Add(time.Second), testutil.RunInGame)), "Paused games are skipped")
+	testutil.AssertNoError(t, testGame.Resume(ctx), "Failed to resume")
+
+	shifted, _ := testGame.ResponseDeadline(target.ID())
+	testutil.AssertFalse(t, shifted.Before(deadline), "Resuming never brings the deadline forward")
+
+	resolved := respond.ExpireResponses(ctx, shifted.Add(time.Second), func(ctx context.Context, gameID string, fn func(context.Context)) error {
+		// The target's answer was queued ahead of the timeout, so it runs first
+		testutil.AssertNoError(t, respond.Execute(ctx, gameID, target.ID(), 2), "Target answers")
+		fn(ctx)
+		return nil
+	})
+	testutil.AssertEqual(t, 0, len(resolved), "An answer queued ahead of the timeout is kept")
+	testutil.AssertEqual(t, 5, target.Resources().Get().Titanium, "The default is not applied on top of the answer")
+
+	openSabotage(t, testGame, attacker, target.ID())
+	reopened, _ := testGame.ResponseDeadline(target.ID())
+	resolved = respond.ExpireResponses(ctx, reopened.Add(time.Second), testutil.RunInGame)
+	testutil.AssertEqual(t, 1, len(resolved), "Expired response is resolved")
+	testutil.AssertEqual(t, 2, target.Resources().Get().Titanium, "Default option is the card's first")
+	testutil.AssertFalse(t, testGame.AwaitingResponses(), "Response is cleared")
+}
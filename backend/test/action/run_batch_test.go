@@ -0,0 +1,91 @@
+package action_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	gameAction "terraforming-mars-backend/internal/action/game"
+	resconvAction "terraforming-mars-backend/internal/action/resource_conversion"
+	"terraforming-mars-backend/internal/game"
+	"terraforming-mars-backend/test/testutil"
+)
+
+func heatSteps(convert *resconvAction.ConvertHeatToTemperatureAction, gameID, playerID string, n int) []gameAction.BatchStep {
+	steps := make([]gameAction.BatchStep, n)
+	for i := range steps {
+		steps[i] = gameAction.BatchStep{
+			Type: "convert-heat",
+			Run: func(ctx context.Context) error {
+				return convert.Execute(ctx, gameID, playerID)
+			},
+		}
+	}
+	return steps
+}
+
+func TestRunBatchAction_KeepsEveryStepWhenAllSucceed(t *testing.T) {
+	testGame, repo, cardRegistry, playerID := setupActiveGame(t)
+	stateRepo := game.NewInMemoryGameStateRepository()
+	ctx := context.Background()
+	player, _ := testGame.GetPlayer(playerID)
+	testutil.SetPlayerHeat(ctx, player, 16)
+	initialTemp := testGame.GlobalParameters().Temperature()
+
+	convert := resconvAction.NewConvertHeatToTemperatureAction(repo, cardRegistry, stateRepo, testutil.TestLogger())
+	batch := gameAction.NewRunBatchAction(repo, stateRepo, testutil.TestLogger())
+
+	results, err := batch.Execute(ctx, testGame.ID(), playerID, heatSteps(convert, testGame.ID(), playerID, 2))
+	testutil.AssertNoError(t, err, "Batch should succeed")
+	testutil.AssertEqual(t, 2, len(results), "One result per step")
+	for _, result := range results {
+		testutil.AssertEqual(t, gameAction.BatchStepSucceeded, result.Status, "Every step succeeded")
+	}
+	testutil.AssertEqual(t, 0, testutil.GetPlayerHeat(player), "Both conversions paid")
+	testutil.AssertEqual(t, initialTemp+4, testGame.GlobalParameters().Temperature(), "Both conversions raised temperature")
+
+	diffs, err := stateRepo.GetDiff(ctx, testGame.ID())
+	testutil.AssertNoError(t, err, "Log should be readable")
+	testutil.AssertEqual(t, 2, len(diffs), "Each step wrote its log entry")
+}
+
+func TestRunBatchAction_RollsBackEarlierStepsOnFailure(t *testing.T) {
+	testGame, repo, cardRegistry, playerID := setupActiveGame(t)
+	stateRepo := game.NewInMemoryGameStateRepository()
+	ctx := context.Background()
+	player, _ := testGame.GetPlayer(playerID)
+	testutil.SetPlayerHeat(ctx, player, 8)
+	initialTemp := testGame.GlobalParameters().Temperature()
+	initialTR := player.Resources().TerraformRating()
+
+	convert := resconvAction.NewConvertHeatToTemperatureAction(repo, cardRegistry, stateRepo, testutil.TestLogger())
+	batch := gameAction.NewRunBatchAction(repo, stateRepo, testutil.TestLogger())
+
+	results, err := batch.Execute(ctx, testGame.ID(), playerID, heatSteps(convert, testGame.ID(), playerID, 3))
+	testutil.AssertTrue(t, errors.Is(err, gameAction.ErrBatchStepFailed), "Batch should report the failed step")
+	testutil.AssertEqual(t, gameAction.BatchStepSucceeded, results[0].Status, "First step ran")
+	testutil.AssertEqual(t, gameAction.BatchStepFailed, results[1].Status, "Second step ran out of heat")
+	testutil.AssertError(t, results[1].Err, "Failed step carries its error")
+	testutil.AssertEqual(t, gameAction.BatchStepSkipped, results[2].Status, "Third step never ran")
+
+	testutil.AssertEqual(t, 8, testutil.GetPlayerHeat(player), "Heat spent by the first step is back")
+	testutil.AssertEqual(t, initialTemp, testGame.GlobalParameters().Temperature(), "Temperature is unchanged")
+	testutil.AssertEqual(t, initialTR, player.Resources().TerraformRating(), "TR is unchanged")
+
+	diffs, err := stateRepo.GetDiff(ctx, testGame.ID())
+	testutil.AssertTrue(t, err != nil || len(diffs) == 0, "The first step's log entry was dropped")
+}
+
+func TestRunBatchAction_RejectsEmptyAndOversizedBatches(t *testing.T) {
+	testGame, repo, cardRegistry, playerID := setupActiveGame(t)
+	convert := resconvAction.NewConvertHeatToTemperatureAction(repo, cardRegistry, nil, testutil.TestLogger())
+	batch := gameAction.NewRunBatchAction(repo, nil, testutil.TestLogger())
+	ctx := context.Background()
+
+	_, err := batch.Execute(ctx, testGame.ID(), playerID, nil)
+	testutil.AssertError(t, err, "Empty batch should be rejected")
+
+	_, err = batch.Execute(ctx, testGame.ID(), playerID, heatSteps(convert, testGame.ID(), playerID, gameAction.MaxBatchSteps+1))
+	testutil.AssertError(t, err, "Oversized batch should be rejected")
+	testutil.AssertFalse(t, errors.Is(err, gameAction.ErrBatchStepFailed), "No step ran")
+}
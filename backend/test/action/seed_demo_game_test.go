@@ -0,0 +1,93 @@
+package action_test
+
+import (
+	"context"
+	"testing"
+
+	"terraforming-mars-backend/internal/action/admin"
+	demoAction "terraforming-mars-backend/internal/action/demo"
+	gameaction "terraforming-mars-backend/internal/action/game"
+	turnAction "terraforming-mars-backend/internal/action/turn_management"
+	"terraforming-mars-backend/internal/game"
+	"terraforming-mars-backend/internal/game/shared"
+	"terraforming-mars-backend/test/testutil"
+)
+
+func newTestSeedDemoGame() (*demoAction.SeedDemoGameAction, game.GameRepository) {
+	repo := game.NewInMemoryGameRepository()
+	cardRegistry := testutil.CreateTestCardRegistry()
+	logger := testutil.TestLogger()
+
+	return demoAction.NewSeedDemoGameAction(
+		repo,
+		cardRegistry,
+		gameaction.NewCreateDemoLobbyAction(repo, cardRegistry, logger),
+		gameaction.NewJoinGameAction(repo, cardRegistry, logger),
+		turnAction.NewStartGameAction(repo, cardRegistry, logger),
+		gameaction.NewConfirmDemoSetupAction(repo, cardRegistry, logger),
+		admin.NewSetupTestStateAction(repo, cardRegistry, logger),
+		logger,
+	), repo
+}
+
+func TestSeedDemoGame_FastForwardsToAMidGameBoard(t *testing.T) {
+	action, repo := newTestSeedDemoGame()
+	ctx := context.Background()
+
+	result, err := action.Execute(ctx, demoAction.SeedSettings{CardPacks: []string{"base"}})
+	testutil.AssertNoError(t, err, "Seeding should succeed")
+	testutil.AssertEqual(t, demoAction.DefaultBotCount, len(result.BotIDs), "Default bot seats")
+
+	g, err := repo.Get(ctx, result.GameDto.ID)
+	testutil.AssertNoError(t, err, "Seeded game should be stored")
+	testutil.AssertEqual(t, 3, len(g.GetAllPlayers()), "Human plus two bots")
+	testutil.AssertEqual(t, demoAction.DefaultGeneration, g.Generation(), "Default generation")
+	testutil.AssertEqual(t, game.GamePhaseAction, g.CurrentPhase(), "Seeded games are ready to play")
+	testutil.AssertEqual(t, result.PlayerID, g.CurrentTurn().PlayerID(), "The human moves first")
+
+	tileCounts := make(map[shared.ResourceType]int)
+	for _, tile := range g.Board().Tiles() {
+		if tile.OccupiedBy != nil {
+			tileCounts[tile.OccupiedBy.Type]++
+		}
+	}
+	testutil.AssertEqual(t, 3, tileCounts[shared.ResourceCityTile], "One city per seat")
+	testutil.AssertEqual(t, 6, tileCounts[shared.ResourceGreeneryTile], "Two greeneries per seat by generation 5")
+	testutil.AssertEqual(t, 4, tileCounts[shared.ResourceOceanTile], "One ocean per elapsed generation")
+
+	params := g.GlobalParameters()
+	testutil.AssertEqual(t, tileCounts[shared.ResourceGreeneryTile], params.Oxygen(), "Oxygen matches the greeneries")
+	testutil.AssertEqual(t, tileCounts[shared.ResourceOceanTile], params.Oceans(), "Ocean count matches the board")
+	testutil.AssertEqual(t, -18, params.Temperature(), "Temperature has risen")
+
+	for _, p := range g.GetAllPlayers() {
+		testutil.AssertTrue(t, p.CorporationID() != "", "Every seat has a corporation")
+		testutil.AssertEqual(t, 8, len(p.PlayedCards().Cards()), "Every seat has a tableau")
+		testutil.AssertTrue(t, p.Resources().TerraformRating() >= 28, "TR reflects four generations of play")
+	}
+}
+
+func TestSeedDemoGame_RejectsOutOfRangeSettings(t *testing.T) {
+	action, _ := newTestSeedDemoGame()
+	ctx := context.Background()
+
+	_, err := action.Execute(ctx, demoAction.SeedSettings{BotCount: 5, CardPacks: []string{"base"}})
+	testutil.AssertError(t, err, "At most four bots fit a five-player game")
+
+	_, err = action.Execute(ctx, demoAction.SeedSettings{Generation: demoAction.MaxGeneration + 1, CardPacks: []string{"base"}})
+	testutil.AssertError(t, err, "Generation beyond the maximum should be rejected")
+}
+
+func TestSeedDemoGame_LinksSeatsToTheAccount(t *testing.T) {
+	action, repo := newTestSeedDemoGame()
+	ctx := context.Background()
+
+	result, err := action.Execute(ctx, demoAction.SeedSettings{CardPacks: []string{"base"}, AccountID: "account-1"})
+	testutil.AssertNoError(t, err, "Seeding should succeed")
+
+	g, err := repo.Get(ctx, result.GameDto.ID)
+	testutil.AssertNoError(t, err, "Seeded game should be stored")
+	for _, p := range g.GetAllPlayers() {
+		testutil.AssertEqual(t, "account-1", p.AccountID(), "Every seat belongs to the account")
+	}
+}
@@ -0,0 +1,170 @@
+package action_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	turnAction "terraforming-mars-backend/internal/action/turn_management"
+	"terraforming-mars-backend/internal/cards"
+	"terraforming-mars-backend/internal/game"
+	gamecards "terraforming-mars-backend/internal/game/cards"
+	"terraforming-mars-backend/internal/game/deck"
+	"terraforming-mars-backend/internal/game/player"
+	"terraforming-mars-backend/internal/game/shared"
+	"terraforming-mars-backend/test/testutil"
+)
+
+// startingSelectionRegistry holds a corporation starting with 5 M€, three project cards and four preludes
+func startingSelectionRegistry() cards.CardRegistry {
+	testCards := []gamecards.Card{
+		{
+			ID:   "corp-test-poor",
+			Name: "Poor Corp",
+			Type: gamecards.CardTypeCorporation,
+			Pack: "base",
+			Behaviors: []shared.CardBehavior{
+				{
+					Triggers: []shared.Trigger{{Type: string(gamecards.ResourceTriggerAutoCorporationStart)}},
+					Outputs: []shared.ResourceCondition{
+						{ResourceType: shared.ResourceCredit, Amount: 5, Target: "self-player"},
+					},
+				},
+			},
+		},
+	}
+	for _, id := range []string{"card-test-a", "card-test-b", "card-test-c"} {
+		testCards = append(testCards, gamecards.Card{ID: id, Name: id, Type: gamecards.CardTypeAutomated, Pack: "base", Cost: 5})
+	}
+	for _, id := range []string{"prelude-test-1", "prelude-test-2", "prelude-test-3", "prelude-test-4"} {
+		testCards = append(testCards, gamecards.Card{ID: id, Name: id, Type: gamecards.CardTypePrelude, Pack: "prelude"})
+	}
+	return cards.NewInMemoryCardRegistry(testCards)
+}
+
+func setupStartingSelection(t *testing.T) (*game.Game, game.GameRepository, *player.Player) {
+	t.Helper()
+	ctx := context.Background()
+	repo := game.NewInMemoryGameRepository()
+	testGame := game.NewGame("starting-selection", "player-1", game.GameSettings{MaxPlayers: 2})
+	testutil.AssertNoError(t, repo.Create(ctx, testGame), "Failed to create game")
+	p := player.NewPlayer(testGame.EventBus(), testGame.ID(), "player-1", "Alice")
+	testutil.AssertNoError(t, testGame.AddPlayer(ctx, p), "Failed to add player")
+	testutil.AssertNoError(t, testGame.UpdateStatus(ctx, game.GameStatusActive), "Failed to start game")
+	testutil.AssertNoError(t, testGame.UpdatePhase(ctx, game.GamePhaseStartingCardSelection), "Failed to set phase")
+	phase := &player.SelectStartingCardsPhase{
+		AvailableCards:        []string{"card-test-a", "card-test-b"},
+		AvailableCorporations: []string{"corp-test-poor"},
+	}
+	testutil.AssertNoError(t, testGame.SetSelectStartingCardsPhase(ctx, "player-1", phase), "Failed to set selection")
+	return testGame, repo, p
+}
+
+func TestSelectStartingCards_RejectsWithoutApplyingCorporation(t *testing.T) {
+	testGame, repo, p := setupStartingSelection(t)
+	ctx := context.Background()
+	action := turnAction.NewSelectStartingCardsAction(repo, startingSelectionRegistry(), testutil.TestLogger())
+
+	err := action.Execute(ctx, testGame.ID(), "player-1", []string{"card-test-a", "card-test-c"}, "corp-test-poor", nil)
+	testutil.AssertError(t, err, "Cards not dealt to the player are rejected")
+
+	err = action.Execute(ctx, testGame.ID(), "player-1", []string{"card-test-a", "card-test-a"}, "corp-test-poor", nil)
+	testutil.AssertError(t, err, "A dealt card cannot be kept twice")
+
+	err = action.Execute(ctx, testGame.ID(), "player-1", []string{"card-test-a", "card-test-b"}, "corp-test-poor", nil)
+	testutil.AssertError(t, err, "Two cards cost 6 but the corporation starts with 5")
+	testutil.AssertFalse(t, p.HasCorporation(), "A rejected selection applies nothing")
+	testutil.AssertEqual(t, 0, p.Resources().Get().Credits, "A rejected selection grants nothing")
+
+	err = action.Execute(ctx, testGame.ID(), "player-1", []string{"card-test-b"}, "corp-test-poor", nil)
+	testutil.AssertNoError(t, err, "The player can choose again")
+	testutil.AssertEqual(t, "corp-test-poor", p.CorporationID(), "Corporation is applied")
+	testutil.AssertEqual(t, 2, p.Resources().Get().Credits, "One card is paid from the starting 5 M€")
+	testutil.AssertEqual(t, 1, p.Hand().CardCount(), "Kept card is in hand")
+}
+
+func TestSelectStartingCards_DiscardsUnkeptCards(t *testing.T) {
+	testGame, repo, p := setupStartingSelection(t)
+	ctx := context.Background()
+	testGame.SetDeck(deck.NewDeck(testGame.ID(), []string{"card-test-c"}, nil, nil))
+	action := turnAction.NewSelectStartingCardsAction(repo, startingSelectionRegistry(), testutil.TestLogger())
+
+	err := action.Execute(ctx, testGame.ID(), "player-1", []string{"card-test-a"}, "corp-test-poor", nil)
+	testutil.AssertNoError(t, err, "Selection should succeed")
+	testutil.AssertEqual(t, 1, p.Hand().CardCount(), "Kept card is in hand")
+	testutil.AssertEqual(t, 1, len(testGame.Deck().DiscardPile()), "The unkept card is discarded")
+	testutil.AssertEqual(t, "card-test-b", testGame.Deck().DiscardPile()[0], "The unkept card is discarded")
+}
+
+func TestStartGame_DealsPreludesWhenEnabled(t *testing.T) {
+	ctx := context.Background()
+	repo := game.NewInMemoryGameRepository()
+	testGame := game.NewGame("prelude-dealing", "player-1", game.GameSettings{MaxPlayers: 2, CardPacks: []string{game.PackBaseGame, game.PackPrelude}})
+	projectCards := []string{"card-test-a", "card-test-b", "card-test-c"}
+	for len(projectCards) < 12 {
+		projectCards = append(projectCards, fmt.Sprintf("card-filler-%d", len(projectCards)))
+	}
+	preludes := []string{"prelude-test-1", "prelude-test-2", "prelude-test-3", "prelude-test-4", "prelude-test-5"}
+	testGame.SetDeck(deck.NewDeck(testGame.ID(), projectCards, []string{"corp-test-poor", "corp-test-other"}, preludes))
+	testutil.AssertNoError(t, repo.Create(ctx, testGame), "Failed to create game")
+	p := player.NewPlayer(testGame.EventBus(), testGame.ID(), "player-1", "Alice")
+	testutil.AssertNoError(t, testGame.AddPlayer(ctx, p), "Failed to add player")
+
+	err := turnAction.NewStartGameAction(repo, testutil.CreateTestCardRegistry(), testutil.TestLogger()).Execute(ctx, testGame.ID(), "player-1")
+	testutil.AssertNoError(t, err, "Failed to start game")
+
+	phase := testGame.GetSelectStartingCardsPhase("player-1")
+	testutil.AssertEqual(t, turnAction.StartingProjectCards, len(phase.AvailableCards), "Ten project cards are dealt")
+	testutil.AssertEqual(t, turnAction.StartingCorporations, len(phase.AvailableCorporations), "Two corporations are dealt")
+	testutil.AssertEqual(t, turnAction.StartingPreludes, len(phase.AvailablePreludes), "Four preludes are dealt")
+
+	action := turnAction.NewSelectStartingCardsAction(repo, startingSelectionRegistry(), testutil.TestLogger())
+	err = action.Execute(ctx, testGame.ID(), "player-1", nil, "corp-test-poor", []string{"prelude-test-1"})
+	testutil.AssertError(t, err, "Exactly two preludes must be kept")
+
+	err = action.Execute(ctx, testGame.ID(), "player-1", nil, "corp-test-poor", []string{"prelude-test-1", "prelude-test-5"})
+	testutil.AssertError(t, err, "Preludes not dealt to the player are rejected")
+
+	err = action.Execute(ctx, testGame.ID(), "player-1", []string{"card-test-a"}, "corp-test-poor", []string{"prelude-test-2", "prelude-test-4"})
+	testutil.AssertNoError(t, err, "Valid selection should succeed")
+	kept := p.Preludes()
+	testutil.AssertEqual(t, 2, len(kept), "Kept preludes are recorded")
+	testutil.AssertEqual(t, "prelude-test-2", kept[0], "Kept preludes keep their order")
+}
+
+func TestMulliganStartingHand_RedrawsOnce(t *testing.T) {
+	ctx := context.Background()
+	repo := game.NewInMemoryGameRepository()
+	settings := game.GameSettings{MaxPlayers: 2, RulesOptions: game.RulesOptions{MulliganStartingHand: true}}
+	testGame := game.NewGame("mulligan", "player-1", settings)
+	projectCards := make([]string, 0, 25)
+	for len(projectCards) < 25 {
+		projectCards = append(projectCards, fmt.Sprintf("card-filler-%d", len(projectCards)))
+	}
+	testGame.SetDeck(deck.NewDeck(testGame.ID(), projectCards, []string{"corp-test-poor", "corp-test-other"}, nil))
+	testutil.AssertNoError(t, repo.Create(ctx, testGame), "Failed to create game")
+	testutil.AssertNoError(t, testGame.AddPlayer(ctx, player.NewPlayer(testGame.EventBus(), testGame.ID(), "player-1", "Alice")), "Failed to add player")
+
+	stateRepo := game.NewInMemoryGameStateRepository()
+	action := turnAction.NewMulliganStartingHandAction(repo, stateRepo, testutil.TestLogger())
+	err := action.Execute(ctx, testGame.ID(), "player-1")
+	testutil.AssertError(t, err, "No redraw before the starting selection")
+
+	err = turnAction.NewStartGameAction(repo, testutil.CreateTestCardRegistry(), testutil.TestLogger()).Execute(ctx, testGame.ID(), "player-1")
+	testutil.AssertNoError(t, err, "Failed to start game")
+	dealt := testGame.GetSelectStartingCardsPhase("player-1").AvailableCards
+
+	testutil.AssertNoError(t, action.Execute(ctx, testGame.ID(), "player-1"), "First redraw should succeed")
+	phase := testGame.GetSelectStartingCardsPhase("player-1")
+	testutil.AssertEqual(t, len(dealt), len(phase.AvailableCards), "Same number of cards is redrawn")
+	testutil.AssertNotEqual(t, dealt[0], phase.AvailableCards[0], "Cards are replaced")
+	testutil.AssertEqual(t, len(dealt), len(testGame.Deck().DiscardPile()), "Old cards are discarded")
+	testutil.AssertEqual(t, "corp-test-poor", phase.AvailableCorporations[0], "Corporations are kept")
+
+	err = action.Execute(ctx, testGame.ID(), "player-1")
+	testutil.AssertError(t, err, "Only one redraw per player")
+
+	diffs, _ := stateRepo.GetDiff(ctx, testGame.ID())
+	testutil.AssertEqual(t, 1, len(diffs), "Redraw is logged")
+	testutil.AssertEqual(t, "Alice redrew 10 starting cards", diffs[0].Summary.Text, "Log does not name the cards")
+}
@@ -0,0 +1,29 @@
+package action_test
+
+import (
+	"context"
+	"testing"
+
+	adminAction "terraforming-mars-backend/internal/action/admin"
+	"terraforming-mars-backend/test/testutil"
+)
+
+func TestSetGlobalParameters_RejectsValuesOffTheTrack(t *testing.T) {
+	ctx := context.Background()
+	testGame, repo := testutil.CreateTestGameWithPlayers(t, 1, testutil.NewMockBroadcaster())
+	action := adminAction.NewSetGlobalParametersAction(repo, testutil.TestLogger())
+
+	err := action.Execute(ctx, testGame.ID(), adminAction.SetGlobalParametersRequest{Temperature: 999, Oxygen: 5})
+	testutil.AssertError(t, err, "Temperature above the maximum is rejected")
+	testutil.AssertEqual(t, -30, testGame.GlobalParameters().Temperature(), "Temperature is unchanged")
+	testutil.AssertEqual(t, 0, testGame.GlobalParameters().Oxygen(), "Nothing is applied when one value is rejected")
+
+	err = action.Execute(ctx, testGame.ID(), adminAction.SetGlobalParametersRequest{Temperature: -7})
+	testutil.AssertError(t, err, "Temperature between steps is rejected")
+
+	err = action.Execute(ctx, testGame.ID(), adminAction.SetGlobalParametersRequest{Temperature: -8, Oxygen: 14, Oceans: 9})
+	testutil.AssertNoError(t, err, "Values on the track are applied")
+	testutil.AssertEqual(t, -8, testGame.GlobalParameters().Temperature(), "Temperature is set")
+	testutil.AssertEqual(t, 14, testGame.GlobalParameters().Oxygen(), "Oxygen is set")
+	testutil.AssertEqual(t, 9, testGame.GlobalParameters().Oceans(), "Oceans are set")
+}
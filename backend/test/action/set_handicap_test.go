@@ -0,0 +1,67 @@
+package action_test
+
+import (
+	"context"
+	"testing"
+
+	gameAction "terraforming-mars-backend/internal/action/game"
+	turnAction "terraforming-mars-backend/internal/action/turn_management"
+	"terraforming-mars-backend/internal/game"
+	"terraforming-mars-backend/test/testutil"
+)
+
+func TestSetHandicapAction_AppliedAtGameStart(t *testing.T) {
+	broadcaster := testutil.NewMockBroadcaster()
+	testGame, repo := testutil.CreateTestGameWithPlayers(t, 2, broadcaster)
+	logger := testutil.TestLogger()
+	ctx := context.Background()
+
+	player2, _ := testGame.GetPlayer("player-2")
+	creditsBefore := player2.Resources().Get().Credits
+	trBefore := player2.Resources().TerraformRating()
+
+	action := gameAction.NewSetHandicapAction(repo, logger)
+	handicap := game.Handicap{ExtraCredits: 10, ExtraCards: 2, ExtraTR: 1}
+	err := action.Execute(ctx, testGame.ID(), "player-1", "player-2", handicap)
+	testutil.AssertNoError(t, err, "Host should be able to set a handicap")
+
+	startAction := turnAction.NewStartGameAction(repo, testutil.CreateTestCardRegistry(), logger)
+	err = startAction.Execute(ctx, testGame.ID(), "player-1")
+	testutil.AssertNoError(t, err, "Failed to start game")
+
+	testutil.AssertEqual(t, creditsBefore+10, player2.Resources().Get().Credits, "Extra credits should be granted")
+	testutil.AssertEqual(t, trBefore+1, player2.Resources().TerraformRating(), "Extra TR should be granted")
+
+	phase2 := testGame.GetSelectStartingCardsPhase("player-2")
+	phase1 := testGame.GetSelectStartingCardsPhase("player-1")
+	testutil.AssertEqual(t, 12, len(phase2.AvailableCards), "Handicapped player should see extra starting cards")
+	testutil.AssertEqual(t, 10, len(phase1.AvailableCards), "Other players should see the normal selection")
+
+	testutil.AssertEqual(t, handicap, testGame.Handicap("player-2"), "Handicap should remain recorded after start")
+}
+
+func TestSetHandicapAction_Validation(t *testing.T) {
+	broadcaster := testutil.NewMockBroadcaster()
+	testGame, repo := testutil.CreateTestGameWithPlayers(t, 2, broadcaster)
+	ctx := context.Background()
+
+	action := gameAction.NewSetHandicapAction(repo, testutil.TestLogger())
+
+	err := action.Execute(ctx, testGame.ID(), "player-2", "player-1", game.Handicap{ExtraCredits: 5})
+	testutil.AssertError(t, err, "Non-host should not set handicaps")
+
+	err = action.Execute(ctx, testGame.ID(), "player-1", "player-2", game.Handicap{ExtraCredits: game.MaxHandicapCredits + 1})
+	testutil.AssertError(t, err, "Credits above the cap should be rejected")
+
+	err = action.Execute(ctx, testGame.ID(), "player-1", "player-2", game.Handicap{ExtraTR: -1})
+	testutil.AssertError(t, err, "Negative bonuses should be rejected")
+
+	err = action.Execute(ctx, testGame.ID(), "player-1", "nobody", game.Handicap{ExtraCards: 1})
+	testutil.AssertError(t, err, "Unknown players should be rejected")
+
+	err = action.Execute(ctx, testGame.ID(), "player-1", "player-2", game.Handicap{ExtraCards: 1})
+	testutil.AssertNoError(t, err, "Valid handicap should be accepted")
+	err = action.Execute(ctx, testGame.ID(), "player-1", "player-2", game.Handicap{})
+	testutil.AssertNoError(t, err, "Zero handicap should clear")
+	testutil.AssertEqual(t, 0, len(testGame.Handicaps()), "Cleared handicap should be removed")
+}
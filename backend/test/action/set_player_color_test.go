@@ -0,0 +1,61 @@
+package action_test
+
+import (
+	"context"
+	"testing"
+
+	gameAction "terraforming-mars-backend/internal/action/game"
+	"terraforming-mars-backend/internal/delivery/dto"
+	"terraforming-mars-backend/internal/game"
+	"terraforming-mars-backend/test/testutil"
+)
+
+func TestPlayerColors_AssignedUniquelyOnJoin(t *testing.T) {
+	broadcaster := testutil.NewMockBroadcaster()
+	testGame, _ := testutil.CreateTestGameWithPlayers(t, 3, broadcaster)
+
+	palette := game.DefaultColorPalette()
+	testutil.AssertEqual(t, palette[0], testGame.PlayerColor("player-1"), "First player should get the first palette color")
+	testutil.AssertEqual(t, palette[1], testGame.PlayerColor("player-2"), "Second player should get the second palette color")
+	testutil.AssertEqual(t, palette[2], testGame.PlayerColor("player-3"), "Third player should get the third palette color")
+
+	gameDto := dto.ToGameDto(testGame, testutil.CreateTestCardRegistry(), "player-1")
+	testutil.AssertEqual(t, palette[0], gameDto.CurrentPlayer.Color, "Player DTO should carry the color")
+	for _, other := range gameDto.OtherPlayers {
+		testutil.AssertEqual(t, testGame.PlayerColor(other.ID), other.Color, "Other player DTOs should carry the color")
+	}
+}
+
+func TestSetPlayerColorAction(t *testing.T) {
+	broadcaster := testutil.NewMockBroadcaster()
+	testGame, repo := testutil.CreateTestGameWithPlayers(t, 2, broadcaster)
+	ctx := context.Background()
+
+	action := gameAction.NewSetPlayerColorAction(repo, testutil.TestLogger())
+	palette := game.DefaultColorPalette()
+
+	err := action.Execute(ctx, testGame.ID(), "player-2", "player-2", palette[4])
+	testutil.AssertError(t, err, "Non-host should not set colors")
+
+	err = action.Execute(ctx, testGame.ID(), "player-1", "player-2", palette[0])
+	testutil.AssertError(t, err, "A color held by another player should be rejected")
+
+	err = action.Execute(ctx, testGame.ID(), "player-1", "player-2", "#123456")
+	testutil.AssertError(t, err, "Colors outside the palette should be rejected")
+
+	err = action.Execute(ctx, testGame.ID(), "player-1", "player-2", "#0072b2")
+	testutil.AssertNoError(t, err, "Host should be able to pick a free palette color")
+	testutil.AssertEqual(t, "#0072B2", testGame.PlayerColor("player-2"), "Color should be normalized and applied")
+
+	err = testGame.RemovePlayer(ctx, "player-1")
+	testutil.AssertNoError(t, err, "Failed to remove player")
+	err = testGame.SetPlayerColor(ctx, "player-2", palette[0])
+	testutil.AssertNoError(t, err, "A departed player's color should become free")
+}
+
+func TestValidateColorPalette(t *testing.T) {
+	testutil.AssertNoError(t, game.ValidateColorPalette(game.DefaultColorPalette(), game.DefaultMaxPlayers), "Default palette should be valid")
+	testutil.AssertError(t, game.ValidateColorPalette([]string{"#E69F00", "#e69f00"}, 2), "Duplicate colors should be rejected")
+	testutil.AssertError(t, game.ValidateColorPalette([]string{"red", "#56B4E9"}, 2), "Non-hex colors should be rejected")
+	testutil.AssertError(t, game.ValidateColorPalette([]string{"#E69F00"}, 2), "Palette smaller than max players should be rejected")
+}
@@ -0,0 +1,94 @@
+package action_test
+
+import (
+	"context"
+	"testing"
+
+	gameAction "terraforming-mars-backend/internal/action/game"
+	turnAction "terraforming-mars-backend/internal/action/turn_management"
+	"terraforming-mars-backend/test/testutil"
+)
+
+func TestSetSeatOrderAction_HostArrangesSeats(t *testing.T) {
+	broadcaster := testutil.NewMockBroadcaster()
+	testGame, repo := testutil.CreateTestGameWithPlayers(t, 3, broadcaster)
+	logger := testutil.TestLogger()
+	ctx := context.Background()
+
+	action := gameAction.NewSetSeatOrderAction(repo, logger)
+	seatOrder := []string{"player-3", "player-1", "player-2"}
+
+	err := action.Execute(ctx, testGame.ID(), "player-1", seatOrder, false)
+	testutil.AssertNoError(t, err, "Host should be able to arrange seats")
+	testutil.AssertFalse(t, testGame.RandomizeSeatOrder(), "Manual seating should disable randomization")
+
+	startAction := turnAction.NewStartGameAction(repo, testutil.CreateTestCardRegistry(), logger)
+	err = startAction.Execute(ctx, testGame.ID(), "player-1")
+	testutil.AssertNoError(t, err, "Failed to start game")
+
+	turnOrder := testGame.TurnOrder()
+	testutil.AssertEqual(t, 3, len(turnOrder), "Turn order should contain all players")
+	for i, id := range seatOrder {
+		testutil.AssertEqual(t, id, turnOrder[i], "Turn order should follow host seating")
+	}
+	testutil.AssertEqual(t, "player-3", testGame.CurrentTurn().PlayerID(), "First seat should take the first turn")
+}
+
+func TestSetSeatOrderAction_Randomize(t *testing.T) {
+	broadcaster := testutil.NewMockBroadcaster()
+	testGame, repo := testutil.CreateTestGameWithPlayers(t, 2, broadcaster)
+	ctx := context.Background()
+
+	action := gameAction.NewSetSeatOrderAction(repo, testutil.TestLogger())
+
+	err := action.Execute(ctx, testGame.ID(), "player-1", []string{"player-2", "player-1"}, false)
+	testutil.AssertNoError(t, err, "Host should be able to arrange seats")
+
+	err = action.Execute(ctx, testGame.ID(), "player-1", nil, true)
+	testutil.AssertNoError(t, err, "Host should be able to request random seating")
+	testutil.AssertTrue(t, testGame.RandomizeSeatOrder(), "Seating should be randomized at start")
+}
+
+func TestSetSeatOrderAction_Validation(t *testing.T) {
+	broadcaster := testutil.NewMockBroadcaster()
+	testGame, repo := testutil.CreateTestGameWithPlayers(t, 2, broadcaster)
+	ctx := context.Background()
+
+	action := gameAction.NewSetSeatOrderAction(repo, testutil.TestLogger())
+
+	tests := []struct {
+		name      string
+		playerID  string
+		seatOrder []string
+	}{
+		{name: "non-host", playerID: "player-2", seatOrder: []string{"player-2", "player-1"}},
+		{name: "missing player", playerID: "player-1", seatOrder: []string{"player-1"}},
+		{name: "duplicate player", playerID: "player-1", seatOrder: []string{"player-1", "player-1"}},
+		{name: "unknown player", playerID: "player-1", seatOrder: []string{"player-1", "player-9"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := action.Execute(ctx, testGame.ID(), tt.playerID, tt.seatOrder, false)
+			testutil.AssertError(t, err, "Invalid seat order should be rejected")
+		})
+	}
+
+	testutil.AssertEqual(t, "player-1", testGame.TurnOrder()[0], "Seating should be unchanged after rejected requests")
+}
+
+func TestSetSeatOrderAction_SeatingTracksLobbyMembership(t *testing.T) {
+	broadcaster := testutil.NewMockBroadcaster()
+	testGame, _ := testutil.CreateTestGameWithPlayers(t, 3, broadcaster)
+	ctx := context.Background()
+
+	testutil.AssertEqual(t, 3, len(testGame.TurnOrder()), "Joined players should be seated")
+
+	err := testGame.RemovePlayer(ctx, "player-2")
+	testutil.AssertNoError(t, err, "Failed to remove player")
+
+	turnOrder := testGame.TurnOrder()
+	testutil.AssertEqual(t, 2, len(turnOrder), "Removed player should lose their seat")
+	testutil.AssertEqual(t, "player-1", turnOrder[0], "Remaining seats should keep their order")
+	testutil.AssertEqual(t, "player-3", turnOrder[1], "Remaining seats should keep their order")
+}
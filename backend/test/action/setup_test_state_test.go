@@ -0,0 +1,113 @@
+package action_test
+
+import (
+	"context"
+	"testing"
+
+	adminAction "terraforming-mars-backend/internal/action/admin"
+	"terraforming-mars-backend/internal/events"
+	"terraforming-mars-backend/internal/game"
+	"terraforming-mars-backend/internal/game/deck"
+	"terraforming-mars-backend/internal/game/shared"
+	"terraforming-mars-backend/test/testutil"
+)
+
+func TestSetupTestState_AppliesFixture(t *testing.T) {
+	ctx := context.Background()
+	testGame, repo := testutil.CreateTestGameWithPlayers(t, 2, testutil.NewMockBroadcaster())
+	testutil.StartTestGame(t, testGame)
+	action := adminAction.NewSetupTestStateAction(repo, testutil.CreateTestCardRegistry(), testutil.TestLogger())
+
+	tr := 30
+	city := shared.HexPosition{Q: 0, R: 0, S: 0}
+	err := action.Execute(ctx, testGame.ID(), adminAction.TestStateFixture{
+		Generation: 6,
+		Phase:      game.GamePhaseAction,
+		Tiles:      []adminAction.FixtureTile{{Position: city, TileType: "city", OwnerID: "player-2"}},
+		Players: []adminAction.FixturePlayer{{
+			PlayerID:        "player-2",
+			CorporationID:   "corp-credicor",
+			Resources:       &shared.Resources{Credits: 7, Plants: 9},
+			Production:      &shared.Production{Energy: 2},
+			TerraformRating: &tr,
+			Hand:            []string{"card-asteroid"},
+			PlayedCards:     []string{"card-power-plant"},
+		}},
+		CurrentTurnPlayerID: "player-2",
+	})
+	testutil.AssertNoError(t, err, "Fixture should apply")
+
+	p, _ := testGame.GetPlayer("player-2")
+	testutil.AssertEqual(t, 6, testGame.Generation(), "Generation should be set")
+	testutil.AssertEqual(t, 7, p.Resources().Get().Credits, "Fixture credits win over the corporation's starting credits")
+	testutil.AssertEqual(t, 9, p.Resources().Get().Plants, "Plants should be set")
+	testutil.AssertEqual(t, 2, p.Resources().Production().Energy, "Production should be set")
+	testutil.AssertEqual(t, 30, p.Resources().TerraformRating(), "TR should be set")
+	testutil.AssertEqual(t, "corp-credicor", p.CorporationID(), "Corporation should be set")
+	testutil.AssertEqual(t, 1, p.Hand().CardCount(), "Hand should be replaced")
+	testutil.AssertTrue(t, p.Hand().HasCard("card-asteroid"), "Hand should hold the fixture card")
+	testutil.AssertTrue(t, p.PlayedCards().Contains("card-power-plant"), "Played card should be added")
+	testutil.AssertEqual(t, "player-2", testGame.CurrentTurn().PlayerID(), "Turn should move to the fixture's player")
+
+	tile, _ := testGame.Board().GetTile(city)
+	testutil.AssertTrue(t, tile.OccupiedBy != nil && tile.OccupiedBy.Type == shared.ResourceCityTile, "City should be placed")
+	testutil.AssertEqual(t, "player-2", *tile.OwnerID, "City should belong to its owner")
+}
+
+func TestSetupTestState_BadFixtureChangesNothing(t *testing.T) {
+	ctx := context.Background()
+	testGame, repo := testutil.CreateTestGameWithPlayers(t, 2, testutil.NewMockBroadcaster())
+	testutil.StartTestGame(t, testGame)
+	action := adminAction.NewSetupTestStateAction(repo, testutil.CreateTestCardRegistry(), testutil.TestLogger())
+	generation := testGame.Generation()
+
+	err := action.Execute(ctx, testGame.ID(), adminAction.TestStateFixture{
+		Generation: generation + 3,
+		Players: []adminAction.FixturePlayer{
+			{PlayerID: "player-1", Resources: &shared.Resources{Credits: 99}},
+			{PlayerID: "player-2", Hand: []string{"card-does-not-exist"}},
+		},
+	})
+	testutil.AssertError(t, err, "Unknown cards should be rejected")
+
+	err = action.Execute(ctx, testGame.ID(), adminAction.TestStateFixture{
+		Generation: generation + 3,
+		Players: []adminAction.FixturePlayer{
+			{PlayerID: "player-1", Resources: &shared.Resources{Credits: 99}},
+			{PlayerID: "player-2", CorporationID: "card-asteroid"},
+		},
+	})
+	testutil.AssertError(t, err, "A project card is not a corporation")
+
+	p, _ := testGame.GetPlayer("player-1")
+	testutil.AssertTrue(t, p.Resources().Get().Credits != 99, "A failed fixture should be rolled back")
+	testutil.AssertEqual(t, generation, testGame.Generation(), "A failed fixture should not change the generation")
+}
+
+func TestSetupTestState_FixtureCardsLeaveTheDeckAndPublishTheirPlay(t *testing.T) {
+	ctx := context.Background()
+	testGame, repo := testutil.CreateTestGameWithPlayers(t, 2, testutil.NewMockBroadcaster())
+	testutil.StartTestGame(t, testGame)
+	testGame.SetDeck(deck.NewDeck(testGame.ID(), []string{"card-asteroid", "card-power-plant", "card-ai-central"}, nil, nil))
+	action := adminAction.NewSetupTestStateAction(repo, testutil.CreateTestCardRegistry(), testutil.TestLogger())
+
+	var played []string
+	events.Subscribe(testGame.EventBus(), func(event events.CardPlayedEvent) {
+		played = append(played, event.CardID)
+	})
+
+	err := action.Execute(ctx, testGame.ID(), adminAction.TestStateFixture{
+		Players: []adminAction.FixturePlayer{{
+			PlayerID:    "player-2",
+			Hand:        []string{"card-asteroid"},
+			PlayedCards: []string{"card-power-plant"},
+		}},
+	})
+	testutil.AssertNoError(t, err, "Fixture should apply")
+
+	remaining := testGame.Deck().ProjectCards()
+	testutil.AssertEqual(t, 1, len(remaining), "Fixture cards cannot be drawn again")
+	testutil.AssertEqual(t, "card-ai-central", remaining[0], "Other cards stay in the deck")
+	testutil.AssertEqual(t, 1, len(played), "Placing a card publishes its play")
+	testutil.AssertEqual(t, "card-power-plant", played[0], "The placed card is the one published")
+}
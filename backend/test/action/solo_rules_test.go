@@ -0,0 +1,105 @@
+package action_test
+
+import (
+	"context"
+	"testing"
+
+	gameAction "terraforming-mars-backend/internal/action/game"
+	turnAction "terraforming-mars-backend/internal/action/turn_management"
+	"terraforming-mars-backend/internal/cards"
+	"terraforming-mars-backend/internal/game"
+	"terraforming-mars-backend/internal/game/board"
+	gamecards "terraforming-mars-backend/internal/game/cards"
+	"terraforming-mars-backend/internal/game/deck"
+	"terraforming-mars-backend/internal/game/player"
+	"terraforming-mars-backend/internal/game/shared"
+	"terraforming-mars-backend/test/testutil"
+)
+
+// createSoloGame creates a one-player lobby with the given rules and a deck of the test cards
+func createSoloGame(t *testing.T, repo game.GameRepository, rules game.RulesOptions) *game.Game {
+	t.Helper()
+	ctx := context.Background()
+	registry := testutil.CreateTestCardRegistry()
+
+	testGame := game.NewGame("solo-game", "player-1", game.GameSettings{MaxPlayers: 1, CardPacks: []string{"base"}, RulesOptions: rules})
+	var projectCards, corporations []string
+	for _, card := range registry.GetAll() {
+		if card.Type == gamecards.CardTypeCorporation {
+			corporations = append(corporations, card.ID)
+		} else if card.Type != gamecards.CardTypePrelude {
+			projectCards = append(projectCards, card.ID)
+		}
+	}
+	testGame.SetDeck(deck.NewDeck(testGame.ID(), projectCards, corporations, nil))
+	testGame.SetVPCardLookup(cards.NewVPCardLookupAdapter(registry))
+	testutil.AssertNoError(t, repo.Create(ctx, testGame), "Failed to create game")
+	testutil.AssertNoError(t, testGame.AddPlayer(ctx, player.NewPlayer(testGame.EventBus(), testGame.ID(), "player-1", "Solo")), "Failed to add player")
+	testutil.AssertNoError(t, testGame.SetHostPlayerID(ctx, "player-1"), "Failed to set host")
+	return testGame
+}
+
+func TestStartGame_SoloNeutralTiles(t *testing.T) {
+	repo := game.NewInMemoryGameRepository()
+	testGame := createSoloGame(t, repo, game.RulesOptions{SoloNeutralTiles: true})
+
+	startAction := turnAction.NewStartGameAction(repo, testutil.CreateTestCardRegistry(), testutil.TestLogger())
+	testutil.AssertNoError(t, startAction.Execute(context.Background(), testGame.ID(), "player-1"), "Failed to start solo game")
+
+	var cities, greeneries []board.Tile
+	for _, tile := range testGame.Board().Tiles() {
+		if tile.OccupiedBy == nil {
+			continue
+		}
+		testutil.AssertTrue(t, tile.OwnerID == nil, "Neutral tiles have no owner")
+		switch tile.OccupiedBy.Type {
+		case shared.ResourceCityTile:
+			cities = append(cities, tile)
+		case shared.ResourceGreeneryTile:
+			greeneries = append(greeneries, tile)
+		}
+	}
+	testutil.AssertEqual(t, game.SoloNeutralCities, len(cities), "Two neutral cities are placed")
+	testutil.AssertEqual(t, game.SoloNeutralCities, len(greeneries), "Each city gets a greenery")
+	for _, greenery := range greeneries {
+		adjacent := false
+		for _, neighbor := range greenery.Coordinates.GetNeighbors() {
+			for _, city := range cities {
+				adjacent = adjacent || city.Coordinates == neighbor
+			}
+		}
+		testutil.AssertTrue(t, adjacent, "Greeneries are placed next to a neutral city")
+	}
+	testutil.AssertEqual(t, 4, len(testGame.Deck().DiscardPile()), "Counting cards are discarded")
+	testutil.AssertEqual(t, 0, testGame.GlobalParameters().Oxygen(), "Neutral greeneries do not raise oxygen")
+}
+
+func TestFinalScoring_SoloGoals(t *testing.T) {
+	ctx := context.Background()
+	cases := []struct {
+		name string
+		goal string
+		tr   int
+		won  bool
+	}{
+		{"tr63 reached", game.SoloGoalTR63, 63, true},
+		{"tr63 missed", game.SoloGoalTR63, 62, false},
+		{"terraforming unfinished", game.SoloGoalTerraform, 70, false},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			repo := game.NewInMemoryGameRepository()
+			testGame := createSoloGame(t, repo, game.RulesOptions{SoloGoal: tc.goal})
+			testutil.AssertNoError(t, testGame.UpdateStatus(ctx, game.GameStatusActive), "Failed to start game")
+			p, _ := testGame.GetPlayer("player-1")
+			p.Resources().SetTerraformRating(tc.tr)
+
+			finalScoring := gameAction.NewFinalScoringAction(repo, testutil.CreateTestCardRegistry(), nil, testutil.TestLogger())
+			testutil.AssertNoError(t, finalScoring.Execute(ctx, testGame.ID()), "Final scoring should succeed")
+
+			scores := testGame.GetFinalScores()
+			testutil.AssertEqual(t, 1, len(scores), "The solo player is scored")
+			testutil.AssertEqual(t, tc.won, scores[0].IsWinner, "The goal decides the win")
+		})
+	}
+}
@@ -0,0 +1,63 @@
+package action_test
+
+import (
+	"context"
+	"testing"
+
+	tileAction "terraforming-mars-backend/internal/action/tile"
+	"terraforming-mars-backend/internal/game"
+	"terraforming-mars-backend/internal/game/board"
+	"terraforming-mars-backend/internal/game/shared"
+	"terraforming-mars-backend/test/testutil"
+)
+
+func TestSelectTileAction_MiningRightsCapturesBonus(t *testing.T) {
+	ctx := context.Background()
+	testGame, repo := testutil.CreateTestGameWithPlayers(t, 2, testutil.NewMockBroadcaster())
+	testutil.StartTestGame(t, testGame)
+	playerID := testGame.TurnOrder()[0]
+	p, _ := testGame.GetPlayer(playerID)
+
+	restrictions := &shared.TileRestrictions{CaptureBonus: []string{"steel", "titanium"}}
+	testutil.AssertNoError(t, testGame.AppendToPendingTileSelectionQueue(ctx, playerID,
+		[]string{board.SpecialTileMiningRights}, "Mining Rights", restrictions), "Failed to queue tile")
+	testutil.AssertNoError(t, testGame.ProcessNextTile(ctx, playerID), "Failed to process tile")
+
+	selection := testGame.GetPendingTileSelection(playerID)
+	testutil.AssertEqual(t, 4, len(selection.AvailableHexes), "Only the steel and titanium bonus areas are offered")
+
+	steelArea := shared.HexPosition{Q: -3, R: 1, S: 2}
+	steelBefore := p.Resources().Get().Steel
+	selectTile := tileAction.NewSelectTileAction(repo, testutil.CreateTestCardRegistry(), game.NewInMemoryGameStateRepository(), testutil.TestLogger())
+	_, err := selectTile.Execute(ctx, testGame.ID(), playerID, formatHexCoords(steelArea))
+	testutil.AssertNoError(t, err, "Failed to place tile")
+
+	tile, _ := testGame.Board().GetTile(steelArea)
+	testutil.AssertEqual(t, shared.ResourceSpecialTile, tile.OccupiedBy.Type, "Tile is a special tile")
+	testutil.AssertEqual(t, board.SpecialTileMiningRights, tile.OccupiedBy.SpecialTileType, "Tile carries the card's marker")
+	testutil.AssertEqual(t, steelBefore+2, p.Resources().Get().Steel, "Placement bonus is still awarded")
+	testutil.AssertEqual(t, 1, p.Resources().Production().Steel, "Steel production rises 1 step")
+}
+
+func TestAvailableHexes_SpecialTileAdjacency(t *testing.T) {
+	ctx := context.Background()
+	testGame, _ := testutil.CreateTestGameWithPlayers(t, 2, testutil.NewMockBroadcaster())
+	playerID := testGame.TurnOrder()[0]
+
+	miningArea := &shared.TileRestrictions{Adjacency: shared.AdjacencyOwn, CaptureBonus: []string{"steel", "titanium"}}
+	testutil.AssertEqual(t, 0, testGame.CountAvailableHexesForTile(board.SpecialTileMiningArea, playerID, miningArea),
+		"Mining Area needs one of your tiles next to it")
+
+	city := shared.HexPosition{Q: -2, R: 1, S: 1}
+	testutil.AssertNoError(t, testGame.Board().UpdateTileOccupancy(ctx, city,
+		board.TileOccupant{Type: shared.ResourceCityTile, Tags: []string{}}, playerID), "Failed to place city")
+	testutil.AssertEqual(t, 2, testGame.CountAvailableHexesForTile(board.SpecialTileMiningArea, playerID, miningArea),
+		"Both steel areas next to the city are offered")
+	testutil.AssertEqual(t, 0, testGame.CountAvailableHexesForTile(board.SpecialTileMiningArea, "player-2", miningArea),
+		"Another player's tile does not count")
+
+	free := testGame.CountAvailableHexesForTile(board.SpecialTileNuclearZone, playerID, nil)
+	preserve := testGame.CountAvailableHexesForTile(board.SpecialTileNaturalPreserve, playerID,
+		&shared.TileRestrictions{Adjacency: shared.AdjacencyNone})
+	testutil.AssertEqual(t, free-6, preserve, "Natural Preserve keeps clear of every tile")
+}
@@ -21,7 +21,7 @@ func TestStartGameAction_Success(t *testing.T) {
 		p.SetCorporationID("corp-tharsis-republic")
 	}
 
-	startAction := turnAction.NewStartGameAction(repo, logger)
+	startAction := turnAction.NewStartGameAction(repo, testutil.CreateTestCardRegistry(), logger)
 
 	// Execute
 	err := startAction.Execute(context.Background(), testGame.ID(), testGame.HostPlayerID())
@@ -39,7 +39,7 @@ func TestStartGameAction_GameNotFound(t *testing.T) {
 	repo := game.NewInMemoryGameRepository()
 	logger := testutil.TestLogger()
 
-	startAction := turnAction.NewStartGameAction(repo, logger)
+	startAction := turnAction.NewStartGameAction(repo, testutil.CreateTestCardRegistry(), logger)
 
 	// Execute
 	err := startAction.Execute(context.Background(), "non-existent-game", "some-player")
@@ -62,7 +62,7 @@ func TestStartGameAction_NotInLobby(t *testing.T) {
 	}
 
 	// Start game once using action
-	startAction := turnAction.NewStartGameAction(repo, logger)
+	startAction := turnAction.NewStartGameAction(repo, testutil.CreateTestCardRegistry(), logger)
 	startAction.Execute(ctx, testGame.ID(), testGame.HostPlayerID())
 
 	// Try to start again
@@ -84,7 +84,7 @@ func TestStartGameAction_NotHost(t *testing.T) {
 		p.SetCorporationID("corp-tharsis-republic")
 	}
 
-	startAction := turnAction.NewStartGameAction(repo, logger)
+	startAction := turnAction.NewStartGameAction(repo, testutil.CreateTestCardRegistry(), logger)
 
 	// Get non-host player
 	nonHostPlayer := ""
@@ -112,7 +112,7 @@ func TestStartGameAction_MinimumPlayers(t *testing.T) {
 	players := testGame.GetAllPlayers()
 	players[0].SetCorporationID("corp-tharsis-republic")
 
-	startAction := turnAction.NewStartGameAction(repo, logger)
+	startAction := turnAction.NewStartGameAction(repo, testutil.CreateTestCardRegistry(), logger)
 
 	// Execute - should allow solo play
 	err := startAction.Execute(context.Background(), testGame.ID(), testGame.HostPlayerID())
@@ -137,7 +137,7 @@ func TestStartGameAction_InitialResourcesSet(t *testing.T) {
 		p.SetCorporationID("corp-tharsis-republic")
 	}
 
-	startAction := turnAction.NewStartGameAction(repo, logger)
+	startAction := turnAction.NewStartGameAction(repo, testutil.CreateTestCardRegistry(), logger)
 
 	// Execute
 	err := startAction.Execute(context.Background(), testGame.ID(), testGame.HostPlayerID())
@@ -0,0 +1,168 @@
+package action_test
+
+import (
+	"context"
+	"testing"
+
+	gameaction "terraforming-mars-backend/internal/action/game"
+	turnAction "terraforming-mars-backend/internal/action/turn_management"
+	tutorialAction "terraforming-mars-backend/internal/action/tutorial"
+	"terraforming-mars-backend/internal/delivery/dto"
+	"terraforming-mars-backend/internal/game"
+	"terraforming-mars-backend/internal/tutorial"
+	"terraforming-mars-backend/test/testutil"
+)
+
+func newTestTutorial(t *testing.T) (*tutorialAction.StartTutorialAction, *tutorial.Tracker, game.GameRepository) {
+	t.Helper()
+	action, tracker, repo, _ := newTestScenarioAction(t, nil)
+	return action, tracker, repo
+}
+
+// newTestScenarioAction builds a start action for a single test scenario, optionally with a puzzle victory condition
+func newTestScenarioAction(t *testing.T, victory *tutorial.VictoryCondition) (*tutorialAction.StartTutorialAction, *tutorial.Tracker, game.GameRepository, *tutorial.CompletionStore) {
+	t.Helper()
+
+	corpID := "corp-credicor"
+	generation := 3
+	scenario := tutorial.Scenario{
+		ID:        "test-scenario",
+		Name:      "Test Scenario",
+		CardPacks: []string{"base"},
+		Setup: dto.ConfirmDemoSetupRequest{
+			CorporationID:    &corpID,
+			CardIDs:          []string{"card-power-plant"},
+			Resources:        dto.ResourcesDto{Credits: 30, Heat: 8},
+			Production:       dto.ProductionDto{Credits: 2},
+			TerraformRating:  22,
+			GlobalParameters: &dto.GlobalParametersDto{Temperature: -20, Oxygen: 3, Oceans: 1},
+			Generation:       &generation,
+		},
+		Steps: []tutorial.Step{
+			{ID: "play", Instruction: "Play Power Plant", Objective: tutorial.Objective{Type: tutorial.ObjectivePlayCard, CardID: "card-power-plant"}},
+			{ID: "heat", Instruction: "Raise temperature", Objective: tutorial.Objective{Type: tutorial.ObjectiveGlobalParameterAtLeast, Parameter: tutorial.ParameterTemperature, Amount: -18}},
+		},
+		Victory: victory,
+	}
+	if err := scenario.Validate(); err != nil {
+		t.Fatalf("test scenario invalid: %v", err)
+	}
+
+	repo := game.NewInMemoryGameRepository()
+	cardRegistry := testutil.CreateTestCardRegistry()
+	logger := testutil.TestLogger()
+	completions := tutorial.NewCompletionStore()
+	tracker := tutorial.NewTracker(cardRegistry, completions)
+
+	action := tutorialAction.NewStartTutorialAction(
+		repo,
+		cardRegistry,
+		tutorial.NewRegistry([]tutorial.Scenario{scenario}),
+		tracker,
+		gameaction.NewCreateDemoLobbyAction(repo, cardRegistry, logger),
+		turnAction.NewStartGameAction(repo, testutil.CreateTestCardRegistry(), logger),
+		gameaction.NewConfirmDemoSetupAction(repo, cardRegistry, logger),
+		logger,
+	)
+	return action, tracker, repo, completions
+}
+
+func TestStartTutorialAction_AppliesScenarioSetup(t *testing.T) {
+	action, tracker, repo := newTestTutorial(t)
+	ctx := context.Background()
+
+	result, err := action.Execute(ctx, "test-scenario", "Student", "")
+	testutil.AssertNoError(t, err, "Starting tutorial should succeed")
+
+	g, err := repo.Get(ctx, result.GameDto.ID)
+	testutil.AssertNoError(t, err, "Tutorial game should exist")
+	testutil.AssertEqual(t, game.GamePhaseAction, g.CurrentPhase(), "Tutorial should start in the action phase")
+	testutil.AssertEqual(t, 3, g.Generation(), "Scenario generation should be applied")
+	testutil.AssertEqual(t, -20, g.GlobalParameters().Temperature(), "Scenario temperature should be applied")
+
+	p, err := g.GetPlayer(result.PlayerID)
+	testutil.AssertNoError(t, err, "Tutorial player should exist")
+	testutil.AssertEqual(t, "corp-credicor", p.CorporationID(), "Scenario corporation should be applied")
+	testutil.AssertEqual(t, 30, p.Resources().Get().Credits, "Scenario credits should be applied")
+	testutil.AssertEqual(t, 22, p.Resources().TerraformRating(), "Scenario TR should be applied")
+	testutil.AssertTrue(t, p.Hand().HasCard("card-power-plant"), "Scenario hand should be dealt")
+
+	progress, ok := tracker.Get(g.ID())
+	testutil.AssertTrue(t, ok, "Tutorial progress should be tracked")
+	testutil.AssertEqual(t, result.PlayerID, progress.PlayerID, "Progress should belong to the tutorial player")
+	testutil.AssertEqual(t, 0, progress.StepIndex, "Tutorial should start at the first step")
+}
+
+func TestStartTutorialAction_UnknownScenario(t *testing.T) {
+	action, _, _ := newTestTutorial(t)
+
+	_, err := action.Execute(context.Background(), "missing", "Student", "")
+	testutil.AssertError(t, err, "Unknown scenario should fail")
+}
+
+func TestTutorialTracker_AdvancesThroughSatisfiedSteps(t *testing.T) {
+	action, tracker, repo := newTestTutorial(t)
+	ctx := context.Background()
+
+	result, err := action.Execute(ctx, "test-scenario", "Student", "")
+	testutil.AssertNoError(t, err, "Starting tutorial should succeed")
+	g, _ := repo.Get(ctx, result.GameDto.ID)
+	p, _ := g.GetPlayer(result.PlayerID)
+
+	progress, _ := tracker.Evaluate(g)
+	testutil.AssertEqual(t, 0, progress.StepIndex, "No objective met yet")
+	testutil.AssertEqual(t, "Play Power Plant", progress.ToPayload().Instruction, "Payload should carry the current instruction")
+
+	// The second objective is already met, so both steps complete once the card is played
+	p.PlayedCards().AddCard("card-power-plant", "Power Plant", "automated", nil)
+	testutil.AssertNoError(t, g.GlobalParameters().SetTemperature(ctx, -18), "Setting temperature should succeed")
+
+	progress, _ = tracker.Evaluate(g)
+	testutil.AssertTrue(t, progress.Completed, "All objectives met should complete the tutorial")
+
+	payload := progress.ToPayload()
+	testutil.AssertEqual(t, 2, payload.StepIndex, "Completed payload should point past the last step")
+	testutil.AssertEqual(t, "", payload.Instruction, "Completed payload has no instruction")
+}
+
+func TestPuzzle_SolvedRecordsCompletion(t *testing.T) {
+	victory := &tutorial.VictoryCondition{Type: tutorial.VictoryTerraformRating, Target: 25, ByGeneration: 4}
+	action, tracker, repo, completions := newTestScenarioAction(t, victory)
+	ctx := context.Background()
+
+	result, err := action.Execute(ctx, "test-scenario", "Solver", "account-1")
+	testutil.AssertNoError(t, err, "Starting puzzle should succeed")
+	g, _ := repo.Get(ctx, result.GameDto.ID)
+	p, _ := g.GetPlayer(result.PlayerID)
+
+	progress, _ := tracker.Evaluate(g)
+	testutil.AssertEqual(t, tutorial.PuzzleInProgress, progress.Outcome, "TR 22 should not solve a TR 25 puzzle")
+	testutil.AssertEqual(t, 22, progress.ToPayload().Puzzle.Current, "Payload should report the current TR")
+
+	p.Resources().SetTerraformRating(25)
+	progress, _ = tracker.Evaluate(g)
+	testutil.AssertEqual(t, tutorial.PuzzleSolved, progress.Outcome, "Reaching the target should solve the puzzle")
+	testutil.AssertTrue(t, completions.IsCompleted("account-1", "test-scenario"), "Solved puzzle should be recorded for the account")
+	testutil.AssertFalse(t, completions.IsCompleted("account-2", "test-scenario"), "Other accounts should not be credited")
+}
+
+func TestPuzzle_FailsAfterGenerationLimit(t *testing.T) {
+	victory := &tutorial.VictoryCondition{Type: tutorial.VictoryTerraformRating, Target: 30, ByGeneration: 3}
+	action, tracker, repo, completions := newTestScenarioAction(t, victory)
+	ctx := context.Background()
+
+	result, err := action.Execute(ctx, "test-scenario", "Solver", "account-1")
+	testutil.AssertNoError(t, err, "Starting puzzle should succeed")
+	g, _ := repo.Get(ctx, result.GameDto.ID)
+	p, _ := g.GetPlayer(result.PlayerID)
+
+	testutil.AssertNoError(t, g.SetGeneration(ctx, 4), "Advancing generation should succeed")
+	progress, _ := tracker.Evaluate(g)
+	testutil.AssertEqual(t, tutorial.PuzzleFailed, progress.Outcome, "Passing the generation limit should fail the puzzle")
+
+	// A failed puzzle stays failed even if the target is reached later
+	p.Resources().SetTerraformRating(30)
+	progress, _ = tracker.Evaluate(g)
+	testutil.AssertEqual(t, tutorial.PuzzleFailed, progress.Outcome, "Outcome should be final")
+	testutil.AssertFalse(t, completions.IsCompleted("account-1", "test-scenario"), "Failed puzzle should not be recorded")
+}
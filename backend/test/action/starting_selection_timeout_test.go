@@ -0,0 +1,97 @@
+package action_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	turnAction "terraforming-mars-backend/internal/action/turn_management"
+	"terraforming-mars-backend/internal/game"
+	gamecards "terraforming-mars-backend/internal/game/cards"
+	"terraforming-mars-backend/internal/game/deck"
+	"terraforming-mars-backend/internal/game/player"
+	"terraforming-mars-backend/test/testutil"
+)
+
+func TestStartingSelectionTimeoutAction_PicksForWaitingPlayers(t *testing.T) {
+	ctx := context.Background()
+	logger := testutil.TestLogger()
+	cardRegistry := testutil.CreateTestCardRegistry()
+	repo := game.NewInMemoryGameRepository()
+	settings := game.GameSettings{MaxPlayers: 4, RulesOptions: game.RulesOptions{StartingSelectionTimeoutSeconds: 60}}
+	testGame := game.NewGame("starting-timeout", "player-1", settings)
+	var projectCards, corpCards, preludeCards []string
+	for _, card := range cardRegistry.GetAll() {
+		switch card.Type {
+		case gamecards.CardTypeCorporation:
+			corpCards = append(corpCards, card.ID)
+		case gamecards.CardTypePrelude:
+			preludeCards = append(preludeCards, card.ID)
+		default:
+			projectCards = append(projectCards, card.ID)
+		}
+	}
+	testGame.SetDeck(deck.NewDeck(testGame.ID(), projectCards, corpCards, preludeCards))
+	testutil.AssertNoError(t, repo.Create(ctx, testGame), "Failed to create game")
+	for _, id := range []string{"player-1", "player-2", "player-3"} {
+		testutil.AssertNoError(t, testGame.AddPlayer(ctx, player.NewPlayer(testGame.EventBus(), testGame.ID(), id, id)), "Failed to add player")
+	}
+	err := turnAction.NewStartGameAction(repo, cardRegistry, logger).Execute(ctx, testGame.ID(), "player-1")
+	testutil.AssertNoError(t, err, "Failed to start game")
+
+	selectAction := turnAction.NewSelectStartingCardsAction(repo, cardRegistry, logger)
+	stateRepo := game.NewInMemoryGameStateRepository()
+	action := turnAction.NewStartingSelectionTimeoutAction(repo, stateRepo, selectAction, logger)
+
+	deadline, ok := testGame.StartingSelectionDeadline()
+	testutil.AssertTrue(t, ok, "Starting selection should have a deadline")
+
+	// Player 1 chooses in time and keeps their own selection
+	phase := testGame.GetSelectStartingCardsPhase("player-1")
+	chosenCorporation := phase.AvailableCorporations[len(phase.AvailableCorporations)-1]
+	var chosenPreludes []string
+	if len(phase.AvailablePreludes) >= turnAction.KeptPreludes {
+		chosenPreludes = phase.AvailablePreludes[:turnAction.KeptPreludes]
+	}
+	err = selectAction.Execute(ctx, testGame.ID(), "player-1", nil, chosenCorporation, chosenPreludes)
+	testutil.AssertNoError(t, err, "Player 1 chooses")
+
+	expired := action.ExpireStartingSelection(ctx, deadline.Add(-time.Second), testutil.RunInGame)
+	testutil.AssertEqual(t, 0, len(expired), "Nothing happens before the deadline")
+
+	testutil.AssertNoError(t, testGame.Pause(ctx, "player-1"), "Failed to pause")
+	expired = action.ExpireStartingSelection(ctx, deadline.Add(time.Second), testutil.RunInGame)
+	testutil.AssertEqual(t, 0, len(expired), "Paused games are not timed out")
+	testutil.AssertNoError(t, testGame.Resume(ctx), "Failed to resume")
+
+	resumedDeadline, _ := testGame.StartingSelectionDeadline()
+	offered := testGame.GetSelectStartingCardsPhase("player-3").AvailableCorporations[0]
+	secondPhase := testGame.GetSelectStartingCardsPhase("player-2")
+	secondCorporation := secondPhase.AvailableCorporations[len(secondPhase.AvailableCorporations)-1]
+	var secondPreludes []string
+	if len(secondPhase.AvailablePreludes) >= turnAction.KeptPreludes {
+		secondPreludes = secondPhase.AvailablePreludes[:turnAction.KeptPreludes]
+	}
+	expired = action.ExpireStartingSelection(ctx, resumedDeadline.Add(time.Second), func(ctx context.Context, gameID string, fn func(context.Context)) error {
+		// Player 2's own selection was queued ahead of the timeout, so it runs first
+		testutil.AssertNoError(t, selectAction.Execute(ctx, gameID, "player-2", nil, secondCorporation, secondPreludes), "Player 2 chooses")
+		fn(ctx)
+		return nil
+	})
+	testutil.AssertEqual(t, 1, len(expired), "Expired game is changed")
+
+	second, _ := testGame.GetPlayer("player-2")
+	testutil.AssertEqual(t, secondCorporation, second.CorporationID(), "A choice queued ahead of the timeout is kept")
+	waiting, _ := testGame.GetPlayer("player-3")
+	testutil.AssertEqual(t, offered, waiting.CorporationID(), "Waiting player gets the first offered corporation")
+	testutil.AssertEqual(t, 0, len(waiting.Hand().Cards()), "Waiting player keeps no project cards")
+	chooser, _ := testGame.GetPlayer("player-1")
+	testutil.AssertEqual(t, chosenCorporation, chooser.CorporationID(), "Player who chose keeps their corporation")
+	testutil.AssertTrue(t, testGame.CurrentPhase() != game.GamePhaseStartingCardSelection, "Game leaves the starting selection")
+	_, ok = testGame.StartingSelectionDeadline()
+	testutil.AssertFalse(t, ok, "No deadline outside the starting selection")
+
+	diffs, _ := stateRepo.GetDiff(ctx, testGame.ID())
+	testutil.AssertEqual(t, 1, len(diffs), "Only the auto-pick is recorded in the game log")
+	testutil.AssertEqual(t, "player-3", diffs[0].PlayerID, "The auto-pick is logged under its player")
+}
@@ -0,0 +1,206 @@
+package action_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	baseaction "terraforming-mars-backend/internal/action"
+	cardAction "terraforming-mars-backend/internal/action/card"
+	"terraforming-mars-backend/internal/cards"
+	"terraforming-mars-backend/internal/game"
+	"terraforming-mars-backend/internal/game/board"
+	gamecards "terraforming-mars-backend/internal/game/cards"
+	"terraforming-mars-backend/internal/game/player"
+	"terraforming-mars-backend/internal/game/shared"
+	"terraforming-mars-backend/test/testutil"
+)
+
+// createFailingCardRegistry returns cards whose last output targets a player that does not exist,
+// so the behavior applier fails after earlier outputs have already been applied
+func createFailingCardRegistry() cards.CardRegistry {
+	return cards.NewInMemoryCardRegistry([]gamecards.Card{
+		{
+			ID:   "card-test-half-applied",
+			Name: "Half Applied",
+			Type: gamecards.CardTypeAutomated,
+			Pack: "base",
+			Cost: 10,
+			Tags: []shared.CardTag{shared.TagBuilding},
+			ResourceStorage: &gamecards.ResourceStorage{
+				Type:     shared.ResourceMicrobe,
+				Starting: 1,
+			},
+			Behaviors: []shared.CardBehavior{
+				{
+					Triggers: []shared.Trigger{{Type: shared.TriggerTypeAuto}},
+					Outputs: []shared.ResourceCondition{
+						{ResourceType: shared.ResourceCreditProduction, Amount: 2, Target: "self-player"},
+						{ResourceType: shared.ResourceTemperature, Amount: 1, Target: "none"},
+					},
+				},
+				{
+					Triggers: []shared.Trigger{{Type: shared.TriggerTypeAuto}},
+					Outputs: []shared.ResourceCondition{
+						{ResourceType: shared.ResourceCredit, Amount: -3, Target: "any-player"},
+					},
+				},
+			},
+		},
+	})
+}
+
+func TestPlayCard_RollsBackWhenBehaviorFails(t *testing.T) {
+	testGame, repo, p := setupTwoPhaseGame(t, "card-test-half-applied")
+	logger := testutil.TestLogger()
+	ctx := context.Background()
+
+	p.Resources().Add(map[shared.ResourceType]int{shared.ResourceSteel: 2})
+	creditsBefore := p.Resources().Get().Credits
+	trBefore := p.Resources().TerraformRating()
+	temperatureBefore := testGame.GlobalParameters().Temperature()
+
+	playCardAction := cardAction.NewPlayCardAction(repo, createFailingCardRegistry(), nil, logger)
+	target := "nobody"
+	err := playCardAction.Execute(ctx, testGame.ID(), p.ID(), "card-test-half-applied",
+		cardAction.PaymentRequest{Credits: 6, Steel: 2}, nil, nil, &target)
+	testutil.AssertError(t, err, "Play should fail on the unknown target player")
+
+	testutil.AssertTrue(t, p.Hand().HasCard("card-test-half-applied"), "Card should be back in hand")
+	testutil.AssertFalse(t, p.PlayedCards().Contains("card-test-half-applied"), "Card should not be in played cards")
+	testutil.AssertEqual(t, creditsBefore, p.Resources().Get().Credits, "Credits should be refunded")
+	testutil.AssertEqual(t, 2, p.Resources().Get().Steel, "Steel should be refunded")
+	testutil.AssertEqual(t, 0, p.Resources().Production().Credits, "Production from the first behavior should be undone")
+	testutil.AssertEqual(t, 0, p.Resources().GetCardStorage("card-test-half-applied"), "Card storage should not be initialized")
+	testutil.AssertEqual(t, temperatureBefore, testGame.GlobalParameters().Temperature(), "Temperature should be restored")
+	testutil.AssertEqual(t, trBefore, p.Resources().TerraformRating(), "TR gained from temperature should be undone")
+	testutil.AssertEqual(t, 2, testGame.CurrentTurn().ActionsRemaining(), "No action should be consumed")
+
+	// The player can still play the card normally afterwards
+	err = playCardAction.Execute(ctx, testGame.ID(), p.ID(), "card-test-half-applied",
+		cardAction.PaymentRequest{Credits: 10}, nil, nil, nil)
+	testutil.AssertNoError(t, err, "Play without a target should succeed")
+	testutil.AssertEqual(t, 2, p.Resources().Production().Credits, "Production should apply on success")
+	testutil.AssertEqual(t, 1, testGame.CurrentTurn().ActionsRemaining(), "Action should be consumed on success")
+}
+
+func TestUseCardAction_RollsBackInputsWhenOutputsFail(t *testing.T) {
+	testGame, repo := testutil.CreateTestGameWithPlayers(t, 1, testutil.NewMockBroadcaster())
+	logger := testutil.TestLogger()
+	ctx := context.Background()
+
+	p := testGame.GetAllPlayers()[0]
+	testGame.UpdateStatus(ctx, game.GameStatusActive)
+	testGame.UpdatePhase(ctx, game.GamePhaseAction)
+	testGame.SetCurrentTurn(ctx, p.ID(), 2)
+	p.Resources().Add(map[shared.ResourceType]int{shared.ResourceHeat: 5})
+	p.Actions().AddAction(player.CardAction{
+		CardID:        "card-test-converter",
+		CardName:      "Test Converter",
+		BehaviorIndex: 0,
+		Behavior: shared.CardBehavior{
+			Triggers: []shared.Trigger{{Type: shared.TriggerTypeManual}},
+			Inputs: []shared.ResourceCondition{
+				{ResourceType: shared.ResourceHeat, Amount: 3, Target: "self-player"},
+			},
+			Outputs: []shared.ResourceCondition{
+				{ResourceType: shared.ResourcePlant, Amount: 2, Target: "self-player"},
+				{ResourceType: shared.ResourceCredit, Amount: -2, Target: "any-player"},
+			},
+		},
+	})
+
+	useAction := cardAction.NewUseCardActionAction(repo, testutil.CreateTestCardRegistry(), nil, logger)
+	target := "nobody"
+	err := useAction.Execute(ctx, testGame.ID(), p.ID(), "card-test-converter", 0, nil, nil, &target, nil)
+	testutil.AssertError(t, err, "Action should fail on the unknown target player")
+
+	testutil.AssertEqual(t, 5, p.Resources().Get().Heat, "Spent heat should be restored")
+	testutil.AssertEqual(t, 0, p.Resources().Get().Plants, "Plants from the first output should be undone")
+	testutil.AssertEqual(t, 0, p.Actions().List()[0].TimesUsedThisGeneration, "Usage should not be counted")
+	testutil.AssertEqual(t, 2, testGame.CurrentTurn().ActionsRemaining(), "No action should be consumed")
+}
+
+func TestTransaction_RollbackRestoresGameState(t *testing.T) {
+	testGame, _ := testutil.CreateTestGameWithPlayers(t, 2, testutil.NewMockBroadcaster())
+	ctx := context.Background()
+
+	players := testGame.GetAllPlayers()
+	testGame.UpdateStatus(ctx, game.GameStatusActive)
+	testGame.UpdatePhase(ctx, game.GamePhaseAction)
+	testGame.SetCurrentTurn(ctx, players[0].ID(), 2)
+
+	deckBefore := testGame.Deck().GetAvailableCardCount()
+	oxygenBefore := testGame.GlobalParameters().Oxygen()
+	tilesBefore := testGame.Board().Tiles()
+
+	tx := testGame.BeginTransaction()
+
+	players[0].Resources().Add(map[shared.ResourceType]int{shared.ResourceCredit: 20})
+	players[1].Hand().AddCard("card-artificial-photosynthesis")
+	_, err := testGame.Deck().DrawProjectCards(ctx, 2)
+	testutil.AssertNoError(t, err, "Draw should succeed")
+	_, err = testGame.GlobalParameters().IncreaseOxygen(ctx, 1)
+	testutil.AssertNoError(t, err, "Oxygen increase should succeed")
+	err = testGame.Milestones().ClaimMilestone(ctx, shared.MilestoneMayor, players[0].ID(), 1)
+	testutil.AssertNoError(t, err, "Milestone claim should succeed")
+	if len(tilesBefore) > 0 {
+		err = testGame.Board().UpdateTileOccupancy(ctx, tilesBefore[0].Coordinates,
+			board.TileOccupant{Type: shared.ResourceCityTile}, players[0].ID())
+		testutil.AssertNoError(t, err, "Tile placement should succeed")
+	}
+	testGame.SetCurrentTurn(ctx, players[1].ID(), 1)
+
+	tx.Rollback()
+
+	testutil.AssertEqual(t, 0, players[0].Resources().Get().Credits, "Credits should be restored")
+	testutil.AssertFalse(t, players[1].Hand().HasCard("card-artificial-photosynthesis"), "Hand should be restored")
+	testutil.AssertEqual(t, deckBefore, testGame.Deck().GetAvailableCardCount(), "Deck should be restored")
+	testutil.AssertEqual(t, oxygenBefore, testGame.GlobalParameters().Oxygen(), "Oxygen should be restored")
+	testutil.AssertEqual(t, 0, testGame.Milestones().ClaimedCount(), "Milestone claim should be undone")
+	if len(tilesBefore) > 0 {
+		tile, err := testGame.Board().GetTile(tilesBefore[0].Coordinates)
+		testutil.AssertNoError(t, err, "Tile should exist")
+		testutil.AssertTrue(t, tile.OccupiedBy == nil, "Tile should be unoccupied again")
+	}
+	testutil.AssertEqual(t, players[0].ID(), testGame.CurrentTurn().PlayerID(), "Turn should be restored")
+	testutil.AssertEqual(t, 2, testGame.CurrentTurn().ActionsRemaining(), "Actions remaining should be restored")
+
+	// Rolling back again or after commit is a no-op
+	players[0].Resources().Add(map[shared.ResourceType]int{shared.ResourceCredit: 5})
+	tx.Rollback()
+	testutil.AssertEqual(t, 5, players[0].Resources().Get().Credits, "Second rollback should do nothing")
+
+	committed := testGame.BeginTransaction()
+	players[0].Resources().Add(map[shared.ResourceType]int{shared.ResourceCredit: 5})
+	committed.Commit()
+	committed.Rollback()
+	testutil.AssertEqual(t, 10, players[0].Resources().Get().Credits, "Rollback after commit should do nothing")
+}
+
+func TestRunInTransaction_RollsBackWhenDeadlinePasses(t *testing.T) {
+	testGame, _ := testutil.CreateTestGameWithPlayers(t, 1, testutil.NewMockBroadcaster())
+	p := testGame.GetAllPlayers()[0]
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Hour)
+	err := baseaction.RunInTransaction(ctx, testGame, testutil.TestLogger(), func() error {
+		p.Resources().Add(map[shared.ResourceType]int{shared.ResourceCredit: 7})
+		testutil.AssertTrue(t, testGame.ActionContext() == ctx, "Effects should see the action's context")
+		cancel()
+		return nil
+	})
+	testutil.AssertTrue(t, errors.Is(err, context.Canceled), "An ended context fails the action")
+	testutil.AssertEqual(t, 0, p.Resources().Get().Credits, "Changes of an ended action are rolled back")
+	testutil.AssertTrue(t, testGame.ActionContext() == context.Background(), "The action context is unbound afterwards")
+
+	expired, cancelExpired := context.WithTimeout(context.Background(), -time.Second)
+	defer cancelExpired()
+	ran := false
+	err = baseaction.RunInTransaction(expired, testGame, testutil.TestLogger(), func() error {
+		ran = true
+		return nil
+	})
+	testutil.AssertTrue(t, errors.Is(err, game.ErrActionTimedOut), "An expired deadline is reported as a timeout")
+	testutil.AssertFalse(t, ran, "An action past its deadline does not start")
+}
@@ -0,0 +1,72 @@
+package action_test
+
+import (
+	"context"
+	"testing"
+
+	cardAction "terraforming-mars-backend/internal/action/card"
+	"terraforming-mars-backend/internal/cards"
+	"terraforming-mars-backend/internal/game"
+	"terraforming-mars-backend/internal/game/player"
+	"terraforming-mars-backend/test/testutil"
+)
+
+func setupTurmoilGame(t *testing.T) (*game.Game, game.GameRepository, cards.CardRegistry, *player.Player) {
+	t.Helper()
+	allCards, err := cards.LoadCardsFromJSON("../../assets/terraforming_mars_cards.json")
+	testutil.AssertNoError(t, err, "Card file should load")
+
+	ctx := context.Background()
+	testGame := game.NewGame("turmoil-game", "", game.GameSettings{MaxPlayers: 1, CardPacks: []string{game.PackBaseGame, game.PackTurmoil}})
+	p := player.NewPlayer(testGame.EventBus(), testGame.ID(), "player-1", "player-1")
+	testutil.AssertNoError(t, testGame.AddPlayer(ctx, p), "Player should join")
+	testGame.UpdateStatus(ctx, game.GameStatusActive)
+	testGame.UpdatePhase(ctx, game.GamePhaseAction)
+	testGame.SetCurrentTurn(ctx, p.ID(), -1)
+
+	repo := game.NewInMemoryGameRepository()
+	testutil.AssertNoError(t, repo.Create(ctx, testGame), "Game should be stored")
+	return testGame, repo, cards.NewInMemoryCardRegistry(allCards), p
+}
+
+func TestPlayCard_NotesUncheckedTurmoilRequirement(t *testing.T) {
+	testGame, repo, registry, p := setupTurmoilGame(t)
+	playerID := p.ID()
+	ctx := context.Background()
+	testutil.SetPlayerCredits(ctx, p, 20)
+	p.Hand().AddCard("T13")
+
+	stateRepo := game.NewInMemoryGameStateRepository()
+	playCard := cardAction.NewPlayCardAction(repo, registry, stateRepo, testutil.TestLogger())
+	err := playCard.Execute(ctx, testGame.ID(), playerID, "T13", cardAction.PaymentRequest{Credits: 5}, nil, nil, nil)
+	testutil.AssertNoError(t, err, "Sponsored Mohole plays without Turmoil")
+	testutil.AssertEqual(t, 2, p.Resources().Production().Heat, "The rest of the card applies")
+
+	diffs, err := stateRepo.GetDiff(ctx, testGame.ID())
+	testutil.AssertNoError(t, err, "Play should be logged")
+	testutil.AssertEqual(t,
+		"Played Sponsored Mohole for 5 credits; its requirement (Kelvinists ruling or 2 delegates there) is not checked until Turmoil is supported",
+		diffs[len(diffs)-1].Description, "The log notes the unchecked requirement")
+}
+
+func TestUseCardAction_NotesSkippedDelegate(t *testing.T) {
+	testGame, repo, registry, p := setupTurmoilGame(t)
+	playerID := p.ID()
+	ctx := context.Background()
+	testutil.SetPlayerCredits(ctx, p, 40)
+	p.Hand().AddCard("T07")
+
+	stateRepo := game.NewInMemoryGameStateRepository()
+	playCard := cardAction.NewPlayCardAction(repo, registry, stateRepo, testutil.TestLogger())
+	testutil.AssertNoError(t, playCard.Execute(ctx, testGame.ID(), playerID, "T07", cardAction.PaymentRequest{Credits: 7}, nil, nil, nil),
+		"Martian Media Center should be played")
+
+	useAction := cardAction.NewUseCardActionAction(repo, registry, stateRepo, testutil.TestLogger())
+	testutil.AssertNoError(t, useAction.Execute(ctx, testGame.ID(), playerID, "T07", 1, nil, nil, nil, nil), "The delegate action should run")
+	testutil.AssertEqual(t, 40-7-3, p.Resources().Get().Credits, "The action is still paid")
+
+	diffs, err := stateRepo.GetDiff(ctx, testGame.ID())
+	testutil.AssertNoError(t, err, "Action should be logged")
+	testutil.AssertEqual(t, "Used Martian Media Center action; 1 delegate has no effect until Turmoil is supported",
+		diffs[len(diffs)-1].Description, "The log notes the skipped delegate")
+}
@@ -0,0 +1,74 @@
+package action_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	gameaction "terraforming-mars-backend/internal/action/game"
+	turnAction "terraforming-mars-backend/internal/action/turn_management"
+	"terraforming-mars-backend/internal/game"
+	"terraforming-mars-backend/internal/game/player"
+	"terraforming-mars-backend/test/testutil"
+)
+
+func TestTurnTimeoutAction_SkipsTurnsPastTimerAndTimebank(t *testing.T) {
+	ctx := context.Background()
+	logger := testutil.TestLogger()
+	cardRegistry := testutil.CreateTestCardRegistry()
+	repo := game.NewInMemoryGameRepository()
+	settings := game.GameSettings{MaxPlayers: 4, RulesOptions: game.RulesOptions{TurnTimerSeconds: 60, TimebankSeconds: 30}}
+	testGame := game.NewGame("turn-timeout", "player-1", settings)
+	testutil.AssertNoError(t, repo.Create(ctx, testGame), "Failed to create game")
+	for _, id := range []string{"player-1", "player-2"} {
+		testutil.AssertNoError(t, testGame.AddPlayer(ctx, player.NewPlayer(testGame.EventBus(), testGame.ID(), id, id)), "Failed to add player")
+	}
+	testutil.StartTestGame(t, testGame)
+
+	skipAction := turnAction.NewSkipActionAction(repo, cardRegistry, gameaction.NewFinalScoringAction(repo, cardRegistry, nil, logger), logger)
+	stateRepo := game.NewInMemoryGameStateRepository()
+	action := turnAction.NewTurnTimeoutAction(repo, stateRepo, skipAction, logger)
+
+	startedAt, _ := testGame.TurnStartedAt()
+	deadline, ok := testGame.TurnDeadline()
+	testutil.AssertTrue(t, ok, "Action phase turns should have a deadline")
+	testutil.AssertEqual(t, startedAt.Add(90*time.Second), deadline, "Deadline is the turn timer plus the timebank")
+	testutil.AssertEqual(t, 30*time.Second, testGame.TimebankRemaining("player-1"), "Timebank is untouched")
+
+	expired := action.ExpireTurns(ctx, deadline.Add(-time.Second), testutil.RunInGame)
+	testutil.AssertEqual(t, 0, len(expired), "Nothing happens before the deadline")
+
+	testutil.AssertNoError(t, testGame.Pause(ctx, "player-1"), "Failed to pause")
+	expired = action.ExpireTurns(ctx, deadline.Add(time.Second), testutil.RunInGame)
+	testutil.AssertEqual(t, 0, len(expired), "Paused games are not timed out")
+	testutil.AssertNoError(t, testGame.Resume(ctx), "Failed to resume")
+
+	resumedDeadline, _ := testGame.TurnDeadline()
+	expired = action.ExpireTurns(ctx, resumedDeadline.Add(time.Second), func(context.Context, string, func(context.Context)) error {
+		return game.ErrGameFailed
+	})
+	testutil.AssertEqual(t, 0, len(expired), "A skip the game's queue refused changes nothing")
+	testutil.AssertEqual(t, "player-1", testGame.CurrentTurn().PlayerID(), "The turn only moves inside the game's queue")
+
+	expired = action.ExpireTurns(ctx, resumedDeadline.Add(time.Second), func(ctx context.Context, gameID string, fn func(context.Context)) error {
+		// A pause queued ahead of the skip runs first, so the skip finds the turn no longer expired
+		testutil.AssertNoError(t, testGame.Pause(ctx, "player-1"), "Failed to pause")
+		fn(ctx)
+		return testGame.Resume(ctx)
+	})
+	testutil.AssertEqual(t, 0, len(expired), "The deadline is checked again inside the game's queue")
+
+	resumedDeadline, _ = testGame.TurnDeadline()
+	expired = action.ExpireTurns(ctx, resumedDeadline.Add(time.Second), testutil.RunInGame)
+	testutil.AssertEqual(t, 1, len(expired), "Expired game is changed")
+
+	timedOut, _ := testGame.GetPlayer("player-1")
+	testutil.AssertTrue(t, timedOut.HasPassed(), "A player who took no action passes for the generation")
+	testutil.AssertEqual(t, "player-2", testGame.CurrentTurn().PlayerID(), "The turn moves on")
+
+	entries, err := stateRepo.GetDiff(ctx, testGame.ID())
+	testutil.AssertNoError(t, err, "Log should be readable")
+	last := entries[len(entries)-1]
+	testutil.AssertEqual(t, "player-1", last.PlayerID, "The entry belongs to who ran out of time")
+	testutil.AssertEqual(t, "player-1 ran out of time; turn skipped", last.Summary.Text, "Log names who ran out of time")
+}
@@ -0,0 +1,154 @@
+package action_test
+
+import (
+	"context"
+	"testing"
+
+	cardAction "terraforming-mars-backend/internal/action/card"
+	"terraforming-mars-backend/internal/cards"
+	"terraforming-mars-backend/internal/game"
+	gamecards "terraforming-mars-backend/internal/game/cards"
+	"terraforming-mars-backend/internal/game/player"
+	"terraforming-mars-backend/internal/game/shared"
+	"terraforming-mars-backend/test/testutil"
+)
+
+func setupTwoPhaseGame(t *testing.T, cardID string) (*game.Game, game.GameRepository, *player.Player) {
+	t.Helper()
+	testGame, repo := testutil.CreateTestGameWithPlayers(t, 1, testutil.NewMockBroadcaster())
+	ctx := context.Background()
+
+	p := testGame.GetAllPlayers()[0]
+	p.SetCorporationID("corp-tharsis-republic")
+	testGame.UpdateStatus(ctx, game.GameStatusActive)
+	testGame.UpdatePhase(ctx, game.GamePhaseAction)
+	testGame.SetCurrentTurn(ctx, p.ID(), 2)
+	p.Resources().Add(map[shared.ResourceType]int{shared.ResourceCredit: 100})
+	p.Hand().AddCard(cardID)
+	return testGame, repo, p
+}
+
+func TestTwoPhasePlayCard_PrepareThenCommit(t *testing.T) {
+	testGame, repo, p := setupTwoPhaseGame(t, "card-artificial-photosynthesis")
+	cardRegistry := testutil.CreateTestCardRegistry()
+	logger := testutil.TestLogger()
+	ctx := context.Background()
+
+	playCardAction := cardAction.NewPlayCardAction(repo, cardRegistry, nil, logger)
+	prepareAction := cardAction.NewPreparePlayCardAction(repo, cardRegistry, logger)
+	commitAction := cardAction.NewCommitPlayCardAction(repo, playCardAction, logger)
+
+	preview, err := prepareAction.Execute(ctx, testGame.ID(), p.ID(), "card-artificial-photosynthesis")
+	testutil.AssertNoError(t, err, "Prepare should succeed")
+	testutil.AssertEqual(t, 12, preview.EffectiveCost, "Preview should report the effective cost")
+	testutil.AssertEqual(t, 2, preview.ChoiceCount, "Preview should report the card's choices")
+	testutil.AssertEqual(t, 0, len(preview.PendingPlacements), "Card places no tiles")
+
+	testutil.AssertEqual(t, 100, p.Resources().Get().Credits, "Nothing is paid at prepare time")
+	testutil.AssertTrue(t, p.Hand().HasCard("card-artificial-photosynthesis"), "Card stays in hand while reserved")
+
+	err = playCardAction.Execute(ctx, testGame.ID(), p.ID(), "card-artificial-photosynthesis", cardAction.PaymentRequest{Credits: 12}, nil, nil, nil)
+	testutil.AssertError(t, err, "Direct play should be blocked while a card is prepared")
+
+	_, err = commitAction.Execute(ctx, testGame.ID(), p.ID(), cardAction.PaymentRequest{Credits: 12}, nil, nil, nil)
+	testutil.AssertError(t, err, "Commit without a required choice should fail")
+	testutil.AssertTrue(t, testGame.GetPendingCardPlay(p.ID()) != nil, "Card should stay reserved after a rejected commit")
+
+	choiceIndex := 0
+	cardID, err := commitAction.Execute(ctx, testGame.ID(), p.ID(), cardAction.PaymentRequest{Credits: 12}, &choiceIndex, nil, nil)
+	testutil.AssertNoError(t, err, "Commit should succeed")
+	testutil.AssertEqual(t, "card-artificial-photosynthesis", cardID, "Commit should play the prepared card")
+	testutil.AssertTrue(t, testGame.GetPendingCardPlay(p.ID()) == nil, "Reservation should be released after commit")
+	testutil.AssertEqual(t, 88, p.Resources().Get().Credits, "Payment should be deducted on commit")
+	testutil.AssertEqual(t, 1, p.Resources().Production().Plants, "Chosen behavior should be applied")
+}
+
+func TestTwoPhasePlayCard_Cancel(t *testing.T) {
+	testGame, repo, p := setupTwoPhaseGame(t, "card-artificial-photosynthesis")
+	cardRegistry := testutil.CreateTestCardRegistry()
+	logger := testutil.TestLogger()
+	ctx := context.Background()
+
+	prepareAction := cardAction.NewPreparePlayCardAction(repo, cardRegistry, logger)
+	cancelAction := cardAction.NewCancelPlayCardAction(repo, logger)
+
+	_, err := cancelAction.Execute(ctx, testGame.ID(), p.ID())
+	testutil.AssertError(t, err, "Cancel without a prepared card should fail")
+
+	_, err = prepareAction.Execute(ctx, testGame.ID(), p.ID(), "card-artificial-photosynthesis")
+	testutil.AssertNoError(t, err, "Prepare should succeed")
+
+	cardID, err := cancelAction.Execute(ctx, testGame.ID(), p.ID())
+	testutil.AssertNoError(t, err, "Cancel should succeed")
+	testutil.AssertEqual(t, "card-artificial-photosynthesis", cardID, "Cancel should release the prepared card")
+	testutil.AssertTrue(t, testGame.GetPendingCardPlay(p.ID()) == nil, "Reservation should be released")
+	testutil.AssertTrue(t, p.Hand().HasCard("card-artificial-photosynthesis"), "Card should remain in hand")
+	testutil.AssertEqual(t, 100, p.Resources().Get().Credits, "Credits should be untouched")
+	testutil.AssertTrue(t, testGame.GetPendingTileSelection(p.ID()) == nil, "No tile selection should be left behind")
+}
+
+func TestTwoPhasePlayCard_ReportsPendingPlacements(t *testing.T) {
+	testGame, repo, p := setupTwoPhaseGame(t, "card-test-city")
+	cardRegistry := cards.NewInMemoryCardRegistry([]gamecards.Card{
+		{
+			ID:   "card-test-city",
+			Name: "Test City",
+			Type: gamecards.CardTypeAutomated,
+			Pack: "base",
+			Cost: 10,
+			Tags: []shared.CardTag{shared.TagBuilding, shared.TagCity},
+			Behaviors: []shared.CardBehavior{
+				{
+					Triggers: []shared.Trigger{{Type: shared.TriggerTypeAuto}},
+					Outputs: []shared.ResourceCondition{
+						{ResourceType: shared.ResourceCityPlacement, Amount: 1, Target: "self-player"},
+					},
+				},
+			},
+		},
+	})
+	logger := testutil.TestLogger()
+	ctx := context.Background()
+
+	playCardAction := cardAction.NewPlayCardAction(repo, cardRegistry, nil, logger)
+	prepareAction := cardAction.NewPreparePlayCardAction(repo, cardRegistry, logger)
+	commitAction := cardAction.NewCommitPlayCardAction(repo, playCardAction, logger)
+
+	preview, err := prepareAction.Execute(ctx, testGame.ID(), p.ID(), "card-test-city")
+	testutil.AssertNoError(t, err, "Prepare should succeed")
+	testutil.AssertTrue(t, preview.AllowSteel, "Building card should allow steel")
+	testutil.AssertEqual(t, 1, len(preview.PendingPlacements), "Preview should list one placement")
+	testutil.AssertEqual(t, "city", preview.PendingPlacements[0], "Preview should list the city placement")
+	testutil.AssertTrue(t, testGame.GetPendingTileSelection(p.ID()) == nil, "Nothing is queued at prepare time")
+
+	_, err = commitAction.Execute(ctx, testGame.ID(), p.ID(), cardAction.PaymentRequest{Credits: 10}, nil, nil, nil)
+	testutil.AssertNoError(t, err, "Commit should succeed")
+
+	selection := testGame.GetPendingTileSelection(p.ID())
+	testutil.AssertTrue(t, selection != nil, "City placement should be pending after commit")
+	testutil.AssertEqual(t, "city", selection.TileType, "Pending tile should be the city")
+}
+
+func TestTwoPhasePlayCard_FailedPlayAndGameEndReleaseTheReservation(t *testing.T) {
+	testGame, repo, p := setupTwoPhaseGame(t, "card-artificial-photosynthesis")
+	cardRegistry := testutil.CreateTestCardRegistry()
+	logger := testutil.TestLogger()
+	ctx := context.Background()
+
+	playCardAction := cardAction.NewPlayCardAction(repo, cardRegistry, nil, logger)
+	prepareAction := cardAction.NewPreparePlayCardAction(repo, cardRegistry, logger)
+	commitAction := cardAction.NewCommitPlayCardAction(repo, playCardAction, logger)
+
+	_, err := prepareAction.Execute(ctx, testGame.ID(), p.ID(), "card-artificial-photosynthesis")
+	testutil.AssertNoError(t, err, "Prepare should succeed")
+	choiceIndex := 0
+	_, err = commitAction.Execute(ctx, testGame.ID(), p.ID(), cardAction.PaymentRequest{Credits: 1}, &choiceIndex, nil, nil)
+	testutil.AssertError(t, err, "Underpaying should fail the play")
+	testutil.AssertTrue(t, testGame.GetPendingCardPlay(p.ID()) == nil, "A failed play releases the reservation")
+	testutil.AssertTrue(t, p.Hand().HasCard("card-artificial-photosynthesis"), "The card stays in hand")
+
+	_, err = prepareAction.Execute(ctx, testGame.ID(), p.ID(), "card-artificial-photosynthesis")
+	testutil.AssertNoError(t, err, "The card can be prepared again")
+	testutil.AssertNoError(t, testGame.UpdateStatus(ctx, game.GameStatusCompleted), "Game should end")
+	testutil.AssertTrue(t, testGame.GetPendingCardPlay(p.ID()) == nil, "Ending the game releases the reservation")
+}
@@ -0,0 +1,113 @@
+package action_test
+
+import (
+	"context"
+	"testing"
+
+	"terraforming-mars-backend/internal/action"
+	cardAction "terraforming-mars-backend/internal/action/card"
+	"terraforming-mars-backend/internal/cards"
+	gamecards "terraforming-mars-backend/internal/game/cards"
+	"terraforming-mars-backend/internal/game/player"
+	"terraforming-mars-backend/internal/game/shared"
+	"terraforming-mars-backend/test/testutil"
+)
+
+func usageLimitedAction(cardID string, limit *shared.UsageLimit) player.CardAction {
+	return player.CardAction{
+		CardID:        cardID,
+		CardName:      cardID,
+		BehaviorIndex: 0,
+		Behavior: shared.CardBehavior{
+			Triggers:   []shared.Trigger{{Type: shared.TriggerTypeManual}},
+			Outputs:    []shared.ResourceCondition{{ResourceType: shared.ResourceCredit, Amount: 1, Target: "self-player"}},
+			UsageLimit: limit,
+		},
+	}
+}
+
+func TestUseCardAction_OncePerGameSurvivesNewGeneration(t *testing.T) {
+	testGame, repo, cardRegistry, playerID := setupSoloGame(t)
+	p, _ := testGame.GetPlayer(playerID)
+	p.Actions().SetActions([]player.CardAction{
+		usageLimitedAction("card-once-per-game", &shared.UsageLimit{Per: shared.UsagePeriodGame, Times: 1}),
+		usageLimitedAction("card-default", nil),
+	})
+	useAction := cardAction.NewUseCardActionAction(repo, cardRegistry, nil, testutil.TestLogger())
+	ctx := context.Background()
+
+	for _, cardID := range []string{"card-once-per-game", "card-default"} {
+		testutil.AssertNoError(t, useAction.Execute(ctx, testGame.ID(), playerID, cardID, 0, nil, nil, nil, nil), "First use should succeed")
+		testutil.AssertError(t, useAction.Execute(ctx, testGame.ID(), playerID, cardID, 0, nil, nil, nil, nil), "Second use in a generation is rejected")
+	}
+
+	p.Actions().ResetGenerationCounts()
+
+	actions := p.Actions().List()
+	testutil.AssertEqual(t, 1, actions[0].TimesUsedThisGame, "Game count survives the generation reset")
+	testutil.AssertTrue(t, actions[0].UsageLimitReached(), "Once-per-game action stays used")
+	testutil.AssertFalse(t, actions[1].UsageLimitReached(), "Default action is usable again")
+
+	state := action.CalculatePlayerCardActionState(actions[0].CardID, actions[0].Behavior,
+		actions[0].TimesUsedThisGeneration, actions[0].TimesUsedThisGame, p, testGame)
+	testutil.AssertFalse(t, state.Available(), "State shows the once-per-game action as used")
+	testutil.AssertEqual(t, player.ErrorCodeActionAlreadyPlayed, state.Errors[0].Code, "Usage limit error is reported")
+
+	testutil.AssertError(t, useAction.Execute(ctx, testGame.ID(), playerID, "card-once-per-game", 0, nil, nil, nil, nil),
+		"Once-per-game action is rejected in a later generation")
+	testutil.AssertNoError(t, useAction.Execute(ctx, testGame.ID(), playerID, "card-default", 0, nil, nil, nil, nil),
+		"Default action can be used in a later generation")
+}
+
+func TestUsageLimit_TwicePerGeneration(t *testing.T) {
+	behavior := shared.CardBehavior{
+		Triggers:   []shared.Trigger{{Type: shared.TriggerTypeManual}},
+		UsageLimit: &shared.UsageLimit{Per: shared.UsagePeriodGeneration, Times: 2},
+	}
+	testutil.AssertFalse(t, behavior.UsageLimitReached(1, 5), "One use of two is left")
+	testutil.AssertTrue(t, behavior.UsageLimitReached(2, 5), "Both uses are spent")
+
+	auto := shared.CardBehavior{Triggers: []shared.Trigger{{Type: shared.TriggerTypeAuto}}}
+	testutil.AssertTrue(t, auto.EffectiveUsageLimit() == nil, "Automatic behaviors are not limited")
+}
+
+func TestUsageLimit_UNMIActionOncePerGeneration(t *testing.T) {
+	allCards, err := cards.LoadCardsFromJSON("../../assets/terraforming_mars_cards.json")
+	if err != nil {
+		t.Fatalf("Failed to load cards: %v", err)
+	}
+	registry := cards.NewInMemoryCardRegistry(allCards)
+	unmi, err := registry.GetByID("B10")
+	testutil.AssertNoError(t, err, "United Nations Mars Initiative should be in the card data")
+
+	testGame, repo := testutil.CreateTestGameWithPlayers(t, 1, testutil.NewMockBroadcaster())
+	testutil.StartTestGame(t, testGame)
+	ctx := context.Background()
+	playerID := testGame.TurnOrder()[0]
+	testutil.AssertNoError(t, testGame.SetCurrentTurn(ctx, playerID, -1), "Failed to set solo unlimited actions")
+	p, _ := testGame.GetPlayer(playerID)
+	testutil.SetPlayerCredits(ctx, p, 20)
+
+	actions := gamecards.NewCorporationProcessor(registry, testutil.TestLogger()).GetManualActions(unmi)
+	testutil.AssertEqual(t, 1, len(actions), "UNMI has one action")
+	unmiAction := actions[0]
+	testutil.AssertEqual(t, shared.OncePerGeneration, *unmiAction.Behavior.EffectiveUsageLimit(), "UNMI's action falls back to once per generation")
+	p.Actions().SetActions(actions)
+
+	useAction := cardAction.NewUseCardActionAction(repo, registry, nil, testutil.TestLogger())
+	use := func() error {
+		return useAction.Execute(ctx, testGame.ID(), playerID, unmi.ID, unmiAction.BehaviorIndex, nil, nil, nil, nil)
+	}
+
+	p.GenerationalEvents().Increment(shared.GenerationalEventTRRaise)
+	startTR := p.Resources().TerraformRating()
+	testutil.AssertNoError(t, use(), "UNMI's action is usable after a TR raise")
+	testutil.AssertEqual(t, startTR+1, p.Resources().TerraformRating(), "UNMI raises TR")
+	testutil.AssertError(t, use(), "UNMI's action is used up for the generation")
+
+	p.Actions().ResetGenerationCounts()
+	p.GenerationalEvents().Clear()
+	p.GenerationalEvents().Increment(shared.GenerationalEventTRRaise)
+	testutil.AssertNoError(t, use(), "UNMI's action is usable again next generation")
+	testutil.AssertEqual(t, 2, p.Actions().List()[0].TimesUsedThisGame, "Every use counts towards the game total")
+}
@@ -0,0 +1,141 @@
+package audit_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"terraforming-mars-backend/internal/archive"
+	"terraforming-mars-backend/internal/audit"
+	"terraforming-mars-backend/internal/game"
+	"terraforming-mars-backend/internal/game/deck"
+	"terraforming-mars-backend/test/testutil"
+)
+
+var auditProjectCards = []string{"card-1", "card-2", "card-3", "card-4", "card-5", "card-6"}
+
+// setupAuditedGame seats two players at a game with a seeded six-card deck and logs its starting state
+func setupAuditedGame(t *testing.T) (*game.Game, *game.InMemoryGameStateRepository) {
+	t.Helper()
+	g, _ := testutil.CreateTestGameWithPlayers(t, 2, testutil.NewMockBroadcaster())
+	g.SetDeck(deck.NewSeededDeck(g.ID(), auditProjectCards, []string{"corp-1"}, nil, deck.Seed{7}))
+	testutil.StartTestGame(t, g)
+
+	stateRepo := game.NewInMemoryGameStateRepository()
+	writeLog(t, stateRepo, g, "Game started")
+	return g, stateRepo
+}
+
+func writeLog(t *testing.T, stateRepo *game.InMemoryGameStateRepository, g *game.Game, description string) {
+	t.Helper()
+	_, err := stateRepo.WriteFull(context.Background(), g.ID(), g, description, game.SourceTypeGameEvent, "", description, nil, nil, nil)
+	testutil.AssertNoError(t, err, "Failed to write log entry")
+}
+
+func diffs(t *testing.T, stateRepo *game.InMemoryGameStateRepository, gameID string) []game.StateDiff {
+	t.Helper()
+	logged, err := stateRepo.GetDiff(context.Background(), gameID)
+	testutil.AssertNoError(t, err, "Failed to read log")
+	return logged
+}
+
+// hasViolation reports whether any violation of the check mentions the text
+func hasViolation(violations []audit.Violation, check audit.Check, text string) bool {
+	for _, violation := range violations {
+		if violation.Check == check && strings.Contains(violation.Detail, text) {
+			return true
+		}
+	}
+	return false
+}
+
+func TestCheckGame_SoundGameHasNoViolations(t *testing.T) {
+	g, stateRepo := setupAuditedGame(t)
+	ctx := context.Background()
+	p1, _ := g.GetPlayer("player-1")
+
+	drawn, err := g.Deck().DrawProjectCards(ctx, 3)
+	testutil.AssertNoError(t, err, "Failed to draw")
+	for _, cardID := range drawn[:2] {
+		p1.Hand().AddCard(cardID)
+	}
+	testutil.AssertNoError(t, g.Deck().Discard(ctx, drawn[2:]), "Failed to discard")
+	testutil.AddPlayerCredits(ctx, p1, 7)
+	writeLog(t, stateRepo, g, "Drew cards")
+
+	violations := audit.CheckGame(g, diffs(t, stateRepo, g.ID()))
+	testutil.AssertEqual(t, 0, len(violations), "Every card is accounted for and the log matches the state")
+}
+
+func TestCheckGame_FindsLostAndDuplicatedCards(t *testing.T) {
+	g, stateRepo := setupAuditedGame(t)
+	ctx := context.Background()
+	p1, _ := g.GetPlayer("player-1")
+	p2, _ := g.GetPlayer("player-2")
+
+	drawn, err := g.Deck().DrawProjectCards(ctx, 2)
+	testutil.AssertNoError(t, err, "Failed to draw")
+	p1.Hand().AddCard(drawn[0])
+	p2.Hand().AddCard(drawn[0])
+	writeLog(t, stateRepo, g, "Dealt a card twice and lost one")
+
+	violations := audit.CheckGame(g, diffs(t, stateRepo, g.ID()))
+	testutil.AssertTrue(t, hasViolation(violations, audit.CheckCards, "card "+drawn[0]+" is in 2 places"), "A card in two hands is reported")
+	testutil.AssertTrue(t, hasViolation(violations, audit.CheckCards, "1 of 6 cards are unaccounted for: "+drawn[1]), "A drawn card in no location is reported")
+	testutil.AssertTrue(t, hasViolation(violations, audit.CheckCards, "added to player-2's hand while player-1 still holds it"), "The log shows the card reaching a second hand")
+}
+
+func TestCheckGame_FindsUnloggedChangesAndOceanMismatch(t *testing.T) {
+	g, stateRepo := setupAuditedGame(t)
+	ctx := context.Background()
+	p1, _ := g.GetPlayer("player-1")
+
+	testutil.AssertNoError(t, g.GlobalParameters().SetOceans(ctx, 2), "Failed to set oceans")
+	testutil.AddPlayerCredits(ctx, p1, 5)
+
+	violations := audit.CheckGame(g, diffs(t, stateRepo, g.ID()))
+	testutil.AssertTrue(t, hasViolation(violations, audit.CheckOceans, "0 ocean tiles on the board, but the ocean count is 2"), "Oceans without tiles are reported")
+	testutil.AssertTrue(t, hasViolation(violations, audit.CheckResources, "player-1 has 5 credits, but the log adds up to 0"), "Credits gained outside the log are reported")
+}
+
+func TestCheckRecord_VerifiesShuffleAndLog(t *testing.T) {
+	seed := deck.Seed{7}
+	order := deck.ShuffleOrder(seed, auditProjectCards, []string{"corp-1"}, nil)
+	proof := &archive.ShuffleProof{
+		Algorithm:  deck.ShuffleAlgorithm,
+		Commitment: order.Commitment(seed),
+		Seed:       seed.String(),
+		Order:      order,
+	}
+	sound := archive.Record{
+		GameID: "archived",
+		Log: []game.StateDiff{
+			{SequenceNumber: 1, Changes: &game.GameChanges{PlayerChanges: map[string]*game.PlayerChanges{
+				"player-1": {Credits: &game.DiffValueInt{Old: 0, New: 10}, HandSize: &game.DiffValueInt{Old: 0, New: 1}, CardsAdded: []string{"card-1"}},
+			}}},
+			{SequenceNumber: 2, Changes: &game.GameChanges{PlayerChanges: map[string]*game.PlayerChanges{
+				"player-1": {Credits: &game.DiffValueInt{Old: 10, New: 4}, HandSize: &game.DiffValueInt{Old: 1, New: 0}, CardsRemoved: []string{"card-1"}, CardsPlayed: []string{"card-1"}},
+			}}},
+		},
+		ShuffleProof: proof,
+	}
+	testutil.AssertEqual(t, 0, len(audit.CheckRecord(sound)), "A consistent record has no violations")
+
+	broken := sound
+	broken.ShuffleProof = &archive.ShuffleProof{Algorithm: proof.Algorithm, Commitment: strings.Repeat("0", 64), Seed: proof.Seed, Order: order}
+	broken.Log = append(append([]game.StateDiff(nil), sound.Log...),
+		game.StateDiff{SequenceNumber: 3, Changes: &game.GameChanges{
+			Oceans: &game.DiffValueInt{Old: 0, New: 10},
+			PlayerChanges: map[string]*game.PlayerChanges{
+				"player-2": {Credits: &game.DiffValueInt{Old: 3, New: -1}, CardsAdded: []string{"card-99"}, CardsPlayed: []string{"card-1"}},
+			},
+		}},
+	)
+	violations := audit.CheckRecord(broken)
+	testutil.AssertTrue(t, hasViolation(violations, audit.CheckShuffle, "commitment mismatch"), "A tampered commitment is reported")
+	testutil.AssertTrue(t, hasViolation(violations, audit.CheckOceans, "ocean count went to 10"), "Oceans past the limit are reported")
+	testutil.AssertTrue(t, hasViolation(violations, audit.CheckResources, "player-2's credits changed from 3, but the log left it at 0"), "A gap in the log is reported")
+	testutil.AssertTrue(t, hasViolation(violations, audit.CheckResources, "player-2's credits went negative"), "Negative resources are reported")
+	testutil.AssertTrue(t, hasViolation(violations, audit.CheckCards, "card-99, which is not in the revealed card pool"), "Cards from outside the pool are reported")
+	testutil.AssertTrue(t, hasViolation(violations, audit.CheckCards, "card card-1 played by player-2 was already played by player-1"), "A card played twice is reported")
+}
@@ -0,0 +1,49 @@
+package cards_test
+
+import (
+	"strings"
+	"testing"
+
+	"terraforming-mars-backend/internal/cards"
+	gamecards "terraforming-mars-backend/internal/game/cards"
+	"terraforming-mars-backend/internal/game/shared"
+	"terraforming-mars-backend/test/testutil"
+)
+
+func TestDiffCards_ReportsSemanticChanges(t *testing.T) {
+	grant := func(amount int) []shared.CardBehavior {
+		return []shared.CardBehavior{{
+			Triggers:    []shared.Trigger{{Type: "auto"}},
+			Outputs:     []shared.ResourceCondition{{ResourceType: shared.ResourcePlant, Amount: amount, Target: "self-player"}},
+			Description: "Gain plants.",
+		}}
+	}
+	oldCards := []gamecards.Card{
+		{ID: "001", Name: "Kept", Cost: 10, Behaviors: grant(2), Description: "Old text"},
+		{ID: "002", Name: "Dropped", Cost: 5},
+		{ID: "003", Name: "Retuned", Cost: 12, Behaviors: grant(2)},
+	}
+	newCards := []gamecards.Card{
+		{ID: "001", Name: "Kept", Cost: 10, Behaviors: grant(2), Description: "Reworded text"},
+		{ID: "003", Name: "Retuned", Cost: 14, Behaviors: grant(3)},
+		{ID: "004", Name: "Fresh", Cost: 7},
+	}
+
+	diff := cards.DiffCards(oldCards, newCards)
+	testutil.AssertEqual(t, 1, len(diff.Added), "One card added")
+	testutil.AssertEqual(t, "004", diff.Added[0].CardID, "Added card")
+	testutil.AssertEqual(t, 1, len(diff.Removed), "One card removed")
+	testutil.AssertEqual(t, "002", diff.Removed[0].CardID, "Removed card")
+	testutil.AssertEqual(t, 1, len(diff.Changed), "Description-only changes are not reported")
+
+	changes := diff.Changed[0].Changes
+	testutil.AssertEqual(t, 2, len(changes), "Cost and behavior changed")
+	testutil.AssertEqual(t, cards.FieldChange{Field: "cost", Old: "12", New: "14"}, changes[0], "Cost change")
+	testutil.AssertEqual(t, "When played: gain 3 plants", changes[1].New, "Behavior changes read as rules text")
+
+	report := diff.Markdown()
+	testutil.AssertTrue(t, strings.Contains(report, "### Removed (1)"), "Report lists removals")
+	testutil.AssertTrue(t, strings.Contains(report, "cost: `12` → `14`"), "Report shows old and new values")
+
+	testutil.AssertTrue(t, cards.DiffCards(oldCards, oldCards).Empty(), "Identical data has no changes")
+}
@@ -0,0 +1,44 @@
+package cards_test
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"terraforming-mars-backend/internal/cards"
+	"terraforming-mars-backend/test/testutil"
+)
+
+func TestLoadGuidesFromDir_ShippedGuidesMatchTheCardData(t *testing.T) {
+	guides, err := cards.LoadGuidesFromDir("../../assets/guides")
+	testutil.AssertNoError(t, err, "Shipped guides should load")
+	testutil.AssertTrue(t, len(guides) > 0, "Some corporations should have guides")
+
+	cardData, err := cards.LoadCardsFromJSON("../../assets/terraforming_mars_cards.json")
+	testutil.AssertNoError(t, err, "Card data should load")
+	registry := cards.NewInMemoryCardRegistry(cardData)
+	testutil.AssertNoError(t, cards.NewGuideRegistry(guides).CheckAgainst(registry), "Every guide should name a corporation")
+}
+
+func TestLoadGuidesFromDir_RejectsUnknownTagsAndDuplicates(t *testing.T) {
+	dir := t.TempDir()
+	write := func(name, content string) {
+		testutil.AssertNoError(t, os.WriteFile(filepath.Join(dir, name), []byte(content), 0o644), "Guide file should be written")
+	}
+
+	write("a.json", `[{"cardId": "BC1", "summary": "Plants", "strategy": ["gardening"]}]`)
+	_, err := cards.LoadGuidesFromDir(dir)
+	testutil.AssertError(t, err, "Unknown strategy tags should be rejected")
+	testutil.AssertTrue(t, strings.Contains(err.Error(), "gardening"), "Error should name the tag")
+
+	write("a.json", `[{"cardId": "BC1", "summary": "Plants", "strategy": ["plants"]}]`)
+	write("b.json", `[{"cardId": "BC1", "summary": "Again"}]`)
+	_, err = cards.LoadGuidesFromDir(dir)
+	testutil.AssertError(t, err, "A corporation should have one guide")
+
+	write("b.json", `[{"cardId": "B01", "summary": "Not a corporation here"}]`)
+	guides, err := cards.LoadGuidesFromDir(dir)
+	testutil.AssertNoError(t, err, "Distinct guides should load")
+	testutil.AssertError(t, cards.NewGuideRegistry(guides).CheckAgainst(packRegistry()), "Guides for project cards should be rejected")
+}
@@ -0,0 +1,72 @@
+package cards_test
+
+import (
+	"strings"
+	"sync"
+	"testing"
+
+	"terraforming-mars-backend/internal/cards"
+	gamecards "terraforming-mars-backend/internal/game/cards"
+	"terraforming-mars-backend/test/testutil"
+)
+
+func packRegistry() *cards.InMemoryCardRegistry {
+	return cards.NewInMemoryCardRegistry([]gamecards.Card{
+		{ID: "B03", Pack: "base", Type: gamecards.CardTypeAutomated},
+		{ID: "B01", Pack: "base", Type: gamecards.CardTypeEvent},
+		{ID: "BC1", Pack: "base", Type: gamecards.CardTypeCorporation},
+		{ID: "P02", Pack: "prelude", Type: gamecards.CardTypeActive},
+		{ID: "PC1", Pack: "prelude", Type: gamecards.CardTypeCorporation},
+		{ID: "PP1", Pack: "prelude", Type: gamecards.CardTypePrelude},
+		{ID: "V01", Pack: "venus", Type: gamecards.CardTypeAutomated},
+	})
+}
+
+func TestInMemoryCardRegistry_PackAccessorsSplitByKind(t *testing.T) {
+	registry := packRegistry()
+	packs := []string{"prelude", "base", "unknown", "base"}
+
+	testutil.AssertEqual(t, "B01,B03,P02", strings.Join(registry.ProjectDeckForPacks(packs), ","), "Project cards of both packs, sorted")
+	testutil.AssertEqual(t, "BC1,PC1", strings.Join(registry.CorporationsForPacks(packs), ","), "Corporations of both packs, sorted")
+	testutil.AssertEqual(t, "PP1", strings.Join(registry.PreludesForPacks(packs), ","), "Preludes of the prelude pack")
+	testutil.AssertEqual(t, 0, len(registry.PreludesForPacks([]string{"base"})), "Base has no preludes")
+	testutil.AssertEqual(t, 0, len(registry.ProjectDeckForPacks(nil)), "No packs means no cards")
+
+	projects, corps, preludes := cards.GetCardIDsByPacks(registry, []string{"venus"})
+	testutil.AssertEqual(t, "V01", strings.Join(projects, ","), "Helper should use the pack index")
+	testutil.AssertEqual(t, 0, len(corps)+len(preludes), "Venus has only project cards here")
+}
+
+func TestInMemoryCardRegistry_ReturnedSlicesAreCopies(t *testing.T) {
+	registry := packRegistry()
+
+	deck := registry.ProjectDeckForPacks([]string{"base"})
+	deck[0] = "changed"
+	testutil.AssertEqual(t, "B01", registry.ProjectDeckForPacks([]string{"base"})[0], "Callers cannot change the index")
+
+	all := registry.GetAll()
+	testutil.AssertEqual(t, "B01", all[0].ID, "GetAll should be ordered by ID")
+	all[0].Name = "changed"
+	card, err := registry.GetByID("B01")
+	testutil.AssertNoError(t, err, "Card should be found")
+	testutil.AssertEqual(t, "", card.Name, "Callers cannot change registry cards")
+}
+
+func TestInMemoryCardRegistry_ConcurrentFirstUse(t *testing.T) {
+	registry := packRegistry()
+
+	var wg sync.WaitGroup
+	results := make([]string, 16)
+	for i := range results {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			results[i] = strings.Join(registry.CorporationsForPacks([]string{"base", "prelude"}), ",")
+		}(i)
+	}
+	wg.Wait()
+
+	for _, result := range results {
+		testutil.AssertEqual(t, "BC1,PC1", result, "Every goroutine should see the same index")
+	}
+}
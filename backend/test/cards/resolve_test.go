@@ -0,0 +1,56 @@
+package cards_test
+
+import (
+	"testing"
+
+	"terraforming-mars-backend/internal/cards"
+	gamecards "terraforming-mars-backend/internal/game/cards"
+	"terraforming-mars-backend/test/testutil"
+)
+
+func resolveRegistry() cards.CardRegistry {
+	return cards.NewInMemoryCardRegistry([]gamecards.Card{
+		{ID: "009", Name: "Artificial Photosynthesis"},
+		{ID: "006", Name: "Inventors' Guild"},
+		{ID: "031", Name: "Asteroid"},
+		{ID: "032", Name: "Asteroid Mining"},
+		{ID: "040", Name: "Big Asteroid"},
+	})
+}
+
+func TestResolver_MatchesWhatPlayersType(t *testing.T) {
+	resolver := cards.NewResolver(resolveRegistry())
+
+	cases := []struct {
+		query string
+		id    string
+		kind  cards.MatchKind
+	}{
+		{"9", "009", cards.MatchID},
+		{"inventors guild", "006", cards.MatchName},
+		{"AP", "009", cards.MatchAlias},
+		{"art photo", "009", cards.MatchWords},
+		{"artifical photosynthesis", "009", cards.MatchFuzzy},
+		{"asteroid", "031", cards.MatchName},
+	}
+	for _, c := range cases {
+		matches := resolver.Resolve(c.query, 5)
+		testutil.AssertTrue(t, len(matches) > 0, "Query "+c.query+" should match")
+		testutil.AssertEqual(t, c.id, matches[0].Card.ID, "Best match for "+c.query)
+		testutil.AssertEqual(t, c.kind, matches[0].MatchedBy, "Match kind for "+c.query)
+	}
+}
+
+func TestResolver_RanksAndLimits(t *testing.T) {
+	resolver := cards.NewResolver(resolveRegistry())
+
+	matches := resolver.Resolve("aster", 5)
+	testutil.AssertEqual(t, 3, len(matches), "Every asteroid card matches")
+	testutil.AssertEqual(t, "031", matches[0].Card.ID, "Shorter prefix match ranks first")
+	testutil.AssertEqual(t, "032", matches[1].Card.ID, "Longer prefix match ranks next")
+	testutil.AssertEqual(t, cards.MatchWords, matches[2].MatchedBy, "A later word ranks below prefixes")
+
+	testutil.AssertEqual(t, 1, len(resolver.Resolve("aster", 1)), "Limit caps the matches")
+	testutil.AssertEqual(t, 0, len(resolver.Resolve("zzz", 5)), "Unrelated queries match nothing")
+	testutil.AssertEqual(t, 0, len(resolver.Resolve("  ", 5)), "Blank queries match nothing")
+}
@@ -0,0 +1,54 @@
+package delivery_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"terraforming-mars-backend/internal/cards"
+	"terraforming-mars-backend/internal/delivery/dto"
+	httpHandler "terraforming-mars-backend/internal/delivery/http"
+	gamecards "terraforming-mars-backend/internal/game/cards"
+	"terraforming-mars-backend/internal/game/shared"
+	"terraforming-mars-backend/test/testutil"
+
+	"github.com/gorilla/mux"
+)
+
+func TestExplainBehaviors_ServesClausesInBehaviorOrder(t *testing.T) {
+	registry := cards.NewInMemoryCardRegistry([]gamecards.Card{{
+		ID: "X1", Name: "Power Sink", Type: gamecards.CardTypeActive,
+		Behaviors: []shared.CardBehavior{
+			{
+				Triggers: []shared.Trigger{{Type: "auto"}},
+				Outputs:  []shared.ResourceCondition{{ResourceType: shared.ResourceEnergyProduction, Amount: 1, Target: "self-player"}},
+			},
+			{
+				Triggers: []shared.Trigger{{Type: shared.TriggerTypeManual}},
+				Inputs:   []shared.ResourceCondition{{ResourceType: shared.ResourceEnergy, Amount: 1, Target: "self-player"}},
+				Outputs:  []shared.ResourceCondition{{ResourceType: shared.ResourceTR, Amount: 1, Target: "self-player"}},
+			},
+		},
+	}})
+	handler := httpHandler.NewBehaviorHandler(registry)
+	get := func(cardID string) *httptest.ResponseRecorder {
+		recorder := httptest.NewRecorder()
+		request := mux.SetURLVars(httptest.NewRequest(http.MethodGet, "/api/v1/cards/"+cardID+"/behaviors/explain", nil), map[string]string{"cardId": cardID})
+		handler.ExplainBehaviors(recorder, request)
+		return recorder
+	}
+
+	recorder := get("X1")
+	testutil.AssertEqual(t, http.StatusOK, recorder.Code, "Known card")
+	var response dto.CardBehaviorsExplainResponse
+	testutil.AssertNoError(t, json.Unmarshal(recorder.Body.Bytes(), &response), "Response should be JSON")
+	testutil.AssertEqual(t, 2, len(response.Clauses), "One clause per behavior")
+	testutil.AssertEqual(t, "When played: increase your energy production 1 step", response.Clauses[0].Text, "Immediate clause")
+	testutil.AssertEqual(t, 1, response.Clauses[1].BehaviorIndex, "Clauses carry their behavior index")
+	testutil.AssertEqual(t, "Action", response.Clauses[1].Label, "Action label")
+	testutil.AssertEqual(t, "Action: spend 1 energy → gain 1 TR", response.Clauses[1].Text, "Action clause")
+	testutil.AssertTrue(t, response.Clauses[1].Choices != nil, "Choices are an empty list, not null")
+
+	testutil.AssertEqual(t, http.StatusNotFound, get("nope").Code, "Unknown card")
+}
@@ -0,0 +1,93 @@
+package delivery_test
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+
+	"terraforming-mars-backend/internal/delivery/dto"
+	"terraforming-mars-backend/test/testutil"
+)
+
+// serverPayloads are the payloads the server sends; everything reachable from them is client contract
+var serverPayloads = []any{
+	dto.GameUpdatedPayload{},
+	dto.FullStatePayload{},
+	dto.PlayerConnectedPayload{},
+	dto.PlayerReconnectedPayload{},
+	dto.PlayerDisconnectedPayload{},
+	dto.PlayerKickedPayload{},
+	dto.GameCreatedPayload{},
+	dto.ActionSuccessPayload{},
+	dto.ErrorPayload{},
+	dto.HelloAckPayload{},
+	dto.SyncResponsePayload{},
+	dto.StateSlicesPayload{},
+	dto.LogUpdatePayload{},
+	dto.TutorialProgressPayload{},
+	dto.CardPlayPreparedPayload{},
+	dto.ConfirmPassWithConvertiblesPayload{},
+	dto.ProductionPhaseStartedPayload{},
+	dto.GenerationSummaryPayload{},
+}
+
+var dtoPackage = reflect.TypeOf(dto.GameDto{}).PkgPath()
+
+// walkContract visits every struct type reachable from t and reports types declared outside the dto package
+func walkContract(t *testing.T, typ reflect.Type, path string, seen map[reflect.Type]bool) {
+	t.Helper()
+
+	for typ.Kind() == reflect.Pointer || typ.Kind() == reflect.Slice || typ.Kind() == reflect.Array || typ.Kind() == reflect.Map {
+		typ = typ.Elem()
+	}
+	if typ == reflect.TypeOf(json.RawMessage{}) || seen[typ] {
+		return
+	}
+	seen[typ] = true
+
+	if typ.PkgPath() != "" && typ.PkgPath() != dtoPackage {
+		t.Errorf("%s uses %s from %s; wire types must be declared in the dto package", path, typ.Name(), typ.PkgPath())
+		return
+	}
+	if typ.Kind() != reflect.Struct {
+		return
+	}
+
+	for i := 0; i < typ.NumField(); i++ {
+		field := typ.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		fieldPath := path + "." + field.Name
+
+		jsonTag := field.Tag.Get("json")
+		if jsonTag == "" || strings.HasPrefix(jsonTag, "-") {
+			t.Errorf("%s has no json tag", fieldPath)
+		}
+		if field.Tag.Get("ts") == "" {
+			t.Errorf("%s has no ts tag", fieldPath)
+		}
+		walkContract(t, field.Type, fieldPath, seen)
+	}
+}
+
+func TestServerPayloads_OnlyExposeDtoTypes(t *testing.T) {
+	seen := make(map[reflect.Type]bool)
+	for _, payload := range serverPayloads {
+		typ := reflect.TypeOf(payload)
+		walkContract(t, typ, typ.Name(), seen)
+	}
+	testutil.AssertTrue(t, seen[reflect.TypeOf(dto.PlayerDto{})], "Walk should reach the player view")
+	testutil.AssertTrue(t, seen[reflect.TypeOf(dto.BoardDto{})], "Walk should reach the board")
+}
+
+func TestPlayerConnectedPayload_WireShape(t *testing.T) {
+	data, err := json.Marshal(dto.PlayerConnectedPayload{PlayerID: "player-1", PlayerName: "Alice"})
+	testutil.AssertNoError(t, err, "Payload should marshal")
+	testutil.AssertEqual(t, `{"playerId":"player-1","playerName":"Alice"}`, string(data), "Join confirmation should use camelCase IDs")
+
+	data, err = json.Marshal(dto.ActionSuccessPayload{Action: "skip-action"})
+	testutil.AssertNoError(t, err, "Payload should marshal")
+	testutil.AssertEqual(t, `{"action":"skip-action"}`, string(data), "Unset targets should be omitted")
+}
@@ -0,0 +1,94 @@
+package delivery_test
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"terraforming-mars-backend/internal/action"
+	"terraforming-mars-backend/internal/delivery/dto"
+	"terraforming-mars-backend/internal/game"
+	"terraforming-mars-backend/internal/game/deck"
+	"terraforming-mars-backend/internal/game/player"
+	"terraforming-mars-backend/test/testutil"
+)
+
+func TestGameViewSnapshot_PersonalizesEachViewer(t *testing.T) {
+	testGame, _ := testutil.CreateTestGameWithPlayers(t, 3, testutil.NewMockBroadcaster())
+	snapshot := dto.NewGameViewSnapshot(testGame, testutil.CreateTestCardRegistry())
+
+	for _, p := range testGame.GetAllPlayers() {
+		view := snapshot.ForViewer(p.ID())
+		testutil.AssertEqual(t, p.ID(), view.ViewingPlayerID, "Viewer should be recorded")
+		testutil.AssertEqual(t, p.ID(), view.CurrentPlayer.ID, "Viewer should see their own private view")
+		testutil.AssertEqual(t, 2, len(view.OtherPlayers), "Other players should be listed")
+		for _, other := range view.OtherPlayers {
+			testutil.AssertNotEqual(t, p.ID(), other.ID, "Viewer should not appear among other players")
+		}
+	}
+
+	spectator := snapshot.ForViewer("")
+	testutil.AssertEqual(t, testGame.GetAllPlayers()[0].ID(), spectator.ViewingPlayerID, "Unknown viewer falls back to the first seat")
+}
+
+func TestGameViewSnapshot_TriggeredEffectsReachEveryViewer(t *testing.T) {
+	testGame, _ := testutil.CreateTestGameWithPlayers(t, 2, testutil.NewMockBroadcaster())
+	testGame.AddTriggeredEffect(game.TriggeredEffect{CardName: "Test Effect", PlayerID: "player-1"})
+
+	snapshot := dto.NewGameViewSnapshot(testGame, testutil.CreateTestCardRegistry())
+	for _, p := range testGame.GetAllPlayers() {
+		view := snapshot.ForViewer(p.ID())
+		testutil.AssertEqual(t, 1, len(view.TriggeredEffects), "Every recipient of one broadcast should see the effect")
+	}
+
+	next := dto.ToGameDto(testGame, testutil.CreateTestCardRegistry(), "player-1")
+	testutil.AssertEqual(t, 0, len(next.TriggeredEffects), "Effects are consumed by the snapshot")
+}
+
+func TestGameViewSnapshot_PassAndPlayShowsOnlyTheControlledSeat(t *testing.T) {
+	ctx := context.Background()
+	cardRegistry := testutil.CreateTestCardRegistry()
+	testGame := game.NewGame("pass-and-play", "", game.GameSettings{MaxPlayers: 4, PassAndPlay: true})
+	for _, playerID := range []string{"player-1", "player-2", "player-3"} {
+		p := player.NewPlayer(testGame.EventBus(), testGame.ID(), playerID, playerID)
+		testutil.AssertNoError(t, testGame.AddPlayer(ctx, p), "Player should join")
+	}
+	second, _ := testGame.GetPlayer("player-2")
+	card, err := cardRegistry.GetByID("card-ai-central")
+	testutil.AssertNoError(t, err, "Card should be registered")
+	second.Hand().AddCard(card.ID)
+	second.Hand().AddPlayerCard(card.ID, action.CreateAndCachePlayerCard(card, second, testGame, cardRegistry))
+
+	view := dto.NewGameViewSnapshot(testGame, cardRegistry).ForViewer("player-1")
+	testutil.AssertTrue(t, view.Settings.PassAndPlay, "Settings should report pass-and-play")
+	testutil.AssertEqual(t, "player-1", view.CurrentPlayer.ID, "The controlled seat is the private view")
+	payload, err := json.Marshal(view)
+	testutil.AssertNoError(t, err, "View should serialize")
+	testutil.AssertFalse(t, strings.Contains(string(payload), "card-ai-central"), "A second seat's hand is not sent")
+
+	switched := dto.NewGameViewSnapshot(testGame, cardRegistry).ForViewer("player-2")
+	testutil.AssertEqual(t, 1, len(switched.CurrentPlayer.Cards), "Switching seats shows that seat's hand")
+	testutil.AssertEqual(t, "card-ai-central", switched.CurrentPlayer.Cards[0].ID, "The hand card is the second seat's")
+}
+
+func TestGameViewSnapshot_CountsCardPiles(t *testing.T) {
+	ctx := context.Background()
+	testGame := game.NewGame("piles", "", game.GameSettings{MaxPlayers: 2, CardPacks: []string{game.PackBaseGame, game.PackPrelude}})
+	p := player.NewPlayer(testGame.EventBus(), testGame.ID(), "player-1", "player-1")
+	testutil.AssertNoError(t, testGame.AddPlayer(ctx, p), "Player should join")
+	gameDeck := deck.NewDeck(testGame.ID(), []string{"card-a", "card-b", "card-c"}, []string{"corp-a", "corp-b"}, []string{"prelude-a"})
+	testGame.SetDeck(gameDeck)
+	testutil.AssertNoError(t, gameDeck.Discard(ctx, []string{"card-d"}), "Card should be discarded")
+
+	p.PlayedCards().AddCard("card-e", "Automated", "automated", nil)
+	p.PlayedCards().AddCard("card-f", "Event", "event", nil)
+	p.PlayedCards().AddCard("card-g", "Event", "event", nil)
+
+	piles := dto.ToGameDto(testGame, testutil.CreateTestCardRegistry(), "player-1").CardPiles
+	testutil.AssertEqual(t, 3, piles.Deck, "Draw pile size")
+	testutil.AssertEqual(t, 1, piles.Discard, "Discard pile size")
+	testutil.AssertEqual(t, 2, piles.Corporations, "Corporations left to deal")
+	testutil.AssertEqual(t, 1, *piles.Preludes, "Preludes are counted with the prelude pack")
+	testutil.AssertEqual(t, dto.PlayerCardPilesDto{FaceUp: 1, Events: 2}, piles.Players["player-1"], "Played piles are split")
+}
@@ -0,0 +1,44 @@
+package delivery_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"terraforming-mars-backend/internal/cards"
+	"terraforming-mars-backend/internal/delivery/dto"
+	httpHandler "terraforming-mars-backend/internal/delivery/http"
+	gamecards "terraforming-mars-backend/internal/game/cards"
+	"terraforming-mars-backend/test/testutil"
+
+	"github.com/gorilla/mux"
+)
+
+func TestGetGuide_ServesCorporationGuides(t *testing.T) {
+	registry := cards.NewInMemoryCardRegistry([]gamecards.Card{
+		{ID: "BC1", Name: "Green Corp", Type: gamecards.CardTypeCorporation},
+		{ID: "BC2", Name: "Plain Corp", Type: gamecards.CardTypeCorporation},
+	})
+	guides := cards.NewGuideRegistry([]cards.CorporationGuide{
+		{CardID: "BC1", Summary: "Greeneries cost less", Strategy: []string{"plants"}},
+	})
+	handler := httpHandler.NewGuideHandler(guides, registry)
+	get := func(cardID string) *httptest.ResponseRecorder {
+		recorder := httptest.NewRecorder()
+		request := mux.SetURLVars(httptest.NewRequest(http.MethodGet, "/api/v1/cards/"+cardID+"/guide", nil), map[string]string{"cardId": cardID})
+		handler.GetGuide(recorder, request)
+		return recorder
+	}
+
+	recorder := get("BC1")
+	testutil.AssertEqual(t, http.StatusOK, recorder.Code, "Guided corporation")
+	var guide dto.CardGuideResponse
+	testutil.AssertNoError(t, json.Unmarshal(recorder.Body.Bytes(), &guide), "Response should be JSON")
+	testutil.AssertEqual(t, "Green Corp", guide.CardName, "Guide carries the card name")
+	testutil.AssertEqual(t, "plants", guide.Strategy[0], "Guide carries its strategy tags")
+	testutil.AssertTrue(t, guide.Tips != nil, "Tips are an empty list, not null")
+
+	testutil.AssertEqual(t, http.StatusNotFound, get("BC2").Code, "Corporation without a guide")
+	testutil.AssertEqual(t, http.StatusNotFound, get("nope").Code, "Unknown card")
+}
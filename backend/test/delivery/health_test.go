@@ -0,0 +1,93 @@
+package delivery_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	gameaction "terraforming-mars-backend/internal/action/game"
+	"terraforming-mars-backend/internal/buildinfo"
+	"terraforming-mars-backend/internal/cards"
+	"terraforming-mars-backend/internal/delivery/dto"
+	httpHandler "terraforming-mars-backend/internal/delivery/http"
+	"terraforming-mars-backend/internal/game"
+	"terraforming-mars-backend/test/testutil"
+)
+
+func TestReadiness_UnavailableUntilEveryCheckPasses(t *testing.T) {
+	hubRunning := false
+	handler := httpHandler.NewHealthHandler(buildinfo.Info{},
+		httpHandler.ReadinessCheck{Name: "cards", Check: func() error { return nil }},
+		httpHandler.ReadinessCheck{Name: "websocket-hub", Check: func() error {
+			if !hubRunning {
+				return fmt.Errorf("websocket hub not running")
+			}
+			return nil
+		}},
+	)
+
+	recorder := httptest.NewRecorder()
+	handler.Readiness(recorder, httptest.NewRequest(http.MethodGet, "/readyz", nil))
+	testutil.AssertEqual(t, http.StatusServiceUnavailable, recorder.Code, "Not ready while the hub is down")
+
+	var response dto.ReadinessResponse
+	testutil.AssertNoError(t, json.Unmarshal(recorder.Body.Bytes(), &response), "Response should be JSON")
+	testutil.AssertEqual(t, "not-ready", response.Status, "Status names the outcome")
+	testutil.AssertTrue(t, response.Checks[0].OK, "Cards check passes")
+	testutil.AssertEqual(t, "websocket hub not running", response.Checks[1].Error, "Failed check carries its error")
+
+	hubRunning = true
+	recorder = httptest.NewRecorder()
+	handler.Readiness(recorder, httptest.NewRequest(http.MethodGet, "/readyz", nil))
+	testutil.AssertEqual(t, http.StatusOK, recorder.Code, "Ready once every check passes")
+
+	recorder = httptest.NewRecorder()
+	handler.Liveness(recorder, httptest.NewRequest(http.MethodGet, "/healthz", nil))
+	testutil.AssertEqual(t, http.StatusOK, recorder.Code, "Liveness does not depend on readiness")
+}
+
+func TestBuildInfo_ReportsCardDataVersion(t *testing.T) {
+	version, err := cards.CardDataVersion("../../assets/terraforming_mars_cards.json")
+	testutil.AssertNoError(t, err, "Card file should fingerprint")
+	testutil.AssertEqual(t, 12, len(version), "Fingerprint is 12 hex digits")
+
+	handler := httpHandler.NewHealthHandler(buildinfo.Read(version))
+	recorder := httptest.NewRecorder()
+	handler.BuildInfo(recorder, httptest.NewRequest(http.MethodGet, "/api/v1/build-info", nil))
+
+	var response dto.BuildInfoResponse
+	testutil.AssertNoError(t, json.Unmarshal(recorder.Body.Bytes(), &response), "Response should be JSON")
+	testutil.AssertEqual(t, version, response.CardDataVersion, "Card data version is reported")
+	testutil.AssertTrue(t, response.GitSHA != "", "Git SHA falls back to unknown rather than empty")
+}
+
+func TestCreateGame_AtCapacityAnswers503WithRetryAfter(t *testing.T) {
+	repo := game.NewInMemoryGameRepository()
+	repo.SetMaxActiveGames(1)
+	createGameAction := gameaction.NewCreateGameAction(repo, testutil.CreateTestCardRegistry(), testutil.TestLogger())
+	handler := httpHandler.NewGameHandler(createGameAction, nil, nil, nil, nil, nil, nil, nil, nil, testutil.CreateTestCardRegistry())
+	create := func() *httptest.ResponseRecorder {
+		recorder := httptest.NewRecorder()
+		handler.CreateGame(recorder, httptest.NewRequest(http.MethodPost, "/api/v1/games", strings.NewReader(`{"maxPlayers":4}`)))
+		return recorder
+	}
+
+	testutil.AssertEqual(t, http.StatusCreated, create().Code, "First game fits")
+	recorder := create()
+	testutil.AssertEqual(t, http.StatusServiceUnavailable, recorder.Code, "Second game is refused")
+	testutil.AssertEqual(t, "30", recorder.Header().Get("Retry-After"), "Refusal says when to retry")
+	var response dto.ErrorPayload
+	testutil.AssertNoError(t, json.Unmarshal(recorder.Body.Bytes(), &response), "Response should be JSON")
+	testutil.AssertEqual(t, dto.ErrCodeServerAtCapacity, response.Code, "Refusal carries its error code")
+
+	health := httpHandler.NewHealthHandler(buildinfo.Info{})
+	health.SetCapacity(repo.Capacity)
+	recorder = httptest.NewRecorder()
+	health.Capacity(recorder, httptest.NewRequest(http.MethodGet, "/api/v1/capacity", nil))
+	var capacity dto.CapacityResponse
+	testutil.AssertNoError(t, json.Unmarshal(recorder.Body.Bytes(), &capacity), "Response should be JSON")
+	testutil.AssertEqual(t, dto.CapacityResponse{ActiveGames: 1, MaxActiveGames: 1, Utilization: 1}, capacity, "Capacity reports the full instance")
+}
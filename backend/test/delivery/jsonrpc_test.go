@@ -0,0 +1,181 @@
+package delivery_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"terraforming-mars-backend/internal/action/query"
+	"terraforming-mars-backend/internal/archive"
+	"terraforming-mars-backend/internal/delivery/dto"
+	"terraforming-mars-backend/internal/delivery/jsonrpc"
+	"terraforming-mars-backend/internal/delivery/websocket/core"
+	"terraforming-mars-backend/test/testutil"
+
+	"github.com/gorilla/mux"
+)
+
+// echoHandler replies to the sending connection so tests can observe routing
+type echoHandler struct{}
+
+func (h *echoHandler) HandleMessage(ctx context.Context, connection *core.Connection, message dto.WebSocketMessage) {
+	connection.SendMessage(dto.WebSocketMessage{
+		Type:    dto.MessageTypeError,
+		GameID:  connection.GameID,
+		Payload: map[string]interface{}{"error": "echo " + connection.PlayerID},
+	})
+}
+
+// rpcFixture is a JSON-RPC server over a two-player game whose second seat belongs to an account
+type rpcFixture struct {
+	router     *mux.Router
+	server     *jsonrpc.Server
+	gameID     string
+	accountKey string
+}
+
+func newRPCFixture(t *testing.T) rpcFixture {
+	t.Helper()
+
+	testGame, repo := testutil.CreateTestGameWithPlayers(t, 2, testutil.NewMockBroadcaster())
+	hub := core.NewHub()
+	hub.RegisterHandler(dto.MessageTypeActionSkipAction, &echoHandler{})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	go hub.Run(ctx)
+
+	gameArchive, err := archive.Open(ctx, archive.NewMemoryStore())
+	testutil.AssertNoError(t, err, "Archive should open")
+	accountKey, err := gameArchive.RegisterAccount(ctx, "account-2")
+	testutil.AssertNoError(t, err, "Account should register")
+	seat, _ := testGame.GetPlayer("player-2")
+	seat.SetAccountID("account-2")
+
+	server := jsonrpc.NewServer(hub, query.NewGetGameAction(repo, testutil.TestLogger()), testutil.CreateTestCardRegistry(), gameArchive)
+	router := mux.NewRouter()
+	router.HandleFunc("/games/{gameId}/players/{playerId}/bot-token", server.IssueToken).Methods(http.MethodPost)
+	router.Handle("/rpc", server).Methods(http.MethodPost)
+
+	return rpcFixture{router: router, server: server, gameID: testGame.ID(), accountKey: accountKey}
+}
+
+// issueToken requests a bot token for a seat, sending key as the Bearer account key when set
+func (f rpcFixture) issueToken(playerID, key string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodPost, "/games/"+f.gameID+"/players/"+playerID+"/bot-token", nil)
+	if key != "" {
+		req.Header.Set("Authorization", "Bearer "+key)
+	}
+	rec := httptest.NewRecorder()
+	f.router.ServeHTTP(rec, req)
+	return rec
+}
+
+func setupRPC(t *testing.T) (*mux.Router, string) {
+	t.Helper()
+
+	fixture := newRPCFixture(t)
+	rec := fixture.issueToken("player-2", fixture.accountKey)
+	testutil.AssertEqual(t, http.StatusCreated, rec.Code, "Token should be issued")
+
+	var issued jsonrpc.IssueTokenResponse
+	testutil.AssertNoError(t, json.Unmarshal(rec.Body.Bytes(), &issued), "Failed to decode token response")
+	testutil.AssertTrue(t, issued.Token != "", "Token should not be empty")
+
+	return fixture.router, issued.Token
+}
+
+func callRPC(t *testing.T, router *mux.Router, token, method string, params interface{}) map[string]interface{} {
+	t.Helper()
+
+	body, _ := json.Marshal(map[string]interface{}{"jsonrpc": "2.0", "id": 1, "method": method, "params": params})
+	req := httptest.NewRequest(http.MethodPost, "/rpc", bytes.NewReader(body))
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, req)
+
+	var response map[string]interface{}
+	testutil.AssertNoError(t, json.Unmarshal(rec.Body.Bytes(), &response), "Failed to decode RPC response")
+	return response
+}
+
+func TestJSONRPC_RequiresToken(t *testing.T) {
+	router, _ := setupRPC(t)
+
+	response := callRPC(t, router, "bogus", jsonrpc.MethodObserveState, nil)
+
+	rpcErr, ok := response["error"].(map[string]interface{})
+	testutil.AssertTrue(t, ok, "Should return an error")
+	testutil.AssertEqual(t, float64(jsonrpc.CodeUnauthorized), rpcErr["code"].(float64), "Should be unauthorized")
+}
+
+func TestJSONRPC_ObserveState(t *testing.T) {
+	router, token := setupRPC(t)
+
+	response := callRPC(t, router, token, jsonrpc.MethodObserveState, nil)
+
+	result := response["result"].(map[string]interface{})
+	game := result["game"].(map[string]interface{})
+	testutil.AssertEqual(t, "player-2", game["viewingPlayerId"].(string), "State should be from the bot's seat")
+}
+
+func TestJSONRPC_SubmitActionAndStreamEvents(t *testing.T) {
+	router, token := setupRPC(t)
+
+	response := callRPC(t, router, token, jsonrpc.MethodSubmitAction, map[string]interface{}{
+		"type": dto.MessageTypeActionSkipAction,
+	})
+	testutil.AssertTrue(t, response["error"] == nil, "Action should be accepted")
+
+	response = callRPC(t, router, token, jsonrpc.MethodStreamEvents, map[string]interface{}{"waitMs": 2000})
+	events := response["result"].(map[string]interface{})["events"].([]interface{})
+	testutil.AssertEqual(t, 1, len(events), "Should receive the handler's reply")
+
+	payload := events[0].(map[string]interface{})["payload"].(map[string]interface{})
+	testutil.AssertEqual(t, "echo player-2", payload["error"].(string), "Handler should see the bot's seat")
+}
+
+func TestJSONRPC_RejectsConnectionMessages(t *testing.T) {
+	router, token := setupRPC(t)
+
+	response := callRPC(t, router, token, jsonrpc.MethodSubmitAction, map[string]interface{}{
+		"type": dto.MessageTypePlayerTakeover,
+	})
+
+	rpcErr, ok := response["error"].(map[string]interface{})
+	testutil.AssertTrue(t, ok, "Should return an error")
+	testutil.AssertEqual(t, float64(jsonrpc.CodeInvalidParams), rpcErr["code"].(float64), "Should reject non-action messages")
+}
+
+func TestJSONRPC_IssueTokenRequiresTheSeatsAccountKey(t *testing.T) {
+	fixture := newRPCFixture(t)
+
+	testutil.AssertEqual(t, http.StatusUnauthorized, fixture.issueToken("player-2", "").Code, "A key is required")
+	testutil.AssertEqual(t, http.StatusForbidden, fixture.issueToken("player-2", "wrong-key").Code, "The key must match the seat's account")
+	testutil.AssertEqual(t, http.StatusForbidden, fixture.issueToken("player-1", fixture.accountKey).Code, "Another seat cannot be claimed with the key")
+	testutil.AssertEqual(t, http.StatusCreated, fixture.issueToken("player-2", fixture.accountKey).Code, "The owner gets a token")
+}
+
+func TestJSONRPC_TokensExpire(t *testing.T) {
+	fixture := newRPCFixture(t)
+	rec := fixture.issueToken("player-2", fixture.accountKey)
+	var issued jsonrpc.IssueTokenResponse
+	testutil.AssertNoError(t, json.Unmarshal(rec.Body.Bytes(), &issued), "Failed to decode token response")
+	testutil.AssertTrue(t, issued.ExpiresAt.After(time.Now()), "Token should expire in the future")
+
+	response := callRPC(t, fixture.router, issued.Token, jsonrpc.MethodObserveState, nil)
+	_, hasError := response["error"]
+	testutil.AssertFalse(t, hasError, "Token should work before it expires")
+
+	fixture.server.Sessions().SetClock(func() time.Time { return issued.ExpiresAt })
+	response = callRPC(t, fixture.router, issued.Token, jsonrpc.MethodObserveState, nil)
+	rpcErr, ok := response["error"].(map[string]interface{})
+	testutil.AssertTrue(t, ok, "Expired token should be rejected")
+	testutil.AssertEqual(t, float64(jsonrpc.CodeUnauthorized), rpcErr["code"].(float64), "Should be unauthorized")
+}
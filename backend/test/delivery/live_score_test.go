@@ -0,0 +1,64 @@
+package delivery_test
+
+import (
+	"context"
+	"testing"
+
+	"terraforming-mars-backend/internal/cards"
+	"terraforming-mars-backend/internal/delivery/dto"
+	"terraforming-mars-backend/internal/game"
+	gamecards "terraforming-mars-backend/internal/game/cards"
+	"terraforming-mars-backend/internal/game/player"
+	"terraforming-mars-backend/test/testutil"
+)
+
+// liveScoreGame seats two players at TR 20, player-2 holding a 2 VP face-down event
+func liveScoreGame(t *testing.T, options game.RulesOptions) (*game.Game, cards.CardRegistry) {
+	t.Helper()
+	ctx := context.Background()
+	testGame := game.NewGame("live-scores", "", game.GameSettings{MaxPlayers: 2, RulesOptions: options})
+	for _, playerID := range []string{"player-1", "player-2"} {
+		p := player.NewPlayer(testGame.EventBus(), testGame.ID(), playerID, playerID)
+		testutil.AssertNoError(t, testGame.AddPlayer(ctx, p), "Player should join")
+		p.Resources().SetTerraformRating(20)
+	}
+
+	registry := cards.NewInMemoryCardRegistry([]gamecards.Card{{
+		ID:           "card-vp-event",
+		Name:         "VP Event",
+		Type:         gamecards.CardTypeEvent,
+		Pack:         "base",
+		VPConditions: []gamecards.VictoryPointCondition{{Amount: 2, Condition: gamecards.VPConditionFixed}},
+	}})
+	p, _ := testGame.GetPlayer("player-2")
+	p.PlayedCards().AddCard("card-vp-event", "VP Event", string(gamecards.CardTypeEvent), nil)
+	return testGame, registry
+}
+
+func TestLiveScores_OnlyTRWhenDisabled(t *testing.T) {
+	testGame, registry := liveScoreGame(t, game.DefaultRulesOptions())
+
+	view := dto.ToGameDto(testGame, registry, "player-1")
+	testutil.AssertTrue(t, view.CurrentPlayer.EstimatedScore == nil, "No estimate for the viewer")
+	testutil.AssertTrue(t, view.OtherPlayers[0].EstimatedScore == nil, "No estimate for other players")
+	testutil.AssertTrue(t, view.OtherPlayers[0].CardVP == nil, "Other players' card VP is not sent")
+	testutil.AssertEqual(t, 20, view.OtherPlayers[0].TerraformRating, "TR is still shown")
+}
+
+func TestLiveScores_EstimateEveryPlayer(t *testing.T) {
+	options := game.DefaultRulesOptions()
+	options.LiveScores = true
+	testGame, registry := liveScoreGame(t, options)
+
+	view := dto.ToGameDto(testGame, registry, "player-1")
+	testutil.AssertEqual(t, 20, *view.CurrentPlayer.EstimatedScore, "Viewer's estimate is their TR")
+	testutil.AssertEqual(t, 22, *view.OtherPlayers[0].EstimatedScore, "Event VP counts towards the estimate")
+	testutil.AssertEqual(t, 2, view.OtherPlayers[0].CardVP["card-vp-event"], "Other players' card VP is sent")
+
+	options.LiveScoresExcludeEvents = true
+	testGame, registry = liveScoreGame(t, options)
+	view = dto.ToGameDto(testGame, registry, "player-1")
+	testutil.AssertEqual(t, 20, *view.OtherPlayers[0].EstimatedScore, "Face-down events are left out")
+	_, listed := view.OtherPlayers[0].CardVP["card-vp-event"]
+	testutil.AssertFalse(t, listed, "Face-down events are left out of the card VP")
+}
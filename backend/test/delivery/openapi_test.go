@@ -0,0 +1,50 @@
+package delivery_test
+
+import (
+	"encoding/json"
+	"regexp"
+	"testing"
+
+	"terraforming-mars-backend/internal/delivery/apidoc"
+	"terraforming-mars-backend/test/testutil"
+)
+
+func TestBuildOpenAPISpec_CoversRoutes(t *testing.T) {
+	spec := apidoc.BuildOpenAPISpec("/api/v1")
+
+	paths, ok := spec["paths"].(map[string]map[string]interface{})
+	testutil.AssertTrue(t, ok, "Paths should be a map")
+
+	expected := map[string]string{
+		"/health":                            "get",
+		"/games":                             "post",
+		"/games/demo/lobby":                  "post",
+		"/games/demo/seeded":                 "post",
+		"/games/{gameId}":                    "get",
+		"/games/{gameId}/logs":               "get",
+		"/games/{gameId}/players/{playerId}": "get",
+		"/cards":                             "get",
+		"/action-catalog":                    "get",
+		"/archive/games":                     "get",
+	}
+	for path, method := range expected {
+		_, found := paths[path][method]
+		testutil.AssertTrue(t, found, "Spec should document "+method+" "+path)
+	}
+}
+
+func TestBuildOpenAPISpec_RefsResolve(t *testing.T) {
+	spec := apidoc.BuildOpenAPISpec("/api/v1")
+
+	data, err := json.Marshal(spec)
+	testutil.AssertNoError(t, err, "Spec should marshal to JSON")
+
+	components := spec["components"].(map[string]interface{})["schemas"].(map[string]apidoc.Schema)
+	testutil.AssertTrue(t, len(components["GameDto"]) > 0, "GameDto schema should be registered")
+
+	refPattern := regexp.MustCompile(`"#/components/schemas/([A-Za-z0-9]+)"`)
+	for _, match := range refPattern.FindAllStringSubmatch(string(data), -1) {
+		_, found := components[match[1]]
+		testutil.AssertTrue(t, found, "Referenced schema should exist: "+match[1])
+	}
+}
@@ -0,0 +1,90 @@
+package delivery_test
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"terraforming-mars-backend/internal/action/query"
+	"terraforming-mars-backend/internal/delivery/dto"
+	httphandler "terraforming-mars-backend/internal/delivery/http"
+	"terraforming-mars-backend/internal/game"
+	httpmiddleware "terraforming-mars-backend/internal/middleware/http"
+	"terraforming-mars-backend/test/testutil"
+
+	"github.com/gorilla/mux"
+)
+
+func TestOverlayStream_PushesChangesAndNewLogEntries(t *testing.T) {
+	testGame, repo := testutil.CreateTestGameWithPlayers(t, 2, testutil.NewMockBroadcaster())
+	service := query.NewGameQueryService(repo, testutil.CreateTestCardRegistry(), 0, testutil.TestLogger())
+	stateRepo := game.NewInMemoryGameStateRepository()
+	handler := httphandler.NewOverlayHandler(query.NewGetGameOverlayAction(service, stateRepo, testutil.TestLogger()))
+
+	router := mux.NewRouter()
+	router.Use(httpmiddleware.LoggingMiddleware)
+	router.HandleFunc("/games/{gameId}/overlay/stream", handler.StreamOverlay)
+	server := httptest.NewServer(router)
+	defer server.Close()
+
+	resp, err := http.Get(server.URL + "/games/missing/overlay/stream")
+	testutil.AssertNoError(t, err, "Request should succeed")
+	resp.Body.Close()
+	testutil.AssertEqual(t, http.StatusNotFound, resp.StatusCode, "Unknown games are not streamed")
+
+	resp, err = http.Get(server.URL + "/games/" + testGame.ID() + "/overlay/stream")
+	testutil.AssertNoError(t, err, "Request should succeed")
+	defer resp.Body.Close()
+	testutil.AssertEqual(t, "text/event-stream", resp.Header.Get("Content-Type"), "Stream is server-sent events")
+
+	reader := bufio.NewReader(resp.Body)
+	event, _ := reader.ReadString('\n')
+	data, _ := reader.ReadString('\n')
+	testutil.AssertEqual(t, "event: overlay\n", event, "First event is the overlay")
+
+	var overlay dto.GameOverlayDto
+	testutil.AssertNoError(t, json.Unmarshal([]byte(strings.TrimPrefix(data, "data: ")), &overlay), "Event data is an overlay")
+	testutil.AssertEqual(t, testGame.ID(), overlay.GameID, "Overlay is for the requested game")
+	testutil.AssertEqual(t, 2, len(overlay.Players), "Every player is on the overlay")
+
+	reader.ReadString('\n')
+
+	// Changes are pushed as they happen, and only the new log entry is sent
+	_, err = stateRepo.WriteFull(context.Background(), testGame.ID(), testGame, "Standard Project: City", game.SourceTypeStandardProject,
+		"player-1", "Built city", nil, nil, nil)
+	testutil.AssertNoError(t, err, "Log entry should be written")
+	event, data = readEvent(t, reader)
+	testutil.AssertEqual(t, "event: action\n", event, "New log entries are sent as actions")
+	var action dto.RecentActionDto
+	testutil.AssertNoError(t, json.Unmarshal([]byte(data), &action), "Event data is a log entry")
+	testutil.AssertEqual(t, int64(1), action.SequenceNumber, "The new entry is sent")
+	event, data = readEvent(t, reader)
+	testutil.AssertEqual(t, "event: overlay\n", event, "The overlay follows its new entries")
+	testutil.AssertNoError(t, json.Unmarshal([]byte(data), &overlay), "Event data is an overlay")
+	testutil.AssertEqual(t, int64(1), overlay.LastAction.SequenceNumber, "The overlay shows the new entry")
+
+	testutil.AssertNoError(t, testGame.UpdateStatus(context.Background(), game.GameStatusCompleted), "Game should end")
+	for event != "event: end\n" {
+		event, _ = readEvent(t, reader)
+	}
+}
+
+// readEvent reads one server-sent event, skipping keep-alive comments, and returns its event line and data
+func readEvent(t *testing.T, reader *bufio.Reader) (string, string) {
+	t.Helper()
+	for {
+		line, err := reader.ReadString('\n')
+		testutil.AssertNoError(t, err, "Stream should stay open")
+		if !strings.HasPrefix(line, "event: ") {
+			continue
+		}
+		data, err := reader.ReadString('\n')
+		testutil.AssertNoError(t, err, "Event should carry data")
+		reader.ReadString('\n')
+		return line, strings.TrimSuffix(strings.TrimPrefix(data, "data: "), "\n")
+	}
+}
@@ -0,0 +1,30 @@
+package delivery_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	httpHandler "terraforming-mars-backend/internal/delivery/http"
+	"terraforming-mars-backend/test/testutil"
+)
+
+func TestProfilingServer_OnlyBindsLoopback(t *testing.T) {
+	for _, addr := range []string{httpHandler.DefaultProfilingAddr, "localhost:6060", "[::1]:6060"} {
+		_, err := httpHandler.NewProfilingServer(addr)
+		testutil.AssertNoError(t, err, "Loopback address "+addr+" should be accepted")
+	}
+	for _, addr := range []string{":6060", "0.0.0.0:6060", "10.0.0.5:6060", "example.com:6060", "6060"} {
+		_, err := httpHandler.NewProfilingServer(addr)
+		testutil.AssertError(t, err, "Address "+addr+" should be refused")
+	}
+}
+
+func TestProfilingServer_ServesProfiles(t *testing.T) {
+	server, err := httpHandler.NewProfilingServer(httpHandler.DefaultProfilingAddr)
+	testutil.AssertNoError(t, err, "Default address should be accepted")
+
+	rec := httptest.NewRecorder()
+	server.Handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/debug/pprof/goroutine?debug=1", nil))
+	testutil.AssertEqual(t, http.StatusOK, rec.Code, "Named profiles are served")
+}
@@ -0,0 +1,90 @@
+package delivery_test
+
+import (
+	"encoding/json"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"regexp"
+	"strconv"
+	"testing"
+
+	"terraforming-mars-backend/internal/delivery/apidoc"
+	"terraforming-mars-backend/internal/delivery/dto"
+	"terraforming-mars-backend/test/testutil"
+)
+
+func TestBuildWSSchema_CoversMessageTypes(t *testing.T) {
+	doc := apidoc.BuildWSSchema()
+
+	testutil.AssertEqual(t, dto.ProtocolVersion, doc.ProtocolVersion, "Document should carry the protocol version")
+
+	documented := make(map[dto.MessageType]string)
+	for _, msg := range doc.Messages {
+		documented[msg.Type] = msg.Direction
+	}
+
+	expected := map[dto.MessageType]string{
+		dto.MessageTypeCreateGame:           apidoc.DirectionClientToServer,
+		dto.MessageTypePlayerConnect:        apidoc.DirectionClientToServer,
+		dto.MessageTypeActionPlayCard:       apidoc.DirectionClientToServer,
+		dto.MessageTypeActionTileSelected:   apidoc.DirectionClientToServer,
+		dto.MessageTypeActionSetSeatOrder:   apidoc.DirectionClientToServer,
+		dto.MessageTypeGameUpdated:          apidoc.DirectionServerToClient,
+		dto.MessageTypeLogUpdate:            apidoc.DirectionServerToClient,
+		dto.MessageTypeError:                apidoc.DirectionServerToClient,
+		dto.MessageTypePlayerKicked:         apidoc.DirectionServerToClient,
+		dto.MessageTypeActionClaimMilestone: apidoc.DirectionClientToServer,
+	}
+	for messageType, direction := range expected {
+		testutil.AssertEqual(t, direction, documented[messageType], "Direction for "+string(messageType))
+	}
+}
+
+func TestBuildWSSchema_ListsEveryMessageType(t *testing.T) {
+	documented := make(map[dto.MessageType]bool)
+	for _, msg := range apidoc.BuildWSSchema().Messages {
+		documented[msg.Type] = true
+	}
+
+	// Read the constants from source, so a new message type fails here until it is documented
+	file, err := parser.ParseFile(token.NewFileSet(), "../../internal/delivery/dto/message_types.go", nil, 0)
+	testutil.AssertNoError(t, err, "message_types.go should parse")
+	found := 0
+	for _, decl := range file.Decls {
+		gen, ok := decl.(*ast.GenDecl)
+		if !ok || gen.Tok != token.CONST {
+			continue
+		}
+		for _, spec := range gen.Specs {
+			value := spec.(*ast.ValueSpec)
+			if ident, ok := value.Type.(*ast.Ident); !ok || ident.Name != "MessageType" {
+				continue
+			}
+			for i, name := range value.Names {
+				literal, ok := value.Values[i].(*ast.BasicLit)
+				if !ok {
+					continue
+				}
+				messageType, err := strconv.Unquote(literal.Value)
+				testutil.AssertNoError(t, err, "Message type value should be a string: "+name.Name)
+				found++
+				testutil.AssertTrue(t, documented[dto.MessageType(messageType)], name.Name+" ("+messageType+") should be in /ws-schema")
+			}
+		}
+	}
+	testutil.AssertTrue(t, found > 0, "message_types.go should declare message types")
+}
+
+func TestBuildWSSchema_RefsResolve(t *testing.T) {
+	doc := apidoc.BuildWSSchema()
+
+	data, err := json.Marshal(doc)
+	testutil.AssertNoError(t, err, "Document should marshal to JSON")
+
+	refPattern := regexp.MustCompile(`"#/schemas/([A-Za-z0-9]+)"`)
+	for _, match := range refPattern.FindAllStringSubmatch(string(data), -1) {
+		_, found := doc.Schemas[match[1]]
+		testutil.AssertTrue(t, found, "Referenced schema should exist: "+match[1])
+	}
+}
@@ -320,3 +320,35 @@ func TestEventBusDifferentEventTypes(t *testing.T) {
 		t.Errorf("TR event not received correctly")
 	}
 }
+
+// TestEventBusOrderedSubscribersResolveInOrder tests that ordered handlers run after plain ones, sorted by key
+func TestEventBusOrderedSubscribersResolveInOrder(t *testing.T) {
+	bus := events.NewEventBus()
+
+	var calls []string
+	record := func(name string) events.EventHandler[events.TemperatureChangedEvent] {
+		return func(events.TemperatureChangedEvent) { calls = append(calls, name) }
+	}
+	order := func(key ...int) events.ResolutionOrder {
+		return func() []int { return key }
+	}
+
+	events.SubscribeOrdered(bus, order(1, 0), record("seat-1"))
+	events.SubscribeOrdered(bus, order(0, 2), record("seat-0-late"))
+	events.Subscribe(bus, record("plain-1"))
+	events.SubscribeOrdered(bus, order(0, -1), record("seat-0-corp"))
+	events.Subscribe(bus, record("plain-2"))
+
+	events.Publish(bus, events.TemperatureChangedEvent{GameID: "game-123"})
+
+	expected := []string{"plain-1", "plain-2", "seat-0-corp", "seat-0-late", "seat-1"}
+	if len(calls) != len(expected) {
+		t.Fatalf("Expected %d calls, got %v", len(expected), calls)
+	}
+	for i := range expected {
+		if calls[i] != expected[i] {
+			t.Errorf("Expected handler %d to be %s, got %v", i, expected[i], calls)
+			break
+		}
+	}
+}
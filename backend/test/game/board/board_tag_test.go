@@ -53,21 +53,30 @@ func TestGenerateMarsBoard_NoctisCityTaggedTile(t *testing.T) {
 func TestGenerateMarsBoard_UntaggedTilesHaveNoTags(t *testing.T) {
 	tiles := board.GenerateMarsBoard()
 
-	// Count tiles with tags and without tags
-	taggedCount := 0
+	// Count reserved areas, volcanic areas and tiles without tags
+	reservedCount := 0
+	volcanicCount := 0
 	untaggedCount := 0
 
 	for _, tile := range tiles {
-		if len(tile.Tags) > 0 {
-			taggedCount++
-		} else {
+		switch {
+		case tile.IsReservedArea():
+			reservedCount++
+		case len(tile.Tags) > 0:
+			volcanicCount++
+		default:
 			untaggedCount++
 		}
 	}
 
-	// Noctis City and Ganymede Colony should be tagged
-	if taggedCount != 2 {
-		t.Errorf("expected exactly 2 tagged tiles (Noctis City, Ganymede Colony), got %d", taggedCount)
+	// Noctis City and Ganymede Colony are the only reserved areas
+	if reservedCount != 2 {
+		t.Errorf("expected exactly 2 reserved tiles (Noctis City, Ganymede Colony), got %d", reservedCount)
+	}
+
+	// Tharsis Tholus, Ascraeus Mons, Pavonis Mons and Arsia Mons are volcanic
+	if volcanicCount != 4 {
+		t.Errorf("expected exactly 4 volcanic tiles, got %d", volcanicCount)
 	}
 
 	// All other tiles should have empty tags
@@ -0,0 +1,49 @@
+package board_test
+
+import (
+	"testing"
+
+	"terraforming-mars-backend/internal/game/board"
+	"terraforming-mars-backend/internal/game/shared"
+	"terraforming-mars-backend/test/testutil"
+)
+
+func TestHexLabel_RowsAndColumns(t *testing.T) {
+	testutil.AssertEqual(t, "E5", board.HexLabel(shared.HexPosition{Q: 0, R: 0, S: 0}), "Centre hex")
+	testutil.AssertEqual(t, "A1", board.HexLabel(shared.HexPosition{Q: 0, R: -4, S: 4}), "Top row starts at A1")
+	testutil.AssertEqual(t, "D6", board.HexLabel(shared.HexPosition{Q: 2, R: -1, S: -1}), "Columns count from the left edge of the row")
+	testutil.AssertEqual(t, "I5", board.HexLabel(shared.HexPosition{Q: 0, R: 4, S: -4}), "Bottom row ends at I5")
+
+	labels := make(map[string]bool)
+	for _, tile := range board.GenerateMarsBoard() {
+		label := board.HexLabel(tile.Coordinates)
+		testutil.AssertFalse(t, labels[label], "Label "+label+" is unique")
+		labels[label] = true
+
+		position, ok := board.ParseHexLabel(label)
+		testutil.AssertTrue(t, ok, "Label "+label+" parses")
+		testutil.AssertEqual(t, tile.Coordinates, position, "Label "+label+" round-trips")
+	}
+
+	_, ok := board.ParseHexLabel("A6")
+	testutil.AssertFalse(t, ok, "The top row has five hexes")
+	_, ok = board.ParseHexLabel("J1")
+	testutil.AssertFalse(t, ok, "There are nine rows")
+}
+
+func TestBoard_ResolveHex(t *testing.T) {
+	b := board.NewBoardWithTiles("game-1", board.GenerateMarsBoard(), nil)
+	noctis := shared.HexPosition{Q: -4, R: 2, S: 2}
+
+	for _, id := range []string{"-4,2,2", "G1", "g1", "Noctis City", "noctis city"} {
+		position, err := b.ResolveHex(id)
+		testutil.AssertNoError(t, err, "Resolves "+id)
+		testutil.AssertEqual(t, noctis, position, id+" is Noctis City")
+	}
+
+	_, err := b.ResolveHex("Olympus Mons")
+	testutil.AssertError(t, err, "Unknown names are rejected")
+
+	name := "Noctis City"
+	testutil.AssertEqual(t, "Noctis City (G1)", board.HexName(noctis, &name), "Named areas lead with their name")
+}
@@ -0,0 +1,61 @@
+package board_test
+
+import (
+	"context"
+	"testing"
+
+	"terraforming-mars-backend/internal/game/board"
+	"terraforming-mars-backend/internal/game/shared"
+	"terraforming-mars-backend/test/testutil"
+)
+
+func TestNeutralCitySpace_CountsInReadingOrder(t *testing.T) {
+	b := board.NewBoardWithTiles("game-1", board.GenerateMarsBoard(), nil)
+
+	first, ok := b.NeutralCitySpace(1, false)
+	testutil.AssertTrue(t, ok, "Empty board has city spaces")
+	testutil.AssertEqual(t, shared.HexPosition{Q: 0, R: -4, S: 4}, first, "Counting starts at the top left")
+
+	third, _ := b.NeutralCitySpace(3, false)
+	testutil.AssertEqual(t, shared.HexPosition{Q: 2, R: -4, S: 2}, third, "Counting runs left to right")
+
+	last, _ := b.NeutralCitySpace(1, true)
+	testutil.AssertEqual(t, shared.HexPosition{Q: 0, R: 4, S: -4}, last, "The second city counts from the bottom right")
+
+	eligible := 0
+	for _, tile := range b.Tiles() {
+		if tile.Type == shared.ResourceLandTile && !tile.IsReservedArea() {
+			eligible++
+		}
+	}
+	wrapped, _ := b.NeutralCitySpace(eligible+1, false)
+	testutil.AssertEqual(t, first, wrapped, "Counting wraps past the last space")
+}
+
+func TestNeutralCitySpace_SkipsSpacesNextToCities(t *testing.T) {
+	b := board.NewBoardWithTiles("game-1", board.GenerateMarsBoard(), nil)
+	city := shared.HexPosition{Q: 0, R: -4, S: 4}
+	testutil.AssertNoError(t, b.UpdateTileOccupancy(context.Background(), city,
+		board.TileOccupant{Type: shared.ResourceCityTile, Tags: []string{}}, ""), "Failed to place city")
+
+	tile, _ := b.GetTile(city)
+	testutil.AssertTrue(t, tile.OwnerID == nil, "Neutral tiles have no owner")
+
+	next, _ := b.NeutralCitySpace(1, false)
+	testutil.AssertEqual(t, shared.HexPosition{Q: 2, R: -4, S: 2}, next, "Occupied and adjacent spaces are skipped")
+}
+
+func TestNeutralGreenerySpace_CountsClockwiseFromUpperLeft(t *testing.T) {
+	b := board.NewBoardWithTiles("game-1", board.GenerateMarsBoard(), nil)
+	city := shared.HexPosition{Q: 0, R: 0, S: 0}
+
+	first, ok := b.NeutralGreenerySpace(city, 1)
+	testutil.AssertTrue(t, ok, "City has free neighbours")
+	testutil.AssertEqual(t, shared.HexPosition{Q: 0, R: -1, S: 1}, first, "Counting starts at the upper-left neighbour")
+
+	second, _ := b.NeutralGreenerySpace(city, 2)
+	testutil.AssertEqual(t, shared.HexPosition{Q: 1, R: -1, S: 0}, second, "Counting runs clockwise")
+
+	seventh, _ := b.NeutralGreenerySpace(city, 7)
+	testutil.AssertEqual(t, first, seventh, "Counting wraps around the city")
+}
@@ -0,0 +1,78 @@
+package board_test
+
+import (
+	"context"
+	"testing"
+
+	"terraforming-mars-backend/internal/game/board"
+	"terraforming-mars-backend/internal/game/shared"
+	"terraforming-mars-backend/test/testutil"
+)
+
+func TestAllowsTileType_OceanRule(t *testing.T) {
+	land := board.Tile{Type: shared.ResourceLandTile}
+	ocean := board.Tile{Type: shared.ResourceOceanSpace}
+
+	testutil.AssertTrue(t, ocean.AllowsTileType(board.TileTypeOcean, ""), "Oceans go on ocean spaces")
+	testutil.AssertFalse(t, land.AllowsTileType(board.TileTypeOcean, ""), "Oceans never go on land")
+	testutil.AssertTrue(t, land.AllowsTileType("city", ""), "Cities go on land")
+	testutil.AssertFalse(t, ocean.AllowsTileType("city", ""), "Cities never go on ocean spaces")
+	testutil.AssertFalse(t, ocean.AllowsTileType("greenery", ""), "Greenery never goes on ocean spaces")
+	testutil.AssertFalse(t, ocean.AllowsTileType("special", ""), "Special tiles never go on ocean spaces")
+}
+
+func TestAllowsTileType_CardExceptions(t *testing.T) {
+	land := board.Tile{Type: shared.ResourceLandTile}
+	ocean := board.Tile{Type: shared.ResourceOceanSpace}
+
+	testutil.AssertTrue(t, ocean.AllowsTileType("greenery", shared.OnTileTypeOcean), "Mangrove places greenery on an ocean space")
+	testutil.AssertFalse(t, land.AllowsTileType("greenery", shared.OnTileTypeOcean), "Mangrove only places on ocean spaces")
+	testutil.AssertTrue(t, land.AllowsTileType(board.TileTypeOcean, shared.OnTileTypeLand), "Artificial Lake places an ocean on land")
+	testutil.AssertFalse(t, ocean.AllowsTileType(board.TileTypeOcean, shared.OnTileTypeLand), "Artificial Lake only places on land")
+}
+
+func TestAvailableHexes_FollowOceanRule(t *testing.T) {
+	g, _ := testutil.CreateTestGameWithPlayers(t, 1, testutil.NewMockBroadcaster())
+
+	oceanSpaces, untaggedLand := 0, 0
+	for _, tile := range g.Board().Tiles() {
+		switch {
+		case tile.IsOceanSpace():
+			oceanSpaces++
+		case tile.Type == shared.ResourceLandTile && !tile.IsReservedArea():
+			untaggedLand++
+		}
+	}
+
+	testutil.AssertEqual(t, oceanSpaces, g.CountAvailableHexesForTile(board.TileTypeOcean, "player-1", nil),
+		"Oceans are offered every ocean space")
+	testutil.AssertEqual(t, oceanSpaces, g.CountAvailableHexesForTile("greenery", "player-1",
+		&shared.TileRestrictions{OnTileType: shared.OnTileTypeOcean}), "Mangrove is offered every ocean space")
+	testutil.AssertEqual(t, untaggedLand, g.CountAvailableHexesForTile(board.TileTypeOcean, "player-1",
+		&shared.TileRestrictions{OnTileType: shared.OnTileTypeLand}), "Artificial Lake is offered unreserved land")
+	testutil.AssertEqual(t, untaggedLand, g.CountAvailableHexesForTile("greenery", "player-1", nil),
+		"Greenery is offered land only")
+}
+
+func TestAvailableHexes_VolcanicAreas(t *testing.T) {
+	g, _ := testutil.CreateTestGameWithPlayers(t, 1, testutil.NewMockBroadcaster())
+	lavaFlows := &shared.TileRestrictions{BoardTags: []string{board.BoardTagVolcanic}}
+
+	testutil.AssertEqual(t, 4, g.CountAvailableHexesForTile(board.SpecialTileLavaFlows, "player-1", lavaFlows),
+		"Lava Flows is offered the four volcanic areas")
+
+	cities := g.CountAvailableHexesForTile("city", "player-1", nil)
+	greeneries := g.CountAvailableHexesForTile("greenery", "player-1", nil)
+	for _, label := range []string{"B2", "C1", "D2", "E2"} {
+		pos, ok := board.ParseHexLabel(label)
+		testutil.AssertTrue(t, ok, "Volcanic labels parse")
+		testutil.AssertNoError(t, g.Board().UpdateTileOccupancy(context.Background(), pos,
+			board.TileOccupant{Type: shared.ResourceSpecialTile, Tags: []string{}}, "player-1"), "Failed to occupy volcano")
+	}
+	testutil.AssertEqual(t, 0, g.CountAvailableHexesForTile(board.SpecialTileLavaFlows, "player-1", lavaFlows),
+		"Lava Flows has nowhere else to go once the volcanoes are taken")
+	testutil.AssertTrue(t, g.CountAvailableHexesForTile("city", "player-1", nil) < cities,
+		"Volcanic areas were open to cities")
+	testutil.AssertTrue(t, g.CountAvailableHexesForTile("greenery", "player-1", nil) < greeneries,
+		"Volcanic areas were open to greenery")
+}
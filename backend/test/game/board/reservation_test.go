@@ -0,0 +1,45 @@
+package board_test
+
+import (
+	"context"
+	"testing"
+
+	"terraforming-mars-backend/internal/game/board"
+	"terraforming-mars-backend/internal/game/shared"
+	"terraforming-mars-backend/test/testutil"
+)
+
+func TestReserveTile_OnlyReserverMayBuild(t *testing.T) {
+	ctx := context.Background()
+	b := board.NewBoardWithTiles("game-1", board.GenerateMarsBoard(), nil)
+	pos := shared.HexPosition{Q: 0, R: 0, S: 0}
+
+	testutil.AssertNoError(t, b.ReserveTile(ctx, pos, "player-1"), "Failed to reserve tile")
+	testutil.AssertError(t, b.ReserveTile(ctx, pos, "player-2"), "A reserved tile cannot be claimed again")
+
+	city := board.TileOccupant{Type: shared.ResourceCityTile, Tags: []string{}}
+	testutil.AssertError(t, b.UpdateTileOccupancy(ctx, pos, city, "player-2"), "Others cannot build on a claim")
+	testutil.AssertNoError(t, b.UpdateTileOccupancy(ctx, pos, city, "player-1"), "The reserver builds on their claim")
+
+	tile, _ := b.GetTile(pos)
+	testutil.AssertTrue(t, tile.ReservedBy == nil, "Building on a claim uses it up")
+}
+
+func TestReleasePlayerReservations(t *testing.T) {
+	ctx := context.Background()
+	b := board.NewBoardWithTiles("game-1", board.GenerateMarsBoard(), nil)
+	first := shared.HexPosition{Q: 0, R: 0, S: 0}
+	second := shared.HexPosition{Q: 1, R: 0, S: -1}
+	other := shared.HexPosition{Q: -1, R: 0, S: 1}
+
+	testutil.AssertNoError(t, b.ReserveTile(ctx, first, "player-1"), "Failed to reserve tile")
+	testutil.AssertNoError(t, b.ReserveTile(ctx, second, "player-1"), "Failed to reserve tile")
+	testutil.AssertNoError(t, b.ReserveTile(ctx, other, "player-2"), "Failed to reserve tile")
+
+	released, _ := b.ReleasePlayerReservations(ctx, "player-1")
+	testutil.AssertEqual(t, 2, released, "Every claim of the player is released")
+	tile, _ := b.GetTile(first)
+	testutil.AssertTrue(t, tile.ReservedBy == nil, "Released claim is cleared")
+	tile, _ = b.GetTile(other)
+	testutil.AssertTrue(t, tile.ReservedBy != nil, "Other players keep their claims")
+}
@@ -0,0 +1,52 @@
+package cards_test
+
+import (
+	"testing"
+
+	gamecards "terraforming-mars-backend/internal/game/cards"
+	"terraforming-mars-backend/internal/game/shared"
+	"terraforming-mars-backend/test/testutil"
+)
+
+func TestRankAward_PlacesFromInjectedCounts(t *testing.T) {
+	counts := []gamecards.AwardCounts{
+		{PlayerID: "a", Heat: 3, Tiles: 4},
+		{PlayerID: "b", Heat: 9, Tiles: 4},
+		{PlayerID: "c", Heat: 3, Tiles: 1},
+		{PlayerID: "d", Heat: 1, Tiles: 2},
+	}
+
+	thermalist := gamecards.RankAward(shared.AwardThermalist, counts)
+	testutil.AssertEqual(t, "b", thermalist[0].PlayerID, "Most heat leads")
+	testutil.AssertEqual(t, 1, thermalist[0].Placement, "Leader takes first")
+	testutil.AssertEqual(t, "a", thermalist[1].PlayerID, "Ties keep the order they were given in")
+	testutil.AssertEqual(t, 2, thermalist[1].Placement, "Tied runners-up share second")
+	testutil.AssertEqual(t, 2, thermalist[2].Placement, "Tied runners-up share second")
+	testutil.AssertEqual(t, 0, thermalist[3].Placement, "Third best places nowhere")
+
+	landlord := gamecards.RankAward(shared.AwardLandlord, counts)
+	testutil.AssertEqual(t, 1, landlord[0].Placement, "Tied leaders share first")
+	testutil.AssertEqual(t, 1, landlord[1].Placement, "Tied leaders share first")
+	testutil.AssertEqual(t, "d", landlord[2].PlayerID, "Next best after the tie")
+	testutil.AssertEqual(t, 2, landlord[2].Placement, "Second place is still awarded after a tie for first")
+
+	testutil.AssertEqual(t, 0, len(gamecards.RankAward(shared.AwardMiner, nil)), "No players, no placements")
+}
+
+func TestScoreAward_MatchesRankingCollectedCounts(t *testing.T) {
+	g, _ := testutil.CreateTestGameWithPlayers(t, 2, testutil.NewMockBroadcaster())
+	registry := testutil.CreateTestCardRegistry()
+	ctx := testutil.TestContext()
+	first, _ := g.GetPlayer("player-1")
+	second, _ := g.GetPlayer("player-2")
+	testutil.SetPlayerHeat(ctx, first, 2)
+	testutil.SetPlayerHeat(ctx, second, 6)
+
+	counts := gamecards.CollectAwardCounts(second, g.Board(), registry)
+	testutil.AssertEqual(t, 6, counts.Heat, "Counts are read from the player")
+	testutil.AssertEqual(t, 6, gamecards.CalculateAwardScore(shared.AwardThermalist, second, g.Board(), registry), "Score comes from the counts")
+
+	placements := gamecards.ScoreAward(shared.AwardThermalist, g.GetAllPlayers(), g.Board(), registry)
+	testutil.AssertEqual(t, second.ID(), placements[0].PlayerID, "Live scoring ranks the collected counts")
+	testutil.AssertEqual(t, 2, placements[1].Placement, "Runner-up takes second")
+}
@@ -0,0 +1,66 @@
+package cards_test
+
+import (
+	"strings"
+	"testing"
+
+	"terraforming-mars-backend/internal/cards"
+	gamecards "terraforming-mars-backend/internal/game/cards"
+	"terraforming-mars-backend/internal/game/shared"
+	"terraforming-mars-backend/test/testutil"
+)
+
+func TestExplainBehavior_RendersActionsAndEffects(t *testing.T) {
+	action := gamecards.ExplainBehavior(shared.CardBehavior{
+		Triggers: []shared.Trigger{{Type: shared.TriggerTypeManual}},
+		Inputs:   []shared.ResourceCondition{{ResourceType: shared.ResourceEnergy, Amount: 1, Target: "self-player"}},
+		Outputs:  []shared.ResourceCondition{{ResourceType: shared.ResourceTR, Amount: 1, Target: "self-player"}},
+	})
+	testutil.AssertEqual(t, gamecards.BehaviorKindAction, action.Kind, "Manual trigger is an action")
+	testutil.AssertEqual(t, "Action: spend 1 energy → gain 1 TR", action.Text, "Action text")
+
+	effect := gamecards.ExplainBehavior(shared.CardBehavior{
+		Triggers: []shared.Trigger{{Type: "auto", Condition: &shared.ResourceTriggerCondition{
+			Type:      "tag-played",
+			Selectors: []shared.Selector{{Tags: []shared.CardTag{shared.TagSpace}}},
+		}}},
+		Outputs: []shared.ResourceCondition{
+			{ResourceType: shared.ResourceCredit, Amount: 2, Target: "self-player"},
+			{ResourceType: shared.ResourceHeat, Amount: 2, Target: "self-player"},
+		},
+	})
+	testutil.AssertEqual(t, "when you play a space tag", effect.Condition, "Effect condition")
+	testutil.AssertEqual(t, "Effect: when you play a space tag, gain 2 M€ and 2 heat", effect.Text, "Gains with one verb are merged")
+
+	discount := gamecards.ExplainBehavior(shared.CardBehavior{
+		Triggers: []shared.Trigger{{Type: "auto"}},
+		Outputs: []shared.ResourceCondition{{
+			ResourceType: shared.ResourceDiscount, Amount: 2, Target: "self-player",
+			Selectors: []shared.Selector{{Tags: []shared.CardTag{shared.TagSpace}}},
+		}},
+	})
+	testutil.AssertEqual(t, "Effect: pay 2 M€ less for space cards", discount.Text, "Unconditional discounts are effects")
+
+	limited := gamecards.ExplainBehavior(shared.CardBehavior{
+		Triggers:   []shared.Trigger{{Type: shared.TriggerTypeManual}},
+		Outputs:    []shared.ResourceCondition{{ResourceType: shared.ResourceCardDraw, Amount: 1, Target: "self-player"}},
+		UsageLimit: &shared.UsageLimit{Per: shared.UsagePeriodGame, Times: 1},
+	})
+	testutil.AssertEqual(t, "Action: draw a card (once per game)", limited.Text, "Explicit limits are shown")
+}
+
+func TestExplainCard_EveryCardInTheDatabaseHasText(t *testing.T) {
+	allCards, err := cards.LoadCardsFromJSON("../../../assets/terraforming_mars_cards.json")
+	testutil.AssertNoError(t, err, "Card data should load")
+
+	for _, card := range allCards {
+		explanations := gamecards.ExplainCard(&card)
+		testutil.AssertEqual(t, len(card.Behaviors), len(explanations), card.ID+" explains each behavior")
+		for _, explanation := range explanations {
+			label := explanation.Kind.Label()
+			testutil.AssertTrue(t, label != "", card.ID+" has a known kind")
+			testutil.AssertTrue(t, len(explanation.Text) > len(label)+2, card.ID+" has rules text")
+			testutil.AssertFalse(t, strings.Contains(explanation.Text, "  "), card.ID+" has no gaps: "+explanation.Text)
+		}
+	}
+}
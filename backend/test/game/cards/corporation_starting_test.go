@@ -0,0 +1,59 @@
+package cards_test
+
+import (
+	"context"
+	"testing"
+
+	"terraforming-mars-backend/internal/cards"
+	gamecards "terraforming-mars-backend/internal/game/cards"
+	"terraforming-mars-backend/internal/game/shared"
+	"terraforming-mars-backend/test/testutil"
+)
+
+func TestStartingResources_ReadFromCorporationBehaviors(t *testing.T) {
+	allCards, err := cards.LoadCardsFromJSON("../../../assets/terraforming_mars_cards.json")
+	if err != nil {
+		t.Fatalf("Failed to load cards: %v", err)
+	}
+	registry := cards.NewInMemoryCardRegistry(allCards)
+
+	ecoline, _ := registry.GetByID("B02")
+	resources, production := gamecards.StartingResources(ecoline)
+	testutil.AssertEqual(t, shared.ResourceSet{Credits: 36, Plants: 3}, *resources, "Ecoline starts with 36 M€ and 3 plants")
+	testutil.AssertEqual(t, shared.ResourceSet{Plants: 2}, *production, "Ecoline starts with 2 plant production")
+
+	helion, _ := registry.GetByID("B03")
+	resources, production = gamecards.StartingResources(helion)
+	testutil.AssertEqual(t, 42, resources.Credits, "Helion starts with 42 M€")
+	testutil.AssertEqual(t, shared.ResourceSet{Heat: 3}, *production, "Helion starts with 3 heat production")
+
+	for _, card := range allCards {
+		if card.Type != gamecards.CardTypeCorporation {
+			continue
+		}
+		resources, _ := gamecards.StartingResources(&card)
+		if resources == nil || resources.Credits <= 0 {
+			t.Errorf("Corporation %s (%s) grants no starting M€", card.ID, card.Name)
+		}
+	}
+}
+
+func TestApplyStartingEffects_MatchesStartingResources(t *testing.T) {
+	allCards, err := cards.LoadCardsFromJSON("../../../assets/terraforming_mars_cards.json")
+	if err != nil {
+		t.Fatalf("Failed to load cards: %v", err)
+	}
+	registry := cards.NewInMemoryCardRegistry(allCards)
+	g, _ := testutil.CreateTestGameWithPlayers(t, 1, testutil.NewMockBroadcaster())
+	p := g.GetAllPlayers()[0]
+	testutil.SetPlayerCredits(context.Background(), p, 0)
+
+	ecoline, _ := registry.GetByID("B02")
+	processor := gamecards.NewCorporationProcessor(registry, testutil.TestLogger())
+	testutil.AssertNoError(t, processor.ApplyStartingEffects(context.Background(), ecoline, p, g), "Failed to apply starting effects")
+
+	resources, production := gamecards.StartingResources(ecoline)
+	testutil.AssertEqual(t, resources.Credits, p.Resources().Get().Credits, "Starting M€ are applied")
+	testutil.AssertEqual(t, resources.Plants, p.Resources().Get().Plants, "Starting plants are applied")
+	testutil.AssertEqual(t, production.Plants, p.Resources().Production().Plants, "Starting plant production is applied")
+}
@@ -0,0 +1,58 @@
+package cards_test
+
+import (
+	"context"
+	"testing"
+
+	"terraforming-mars-backend/internal/game"
+	gamecards "terraforming-mars-backend/internal/game/cards"
+	"terraforming-mars-backend/internal/game/player"
+	"terraforming-mars-backend/internal/game/shared"
+	"terraforming-mars-backend/test/testutil"
+)
+
+func TestCalculatePlayerVP_UsesTheGamesScoringVP(t *testing.T) {
+	g, _ := testutil.CreateTestGameWithPlayers(t, 2, testutil.NewMockBroadcaster())
+	registry := testutil.CreateTestCardRegistry()
+	ctx := context.Background()
+	first, _ := g.GetPlayer("player-1")
+	second, _ := g.GetPlayer("player-2")
+	testutil.SetPlayerHeat(ctx, first, 5)
+	testutil.SetPlayerHeat(ctx, second, 2)
+
+	claimed := []gamecards.ClaimedMilestoneInfo{{Type: string(shared.MilestoneTerraformer), PlayerID: first.ID()}}
+	funded := []gamecards.FundedAwardInfo{{Type: string(shared.AwardThermalist)}}
+	score := func(p *player.Player, options game.RulesOptions) gamecards.VPBreakdown {
+		return gamecards.CalculatePlayerVP(p, g.Board(), claimed, funded, g.GetAllPlayers(), registry, options.ScoringVP())
+	}
+
+	standard := game.RulesOptions{}
+	testutil.AssertEqual(t, 5, score(first, standard).MilestoneVP, "A milestone is worth 5 VP by default")
+	testutil.AssertEqual(t, 5, score(first, standard).AwardVP, "First place is worth 5 VP by default")
+	testutil.AssertEqual(t, 2, score(second, standard).AwardVP, "Second place is worth 2 VP by default")
+
+	house := game.RulesOptions{MilestoneVP: intPtr(3), AwardFirstPlaceVP: intPtr(7), AwardSecondPlaceVP: intPtr(4)}
+	testutil.AssertEqual(t, 3, score(first, house).MilestoneVP, "House rule milestone VP")
+	testutil.AssertEqual(t, 7, score(first, house).AwardVP, "House rule first place VP")
+	testutil.AssertEqual(t, 4, score(second, house).AwardVP, "House rule second place VP")
+}
+
+func TestRulesOptions_ScoringVPBounds(t *testing.T) {
+	testutil.AssertNoError(t, game.RulesOptions{MilestoneVP: intPtr(game.MaxScoringVP)}.WithDefaults().Validate(), "The cap is allowed")
+	testutil.AssertError(t, game.RulesOptions{MilestoneVP: intPtr(game.MaxScoringVP + 1)}.WithDefaults().Validate(), "Milestone VP above the cap")
+	testutil.AssertError(t, game.RulesOptions{AwardFirstPlaceVP: intPtr(-1)}.WithDefaults().Validate(), "Negative award VP")
+	testutil.AssertError(t, game.RulesOptions{AwardFirstPlaceVP: intPtr(3), AwardSecondPlaceVP: intPtr(4)}.WithDefaults().Validate(),
+		"Second place cannot be worth more than first")
+	lowered := game.RulesOptions{AwardFirstPlaceVP: intPtr(1)}.WithDefaults()
+	testutil.AssertNoError(t, lowered.Validate(), "Lowering first place lowers the default second place with it")
+	testutil.AssertEqual(t, 1, *lowered.AwardSecondPlaceVP, "Second place defaults to at most first place's VP")
+
+	zero := game.RulesOptions{MilestoneVP: intPtr(0), AwardSecondPlaceVP: intPtr(0)}
+	testutil.AssertNoError(t, zero.Validate(), "0 VP is a valid house rule")
+	testutil.AssertEqual(t, 0, zero.ScoringVP().Milestone, "0 milestone VP is kept, not replaced by the default")
+	testutil.AssertEqual(t, 0, zero.ScoringVP().AwardSecondPlace, "0 second place VP is kept, not replaced by the default")
+}
+
+func intPtr(v int) *int {
+	return &v
+}
@@ -0,0 +1,64 @@
+package cards_test
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"terraforming-mars-backend/internal/cards"
+	gamecards "terraforming-mars-backend/internal/game/cards"
+	"terraforming-mars-backend/internal/game/shared"
+	"terraforming-mars-backend/test/testutil"
+)
+
+func TestTurmoilOutputs_ResolveAsNotedNoOps(t *testing.T) {
+	g, _ := testutil.CreateTestGameWithPlayers(t, 1, testutil.NewMockBroadcaster())
+	p := g.GetAllPlayers()[0]
+	ctx := context.Background()
+	testutil.SetPlayerCredits(ctx, p, 0)
+
+	outputs := []shared.ResourceCondition{
+		{ResourceType: shared.ResourceInfluence, Amount: 1, Target: "self-player"},
+		{ResourceType: shared.ResourceCredit, Amount: 3, Target: "self-player"},
+	}
+
+	applier := gamecards.NewBehaviorApplier(p, g, "Party Card", testutil.TestLogger())
+	err := applier.ApplyOutputs(ctx, outputs)
+	testutil.AssertNoError(t, err, "Turmoil outputs should not fail the card")
+	testutil.AssertEqual(t, 3, testutil.GetPlayerCredits(p), "The rest of the card still applies")
+	testutil.AssertEqual(t, 1, len(applier.Notes()), "The skipped influence should be noted for the game log")
+}
+
+func TestTurmoilDependentCards_ListsCardsUsingInfluenceOrDelegates(t *testing.T) {
+	cardList := []gamecards.Card{
+		{ID: "plain", Behaviors: []shared.CardBehavior{{
+			Outputs: []shared.ResourceCondition{{ResourceType: shared.ResourceCredit, Amount: 1, Target: "self-player"}},
+		}}},
+		{ID: "delegate-choice", Behaviors: []shared.CardBehavior{{
+			Choices: []shared.Choice{{
+				Outputs: []shared.ResourceCondition{{ResourceType: shared.ResourceDelegate, Amount: 2, Target: "self-player"}},
+			}},
+		}}},
+	}
+
+	ids := cards.TurmoilDependentCards(cardList)
+	testutil.AssertEqual(t, 1, len(ids), "Only the delegate card depends on Turmoil")
+	testutil.AssertEqual(t, "delegate-choice", ids[0], "The delegate card should be listed")
+}
+
+func TestTurmoilDependentCards_FindsTheRealTurmoilCards(t *testing.T) {
+	allCards, err := cards.LoadCardsFromJSON("../../../assets/terraforming_mars_cards.json")
+	testutil.AssertNoError(t, err, "Card file should load")
+
+	ids := cards.TurmoilDependentCards(allCards)
+	for _, id := range []string{"T01", "T05", "T07", "T11", "T16", "X09"} {
+		testutil.AssertTrue(t, slices.Contains(ids, id), "Turmoil card "+id+" should be listed")
+	}
+	for _, id := range []string{"X01", "X10", "TC3"} {
+		testutil.AssertFalse(t, slices.Contains(ids, id), "Turmoil pack card "+id+" plays fully without Turmoil")
+	}
+
+	requirement, ok := cards.TurmoilRequirement("T13")
+	testutil.AssertTrue(t, ok, "Sponsored Mohole's party requirement should be known")
+	testutil.AssertEqual(t, "Kelvinists ruling or 2 delegates there", requirement, "Requirement text")
+}
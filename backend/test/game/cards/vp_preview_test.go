@@ -0,0 +1,67 @@
+package cards_test
+
+import (
+	"context"
+	"testing"
+
+	"terraforming-mars-backend/internal/cards"
+	"terraforming-mars-backend/internal/game/board"
+	gamecards "terraforming-mars-backend/internal/game/cards"
+	"terraforming-mars-backend/internal/game/shared"
+	"terraforming-mars-backend/test/testutil"
+)
+
+func TestPreviewCardVP_TracksStorageAndTiles(t *testing.T) {
+	g, _ := testutil.CreateTestGameWithPlayers(t, 2, testutil.NewMockBroadcaster())
+	p := g.GetAllPlayers()[0]
+	ctx := context.Background()
+
+	selfCard := gamecards.TargetSelfCard
+	registry := cards.NewInMemoryCardRegistry([]gamecards.Card{
+		{
+			ID:   "card-herd",
+			Name: "Herd",
+			Type: gamecards.CardTypeActive,
+			Pack: "base",
+			VPConditions: []gamecards.VictoryPointCondition{{
+				Amount:    1,
+				Condition: gamecards.VPConditionPer,
+				Per:       &gamecards.PerCondition{Type: shared.ResourceAnimal, Amount: 2, Target: &selfCard},
+			}},
+		},
+		{
+			ID:   "card-city-planner",
+			Name: "City Planner",
+			Type: gamecards.CardTypeAutomated,
+			Pack: "base",
+			VPConditions: []gamecards.VictoryPointCondition{{
+				Amount:    1,
+				Condition: gamecards.VPConditionPer,
+				Per:       &gamecards.PerCondition{Type: shared.ResourceCityTile, Amount: 1},
+			}},
+		},
+	})
+
+	p.PlayedCards().AddCard("card-herd", "Herd", string(gamecards.CardTypeActive), nil)
+	p.PlayedCards().AddCard("card-city-planner", "City Planner", string(gamecards.CardTypeAutomated), nil)
+
+	cardVP := gamecards.PreviewCardVP(p, g.Board(), registry)
+	testutil.AssertEqual(t, 0, cardVP["card-herd"], "No animals yet")
+	testutil.AssertEqual(t, 0, cardVP["card-city-planner"], "No cities yet")
+
+	p.Resources().AddToStorage("card-herd", 3)
+	cardVP = gamecards.PreviewCardVP(p, g.Board(), registry)
+	testutil.AssertEqual(t, 1, cardVP["card-herd"], "Storage changes refresh the preview")
+
+	var city shared.HexPosition
+	for _, tile := range g.Board().Tiles() {
+		if tile.Location == board.TileLocationMars && tile.OccupiedBy == nil {
+			city = tile.Coordinates
+			break
+		}
+	}
+	testutil.AssertNoError(t, g.Board().UpdateTileOccupancy(ctx, city,
+		board.TileOccupant{Type: shared.ResourceCityTile, Tags: []string{}}, "player-2"), "Failed to place city")
+	cardVP = gamecards.PreviewCardVP(p, g.Board(), registry)
+	testutil.AssertEqual(t, 1, cardVP["card-city-planner"], "Another player's tile refreshes the preview")
+}
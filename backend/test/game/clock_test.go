@@ -0,0 +1,53 @@
+package game_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"terraforming-mars-backend/internal/game"
+	"terraforming-mars-backend/test/testutil"
+)
+
+func TestTurnStartedAt_FollowsTheTurn(t *testing.T) {
+	testGame, _ := testutil.CreateTestGameWithPlayers(t, 2, testutil.NewMockBroadcaster())
+	ctx := context.Background()
+
+	_, ok := testGame.TurnStartedAt()
+	testutil.AssertFalse(t, ok, "Lobby has no turn clock")
+
+	before := time.Now()
+	testutil.AssertNoError(t, testGame.SetCurrentTurn(ctx, "player-1", 2), "Failed to set turn")
+	first, ok := testGame.TurnStartedAt()
+	testutil.AssertTrue(t, ok, "Turn clock starts with the turn")
+	testutil.AssertFalse(t, first.Before(before), "Turn clock starts now")
+
+	time.Sleep(2 * time.Millisecond)
+	testutil.AssertNoError(t, testGame.SetCurrentTurn(ctx, "player-2", 2), "Failed to set turn")
+	second, _ := testGame.TurnStartedAt()
+	testutil.AssertTrue(t, second.After(first), "Next turn restarts the clock")
+
+	testutil.AssertNoError(t, testGame.Pause(ctx, "player-1"), "Failed to pause")
+	time.Sleep(2 * time.Millisecond)
+	testutil.AssertNoError(t, testGame.Resume(ctx), "Failed to resume")
+	resumed, _ := testGame.TurnStartedAt()
+	testutil.AssertTrue(t, resumed.After(second), "Time spent paused does not count against the turn")
+}
+
+func TestTurnStartedAt_RolledBackWithTheTurn(t *testing.T) {
+	testGame, _ := testutil.CreateTestGameWithPlayers(t, 2, testutil.NewMockBroadcaster())
+	ctx := context.Background()
+
+	testutil.AssertNoError(t, testGame.SetCurrentTurn(ctx, "player-1", 2), "Failed to set turn")
+	started, _ := testGame.TurnStartedAt()
+
+	tx := testGame.BeginTransaction()
+	time.Sleep(2 * time.Millisecond)
+	testutil.AssertNoError(t, testGame.SetCurrentTurn(ctx, "player-2", 2), "Failed to set turn")
+	testutil.AssertNoError(t, testGame.UpdatePhase(ctx, game.GamePhaseProductionAndCardDraw), "Failed to set phase")
+	tx.Rollback()
+
+	restored, _ := testGame.TurnStartedAt()
+	testutil.AssertTrue(t, restored.Equal(started), "Rollback restores the turn clock")
+	testutil.AssertEqual(t, game.GamePhaseWaitingForGameStart, testGame.CurrentPhase(), "Rollback restores the phase")
+}
@@ -0,0 +1,128 @@
+package deck_test
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"testing"
+
+	"terraforming-mars-backend/internal/game/deck"
+	"terraforming-mars-backend/test/testutil"
+)
+
+func TestRevealUntil_TakesMatchesInDeckOrderAndDiscardsTheRest(t *testing.T) {
+	ctx := context.Background()
+	d := deck.NewDeck("game-1", []string{"plant-1", "space-1", "plant-2", "space-2", "plant-3", "space-3"}, nil, nil)
+	isSpace := func(cardID string) bool { return strings.HasPrefix(cardID, "space-") }
+
+	taken, discarded, err := d.RevealUntil(ctx, 2, isSpace)
+	testutil.AssertNoError(t, err, "Reveal should succeed")
+	testutil.AssertTrue(t, slices.Equal([]string{"space-1", "space-2"}, taken), "The first two matches are taken")
+	testutil.AssertTrue(t, slices.Equal([]string{"plant-1", "plant-2"}, discarded), "Cards revealed on the way are discarded")
+	testutil.AssertTrue(t, slices.Equal([]string{"plant-3", "space-3"}, d.ProjectCards()), "Cards after the last match stay in order")
+	testutil.AssertTrue(t, slices.Equal([]string{"plant-1", "plant-2"}, d.DiscardPile()), "Discards land on the discard pile")
+	testutil.AssertEqual(t, 2, d.DrawnCardCount(), "Only taken cards count as drawn")
+}
+
+func TestRevealUntil_ShufflesTheDiscardPileInOnceThenStops(t *testing.T) {
+	ctx := context.Background()
+	d := deck.NewDeck("game-1", []string{"plant-1"}, nil, nil)
+	testutil.AssertNoError(t, d.Discard(ctx, []string{"space-1", "plant-2"}), "Discard should succeed")
+	isSpace := func(cardID string) bool { return strings.HasPrefix(cardID, "space-") }
+
+	taken, discarded, err := d.RevealUntil(ctx, 2, isSpace)
+	testutil.AssertNoError(t, err, "Reveal should succeed")
+	testutil.AssertTrue(t, slices.Equal([]string{"space-1"}, taken), "The match in the old discard pile is found")
+	testutil.AssertEqual(t, 2, len(discarded), "Both plants are revealed and discarded")
+	testutil.AssertEqual(t, 0, d.GetAvailableCardCount(), "The deck is exhausted")
+	testutil.AssertEqual(t, 2, len(d.DiscardPile()), "Revealed cards are not shuffled back in")
+	testutil.AssertEqual(t, 1, d.Shortfall(), "The missing match is a shortfall")
+	testutil.AssertEqual(t, 1, d.DrawnCardCount(), "Only the taken card counts as drawn")
+}
+
+func TestSeededDeck_CommitmentVerifiesAgainstTheRevealedSeed(t *testing.T) {
+	var seed deck.Seed
+	seed[0] = 7
+	projects := []string{"p-1", "p-2", "p-3", "p-4", "p-5", "p-6"}
+	corps := []string{"c-1", "c-2", "c-3"}
+	preludes := []string{"pre-1", "pre-2"}
+
+	d := deck.NewSeededDeck("game-1", projects, corps, preludes, seed)
+	revealedSeed, order, ok := d.Reveal()
+	testutil.AssertTrue(t, ok, "Seeded decks reveal their seed")
+	testutil.AssertEqual(t, seed, revealedSeed, "The revealed seed is the one the deck was built from")
+	testutil.AssertTrue(t, slices.Equal(order.ProjectCards, d.ProjectCards()), "The starting order is the draw order")
+	testutil.AssertNoError(t, deck.VerifyShuffle(revealedSeed, order, d.Commitment()), "The commitment verifies")
+
+	reversed := slices.Clone(projects)
+	slices.Reverse(reversed)
+	same := deck.NewSeededDeck("game-2", reversed, corps, preludes, seed)
+	testutil.AssertEqual(t, d.Commitment(), same.Commitment(), "The order depends only on the seed and the card pool")
+
+	stacked := order
+	stacked.ProjectCards = slices.Clone(order.ProjectCards)
+	stacked.ProjectCards[0], stacked.ProjectCards[1] = stacked.ProjectCards[1], stacked.ProjectCards[0]
+	testutil.AssertError(t, deck.VerifyShuffle(seed, stacked, d.Commitment()), "A stacked order does not follow from the seed")
+
+	otherSeed := seed
+	otherSeed[0] = 8
+	testutil.AssertError(t, deck.VerifyShuffle(otherSeed, order, d.Commitment()), "Another seed does not verify")
+
+	parsed, err := deck.ParseSeed(seed.String())
+	testutil.AssertNoError(t, err, "Hex seeds parse")
+	testutil.AssertEqual(t, seed, parsed, "Seeds round-trip through hex")
+}
+
+func TestUnseededDeck_HasNoCommitment(t *testing.T) {
+	d := deck.NewDeck("game-1", []string{"p-1", "p-2"}, nil, nil)
+	_, _, ok := d.Reveal()
+	testutil.AssertFalse(t, ok, "Decks kept in the given order have no seed to reveal")
+	testutil.AssertEqual(t, "", d.Commitment(), "Nor a commitment")
+	testutil.AssertTrue(t, slices.Equal([]string{"p-1", "p-2"}, d.ProjectCards()), "The given order is kept")
+}
+
+func TestRestore_RewindsTheShuffleStream(t *testing.T) {
+	ctx := context.Background()
+	var seed deck.Seed
+	projects := make([]string, 30)
+	for i := range projects {
+		projects[i] = fmt.Sprintf("p-%02d", i)
+	}
+	d := deck.NewSeededDeck("game-1", projects, nil, nil, seed)
+
+	checkpoint := d.Checkpoint()
+	testutil.AssertNoError(t, d.Discard(ctx, projects[:20]), "Discard should succeed")
+	testutil.AssertNoError(t, d.Shuffle(ctx), "Reshuffle should succeed")
+	first := d.ProjectCards()
+
+	d.Restore(checkpoint)
+	testutil.AssertNoError(t, d.Discard(ctx, projects[:20]), "Discard should succeed")
+	testutil.AssertNoError(t, d.Shuffle(ctx), "Reshuffle should succeed")
+	testutil.AssertTrue(t, slices.Equal(first, d.ProjectCards()), "A rolled-back shuffle replays the same way")
+}
+
+func TestDrawProjectCards_ReshufflesThenDrawsWhatIsLeft(t *testing.T) {
+	ctx := context.Background()
+	d := deck.NewDeck("game-1", []string{"p-1", "p-2", "p-3"}, nil, nil)
+
+	_, err := d.DrawProjectCards(ctx, 2)
+	testutil.AssertNoError(t, err, "Draw should succeed")
+	testutil.AssertNoError(t, d.Discard(ctx, []string{"p-1", "p-2"}), "Discard should succeed")
+
+	drawn, err := d.DrawProjectCards(ctx, 3)
+	testutil.AssertNoError(t, err, "A draw the discard pile can cover should succeed")
+	testutil.AssertEqual(t, 3, len(drawn), "Discards are shuffled back in")
+	testutil.AssertEqual(t, 1, d.ShuffleCount(), "The reshuffle counts as a deck cycle")
+	testutil.AssertEqual(t, 0, len(d.DiscardPile()), "The discard pile is emptied into the deck")
+
+	checkpoint := d.Checkpoint()
+	drawn, err = d.DrawProjectCards(ctx, 2)
+	testutil.AssertNoError(t, err, "An exhausted deck should not error")
+	testutil.AssertEqual(t, 0, len(drawn), "Nothing is left to draw")
+	testutil.AssertEqual(t, 2, d.Shortfall(), "The missing cards are counted")
+	testutil.AssertEqual(t, 5, d.DrawnCardCount(), "Only dealt cards count as drawn")
+
+	d.Restore(checkpoint)
+	testutil.AssertEqual(t, 0, d.Shortfall(), "Restore rewinds the shortfall")
+}
@@ -0,0 +1,63 @@
+package game_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"terraforming-mars-backend/internal/delivery/dto"
+	"terraforming-mars-backend/internal/game"
+	"terraforming-mars-backend/test/testutil"
+)
+
+func TestSummarizeLogEntry_TilesAndGains(t *testing.T) {
+	changes := &game.GameChanges{
+		PlayerChanges: map[string]*game.PlayerChanges{
+			"player-1": {
+				Credits: &game.DiffValueInt{Old: 30, New: 5},
+				Steel:   &game.DiffValueInt{Old: 0, New: 2},
+			},
+		},
+		BoardChanges: &game.BoardChanges{
+			TilesPlaced: []game.TilePlacement{{HexID: "2,-1,-1", HexName: "D6", TileType: "city", OwnerID: "player-1"}},
+		},
+	}
+
+	summary := game.SummarizeLogEntry(game.SourceTypeStandardProject, "Standard Project: City", "player-1",
+		"Built city", changes, map[string]string{"player-1": "Emil"})
+
+	testutil.AssertEqual(t, "Emil built city, placed a City at D6 and gained 2 steel", summary.Text, "Summary text")
+	testutil.AssertEqual(t, "log.standard-project", summary.Key, "Localization key follows the source type")
+	testutil.AssertEqual(t, "Emil", summary.Params["player"], "Player param")
+	testutil.AssertEqual(t, "a City at D6", summary.Params["tiles"], "Tiles param")
+	testutil.AssertEqual(t, "2 steel", summary.Params["gains"], "Spent credits are not gains")
+}
+
+func TestSummarizeLogEntry_GameEvent(t *testing.T) {
+	summary := game.SummarizeLogEntry(game.SourceTypeGameEvent, "Production Phase", "",
+		"Research time ran out; Bob bought no cards", nil, nil)
+
+	testutil.AssertEqual(t, "Research time ran out; Bob bought no cards", summary.Text, "Game events use the description")
+	testutil.AssertEqual(t, "log.game-event", summary.Key, "Localization key")
+	_, hasPlayer := summary.Params["player"]
+	testutil.AssertFalse(t, hasPlayer, "No player param without a player")
+}
+
+func TestStateRepository_RecentActionsWindow(t *testing.T) {
+	testGame, _ := testutil.CreateTestGameWithPlayers(t, 2, testutil.NewMockBroadcaster())
+	ctx := context.Background()
+	stateRepo := game.NewInMemoryGameStateRepository()
+
+	for i := 0; i < dto.MaxRecentActions+5; i++ {
+		_, err := stateRepo.WriteFull(ctx, testGame.ID(), testGame, "Test", game.SourceTypeCardAction, "player-2",
+			fmt.Sprintf("Used action %d", i), nil, nil, nil)
+		testutil.AssertNoError(t, err, "Write should succeed")
+	}
+
+	diffs, err := stateRepo.GetDiff(ctx, testGame.ID())
+	testutil.AssertNoError(t, err, "GetDiff should succeed")
+	recent := dto.ToRecentActionDtos(diffs)
+	testutil.AssertEqual(t, dto.MaxRecentActions, len(recent), "Window holds the latest entries only")
+	testutil.AssertEqual(t, int64(6), recent[0].SequenceNumber, "Oldest entries fall out first")
+	testutil.AssertEqual(t, "Player B used action 24", recent[len(recent)-1].Summary.Text, "Summary names the player")
+}
@@ -0,0 +1,78 @@
+package game_test
+
+import (
+	"context"
+	"testing"
+
+	"terraforming-mars-backend/internal/game/parameters"
+	"terraforming-mars-backend/test/testutil"
+)
+
+func TestParameterDefinition_ValidateKeepsValuesOnTheTrack(t *testing.T) {
+	testutil.AssertNoError(t, parameters.Temperature.Validate(-30), "Minimum temperature is valid")
+	testutil.AssertNoError(t, parameters.Temperature.Validate(8), "Maximum temperature is valid")
+	testutil.AssertError(t, parameters.Temperature.Validate(999), "Temperature above the maximum is rejected")
+	testutil.AssertError(t, parameters.Temperature.Validate(-32), "Temperature below the minimum is rejected")
+	testutil.AssertError(t, parameters.Temperature.Validate(-29), "Temperature between steps is rejected")
+	testutil.AssertError(t, parameters.Oxygen.Validate(15), "Oxygen above 14% is rejected")
+	testutil.AssertError(t, parameters.Venus.Validate(31), "Venus above 30% is rejected")
+	testutil.AssertEqual(t, 19, parameters.Temperature.Steps(), "Temperature has 19 steps")
+	testutil.AssertEqual(t, 15, parameters.Venus.Steps(), "Venus has 15 steps")
+}
+
+func TestParameterDefinition_RaiseStopsAtMaximum(t *testing.T) {
+	value, steps := parameters.Temperature.Raise(4, 3)
+	testutil.AssertEqual(t, 8, value, "Temperature stops at +8°C")
+	testutil.AssertEqual(t, 2, steps, "Only the steps left are raised")
+
+	value, steps = parameters.Oxygen.Raise(14, 1)
+	testutil.AssertEqual(t, 14, value, "Maxed oxygen does not move")
+	testutil.AssertEqual(t, 0, steps, "No steps are raised past the maximum")
+
+	testutil.AssertEqual(t, 3, parameters.Temperature.RemainingSteps(2), "Three steps from +2°C to +8°C")
+}
+
+func TestParameterDefinition_BonusesReachedBetweenValues(t *testing.T) {
+	testutil.AssertEqual(t, 0, len(parameters.Temperature.BonusesReached(-30, -26)), "No bonus below -24°C")
+	testutil.AssertEqual(t, 2, len(parameters.Temperature.BonusesReached(-26, -20)), "A raise past two thresholds reaches both")
+	testutil.AssertEqual(t, 0, len(parameters.Temperature.BonusesReached(-24, -22)), "A threshold already reached is not granted again")
+	testutil.AssertEqual(t, parameters.BonusTemperature, parameters.Oxygen.BonusesReached(7, 8)[0].Reward, "8% oxygen raises the temperature")
+}
+
+func TestRaiseTemperature_GrantsTrackBonuses(t *testing.T) {
+	g, _ := testutil.CreateTestGameWithPlayers(t, 1, testutil.NewMockBroadcaster())
+	p := g.GetAllPlayers()[0]
+	ctx := context.Background()
+
+	testutil.AssertNoError(t, g.GlobalParameters().SetTemperature(ctx, -26), "Temperature should be set")
+	steps, err := g.RaiseTemperature(ctx, p.ID(), 1)
+	testutil.AssertNoError(t, err, "Raise should succeed")
+	testutil.AssertEqual(t, 1, steps, "One step is raised")
+	testutil.AssertEqual(t, 1, p.Resources().Production().Heat, "Reaching -24°C gives heat production")
+
+	testutil.AssertNoError(t, g.GlobalParameters().SetTemperature(ctx, -2), "Temperature should be set")
+	_, err = g.RaiseTemperature(ctx, p.ID(), 1)
+	testutil.AssertNoError(t, err, "Raise should succeed")
+	pending := g.GetPendingTileSelection(p.ID())
+	testutil.AssertTrue(t, pending != nil && pending.TileType == "ocean", "Reaching 0°C places an ocean")
+
+	_, err = g.RaiseTemperature(ctx, "", 1)
+	testutil.AssertNoError(t, err, "A raise with no player still moves the track")
+	testutil.AssertEqual(t, 2, g.GlobalParameters().Temperature(), "Temperature moved")
+}
+
+func TestRaiseOxygen_BonusRaisesTemperatureWithTR(t *testing.T) {
+	g, _ := testutil.CreateTestGameWithPlayers(t, 1, testutil.NewMockBroadcaster())
+	p := g.GetAllPlayers()[0]
+	ctx := context.Background()
+	trBefore := p.Resources().TerraformRating()
+
+	testutil.AssertNoError(t, g.GlobalParameters().SetOxygen(ctx, 7), "Oxygen should be set")
+	testutil.AssertNoError(t, g.GlobalParameters().SetTemperature(ctx, -26), "Temperature should be set")
+	steps, err := g.RaiseOxygen(ctx, p.ID(), 1)
+	testutil.AssertNoError(t, err, "Raise should succeed")
+	testutil.AssertEqual(t, 1, steps, "Oxygen raised one step")
+	testutil.AssertEqual(t, -24, g.GlobalParameters().Temperature(), "8% oxygen raises the temperature")
+	testutil.AssertEqual(t, trBefore+1, p.Resources().TerraformRating(), "The bonus temperature step gives TR")
+	testutil.AssertEqual(t, 1, p.Resources().Production().Heat, "The bonus step grants its own temperature bonus")
+}
@@ -0,0 +1,28 @@
+package player_test
+
+import (
+	"fmt"
+	"testing"
+
+	gamecards "terraforming-mars-backend/internal/game/cards"
+	"terraforming-mars-backend/internal/game/shared"
+	"terraforming-mars-backend/test/testutil"
+)
+
+func TestPlannerMilestone_CountsCardsInHand(t *testing.T) {
+	testGame, _ := testutil.CreateTestGameWithPlayers(t, 1, testutil.NewMockBroadcaster())
+	p := testGame.GetAllPlayers()[0]
+	registry := testutil.CreateTestCardRegistry()
+
+	for i := 0; i < 15; i++ {
+		p.Hand().AddCard(fmt.Sprintf("card-%d", i))
+	}
+	testutil.AssertFalse(t, gamecards.CanClaimMilestone(shared.MilestonePlanner, p, testGame.Board(), registry),
+		"15 cards are not enough")
+
+	p.Hand().AddCard("card-15")
+	testutil.AssertEqual(t, 16, gamecards.GetPlayerMilestoneProgress(shared.MilestonePlanner, p, testGame.Board(), registry),
+		"Progress is the hand size")
+	testutil.AssertTrue(t, gamecards.CanClaimMilestone(shared.MilestonePlanner, p, testGame.Board(), registry),
+		"16 cards claim Planner")
+}
@@ -116,3 +116,29 @@ func TestAddProduction_ExactlyMinusFive(t *testing.T) {
 	})
 	testutil.AssertEqual(t, -5, player.Resources().Production().Credits, "MC production should be exactly -5")
 }
+
+func TestCanAddProduction_RespectsFloors(t *testing.T) {
+	broadcaster := testutil.NewMockBroadcaster()
+	testGame, _ := testutil.CreateTestGameWithPlayers(t, 1, broadcaster)
+	player := testGame.GetAllPlayers()[0]
+
+	player.Resources().AddProduction(map[shared.ResourceType]int{
+		shared.ResourceSteelProduction: 1,
+	})
+
+	testutil.AssertTrue(t, player.Resources().CanAddProduction(map[shared.ResourceType]int{
+		shared.ResourceCreditProduction: -5,
+	}), "MC production may drop to -5")
+	testutil.AssertFalse(t, player.Resources().CanAddProduction(map[shared.ResourceType]int{
+		shared.ResourceCreditProduction: -6,
+	}), "MC production may not drop below -5")
+	testutil.AssertTrue(t, player.Resources().CanAddProduction(map[shared.ResourceType]int{
+		shared.ResourceSteelProduction: -1,
+	}), "Steel production may drop to 0")
+	testutil.AssertFalse(t, player.Resources().CanAddProduction(map[shared.ResourceType]int{
+		shared.ResourceSteelProduction: -2,
+	}), "Steel production may not go negative")
+	testutil.AssertTrue(t, player.Resources().CanAddProduction(map[shared.ResourceType]int{
+		shared.ResourceHeat: -3,
+	}), "Non-production types are not checked")
+}
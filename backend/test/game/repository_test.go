@@ -0,0 +1,89 @@
+package game_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"terraforming-mars-backend/internal/game"
+	"terraforming-mars-backend/test/testutil"
+)
+
+func TestGameRepository_Contract(t *testing.T) {
+	var repo game.GameRepository = game.NewInMemoryGameRepository()
+	ctx := context.Background()
+
+	_, err := repo.Get(ctx, "missing")
+	testutil.AssertTrue(t, errors.Is(err, game.ErrGameNotFound), "Get on unknown ID should wrap ErrGameNotFound")
+	testutil.AssertTrue(t, errors.Is(repo.Delete(ctx, "missing"), game.ErrGameNotFound), "Delete on unknown ID should wrap ErrGameNotFound")
+	testutil.AssertFalse(t, repo.Exists(ctx, "missing"), "Exists should report false for unknown ID")
+	testutil.AssertError(t, repo.Create(ctx, nil), "Create should reject nil games")
+
+	g := game.NewGame("game-1", "", game.GameSettings{MaxPlayers: 4})
+	testutil.AssertNoError(t, repo.Create(ctx, g), "Create should succeed")
+	testutil.AssertTrue(t, errors.Is(repo.Create(ctx, g), game.ErrGameAlreadyExists), "Duplicate create should wrap ErrGameAlreadyExists")
+
+	stored, err := repo.Get(ctx, "game-1")
+	testutil.AssertNoError(t, err, "Get should succeed")
+	testutil.AssertTrue(t, stored == g, "Get should return the live aggregate")
+
+	active := game.GameStatusActive
+	games, err := repo.List(ctx, &active)
+	testutil.AssertNoError(t, err, "List should succeed")
+	testutil.AssertEqual(t, 0, len(games), "Lobby game should be filtered out")
+	games, err = repo.List(ctx, nil)
+	testutil.AssertNoError(t, err, "List should succeed")
+	testutil.AssertEqual(t, 1, len(games), "Unfiltered list should return every game")
+
+	cancelled, cancel := context.WithCancel(ctx)
+	cancel()
+	_, err = repo.Get(cancelled, "game-1")
+	testutil.AssertTrue(t, errors.Is(err, context.Canceled), "Get should honour a cancelled context")
+
+	testutil.AssertNoError(t, repo.Delete(ctx, "game-1"), "Delete should succeed")
+	testutil.AssertFalse(t, repo.Exists(ctx, "game-1"), "Deleted game should no longer exist")
+}
+
+func TestGameStateRepository_UnknownGameWrapsNotFound(t *testing.T) {
+	var repo game.GameStateRepository = game.NewInMemoryGameStateRepository()
+
+	_, err := repo.GetDiff(context.Background(), "missing")
+	testutil.AssertTrue(t, errors.Is(err, game.ErrGameNotFound), "GetDiff on unknown ID should wrap ErrGameNotFound")
+}
+
+func TestGameStateRepository_Delete(t *testing.T) {
+	var repo game.GameStateRepository = game.NewInMemoryGameStateRepository()
+	ctx := context.Background()
+	g := game.NewGame("game-1", "", game.GameSettings{MaxPlayers: 4})
+
+	testutil.AssertTrue(t, errors.Is(repo.Delete(ctx, "game-1"), game.ErrGameNotFound), "Delete on unknown ID should wrap ErrGameNotFound")
+
+	_, err := repo.WriteFull(ctx, "game-1", g, "test", game.SourceTypeGameEvent, "", "Created", nil, nil, nil)
+	testutil.AssertNoError(t, err, "Write should succeed")
+	testutil.AssertNoError(t, repo.Delete(ctx, "game-1"), "Delete should succeed")
+
+	_, err = repo.GetDiff(ctx, "game-1")
+	testutil.AssertTrue(t, errors.Is(err, game.ErrGameNotFound), "Deleted history should be gone")
+
+	diff, err := repo.WriteFull(ctx, "game-1", g, "test", game.SourceTypeGameEvent, "", "Recreated", nil, nil, nil)
+	testutil.AssertNoError(t, err, "Write after delete should succeed")
+	testutil.AssertEqual(t, int64(1), diff.SequenceNumber, "A deleted history starts over")
+}
+
+func TestGameRepository_RefusesGamesPastTheCap(t *testing.T) {
+	repo := game.NewInMemoryGameRepository()
+	repo.SetMaxActiveGames(2)
+	ctx := context.Background()
+
+	first := game.NewGame("game-1", "", game.GameSettings{MaxPlayers: 4})
+	testutil.AssertNoError(t, repo.Create(ctx, first), "First game fits")
+	testutil.AssertNoError(t, repo.Create(ctx, game.NewGame("game-2", "", game.GameSettings{MaxPlayers: 4})), "Second game fits")
+
+	err := repo.Create(ctx, game.NewGame("game-3", "", game.GameSettings{MaxPlayers: 4}))
+	testutil.AssertTrue(t, errors.Is(err, game.ErrServerAtCapacity), "Third game should wrap ErrServerAtCapacity")
+	testutil.AssertEqual(t, 1.0, repo.Capacity().Utilization(), "Full instance")
+
+	testutil.AssertNoError(t, first.UpdateStatus(ctx, game.GameStatusCompleted), "Finish the first game")
+	testutil.AssertNoError(t, repo.Create(ctx, game.NewGame("game-3", "", game.GameSettings{MaxPlayers: 4})), "A finished game frees its slot")
+	testutil.AssertEqual(t, game.GameCapacity{Active: 2, Max: 2}, repo.Capacity(), "Finished games are not counted")
+}
@@ -0,0 +1,51 @@
+package game_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"terraforming-mars-backend/internal/game"
+	"terraforming-mars-backend/internal/game/shared"
+	"terraforming-mars-backend/test/testutil"
+)
+
+func TestReplaySnapshot_RebuildsStateAfterEntry(t *testing.T) {
+	testGame, _ := testutil.CreateTestGameWithPlayers(t, 1, testutil.NewMockBroadcaster())
+	repo := game.NewInMemoryGameStateRepository()
+	ctx := context.Background()
+	p, _ := testGame.GetPlayer("player-1")
+
+	_, err := repo.WriteFull(ctx, testGame.ID(), testGame, "Game Setup", game.SourceTypeInitial, "", "Game created", nil, nil, nil)
+	testutil.AssertNoError(t, err, "First write should succeed")
+	startCredits := p.Resources().Get().Credits
+
+	p.Resources().Add(map[shared.ResourceType]int{shared.ResourceCredit: 10})
+	p.Hand().AddCard("card-power-plant")
+	_, err = repo.WriteFull(ctx, testGame.ID(), testGame, "Test", game.SourceTypeGameEvent, "player-1", "Gained credits and a card", nil, nil, nil)
+	testutil.AssertNoError(t, err, "Second write should succeed")
+
+	p.Hand().RemoveCard("card-power-plant")
+	p.Resources().Add(map[shared.ResourceType]int{shared.ResourceCredit: -4})
+	testutil.AssertNoError(t, testGame.SetGeneration(ctx, 2), "Failed to set generation")
+	_, err = repo.WriteFull(ctx, testGame.ID(), testGame, "Test", game.SourceTypeGameEvent, "player-1", "Spent credits", nil, nil, nil)
+	testutil.AssertNoError(t, err, "Third write should succeed")
+
+	diffs, err := repo.GetDiff(ctx, testGame.ID())
+	testutil.AssertNoError(t, err, "Log should be readable")
+
+	atTwo, err := game.ReplaySnapshot(diffs, 2)
+	testutil.AssertNoError(t, err, "Entry 2 should replay")
+	testutil.AssertEqual(t, startCredits+10, atTwo.Players["player-1"].Credits, "Credits after entry 2")
+	testutil.AssertEqual(t, 1, len(atTwo.Players["player-1"].HandCardIDs), "Card is in hand after entry 2")
+	testutil.AssertEqual(t, 1, atTwo.Generation, "Generation after entry 2")
+
+	atThree, err := game.ReplaySnapshot(diffs, 3)
+	testutil.AssertNoError(t, err, "Entry 3 should replay")
+	testutil.AssertEqual(t, startCredits+6, atThree.Players["player-1"].Credits, "Credits after entry 3")
+	testutil.AssertEqual(t, 0, len(atThree.Players["player-1"].HandCardIDs), "Card left the hand in entry 3")
+	testutil.AssertEqual(t, 2, atThree.Generation, "Generation after entry 3")
+
+	_, err = game.ReplaySnapshot(diffs, 4)
+	testutil.AssertTrue(t, errors.Is(err, game.ErrSequenceNotFound), "Entries past the log are not found")
+}
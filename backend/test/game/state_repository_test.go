@@ -4,6 +4,7 @@ import (
 	"context"
 	"testing"
 
+	"terraforming-mars-backend/internal/events"
 	"terraforming-mars-backend/internal/game"
 	"terraforming-mars-backend/internal/game/shared"
 	"terraforming-mars-backend/test/testutil"
@@ -14,7 +15,7 @@ func TestStateRepository_WriteInitialState(t *testing.T) {
 	testGame, _ := testutil.CreateTestGameWithPlayers(t, 2, broadcaster)
 	repo := game.NewInMemoryGameStateRepository()
 
-	diff, err := repo.Write(context.Background(), testGame.ID(), testGame, "Game Setup", game.SourceTypeInitial, "", "Game created")
+	diff, err := repo.WriteFull(context.Background(), testGame.ID(), testGame, "Game Setup", game.SourceTypeInitial, "", "Game created", nil, nil, nil)
 	if err != nil {
 		t.Fatalf("Write failed: %v", err)
 	}
@@ -39,7 +40,7 @@ func TestStateRepository_WriteIncrementalChanges(t *testing.T) {
 	testGame, _ := testutil.CreateTestGameWithPlayers(t, 1, broadcaster)
 	repo := game.NewInMemoryGameStateRepository()
 
-	_, err := repo.Write(context.Background(), testGame.ID(), testGame, "Game Setup", game.SourceTypeInitial, "", "Game created")
+	_, err := repo.WriteFull(context.Background(), testGame.ID(), testGame, "Game Setup", game.SourceTypeInitial, "", "Game created", nil, nil, nil)
 	if err != nil {
 		t.Fatalf("First write failed: %v", err)
 	}
@@ -50,7 +51,7 @@ func TestStateRepository_WriteIncrementalChanges(t *testing.T) {
 		shared.ResourceCredit: 10,
 	})
 
-	diff, err := repo.Write(context.Background(), testGame.ID(), testGame, "Test Card", game.SourceTypeCardPlay, player.ID(), "Played Test Card")
+	diff, err := repo.WriteFull(context.Background(), testGame.ID(), testGame, "Test Card", game.SourceTypeCardPlay, player.ID(), "Played Test Card", nil, nil, nil)
 	if err != nil {
 		t.Fatalf("Second write failed: %v", err)
 	}
@@ -76,7 +77,7 @@ func TestStateRepository_GetDiff(t *testing.T) {
 	testGame, _ := testutil.CreateTestGameWithPlayers(t, 1, broadcaster)
 	repo := game.NewInMemoryGameStateRepository()
 
-	_, err := repo.Write(context.Background(), testGame.ID(), testGame, "Game Setup", game.SourceTypeInitial, "", "Game created")
+	_, err := repo.WriteFull(context.Background(), testGame.ID(), testGame, "Game Setup", game.SourceTypeInitial, "", "Game created", nil, nil, nil)
 	if err != nil {
 		t.Fatalf("First write failed: %v", err)
 	}
@@ -86,7 +87,7 @@ func TestStateRepository_GetDiff(t *testing.T) {
 	player.Resources().Add(map[shared.ResourceType]int{
 		shared.ResourceSteel: 5,
 	})
-	_, err = repo.Write(context.Background(), testGame.ID(), testGame, "Card A", game.SourceTypeCardPlay, player.ID(), "Played Card A")
+	_, err = repo.WriteFull(context.Background(), testGame.ID(), testGame, "Card A", game.SourceTypeCardPlay, player.ID(), "Played Card A", nil, nil, nil)
 	if err != nil {
 		t.Fatalf("Second write failed: %v", err)
 	}
@@ -94,7 +95,7 @@ func TestStateRepository_GetDiff(t *testing.T) {
 	player.Resources().AddProduction(map[shared.ResourceType]int{
 		shared.ResourcePlantProduction: 2,
 	})
-	_, err = repo.Write(context.Background(), testGame.ID(), testGame, "Card B", game.SourceTypeCardPlay, player.ID(), "Played Card B")
+	_, err = repo.WriteFull(context.Background(), testGame.ID(), testGame, "Card B", game.SourceTypeCardPlay, player.ID(), "Played Card B", nil, nil, nil)
 	if err != nil {
 		t.Fatalf("Third write failed: %v", err)
 	}
@@ -110,6 +111,38 @@ func TestStateRepository_GetDiff(t *testing.T) {
 	testutil.AssertEqual(t, int64(3), diffs[2].SequenceNumber, "Third diff should have sequence 3")
 }
 
+func TestStateRepository_GetDiffSinceReadsOnlyNewerEntries(t *testing.T) {
+	ctx := context.Background()
+	testGame, _ := testutil.CreateTestGameWithPlayers(t, 1, testutil.NewMockBroadcaster())
+	repo := game.NewInMemoryGameStateRepository()
+
+	var written []int64
+	events.Subscribe(testGame.EventBus(), func(e events.LogEntryWrittenEvent) {
+		diffs, err := repo.GetDiffSince(ctx, e.GameID, e.SequenceNumber-1)
+		testutil.AssertNoError(t, err, "The log is readable from the event")
+		testutil.AssertEqual(t, 1, len(diffs), "The written entry is stored before the event")
+		written = append(written, e.SequenceNumber)
+	})
+
+	for _, source := range []string{"Game Setup", "Card A", "Card B"} {
+		_, err := repo.WriteFull(ctx, testGame.ID(), testGame, source, game.SourceTypeCardPlay, "", source, nil, nil, nil)
+		testutil.AssertNoError(t, err, "Write should succeed")
+	}
+	testutil.AssertEqual(t, 3, len(written), "Every write publishes an event")
+
+	diffs, err := repo.GetDiffSince(ctx, testGame.ID(), 1)
+	testutil.AssertNoError(t, err, "GetDiffSince should succeed")
+	testutil.AssertEqual(t, 2, len(diffs), "Only entries after the cursor are returned")
+	testutil.AssertEqual(t, "Card A", diffs[0].Source, "Entries are in write order")
+
+	diffs, err = repo.GetDiffSince(ctx, testGame.ID(), 3)
+	testutil.AssertNoError(t, err, "A cursor at the end is not an error")
+	testutil.AssertEqual(t, 0, len(diffs), "Nothing newer than the last entry")
+
+	_, err = repo.GetDiffSince(ctx, "missing", 0)
+	testutil.AssertError(t, err, "Unknown games are an error")
+}
+
 func TestStateRepository_GetDiffGameNotFound(t *testing.T) {
 	repo := game.NewInMemoryGameStateRepository()
 
@@ -122,7 +155,7 @@ func TestStateRepository_GetDiffGameNotFound(t *testing.T) {
 func TestStateRepository_WriteNilGameReturnsError(t *testing.T) {
 	repo := game.NewInMemoryGameStateRepository()
 
-	_, err := repo.Write(context.Background(), "test-game", nil, "Test", game.SourceTypeInitial, "", "Test")
+	_, err := repo.WriteFull(context.Background(), "test-game", nil, "Test", game.SourceTypeInitial, "", "Test", nil, nil, nil)
 	if err == nil {
 		t.Fatal("Write should return error for nil game")
 	}
@@ -136,7 +169,7 @@ func TestStateRepository_WriteContextCancelled(t *testing.T) {
 	ctx, cancel := context.WithCancel(context.Background())
 	cancel()
 
-	_, err := repo.Write(ctx, testGame.ID(), testGame, "Test", game.SourceTypeInitial, "", "Test")
+	_, err := repo.WriteFull(ctx, testGame.ID(), testGame, "Test", game.SourceTypeInitial, "", "Test", nil, nil, nil)
 	if err == nil {
 		t.Fatal("Write should return error when context is cancelled")
 	}
@@ -149,14 +182,14 @@ func TestStateRepository_GlobalParameterChanges(t *testing.T) {
 	players := testGame.GetAllPlayers()
 	player := players[0]
 
-	_, err := repo.Write(context.Background(), testGame.ID(), testGame, "Game Setup", game.SourceTypeInitial, "", "Game created")
+	_, err := repo.WriteFull(context.Background(), testGame.ID(), testGame, "Game Setup", game.SourceTypeInitial, "", "Game created", nil, nil, nil)
 	if err != nil {
 		t.Fatalf("First write failed: %v", err)
 	}
 
 	testGame.GlobalParameters().IncreaseTemperature(context.Background(), 2)
 
-	diff, err := repo.Write(context.Background(), testGame.ID(), testGame, "Heat Conversion", game.SourceTypeResourceConvert, player.ID(), "Converted heat to temperature")
+	diff, err := repo.WriteFull(context.Background(), testGame.ID(), testGame, "Heat Conversion", game.SourceTypeResourceConvert, player.ID(), "Converted heat to temperature", nil, nil, nil)
 	if err != nil {
 		t.Fatalf("Second write failed: %v", err)
 	}
@@ -172,7 +205,7 @@ func TestStateRepository_PhaseChange(t *testing.T) {
 	testGame, _ := testutil.CreateTestGameWithPlayers(t, 1, broadcaster)
 	repo := game.NewInMemoryGameStateRepository()
 
-	_, err := repo.Write(context.Background(), testGame.ID(), testGame, "Game Setup", game.SourceTypeInitial, "", "Game created")
+	_, err := repo.WriteFull(context.Background(), testGame.ID(), testGame, "Game Setup", game.SourceTypeInitial, "", "Game created", nil, nil, nil)
 	if err != nil {
 		t.Fatalf("First write failed: %v", err)
 	}
@@ -182,7 +215,7 @@ func TestStateRepository_PhaseChange(t *testing.T) {
 		t.Fatalf("UpdatePhase failed: %v", err)
 	}
 
-	diff, err := repo.Write(context.Background(), testGame.ID(), testGame, "Phase Transition", game.SourceTypeGameEvent, "", "Phase changed to action")
+	diff, err := repo.WriteFull(context.Background(), testGame.ID(), testGame, "Phase Transition", game.SourceTypeGameEvent, "", "Phase changed to action", nil, nil, nil)
 	if err != nil {
 		t.Fatalf("Second write failed: %v", err)
 	}
@@ -198,12 +231,12 @@ func TestStateRepository_NoChangesProducesEmptyDiff(t *testing.T) {
 	testGame, _ := testutil.CreateTestGameWithPlayers(t, 1, broadcaster)
 	repo := game.NewInMemoryGameStateRepository()
 
-	_, err := repo.Write(context.Background(), testGame.ID(), testGame, "Game Setup", game.SourceTypeInitial, "", "Game created")
+	_, err := repo.WriteFull(context.Background(), testGame.ID(), testGame, "Game Setup", game.SourceTypeInitial, "", "Game created", nil, nil, nil)
 	if err != nil {
 		t.Fatalf("First write failed: %v", err)
 	}
 
-	diff, err := repo.Write(context.Background(), testGame.ID(), testGame, "No Op", game.SourceTypeGameEvent, "", "No changes")
+	diff, err := repo.WriteFull(context.Background(), testGame.ID(), testGame, "No Op", game.SourceTypeGameEvent, "", "No changes", nil, nil, nil)
 	if err != nil {
 		t.Fatalf("Second write failed: %v", err)
 	}
@@ -229,3 +262,31 @@ func TestStateRepository_NoChangesProducesEmptyDiff(t *testing.T) {
 		t.Error("Oceans should be nil when unchanged")
 	}
 }
+
+func TestStateRepository_RecordInput(t *testing.T) {
+	testGame, _ := testutil.CreateTestGameWithPlayers(t, 2, testutil.NewMockBroadcaster())
+	repo := game.NewInMemoryGameStateRepository()
+	ctx := context.Background()
+
+	inputs, err := repo.GetInputs(ctx, testGame.ID())
+	testutil.AssertNoError(t, err, "A game with no inputs has an empty journal")
+	testutil.AssertEqual(t, 0, len(inputs), "Nothing recorded yet")
+
+	_, err = repo.WriteFull(ctx, testGame.ID(), testGame, "Game Setup", game.SourceTypeInitial, "", "Game created", nil, nil, nil)
+	testutil.AssertNoError(t, err, "Write failed")
+	player, _ := testGame.GetPlayer("player-1")
+	testutil.AddPlayerCredits(ctx, player, 7)
+
+	input, err := repo.RecordInput(ctx, testGame.ID(), testGame, "player-1", "action.game-management.skip-action", []byte(`{}`))
+	testutil.AssertNoError(t, err, "RecordInput failed")
+	testutil.AssertEqual(t, int64(1), input.Sequence, "Inputs are numbered from 1")
+	testutil.AssertEqual(t, 1, input.LogLength, "Input notes how far the log had got")
+	testutil.AssertEqual(t, player.Resources().Get().Credits, input.State.Players["player-1"].Credits, "Input keeps the state it left behind")
+
+	_, err = repo.RecordInput(ctx, testGame.ID(), nil, "player-1", "action.game-management.skip-action", nil)
+	testutil.AssertError(t, err, "A nil game is rejected")
+
+	testutil.AssertNoError(t, repo.Delete(ctx, testGame.ID()), "Delete failed")
+	inputs, _ = repo.GetInputs(ctx, testGame.ID())
+	testutil.AssertEqual(t, 0, len(inputs), "Delete drops the journal with the log")
+}
@@ -0,0 +1,50 @@
+package game_test
+
+import (
+	"context"
+	"testing"
+
+	"terraforming-mars-backend/internal/game"
+	"terraforming-mars-backend/internal/game/player"
+	"terraforming-mars-backend/test/testutil"
+)
+
+func TestWaitingOn_FollowsThePhase(t *testing.T) {
+	testGame, _ := testutil.CreateTestGameWithPlayers(t, 3, testutil.NewMockBroadcaster())
+	ctx := context.Background()
+
+	testutil.AssertEqual(t, 0, len(testGame.WaitingOn()), "Lobby waits on no one")
+
+	testutil.AssertNoError(t, testGame.UpdatePhase(ctx, game.GamePhaseStartingCardSelection), "Failed to set phase")
+	for _, id := range []string{"player-1", "player-2", "player-3"} {
+		phase := &player.SelectStartingCardsPhase{SelectionComplete: id == "player-2"}
+		testutil.AssertNoError(t, testGame.SetSelectStartingCardsPhase(ctx, id, phase), "Failed to set selection")
+	}
+	waiting := testGame.WaitingOn()
+	testutil.AssertEqual(t, 2, len(waiting), "Two players still selecting")
+	testutil.AssertEqual(t, "player-1", waiting[0], "Waiting list follows turn order")
+	testutil.AssertEqual(t, "player-3", waiting[1], "Waiting list follows turn order")
+
+	testutil.StartTestGame(t, testGame)
+	waiting = testGame.WaitingOn()
+	testutil.AssertEqual(t, 1, len(waiting), "Action phase waits on the current player")
+	testutil.AssertEqual(t, testGame.CurrentTurn().PlayerID(), waiting[0], "Current player is waited on")
+
+	other := "player-3"
+	if waiting[0] == other {
+		other = "player-2"
+	}
+	selection := &player.PendingTileSelection{TileType: "greenery", AvailableHexes: []string{"0,0,0"}, Source: "test"}
+	testutil.AssertNoError(t, testGame.SetPendingTileSelection(ctx, other, selection), "Failed to set tile selection")
+	testutil.AssertEqual(t, 2, len(testGame.WaitingOn()), "A pending tile placement is waited on too")
+
+	testutil.AssertNoError(t, testGame.UpdatePhase(ctx, game.GamePhaseProductionAndCardDraw), "Failed to set phase")
+	testutil.AssertNoError(t, testGame.SetProductionPhase(ctx, "player-1", &player.ProductionPhase{}), "Failed to set production phase")
+	testutil.AssertNoError(t, testGame.SetProductionPhase(ctx, "player-2", &player.ProductionPhase{SelectionComplete: true}), "Failed to set production phase")
+	waiting = testGame.WaitingOn()
+	testutil.AssertEqual(t, 1, len(waiting), "Only unconfirmed purchases are waited on")
+	testutil.AssertEqual(t, "player-1", waiting[0], "Player 1 has not bought cards")
+
+	testutil.AssertNoError(t, testGame.UpdatePhase(ctx, game.GamePhaseComplete), "Failed to set phase")
+	testutil.AssertEqual(t, 0, len(testGame.WaitingOn()), "Finished games wait on no one")
+}
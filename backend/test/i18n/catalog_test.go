@@ -0,0 +1,75 @@
+package i18n_test
+
+import (
+	"context"
+	"testing"
+
+	gameAction "terraforming-mars-backend/internal/action/game"
+	"terraforming-mars-backend/internal/delivery/dto"
+	"terraforming-mars-backend/internal/i18n"
+	"terraforming-mars-backend/test/testutil"
+)
+
+func TestLocalizeError_KnownEngineErrors(t *testing.T) {
+	code, text := i18n.LocalizeError("de", "failed to build city: insufficient credits: need 25, have 12")
+	testutil.AssertEqual(t, "ERR_INSUFFICIENT_RESOURCE", code, "Wrapped errors are recognized")
+	testutil.AssertEqual(t, "Nicht genug M€: benötigt 25, vorhanden 12", text, "Parameters and resource names are translated")
+
+	code, text = i18n.LocalizeError("sv", "not your turn")
+	testutil.AssertEqual(t, "ERR_NOT_YOUR_TURN", code, "Turn errors are recognized")
+	testutil.AssertEqual(t, "Det är inte din tur", text, "Message is in the player's locale")
+
+	code, text = i18n.LocalizeError("", "game not found: abc")
+	testutil.AssertEqual(t, "ERR_GAME_NOT_FOUND", code, "English errors still get a code")
+	testutil.AssertEqual(t, "game not found: abc", text, "English keeps the engine's wording")
+
+	code, text = i18n.LocalizeError("de", "something unexpected")
+	testutil.AssertEqual(t, "", code, "Unknown errors get no code")
+	testutil.AssertEqual(t, "something unexpected", text, "Unknown errors pass through")
+}
+
+func TestResolve_MatchesEngineTemplates(t *testing.T) {
+	code, params, ok := i18n.Resolve("failed to join: player p-1 not found in game g-1")
+	testutil.AssertTrue(t, ok, "Wrapped errors are recognized")
+	testutil.AssertEqual(t, "ERR_PLAYER_NOT_FOUND", code, "Multi-placeholder templates match")
+	testutil.AssertEqual(t, "p-1", params["player"], "First placeholder is captured")
+	testutil.AssertEqual(t, "g-1", params["game"], "Last placeholder is captured")
+
+	code, params, _ = i18n.Resolve("game not in action phase")
+	testutil.AssertEqual(t, "ERR_WRONG_PHASE", code, "The literal after a placeholder decides the template")
+	testutil.AssertEqual(t, "action", params["phase"], "Phase is captured")
+
+	code, params, _ = i18n.Resolve("milestone Space Baron is already claimed")
+	testutil.AssertEqual(t, "ERR_MILESTONE_CLAIMED", code, "Placeholders may hold spaces")
+	testutil.AssertEqual(t, "Space Baron", params["milestone"], "Milestone name is captured whole")
+
+	_, _, ok = i18n.Resolve("card  not in hand")
+	testutil.AssertFalse(t, ok, "A placeholder never matches nothing")
+}
+
+func TestCatalog_CoversProtocolErrorCodes(t *testing.T) {
+	for _, code := range []string{dto.ErrCodeGamePaused, dto.ErrCodeAwaitingResponse, dto.ErrCodeMilestonesFull, dto.ErrCodeServerAtCapacity} {
+		for _, locale := range i18n.SupportedLocales {
+			testutil.AssertTrue(t, i18n.Message(locale, code, nil) != code, code+" is translated to "+locale)
+		}
+	}
+	testutil.AssertEqual(t, "sv", i18n.Normalize("sv-SE"), "Regional variants map to their language")
+	testutil.AssertEqual(t, "", i18n.Normalize("xx"), "Unsupported locales normalize to nothing")
+}
+
+func TestSetPreferences_StoresLocale(t *testing.T) {
+	testGame, repo := testutil.CreateTestGameWithPlayers(t, 2, testutil.NewMockBroadcaster())
+	ctx := context.Background()
+	action := gameAction.NewSetPreferencesAction(repo, testutil.TestLogger())
+	p, _ := testGame.GetPlayer("player-1")
+
+	testutil.AssertNoError(t, action.Execute(ctx, testGame.ID(), p.ID(), gameAction.PlayerPreferences{Locale: "de-AT"}), "Locale should be stored")
+	testutil.AssertEqual(t, "de", p.Locale(), "Locale is normalized")
+
+	err := action.Execute(ctx, testGame.ID(), p.ID(), gameAction.PlayerPreferences{Locale: "xx"})
+	testutil.AssertError(t, err, "Unsupported locales are rejected")
+	testutil.AssertEqual(t, "de", p.Locale(), "A rejected locale keeps the current one")
+
+	testutil.AssertNoError(t, action.Execute(ctx, testGame.ID(), p.ID(), gameAction.PlayerPreferences{AutoPass: true}), "Other preferences can change")
+	testutil.AssertEqual(t, "de", p.Locale(), "Omitting the locale keeps it")
+}
@@ -9,7 +9,7 @@ import (
 	turnAction "terraforming-mars-backend/internal/action/turn_management"
 	"terraforming-mars-backend/internal/cards"
 	"terraforming-mars-backend/internal/game"
-	"terraforming-mars-backend/internal/game/global_parameters"
+	"terraforming-mars-backend/internal/game/parameters"
 	"terraforming-mars-backend/test/testutil"
 )
 
@@ -24,7 +24,7 @@ func setupActiveGameForGlobalParams(t *testing.T) (*game.Game, game.GameReposito
 	// Create and start game
 	createAction := gameAction.NewCreateGameAction(repo, cardRegistry, logger)
 	joinAction := gameAction.NewJoinGameAction(repo, cardRegistry, logger)
-	startAction := turnAction.NewStartGameAction(repo, logger)
+	startAction := turnAction.NewStartGameAction(repo, testutil.CreateTestCardRegistry(), logger)
 
 	settings := game.GameSettings{
 		MaxPlayers: 2,
@@ -101,7 +101,7 @@ func TestGlobalParameters_TemperatureMax(t *testing.T) {
 	ctx := context.Background()
 
 	// Set temperature near max
-	testGame.GlobalParameters().SetTemperature(ctx, global_parameters.MaxTemperature-2)
+	testGame.GlobalParameters().SetTemperature(ctx, parameters.MaxTemperature-2)
 
 	// Give player heat
 	player, _ := testGame.GetPlayer(playerID)
@@ -125,7 +125,7 @@ func TestGlobalParameters_TemperatureMax(t *testing.T) {
 
 	// Verify temperature doesn't exceed max
 	finalTemp := testGame.GlobalParameters().Temperature()
-	testutil.AssertTrue(t, finalTemp <= global_parameters.MaxTemperature, "Temperature should not exceed max")
+	testutil.AssertTrue(t, finalTemp <= parameters.MaxTemperature, "Temperature should not exceed max")
 }
 
 // TestGlobalParameters_AllParametersInitialized tests all global parameters are set on game start
@@ -138,16 +138,16 @@ func TestGlobalParameters_AllParametersInitialized(t *testing.T) {
 
 	// Verify all parameters have valid initial values
 	temp := globalParams.Temperature()
-	testutil.AssertTrue(t, temp >= global_parameters.MinTemperature, "Temperature should be at least minimum")
-	testutil.AssertTrue(t, temp <= global_parameters.MaxTemperature, "Temperature should not exceed maximum")
+	testutil.AssertTrue(t, temp >= parameters.MinTemperature, "Temperature should be at least minimum")
+	testutil.AssertTrue(t, temp <= parameters.MaxTemperature, "Temperature should not exceed maximum")
 
 	oxygen := globalParams.Oxygen()
 	testutil.AssertTrue(t, oxygen >= 0, "Oxygen should be non-negative")
-	testutil.AssertTrue(t, oxygen <= global_parameters.MaxOxygen, "Oxygen should not exceed maximum")
+	testutil.AssertTrue(t, oxygen <= parameters.MaxOxygen, "Oxygen should not exceed maximum")
 
 	oceans := globalParams.Oceans()
 	testutil.AssertTrue(t, oceans >= 0, "Oceans should be non-negative")
-	testutil.AssertTrue(t, oceans <= global_parameters.MaxOceans, "Oceans should not exceed maximum")
+	testutil.AssertTrue(t, oceans <= parameters.MaxOceans, "Oceans should not exceed maximum")
 }
 
 // TestGlobalParameters_EventsPublished tests that events are published on parameter changes
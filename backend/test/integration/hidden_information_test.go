@@ -0,0 +1,156 @@
+package integration_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	gameAction "terraforming-mars-backend/internal/action/game"
+	stdprojAction "terraforming-mars-backend/internal/action/standard_project"
+	turnAction "terraforming-mars-backend/internal/action/turn_management"
+	"terraforming-mars-backend/internal/delivery/dto"
+	wsdelivery "terraforming-mars-backend/internal/delivery/websocket"
+	"terraforming-mars-backend/internal/delivery/websocket/core"
+	"terraforming-mars-backend/internal/game"
+	"terraforming-mars-backend/test/testutil"
+)
+
+// auditedTable connects every seated player through a virtual connection so each broadcast can be audited
+type auditedTable struct {
+	t           *testing.T
+	repo        game.GameRepository
+	broadcaster *wsdelivery.Broadcaster
+	gameID      string
+	connections map[string]*core.Connection
+	audited     int
+}
+
+// auditAll drains every connection and fails on any message mentioning a card its recipient must not see
+func (a *auditedTable) auditAll(step string) {
+	a.t.Helper()
+
+	g, err := a.repo.Get(context.Background(), a.gameID)
+	testutil.AssertNoError(a.t, err, "Game should exist")
+
+	for playerID, conn := range a.connections {
+		for {
+			message, ok := conn.Receive()
+			if !ok {
+				break
+			}
+			data, err := json.Marshal(message)
+			testutil.AssertNoError(a.t, err, "Message should marshal")
+			testutil.AssertNoHiddenCards(a.t, g, playerID, data, fmt.Sprintf("%s (%s)", step, message.Type))
+			a.audited++
+		}
+	}
+}
+
+func TestHiddenInformation_NoBroadcastRevealsOtherPlayersCards(t *testing.T) {
+	ctx := context.Background()
+	repo := game.NewInMemoryGameRepository()
+	stateRepo := game.NewInMemoryGameStateRepository()
+	cardRegistry := testutil.CreateTestCardRegistry()
+	logger := testutil.TestLogger()
+	hub := core.NewHub()
+
+	createdGame, err := gameAction.NewCreateGameAction(repo, cardRegistry, logger).Execute(ctx, game.GameSettings{
+		MaxPlayers: 4,
+		CardPacks:  []string{"base"},
+	})
+	testutil.AssertNoError(t, err, "Failed to create game")
+
+	table := &auditedTable{
+		t:           t,
+		repo:        repo,
+		broadcaster: wsdelivery.NewBroadcaster(repo, stateRepo, hub, cardRegistry, nil),
+		gameID:      createdGame.ID(),
+		connections: make(map[string]*core.Connection),
+	}
+
+	joinAction := gameAction.NewJoinGameAction(repo, cardRegistry, logger)
+	for i, name := range []string{"Alice", "Bob", "Carol"} {
+		playerID := fmt.Sprintf("player-%d", i+1)
+		_, err := joinAction.Execute(ctx, table.gameID, name, playerID)
+		testutil.AssertNoError(t, err, "Failed to join game")
+
+		conn := core.NewVirtualConnection("conn-"+playerID, hub.GetManager())
+		hub.GetManager().RegisterConnection(conn)
+		conn.SetPlayer(playerID, table.gameID)
+		table.connections[playerID] = conn
+	}
+	table.broadcaster.BroadcastGameState(table.gameID, nil)
+	table.auditAll("lobby")
+
+	// Starting hands and corporations are dealt privately
+	g, _ := repo.Get(ctx, table.gameID)
+	err = turnAction.NewStartGameAction(repo, testutil.CreateTestCardRegistry(), logger).Execute(ctx, table.gameID, g.HostPlayerID())
+	testutil.AssertNoError(t, err, "Failed to start game")
+	_, err = stateRepo.WriteFull(ctx, table.gameID, g, "Game Started", game.SourceTypeGameEvent, "", "Game started", nil, nil, nil)
+	testutil.AssertNoError(t, err, "Failed to write log")
+	table.broadcaster.BroadcastGameState(table.gameID, nil)
+	table.auditAll("starting selection")
+
+	// Kept cards move into hands, which the log records as hand changes
+	selectAction := turnAction.NewSelectStartingCardsAction(repo, cardRegistry, logger)
+	for _, p := range g.GetAllPlayers() {
+		phase := g.GetSelectStartingCardsPhase(p.ID())
+		testutil.AssertTrue(t, phase != nil, "Player should have a starting selection")
+		testutil.SetPlayerCredits(ctx, p, 50)
+		err := selectAction.Execute(ctx, table.gameID, p.ID(), phase.AvailableCards[:2], phase.AvailableCorporations[0], nil)
+		testutil.AssertNoError(t, err, "Failed to select starting cards")
+
+		_, err = stateRepo.WriteFull(ctx, table.gameID, g, "Starting Selection", game.SourceTypeGameEvent, p.ID(), "Selected starting cards", nil, nil, nil)
+		testutil.AssertNoError(t, err, "Failed to write log")
+		table.broadcaster.BroadcastGameState(table.gameID, nil)
+		table.auditAll("after " + p.ID() + " selected")
+	}
+	for _, p := range g.GetAllPlayers() {
+		testutil.AssertTrue(t, p.Hand().CardCount() > 0, "Player should hold cards")
+	}
+
+	// A pending selection is only visible to the player choosing
+	currentID := g.CurrentTurn().PlayerID()
+	sellPatents := stdprojAction.NewSellPatentsAction(repo, stateRepo, logger)
+	testutil.AssertNoError(t, sellPatents.Execute(ctx, table.gameID, currentID), "Failed to sell patents")
+	table.broadcaster.BroadcastGameState(table.gameID, nil)
+	table.auditAll("pending sell patents")
+
+	// Players joining late or resuming get the log history
+	for playerID := range table.connections {
+		table.broadcaster.SendInitialLogs(table.gameID, playerID)
+		response, err := table.broadcaster.SyncState(table.gameID, playerID, dto.SyncRequestPayload{SinceSequence: -1})
+		testutil.AssertNoError(t, err, "Sync should succeed")
+		data, err := json.Marshal(response)
+		testutil.AssertNoError(t, err, "Sync response should marshal")
+		testutil.AssertNoHiddenCards(t, g, playerID, data, "sync response")
+	}
+	table.auditAll("initial logs")
+
+	testutil.AssertTrue(t, table.audited > 20, "Audit should have inspected every broadcast")
+}
+
+func TestHiddenInformation_LogHandChangesOnlyReachTheirOwner(t *testing.T) {
+	diffs := []dto.StateDiffDto{{
+		SequenceNumber: 1,
+		Changes: &dto.GameChangesDto{
+			PlayerChanges: map[string]*dto.PlayerChangesDto{
+				"player-1": {CardsAdded: []string{"card-power-plant"}, CardsPlayed: []string{"card-asteroid"}},
+				"player-2": {CardsRemoved: []string{"card-water-import"}, HandSize: &dto.DiffValueIntDto{Old: 9, New: 8}},
+			},
+		},
+	}}
+
+	redacted := dto.RedactStateDiffsForViewer(diffs, "player-1")
+	own := redacted[0].Changes.PlayerChanges["player-1"]
+	other := redacted[0].Changes.PlayerChanges["player-2"]
+	testutil.AssertEqual(t, 1, len(own.CardsAdded), "Own hand changes should be kept")
+	testutil.AssertEqual(t, 0, len(other.CardsRemoved), "Other hand changes should be hidden")
+	testutil.AssertEqual(t, 8, other.HandSize.New, "Other hand sizes stay public")
+
+	redacted = dto.RedactStateDiffsForViewer(diffs, "")
+	testutil.AssertEqual(t, 0, len(redacted[0].Changes.PlayerChanges["player-1"].CardsAdded), "Spectators see no hand changes")
+	testutil.AssertEqual(t, 1, len(redacted[0].Changes.PlayerChanges["player-1"].CardsPlayed), "Played cards stay public")
+	testutil.AssertEqual(t, 1, len(diffs[0].Changes.PlayerChanges["player-2"].CardsRemoved), "Input should not be mutated")
+}
@@ -0,0 +1,118 @@
+package integration_test
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	milestoneAction "terraforming-mars-backend/internal/action/milestone"
+	"terraforming-mars-backend/internal/delivery/dto"
+	"terraforming-mars-backend/internal/delivery/websocket/core"
+	milestoneHandler "terraforming-mars-backend/internal/delivery/websocket/handler/milestone"
+	"terraforming-mars-backend/internal/game"
+	"terraforming-mars-backend/internal/game/shared"
+	"terraforming-mars-backend/test/testutil"
+)
+
+type noopBroadcaster struct{}
+
+func (noopBroadcaster) BroadcastGameState(gameID string, playerIDs []string) {}
+
+// errorCodes returns the codes of the errors a connection was sent
+func errorCodes(conn *core.Connection) []string {
+	var codes []string
+	for {
+		message, ok := conn.Receive()
+		if !ok {
+			return codes
+		}
+		if payload, isError := message.Payload.(dto.ErrorPayload); isError {
+			codes = append(codes, payload.Code)
+		}
+	}
+}
+
+// TestMilestoneRace_LastSlotGoesToExactlyOneClaim fires claims for the last milestone slot at once
+// through the hub; the game queue runs them one at a time, so one wins and the rest are refused cleanly
+func TestMilestoneRace_LastSlotGoesToExactlyOneClaim(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	g, repo := testutil.CreateTestGameWithPlayers(t, 2, testutil.NewMockBroadcaster())
+	testutil.StartTestGame(t, g)
+	first, _ := g.GetPlayer("player-1")
+	second, _ := g.GetPlayer("player-2")
+
+	testutil.AssertNoError(t, g.Milestones().ClaimMilestone(ctx, shared.MilestoneMayor, second.ID(), 1), "First slot")
+	testutil.AssertNoError(t, g.Milestones().ClaimMilestone(ctx, shared.MilestoneGardener, second.ID(), 1), "Second slot")
+
+	// The current player qualifies for two milestones and claims both at once from two tabs
+	testutil.SetPlayerCredits(ctx, first, 30)
+	testutil.SetPlayerCredits(ctx, second, 30)
+	first.Resources().SetTerraformRating(35)
+	hand := make([]string, 16)
+	for i := range hand {
+		hand[i] = fmt.Sprintf("card-%d", i)
+	}
+	first.Hand().SetCards(hand)
+
+	hub := core.NewHub()
+	action := milestoneAction.NewClaimMilestoneAction(repo, testutil.CreateTestCardRegistry(), game.NewInMemoryGameStateRepository(), testutil.TestLogger())
+	hub.RegisterHandler(dto.MessageTypeActionClaimMilestone, milestoneHandler.NewClaimMilestoneHandler(action, noopBroadcaster{}))
+	go hub.Run(ctx)
+
+	claims := map[*core.Connection]shared.MilestoneType{
+		core.NewVirtualConnection("tab-1", hub.GetManager()): shared.MilestoneTerraformer,
+		core.NewVirtualConnection("tab-2", hub.GetManager()): shared.MilestonePlanner,
+	}
+	var wg sync.WaitGroup
+	for conn, milestone := range claims {
+		conn.SetPlayer(first.ID(), g.ID())
+		wg.Add(1)
+		go func(conn *core.Connection, milestone shared.MilestoneType) {
+			defer wg.Done()
+			hub.Messages <- core.HubMessage{
+				Connection: conn,
+				Message: dto.WebSocketMessage{
+					Type:    dto.MessageTypeActionClaimMilestone,
+					GameID:  g.ID(),
+					Payload: map[string]interface{}{"milestoneType": string(milestone)},
+				},
+			}
+		}(conn, milestone)
+	}
+	wg.Wait()
+
+	waitCtx, waitCancel := context.WithTimeout(ctx, 2*time.Second)
+	defer waitCancel()
+	testutil.AssertNoError(t, hub.RunInGameQueue(waitCtx, g.ID(), func(context.Context) {}), "Both claims should be handled")
+
+	var refusals []string
+	for conn := range claims {
+		refusals = append(refusals, errorCodes(conn)...)
+	}
+	testutil.AssertEqual(t, 1, len(refusals), "Exactly one claim is refused")
+	testutil.AssertEqual(t, dto.ErrCodeMilestonesFull, refusals[0], "The losing claim gets ERR_MILESTONES_FULL")
+	testutil.AssertEqual(t, game.MaxClaimedMilestones, g.Milestones().ClaimedCount(), "Only the winner took the last slot")
+	testutil.AssertEqual(t, 30-game.MilestoneClaimCost, testutil.GetPlayerCredits(first), "The claim is paid for once")
+
+	// A late claim from another player is refused the same way, whoever's turn it is
+	late := core.NewVirtualConnection("late", hub.GetManager())
+	late.SetPlayer(second.ID(), g.ID())
+	second.Resources().SetTerraformRating(35)
+	hub.Messages <- core.HubMessage{
+		Connection: late,
+		Message: dto.WebSocketMessage{
+			Type:    dto.MessageTypeActionClaimMilestone,
+			GameID:  g.ID(),
+			Payload: map[string]interface{}{"milestoneType": string(shared.MilestoneBuilder)},
+		},
+	}
+	testutil.AssertNoError(t, hub.RunInGameQueue(waitCtx, g.ID(), func(context.Context) {}), "Late claim should be handled")
+	codes := errorCodes(late)
+	testutil.AssertEqual(t, 1, len(codes), "Late claim is refused")
+	testutil.AssertEqual(t, dto.ErrCodeMilestonesFull, codes[0], "Late claims get ERR_MILESTONES_FULL too")
+	testutil.AssertEqual(t, 30, testutil.GetPlayerCredits(second), "Refused claims cost nothing")
+}
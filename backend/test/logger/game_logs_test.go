@@ -0,0 +1,130 @@
+package logger_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"terraforming-mars-backend/internal/logger"
+)
+
+func TestGameLogStore_RecordsGameScopedLines(t *testing.T) {
+	store := logger.NewGameLogStore(10, 10, logger.DefaultMaxGameLogBytes)
+	log := zap.New(store.Core(zapcore.DebugLevel))
+
+	gameLog := log.With(zap.String("game_id", "game-1"), zap.String("player_id", "player-1"))
+	gameLog.Debug("Tile placed")
+	gameLog.Warn("Action rejected", zap.String("reason", "not your turn"))
+	log.Info("Server line without a game")
+	log.Error("Game stuck", zap.String("game_id", "game-2"))
+
+	entries := store.Entries("game-1", zapcore.DebugLevel)
+	if len(entries) != 2 {
+		t.Fatalf("Expected 2 entries for game-1, got %d", len(entries))
+	}
+	if entries[0].Message != "Tile placed" || entries[1].Fields["reason"] != "not your turn" {
+		t.Errorf("Unexpected entries: %+v", entries)
+	}
+	if entries[1].Fields["player_id"] != "player-1" {
+		t.Errorf("Logger context fields should be kept, got %v", entries[1].Fields)
+	}
+
+	warnings := store.Entries("game-1", zapcore.WarnLevel)
+	if len(warnings) != 1 || warnings[0].Message != "Action rejected" {
+		t.Errorf("Level filter should keep only the warning, got %+v", warnings)
+	}
+
+	if len(store.Entries("game-2", zapcore.DebugLevel)) != 1 {
+		t.Error("A game_id field on the entry itself should route the line")
+	}
+
+	store.Forget("game-1")
+	if len(store.Entries("game-1", zapcore.DebugLevel)) != 0 {
+		t.Error("Forgotten games should have no entries")
+	}
+}
+
+func TestGameLogStore_KeepsMostRecentLines(t *testing.T) {
+	store := logger.NewGameLogStore(3, 2, logger.DefaultMaxGameLogBytes)
+	log := zap.New(store.Core(zapcore.DebugLevel))
+
+	gameLog := log.With(zap.String("game_id", "game-1"))
+	for i := 1; i <= 5; i++ {
+		gameLog.Info(fmt.Sprintf("line %d", i))
+	}
+
+	entries := store.Entries("game-1", zapcore.DebugLevel)
+	if len(entries) != 3 || entries[0].Message != "line 3" || entries[2].Message != "line 5" {
+		t.Errorf("Ring buffer should keep the last 3 lines oldest first, got %+v", entries)
+	}
+
+	log.Info("first", zap.String("game_id", "game-2"))
+	log.Info("first", zap.String("game_id", "game-3"))
+	if len(store.Entries("game-1", zapcore.DebugLevel)) != 0 {
+		t.Error("The oldest game's buffer should be dropped past the game limit")
+	}
+	if len(store.Entries("game-3", zapcore.DebugLevel)) != 1 {
+		t.Error("The newest game should have its buffer")
+	}
+}
+
+func TestGameLogStore_StaysWithinByteBudget(t *testing.T) {
+	line := strings.Repeat("x", 1000)
+	store := logger.NewGameLogStore(100, 10, 10_000)
+	log := zap.New(store.Core(zapcore.DebugLevel))
+
+	for i := 0; i < 5; i++ {
+		log.Info(line, zap.String("game_id", "game-1"))
+	}
+	for i := 0; i < 8; i++ {
+		log.Info(line, zap.String("game_id", "game-2"))
+	}
+	if store.Bytes() > 10_000 {
+		t.Errorf("Buffers should stay within the byte budget, hold %d bytes", store.Bytes())
+	}
+	if len(store.Entries("game-1", zapcore.DebugLevel)) != 0 {
+		t.Error("The oldest game's buffer should be dropped first")
+	}
+	if len(store.Entries("game-2", zapcore.DebugLevel)) != 8 {
+		t.Error("The game being recorded should keep its lines while others can be dropped")
+	}
+
+	for i := 0; i < 20; i++ {
+		log.Info(line, zap.String("game_id", "game-2"))
+	}
+	kept := store.Entries("game-2", zapcore.DebugLevel)
+	if len(kept) == 0 || len(kept) >= 20 || store.Bytes() > 10_000 {
+		t.Errorf("A lone game should lose its oldest lines to the budget, kept %d (%d bytes)", len(kept), store.Bytes())
+	}
+
+	log.Info(strings.Repeat("x", 20_000), zap.String("game_id", "game-3"))
+	if len(store.Entries("game-3", zapcore.DebugLevel)) != 0 {
+		t.Error("A line larger than the whole budget should not be kept")
+	}
+
+	store.Forget("game-2")
+	if store.Bytes() != 0 {
+		t.Errorf("Forgetting every game should free every byte, %d left", store.Bytes())
+	}
+}
+
+func TestGameLogStore_RecordsNothingWhenDisabled(t *testing.T) {
+	store := logger.NewGameLogStore(10, 10, logger.DefaultMaxGameLogBytes)
+	log := zap.New(store.Core(zapcore.DebugLevel))
+	log.Info("before", zap.String("game_id", "game-1"))
+
+	store.Configure(false, logger.DefaultMaxGameLogBytes)
+	log.Info("while off", zap.String("game_id", "game-1"))
+	if len(store.Entries("game-1", zapcore.DebugLevel)) != 0 || store.Bytes() != 0 {
+		t.Error("Turning recording off should drop and stop recording lines")
+	}
+
+	store.Configure(true, logger.DefaultMaxGameLogBytes)
+	log.Info("after", zap.String("game_id", "game-1"))
+	entries := store.Entries("game-1", zapcore.DebugLevel)
+	if len(entries) != 1 || entries[0].Message != "after" {
+		t.Errorf("Recording should resume once turned on, got %+v", entries)
+	}
+}
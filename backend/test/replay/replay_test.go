@@ -0,0 +1,111 @@
+package replay_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	gameAction "terraforming-mars-backend/internal/action/game"
+	turnAction "terraforming-mars-backend/internal/action/turn_management"
+	"terraforming-mars-backend/internal/archive"
+	"terraforming-mars-backend/internal/cards"
+	"terraforming-mars-backend/internal/delivery/dto"
+	"terraforming-mars-backend/internal/game"
+	"terraforming-mars-backend/internal/game/deck"
+	"terraforming-mars-backend/internal/replay"
+	"terraforming-mars-backend/test/testutil"
+)
+
+// playedRecord plays a two-player game up to its starting selection, journaling each input as the hub
+// would, and archives it: the second join lets the server pick the player ID
+func playedRecord(t *testing.T, cardRegistry cards.CardRegistry) archive.Record {
+	t.Helper()
+	ctx := context.Background()
+	repo := game.NewInMemoryGameRepository()
+	stateRepo := game.NewInMemoryGameStateRepository()
+	logger := testutil.TestLogger()
+
+	g, err := gameAction.NewCreateGameAction(repo, cardRegistry, logger).Execute(ctx, game.GameSettings{
+		MaxPlayers: 2,
+		CardPacks:  []string{"base"},
+	})
+	testutil.AssertNoError(t, err, "Failed to create game")
+
+	record := func(playerID string, messageType dto.MessageType, payload map[string]interface{}) {
+		data, err := json.Marshal(payload)
+		testutil.AssertNoError(t, err, "Payload should marshal")
+		_, err = stateRepo.RecordInput(ctx, g.ID(), g, playerID, string(messageType), data)
+		testutil.AssertNoError(t, err, "Failed to record input")
+	}
+
+	joinAction := gameAction.NewJoinGameAction(repo, cardRegistry, logger)
+	_, err = joinAction.Execute(ctx, g.ID(), "Alice", "player-1")
+	testutil.AssertNoError(t, err, "Failed to join game")
+	record("player-1", dto.MessageTypeJoinGame, map[string]interface{}{"gameId": g.ID(), "playerName": "Alice", "playerId": "player-1"})
+
+	_, err = joinAction.Execute(ctx, g.ID(), "Bob", "generated-2")
+	testutil.AssertNoError(t, err, "Failed to join game")
+	record("generated-2", dto.MessageTypeJoinGame, map[string]interface{}{"gameId": g.ID(), "playerName": "Bob"})
+
+	err = turnAction.NewStartGameAction(repo, cardRegistry, logger).Execute(ctx, g.ID(), "player-1")
+	testutil.AssertNoError(t, err, "Failed to start game")
+	record("player-1", dto.MessageTypeActionStartGame, map[string]interface{}{})
+
+	inputs, err := stateRepo.GetInputs(ctx, g.ID())
+	testutil.AssertNoError(t, err, "Failed to read inputs")
+	seed, order, ok := g.Deck().Reveal()
+	testutil.AssertTrue(t, ok, "Deck should be seeded")
+
+	return archive.Record{
+		GameID:   g.ID(),
+		Settings: g.Settings(),
+		Inputs:   inputs,
+		ShuffleProof: &archive.ShuffleProof{
+			Algorithm:  deck.ShuffleAlgorithm,
+			Commitment: g.Deck().Commitment(),
+			Seed:       seed.String(),
+			Order:      order,
+		},
+	}
+}
+
+func TestRun_ReproducesARecordedGame(t *testing.T) {
+	cardRegistry := testutil.CreateTestCardRegistry()
+	record := playedRecord(t, cardRegistry)
+
+	result, err := replay.Run(context.Background(), cardRegistry, record, testutil.TestLogger())
+	testutil.AssertNoError(t, err, "Replay should run")
+	testutil.AssertEqual(t, 3, result.Replayed, "Every input replayed")
+	testutil.AssertTrue(t, result.Divergence == nil, "Seats, dealt cards and the rest of the state match the recording")
+}
+
+func TestRun_ReportsTheFirstDivergentInput(t *testing.T) {
+	cardRegistry := testutil.CreateTestCardRegistry()
+	record := playedRecord(t, cardRegistry)
+	record.Inputs[2].State.Players["player-1"].Credits += 5
+
+	result, err := replay.Run(context.Background(), cardRegistry, record, testutil.TestLogger())
+	testutil.AssertNoError(t, err, "Replay should run")
+	testutil.AssertTrue(t, result.Divergence != nil, "Replay should diverge")
+	testutil.AssertEqual(t, int64(3), result.Divergence.Input.Sequence, "The start is the first divergent input")
+	testutil.AssertEqual(t, string(dto.MessageTypeActionStartGame), result.Divergence.Input.Type, "Divergent input is reported")
+
+	credits := result.Divergence.Changes.PlayerChanges["player-1"].Credits
+	testutil.AssertEqual(t, -5, credits.New-credits.Old, "Changes run from the recorded state to the replayed one")
+}
+
+func TestRun_RejectsRecordsItCannotReplay(t *testing.T) {
+	cardRegistry := testutil.CreateTestCardRegistry()
+	ctx := context.Background()
+
+	record := playedRecord(t, cardRegistry)
+	record.Inputs = nil
+	_, err := replay.Run(ctx, cardRegistry, record, testutil.TestLogger())
+	testutil.AssertTrue(t, errors.Is(err, replay.ErrNoInputs), "Games archived without inputs cannot be replayed")
+
+	record = playedRecord(t, cardRegistry)
+	record.ShuffleProof.Commitment = "tampered"
+	_, err = replay.Run(ctx, cardRegistry, record, testutil.TestLogger())
+	testutil.AssertTrue(t, errors.Is(err, replay.ErrCardPoolChanged), "A different card pool would deal different cards")
+}
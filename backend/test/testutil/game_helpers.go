@@ -92,3 +92,9 @@ func AddPlayerHeat(ctx context.Context, p *player.Player, amount int) {
 	}
 	p.Resources().Add(changes)
 }
+
+// RunInGame runs queued game work right away, standing in for the hub's game queue in action tests
+func RunInGame(ctx context.Context, gameID string, fn func(ctx context.Context)) error {
+	fn(ctx)
+	return nil
+}
@@ -0,0 +1,107 @@
+package testutil
+
+import (
+	"encoding/json"
+	"sort"
+	"testing"
+
+	"terraforming-mars-backend/internal/game"
+)
+
+// HiddenCardIDs returns the card IDs recipientID must not see in the game's current state:
+// other players' hands and pending selections, and every card still in the deck
+// Cards that are already public (played cards and chosen corporations) are never hidden
+func HiddenCardIDs(g *game.Game, recipientID string) map[string]string {
+	hidden := make(map[string]string)
+	hide := func(owner string, cardIDs []string) {
+		for _, cardID := range cardIDs {
+			hidden[cardID] = owner
+		}
+	}
+
+	deck := g.Deck()
+	if deck != nil {
+		hide("deck", deck.ProjectCards())
+		hide("deck", deck.Corporations())
+		hide("deck", deck.PreludeCards())
+	}
+
+	players := g.GetAllPlayers()
+	for _, p := range players {
+		if p.ID() == recipientID {
+			continue
+		}
+		owner := "hand or selection of " + p.ID()
+		hide(owner, p.Hand().Cards())
+		hide(owner, p.Preludes())
+		if selection := p.Selection().GetPendingCardSelection(); selection != nil {
+			hide(owner, selection.AvailableCards)
+		}
+		if selection := p.Selection().GetPendingCardDrawSelection(); selection != nil {
+			hide(owner, selection.AvailableCards)
+		}
+		if phase := g.GetSelectStartingCardsPhase(p.ID()); phase != nil {
+			hide(owner, phase.AvailableCards)
+			hide(owner, phase.AvailableCorporations)
+			hide(owner, phase.AvailablePreludes)
+		}
+		if phase := g.GetProductionPhase(p.ID()); phase != nil {
+			hide(owner, phase.AvailableCards)
+		}
+	}
+
+	for _, p := range players {
+		for _, cardID := range p.PlayedCards().Cards() {
+			delete(hidden, cardID)
+		}
+		if p.HasCorporation() {
+			delete(hidden, p.CorporationID())
+		}
+	}
+
+	return hidden
+}
+
+// AssertNoHiddenCards fails if the serialized payload sent to recipientID mentions a card it must not see
+// Every string value and object key in the JSON is checked against HiddenCardIDs
+func AssertNoHiddenCards(t *testing.T, g *game.Game, recipientID string, payload []byte, context string) {
+	t.Helper()
+
+	var decoded any
+	if err := json.Unmarshal(payload, &decoded); err != nil {
+		t.Fatalf("%s: payload is not JSON: %v", context, err)
+	}
+
+	hidden := HiddenCardIDs(g, recipientID)
+	leaked := make(map[string]string)
+	collectHiddenStrings(decoded, hidden, leaked)
+
+	cardIDs := make([]string, 0, len(leaked))
+	for cardID := range leaked {
+		cardIDs = append(cardIDs, cardID)
+	}
+	sort.Strings(cardIDs)
+	for _, cardID := range cardIDs {
+		t.Errorf("%s: payload for %s contains %s from the %s", context, recipientID, cardID, leaked[cardID])
+	}
+}
+
+func collectHiddenStrings(value any, hidden, leaked map[string]string) {
+	switch v := value.(type) {
+	case string:
+		if owner, ok := hidden[v]; ok {
+			leaked[v] = owner
+		}
+	case []any:
+		for _, item := range v {
+			collectHiddenStrings(item, hidden, leaked)
+		}
+	case map[string]any:
+		for key, item := range v {
+			if owner, ok := hidden[key]; ok {
+				leaked[key] = owner
+			}
+			collectHiddenStrings(item, hidden, leaked)
+		}
+	}
+}
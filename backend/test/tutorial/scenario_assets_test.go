@@ -0,0 +1,72 @@
+package tutorial_test
+
+import (
+	"testing"
+
+	"terraforming-mars-backend/internal/cards"
+	"terraforming-mars-backend/internal/tutorial"
+)
+
+func TestTutorialScenarioAssets(t *testing.T) {
+	checkScenarioAssets(t, "../../assets/tutorials")
+}
+
+func TestPuzzleAssets(t *testing.T) {
+	scenarios := checkScenarioAssets(t, "../../assets/puzzles")
+	for _, scenario := range scenarios {
+		if !scenario.IsPuzzle() {
+			t.Errorf("Puzzle %s has no victory condition", scenario.ID)
+		}
+	}
+}
+
+// checkScenarioAssets loads a scenario directory and verifies every referenced card exists
+func checkScenarioAssets(t *testing.T, dir string) []tutorial.Scenario {
+	t.Helper()
+
+	scenarios, err := tutorial.LoadScenariosFromDir(dir)
+	if err != nil {
+		t.Fatalf("Failed to load scenarios from %s: %v", dir, err)
+	}
+	if len(scenarios) == 0 {
+		t.Fatalf("Expected at least one scenario in %s", dir)
+	}
+
+	allCards, err := cards.LoadCardsFromJSON("../../assets/terraforming_mars_cards.json")
+	if err != nil {
+		t.Fatalf("Failed to load cards: %v", err)
+	}
+	registry := cards.NewInMemoryCardRegistry(allCards)
+
+	for _, scenario := range scenarios {
+		if scenario.Setup.CorporationID != nil {
+			if _, err := registry.GetByID(*scenario.Setup.CorporationID); err != nil {
+				t.Errorf("Scenario %s: unknown corporation %s", scenario.ID, *scenario.Setup.CorporationID)
+			}
+		}
+		for _, cardID := range scenario.Setup.CardIDs {
+			if _, err := registry.GetByID(cardID); err != nil {
+				t.Errorf("Scenario %s: unknown card %s", scenario.ID, cardID)
+			}
+		}
+		for _, step := range scenario.Steps {
+			if step.Objective.CardID == "" {
+				continue
+			}
+			if _, err := registry.GetByID(step.Objective.CardID); err != nil {
+				t.Errorf("Scenario %s step %s: unknown card %s", scenario.ID, step.ID, step.Objective.CardID)
+			}
+		}
+	}
+	return scenarios
+}
+
+func TestScenarioValidate_RejectsUnknownObjective(t *testing.T) {
+	scenario := tutorial.Scenario{
+		ID:    "bad",
+		Steps: []tutorial.Step{{ID: "x", Objective: tutorial.Objective{Type: "win-the-game"}}},
+	}
+	if err := scenario.Validate(); err == nil {
+		t.Error("Expected unknown objective type to be rejected")
+	}
+}
@@ -0,0 +1,85 @@
+package webhook_test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"terraforming-mars-backend/internal/webhook"
+	"terraforming-mars-backend/test/testutil"
+)
+
+type delivery struct {
+	event     string
+	signature string
+	body      []byte
+}
+
+func recordingServer(t *testing.T) (*httptest.Server, chan delivery) {
+	t.Helper()
+	received := make(chan delivery, 4)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		received <- delivery{event: r.Header.Get(webhook.EventHeader), signature: r.Header.Get(webhook.SignatureHeader), body: body}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	t.Cleanup(server.Close)
+	return server, received
+}
+
+func awaitDelivery(t *testing.T, received chan delivery) delivery {
+	t.Helper()
+	select {
+	case d := <-received:
+		return d
+	case <-time.After(5 * time.Second):
+		t.Fatal("Webhook was not delivered")
+		return delivery{}
+	}
+}
+
+func TestParseEndpoints(t *testing.T) {
+	endpoints, err := webhook.ParseEndpoints(" https://example.org/hook , discord:https://discord.com/api/webhooks/1/abc,")
+	testutil.AssertNoError(t, err, "Valid endpoints should parse")
+	testutil.AssertEqual(t, 2, len(endpoints), "Empty entries are skipped")
+	testutil.AssertEqual(t, webhook.Endpoint{URL: "https://example.org/hook", Format: webhook.FormatJSON}, endpoints[0], "Plain URLs get JSON payloads")
+	testutil.AssertEqual(t, webhook.Endpoint{URL: "https://discord.com/api/webhooks/1/abc", Format: webhook.FormatDiscord}, endpoints[1], "discord: URLs get Discord messages")
+
+	_, err = webhook.ParseEndpoints("ftp://example.org")
+	testutil.AssertError(t, err, "Only HTTP URLs are accepted")
+}
+
+func TestSender_SignsJSONAndPostsDiscordMessages(t *testing.T) {
+	jsonServer, jsonReceived := recordingServer(t)
+	discordServer, discordReceived := recordingServer(t)
+
+	sender := webhook.NewSender([]webhook.Endpoint{
+		{URL: jsonServer.URL, Format: webhook.FormatJSON},
+		{URL: discordServer.URL, Format: webhook.FormatDiscord},
+	}, "s3cret", testutil.TestLogger())
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go sender.Run(ctx)
+
+	sender.Enqueue(webhook.Payload{
+		Event:   webhook.EventGameCreated,
+		Game:    webhook.GameSummary{ID: "game-1", Status: "lobby", MaxPlayers: 4},
+		Summary: "New lobby open",
+	})
+
+	d := awaitDelivery(t, jsonReceived)
+	testutil.AssertEqual(t, "game.created", d.event, "The event is named in a header")
+	testutil.AssertEqual(t, webhook.Sign([]byte("s3cret"), d.body), d.signature, "The body is signed with the shared secret")
+	var payload webhook.Payload
+	testutil.AssertNoError(t, json.Unmarshal(d.body, &payload), "The body is a JSON payload")
+	testutil.AssertEqual(t, "game-1", payload.Game.ID, "The payload names the game")
+
+	d = awaitDelivery(t, discordReceived)
+	var message map[string]string
+	testutil.AssertNoError(t, json.Unmarshal(d.body, &message), "The body is a Discord message")
+	testutil.AssertEqual(t, "New lobby open", message["content"], "Discord gets the summary line")
+}
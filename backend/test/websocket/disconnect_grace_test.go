@@ -0,0 +1,89 @@
+package websocket_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"terraforming-mars-backend/internal/delivery/dto"
+	"terraforming-mars-backend/internal/delivery/websocket/core"
+)
+
+// disconnectRecorder reports each disconnect the hub hands to its handler
+type disconnectRecorder struct {
+	disconnected chan string
+}
+
+func (h *disconnectRecorder) HandleMessage(ctx context.Context, connection *core.Connection, message dto.WebSocketMessage) {
+	h.disconnected <- connection.PlayerID
+}
+
+func startGraceHub(t *testing.T, grace time.Duration) (*core.Hub, *disconnectRecorder) {
+	t.Helper()
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	hub := core.NewHub()
+	hub.SetDisconnectGrace(grace)
+	recorder := &disconnectRecorder{disconnected: make(chan string, 4)}
+	hub.RegisterHandler(dto.MessageTypePlayerDisconnected, recorder)
+	go hub.Run(ctx)
+	return hub, recorder
+}
+
+func joinVirtual(hub *core.Hub, id, playerID, gameID string) *core.Connection {
+	conn := core.NewVirtualConnection(id, hub.GetManager())
+	conn.SetPlayer(playerID, gameID)
+	hub.Register <- conn
+	hub.RegisterConnectionWithGame(conn, gameID)
+	return conn
+}
+
+func TestDisconnectGrace_HandlesDisconnectAfterTheWindow(t *testing.T) {
+	hub, recorder := startGraceHub(t, 100*time.Millisecond)
+	conn := joinVirtual(hub, "conn-1", "player-1", "game-1")
+
+	hub.Unregister <- conn
+
+	select {
+	case <-recorder.disconnected:
+		t.Fatal("Disconnect should wait out the grace period")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	select {
+	case playerID := <-recorder.disconnected:
+		if playerID != "player-1" {
+			t.Fatalf("Expected player-1 to be disconnected, got %s", playerID)
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("Disconnect was never handled")
+	}
+}
+
+func TestDisconnectGrace_ReconnectWithinTheWindowIsInvisible(t *testing.T) {
+	hub, recorder := startGraceHub(t, 100*time.Millisecond)
+	conn := joinVirtual(hub, "conn-1", "player-1", "game-1")
+
+	hub.Unregister <- conn
+	joinVirtual(hub, "conn-2", "player-1", "game-1")
+
+	select {
+	case <-recorder.disconnected:
+		t.Fatal("A player who came back in time should not be disconnected")
+	case <-time.After(300 * time.Millisecond):
+	}
+}
+
+func TestDisconnectGrace_ZeroDisconnectsRightAway(t *testing.T) {
+	hub, recorder := startGraceHub(t, 0)
+	conn := joinVirtual(hub, "conn-1", "player-1", "game-1")
+
+	hub.Unregister <- conn
+
+	select {
+	case <-recorder.disconnected:
+	case <-time.After(2 * time.Second):
+		t.Fatal("Disconnect should be handled immediately without a grace period")
+	}
+}
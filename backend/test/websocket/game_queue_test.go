@@ -0,0 +1,341 @@
+package websocket_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"terraforming-mars-backend/internal/delivery/dto"
+	"terraforming-mars-backend/internal/delivery/websocket/core"
+	"terraforming-mars-backend/internal/game"
+	"terraforming-mars-backend/test/testutil"
+)
+
+// orderingHandler records the order messages are handled in and can hold one game's messages
+type orderingHandler struct {
+	mu      sync.Mutex
+	handled map[string][]string // gameID -> message IDs
+	active  map[string]int      // gameID -> handlers running right now
+	overlap bool
+	hold    map[string]chan struct{}
+	done    chan string
+	hub     *core.Hub
+	seqs    map[string][]int64
+}
+
+func (h *orderingHandler) HandleMessage(ctx context.Context, connection *core.Connection, message dto.WebSocketMessage) {
+	gameID := message.GameID
+
+	h.mu.Lock()
+	h.active[gameID]++
+	if h.active[gameID] > 1 {
+		h.overlap = true
+	}
+	hold := h.hold[gameID]
+	h.mu.Unlock()
+
+	if hold != nil {
+		<-hold
+	}
+
+	h.mu.Lock()
+	h.handled[gameID] = append(h.handled[gameID], message.Payload.(string))
+	h.seqs[gameID] = append(h.seqs[gameID], h.hub.ActionSequence(gameID))
+	h.active[gameID]--
+	h.mu.Unlock()
+
+	h.done <- gameID
+}
+
+func TestHub_ProcessesEachGameInOrder(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	hub := core.NewHub()
+	handler := &orderingHandler{
+		handled: make(map[string][]string),
+		active:  make(map[string]int),
+		hold:    map[string]chan struct{}{"game-slow": make(chan struct{})},
+		done:    make(chan string, 64),
+		hub:     hub,
+		seqs:    make(map[string][]int64),
+	}
+	hub.RegisterHandler("test-action", handler)
+	go hub.Run(ctx)
+
+	conns := []*core.Connection{
+		core.NewVirtualConnection("conn-1", hub.GetManager()),
+		core.NewVirtualConnection("conn-2", hub.GetManager()),
+	}
+	const perGame = 10
+	for i := 0; i < perGame; i++ {
+		for _, gameID := range []string{"game-slow", "game-fast"} {
+			hub.Messages <- core.HubMessage{
+				Connection: conns[i%2],
+				Message:    dto.WebSocketMessage{Type: "test-action", GameID: gameID, Payload: fmt.Sprintf("%s-%d", gameID, i)},
+			}
+		}
+	}
+
+	// The fast game finishes while the slow game's first action is still blocked
+	for i := 0; i < perGame; i++ {
+		select {
+		case gameID := <-handler.done:
+			testutil.AssertEqual(t, "game-fast", gameID, "Only the fast game should make progress")
+		case <-time.After(2 * time.Second):
+			t.Fatal("Fast game was stalled by the slow one")
+		}
+	}
+
+	close(handler.hold["game-slow"])
+	for i := 0; i < perGame; i++ {
+		select {
+		case <-handler.done:
+		case <-time.After(2 * time.Second):
+			t.Fatal("Slow game did not finish")
+		}
+	}
+
+	handler.mu.Lock()
+	defer handler.mu.Unlock()
+	testutil.AssertFalse(t, handler.overlap, "Two messages for the same game should never be handled at once")
+	for _, gameID := range []string{"game-slow", "game-fast"} {
+		for i, id := range handler.handled[gameID] {
+			testutil.AssertEqual(t, fmt.Sprintf("%s-%d", gameID, i), id, "Messages should be handled in arrival order")
+			testutil.AssertEqual(t, int64(i+1), handler.seqs[gameID][i], "Action sequence should count messages per game")
+		}
+	}
+}
+
+func TestHub_RunInGameQueueWaitsBehindActions(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	hub := core.NewHub()
+	handler := &orderingHandler{
+		handled: make(map[string][]string),
+		active:  make(map[string]int),
+		hold:    map[string]chan struct{}{"game-1": make(chan struct{})},
+		done:    make(chan string, 8),
+		hub:     hub,
+		seqs:    make(map[string][]int64),
+	}
+	hub.RegisterHandler("test-action", handler)
+	go hub.Run(ctx)
+
+	hub.Messages <- core.HubMessage{
+		Connection: core.NewVirtualConnection("conn-1", hub.GetManager()),
+		Message:    dto.WebSocketMessage{Type: "test-action", GameID: "game-1", Payload: "action-1"},
+	}
+
+	ran := make(chan []string, 1)
+	go func() {
+		_ = hub.RunInGameQueue(ctx, "game-1", func(context.Context) {
+			handler.mu.Lock()
+			defer handler.mu.Unlock()
+			ran <- append([]string(nil), handler.handled["game-1"]...)
+		})
+	}()
+
+	select {
+	case <-ran:
+		t.Fatal("Queued work ran while an action was in progress")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	close(handler.hold["game-1"])
+	select {
+	case handled := <-ran:
+		testutil.AssertEqual(t, 1, len(handled), "Queued work runs after the pending action")
+	case <-time.After(2 * time.Second):
+		t.Fatal("Queued work never ran")
+	}
+	testutil.AssertEqual(t, int64(1), hub.ActionSequence("game-1"), "Queued work does not count as an action")
+}
+
+// deadlineHandler reports when its message's context ends and then keeps running until released
+type deadlineHandler struct {
+	expired chan struct{}
+	release chan struct{}
+}
+
+func (h *deadlineHandler) HandleMessage(ctx context.Context, connection *core.Connection, message dto.WebSocketMessage) {
+	<-ctx.Done()
+	close(h.expired)
+	<-h.release
+}
+
+func TestHub_WaitsForMessagePastItsDeadline(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	hub := core.NewHub()
+	hub.SetActionTimeout(20 * time.Millisecond)
+	handler := &deadlineHandler{expired: make(chan struct{}), release: make(chan struct{})}
+	hub.RegisterHandler("test-action", handler)
+	go hub.Run(ctx)
+
+	conn := core.NewVirtualConnection("conn-1", hub.GetManager())
+	hub.Messages <- core.HubMessage{
+		Connection: conn,
+		Message:    dto.WebSocketMessage{Type: "test-action", GameID: "game-1", Payload: "slow"},
+	}
+
+	ran := make(chan struct{})
+	go func() {
+		_ = hub.RunInGameQueue(ctx, "game-1", func(context.Context) { close(ran) })
+	}()
+
+	select {
+	case <-handler.expired:
+	case <-time.After(2 * time.Second):
+		t.Fatal("The message's context never reached its deadline")
+	}
+	select {
+	case <-ran:
+		t.Fatal("Queued work ran while the message past its deadline was still running")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	close(handler.release)
+	select {
+	case <-ran:
+	case <-time.After(2 * time.Second):
+		t.Fatal("Queued work never ran after the message returned")
+	}
+	testutil.AssertEqual(t, 0, len(drain(conn)), "The queue leaves reporting the deadline to the action")
+}
+
+func TestHub_RunInGameQueueGetsTheActionDeadline(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	hub := core.NewHub()
+	hub.SetActionTimeout(20 * time.Millisecond)
+	go hub.Run(ctx)
+
+	expired := false
+	err := hub.RunInGameQueue(ctx, "game-1", func(workCtx context.Context) {
+		select {
+		case <-workCtx.Done():
+			expired = true
+		case <-time.After(2 * time.Second):
+		}
+	})
+	testutil.AssertNoError(t, err, "Queued work should run")
+	testutil.AssertTrue(t, expired, "Work queued from outside a connection gets the action deadline")
+
+	untimed := core.NewHub()
+	go untimed.Run(ctx)
+	callerCtx, callerCancel := context.WithCancel(ctx)
+	started := make(chan struct{})
+	stopped := make(chan struct{})
+	go func() {
+		_ = untimed.RunInGameQueue(callerCtx, "game-1", func(workCtx context.Context) {
+			close(started)
+			<-workCtx.Done()
+			close(stopped)
+		})
+	}()
+	<-started
+	callerCancel()
+	select {
+	case <-stopped:
+	case <-time.After(2 * time.Second):
+		t.Fatal("Queued work kept running after its caller gave up")
+	}
+}
+
+func TestHub_FailsGameWhoseMessageHangs(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	hub := core.NewHub()
+	hub.SetHangTimeout(50 * time.Millisecond)
+	failed := make(chan string, 1)
+	hub.SetGameFailedHandler(func(gameID string) { failed <- gameID })
+	wedged := make(chan struct{})
+	defer close(wedged)
+	handler := &orderingHandler{
+		handled: make(map[string][]string),
+		active:  make(map[string]int),
+		hold:    map[string]chan struct{}{"game-1": wedged},
+		done:    make(chan string, 8),
+		hub:     hub,
+		seqs:    make(map[string][]int64),
+	}
+	hub.RegisterHandler("test-action", handler)
+	go hub.Run(ctx)
+
+	conn := core.NewVirtualConnection("conn-1", hub.GetManager())
+	hub.Messages <- core.HubMessage{
+		Connection: conn,
+		Message:    dto.WebSocketMessage{Type: "test-action", GameID: "game-1", Payload: "wedged"},
+	}
+
+	refused := make(chan error, 1)
+	ran := false
+	go func() {
+		refused <- hub.RunInGameQueue(ctx, "game-1", func(context.Context) { ran = true })
+	}()
+
+	select {
+	case gameID := <-failed:
+		testutil.AssertEqual(t, "game-1", gameID, "The hung game is handed to the failure handler")
+	case <-time.After(2 * time.Second):
+		t.Fatal("The hung game was never marked failed")
+	}
+	select {
+	case err := <-refused:
+		testutil.AssertTrue(t, errors.Is(err, game.ErrGameFailed), "Work waiting behind the hung message is refused")
+	case <-time.After(2 * time.Second):
+		t.Fatal("Work waiting behind the hung message was never refused")
+	}
+	testutil.AssertFalse(t, ran, "Refused work does not run")
+
+	messages := drain(conn)
+	testutil.AssertEqual(t, 1, len(messages), "The sender should hear the game failed")
+	payload := messages[0].Payload.(dto.ErrorPayload)
+	testutil.AssertEqual(t, dto.ErrCodeGameFailed, payload.Code, "Failed games carry their own error code")
+
+	err := hub.RunInGameQueue(ctx, "game-1", func(context.Context) {})
+	testutil.AssertTrue(t, errors.Is(err, game.ErrGameFailed), "A failed game takes no further work")
+}
+
+func TestHub_DeletingAGameRemovesItsQueue(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	hub := core.NewHub()
+	handler := &orderingHandler{
+		handled: make(map[string][]string),
+		active:  make(map[string]int),
+		hold:    map[string]chan struct{}{},
+		done:    make(chan string, 8),
+		hub:     hub,
+		seqs:    make(map[string][]int64),
+	}
+	hub.RegisterHandler("test-action", handler)
+	go hub.Run(ctx)
+
+	repo := game.NewInMemoryGameRepository()
+	repo.OnDelete(hub.RemoveGameQueue)
+	testutil.AssertNoError(t, repo.Create(ctx, game.NewGame("game-1", "", game.GameSettings{MaxPlayers: 2})), "Game should be created")
+
+	hub.Messages <- core.HubMessage{
+		Connection: core.NewVirtualConnection("conn-1", hub.GetManager()),
+		Message:    dto.WebSocketMessage{Type: "test-action", GameID: "game-1", Payload: "action-1"},
+	}
+	select {
+	case <-handler.done:
+	case <-time.After(2 * time.Second):
+		t.Fatal("Action never ran")
+	}
+	testutil.AssertEqual(t, int64(1), hub.ActionSequence("game-1"), "The game has a queue while it exists")
+
+	testutil.AssertNoError(t, repo.Delete(ctx, "game-1"), "Game should be deleted")
+	testutil.AssertEqual(t, int64(0), hub.ActionSequence("game-1"), "The queue goes with the game")
+}
@@ -0,0 +1,120 @@
+package websocket_test
+
+import (
+	"context"
+	"testing"
+
+	connaction "terraforming-mars-backend/internal/action/connection"
+	"terraforming-mars-backend/internal/delivery/dto"
+	"terraforming-mars-backend/internal/delivery/websocket/core"
+	"terraforming-mars-backend/internal/delivery/websocket/handler/connection"
+	"terraforming-mars-backend/test/testutil"
+)
+
+// recordingBroadcaster records the player IDs broadcast to
+type recordingBroadcaster struct {
+	playerIDs []string
+}
+
+func (b *recordingBroadcaster) BroadcastGameState(gameID string, playerIDs []string) {
+	b.playerIDs = append(b.playerIDs, playerIDs...)
+}
+
+func TestConnection_ControlPlayerRequiresJoin(t *testing.T) {
+	manager := core.NewManager()
+	conn := core.NewVirtualConnection("conn-1", manager)
+
+	conn.SetPlayer("player-1", "game-1")
+	conn.SetPlayer("player-2", "game-1")
+
+	testutil.AssertTrue(t, conn.ControlsPlayer("player-1"), "First joined seat should stay authorized")
+	testutil.AssertTrue(t, conn.ControlsPlayer("player-2"), "Second joined seat should be authorized")
+	testutil.AssertNoError(t, conn.ControlPlayer("player-1"), "Should switch to an authorized seat")
+	testutil.AssertEqual(t, "player-1", conn.PlayerID, "Active seat should switch")
+
+	testutil.AssertError(t, conn.ControlPlayer("player-3"), "Should reject a seat never joined")
+	testutil.AssertEqual(t, "player-1", conn.PlayerID, "Active seat should not change on rejection")
+}
+
+func TestConnection_JoiningAnotherGameResetsSeats(t *testing.T) {
+	manager := core.NewManager()
+	conn := core.NewVirtualConnection("conn-1", manager)
+
+	conn.SetPlayer("player-1", "game-1")
+	conn.SetPlayer("player-9", "game-2")
+
+	testutil.AssertFalse(t, conn.ControlsPlayer("player-1"), "Seats from the previous game should be dropped")
+	testutil.AssertEqual(t, 1, len(conn.ControlledPlayers()), "Only the new seat should be controlled")
+}
+
+func TestManager_RoutesInactiveHotseatPlayers(t *testing.T) {
+	manager := core.NewManager()
+	conn := core.NewVirtualConnection("conn-1", manager)
+	manager.RegisterConnection(conn)
+
+	conn.SetPlayer("player-1", "game-1")
+	conn.SetPlayer("player-2", "game-1")
+
+	testutil.AssertTrue(t, manager.GetConnectionByPlayerID("game-1", "player-1") == conn, "Inactive hotseat player should route to the shared connection")
+	testutil.AssertTrue(t, manager.GetConnectionByPlayerID("game-1", "player-2") == conn, "Active player should route to the connection")
+}
+
+func TestControlPlayerHandler(t *testing.T) {
+	manager := core.NewManager()
+	conn := core.NewVirtualConnection("conn-1", manager)
+	conn.SetPlayer("player-1", "game-1")
+	conn.SetPlayer("player-2", "game-1")
+
+	broadcaster := &recordingBroadcaster{}
+	handler := connection.NewControlPlayerHandler(broadcaster)
+
+	handler.HandleMessage(context.Background(), conn, dto.WebSocketMessage{
+		Type:    dto.MessageTypeControlPlayer,
+		Payload: map[string]any{"playerId": "player-1"},
+	})
+
+	testutil.AssertEqual(t, "player-1", conn.PlayerID, "Handler should switch the active seat")
+	testutil.AssertEqual(t, 1, len(broadcaster.playerIDs), "Should send state for the selected seat")
+
+	handler.HandleMessage(context.Background(), conn, dto.WebSocketMessage{
+		Type:    dto.MessageTypeControlPlayer,
+		Payload: map[string]any{"playerId": "intruder"},
+	})
+
+	testutil.AssertEqual(t, "player-1", conn.PlayerID, "Unauthorized seat should be rejected")
+	reply, ok := conn.Receive()
+	testutil.AssertTrue(t, ok, "Should queue a reply")
+	testutil.AssertEqual(t, dto.MessageTypeError, reply.Type, "Should reply with an error")
+}
+
+// countingBroadcaster counts broadcasts
+type countingBroadcaster struct {
+	calls int
+}
+
+func (b *countingBroadcaster) BroadcastGameState(gameID string, playerIDs []string) {
+	b.calls++
+}
+
+func TestPlayerDisconnectedHandler_DisconnectsEverySeatPastAFailure(t *testing.T) {
+	testGame, repo := testutil.CreateTestGameWithPlayers(t, 2, testutil.NewMockBroadcaster())
+	testutil.StartTestGame(t, testGame)
+	for _, p := range testGame.GetAllPlayers() {
+		p.SetConnected(true)
+	}
+
+	conn := core.NewVirtualConnection("conn-1", core.NewManager())
+	conn.SetPlayer("player-1", testGame.ID())
+	conn.SetPlayer("ghost", testGame.ID())
+	conn.SetPlayer("player-2", testGame.ID())
+
+	broadcaster := &countingBroadcaster{}
+	handler := connection.NewPlayerDisconnectedHandler(connaction.NewPlayerDisconnectedAction(repo, testutil.TestLogger()), broadcaster)
+	handler.HandleMessage(context.Background(), conn, dto.WebSocketMessage{Type: dto.MessageTypePlayerDisconnected})
+
+	for _, playerID := range []string{"player-1", "player-2"} {
+		p, _ := testGame.GetPlayer(playerID)
+		testutil.AssertFalse(t, p.IsConnected(), "Every real seat should be disconnected despite the failing one")
+	}
+	testutil.AssertEqual(t, 1, broadcaster.calls, "State should still be broadcast")
+}
@@ -0,0 +1,22 @@
+package websocket_test
+
+import (
+	"testing"
+
+	"terraforming-mars-backend/internal/delivery/dto"
+	"terraforming-mars-backend/internal/delivery/websocket/core"
+	"terraforming-mars-backend/test/testutil"
+)
+
+func TestConnection_LocalizesErrors(t *testing.T) {
+	conn := core.NewVirtualConnection("conn-1", core.NewManager())
+	conn.SetLocale("de")
+
+	conn.SendMessage(dto.WebSocketMessage{Type: dto.MessageTypeError, Payload: dto.ErrorPayload{Message: "game is paused"}})
+	messages := drain(conn)
+	testutil.AssertEqual(t, 1, len(messages), "Error should be sent")
+
+	payload := messages[0].Payload.(dto.ErrorPayload)
+	testutil.AssertEqual(t, dto.ErrCodeGamePaused, payload.Code, "Known errors get their code")
+	testutil.AssertEqual(t, "Das Spiel ist pausiert", payload.Message, "Message is in the connection's locale")
+}
@@ -0,0 +1,50 @@
+package websocket_test
+
+import (
+	"context"
+	"testing"
+
+	"terraforming-mars-backend/internal/delivery/dto"
+	wsdelivery "terraforming-mars-backend/internal/delivery/websocket"
+	"terraforming-mars-backend/internal/delivery/websocket/core"
+	"terraforming-mars-backend/internal/game"
+	"terraforming-mars-backend/test/testutil"
+)
+
+func TestPhaseGuard_RejectsActionsOutsideTheirPhases(t *testing.T) {
+	ctx := context.Background()
+	g, repo := testutil.CreateTestGameWithPlayers(t, 2, testutil.NewMockBroadcaster())
+	hub := core.NewHub()
+	conn := core.NewVirtualConnection("conn-1", hub.GetManager())
+	conn.SetPlayer("player-1", g.ID())
+	guard := wsdelivery.NewPhaseGuard(repo)
+
+	launch := dto.WebSocketMessage{Type: dto.MessageTypeActionLaunchAsteroid, GameID: g.ID()}
+	allowed := guard.Allow(ctx, conn, launch)
+	testutil.AssertFalse(t, allowed, "Standard projects are refused in the lobby")
+
+	messages := drain(conn)
+	testutil.AssertEqual(t, 1, len(messages), "Sender is told why")
+	payload, ok := messages[0].Payload.(dto.ErrorPayload)
+	testutil.AssertTrue(t, ok, "Refusal is an error payload")
+	testutil.AssertEqual(t, dto.ErrCodeWrongPhase, payload.Code, "Refusal carries ERR_WRONG_PHASE")
+	testutil.AssertEqual(t, 1, len(payload.ExpectedPhases), "Refusal names the legal phases")
+	testutil.AssertEqual(t, dto.GamePhaseAction, payload.ExpectedPhases[0], "Standard projects belong to the action phase")
+
+	testutil.AssertTrue(t, guard.Allow(ctx, conn, dto.WebSocketMessage{Type: dto.MessageTypeActionSetNote}), "Notes are open in every phase")
+	testutil.AssertTrue(t, guard.Allow(ctx, conn, dto.WebSocketMessage{Type: dto.MessageTypeSyncRequest}), "Messages outside the matrix pass")
+
+	testutil.StartTestGame(t, g)
+	testutil.AssertNoError(t, g.UpdatePhase(ctx, game.GamePhaseAction), "Failed to set phase")
+	testutil.AssertTrue(t, guard.Allow(ctx, conn, launch), "Standard projects pass in the action phase")
+	testutil.AssertFalse(t, guard.Allow(ctx, conn, dto.WebSocketMessage{Type: dto.MessageTypeActionStartGame}), "Starting is refused once the game runs")
+	testutil.AssertEqual(t, 1, len(drain(conn)), "Only the refusal is sent")
+}
+
+func TestActionPhaseMatrix_CoversTheCatalog(t *testing.T) {
+	for _, entry := range dto.ActionCatalog() {
+		_, covered := dto.AllowedPhases(entry.Type)
+		testutil.AssertTrue(t, covered, "Catalog action "+string(entry.Type)+" has a row in the phase matrix")
+		testutil.AssertTrue(t, len(entry.Phases) > 0, "Catalog action "+string(entry.Type)+" lists its phases")
+	}
+}
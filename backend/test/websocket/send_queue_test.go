@@ -0,0 +1,89 @@
+package websocket_test
+
+import (
+	"testing"
+
+	"terraforming-mars-backend/internal/delivery/dto"
+	"terraforming-mars-backend/internal/delivery/websocket/core"
+	"terraforming-mars-backend/test/testutil"
+)
+
+func gameUpdate(viewerID string, generation int) dto.WebSocketMessage {
+	return dto.WebSocketMessage{
+		Type:   dto.MessageTypeGameUpdated,
+		GameID: "game-1",
+		Payload: dto.GameUpdatedPayload{
+			Game: dto.GameDto{ViewingPlayerID: viewerID, Generation: generation},
+		},
+	}
+}
+
+func logUpdate() dto.WebSocketMessage {
+	return dto.WebSocketMessage{Type: dto.MessageTypeLogUpdate, GameID: "game-1", Payload: dto.LogUpdatePayload{}}
+}
+
+func drain(conn *core.Connection) []dto.WebSocketMessage {
+	var messages []dto.WebSocketMessage
+	for {
+		message, ok := conn.Receive()
+		if !ok {
+			return messages
+		}
+		messages = append(messages, message)
+	}
+}
+
+func TestSendQueue_CoalescesStatesPerViewer(t *testing.T) {
+	manager := core.NewManager()
+	conn := core.NewVirtualConnection("conn-1", manager)
+
+	conn.SendMessage(gameUpdate("player-1", 1))
+	conn.SendMessage(gameUpdate("player-2", 1))
+	conn.SendMessage(logUpdate())
+	conn.SendMessage(gameUpdate("player-1", 2))
+
+	messages := drain(conn)
+	testutil.AssertEqual(t, 3, len(messages), "Older state for the same viewer should be replaced")
+	testutil.AssertEqual(t, "player-2", messages[0].Payload.(dto.GameUpdatedPayload).Game.ViewingPlayerID, "Other viewer's state should be kept")
+	testutil.AssertEqual(t, dto.MessageTypeLogUpdate, messages[1].Type, "Logs should never be coalesced")
+	testutil.AssertEqual(t, 2, messages[2].Payload.(dto.GameUpdatedPayload).Game.Generation, "Latest state should be delivered last")
+
+	stats := manager.QueueMetrics().Snapshot()
+	testutil.AssertEqual(t, int64(4), stats.Queued, "Every message should be counted as queued")
+	testutil.AssertEqual(t, int64(1), stats.Coalesced, "One state should be coalesced")
+}
+
+func TestSendQueue_OverflowKeepsLatestStateAndFlagsSlowClient(t *testing.T) {
+	manager := core.NewManager()
+	conn := core.NewVirtualConnection("conn-1", manager)
+
+	// Nobody drains the queue, like a spectator on a stalled connection
+	sent := 0
+	for manager.QueueMetrics().Snapshot().Dropped == 0 {
+		conn.SendMessage(logUpdate())
+		sent++
+	}
+	stats := manager.QueueMetrics().Snapshot()
+	testutil.AssertEqual(t, int64(1), stats.SlowConnections, "Overflowing connection should be flagged slow")
+	testutil.AssertEqual(t, int64(1), stats.SlowEvents, "Slow spell should be counted once")
+
+	conn.SendMessage(gameUpdate("player-1", 7))
+	testutil.AssertEqual(t, int64(1), manager.QueueMetrics().Snapshot().Dropped, "State should make room instead of being dropped")
+
+	messages := drain(conn)
+	testutil.AssertEqual(t, sent-1, len(messages), "Queue should hold its capacity")
+	testutil.AssertEqual(t, 7, messages[len(messages)-1].Payload.(dto.GameUpdatedPayload).Game.Generation, "Latest state should be queued")
+	testutil.AssertEqual(t, int64(0), manager.QueueMetrics().Snapshot().SlowConnections, "Draining the queue should clear the slow flag")
+}
+
+func TestSendQueue_ClosedConnectionDropsSilently(t *testing.T) {
+	manager := core.NewManager()
+	conn := core.NewVirtualConnection("conn-1", manager)
+
+	conn.SendMessage(logUpdate())
+	conn.CloseSend()
+	conn.SendMessage(logUpdate())
+
+	testutil.AssertEqual(t, 1, len(drain(conn)), "Messages queued before closing should still be delivered")
+	testutil.AssertEqual(t, int64(0), manager.QueueMetrics().Snapshot().Dropped, "Closed connection should not count drops")
+}
@@ -0,0 +1,109 @@
+package websocket_test
+
+import (
+	"context"
+	"testing"
+
+	"terraforming-mars-backend/internal/delivery/dto"
+	wsdelivery "terraforming-mars-backend/internal/delivery/websocket"
+	"terraforming-mars-backend/internal/delivery/websocket/core"
+	"terraforming-mars-backend/internal/delivery/websocket/handler/connection"
+	"terraforming-mars-backend/internal/game"
+	"terraforming-mars-backend/test/testutil"
+)
+
+func subscribeMessage(slices ...any) dto.WebSocketMessage {
+	return dto.WebSocketMessage{
+		Type:    dto.MessageTypeSubscribe,
+		Payload: map[string]any{"slices": slices},
+	}
+}
+
+func TestSubscribe_SendsOnlyTheSubscribedSlices(t *testing.T) {
+	broadcaster, g, conn := newSyncBroadcaster(t)
+	handler := connection.NewSubscribeHandler(broadcaster)
+	ctx := context.Background()
+
+	handler.HandleMessage(ctx, conn, subscribeMessage("globalParameters", "scoreboard", "scoreboard"))
+	messages := drain(conn)
+	testutil.AssertEqual(t, 1, len(messages), "Subscribing sends the current state")
+	testutil.AssertEqual(t, dto.MessageTypeStateSlices, messages[0].Type, "Subscribed connections get state slices")
+
+	payload := messages[0].Payload.(dto.StateSlicesPayload)
+	testutil.AssertEqual(t, 2, len(payload.Slices), "Duplicate slices are dropped")
+	testutil.AssertTrue(t, payload.GlobalParameters != nil, "Global parameters are included")
+	testutil.AssertEqual(t, 2, len(payload.Scoreboard), "Every player is on the scoreboard")
+	testutil.AssertTrue(t, payload.Board == nil, "Unsubscribed slices are omitted")
+	testutil.AssertTrue(t, payload.Turn == nil, "Unsubscribed slices are omitted")
+
+	broadcaster.BroadcastGameState(g.ID(), nil)
+	messages = drain(conn)
+	testutil.AssertEqual(t, 1, len(messages), "Broadcasts keep the subscription")
+	testutil.AssertEqual(t, dto.MessageTypeStateSlices, messages[0].Type, "Broadcasts send slices to subscribers")
+
+	handler.HandleMessage(ctx, conn, subscribeMessage())
+	messages = drain(conn)
+	testutil.AssertEqual(t, 1, len(messages), "Unsubscribing sends the current state")
+	testutil.AssertEqual(t, dto.MessageTypeGameUpdated, messages[0].Type, "An empty subscription restores the full game")
+}
+
+func TestSubscribe_RejectsUnknownSlices(t *testing.T) {
+	broadcaster, _, conn := newSyncBroadcaster(t)
+	handler := connection.NewSubscribeHandler(broadcaster)
+
+	handler.HandleMessage(context.Background(), conn, subscribeMessage("globalParameters", "hands"))
+	messages := drain(conn)
+	testutil.AssertEqual(t, 1, len(messages), "Only an error is sent")
+	testutil.AssertEqual(t, dto.MessageTypeError, messages[0].Type, "Unknown slices are rejected")
+	testutil.AssertTrue(t, conn.Subscription() == nil, "A rejected subscription changes nothing")
+}
+
+func TestSubscribe_WithoutASeatReceivesPublicSlices(t *testing.T) {
+	g, repo := testutil.CreateTestGameWithPlayers(t, 2, testutil.NewMockBroadcaster())
+	hub := core.NewHub()
+	seated := core.NewVirtualConnection("conn-seated", hub.GetManager())
+	hub.GetManager().RegisterConnection(seated)
+	seated.SetPlayer("player-1", g.ID())
+	watcher := core.NewVirtualConnection("conn-watcher", hub.GetManager())
+	hub.GetManager().RegisterConnection(watcher)
+
+	broadcaster := wsdelivery.NewBroadcaster(repo, game.NewInMemoryGameStateRepository(), hub, testutil.CreateTestCardRegistry(), nil)
+	handler := connection.NewSubscribeHandler(broadcaster)
+	ctx := context.Background()
+
+	handler.HandleMessage(ctx, watcher, subscribeMessage("scoreboard"))
+	messages := drain(watcher)
+	testutil.AssertEqual(t, dto.MessageTypeError, messages[0].Type, "A seatless subscription must name the game")
+
+	unknown := subscribeMessage("scoreboard")
+	unknown.Payload.(map[string]any)["gameId"] = "missing-game"
+	handler.HandleMessage(ctx, watcher, unknown)
+	messages = drain(watcher)
+	testutil.AssertEqual(t, dto.MessageTypeError, messages[0].Type, "An unknown game is rejected")
+	_, boundGameID := watcher.GetPlayer()
+	testutil.AssertEqual(t, "", boundGameID, "A rejected subscription binds no game")
+	testutil.AssertTrue(t, watcher.Subscription() == nil, "A rejected subscription changes nothing")
+
+	full := subscribeMessage()
+	full.Payload.(map[string]any)["gameId"] = g.ID()
+	handler.HandleMessage(ctx, watcher, full)
+	messages = drain(watcher)
+	testutil.AssertEqual(t, dto.MessageTypeError, messages[0].Type, "A seatless connection cannot ask for the full game")
+
+	watch := subscribeMessage("scoreboard", "globalParameters")
+	watch.Payload.(map[string]any)["gameId"] = g.ID()
+	handler.HandleMessage(ctx, watcher, watch)
+	messages = drain(watcher)
+	testutil.AssertEqual(t, 1, len(messages), "Subscribing sends the current public state")
+	testutil.AssertEqual(t, dto.MessageTypeStateSlices, messages[0].Type, "Seatless connections get state slices")
+	payload := messages[0].Payload.(dto.StateSlicesPayload)
+	testutil.AssertEqual(t, 2, len(payload.Scoreboard), "Every player is on the public scoreboard")
+	testutil.AssertTrue(t, payload.GlobalParameters != nil, "Global parameters are included")
+
+	broadcaster.BroadcastGameState(g.ID(), nil)
+	messages = drain(watcher)
+	testutil.AssertEqual(t, 1, len(messages), "Broadcasts reach seatless subscribers")
+	testutil.AssertEqual(t, dto.MessageTypeStateSlices, messages[0].Type, "Seatless subscribers only get slices")
+	seatedMessages := drain(seated)
+	testutil.AssertEqual(t, dto.MessageTypeGameUpdated, seatedMessages[0].Type, "Seated connections keep the full game")
+}
@@ -0,0 +1,90 @@
+package websocket_test
+
+import (
+	"context"
+	"testing"
+
+	"terraforming-mars-backend/internal/delivery/dto"
+	wsdelivery "terraforming-mars-backend/internal/delivery/websocket"
+	"terraforming-mars-backend/internal/delivery/websocket/core"
+	"terraforming-mars-backend/internal/game"
+	"terraforming-mars-backend/test/testutil"
+)
+
+func newSyncBroadcaster(t *testing.T) (*wsdelivery.Broadcaster, *game.Game, *core.Connection) {
+	t.Helper()
+
+	g, repo := testutil.CreateTestGameWithPlayers(t, 2, testutil.NewMockBroadcaster())
+	hub := core.NewHub()
+	conn := core.NewVirtualConnection("conn-1", hub.GetManager())
+	hub.GetManager().RegisterConnection(conn)
+	conn.SetPlayer("player-1", g.ID())
+
+	broadcaster := wsdelivery.NewBroadcaster(repo, game.NewInMemoryGameStateRepository(), hub, testutil.CreateTestCardRegistry(), nil)
+	return broadcaster, g, conn
+}
+
+func findOperation(patch []dto.JSONPatchOperation, path string) (dto.JSONPatchOperation, bool) {
+	for _, op := range patch {
+		if op.Path == path {
+			return op, true
+		}
+	}
+	return dto.JSONPatchOperation{}, false
+}
+
+func TestSyncState_PatchesFromLastBroadcast(t *testing.T) {
+	broadcaster, g, conn := newSyncBroadcaster(t)
+	p, _ := g.GetPlayer("player-1")
+
+	broadcaster.BroadcastGameState(g.ID(), []string{"player-1"})
+	testutil.AssertEqual(t, 1, len(drain(conn)), "Player should receive the broadcast")
+
+	testutil.SetPlayerCredits(context.Background(), p, 42)
+
+	response, err := broadcaster.SyncState(g.ID(), "player-1", dto.SyncRequestPayload{SinceSequence: 0})
+	testutil.AssertNoError(t, err, "Sync should succeed")
+	testutil.AssertEqual(t, dto.SyncModeDeltas, response.Mode, "Known state should be patched")
+	testutil.AssertTrue(t, response.Game == nil, "Deltas should not carry the full game")
+
+	op, found := findOperation(response.Patch, "/currentPlayer/resources/credits")
+	testutil.AssertTrue(t, found, "Patch should update the changed credits")
+	testutil.AssertEqual(t, "replace", op.Op, "Changed value should be replaced")
+	testutil.AssertEqual(t, "42", string(op.Value), "Patch should carry the new value")
+	testutil.AssertTrue(t, len(response.Patch) < 5, "Unchanged fields should not be patched")
+}
+
+func TestSyncState_SnapshotWhenSequenceUnknown(t *testing.T) {
+	broadcaster, g, _ := newSyncBroadcaster(t)
+
+	broadcaster.BroadcastGameState(g.ID(), []string{"player-1"})
+
+	response, err := broadcaster.SyncState(g.ID(), "player-1", dto.SyncRequestPayload{SinceSequence: 99})
+	testutil.AssertNoError(t, err, "Sync should succeed")
+	testutil.AssertEqual(t, dto.SyncModeSnapshot, response.Mode, "Unknown sequence should get a snapshot")
+	testutil.AssertTrue(t, response.Game != nil, "Snapshot should carry the game")
+	testutil.AssertEqual(t, "player-1", response.Game.ViewingPlayerID, "Snapshot should be the requester's view")
+
+	// Another player's history never serves as a base
+	response, err = broadcaster.SyncState(g.ID(), "player-2", dto.SyncRequestPayload{SinceSequence: 0})
+	testutil.AssertNoError(t, err, "Sync should succeed")
+	testutil.AssertEqual(t, dto.SyncModeSnapshot, response.Mode, "Player never sent a state should get a snapshot")
+
+	_, err = broadcaster.SyncState(g.ID(), "intruder", dto.SyncRequestPayload{})
+	testutil.AssertError(t, err, "Players outside the game cannot sync")
+}
+
+func TestSyncState_SnapshotWhenSequenceIsAmbiguous(t *testing.T) {
+	broadcaster, g, _ := newSyncBroadcaster(t)
+	p, _ := g.GetPlayer("player-1")
+
+	// Two different states sent under the same sequence: the client may hold either
+	broadcaster.BroadcastGameState(g.ID(), []string{"player-1"})
+	testutil.SetPlayerCredits(context.Background(), p, 7)
+	broadcaster.BroadcastGameState(g.ID(), []string{"player-1"})
+
+	response, err := broadcaster.SyncState(g.ID(), "player-1", dto.SyncRequestPayload{SinceSequence: 0})
+	testutil.AssertNoError(t, err, "Sync should succeed")
+	testutil.AssertEqual(t, dto.SyncModeSnapshot, response.Mode, "Ambiguous sequence should get a snapshot")
+	testutil.AssertEqual(t, 0, len(response.Patch), "Snapshot should not carry a patch")
+}
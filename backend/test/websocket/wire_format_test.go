@@ -0,0 +1,104 @@
+package websocket_test
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"terraforming-mars-backend/internal/delivery/dto"
+	"terraforming-mars-backend/internal/delivery/websocket/core"
+	"terraforming-mars-backend/test/testutil"
+
+	"github.com/gorilla/websocket"
+)
+
+func dialTestHub(t *testing.T, compression bool) (*core.Hub, *websocket.Conn) {
+	t.Helper()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	hub := core.NewHub()
+	go hub.Run(ctx)
+
+	server := httptest.NewServer(http.HandlerFunc(core.NewHandler(hub).ServeWS))
+	t.Cleanup(func() {
+		server.Close()
+		cancel()
+	})
+
+	dialer := websocket.Dialer{EnableCompression: compression}
+	conn, _, err := dialer.Dial("ws"+strings.TrimPrefix(server.URL, "http"), nil)
+	testutil.AssertNoError(t, err, "Dial should succeed")
+	t.Cleanup(func() { conn.Close() })
+	return hub, conn
+}
+
+func sendHello(t *testing.T, conn *websocket.Conn, encodings []string, compression bool) dto.HelloAckPayload {
+	t.Helper()
+
+	err := conn.WriteJSON(dto.WebSocketMessage{
+		Type:    dto.MessageTypeHello,
+		Payload: dto.HelloPayload{Encodings: encodings, Compression: compression},
+	})
+	testutil.AssertNoError(t, err, "Hello should be sent")
+
+	var ack struct {
+		Type    dto.MessageType     `json:"type"`
+		Payload dto.HelloAckPayload `json:"payload"`
+	}
+	conn.SetReadDeadline(time.Now().Add(2 * time.Second))
+	testutil.AssertNoError(t, conn.ReadJSON(&ack), "Ack should arrive as JSON")
+	testutil.AssertEqual(t, dto.MessageTypeHelloAck, ack.Type, "First reply should be the ack")
+	return ack.Payload
+}
+
+func TestHello_SwitchesToCompressedMsgpack(t *testing.T) {
+	hub, conn := dialTestHub(t, true)
+
+	ack := sendHello(t, conn, []string{"cbor", "msgpack", "json"}, true)
+	testutil.AssertEqual(t, "msgpack", ack.Encoding, "First supported encoding should be chosen")
+	testutil.AssertTrue(t, ack.Compression, "Compression should be on when deflate was negotiated")
+
+	// Any reply after the ack uses the new format; an unknown message type produces an error reply
+	testutil.AssertNoError(t, conn.WriteJSON(dto.WebSocketMessage{Type: "no-such-message"}), "Message should be sent")
+	conn.SetReadDeadline(time.Now().Add(2 * time.Second))
+	frameType, data, err := conn.ReadMessage()
+	testutil.AssertNoError(t, err, "Error reply should arrive")
+	testutil.AssertEqual(t, websocket.BinaryMessage, frameType, "Msgpack frames should be binary")
+	testutil.AssertEqual(t, byte(0x82), data[0], "Reply should be a msgpack map of type and payload")
+	testutil.AssertTrue(t, bytes.Contains(data, []byte(core.ErrUnknownMessageType)), "Reply should carry the error message")
+
+	var stats core.WireStats
+	deadline := time.Now().Add(2 * time.Second)
+	for time.Now().Before(deadline) {
+		for _, entry := range hub.WireMetrics().Snapshot() {
+			if entry.Encoding == core.EncodingMsgpack && entry.Compressed {
+				stats = entry
+			}
+		}
+		if stats.Messages > 0 {
+			break
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	testutil.AssertEqual(t, int64(1), stats.Messages, "Reply should be counted under compressed msgpack")
+	testutil.AssertTrue(t, stats.EncodedBytes < stats.JSONBytes, "Msgpack should be smaller than JSON")
+	testutil.AssertTrue(t, stats.WireBytes > 0, "Compressed size should be estimated from the sampled frame")
+}
+
+func TestHello_CompressionRequiresNegotiation(t *testing.T) {
+	_, conn := dialTestHub(t, false)
+
+	ack := sendHello(t, conn, []string{"json"}, true)
+	testutil.AssertEqual(t, "json", ack.Encoding, "JSON should be kept when asked for")
+	testutil.AssertFalse(t, ack.Compression, "Compression cannot be enabled without permessage-deflate")
+
+	testutil.AssertNoError(t, conn.WriteJSON(dto.WebSocketMessage{Type: "no-such-message"}), "Message should be sent")
+	conn.SetReadDeadline(time.Now().Add(2 * time.Second))
+	frameType, _, err := conn.ReadMessage()
+	testutil.AssertNoError(t, err, "Error reply should arrive")
+	testutil.AssertEqual(t, websocket.TextMessage, frameType, "JSON frames should stay text")
+}
@@ -0,0 +1,130 @@
+package websocket_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	gameAction "terraforming-mars-backend/internal/action/game"
+	turnAction "terraforming-mars-backend/internal/action/turn_management"
+	"terraforming-mars-backend/internal/delivery/dto"
+	"terraforming-mars-backend/internal/delivery/websocket/core"
+	"terraforming-mars-backend/internal/game"
+	"terraforming-mars-backend/internal/logger"
+	"terraforming-mars-backend/test/testutil"
+
+	"go.uber.org/zap/zapcore"
+)
+
+// oversizedReplyHandler seats the connection in a game and answers with a message of the given size
+type oversizedReplyHandler struct {
+	gameID string
+	size   int
+}
+
+func (h oversizedReplyHandler) HandleMessage(ctx context.Context, connection *core.Connection, message dto.WebSocketMessage) {
+	connection.SetPlayer("player-1", h.gameID)
+	connection.SendMessage(dto.WebSocketMessage{
+		Type:    dto.MessageTypeError,
+		Payload: dto.ErrorPayload{Message: strings.Repeat("x", h.size)},
+	})
+}
+
+func TestWireMetrics_WarnsOncePerGameOverSizeBudget(t *testing.T) {
+	logger.GameLogs().Configure(true, logger.DefaultMaxGameLogBytes)
+	t.Cleanup(func() { logger.GameLogs().Configure(false, logger.DefaultMaxGameLogBytes) })
+	hub, conn := dialTestHub(t, false)
+	gameID := fmt.Sprintf("budget-game-%d", time.Now().UnixNano())
+	hub.WireMetrics().SetSizeBudget(1024)
+	hub.RegisterHandler(dto.MessageTypeJoinGame, oversizedReplyHandler{gameID: gameID, size: 2048})
+
+	for i := 0; i < 2; i++ {
+		testutil.AssertNoError(t, conn.WriteJSON(dto.WebSocketMessage{Type: dto.MessageTypeJoinGame}), "Message should be sent")
+		conn.SetReadDeadline(time.Now().Add(2 * time.Second))
+		_, _, err := conn.ReadMessage()
+		testutil.AssertNoError(t, err, "Oversized reply should still be delivered")
+	}
+
+	var stats core.MessageSizeStats
+	deadline := time.Now().Add(2 * time.Second)
+	for time.Now().Before(deadline) && stats.Messages < 2 {
+		for _, entry := range hub.WireMetrics().TypeSnapshot() {
+			if entry.Type == dto.MessageTypeError {
+				stats = entry
+			}
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	testutil.AssertEqual(t, int64(2), stats.Messages, "Both replies should be counted under their type")
+	testutil.AssertTrue(t, stats.MaxJSONBytes > 2048, "Largest message should be tracked")
+	testutil.AssertEqual(t, 2*stats.MaxJSONBytes, stats.JSONBytes, "Totals should add up both replies")
+
+	warnings := 0
+	for _, entry := range logger.GameLogs().Entries(gameID, zapcore.WarnLevel) {
+		if strings.Contains(entry.Message, "size budget") {
+			warnings++
+			testutil.AssertEqual[any](t, string(dto.MessageTypeError), entry.Fields["message_type"], "Warning should name the message type")
+		}
+	}
+	testutil.AssertEqual(t, 1, warnings, "Repeated oversized messages in one game should warn once")
+}
+
+func TestWireMetrics_ZeroBudgetDisablesWarnings(t *testing.T) {
+	hub, conn := dialTestHub(t, false)
+	gameID := fmt.Sprintf("unbudgeted-game-%d", time.Now().UnixNano())
+	hub.WireMetrics().SetSizeBudget(0)
+	hub.RegisterHandler(dto.MessageTypeJoinGame, oversizedReplyHandler{gameID: gameID, size: 2048})
+
+	testutil.AssertNoError(t, conn.WriteJSON(dto.WebSocketMessage{Type: dto.MessageTypeJoinGame}), "Message should be sent")
+	conn.SetReadDeadline(time.Now().Add(2 * time.Second))
+	_, _, err := conn.ReadMessage()
+	testutil.AssertNoError(t, err, "Reply should be delivered")
+
+	testutil.AssertEqual(t, 0, len(logger.GameLogs().Entries(gameID, zapcore.WarnLevel)), "No budget should mean no warning")
+}
+
+// BenchmarkWireFormat_GameState measures a started four-player game's full state in every wire format
+// Run with -bench WireFormat to see the byte counts next to the encoding time.
+func BenchmarkWireFormat_GameState(b *testing.B) {
+	ctx := context.Background()
+	repo := game.NewInMemoryGameRepository()
+	cardRegistry := testutil.CreateTestCardRegistry()
+	logger := testutil.TestLogger()
+
+	g, err := gameAction.NewCreateGameAction(repo, cardRegistry, logger).Execute(ctx, game.GameSettings{
+		MaxPlayers: 4,
+		CardPacks:  []string{"base"},
+	})
+	if err != nil {
+		b.Fatal(err)
+	}
+	joinAction := gameAction.NewJoinGameAction(repo, cardRegistry, logger)
+	for i := 1; i <= 4; i++ {
+		if _, err := joinAction.Execute(ctx, g.ID(), fmt.Sprintf("Player %d", i), fmt.Sprintf("player-%d", i)); err != nil {
+			b.Fatal(err)
+		}
+	}
+	if err := turnAction.NewStartGameAction(repo, cardRegistry, logger).Execute(ctx, g.ID(), "player-1"); err != nil {
+		b.Fatal(err)
+	}
+
+	message := dto.WebSocketMessage{
+		Type:    dto.MessageTypeGameUpdated,
+		GameID:  g.ID(),
+		Payload: dto.GameUpdatedPayload{Game: dto.ToGameDto(g, cardRegistry, "player-1")},
+	}
+
+	var sizes core.PayloadSizes
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if sizes, err = core.MeasurePayload(message); err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.ReportMetric(float64(sizes.JSON), "json-B")
+	b.ReportMetric(float64(sizes.Msgpack), "msgpack-B")
+	b.ReportMetric(float64(sizes.JSONDeflated), "json-deflate-B")
+	b.ReportMetric(float64(sizes.MsgpackDeflated), "msgpack-deflate-B")
+}
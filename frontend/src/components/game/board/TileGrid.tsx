@@ -33,7 +33,7 @@ type TileType = "city" | "empty" | "ocean" | "greenery" | "special";
 interface TileData {
   type: TileType;
   ownerId: string | null;
-  specialType: null;
+  specialType: string | null; // Card marker of a special tile, e.g. "nuclear-zone"
 }
 
 export default function TileGrid({
@@ -157,7 +157,7 @@ export default function TileGrid({
             return {
               type: "special",
               ownerId: backendTile.ownerId || null,
-              specialType: null,
+              specialType: backendTile.occupiedBy.specialTileType || null,
             };
         }
       }
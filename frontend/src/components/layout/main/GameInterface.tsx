@@ -570,8 +570,13 @@ export default function GameInterface() {
 
         // Check if any AUTO-triggered behavior has choices
         // Manual-triggered behaviors (actions) will show choices when the action is played
+        // Choices of "any-player-choice" outputs are made by the targeted player, not here
         const behaviorWithChoices = card.behaviors?.findIndex(
-          (b) => b.choices && b.choices.length > 0 && b.triggers?.some((t) => t.type === "auto"),
+          (b) =>
+            b.choices &&
+            b.choices.length > 0 &&
+            b.triggers?.some((t) => t.type === "auto") &&
+            !b.choices.every((c) => c.outputs?.every((o) => o.target === "any-player-choice")),
         );
 
         if (
@@ -1940,7 +1945,11 @@ export default function GameInterface() {
       )}
 
       {loadingPhase === "joining" && gameForSelection && (
-        <JoinGameOverlay game={gameForSelection} onCancel={handlePlayerSelectionCancel} />
+        <JoinGameOverlay
+          game={gameForSelection}
+          onCancel={handlePlayerSelectionCancel}
+          inviteToken={new URLSearchParams(location.search).get("invite") ?? undefined}
+        />
       )}
 
       {/* Leave game confirmation dialog */}
@@ -2,7 +2,7 @@ import React, { useState, useEffect } from "react";
 import { useNavigate } from "react-router-dom";
 import { apiService } from "../../services/apiService";
 import { globalWebSocketManager } from "../../services/globalWebSocketManager";
-import { GameSettingsDto } from "../../types/generated/api-types.ts";
+import { CreateGameRequest } from "../../types/generated/api-types.ts";
 import { skyboxCache } from "../../services/SkyboxCache.ts";
 import { saveGameSession } from "../../utils/sessionStorage.ts";
 import LoadingOverlay from "../game/view/LoadingOverlay.tsx";
@@ -15,6 +15,7 @@ const CreateGamePage: React.FC = () => {
   const { showNotification } = useNotifications();
   const [playerName, setPlayerName] = useState("");
   const [developmentMode, setDevelopmentMode] = useState(true);
+  const [passAndPlay, setPassAndPlay] = useState(false);
   const [selectedPacks, setSelectedPacks] = useState<string[]>(["base-game"]);
   const [isLoading, setIsLoading] = useState(false);
   const [loadingStep, setLoadingStep] = useState<"game" | "environment" | null>(null);
@@ -52,11 +53,11 @@ const CreateGamePage: React.FC = () => {
 
     try {
       // Step 1: Create game
-      const gameSettings: GameSettingsDto = {
+      const gameSettings: CreateGameRequest = {
         maxPlayers: 4, // Default max players
         developmentMode: developmentMode,
+        passAndPlay: passAndPlay,
         cardPacks: selectedPacks,
-        demoGame: false,
       };
 
       const game = await apiService.createGame(gameSettings);
@@ -275,6 +276,22 @@ const CreateGamePage: React.FC = () => {
                       </InfoTooltip>
                     </span>
                   </label>
+                  <label className="flex items-center gap-3 cursor-pointer py-2 px-2 rounded hover:bg-white/5 transition-all duration-200">
+                    <input
+                      type="checkbox"
+                      checked={passAndPlay}
+                      onChange={(e) => setPassAndPlay(e.target.checked)}
+                      disabled={isLoading}
+                      className="w-[18px] h-[18px] accent-space-blue-solid cursor-pointer m-0 disabled:opacity-60 disabled:cursor-not-allowed"
+                    />
+                    <span className="text-white text-sm font-medium leading-none m-0 flex items-center gap-2">
+                      Pass and Play
+                      <InfoTooltip size="medium">
+                        Play every seat on one shared device. All hands are visible to that device,
+                        so only use this when everyone is sitting around the same screen.
+                      </InfoTooltip>
+                    </span>
+                  </label>
                 </div>
 
                 <div>
@@ -61,6 +61,8 @@ const BehaviorIcon: React.FC<BehaviorIconProps> = ({
     cleanType === "card-draw" ||
     cleanType === "card-take" ||
     cleanType === "card-peek" ||
+    cleanType === "card-search" ||
+    cleanType === "card-discard" ||
     cleanType === "card";
 
   const isStandaloneTile =
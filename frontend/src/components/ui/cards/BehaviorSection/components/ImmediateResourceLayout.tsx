@@ -620,7 +620,10 @@ const ImmediateResourceLayout: React.FC<ImmediateResourceLayoutProps> = ({
               choice.outputs.map((output: any, outputIndex: number) => {
                 const amount = Math.abs(output.amount || 1);
                 const resourceType = output.resourceType || output.type;
-                const isAttack = output.target === "any-player" || output.target === "any-card";
+                const isAttack =
+                  output.target === "any-player" ||
+                  output.target === "any-player-choice" ||
+                  output.target === "any-card";
 
                 if (resourceType === "credit") {
                   return (
@@ -11,6 +11,8 @@ import {
   AdminCommandTypeSetGlobalParams,
   AdminCommandTypeStartTileSelection,
   AdminCommandTypeSetTR,
+  AdminCommandTypeSetupTestState,
+  AdminCommandTypeSetAwardFreeze,
   GiveCardAdminCommand,
   SetPhaseAdminCommand,
   SetResourcesAdminCommand,
@@ -18,6 +20,8 @@ import {
   SetGlobalParamsAdminCommand,
   StartTileSelectionAdminCommand,
   SetTRAdminCommand,
+  SetupTestStateAdminCommand,
+  SetAwardFreezeAdminCommand,
   GamePhaseWaitingForGameStart,
   GamePhaseStartingCardSelection,
   GamePhaseAction,
@@ -106,6 +110,7 @@ const AdminCommandPanel: React.FC<AdminCommandPanelProps> = ({ gameState, onClos
   const [giveCardForm, setGiveCardForm] = useState({
     playerId: "",
     cardId: "",
+    allowAnyPack: false,
   });
   const [setPhaseForm, setSetPhaseForm] = useState({ phase: "" });
   const [resourcesForm, setResourcesForm] = useState({
@@ -143,6 +148,8 @@ const AdminCommandPanel: React.FC<AdminCommandPanelProps> = ({ gameState, onClos
     playerId: "",
     terraformRating: "",
   });
+  const [fixtureJson, setFixtureJson] = useState("");
+  const [freezeAwards, setFreezeAwards] = useState(false);
 
   // Card data cache for autocomplete
   const [allCards, setAllCards] = useState<CardDto[]>([]);
@@ -433,6 +440,7 @@ const AdminCommandPanel: React.FC<AdminCommandPanelProps> = ({ gameState, onClos
     const command: GiveCardAdminCommand = {
       playerId: giveCardForm.playerId,
       cardId: giveCardForm.cardId,
+      allowAnyPack: giveCardForm.allowAnyPack,
     };
 
     await sendAdminCommand(AdminCommandTypeGiveCard, command);
@@ -668,6 +676,41 @@ const AdminCommandPanel: React.FC<AdminCommandPanelProps> = ({ gameState, onClos
     await sendAdminCommand(AdminCommandTypeSetTR, command);
   };
 
+  const handleSetupTestState = async () => {
+    let command: SetupTestStateAdminCommand;
+    try {
+      command = JSON.parse(fixtureJson) as SetupTestStateAdminCommand;
+    } catch {
+      setValidationErrors({ fixtureJson: true });
+      setTimeout(() => setValidationErrors({}), 3000);
+      return;
+    }
+
+    await sendAdminCommand(AdminCommandTypeSetupTestState, command);
+  };
+
+  const handleSetAwardFreeze = async () => {
+    const command: SetAwardFreezeAdminCommand = { enabled: freezeAwards };
+    await sendAdminCommand(AdminCommandTypeSetAwardFreeze, command);
+  };
+
+  const fixturePlaceholder = JSON.stringify(
+    {
+      generation: 5,
+      phase: GamePhaseAction,
+      players: [
+        {
+          playerId: gameState.currentPlayer?.id ?? "player-id",
+          resources: { credits: 40, steel: 0, titanium: 0, plants: 8, energy: 0, heat: 0 },
+          hand: ["card-id"],
+        },
+      ],
+      tiles: [{ hexPosition: { q: 0, r: 0, s: 0 }, tileType: "city" }],
+    },
+    null,
+    2,
+  );
+
   const commandOptions = [
     { value: "give-card", label: "Give Card to Player" },
     { value: "set-phase", label: "Set Game Phase" },
@@ -677,6 +720,8 @@ const AdminCommandPanel: React.FC<AdminCommandPanelProps> = ({ gameState, onClos
     { value: "set-global-params", label: "Set Global Parameters" },
     { value: "start-tile-selection", label: "Start Tile Selection (Demo)" },
     { value: "set-corporation", label: "Set Player Corporation" },
+    { value: "setup-test-state", label: "Set Up Test State (Fixture)" },
+    { value: "set-award-freeze", label: "Freeze Award Standings at Funding" },
   ];
 
   const phaseOptions = [
@@ -847,6 +892,23 @@ const AdminCommandPanel: React.FC<AdminCommandPanelProps> = ({ gameState, onClos
               </div>
             )}
           </div>
+          <label
+            style={{
+              color: "#abb2bf",
+              fontSize: "11px",
+              display: "flex",
+              alignItems: "center",
+              gap: "6px",
+              marginBottom: "8px",
+            }}
+          >
+            <input
+              type="checkbox"
+              checked={giveCardForm.allowAnyPack}
+              onChange={(e) => setGiveCardForm({ ...giveCardForm, allowAnyPack: e.target.checked })}
+            />
+            Allow cards from packs this game was not created with
+          </label>
           <button onClick={handleGiveCard} style={buttonStyle}>
             Give Card
           </button>
@@ -1318,6 +1380,59 @@ const AdminCommandPanel: React.FC<AdminCommandPanelProps> = ({ gameState, onClos
         </div>
       )}
 
+      {selectedCommand === "setup-test-state" && (
+        <div style={{ marginBottom: "16px" }}>
+          <h4 style={{ color: "#9b59b6", margin: "0 0 12px 0" }}>Set Up Test State</h4>
+          <div style={{ marginBottom: "8px" }}>
+            <label
+              style={{
+                color: "#abb2bf",
+                fontSize: "11px",
+                display: "block",
+                marginBottom: "4px",
+              }}
+            >
+              Fixture JSON (applied all at once; unset fields are left as they are):
+            </label>
+            <textarea
+              value={fixtureJson}
+              onChange={(e) => setFixtureJson(e.target.value)}
+              placeholder={fixturePlaceholder}
+              rows={14}
+              spellCheck={false}
+              style={{
+                ...getInputStyle(validationErrors.fixtureJson),
+                fontFamily: "monospace",
+                resize: "vertical" as const,
+                boxSizing: "border-box" as const,
+              }}
+            />
+          </div>
+          <button onClick={handleSetupTestState} style={buttonStyle}>
+            Apply Fixture
+          </button>
+        </div>
+      )}
+
+      {selectedCommand === "set-award-freeze" && (
+        <div style={{ marginBottom: "16px" }}>
+          <h4 style={{ color: "#9b59b6", margin: "0 0 12px 0" }}>Freeze Award Standings</h4>
+          <div style={{ marginBottom: "8px" }}>
+            <label style={{ color: "#abb2bf", fontSize: "11px", display: "flex", gap: "6px" }}>
+              <input
+                type="checkbox"
+                checked={freezeAwards}
+                onChange={(e) => setFreezeAwards(e.target.checked)}
+              />
+              Score awards funded from now on at their funding-time standings (official: game end)
+            </label>
+          </div>
+          <button onClick={handleSetAwardFreeze} style={buttonStyle}>
+            Apply
+          </button>
+        </div>
+      )}
+
       {selectedCommand === "set-corporation" && (
         <div style={{ marginBottom: "16px" }}>
           <h4 style={{ color: "#9b59b6", margin: "0 0 12px 0" }}>Set Player Corporation</h4>
@@ -1505,6 +1620,7 @@ const AdminCommandPanel: React.FC<AdminCommandPanelProps> = ({ gameState, onClos
             <li>Modify global parameters</li>
             <li>Start tile selection (demo)</li>
             <li>Set player corporation</li>
+            <li>Apply a whole test fixture at once</li>
           </ul>
         </div>
       )}
@@ -80,6 +80,8 @@ const GameIcon: React.FC<GameIconProps> = ({
     baseType === "card-draw" ||
     baseType === "card-take" ||
     baseType === "card-peek" ||
+    baseType === "card-search" ||
+    baseType === "card-discard" ||
     baseType === "card";
 
   const iconUrl = baseType ? getIconPath(baseType) : null;
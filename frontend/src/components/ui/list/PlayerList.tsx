@@ -54,7 +54,7 @@ const PlayerList: React.FC<PlayerListProps> = ({
         <PlayerCard
           key={player.id}
           player={player}
-          playerColor={getPlayerColor(index)}
+          playerColor={player.color || getPlayerColor(index)}
           isCurrentPlayer={player.id === currentPlayer?.id}
           isCurrentTurn={player.id === turnPlayerId}
           isActionPhase={isActionPhase}
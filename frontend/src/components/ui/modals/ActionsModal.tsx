@@ -138,7 +138,8 @@ const ActionsModal: React.FC<ActionsModalProps> = ({
                   <div className="flex flex-col gap-2.5 flex-1 overflow-hidden">
                     <div className="text-white/90 text-sm font-semibold [text-shadow:1px_1px_2px_rgba(0,0,0,0.8)] leading-[1.3] text-center m-0 flex-shrink-0 flex items-center justify-center gap-2 flex-wrap max-md:text-xs">
                       {action.cardName}
-                      {action.timesUsedThisGeneration > 0 && (
+                      {(action.timesUsedThisGeneration > 0 ||
+                        (action.behavior.usageLimit?.per === "game" && action.timesUsedThisGame > 0)) && (
                         <span className="bg-[linear-gradient(135deg,rgba(120,120,120,0.8)_0%,rgba(80,80,80,0.9)_100%)] text-white/90 text-[10px] font-semibold uppercase tracking-[0.3px] py-[3px] px-2 rounded-[10px] border border-[rgba(120,120,120,0.6)] [text-shadow:none] opacity-100">
                           played
                         </span>
@@ -223,6 +223,10 @@ const BottomResourceBar: React.FC<BottomResourceBarProps> = ({
     const counts: { [key: string]: number } = {};
 
     playedCards.forEach((card) => {
+      if (card.isFaceDown) {
+        counts.event = (counts.event || 0) + 1;
+        return;
+      }
       if (card.tags) {
         card.tags.forEach((tag) => {
           const tagKey = tag.toLowerCase();
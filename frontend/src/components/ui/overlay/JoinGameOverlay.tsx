@@ -11,6 +11,7 @@ interface JoinGameOverlayProps {
   onExited?: () => void;
   title?: string;
   subtitle?: string;
+  inviteToken?: string;
 }
 
 const JoinGameOverlay: React.FC<JoinGameOverlayProps> = ({
@@ -20,15 +21,23 @@ const JoinGameOverlay: React.FC<JoinGameOverlayProps> = ({
   onExited,
   title = "Game Found",
   subtitle,
+  inviteToken,
 }) => {
-  const { playerName, setPlayerName, isLoading, handleJoin, handleKeyDown, loadingMessage } =
-    useJoinGame({ game });
+  const {
+    playerName,
+    setPlayerName,
+    isInvited,
+    isLoading,
+    handleJoin,
+    handleKeyDown,
+    loadingMessage,
+  } = useJoinGame({ game, inviteToken });
 
   return (
     <>
       <GameMenuModal
         title={title}
-        subtitle={subtitle}
+        subtitle={isInvited ? "A seat is reserved for you" : subtitle}
         onBack={onCancel}
         visible={visible}
         onExited={onExited}
@@ -45,6 +54,7 @@ const JoinGameOverlay: React.FC<JoinGameOverlayProps> = ({
             onKeyDown={handleKeyDown}
             placeholder="Enter your name"
             disabled={isLoading}
+            readOnly={isInvited}
             spellCheck={false}
             autoComplete="off"
             autoCorrect="off"
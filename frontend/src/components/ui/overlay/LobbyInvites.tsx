@@ -0,0 +1,138 @@
+import React, { useCallback, useEffect, useState } from "react";
+import { InviteDto } from "../../../types/generated/api-types.ts";
+import { apiService } from "../../../services/apiService.ts";
+import { useNotifications } from "../../../contexts/NotificationContext.tsx";
+import CopyLinkButton from "../buttons/CopyLinkButton.tsx";
+import GameMenuButton from "../buttons/GameMenuButton.tsx";
+
+interface LobbyInvitesProps {
+  gameId: string;
+  hostPlayerId: string;
+  playerCount: number;
+}
+
+// Links are relative unless the server knows its public URL
+const absoluteInviteUrl = (url: string) =>
+  url.startsWith("/") ? `${window.location.origin}${url}` : url;
+
+const LobbyInvites: React.FC<LobbyInvitesProps> = ({ gameId, hostPlayerId, playerCount }) => {
+  const { showNotification } = useNotifications();
+  const [invites, setInvites] = useState<InviteDto[]>([]);
+  const [seatName, setSeatName] = useState("");
+  const [isCreating, setIsCreating] = useState(false);
+
+  const refreshInvites = useCallback(async () => {
+    try {
+      setInvites(await apiService.listInvites(gameId, hostPlayerId));
+    } catch {
+      setInvites([]);
+    }
+  }, [gameId, hostPlayerId]);
+
+  // Claimed invites disappear, so refresh whenever someone joins
+  useEffect(() => {
+    void refreshInvites();
+  }, [refreshInvites, playerCount]);
+
+  const handleCreate = async () => {
+    const trimmed = seatName.trim();
+    if (trimmed.length < 2) {
+      showNotification({ message: "Name must be at least 2 characters long", type: "error" });
+      return;
+    }
+    setIsCreating(true);
+    try {
+      const invite = await apiService.createInvite(gameId, hostPlayerId, trimmed);
+      setInvites((prev) => [...prev, invite]);
+      setSeatName("");
+    } catch (err) {
+      showNotification({
+        message: err instanceof Error ? err.message : "Failed to create invite",
+        type: "error",
+      });
+    } finally {
+      setIsCreating(false);
+    }
+  };
+
+  const handleRevoke = async (token: string) => {
+    try {
+      setInvites(await apiService.revokeInvite(gameId, hostPlayerId, token));
+    } catch (err) {
+      showNotification({
+        message: err instanceof Error ? err.message : "Failed to revoke invite",
+        type: "error",
+      });
+      void refreshInvites();
+    }
+  };
+
+  return (
+    <div className="mt-6">
+      <h3 className="text-white text-sm font-semibold mb-2 uppercase tracking-wide">Invites</h3>
+      <div className="flex flex-col gap-2">
+        {invites.map((invite) => (
+          <div
+            key={invite.token}
+            className="flex justify-between items-center py-2 px-3 bg-black/40 rounded-lg border border-dashed border-space-blue-600/50"
+          >
+            <span className="text-white/70 text-sm font-medium">{invite.seatName}</span>
+            <div className="flex gap-2 items-center">
+              <CopyLinkButton
+                textToCopy={absoluteInviteUrl(invite.url)}
+                defaultText="Copy"
+                copiedText="Copied!"
+                className="!min-w-[80px]"
+              />
+              <button
+                onClick={() => void handleRevoke(invite.token)}
+                className="text-red-400 hover:text-red-300 transition-colors cursor-pointer"
+                title={`Revoke invite for ${invite.seatName}`}
+              >
+                <svg
+                  width="14"
+                  height="14"
+                  viewBox="0 0 24 24"
+                  fill="none"
+                  stroke="currentColor"
+                  strokeWidth="2"
+                  strokeLinecap="round"
+                  strokeLinejoin="round"
+                >
+                  <line x1="18" y1="6" x2="6" y2="18" />
+                  <line x1="6" y1="6" x2="18" y2="18" />
+                </svg>
+              </button>
+            </div>
+          </div>
+        ))}
+        <div className="flex flex-row gap-2 items-center">
+          <input
+            type="text"
+            value={seatName}
+            onChange={(e) => setSeatName(e.target.value)}
+            onKeyDown={(e) => {
+              if (e.key === "Enter") void handleCreate();
+            }}
+            placeholder="Reserve a seat for..."
+            disabled={isCreating}
+            spellCheck={false}
+            autoComplete="off"
+            maxLength={50}
+            className="flex-1 bg-black/50 border border-white/20 rounded-lg py-2 px-3 text-white text-sm outline-none placeholder:text-white/50 focus:border-white/60 transition-all duration-200 disabled:opacity-60"
+          />
+          <GameMenuButton
+            variant="secondary"
+            size="sm"
+            onClick={() => void handleCreate()}
+            disabled={isCreating || !seatName.trim()}
+          >
+            Invite
+          </GameMenuButton>
+        </div>
+      </div>
+    </div>
+  );
+};
+
+export default LobbyInvites;
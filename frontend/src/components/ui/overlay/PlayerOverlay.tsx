@@ -49,7 +49,7 @@ const PlayerOverlay: React.FC<PlayerOverlayProps> = ({ players, currentPlayer })
       <div className="flex gap-2 items-center justify-center">
         {playersToShow.map((player, index) => {
           const isCurrentPlayer = player.id === currentPlayer?.id;
-          const playerColor = getPlayerColor(index);
+          const playerColor = player.color || getPlayerColor(index);
           const corpLogo = getCorpLogo(player.corporation?.id);
           const isPassed = player.passed || false;
 
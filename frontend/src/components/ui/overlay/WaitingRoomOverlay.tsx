@@ -5,6 +5,7 @@ import { globalWebSocketManager } from "../../../services/globalWebSocketManager
 import CopyLinkButton from "../buttons/CopyLinkButton.tsx";
 import GameMenuButton from "../buttons/GameMenuButton.tsx";
 import GameMenuModal from "./GameMenuModal.tsx";
+import LobbyInvites from "./LobbyInvites.tsx";
 
 interface WaitingRoomOverlayProps {
   game: GameDto;
@@ -298,6 +299,13 @@ const WaitingRoomOverlay: React.FC<WaitingRoomOverlayProps> = ({
           </div>
         </div>
 
+        {/* Seat invites (Host only) */}
+        {isHost && !game.settings.passAndPlay && (
+          <div className="mb-6">
+            <LobbyInvites gameId={game.id} hostPlayerId={playerId} playerCount={playerCount} />
+          </div>
+        )}
+
         {/* Start Game Button (Host only) */}
         {isHost && (
           <div className="text-center">
@@ -93,7 +93,8 @@ const ActionsPopover: React.FC<ActionsPopoverProps> = ({
                 <div className="flex flex-col gap-2 flex-1">
                   <div className="text-white/70 text-[11px] font-medium uppercase tracking-[0.5px] [text-shadow:1px_1px_2px_rgba(0,0,0,0.8)] leading-[1.2] opacity-80 flex items-center gap-2 max-[768px]:text-[10px]">
                     {action.cardName}
-                    {action.timesUsedThisGeneration > 0 && (
+                    {(action.timesUsedThisGeneration > 0 ||
+                      (action.behavior.usageLimit?.per === "game" && action.timesUsedThisGame > 0)) && (
                       <span className="bg-[linear-gradient(135deg,rgba(120,120,120,0.8)_0%,rgba(80,80,80,0.9)_100%)] text-white/90 text-[8px] font-semibold uppercase tracking-[0.3px] py-0.5 px-1.5 rounded-lg border border-[rgba(120,120,120,0.6)] [text-shadow:none] opacity-100">
                         played
                       </span>
@@ -30,6 +30,8 @@ const AwardPopover: React.FC<AwardPopoverProps> = ({
   const canFundAwards =
     isGameActive && isActionPhase && isCurrentPlayerTurn && canPerformActions(gameState);
 
+  const firstPlaceVP = gameState?.settings.rulesOptions.awardFirstPlaceVP ?? 5;
+  const secondPlaceVP = gameState?.settings.rulesOptions.awardSecondPlaceVP ?? 2;
   const awards = gameState?.currentPlayer?.awards ?? [];
   const fundedCount = awards.filter((a) => a.isFunded).length;
   const availableCount = awards.filter((a) => a.available && !a.isFunded).length;
@@ -114,7 +116,7 @@ const AwardPopover: React.FC<AwardPopoverProps> = ({
                       />
                       <span className="text-white/60 text-xs">→</span>
                       <span className="text-amber-400 text-xs font-semibold">
-                        5 VP (1st), 2 VP (2nd)
+                        {firstPlaceVP} VP (1st), {secondPlaceVP} VP (2nd)
                       </span>
                     </div>
                   </div>
@@ -316,6 +316,16 @@ const LogEntry: React.FC<LogEntryProps> = ({ diff, playerNames }) => {
       {!displayData && !isCardSource && !isBehaviorSource && (
         <div className="text-xs text-gray-400">{diff.description}</div>
       )}
+
+      {diff.effectResolutions && diff.effectResolutions.length > 0 && (
+        <ol className="text-xs text-gray-400 list-decimal list-inside">
+          {diff.effectResolutions.map((resolution, index) => (
+            <li key={`${resolution.playerId}-${resolution.cardId}-${index}`}>
+              {resolution.cardName} ({playerNames.get(resolution.playerId) || "Unknown"})
+            </li>
+          ))}
+        </ol>
+      )}
     </div>
   );
 };
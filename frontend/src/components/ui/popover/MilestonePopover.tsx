@@ -30,6 +30,7 @@ const MilestonePopover: React.FC<MilestonePopoverProps> = ({
   const canClaimMilestones =
     isGameActive && isActionPhase && isCurrentPlayerTurn && canPerformActions(gameState);
 
+  const milestoneVP = gameState?.settings.rulesOptions.milestoneVP ?? 5;
   const milestones = gameState?.currentPlayer?.milestones ?? [];
   const claimedCount = milestones.filter((m) => m.isClaimed).length;
   const availableCount = milestones.filter((m) => m.available && !m.isClaimed).length;
@@ -117,7 +118,7 @@ const MilestonePopover: React.FC<MilestonePopoverProps> = ({
                         size="small"
                       />
                       <span className="text-white/60 text-xs">→</span>
-                      <span className="text-amber-400 text-xs font-semibold">5 VP</span>
+                      <span className="text-amber-400 text-xs font-semibold">{milestoneVP} VP</span>
                     </div>
 
                     {milestone.progress !== undefined &&
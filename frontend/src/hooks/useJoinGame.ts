@@ -1,6 +1,7 @@
-import { useState } from "react";
+import { useEffect, useState } from "react";
 import { useNavigate } from "react-router-dom";
 import { GameDto } from "@/types/generated/api-types";
+import { apiService } from "@/services/apiService";
 import { globalWebSocketManager } from "@/services/globalWebSocketManager";
 import { skyboxCache } from "@/services/SkyboxCache";
 import { saveGameSession } from "@/utils/sessionStorage";
@@ -8,11 +9,13 @@ import { useNotifications } from "@/contexts/NotificationContext";
 
 interface UseJoinGameOptions {
   game: GameDto | null;
+  inviteToken?: string; // Claims the seat an invite reserved; the name is fixed to the invite's seat
 }
 
 interface UseJoinGameReturn {
   playerName: string;
   setPlayerName: (name: string) => void;
+  isInvited: boolean;
   isLoading: boolean;
   loadingStep: "game" | "environment" | null;
   handleJoin: () => Promise<void>;
@@ -20,13 +23,38 @@ interface UseJoinGameReturn {
   loadingMessage: string;
 }
 
-export function useJoinGame({ game }: UseJoinGameOptions): UseJoinGameReturn {
+export function useJoinGame({ game, inviteToken }: UseJoinGameOptions): UseJoinGameReturn {
   const navigate = useNavigate();
   const { showNotification } = useNotifications();
   const [playerName, setPlayerName] = useState("");
+  const [isInvited, setIsInvited] = useState(false);
   const [isLoading, setIsLoading] = useState(false);
   const [loadingStep, setLoadingStep] = useState<"game" | "environment" | null>(null);
 
+  useEffect(() => {
+    if (!game || !inviteToken) return;
+    let cancelled = false;
+    apiService
+      .getInvite(game.id, inviteToken)
+      .then((invite) => {
+        if (cancelled) return;
+        if (!invite) {
+          showNotification({ message: "This invite is no longer valid", type: "error" });
+          return;
+        }
+        setPlayerName(invite.seatName);
+        setIsInvited(true);
+      })
+      .catch(() => {
+        if (!cancelled) {
+          showNotification({ message: "Failed to load invite", type: "error" });
+        }
+      });
+    return () => {
+      cancelled = true;
+    };
+  }, [game, inviteToken, showNotification]);
+
   const handleJoin = async () => {
     if (!game) {
       showNotification({ message: "No game selected", type: "error" });
@@ -84,7 +112,12 @@ export function useJoinGame({ game }: UseJoinGameOptions): UseJoinGameReturn {
       };
 
       globalWebSocketManager.on("game-updated", handleGameUpdated);
-      globalWebSocketManager.playerConnect(trimmed, game.id);
+      globalWebSocketManager.playerConnect(
+        trimmed,
+        game.id,
+        undefined,
+        isInvited ? inviteToken : undefined,
+      );
     } catch (err) {
       if (err instanceof Error) {
         if (err.message.includes("WebSocket")) {
@@ -115,6 +148,7 @@ export function useJoinGame({ game }: UseJoinGameOptions): UseJoinGameReturn {
   return {
     playerName,
     setPlayerName,
+    isInvited,
     isLoading,
     loadingStep,
     handleJoin,
@@ -4,9 +4,11 @@ import {
   CreateDemoLobbyRequest,
   CreateDemoLobbyResponse,
   GameDto,
-  GameSettingsDto,
   GetGameResponse,
+  InviteDto,
+  InviteResponse,
   ListGamesResponse,
+  ListInvitesResponse,
   ListCardsResponse,
   StateDiffDto,
 } from "../types/generated/api-types.ts";
@@ -19,14 +21,8 @@ export class ApiService {
     this.baseUrl = baseUrl;
   }
 
-  async createGame(settings: GameSettingsDto): Promise<GameDto> {
+  async createGame(request: CreateGameRequest): Promise<GameDto> {
     try {
-      const request: CreateGameRequest = {
-        maxPlayers: settings.maxPlayers,
-        developmentMode: settings.developmentMode,
-        cardPacks: settings.cardPacks,
-      };
-
       const response = await fetch(`${this.baseUrl}/games`, {
         method: "POST",
         headers: {
@@ -159,6 +155,72 @@ export class ApiService {
       throw error;
     }
   }
+
+  async createInvite(gameId: string, playerId: string, seatName: string): Promise<InviteDto> {
+    const response = await fetch(`${this.baseUrl}/games/${gameId}/invites`, {
+      method: "POST",
+      headers: {
+        "Content-Type": "application/json",
+      },
+      body: JSON.stringify({ playerId, seatName }),
+    });
+
+    if (!response.ok) {
+      const errorData = await response.json();
+      throw new Error(errorData.message || `HTTP error! status: ${response.status}`);
+    }
+
+    const inviteResponse: InviteResponse = await response.json();
+    return inviteResponse.invite;
+  }
+
+  async listInvites(gameId: string, playerId: string): Promise<InviteDto[]> {
+    const url = new URL(`${this.baseUrl}/games/${gameId}/invites`);
+    url.searchParams.set("playerId", playerId);
+
+    const response = await fetch(url.toString());
+
+    if (!response.ok) {
+      const errorData = await response.json();
+      throw new Error(errorData.message || `HTTP error! status: ${response.status}`);
+    }
+
+    const listResponse: ListInvitesResponse = await response.json();
+    return listResponse.invites;
+  }
+
+  async getInvite(gameId: string, token: string): Promise<InviteDto | null> {
+    const response = await fetch(
+      `${this.baseUrl}/games/${gameId}/invites/${encodeURIComponent(token)}`,
+    );
+
+    if (response.status === 404) {
+      return null;
+    }
+
+    if (!response.ok) {
+      const errorData = await response.json();
+      throw new Error(errorData.message || `HTTP error! status: ${response.status}`);
+    }
+
+    const inviteResponse: InviteResponse = await response.json();
+    return inviteResponse.invite;
+  }
+
+  async revokeInvite(gameId: string, playerId: string, token: string): Promise<InviteDto[]> {
+    const url = new URL(`${this.baseUrl}/games/${gameId}/invites/${encodeURIComponent(token)}`);
+    url.searchParams.set("playerId", playerId);
+
+    const response = await fetch(url.toString(), { method: "DELETE" });
+
+    if (!response.ok) {
+      const errorData = await response.json();
+      throw new Error(errorData.message || `HTTP error! status: ${response.status}`);
+    }
+
+    const listResponse: ListInvitesResponse = await response.json();
+    return listResponse.invites;
+  }
 }
 
 // Singleton instance
@@ -152,9 +152,14 @@ class GlobalWebSocketManager implements WebSocketConnection {
     }
   }
 
-  async playerConnect(playerName: string, gameId: string, playerId?: string) {
+  async playerConnect(
+    playerName: string,
+    gameId: string,
+    playerId?: string,
+    inviteToken?: string,
+  ) {
     await this.ensureConnected();
-    return webSocketService.playerConnect(playerName, gameId, playerId);
+    return webSocketService.playerConnect(playerName, gameId, playerId, inviteToken);
   }
 
   async sellPatents(): Promise<string> {
@@ -213,6 +218,8 @@ class GlobalWebSocketManager implements WebSocketConnection {
     choiceIndex?: number,
     cardStorageTarget?: string,
     targetPlayerId?: string,
+    attackAmount?: number,
+    discardCardIds?: string[],
   ): Promise<string> {
     await this.ensureConnected();
     return webSocketService.playCard(
@@ -221,6 +228,8 @@ class GlobalWebSocketManager implements WebSocketConnection {
       choiceIndex,
       cardStorageTarget,
       targetPlayerId,
+      attackAmount,
+      discardCardIds,
     );
   }
 
@@ -231,6 +240,7 @@ class GlobalWebSocketManager implements WebSocketConnection {
     cardStorageTarget?: string,
     targetPlayerId?: string,
     sourceCardForInput?: string,
+    discardCardIds?: string[],
   ): Promise<string> {
     await this.ensureConnected();
     return webSocketService.playCardAction(
@@ -240,6 +250,7 @@ class GlobalWebSocketManager implements WebSocketConnection {
       cardStorageTarget,
       targetPlayerId,
       sourceCardForInput,
+      discardCardIds,
     );
   }
 
@@ -289,6 +300,11 @@ class GlobalWebSocketManager implements WebSocketConnection {
     return webSocketService.kickPlayer(targetPlayerId);
   }
 
+  async requestSync(): Promise<string> {
+    await this.ensureConnected();
+    return webSocketService.requestSync();
+  }
+
   get connected() {
     return webSocketService.connected;
   }
@@ -1,21 +1,31 @@
 import { v4 as uuidv4 } from "uuid";
 import { getWebSocketUrl } from "../config";
+import { decodeMsgpack } from "../utils/msgpack.ts";
+import { applyJsonPatch } from "../utils/jsonPatch.ts";
 import {
   CardPaymentDto,
   ConfirmDemoSetupRequest,
   ErrorPayload,
   FullStatePayload,
+  GameDto,
   GameUpdatedPayload,
+  HelloPayload,
   LogUpdatePayload,
   MessageType,
+  MessageTypeActionSuccess,
   MessageTypeError,
   MessageTypeFullState,
+  MessageTypeGameCreated,
   MessageTypeGameUpdated,
+  MessageTypeHello,
+  MessageTypeHelloAck,
   MessageTypeLogUpdate,
   MessageTypePlayerConnect,
   MessageTypePlayerConnected,
   MessageTypePlayerDisconnected,
   MessageTypePlayerKicked,
+  MessageTypeSyncRequest,
+  MessageTypeSyncResponse,
   // New message types
   MessageTypeActionSellPatents,
   MessageTypeActionLaunchAsteroid,
@@ -41,6 +51,10 @@ import {
   // Payload types
   PlayerConnectedPayload,
   PlayerDisconnectedPayload,
+  StateDiffDto,
+  SyncModeDeltas,
+  SyncRequestPayload,
+  SyncResponsePayload,
   WebSocketMessage,
 } from "../types/generated/api-types.ts";
 
@@ -56,6 +70,11 @@ export class WebSocketService {
   private reconnectDelay = 1000;
   private currentGameId: string | null = null;
   private currentPlayerId: string | null = null;
+  private lastActionSequence = 0;
+  // Last applied game state and its sequence, the base a sync-response patch applies to
+  private lastGameState: GameDto | null = null;
+  private lastStateSequence = 0;
+  private lastLogSequence = 0;
   private pendingConnection: Promise<void> | null = null;
   private shouldReconnect = true;
 
@@ -83,11 +102,15 @@ export class WebSocketService {
         }
 
         this.ws = new WebSocket(this.url);
+        this.ws.binaryType = "arraybuffer";
 
         this.ws.onopen = () => {
           this.isConnected = true;
           this.pendingConnection = null;
           this.reconnectAttempts = 0;
+          // Ask for compact server frames before anything else is sent
+          const hello: HelloPayload = { encodings: ["msgpack", "json"], compression: true };
+          this.send(MessageTypeHello, hello);
           this.emit("connect");
           resolve();
         };
@@ -95,7 +118,8 @@ export class WebSocketService {
         this.ws.onmessage = (event) => {
           let message: any;
           try {
-            message = JSON.parse(event.data);
+            const data = event.data;
+            message = data instanceof ArrayBuffer ? decodeMsgpack(data) : JSON.parse(data);
           } catch (error) {
             console.error("Failed to parse WebSocket message:", error);
             return;
@@ -135,14 +159,25 @@ export class WebSocketService {
   }
 
   private handleMessage(message: WebSocketMessage) {
+    // Broadcasts are stamped with the game's action sequence; states can be coalesced, so gaps are expected
+    if (message.actionSequence && message.actionSequence > this.lastActionSequence) {
+      this.lastActionSequence = message.actionSequence;
+    }
+
     switch (message.type) {
       case MessageTypeGameUpdated: {
         const gamePayload = message.payload as GameUpdatedPayload;
         // Handle both direct game data and nested structure
         const gameData = gamePayload.game || gamePayload;
+        this.lastGameState = gameData as GameDto;
+        this.lastStateSequence = message.actionSequence ?? this.lastStateSequence;
         this.emit("game-updated", gameData);
         break;
       }
+      case MessageTypeSyncResponse: {
+        this.applySyncResponse(message.payload as SyncResponsePayload);
+        break;
+      }
       case MessageTypePlayerConnected: {
         const connectedPayload = message.payload as PlayerConnectedPayload;
         // This is a confirmation that player joined successfully
@@ -168,9 +203,17 @@ export class WebSocketService {
       }
       case MessageTypeLogUpdate: {
         const logPayload = message.payload as LogUpdatePayload;
+        this.trackLogs(logPayload.logs);
         this.emit("log-update", logPayload.logs);
         break;
       }
+      case MessageTypeHelloAck:
+        // Frames after the ack may be binary; onmessage handles both formats
+        break;
+      case MessageTypeActionSuccess:
+      case MessageTypeGameCreated:
+        // Acknowledgements only; the resulting state arrives as game-updated
+        break;
       case MessageTypePlayerKicked: {
         this.emit("player-kicked", message.payload);
         break;
@@ -180,6 +223,53 @@ export class WebSocketService {
     }
   }
 
+  private applySyncResponse(response: SyncResponsePayload) {
+    if (response.mode === SyncModeDeltas) {
+      // A game-updated that arrived after the request moved the base; ask again from there
+      if (!this.lastGameState || response.fromSequence !== this.lastStateSequence) {
+        if (response.actionSequence > this.lastStateSequence) {
+          this.requestSync();
+        }
+        return;
+      }
+      this.lastGameState = applyJsonPatch(this.lastGameState, response.patch ?? []);
+    } else if (response.game) {
+      this.lastGameState = response.game;
+    }
+
+    this.lastStateSequence = response.actionSequence;
+    this.emit("game-updated", this.lastGameState);
+    if (response.logs.length > 0) {
+      this.trackLogs(response.logs);
+      this.emit("log-update", response.logs);
+    }
+  }
+
+  private trackLogs(logs: StateDiffDto[]) {
+    for (const log of logs) {
+      this.lastLogSequence = Math.max(this.lastLogSequence, log.sequenceNumber);
+    }
+  }
+
+  private resetGameState() {
+    this.lastActionSequence = 0;
+    this.lastGameState = null;
+    this.lastStateSequence = 0;
+    this.lastLogSequence = 0;
+  }
+
+  /**
+   * Asks for the changes since the last applied game state; the answer arrives as game-updated and
+   * log-update events, so listeners do not need to handle it separately.
+   */
+  requestSync(): string {
+    const payload: SyncRequestPayload = {
+      sinceSequence: this.lastStateSequence,
+      sinceLogSequence: this.lastLogSequence,
+    };
+    return this.send(MessageTypeSyncRequest, payload);
+  }
+
   send(type: MessageType, payload: unknown, gameId?: string): string {
     const reqId = uuidv4();
 
@@ -198,12 +288,18 @@ export class WebSocketService {
     return reqId;
   }
 
-  playerConnect(playerName: string, gameId: string, playerId?: string): void {
+  playerConnect(playerName: string, gameId: string, playerId?: string, inviteToken?: string): void {
     const payload: any = { playerName, gameId };
     if (playerId) {
       payload.playerId = playerId;
     }
+    if (inviteToken) {
+      payload.inviteToken = inviteToken;
+    }
 
+    if (gameId !== this.currentGameId) {
+      this.resetGameState();
+    }
     this.send(MessageTypePlayerConnect, payload, gameId);
     this.currentGameId = gameId;
   }
@@ -258,6 +354,8 @@ export class WebSocketService {
     choiceIndex?: number,
     cardStorageTarget?: string,
     targetPlayerId?: string,
+    attackAmount?: number,
+    discardCardIds?: string[],
   ): string {
     return this.send(MessageTypeActionPlayCard, {
       type: "play-card",
@@ -266,6 +364,8 @@ export class WebSocketService {
       ...(choiceIndex !== undefined && { choiceIndex }),
       ...(cardStorageTarget !== undefined && { cardStorageTarget }),
       ...(targetPlayerId !== undefined && { targetPlayerId }),
+      ...(attackAmount !== undefined && { attackAmount }),
+      ...(discardCardIds !== undefined && { discardCardIds }),
     });
   }
 
@@ -276,6 +376,7 @@ export class WebSocketService {
     cardStorageTarget?: string,
     targetPlayerId?: string,
     sourceCardForInput?: string,
+    discardCardIds?: string[],
   ): string {
     return this.send(MessageTypeActionCardAction, {
       type: "card-action",
@@ -285,6 +386,7 @@ export class WebSocketService {
       ...(cardStorageTarget !== undefined && { cardStorageTarget }),
       ...(targetPlayerId !== undefined && { targetPlayerId }),
       ...(sourceCardForInput !== undefined && { sourceCardForInput }),
+      ...(discardCardIds !== undefined && { discardCardIds }),
     });
   }
 
@@ -387,6 +489,7 @@ export class WebSocketService {
     this.isConnected = false;
     this.currentGameId = null;
     this.currentPlayerId = null;
+    this.resetGameState();
   }
 
   get connected() {
@@ -400,6 +503,10 @@ export class WebSocketService {
   get gameId() {
     return this.currentGameId;
   }
+
+  get actionSequence() {
+    return this.lastActionSequence;
+  }
 }
 
 // Singleton instance for application-wide use
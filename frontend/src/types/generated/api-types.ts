@@ -1,5 +1,36 @@
 // Code generated by tygo. DO NOT EDIT.
 
+//////////
+// source: action_catalog.go
+
+/**
+ * ActionCatalogFieldDto describes a single field of an action request payload
+ */
+export interface ActionCatalogFieldDto {
+  name: string;
+  type: string;
+  required: boolean;
+  constraints?: string;
+  description: string;
+}
+/**
+ * ActionCatalogEntryDto describes a WebSocket action request and the phases it is valid in
+ */
+export interface ActionCatalogEntryDto {
+  type: MessageType;
+  description: string;
+  phases: GamePhase[];
+  fields: ActionCatalogFieldDto[];
+  examplePayload: Record<string, any>;
+}
+/**
+ * ActionCatalogResponse represents the response for the action catalog endpoint
+ */
+export interface ActionCatalogResponse {
+  phase?: GamePhase;
+  actions: ActionCatalogEntryDto[];
+}
+
 //////////
 // source: actions_dto.go
 
@@ -28,6 +59,7 @@ export interface SelectStartingCardAction {
   type: ActionType;
   cardIds: string[];
   corporationId: string;
+  preludeIds?: string[];
 }
 /**
  * StartGameAction represents starting the game (host only)
@@ -113,6 +145,7 @@ export interface ActionSelectStartingCardRequest {
   type: ActionType;
   cardIds: string[];
   corporationId: string; // Corporation selected alongside starting cards
+  preludeIds?: string[]; // Preludes to keep when the prelude pack is enabled
 }
 /**
  * ActionSelectProductionCardsRequest contains the action data for select production card actions
@@ -145,6 +178,97 @@ export interface ConfirmDemoSetupRequest {
   globalParameters?: GlobalParametersDto; // Host only
   generation?: number /* int */; // Host only
 }
+/**
+ * SetSeatOrderRequest contains the host's lobby seating arrangement
+ */
+export interface SetSeatOrderRequest {
+  seatOrder?: string[]; // Player IDs in seat order (ignored when randomize is true)
+  randomize: boolean; // Shuffle seats at game start instead
+}
+/**
+ * SetHandicapRequest contains the host's handicap for one seat; all-zero bonuses clear it
+ */
+export interface SetHandicapRequest {
+  playerId: string;
+  extraCredits: number /* int */; // 0-20
+  extraCards: number /* int */; // 0-5, added to the starting card selection
+  extraTR: number /* int */; // 0-5
+}
+/**
+ * ForceAdvancePhaseRequest contains the host's confirmation for forcing the production phase forward
+ */
+export interface ForceAdvancePhaseRequest {
+  confirmed: boolean; // Must be true; players still choosing cards buy none
+}
+/**
+ * SkipActionRequest contains the optional confirmation for passing with convertible resources
+ */
+export interface SkipActionRequest {
+  confirmed?: boolean; // Pass even though plants or heat could still be converted
+}
+/**
+ * SetNoteRequest contains the sending player's private note for this game
+ */
+export interface SetNoteRequest {
+  note: string; // At most 2000 characters; empty clears the note
+}
+/**
+ * RunBatchRequest contains an ordered batch of the sending player's actions, run as one unit
+ */
+export interface RunBatchRequest {
+  steps: BatchStepRequest[]; // 1 to 10 steps, run in order
+}
+/**
+ * BatchStepRequest is one action of a batch: a message type and the payload it would be sent with
+ */
+export interface BatchStepRequest {
+  type: MessageType;
+  payload?: Record<string, any>;
+}
+/**
+ * SendReactionRequest contains a canned reaction for the table
+ */
+export interface SendReactionRequest {
+  reaction: string; // thumbs-up, nice-move, well-played, wow, thinking, oops or frowny-asteroid
+}
+/**
+ * MuteReactionsRequest hides or shows reactions for the sending player
+ */
+export interface MuteReactionsRequest {
+  targetPlayerId?: string; // Player to mute; unset mutes everyone
+  muted: boolean;
+}
+/**
+ * SetPreferencesRequest contains the sending player's own preferences for this game
+ */
+export interface SetPreferencesRequest {
+  autoPass: boolean; // Pass automatically when a turn starts with no legal action
+  locale?: string; // Language of server messages, e.g. "de"; unset keeps the current one
+}
+/**
+ * SetPlayerColorRequest contains the host's color override for one player
+ */
+export interface SetPlayerColorRequest {
+  playerId: string;
+  color: string; // "#RRGGBB"; must be a free color from the game's palette
+}
+/**
+ * PreparePlayCardRequest reserves a card in hand and asks for its play preview
+ */
+export interface PreparePlayCardRequest {
+  cardId: string;
+}
+/**
+ * CommitPlayCardRequest plays the prepared card with the chosen payment and options
+ */
+export interface CommitPlayCardRequest {
+  payment: CardPaymentDto;
+  choiceIndex?: number /* int */; // Required when the preview reports choices
+  cardStorageTarget?: string; // Target card for outputs with target "any-card"
+  targetPlayerId?: string; // Player targeted by attacks
+  attackAmount?: number /* int */; // How many resources "up to" removals take; omit for the full amount
+  discardCardIds?: string[]; // Cards discarded to pay card-discard inputs
+}
 /**
  * ActionPlayCardRequest contains the action data for play card actions
  */
@@ -241,6 +365,8 @@ export const AdminCommandTypeStartTileSelection: AdminCommandType = "start-tile-
 export const AdminCommandTypeSetCurrentTurn: AdminCommandType = "set-current-turn";
 export const AdminCommandTypeSetCorporation: AdminCommandType = "set-corporation";
 export const AdminCommandTypeSetTR: AdminCommandType = "set-tr";
+export const AdminCommandTypeSetupTestState: AdminCommandType = "setup-test-state";
+export const AdminCommandTypeSetAwardFreeze: AdminCommandType = "set-award-freeze";
 /**
  * AdminCommandRequest contains the admin command data
  */
@@ -254,6 +380,7 @@ export interface AdminCommandRequest {
 export interface GiveCardAdminCommand {
   playerId: string;
   cardId: string;
+  allowAnyPack?: boolean; // Allow a card from a pack the game was not created with
 }
 /**
  * SetPhaseAdminCommand represents setting the game phase
@@ -302,6 +429,43 @@ export interface SetTRAdminCommand {
   playerId: string;
   terraformRating: number /* int */;
 }
+/**
+ * SetupTestStateAdminCommand applies a whole test fixture in one step; unset fields are left as they are
+ */
+export interface SetupTestStateAdminCommand {
+  generation?: number /* int */;
+  phase?: string;
+  currentTurnPlayerId?: string;
+  globalParameters?: GlobalParametersDto;
+  tiles?: FixtureTileDto[];
+  players?: FixturePlayerDto[];
+}
+/**
+ * FixtureTileDto is a tile placed by a test fixture, without placement bonuses
+ */
+export interface FixtureTileDto {
+  hexPosition: HexPositionDto;
+  tileType: string; // city, greenery or ocean
+  ownerId?: string; // Unset for a neutral tile
+}
+/**
+ * FixturePlayerDto is the state a test fixture gives one player
+ */
+export interface FixturePlayerDto {
+  playerId: string;
+  corporationId?: string;
+  resources?: ResourcesDto;
+  production?: ProductionDto;
+  terraformRating?: number /* int */;
+  hand?: string[]; // Replaces the hand
+  playedCards?: string[]; // Added without paying or immediate effects
+}
+/**
+ * SetAwardFreezeAdminCommand turns freezing award standings at funding on or off for awards funded from now on
+ */
+export interface SetAwardFreezeAdminCommand {
+  enabled: boolean;
+}
 /**
  * CardPaymentDto represents how a player is paying for a card
  */
@@ -333,6 +497,8 @@ export type GameStatus = string;
 export const GameStatusLobby: GameStatus = "lobby";
 export const GameStatusActive: GameStatus = "active";
 export const GameStatusCompleted: GameStatus = "completed";
+export const GameStatusAbandoned: GameStatus = "abandoned";
+export const GameStatusFailed: GameStatus = "failed";
 /**
  * CardType represents different types of cards
  */
@@ -393,13 +559,17 @@ export const ResourceTypeDisease: ResourceType = "disease";
 export const ResourceTypeCardDraw: ResourceType = "card-draw";
 export const ResourceTypeCardTake: ResourceType = "card-take";
 export const ResourceTypeCardPeek: ResourceType = "card-peek";
+export const ResourceTypeCardSearch: ResourceType = "card-search";
+export const ResourceTypeCardDiscard: ResourceType = "card-discard";
 export const ResourceTypeCityPlacement: ResourceType = "city-placement";
 export const ResourceTypeOceanPlacement: ResourceType = "ocean-placement";
 export const ResourceTypeGreeneryPlacement: ResourceType = "greenery-placement";
+export const ResourceTypeSpecialPlacement: ResourceType = "special-placement";
 export const ResourceTypeCityTile: ResourceType = "city-tile";
 export const ResourceTypeOceanTile: ResourceType = "ocean-tile";
 export const ResourceTypeGreeneryTile: ResourceType = "greenery-tile";
 export const ResourceTypeColonyTile: ResourceType = "colony-tile";
+export const ResourceTypeSpecialTile: ResourceType = "special-tile";
 export const ResourceTypeTemperature: ResourceType = "temperature";
 export const ResourceTypeOxygen: ResourceType = "oxygen";
 export const ResourceTypeVenus: ResourceType = "venus";
@@ -425,6 +595,7 @@ export const TargetSelfPlayer: TargetType = "self-player";
 export const TargetSelfCard: TargetType = "self-card";
 export const TargetAnyCard: TargetType = "any-card";
 export const TargetAnyPlayer: TargetType = "any-player";
+export const TargetAnyPlayerChoice: TargetType = "any-player-choice"; // Outputs the target player chooses between
 export const TargetOpponent: TargetType = "opponent";
 export const TargetNone: TargetType = "none";
 /**
@@ -490,8 +661,9 @@ export interface ResourceSet {
  */
 export interface TileRestrictionsDto {
   boardTags?: string[];
-  adjacency?: string; // "none" = no adjacent occupied tiles
-  onTileType?: string; // "ocean" = only on ocean spaces
+  adjacency?: string; // "none" = no adjacent occupied tiles, "own" = next to one of your tiles
+  onTileType?: string; // "ocean" = only on ocean spaces, "land" = only on land spaces
+  captureBonus?: string[]; // Only areas with one of these placement bonuses; that production rises 1 step
 }
 /**
  * SelectorDto represents matching criteria for cards, resources, or projects.
@@ -516,6 +688,7 @@ export interface ResourceConditionDto {
   maxTrigger?: number /* int */;
   per?: PerConditionDto;
   tileRestrictions?: TileRestrictionsDto;
+  specialTileType?: string;
 }
 /**
  * PerConditionDto represents a per condition for client consumption
@@ -569,6 +742,7 @@ export interface CardBehaviorDto {
   outputs?: ResourceConditionDto[];
   choices?: ChoiceDto[];
   generationalEventRequirements?: GenerationalEventRequirementDto[];
+  usageLimit?: UsageLimitDto;
 }
 /**
  * PaymentConstantsDto represents payment conversion rates
@@ -631,10 +805,13 @@ export interface CardDto {
   vpConditions?: VPConditionDto[];
   startingResources?: ResourceSet;
   startingProduction?: ResourceSet;
+  isFaceDown?: boolean; // Played event: counts only as an event tag
 }
 export interface SelectStartingCardsPhaseDto {
   availableCards: CardDto[]; // Cards available for selection
   availableCorporations: CardDto[]; // Corporation cards available for selection (2 corporations)
+  availablePreludes?: CardDto[]; // Preludes dealt when the prelude pack is enabled; keep 2
+  canMulligan: boolean; // The mulligan rule is on and this player has not redrawn yet
 }
 export interface SelectStartingCardsOtherPlayerDto {}
 /**
@@ -664,7 +841,34 @@ export interface GameSettingsDto {
   maxPlayers: number /* int */;
   developmentMode: boolean;
   demoGame: boolean;
+  passAndPlay: boolean;
+  analytics: boolean;
   cardPacks?: string[];
+  colorPalette: string[];
+  rulesOptions: RulesOptionsDto;
+}
+/**
+ * RulesOptionsDto contains the official rule variants enabled for a game
+ */
+export interface RulesOptionsDto {
+  draftVariant: boolean;
+  fastMode: boolean;
+  showTimers: boolean;
+  milestoneAwardSet: string;
+  soloTRDecay: boolean;
+  speedPreset?: string; // "blitz", "standard" or "relaxed": fills in the timers left unset
+  turnTimerSeconds?: number /* int */; // 0 or unset: turns have no time limit
+  timebankSeconds?: number /* int */; // Extra time per player for turns that run over the turn timer
+  researchTimeoutSeconds?: number /* int */; // 0 or unset: wait for every card purchase
+  startingSelectionTimeoutSeconds?: number /* int */; // 0 or unset: wait for every starting selection
+  mulliganStartingHand?: boolean; // House rule: one redraw of the starting project cards
+  soloGoal?: string; // "terraform" or "tr63": win by the end of generation 14; unset: no limit
+  soloNeutralTiles?: boolean; // Place neutral cities and greeneries at solo setup
+  liveScores?: boolean; // Broadcast an estimated score per player; unset: only TR is shown
+  liveScoresExcludeEvents?: boolean; // Leave face-down event cards out of the live estimate
+  milestoneVP?: number /* int */; // House rule: VP per claimed milestone, 0 allowed; unset: 5
+  awardFirstPlaceVP?: number /* int */; // House rule: VP for first place in an award, 0 allowed; unset: 5
+  awardSecondPlaceVP?: number /* int */; // House rule: VP for second place, at most first place's; unset: 2
 }
 /**
  * GlobalParametersDto represents the terraforming progress
@@ -673,6 +877,18 @@ export interface GlobalParametersDto {
   temperature: number /* int */; // Range: -30 to +8°C
   oxygen: number /* int */; // Range: 0-14%
   oceans: number /* int */; // Range: 0-9
+  tracks?: GlobalParameterTrackDto[]; // Game state only: bounds and steps of each parameter
+}
+/**
+ * GlobalParameterTrackDto describes the values one global parameter can take
+ */
+export interface GlobalParameterTrackDto {
+  parameter: string; // "temperature", "oxygen" or "oceans"
+  unit: string; // "°C", "%", or empty for a count
+  min: number /* int */;
+  max: number /* int */;
+  step: number /* int */; // Change in value per step
+  trPerStep: number /* int */; // Terraform rating gained per step raised
 }
 /**
  * ResourcesDto represents a player's resources
@@ -727,9 +943,11 @@ export const ErrorCodeInsufficientProduction: StateErrorCode = "insufficient-pro
 export const ErrorCodeNoOceanTiles: StateErrorCode = "no-ocean-tiles";
 export const ErrorCodeNoCityPlacements: StateErrorCode = "no-city-placements";
 export const ErrorCodeNoGreeneryPlacements: StateErrorCode = "no-greenery-placements";
+export const ErrorCodeNoSpecialPlacements: StateErrorCode = "no-special-placements";
 export const ErrorCodeNoCardsInHand: StateErrorCode = "no-cards-in-hand";
 export const ErrorCodeInvalidProjectType: StateErrorCode = "invalid-project-type";
 export const ErrorCodeInvalidRequirement: StateErrorCode = "invalid-requirement";
+export const ErrorCodeExpansionDisabled: StateErrorCode = "expansion-disabled";
 export const ErrorCodeInvalidCardType: StateErrorCode = "invalid-card-type";
 /**
  * StateErrorCategory represents categories for error grouping.
@@ -759,6 +977,7 @@ export interface StateErrorDto {
  */
 export type StateWarningCode = string;
 export const WarningCodeNoValidTilePlacements: StateWarningCode = "no-valid-tile-placements";
+export const WarningCodeDiscardSelectionRequired: StateWarningCode = "discard-selection-required";
 /**
  * StateWarningDto represents a non-blocking warning about an action
  * Warnings inform the player of potential issues without preventing the action
@@ -788,6 +1007,7 @@ export interface PlayerCardDto {
   warnings?: StateWarningDto[]; // Non-blocking warnings
   effectiveCost: number /* int */; // Effective cost after discounts (credits)
   discounts?: { [key: string]: number /* int */ }; // Discount amounts per resource type (if any)
+  legalTargets?: string[]; // Players the card's attacks may target (attack cards only, multiplayer)
 }
 /**
  * PlayerEffectDto represents ongoing effects that a player has active for client consumption
@@ -810,8 +1030,10 @@ export interface PlayerActionDto {
   behavior: CardBehaviorDto; // The actual behavior definition with inputs/outputs
   timesUsedThisTurn: number /* int */; // Times used this turn
   timesUsedThisGeneration: number /* int */; // Times used this generation
+  timesUsedThisGame: number /* int */; // Times used this game
   available: boolean; // Computed: action is usable
   errors: StateErrorDto[]; // Reasons why action is not usable
+  warnings?: StateWarningDto[]; // Non-blocking warnings
 }
 /**
  * PlayerStandardProjectDto represents a standard project with availability state
@@ -843,6 +1065,32 @@ export interface PendingTileSelectionDto {
   availableHexes: string[]; // Backend-calculated valid hex coordinates
   source: string; // What triggered this selection (card ID, standard project, etc.)
 }
+/**
+ * PendingCardPlayDto represents a card reserved with prepare-play-card, awaiting commit or cancel
+ */
+export interface PendingCardPlayDto {
+  cardId: string;
+  effectiveCost: number /* int */;
+  requiresChoice: boolean; // Commit must include a choiceIndex
+  pendingPlacements: string[]; // Tile types queued on commit
+}
+/**
+ * PendingResponseDto is a choice another player's effect asks of the viewing player
+ */
+export interface PendingResponseDto {
+  sourcePlayerId: string; // Player whose effect opened the response
+  source: string; // Card name
+  options: ResponseOptionDto[];
+  defaultOption: number /* int */; // Applied when the deadline passes
+  deadline: string; // ISO 8601; pushed back while the game is paused
+}
+/**
+ * ResponseOptionDto is one answer to a pending response: lose Amount of ResourceType (clamped to what the player has)
+ */
+export interface ResponseOptionDto {
+  resourceType: ResourceType;
+  amount: number /* int */;
+}
 /**
  * PendingCardSelectionDto represents a pending card selection action (e.g., sell patents, card effects)
  */
@@ -878,6 +1126,7 @@ export const PlayerStatusActive: PlayerStatus = "active";
 export interface PlayerDto {
   id: string;
   name: string;
+  color: string; // "#RRGGBB" from the game's palette
   status: PlayerStatus;
   corporation?: CardDto;
   cards: PlayerCardDto[]; // Hand cards with playability state (Player-Scoped Architecture)
@@ -888,6 +1137,12 @@ export interface PlayerDto {
   passed: boolean;
   availableActions: number /* int */;
   isConnected: boolean;
+  autoPass: boolean; // Preference: pass automatically when a turn starts with no legal action
+  locale?: string; // Preference: language of server messages; unset for English
+  mutedPlayerIds: string[]; // Preference: players whose reactions are hidden
+  reactionsMuted: boolean; // Preference: hide every player's reactions
+  note?: string; // Private note for this game; never sent to other players
+  preludes?: CardDto[]; // Preludes kept from the starting selection; private until played
   effects: PlayerEffectDto[]; // Active ongoing effects (discounts, special abilities, etc.)
   actions: PlayerActionDto[]; // Available actions from played cards with manual triggers
   standardProjects: PlayerStandardProjectDto[]; // Standard projects with availability state (Player-Scoped Architecture)
@@ -897,13 +1152,17 @@ export interface PlayerDto {
   productionPhase?: ProductionPhaseDto;
   startingCards: CardDto[];
   pendingTileSelection?: PendingTileSelectionDto;
+  pendingCardPlay?: PendingCardPlayDto;
   pendingCardSelection?: PendingCardSelectionDto;
   pendingCardDrawSelection?: PendingCardDrawSelectionDto;
+  pendingResponse?: PendingResponseDto; // Another player's effect waiting on this player
   forcedFirstAction?: ForcedFirstActionDto;
   resourceStorage: { [key: string]: number /* int */ };
   paymentSubstitutes: PaymentSubstituteDto[];
   generationalEvents: PlayerGenerationalEventEntryDto[];
   vpGranters: VPGranterDto[];
+  cardVP: { [key: string]: number /* int */ }; // Current VP of each played card, scored as at game end
+  estimatedScore?: number /* int */; // Score if the game ended now; only with the liveScores option
 }
 /**
  * OtherPlayerDto represents another player from the viewing player's perspective (limited data)
@@ -911,9 +1170,10 @@ export interface PlayerDto {
 export interface OtherPlayerDto {
   id: string;
   name: string;
+  color: string; // "#RRGGBB" from the game's palette
   status: PlayerStatus;
   corporation?: CardDto;
-  handCardCount: number /* int */; // Number of cards in hand (private)
+  handCardCount: number /* int */; // Number of cards in hand; public, unlike the cards
   resources: ResourcesDto;
   production: ProductionDto;
   terraformRating: number /* int */;
@@ -927,6 +1187,24 @@ export interface OtherPlayerDto {
   productionPhase?: ProductionPhaseOtherPlayerDto;
   resourceStorage: { [key: string]: number /* int */ };
   paymentSubstitutes: PaymentSubstituteDto[];
+  cardVP?: { [key: string]: number /* int */ }; // Current VP of each played card; only with the liveScores option
+  estimatedScore?: number /* int */; // Score if the game ended now; only with the liveScores option
+}
+/**
+ * HandicapDto represents a per-seat starting bonus
+ */
+export interface HandicapDto {
+  extraCredits: number /* int */;
+  extraCards: number /* int */;
+  extraTR: number /* int */;
+}
+/**
+ * ConcededPlayerDto names a player who conceded; their tiles stay on the board under their ID
+ */
+export interface ConcededPlayerDto {
+  playerId: string;
+  playerName: string;
+  concededAt: string;
 }
 /**
  * GameDto represents a game for client consumption (clean architecture)
@@ -943,7 +1221,9 @@ export interface GameDto {
   viewingPlayerId: string; // The player viewing this game state
   currentTurn?: string; // Whose turn it is (nullable)
   generation: number /* int */;
-  turnOrder: string[]; // Turn order of all players in game
+  turnOrder: string[]; // Turn order of all players in game (lobby seating before start)
+  randomizeSeats: boolean; // Whether seating is shuffled at game start
+  handicaps: { [key: string]: HandicapDto }; // Per-player starting bonuses (kept after start as a record)
   board: BoardDto; // Game board with tiles and occupancy state
   paymentConstants: PaymentConstantsDto; // Conversion rates for alternative payments
   milestones: MilestoneDto[]; // All milestones with claim status
@@ -951,6 +1231,47 @@ export interface GameDto {
   awardResults: AwardResultDto[]; // Current award placements (1st/2nd place per award)
   finalScores?: FinalScoreDto[]; // Final scores (only when game completed)
   triggeredEffects?: TriggeredEffectDto[]; // Recently triggered passive effects
+  pause: PauseDto; // Paused state and pending pause/resume votes
+  abandonVotes: string[]; // Players who voted to abandon the game
+  concededPlayers: ConcededPlayerDto[]; // Players who left by conceding, in order
+  researchDeadline?: string; // ISO 8601; production phase only, when unconfirmed players buy no cards
+  startingDeadline?: string; // ISO 8601; starting selection only, when undecided players are picked for
+  phaseStartedAt: string; // ISO 8601; when the current phase began, not counting time paused
+  turnStartedAt?: string; // ISO 8601; when the current turn began, not counting time paused
+  turnDeadline?: string; // ISO 8601; action phase only, when the turn is skipped (turn timer plus timebank)
+  timebanks?: { [key: string]: number /* int */ }; // Seconds of timebank left per player, not counting the turn in progress
+  waitingOn: string[]; // Players the current phase is waiting for, in turn order
+  recentActions?: RecentActionDto[]; // Last 20 log entries as summaries (WebSocket state only)
+  cardPiles: CardPilesDto; // Sizes of the shared piles and each player's played piles
+  deckCommitment?: string; // SHA-256 of the deck's seed and starting order; the seed is revealed in the log export
+}
+/**
+ * CardPilesDto holds the number of cards in each pile on the table
+ */
+export interface CardPilesDto {
+  deck: number /* int */; // Project cards left to draw
+  discard: number /* int */; // Discarded project cards
+  corporations: number /* int */; // Corporations left to deal
+  preludes?: number /* int */; // Preludes left to deal (prelude pack only)
+  deckCycles: number /* int */; // Times the discard pile was shuffled back into the deck
+  shortfall: number /* int */; // Cards owed to draw effects after the deck ran out
+  players: { [key: string]: PlayerCardPilesDto };
+}
+/**
+ * PlayerCardPilesDto holds the size of a player's played piles
+ */
+export interface PlayerCardPilesDto {
+  faceUp: number /* int */; // Played non-event cards
+  events: number /* int */; // Face-down event pile
+}
+/**
+ * PauseDto describes whether a game is paused and who is asking to change that
+ */
+export interface PauseDto {
+  paused: boolean;
+  pausedBy?: string; // Host, or the player whose vote completed the pause
+  pausedAt?: string; // ISO 8601; clients stop their timers from here
+  votes: string[]; // Players voting to pause (running) or resume (paused)
 }
 /**
  * TileBonusDto represents a resource bonus provided by a tile when occupied
@@ -965,6 +1286,7 @@ export interface TileBonusDto {
 export interface TileOccupantDto {
   type: string;
   tags: string[];
+  specialTileType?: string; // Card marker for special tiles, e.g. "nuclear-zone"
 }
 /**
  * TileDto represents a single hexagonal tile on the game board
@@ -975,9 +1297,11 @@ export interface TileDto {
   type: string;
   location: string;
   displayName?: string;
+  label: string; // Row and column, e.g. "E5"; accepted in place of coordinates
   bonuses: TileBonusDto[];
   occupiedBy?: TileOccupantDto;
   ownerId?: string;
+  ownerColor?: string; // Owner's player color, so every client renders ownership alike
   reservedBy?: string;
 }
 /**
@@ -1158,6 +1482,19 @@ export interface GenerationalEventRequirementDto {
   count?: MinMaxValueDto;
   target?: TargetType;
 }
+/**
+ * UsagePeriod is the span a behavior usage limit counts over
+ */
+export type UsagePeriod = string;
+export const UsagePeriodGeneration: UsagePeriod = "generation";
+export const UsagePeriodGame: UsagePeriod = "game";
+/**
+ * UsageLimitDto caps how often a behavior may be used per generation or per game
+ */
+export interface UsageLimitDto {
+  per: UsagePeriod;
+  times: number /* int */;
+}
 
 //////////
 // source: http_dto.go
@@ -1168,7 +1505,11 @@ export interface GenerationalEventRequirementDto {
 export interface CreateGameRequest {
   maxPlayers: number /* int */;
   developmentMode: boolean;
+  passAndPlay?: boolean; // Show every hand to the one device playing all seats
+  analytics?: boolean; // Contribute anonymized card and corporation stats
   cardPacks?: string[];
+  colorPalette?: string[]; // "#RRGGBB" player colors; defaults to a colorblind-safe palette
+  rulesOptions?: RulesOptionsDto;
 }
 /**
  * CreateGameResponse represents the response for creating a game
@@ -1195,6 +1536,353 @@ export interface JoinGameResponse {
 export interface GetGameResponse {
   game: GameDto;
 }
+/**
+ * GetGameSummaryResponse represents the response for getting a game's read models
+ */
+export interface GetGameSummaryResponse {
+  summary: GameSummaryDto;
+}
+/**
+ * GameSummaryDto holds lightweight read models derived from a game, from one player's point of view
+ */
+export interface GameSummaryDto {
+  gameId: string;
+  status: GameStatus;
+  phase: GamePhase;
+  generation: number /* int */;
+  scoreboard: ScoreboardEntryDto[]; // Ordered by terraform rating, highest first
+  board: BoardSummaryDto; // Occupied tile counts and global parameters
+  tagCounts: Record<string, Record<string, number>>; // Player ID -> tag -> count over played cards
+  availableActions: string[]; // What the viewing player can do right now
+}
+/**
+ * ScoreboardEntryDto is one player's row on the scoreboard
+ */
+export interface ScoreboardEntryDto {
+  playerId: string;
+  name: string;
+  color: string;
+  terraformRating: number /* int */;
+  playedCards: number /* int */;
+  passed: boolean;
+}
+/**
+ * BoardSummaryDto counts occupied tiles on the board
+ */
+export interface BoardSummaryDto {
+  temperature: number /* int */;
+  oxygen: number /* int */;
+  oceans: number /* int */;
+  tilesByType: Record<string, number>; // Tile type -> count
+  tilesByOwner: Record<string, Record<string, number>>; // Player ID -> tile type -> count
+  freeTiles: number /* int */; // Unoccupied spaces
+}
+/**
+ * GameOverlayDto is a compact, spectator-safe view of a game for stream overlays
+ * Only public information is included: no hands, no pending choices
+ */
+export interface GameOverlayDto {
+  gameId: string;
+  status: GameStatus;
+  phase: GamePhase;
+  generation: number /* int */;
+  globalParameters: GlobalParametersDto;
+  currentTurn?: string;
+  players: OverlayPlayerDto[]; // In turn order
+  lastAction?: RecentActionDto; // Newest log entry as a summary
+}
+/**
+ * OverlayPlayerDto is one player's public standing on an overlay
+ */
+export interface OverlayPlayerDto {
+  playerId: string;
+  name: string;
+  color: string;
+  corporation?: string;
+  terraformRating: number /* int */;
+  resources: ResourcesDto;
+  production: ProductionDto;
+  handCardCount: number /* int */; // Number of cards in hand; the cards stay private
+  passed: boolean;
+}
+/**
+ * GetGameOverlayResponse represents the response for getting a game's stream overlay
+ */
+export interface GetGameOverlayResponse {
+  overlay: GameOverlayDto;
+}
+/**
+ * GameLogExportResponse is a finished game's complete log with the settings and results needed to archive it
+ */
+export interface GameLogExportResponse {
+  gameId: string;
+  status: GameStatus; // completed or abandoned
+  generation: number /* int */;
+  settings: GameSettingsDto;
+  players: GameLogPlayerDto[]; // Seated at the end, in turn order
+  concededPlayers: ConcededPlayerDto[];
+  finalScores: FinalScoreDto[]; // Empty for abandoned games
+  entries: RecentActionDto[]; // Every log entry, oldest first
+  shuffleProof?: ShuffleProofDto; // Revealed deck seed; absent for unseeded decks
+}
+/**
+ * ShuffleProofDto reveals a finished game's deck seed and starting order
+ * Re-deriving the order from the seed and hashing both must give the commitment published at game start
+ */
+export interface ShuffleProofDto {
+  algorithm: string; // How the seed orders the cards, e.g. chacha8-fisher-yates-v1
+  commitment: string; // Hex SHA-256 published while the game ran
+  seed: string; // Hex 32-byte seed
+  projectCards: string[];
+  corporations: string[];
+  preludes: string[];
+}
+/**
+ * GameLogPlayerDto names a player and their corporation in a log export
+ */
+export interface GameLogPlayerDto {
+  playerId: string;
+  playerName: string;
+  corporation: string; // Corporation name; empty if none was chosen
+}
+/**
+ * ListArchivedGamesResponse lists archived games, most recently finished first
+ */
+export interface ListArchivedGamesResponse {
+  games: ArchivedGameDto[];
+}
+/**
+ * ArchivedGameDto is one archived game in the history listing
+ */
+export interface ArchivedGameDto {
+  gameId: string;
+  status: GameStatus; // completed or abandoned
+  generation: number /* int */;
+  finishedAt: string;
+  players: ArchivedPlayerDto[]; // Seated players in turn order, then conceded players
+}
+/**
+ * ArchivedPlayerDto is one seat's outcome in an archived game
+ */
+export interface ArchivedPlayerDto {
+  playerId: string;
+  playerName: string;
+  corporation: string; // Corporation name; empty if none was chosen
+  totalVP: number /* int */; // 0 for abandoned games
+  placement: number /* int */; // 0 for abandoned games
+  isWinner: boolean;
+  conceded: boolean;
+}
+/**
+ * ArchivedPlayerStatsResponse summarizes a player's completed archived games, matched by name
+ */
+export interface ArchivedPlayerStatsResponse {
+  playerName: string;
+  games: number /* int */;
+  wins: number /* int */;
+  winRate: number /* float64 */;
+  bestVP: number /* int */;
+  averageVP: number /* float64 */;
+}
+/**
+ * AccountKeyResponse carries the key that proves ownership of an account; it is only ever returned once
+ */
+export interface AccountKeyResponse {
+  accountKey: string;
+}
+/**
+ * DeleteAccountResponse reports what an account data deletion removed
+ */
+export interface DeleteAccountResponse {
+  gamesAnonymized: number /* int */; // Archived games whose seats were renamed
+  puzzleCompletionsRemoved: number /* int */;
+  deletedAt: string;
+}
+/**
+ * AdminListGamesResponse represents the admin game listing with memory estimates
+ */
+export interface AdminListGamesResponse {
+  games: AdminGameFootprintDto[]; // Largest estimate first
+  thresholdBytes: number /* int64 */; // Per-game alert threshold
+  heapAllocBytes: number /* uint64 */; // Whole-process heap, for comparison with the estimates
+}
+/**
+ * AdminGameFootprintDto is one game's estimated memory footprint
+ */
+export interface AdminGameFootprintDto {
+  gameId: string;
+  status: GameStatus;
+  players: number /* int */;
+  handCards: number /* int */;
+  playedCards: number /* int */;
+  effects: number /* int */;
+  actions: number /* int */;
+  tiles: number /* int */;
+  deckCards: number /* int */;
+  eventSubscriptions: number /* int */;
+  stateDiffs: number /* int */;
+  estimatedBytes: number /* int64 */;
+  overThreshold: boolean;
+}
+/**
+ * AdminGameLogsResponse represents one game's buffered server log lines
+ */
+export interface AdminGameLogsResponse {
+  gameId: string;
+  level: string; // Lowest level included
+  entries: AdminGameLogEntryDto[]; // Oldest first
+}
+/**
+ * AdminGameLogEntryDto is one server log line recorded for a game
+ */
+export interface AdminGameLogEntryDto {
+  time: string;
+  level: string;
+  message: string;
+  caller?: string;
+  fields?: Record<string, any>;
+}
+/**
+ * AdminCollusionFlagsResponse lists suspicious patterns in public games, for an operator to review
+ */
+export interface AdminCollusionFlagsResponse {
+  heuristics: string[]; // Checks that ran: same-ip, targeted-attacks
+  minAttacks: number /* int */; // Attacks at one opponent before targeted-attacks flags
+  flags: AdminCollusionFlagDto[];
+}
+/**
+ * AdminCollusionFlagDto is one flag raised by a collusion heuristic
+ */
+export interface AdminCollusionFlagDto {
+  gameId: string;
+  kind: string; // same-ip or targeted-attacks
+  playerIds: string[]; // For targeted-attacks: the attacker, then the target
+  detail: string;
+}
+/**
+ * AdminGameAuditResponse lists the conservation invariants a live game breaks; empty when the game is sound
+ */
+export interface AdminGameAuditResponse {
+  gameId: string;
+  violations: AdminAuditViolationDto[];
+}
+/**
+ * AdminAuditViolationDto is one broken invariant
+ */
+export interface AdminAuditViolationDto {
+  check: string; // cards, oceans, resources or shuffle
+  detail: string;
+}
+/**
+ * AdminAwardsResponse is every award's standings in a live game
+ */
+export interface AdminAwardsResponse {
+  gameId: string;
+  freezeAtFunding: boolean; // Awards funded from now on keep their funding-time standings
+  awards: AdminAwardStandingsDto[];
+}
+/**
+ * AdminAwardStandingsDto is one award's standings now and, when they were frozen, at funding
+ */
+export interface AdminAwardStandingsDto {
+  awardType: string;
+  fundedBy?: string; // Unset when not funded
+  standingsAtFunding?: AdminAwardStandingDto[]; // Set only when frozen; these are what the award scores
+  currentStandings: AdminAwardStandingDto[];
+}
+/**
+ * AdminAwardStandingDto is one player's score and place in an award
+ */
+export interface AdminAwardStandingDto {
+  playerId: string;
+  score: number /* int */;
+  placement: number /* int */; // 1, 2, or 0 for no placement
+}
+/**
+ * AdminStateAtResponse is a game's logged state right after one log entry
+ */
+export interface AdminStateAtResponse {
+  gameId: string;
+  sequence: number /* int64 */;
+  entry: StateDiffDto; // The entry the state follows
+  state: AdminGameSnapshotDto;
+}
+/**
+ * AdminGameSnapshotDto is the part of a game's state its log records
+ */
+export interface AdminGameSnapshotDto {
+  status: string;
+  phase: string;
+  generation: number /* int */;
+  currentTurnPlayerId: string;
+  temperature: number /* int */;
+  oxygen: number /* int */;
+  oceans: number /* int */;
+  players: AdminPlayerSnapshotDto[]; // Sorted by player ID
+  tiles: AdminTileSnapshotDto[]; // Sorted by hex ID
+}
+/**
+ * AdminPlayerSnapshotDto is one player's logged state
+ */
+export interface AdminPlayerSnapshotDto {
+  playerId: string;
+  corporation: string;
+  resources: ResourcesDto;
+  production: ProductionDto;
+  terraformRating: number /* int */;
+  passed: boolean;
+  hand: string[];
+  playedCards: string[];
+}
+/**
+ * AdminTileSnapshotDto is one occupied hex
+ */
+export interface AdminTileSnapshotDto {
+  hexId: string;
+  hexName: string;
+  tileType: string;
+  ownerId: string;
+}
+/**
+ * AdminWebSocketStatsResponse represents outgoing WebSocket traffic by wire format and message type
+ */
+export interface AdminWebSocketStatsResponse {
+  connections: number /* int */;
+  wire: AdminWireStatsDto[]; // Only formats that have sent frames
+  queue: AdminSendQueueStatsDto;
+  messageTypes: AdminMessageSizeStatsDto[]; // Largest total first
+  sizeBudgetBytes: number /* int64 */; // Single-message JSON size that logs a warning; 0 = off
+}
+/**
+ * AdminWireStatsDto compares payload sizes of one encoding and compression combination
+ */
+export interface AdminWireStatsDto {
+  encoding: string;
+  compressed: boolean;
+  messages: number /* int64 */;
+  jsonBytes: number /* int64 */; // What the messages would have cost as JSON
+  encodedBytes: number /* int64 */; // After encoding, before permessage-deflate
+  wireBytes: number /* int64 */; // Estimated from sampled frames when compressed
+}
+/**
+ * AdminMessageSizeStatsDto summarizes payload sizes of one outgoing message type
+ */
+export interface AdminMessageSizeStatsDto {
+  type: string;
+  messages: number /* int64 */;
+  jsonBytes: number /* int64 */;
+  maxJsonBytes: number /* int64 */; // Largest single message
+}
+/**
+ * AdminSendQueueStatsDto summarizes per-connection outgoing queues
+ */
+export interface AdminSendQueueStatsDto {
+  queued: number /* int64 */;
+  coalesced: number /* int64 */; // Queued game states replaced by a newer one
+  dropped: number /* int64 */; // Discarded because a queue was full
+  slowEvents: number /* int64 */; // Times a connection fell behind
+  slowConnections: number /* int64 */; // Connections currently behind
+}
 /**
  * ListGamesResponse represents the response for listing games
  */
@@ -1216,6 +1904,141 @@ export interface ListCardsResponse {
   offset: number /* int */;
   limit: number /* int */;
 }
+/**
+ * ResolveCardsResponse lists the cards a typed name resolved to, best first
+ */
+export interface ResolveCardsResponse {
+  query: string;
+  matches: CardMatchDto[];
+}
+/**
+ * CardMatchDto is one card a query resolved to
+ */
+export interface CardMatchDto {
+  card: CardDto;
+  score: number /* int */; // 100 for an exact ID or name, lower for looser matches
+  matchedBy: string; // id, name, alias, prefix, words, substring or fuzzy
+}
+/**
+ * CardGuideResponse is a corporation's "how to play" sheet, for starting selection
+ */
+export interface CardGuideResponse {
+  cardId: string;
+  cardName: string;
+  summary: string; // The ability in plain words
+  strategy: string[]; // Strategy tags: economy, plants, space, ...
+  tips: string[];
+}
+/**
+ * BehaviorClauseDto is one card behavior as rules text, generated from the engine's behavior data
+ */
+export interface BehaviorClauseDto {
+  behaviorIndex: number /* int */; // Index into the card's behaviors, as actions address them
+  kind: string; // immediate, action, effect, start or first-action
+  label: string; // "When played", "Action", "Effect", "Start", "First action"
+  condition?: string; // Effects: "when you play a space tag"
+  costs: string[];
+  gains: string[];
+  choices: string[]; // One entry per option
+  limit?: string; // Only when not the default once per generation
+  text: string; // The whole clause: "Action: spend 1 energy → gain 1 TR"
+}
+/**
+ * CardBehaviorsExplainResponse is every behavior of a card as rules text, for tooltips
+ */
+export interface CardBehaviorsExplainResponse {
+  cardId: string;
+  cardName: string;
+  clauses: BehaviorClauseDto[];
+}
+/**
+ * PreviewActionType selects what a preview-action request dry-runs
+ */
+export type PreviewActionType = string;
+export const PreviewActionTypePlayCard: PreviewActionType = "play-card";
+export const PreviewActionTypeCardAction: PreviewActionType = "card-action";
+/**
+ * PreviewActionRequest is a card play or card action to dry-run, with the same choices the real action takes
+ */
+export interface PreviewActionRequest {
+  type: PreviewActionType;
+  cardId: string;
+  behaviorIndex?: number; // card-action only
+  payment?: CardPaymentDto; // play-card only
+  choiceIndex?: number;
+  cardStorageTarget?: string;
+  targetPlayerId?: string;
+  attackAmount?: number; // How much an "up to" removal takes
+  discardCardIds?: string[]; // Hand cards paying card-discard inputs
+}
+/**
+ * PreviewActionResponse lists the log entries the action would write; nothing is committed
+ * Cards entering or leaving hands are left out, so a preview never reveals the deck
+ */
+export interface PreviewActionResponse {
+  diffs: StateDiffDto[];
+}
+/**
+ * CreateInviteRequest asks for an invite link reserving a named lobby seat; only the host may ask
+ */
+export interface CreateInviteRequest {
+  playerId: string; // The host's player ID
+  seatName: string; // Name the invited player joins under
+}
+/**
+ * InviteDto is an open lobby invite
+ */
+export interface InviteDto {
+  token: string;
+  gameId: string;
+  seatName: string;
+  url: string; // Join link; absolute when the server knows its public URL, otherwise relative to the site
+  createdAt: string;
+}
+/**
+ * InviteResponse wraps a single invite
+ */
+export interface InviteResponse {
+  invite: InviteDto;
+}
+/**
+ * ListInvitesResponse lists a lobby's open invites, oldest first
+ */
+export interface ListInvitesResponse {
+  invites: InviteDto[];
+}
+/**
+ * ReadinessResponse reports whether the server can take traffic; served with 503 until every check passes
+ */
+export interface ReadinessResponse {
+  status: string; // "ready" or "not-ready"
+  checks: ReadinessCheckDto[];
+}
+/**
+ * ReadinessCheckDto is the outcome of one readiness check
+ */
+export interface ReadinessCheckDto {
+  name: string;
+  ok: boolean;
+  error?: string;
+}
+/**
+ * CapacityResponse reports how full the instance is; game creation answers 503 once active games reach the cap
+ */
+export interface CapacityResponse {
+  activeGames: number /* int */; // Games in the lobby or in play
+  maxActiveGames: number /* int */; // 0 means no cap
+  utilization: number /* float64 */; // ActiveGames / MaxActiveGames, 0 without a cap
+}
+/**
+ * BuildInfoResponse identifies the running build, for bug reports
+ */
+export interface BuildInfoResponse {
+  gitSha: string;
+  modified: boolean; // Built from a tree with uncommitted changes
+  goVersion: string;
+  cardDataVersion: string; // Fingerprint of the loaded card data
+}
 /**
  * ErrorResponse represents an error response
  */
@@ -1239,6 +2062,100 @@ export interface CreateDemoLobbyResponse {
   game: GameDto;
   playerId: string;
 }
+/**
+ * SeedDemoGameRequest represents the request body for seeding a mid-game demo game
+ */
+export interface SeedDemoGameRequest {
+  playerName?: string;
+  botCount?: number /* int */; // 1-4, default 2
+  generation?: number /* int */; // 1-10, default 5
+  cardPacks?: string[];
+  accountId?: string; // Seats are linked to it, so its owner can issue bot tokens
+}
+/**
+ * SeedDemoGameResponse represents the response for seeding a demo game
+ */
+export interface SeedDemoGameResponse {
+  game: GameDto;
+  playerId: string;
+  botIds: string[]; // In seat order
+}
+/**
+ * TutorialScenarioDto summarizes a tutorial scenario
+ */
+export interface TutorialScenarioDto {
+  id: string;
+  name: string;
+  description: string;
+  stepCount: number /* int */;
+}
+/**
+ * ListTutorialsResponse represents the response for listing tutorial scenarios
+ */
+export interface ListTutorialsResponse {
+  tutorials: TutorialScenarioDto[];
+}
+/**
+ * StartTutorialRequest represents the request body for starting a tutorial or puzzle
+ */
+export interface StartTutorialRequest {
+  playerName?: string;
+  accountId?: string; // Client-chosen ID credited with puzzle completion
+}
+/**
+ * StartTutorialResponse represents the response for starting a tutorial or puzzle
+ */
+export interface StartTutorialResponse {
+  game: GameDto;
+  playerId: string;
+}
+/**
+ * PuzzleDto summarizes a puzzle and whether the requesting account has solved it
+ */
+export interface PuzzleDto {
+  id: string;
+  name: string;
+  description: string;
+  victoryType: string; // terraform-rating, victory-points
+  target: number /* int */;
+  byGeneration: number /* int */;
+  completed: boolean;
+}
+/**
+ * ListPuzzlesResponse represents the response for listing puzzles
+ */
+export interface ListPuzzlesResponse {
+  puzzles: PuzzleDto[];
+}
+/**
+ * CardAnalyticsResponse aggregates anonymized stats from games that opted into analytics
+ */
+export interface CardAnalyticsResponse {
+  games: number /* int */; // Finished games recorded
+  cards: CardAnalyticsDto[]; // Ordered by card ID
+  corporations: CorporationAnalyticsDto[]; // Ordered by corporation ID
+}
+/**
+ * CardAnalyticsDto is one card's research pick rate and finished-game record
+ */
+export interface CardAnalyticsDto {
+  cardId: string;
+  offered: number /* int */;
+  bought: number /* int */;
+  pickRate: number /* float64 */; // bought / offered, 0 when never offered
+  played: number /* int */;
+  playedByWinner: number /* int */;
+  winRate: number /* float64 */; // playedByWinner / played, 0 when never played
+}
+/**
+ * CorporationAnalyticsDto is one corporation's finished-game record
+ */
+export interface CorporationAnalyticsDto {
+  corporationId: string;
+  games: number /* int */;
+  wins: number /* int */; // Ties count as no win
+  winRate: number /* float64 */;
+}
 
 //////////
 // source: message_types.go
@@ -1247,8 +2164,14 @@ export interface CreateDemoLobbyResponse {
  * MessageType represents different types of WebSocket messages
  */
 export type MessageType = string;
+export const MessageTypeHello: MessageType = "hello";
+export const MessageTypeHelloAck: MessageType = "hello-ack";
 export const MessageTypePlayerConnect: MessageType = "player-connect";
 export const MessageTypeJoinGame: MessageType = "join-game";
+export const MessageTypeSyncRequest: MessageType = "sync-request";
+export const MessageTypeSyncResponse: MessageType = "sync-response";
+export const MessageTypeSubscribe: MessageType = "subscribe";
+export const MessageTypeStateSlices: MessageType = "state-slices";
 export const MessageTypeGameUpdated: MessageType = "game-updated";
 export const MessageTypePlayerConnected: MessageType = "player-connected";
 export const MessageTypePlayerReconnected: MessageType = "player-reconnected";
@@ -1257,6 +2180,14 @@ export const MessageTypeError: MessageType = "error";
 export const MessageTypeFullState: MessageType = "full-state";
 export const MessageTypeProductionPhaseStarted: MessageType = "production-phase-started";
 export const MessageTypeLogUpdate: MessageType = "log-update";
+export const MessageTypeTutorialProgress: MessageType = "tutorial-progress";
+export const MessageTypeCardPlayPrepared: MessageType = "card-play-prepared";
+export const MessageTypeConfirmPassWithConvertibles: MessageType = "confirm-pass-with-convertibles";
+export const MessageTypeActionSuccess: MessageType = "action-success";
+export const MessageTypeGameCreated: MessageType = "game-created";
+export const MessageTypeReaction: MessageType = "reaction";
+export const MessageTypeGenerationSummary: MessageType = "generation-summary";
+export const MessageTypeBatchResult: MessageType = "batch-result";
 export const MessageTypeActionSellPatents: MessageType = "action.standard-project.sell-patents";
 export const MessageTypeActionConfirmSellPatents: MessageType =
   "action.standard-project.confirm-sell-patents";
@@ -1271,25 +2202,47 @@ export const MessageTypeActionConvertPlantsToGreenery: MessageType =
   "action.resource-conversion.convert-plants-to-greenery";
 export const MessageTypeActionConvertHeatToTemperature: MessageType =
   "action.resource-conversion.convert-heat-to-temperature";
+export const MessageTypeActionConvertAll: MessageType = "action.resource-conversion.convert-all";
 export const MessageTypeCreateGame: MessageType = "create-game";
 export const MessageTypeActionStartGame: MessageType = "action.game-management.start-game";
 export const MessageTypeActionSkipAction: MessageType = "action.game-management.skip-action";
 export const MessageTypeActionConfirmDemoSetup: MessageType =
   "action.game-management.confirm-demo-setup";
+export const MessageTypeActionSetSeatOrder: MessageType = "action.game-management.set-seat-order";
+export const MessageTypeActionSetHandicap: MessageType = "action.game-management.set-handicap";
+export const MessageTypeActionSetPlayerColor: MessageType =
+  "action.game-management.set-player-color";
+export const MessageTypeActionPauseGame: MessageType = "action.game-management.pause-game";
+export const MessageTypeActionResumeGame: MessageType = "action.game-management.resume-game";
+export const MessageTypeActionConcede: MessageType = "action.game-management.concede";
+export const MessageTypeActionVoteAbandon: MessageType = "action.game-management.vote-abandon";
+export const MessageTypeActionSetPreferences: MessageType =
+  "action.game-management.set-preferences";
+export const MessageTypeActionForceAdvancePhase: MessageType =
+  "action.game-management.force-advance-phase";
+export const MessageTypeActionSetNote: MessageType = "action.game-management.set-note";
+export const MessageTypeActionRunBatch: MessageType = "action.game-management.run-batch";
+export const MessageTypeActionSendReaction: MessageType = "action.reaction.send-reaction";
+export const MessageTypeActionMuteReactions: MessageType = "action.reaction.mute-reactions";
 export const MessageTypeActionClaimMilestone: MessageType = "action.milestone.claim-milestone";
 export const MessageTypeActionFundAward: MessageType = "action.award.fund-award";
 export const MessageTypeActionTileSelected: MessageType = "action.tile-selection.tile-selected";
 export const MessageTypeActionPlayCard: MessageType = "action.card.play-card";
+export const MessageTypeActionPreparePlayCard: MessageType = "action.card.prepare-play-card";
+export const MessageTypeActionCommitPlayCard: MessageType = "action.card.commit-play-card";
+export const MessageTypeActionCancelPlayCard: MessageType = "action.card.cancel-play-card";
 export const MessageTypeActionCardAction: MessageType = "action.card.card-action";
 export const MessageTypeActionSelectStartingCard: MessageType = "action.card.select-starting-card";
-export const MessageTypeActionSelectCards: MessageType = "action.card.select-cards";
+export const MessageTypeActionMulliganStartingHand: MessageType = "action.card.mulligan-starting-hand";
 export const MessageTypeActionConfirmProductionCards: MessageType =
   "action.card.confirm-production-cards";
 export const MessageTypeActionCardDrawConfirmed: MessageType = "action.card.card-draw-confirmed";
+export const MessageTypeActionRespondToEffect: MessageType = "action.card.respond-to-effect";
 export const MessageTypeAdminCommand: MessageType = "admin-command";
 export const MessageTypePlayerTakeover: MessageType = "player-takeover";
 export const MessageTypeKickPlayer: MessageType = "kick-player";
 export const MessageTypePlayerKicked: MessageType = "player-kicked";
+export const MessageTypeControlPlayer: MessageType = "control-player";
 
 //////////
 // source: state_diff_dto.go
@@ -1320,6 +2273,7 @@ export interface DiffValueBoolDto {
  */
 export interface TilePlacementDto {
   hexId: string;
+  hexName?: string; // e.g. "E5" or "Noctis City (G1)"
   tileType: string;
   ownerId?: string;
 }
@@ -1346,6 +2300,7 @@ export interface PlayerChangesDto {
   plantsProduction?: DiffValueIntDto;
   energyProduction?: DiffValueIntDto;
   heatProduction?: DiffValueIntDto;
+  handSize?: DiffValueIntDto; // Public even when cardsAdded/cardsRemoved are redacted
   cardsAdded?: string[];
   cardsRemoved?: string[];
   cardsPlayed?: string[];
@@ -1382,6 +2337,32 @@ export interface LogDisplayDataDto {
   tags?: CardTag[];
   vpConditions?: VPConditionDto[];
 }
+/**
+ * LogSummaryDto is the server-rendered line for a log entry; clients show Text or localize Key with Params
+ */
+export interface LogSummaryDto {
+  key: string; // "log." + source type, e.g. "log.standard-project"
+  params: Record<string, string>; // player, source, description, and tiles/gains when present
+  text: string; // English rendering
+}
+/**
+ * RecentActionDto is one entry of the rolling recent actions window; the full history is at /logs
+ */
+export interface RecentActionDto {
+  sequenceNumber: number /* int64 */;
+  timestamp: string;
+  playerId: string; // Empty for game events
+  summary: LogSummaryDto;
+}
+/**
+ * EffectResolutionDto is a card effect triggered by a log entry; entries are listed in the order they resolved
+ */
+export interface EffectResolutionDto {
+  playerId: string;
+  cardId: string;
+  cardName: string;
+  trigger: string; // Condition type that fired the effect, e.g. "city-placed"
+}
 /**
  * StateDiffDto represents the difference between two consecutive game states
  */
@@ -1397,6 +2378,8 @@ export interface StateDiffDto {
   choiceIndex?: number /* int */;
   calculatedOutputs?: CalculatedOutputDto[];
   displayData?: LogDisplayDataDto;
+  summary: LogSummaryDto;
+  effectResolutions?: EffectResolutionDto[]; // Triggered card effects in resolution order
 }
 /**
  * DiffLogDto contains the complete history of state changes for a game
@@ -1417,6 +2400,102 @@ export interface WebSocketMessage {
   type: MessageType;
   payload: any;
   gameId?: string;
+  actionSequence?: number /* int64 */; // Number of messages the game had started processing when this broadcast was built (server to client only)
+}
+/**
+ * HelloPayload is sent by a client right after connecting to agree on the wire format
+ * Encodings are in order of preference; unknown ones are ignored
+ */
+export interface HelloPayload {
+  encodings: string[];
+  compression: boolean; // Ask for permessage-deflate on outgoing frames
+  locale?: string; // Language of server messages, e.g. "de" or "sv-SE"; a player's saved locale replaces it on join
+}
+/**
+ * HelloAckPayload tells the client which wire format the server uses from the next frame on
+ */
+export interface HelloAckPayload {
+  encoding: string;
+  compression: boolean; // False when the client did not negotiate permessage-deflate
+  locale: string; // Language of server messages; "en" when the offered one is unsupported
+}
+/**
+ * Sync response modes
+ */
+export const SyncModeDeltas = "deltas"; // Patch turns the client's state at FromSequence into the current state
+export const SyncModeSnapshot = "snapshot"; // The client's state is unknown or too old; Game replaces it
+/**
+ * SyncRequestPayload asks for the changes since the last game state the client received
+ */
+export interface SyncRequestPayload {
+  sinceSequence: number /* int64 */; // Action sequence of the last game-updated the client applied
+  sinceLogSequence?: number /* int64 */; // Newest log entry the client has; 0 sends every entry
+}
+/**
+ * SyncResponsePayload brings a client's game state and log up to date
+ */
+export interface SyncResponsePayload {
+  mode: string;
+  fromSequence: number /* int64 */; // Sequence the patch applies to; 0 for snapshots
+  actionSequence: number /* int64 */; // Sequence of the resulting state
+  patch?: JSONPatchOperation[]; // Applied in order to the client's GameDto
+  game?: GameDto;
+  logs: StateDiffDto[]; // Log entries newer than SinceLogSequence
+}
+/**
+ * JSONPatchOperation is one RFC 6902 operation; only add, remove and replace are produced
+ */
+export interface JSONPatchOperation {
+  op: string;
+  path: string; // JSON pointer into the GameDto
+  value?: any;
+}
+/**
+ * State slices a client can subscribe to instead of the full game state
+ */
+export const StateSliceGlobalParameters = "globalParameters"; // Temperature, oxygen and oceans
+export const StateSliceScoreboard = "scoreboard"; // Every player's terraform rating, highest first
+export const StateSliceTurn = "turn"; // Status, phase, generation, current turn and pause
+export const StateSliceBoard = "board"; // Tiles and their occupants
+export const StateSliceMilestonesAndAwards = "milestonesAndAwards"; // Claimed milestones and funded awards
+export const StateSliceRecentActions = "recentActions"; // Latest log entries as summaries
+/**
+ * SubscribePayload limits the game state a connection receives to the listed slices
+ * An empty list goes back to the full game-updated state
+ * A connection without a seat names the game to watch and receives the public view only
+ */
+export interface SubscribePayload {
+  slices: string[];
+  gameId?: string; // Only read from connections that have not joined a game
+}
+/**
+ * StateSlicesPayload carries only the slices a connection subscribed to; the others are omitted
+ */
+export interface StateSlicesPayload {
+  gameId: string;
+  slices: string[];
+  globalParameters?: GlobalParametersDto;
+  scoreboard?: ScoreboardEntryDto[];
+  turn?: TurnSliceDto;
+  board?: BoardDto;
+  milestones?: MilestoneDto[];
+  awards?: AwardDto[];
+  recentActions?: RecentActionDto[];
+  serverTime: string; // ISO 8601; when the server sent this, for clients to offset their clock
+}
+/**
+ * TurnSliceDto tells where the game is without any player's details
+ */
+export interface TurnSliceDto {
+  status: GameStatus;
+  currentPhase: GamePhase;
+  generation: number /* int */;
+  currentTurn?: string;
+  waitingOn: string[];
+  paused: boolean;
+  phaseStartedAt: string; // ISO 8601; when the current phase began, not counting time paused
+  turnStartedAt?: string; // ISO 8601; when the current turn began, not counting time paused
+  turnDeadline?: string; // ISO 8601; action phase only, when the turn is skipped
 }
 /**
  * PlayerConnectPayload contains player connection data
@@ -1425,20 +2504,55 @@ export interface PlayerConnectPayload {
   playerName: string;
   gameId: string;
   playerId?: string; // Optional: used for reconnection
+  accountId?: string; // Optional: links the seat to an account for data deletion
+  inviteToken?: string; // Optional: claims the seat an invite reserved, under its name
 }
 /**
  * GameUpdatedPayload contains updated game state
  */
 export interface GameUpdatedPayload {
   game: GameDto;
+  serverTime: string; // ISO 8601; when the server sent this, for clients to offset their clock
 }
 /**
- * PlayerConnectedPayload contains data about a newly connected player
+ * PlayerConnectedPayload confirms a join or takeover; the game itself follows as game-updated
  */
 export interface PlayerConnectedPayload {
   playerId: string;
   playerName: string;
-  game: GameDto;
+}
+/**
+ * GameCreatedPayload answers create-game with the new game's ID
+ */
+export interface GameCreatedPayload {
+  gameId: string;
+}
+/**
+ * ActionSuccessPayload acknowledges an action to the player who sent it
+ * The resulting state arrives separately as game-updated; the optional fields echo the action's target
+ */
+export interface ActionSuccessPayload {
+  action: string;
+  cardId?: string;
+  behaviorIndex?: number /* int */;
+  hex?: string;
+  milestoneType?: string;
+  awardType?: string;
+}
+/**
+ * PlayerKickedPayload tells a player they were removed from the lobby
+ */
+export interface PlayerKickedPayload {
+  reason: string;
+}
+/**
+ * ReactionPayload is a reaction sent to the table, delivered to every player who has not muted the sender
+ */
+export interface ReactionPayload {
+  playerId: string;
+  playerName: string;
+  reaction: string;
+  sentAt: string;
 }
 /**
  * ErrorPayload contains error information
@@ -1446,6 +2560,7 @@ export interface PlayerConnectedPayload {
 export interface ErrorPayload {
   message: string;
   code?: string;
+  expectedPhases?: GamePhase[]; // ERR_WRONG_PHASE only: phases the action is legal in
 }
 /**
  * FullStatePayload contains the complete game state
@@ -1455,12 +2570,10 @@ export interface FullStatePayload {
   playerId: string;
 }
 /**
- * PlayerReconnectedPayload contains data about a reconnected player
+ * PlayerReconnectedPayload confirms a reconnect; the game itself follows as game-updated
  */
 export interface PlayerReconnectedPayload {
   playerId: string;
-  playerName: string;
-  game: GameDto;
 }
 /**
  * PlayerDisconnectedPayload contains data about a disconnected player (for internal handler use)
@@ -1485,12 +2598,97 @@ export interface ProductionPhaseStartedPayload {
   playersData: PlayerProductionData[];
   game: GameDto;
 }
+/**
+ * PlayerGenerationSummaryDto recaps one player's generation
+ */
+export interface PlayerGenerationSummaryDto {
+  playerId: string;
+  playerName: string;
+  income: ResourcesDto; // Credits include terraform rating
+  energyConverted: number /* int */;
+  cardsBought: number /* int */;
+  trDelta: number /* int */;
+}
+/**
+ * GenerationSummaryPayload recaps a finished generation, sent once when its production phase runs
+ */
+export interface GenerationSummaryPayload {
+  generation: number /* int */;
+  players: PlayerGenerationSummaryDto[];
+}
 /**
  * LogUpdatePayload contains game log entries sent via WebSocket
  */
 export interface LogUpdatePayload {
   logs: StateDiffDto[];
 }
+/**
+ * TutorialProgressPayload guides a tutorial player to the current objective
+ */
+export interface TutorialProgressPayload {
+  scenarioId: string;
+  scenarioName: string;
+  stepId?: string; // Empty once completed
+  stepIndex: number /* int */; // 0-based; equals totalSteps once completed
+  totalSteps: number /* int */;
+  instruction?: string;
+  completed: boolean;
+  puzzle?: PuzzleStatusDto; // Set for puzzle scenarios
+}
+/**
+ * PuzzleStatusDto reports progress toward a puzzle's victory condition
+ */
+export interface PuzzleStatusDto {
+  victoryType: string; // terraform-rating, victory-points
+  target: number /* int */;
+  byGeneration: number /* int */;
+  current: number /* int */;
+  outcome: string; // in-progress, solved, failed
+}
+/**
+ * CardPlayPreparedPayload is sent to the preparing player with everything needed to commit the card
+ */
+export interface CardPlayPreparedPayload {
+  cardId: string;
+  cardName: string;
+  baseCost: number /* int */;
+  discount: number /* int */;
+  effectiveCost: number /* int */;
+  allowSteel: boolean;
+  allowTitanium: boolean;
+  steelRate: number /* int */;
+  titaniumRate: number /* int */;
+  substitutes: PaymentSubstituteDto[];
+  choiceCount: number /* int */; // Commit needs a choiceIndex below this when > 0
+  pendingPlacements: string[]; // Tile types queued on commit, in order
+}
+/**
+ * ConfirmPassWithConvertiblesPayload warns that a pass would leave plants or heat unconverted
+ * The client resends skip-action with confirmed set to pass anyway, or sends convert-all first
+ */
+export interface ConfirmPassWithConvertiblesPayload {
+  greeneries: number /* int */; // Greeneries the player's plants could pay for
+  temperatureSteps: number /* int */; // Temperature steps the player's heat could buy
+  plantCost: number /* int */; // Plants per greenery after discounts
+  heatCost: number /* int */; // Heat per temperature step after discounts
+}
+/**
+ * BatchResultPayload answers run-batch with the outcome of each step
+ * When a step failed, nothing in the batch was kept: earlier steps were rolled back and later ones never ran
+ */
+export interface BatchResultPayload {
+  completed: boolean;
+  steps: BatchStepResultDto[];
+}
+/**
+ * BatchStepResultDto is the outcome of one batch step
+ */
+export interface BatchStepResultDto {
+  index: number /* int */;
+  type: MessageType;
+  status: string; // succeeded, failed or skipped
+  error?: string; // Why the failed step failed
+}
 /**
  * ConfirmStartingCardSelectionMessage represents confirm starting card selection message
  */
@@ -0,0 +1,45 @@
+import { JSONPatchOperation } from "../types/generated/api-types.ts";
+
+const parsePointer = (path: string): string[] =>
+  path
+    .split("/")
+    .slice(1)
+    .map((segment) => segment.replace(/~1/g, "/").replace(/~0/g, "~"));
+
+/**
+ * Applies the add, remove and replace operations of an RFC 6902 patch from a sync response.
+ * The document is copied first, so the caller's state is never mutated.
+ */
+export function applyJsonPatch<T>(document: T, operations: JSONPatchOperation[]): T {
+  const result = structuredClone(document) as any;
+
+  for (const operation of operations) {
+    const segments = parsePointer(operation.path);
+    const key = segments.pop();
+    if (key === undefined) {
+      throw new Error("Patching the document root is not supported");
+    }
+
+    let parent = result;
+    for (const segment of segments) {
+      parent = parent?.[segment];
+    }
+    if (parent === null || typeof parent !== "object") {
+      throw new Error(`Patch path not found: ${operation.path}`);
+    }
+
+    switch (operation.op) {
+      case "add":
+      case "replace":
+        parent[key] = operation.value;
+        break;
+      case "remove":
+        delete parent[key];
+        break;
+      default:
+        throw new Error(`Unsupported patch operation: ${operation.op}`);
+    }
+  }
+
+  return result as T;
+}
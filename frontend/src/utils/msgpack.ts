@@ -0,0 +1,122 @@
+/**
+ * Minimal MessagePack decoder for server frames.
+ * The server only emits what JSON can express (nil, booleans, numbers, strings, arrays, string-keyed maps),
+ * so binary, ext and timestamp types are not supported.
+ */
+export function decodeMsgpack(buffer: ArrayBuffer): unknown {
+  const view = new DataView(buffer);
+  const bytes = new Uint8Array(buffer);
+  const textDecoder = new TextDecoder();
+  let offset = 0;
+
+  const readString = (length: number): string => {
+    const value = textDecoder.decode(bytes.subarray(offset, offset + length));
+    offset += length;
+    return value;
+  };
+
+  const readArray = (length: number): unknown[] => {
+    const items = new Array(length);
+    for (let i = 0; i < length; i++) {
+      items[i] = read();
+    }
+    return items;
+  };
+
+  const readMap = (length: number): Record<string, unknown> => {
+    const result: Record<string, unknown> = {};
+    for (let i = 0; i < length; i++) {
+      const key = read() as string;
+      result[key] = read();
+    }
+    return result;
+  };
+
+  const read = (): unknown => {
+    const code = view.getUint8(offset++);
+
+    if (code <= 0x7f) return code;
+    if (code >= 0xe0) return code - 0x100;
+    if ((code & 0xf0) === 0x80) return readMap(code & 0x0f);
+    if ((code & 0xf0) === 0x90) return readArray(code & 0x0f);
+    if ((code & 0xe0) === 0xa0) return readString(code & 0x1f);
+
+    let value: unknown;
+    switch (code) {
+      case 0xc0:
+        return null;
+      case 0xc2:
+        return false;
+      case 0xc3:
+        return true;
+      case 0xcb:
+        value = view.getFloat64(offset);
+        offset += 8;
+        return value;
+      case 0xcc:
+        return view.getUint8(offset++);
+      case 0xcd:
+        value = view.getUint16(offset);
+        offset += 2;
+        return value;
+      case 0xce:
+        value = view.getUint32(offset);
+        offset += 4;
+        return value;
+      case 0xcf:
+        value = Number(view.getBigUint64(offset));
+        offset += 8;
+        return value;
+      case 0xd0:
+        return view.getInt8(offset++);
+      case 0xd1:
+        value = view.getInt16(offset);
+        offset += 2;
+        return value;
+      case 0xd2:
+        value = view.getInt32(offset);
+        offset += 4;
+        return value;
+      case 0xd3:
+        value = Number(view.getBigInt64(offset));
+        offset += 8;
+        return value;
+      case 0xd9:
+        return readString(view.getUint8(offset++));
+      case 0xda: {
+        const length = view.getUint16(offset);
+        offset += 2;
+        return readString(length);
+      }
+      case 0xdb: {
+        const length = view.getUint32(offset);
+        offset += 4;
+        return readString(length);
+      }
+      case 0xdc: {
+        const length = view.getUint16(offset);
+        offset += 2;
+        return readArray(length);
+      }
+      case 0xdd: {
+        const length = view.getUint32(offset);
+        offset += 4;
+        return readArray(length);
+      }
+      case 0xde: {
+        const length = view.getUint16(offset);
+        offset += 2;
+        return readMap(length);
+      }
+      case 0xdf: {
+        const length = view.getUint32(offset);
+        offset += 4;
+        return readMap(length);
+      }
+      default:
+        throw new Error(`Unsupported msgpack type 0x${code.toString(16)}`);
+    }
+  };
+
+  return read();
+}